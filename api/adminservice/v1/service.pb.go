@@ -54,63 +54,88 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 882 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0x4d, 0x6f, 0x23, 0x35,
-	0x18, 0xc7, 0xe3, 0x0b, 0x42, 0x56, 0x79, 0x1b, 0x10, 0x82, 0x1e, 0x86, 0xb7, 0x7b, 0xa2, 0x16,
-	0x28, 0x34, 0xa1, 0x4d, 0xd2, 0x34, 0xa4, 0x12, 0x09, 0xd0, 0xa4, 0x14, 0x89, 0x0b, 0x72, 0x32,
-	0x4f, 0x5b, 0xab, 0x93, 0xcc, 0x60, 0x7b, 0x52, 0x72, 0x82, 0x0b, 0x12, 0x12, 0x12, 0x02, 0x09,
-	0x09, 0x09, 0x89, 0x13, 0x17, 0x90, 0xf8, 0x0c, 0x48, 0xdc, 0xf6, 0xd8, 0x63, 0x8f, 0xdb, 0xf4,
-	0xb2, 0xc7, 0x7e, 0x82, 0xd5, 0x6a, 0x3a, 0xb1, 0x3b, 0x93, 0x78, 0x2b, 0x7b, 0xd2, 0xdb, 0x66,
-	0xed, 0xdf, 0xdf, 0xbf, 0x4c, 0xfd, 0xd8, 0xcf, 0x04, 0xaf, 0x09, 0x18, 0x86, 0x01, 0x23, 0x7e,
-	0x89, 0x03, 0x1b, 0x03, 0x2b, 0x91, 0x90, 0x96, 0x88, 0x37, 0xa4, 0xa3, 0xf8, 0x33, 0x1d, 0x40,
-	0x69, 0xbc, 0x56, 0x9a, 0xfd, 0xb3, 0x18, 0xb2, 0x40, 0x04, 0xce, 0x3b, 0x12, 0x29, 0x26, 0x48,
-	0x91, 0x84, 0xb4, 0x98, 0x46, 0x8a, 0xe3, 0xb5, 0xd5, 0xb2, 0x49, 0x2e, 0x83, 0x6f, 0x22, 0xe0,
-	0xe2, 0x6b, 0x06, 0x3c, 0x0c, 0x46, 0x7c, 0xb6, 0xc0, 0xfa, 0xe3, 0xb7, 0xf0, 0x4a, 0x3d, 0x9e,
-	0xda, 0x4b, 0xa6, 0x3a, 0x7f, 0x22, 0xfc, 0xca, 0x2e, 0xf0, 0x01, 0xa3, 0x7d, 0xe8, 0x44, 0x82,
-	0xf4, 0x7d, 0xe8, 0x09, 0x22, 0xc0, 0xa9, 0x15, 0x0d, 0x5c, 0x8a, 0x3a, 0xb4, 0x9b, 0x2c, 0xbd,
-	0x5a, 0x5f, 0x22, 0x21, 0x91, 0x7e, 0xbb, 0xe0, 0xfc, 0x81, 0xf0, 0xcb, 0x72, 0xca, 0x1e, 0xe5,
-	0x22, 0x60, 0x93, 0xbd, 0x80, 0x0b, 0xa7, 0x6a, 0x15, 0x9e, 0x22, 0xa5, 0x5d, 0x2d, 0x7f, 0x80,
-	0x92, 0x9b, 0xe0, 0x67, 0x5b, 0x20, 0x7a, 0x27, 0x84, 0x79, 0xce, 0x7b, 0x46, 0x79, 0x72, 0xba,
-	0xb4, 0x78, 0xdf, 0x92, 0x52, 0x4b, 0x7f, 0x87, 0x71, 0xc3, 0x0f, 0x38, 0x24, 0x8b, 0x6f, 0x18,
-	0xc5, 0xdc, 0x02, 0x72, 0xf9, 0x0f, 0xac, 0x39, 0x25, 0xf0, 0x1b, 0xc2, 0x2f, 0xb5, 0x29, 0x17,
-	0x07, 0x8c, 0x8c, 0xf8, 0x11, 0xb0, 0x03, 0xc2, 0x4f, 0xb9, 0xb3, 0x65, 0x14, 0xb8, 0xc0, 0x49,
-	0x9f, 0xed, 0xbc, 0xb8, 0xd2, 0xfa, 0x09, 0xe1, 0xe7, 0x6f, 0xc6, 0xe9, 0x50, 0x3a, 0x95, 0xcd,
-	0x43, 0x15, 0x24, 0x85, 0x2a, 0xb9, 0x58, 0x65, 0x13, 0x57, 0x57, 0x3c, 0xd8, 0x85, 0xd0, 0xa7,
-	0x03, 0x22, 0x68, 0x30, 0x4a, 0x9c, 0x6a, 0xc6, 0xb9, 0xf3, 0xa8, 0x5d, 0x75, 0xe9, 0x13, 0x32,
-	0xd5, 0x15, 0x4f, 0x39, 0xa4, 0x9c, 0xf6, 0xa9, 0x4f, 0xc5, 0x24, 0xd1, 0xab, 0x1a, 0x87, 0xcf,
-	0x91, 0x76, 0xd5, 0xa5, 0x0d, 0x48, 0x6f, 0xf1, 0x2e, 0x0c, 0x83, 0x31, 0xc4, 0x03, 0x86, 0x5b,
-	0xfc, 0x16, 0xb0, 0xdb, 0xe2, 0x69, 0x4e, 0x09, 0xfc, 0x8f, 0xf0, 0x9b, 0x2d, 0x10, 0x5f, 0x06,
-	0xec, 0xf4, 0xc8, 0x0f, 0xce, 0x9a, 0xdf, 0xc2, 0x20, 0x8a, 0x9f, 0x62, 0x97, 0x9c, 0xcd, 0xce,
-	0x83, 0xc3, 0x75, 0xa7, 0x6d, 0x5a, 0xc1, 0x77, 0xc6, 0x48, 0xdb, 0xce, 0x3d, 0xa5, 0xa9, 0xef,
-	0xf0, 0x17, 0xc2, 0xaf, 0xb6, 0x20, 0xbd, 0x07, 0x3a, 0xc0, 0x39, 0x39, 0x06, 0xee, 0xec, 0x98,
-	0xae, 0xa5, 0x81, 0xa5, 0x6f, 0x63, 0xa9, 0x0c, 0x65, 0xf9, 0x1f, 0xc2, 0x6f, 0xb4, 0x40, 0x7c,
-	0x4a, 0x86, 0xc0, 0x43, 0x32, 0x00, 0x9d, 0xee, 0x27, 0xa6, 0x4b, 0xdd, 0x95, 0x22, 0xbd, 0xdb,
-	0xf7, 0x13, 0xa6, 0xbe, 0xc0, 0xbf, 0x08, 0xbf, 0xde, 0x02, 0xb1, 0xdb, 0xde, 0xd7, 0xa9, 0x37,
-	0x4d, 0x57, 0xd3, 0xf3, 0x52, 0xfa, 0xe3, 0x65, 0x63, 0x94, 0xee, 0x8f, 0x08, 0x3f, 0xd7, 0x05,
-	0x12, 0x86, 0xfe, 0xa4, 0x39, 0x86, 0x91, 0xe0, 0xce, 0xa6, 0x61, 0x99, 0xa4, 0x18, 0xa9, 0x55,
-	0xce, 0x83, 0x66, 0x8e, 0xa0, 0xba, 0xe7, 0xf5, 0x80, 0xb0, 0xc1, 0x49, 0x5d, 0x08, 0x46, 0xfb,
-	0x91, 0x00, 0xd3, 0x23, 0x48, 0x43, 0xda, 0x1d, 0x41, 0xda, 0x80, 0x4c, 0xf5, 0x24, 0x47, 0xc3,
-	0x82, 0xdf, 0x8e, 0xc5, 0xb9, 0xf2, 0x34, 0xc5, 0xc6, 0x52, 0x19, 0x99, 0x47, 0x18, 0xb7, 0x08,
-	0xf9, 0x1e, 0xa1, 0x86, 0xb4, 0x7b, 0x84, 0xda, 0x00, 0x25, 0xf7, 0x33, 0xc2, 0x2f, 0xc8, 0x2e,
-	0xaa, 0xe1, 0x47, 0x5c, 0x00, 0x73, 0x2a, 0x56, 0xbd, 0xd7, 0x8c, 0x92, 0x52, 0x1f, 0xe5, 0x83,
-	0x95, 0xd0, 0x0f, 0x08, 0xaf, 0xc4, 0x17, 0xcf, 0x6c, 0x84, 0x3b, 0x1f, 0x1a, 0xdf, 0x55, 0x12,
-	0x91, 0x2a, 0x9b, 0x39, 0x48, 0xe5, 0xf1, 0x3b, 0xc2, 0x4e, 0x6a, 0xa8, 0x03, 0xc3, 0x7e, 0x6c,
-	0xb3, 0x6d, 0x9b, 0x39, 0x03, 0xa5, 0x53, 0x35, 0x37, 0xaf, 0xcc, 0xfe, 0x41, 0xf8, 0xb5, 0xba,
-	0xe7, 0x7d, 0xc6, 0xbe, 0x08, 0xbd, 0x9b, 0x6e, 0x7c, 0x18, 0x08, 0xf5, 0xb7, 0xdb, 0x35, 0x2d,
-	0x2b, 0x2d, 0x2e, 0x2d, 0x9b, 0x4b, 0xa6, 0x64, 0xf6, 0x7e, 0x52, 0x20, 0x59, 0xcd, 0xaa, 0x45,
-	0x69, 0x69, 0x0d, 0x6b, 0xf9, 0x03, 0x32, 0xcd, 0x68, 0x72, 0x1c, 0xab, 0xab, 0xa0, 0x6c, 0x71,
-	0x86, 0xcf, 0x9f, 0xff, 0x95, 0x5c, 0xac, 0xb2, 0xf9, 0x15, 0xe1, 0x17, 0x3f, 0x8f, 0xd8, 0x31,
-	0xa4, 0x7d, 0xcc, 0xaa, 0x69, 0x1e, 0x93, 0x46, 0x5b, 0x39, 0xe9, 0x8c, 0x53, 0x07, 0x72, 0x39,
-	0xcd, 0x63, 0x76, 0x4e, 0x8b, 0x74, 0xa6, 0x69, 0xef, 0xc2, 0x11, 0x03, 0x7e, 0x22, 0xbb, 0x2c,
-	0x9b, 0xa6, 0x5d, 0x87, 0xda, 0x35, 0xed, 0xfa, 0x84, 0xb9, 0x4b, 0x89, 0xc3, 0xc8, 0x5b, 0x78,
-	0xad, 0x30, 0xbd, 0x94, 0x74, 0xb0, 0xed, 0xa5, 0xa4, 0xcf, 0xc8, 0xbc, 0x1f, 0xb6, 0x40, 0xc4,
-	0xff, 0xbd, 0x1f, 0x41, 0x04, 0x36, 0xef, 0x87, 0x0b, 0x9c, 0xdd, 0xfb, 0xa1, 0x06, 0x97, 0x5a,
-	0x3b, 0xfe, 0xf9, 0xa5, 0x5b, 0xb8, 0xb8, 0x74, 0x0b, 0xd7, 0x97, 0x2e, 0xfa, 0x7e, 0xea, 0xa2,
-	0xbf, 0xa7, 0x2e, 0x7a, 0x30, 0x75, 0xd1, 0xf9, 0xd4, 0x45, 0x0f, 0xa7, 0x2e, 0x7a, 0x34, 0x75,
-	0x0b, 0xd7, 0x53, 0x17, 0xfd, 0x72, 0xe5, 0x16, 0xce, 0xaf, 0xdc, 0xc2, 0xc5, 0x95, 0x5b, 0xf8,
-	0x6a, 0xe3, 0x38, 0xb8, 0x5d, 0x99, 0x06, 0x77, 0xfc, 0xf0, 0x52, 0x49, 0x7f, 0xee, 0x3f, 0x73,
-	0xf3, 0xab, 0xcb, 0xbb, 0x4f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x23, 0x08, 0x99, 0xa1, 0x0b, 0x12,
-	0x00, 0x00,
+	// 1289 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcf, 0x6b, 0x24, 0x45,
+	0x14, 0xc7, 0xa7, 0x2e, 0x22, 0xcd, 0xfa, 0xab, 0x15, 0x7f, 0xac, 0xd0, 0x8a, 0xde, 0x27, 0x64,
+	0xd5, 0xd5, 0x4d, 0x4c, 0x26, 0xf3, 0xcb, 0x89, 0x98, 0x31, 0xbb, 0x33, 0xeb, 0x0a, 0x5e, 0xa4,
+	0x66, 0xfa, 0x4d, 0x52, 0x6c, 0xcf, 0x74, 0x5b, 0x55, 0x33, 0x6b, 0x4e, 0x7a, 0x11, 0x04, 0x41,
+	0x14, 0x04, 0x41, 0xf0, 0x24, 0x88, 0x82, 0x27, 0xff, 0x00, 0xc1, 0x9b, 0xc7, 0x1c, 0xf7, 0x68,
+	0x26, 0x17, 0x0f, 0x1e, 0xf6, 0x4f, 0x58, 0x3a, 0x3d, 0x55, 0x33, 0x55, 0x5d, 0x13, 0xaa, 0xba,
+	0xf7, 0xb6, 0xd9, 0xee, 0xef, 0xb7, 0x3e, 0x79, 0xa9, 0xf7, 0xea, 0xbd, 0x6a, 0x6f, 0x93, 0xc3,
+	0x38, 0x89, 0x29, 0x8e, 0x36, 0x18, 0xd0, 0x19, 0xd0, 0x0d, 0x9c, 0x90, 0x0d, 0x1c, 0x8e, 0xc9,
+	0x24, 0xfd, 0x99, 0x0c, 0x61, 0x63, 0xb6, 0xb9, 0xb1, 0xf8, 0x67, 0x35, 0xa1, 0x31, 0x8f, 0xfd,
+	0xd7, 0x85, 0xa4, 0x9a, 0x49, 0xaa, 0x38, 0x21, 0xd5, 0x55, 0x49, 0x75, 0xb6, 0x79, 0x75, 0xcb,
+	0xc6, 0x97, 0xc2, 0x67, 0x53, 0x60, 0xfc, 0x53, 0x0a, 0x2c, 0x89, 0x27, 0x6c, 0xb1, 0xc0, 0xb5,
+	0xff, 0x77, 0xbc, 0x2b, 0xf5, 0xf4, 0xd5, 0x7e, 0xf6, 0xaa, 0xff, 0x33, 0xf2, 0x9e, 0x6b, 0x01,
+	0x1b, 0x52, 0x32, 0x80, 0xee, 0x94, 0xe3, 0x41, 0x04, 0x7d, 0x8e, 0x39, 0xf8, 0x7b, 0x55, 0x0b,
+	0x96, 0xaa, 0x49, 0xda, 0xcb, 0x96, 0xbe, 0x5a, 0x2f, 0xe1, 0x90, 0x41, 0xbf, 0x56, 0xf1, 0x7f,
+	0x42, 0xde, 0xb3, 0xe2, 0x95, 0x7d, 0xc2, 0x78, 0x4c, 0x4f, 0xf6, 0x63, 0xc6, 0xfd, 0x9a, 0x93,
+	0xf9, 0x8a, 0x52, 0xd0, 0xed, 0x15, 0x37, 0x90, 0x70, 0x27, 0xde, 0xe3, 0x1d, 0xe0, 0xfd, 0x63,
+	0x4c, 0x43, 0xff, 0x4d, 0x2b, 0x3f, 0xf1, 0xba, 0xa0, 0x78, 0xcb, 0x51, 0x25, 0x97, 0xfe, 0xc2,
+	0xf3, 0x9a, 0x51, 0xcc, 0x20, 0x5b, 0xfc, 0xba, 0x95, 0xcd, 0x52, 0x20, 0x96, 0x7f, 0xdb, 0x59,
+	0x27, 0x01, 0x7e, 0x40, 0xde, 0x33, 0x07, 0x84, 0xf1, 0xdb, 0x14, 0x4f, 0xd8, 0x08, 0xe8, 0x6d,
+	0xcc, 0xee, 0x32, 0x7f, 0xc7, 0xca, 0x30, 0xa7, 0x13, 0x3c, 0xbb, 0x45, 0xe5, 0x12, 0xeb, 0x1b,
+	0xe4, 0x3d, 0x79, 0xf1, 0x9c, 0x8c, 0x05, 0xd3, 0x96, 0xbd, 0x29, 0x19, 0x6b, 0x40, 0xdb, 0x85,
+	0xb4, 0x92, 0x26, 0xcd, 0xae, 0xf4, 0x61, 0x0f, 0x92, 0x88, 0x0c, 0x31, 0x27, 0xf1, 0x24, 0x63,
+	0xda, 0xb3, 0xf6, 0xd5, 0xa5, 0x6e, 0xd9, 0x65, 0x76, 0x50, 0xb2, 0x2b, 0x7d, 0xe5, 0x0e, 0x61,
+	0x64, 0x40, 0x22, 0xc2, 0x4f, 0x32, 0xbc, 0x9a, 0xb5, 0xb9, 0xa6, 0x74, 0xcb, 0x2e, 0xa3, 0xc1,
+	0xea, 0x16, 0xef, 0xc1, 0x38, 0x9e, 0x41, 0xfa, 0xc0, 0x72, 0x8b, 0x2f, 0x05, 0x6e, 0x5b, 0x7c,
+	0x55, 0x27, 0x01, 0xfe, 0x46, 0xde, 0xab, 0x1d, 0xe0, 0x1f, 0xc7, 0xf4, 0xee, 0x28, 0x8a, 0xef,
+	0xb5, 0x3f, 0x87, 0xe1, 0x34, 0x8d, 0x62, 0x0f, 0xdf, 0x5b, 0xd4, 0x83, 0x3b, 0xd7, 0xfc, 0x03,
+	0xdb, 0x0c, 0xbe, 0xd4, 0x46, 0xd0, 0x76, 0x1f, 0x91, 0x9b, 0xfc, 0x1d, 0x7e, 0x41, 0xde, 0xf3,
+	0x1d, 0x58, 0xdd, 0x03, 0x5d, 0x60, 0x0c, 0x1f, 0x01, 0xf3, 0x1b, 0xb6, 0x6b, 0x19, 0xc4, 0x82,
+	0xb7, 0x59, 0xca, 0x43, 0x52, 0xfe, 0x85, 0xbc, 0x57, 0x3a, 0xc0, 0x3f, 0xc4, 0x63, 0x60, 0x09,
+	0x1e, 0x82, 0x09, 0xf7, 0x03, 0xdb, 0xa5, 0x2e, 0x73, 0x11, 0xdc, 0x07, 0x8f, 0xc6, 0x4c, 0xfe,
+	0x02, 0x7f, 0x20, 0xef, 0xa5, 0x0e, 0xf0, 0xd6, 0xc1, 0x2d, 0x13, 0x7a, 0xdb, 0x76, 0x35, 0xb3,
+	0x5e, 0x40, 0xbf, 0x57, 0xd6, 0x46, 0xe2, 0x7e, 0x8d, 0xbc, 0x27, 0x7a, 0x80, 0x93, 0x24, 0x3a,
+	0x69, 0xcf, 0x60, 0xc2, 0x99, 0x7f, 0xc3, 0x32, 0x4d, 0x56, 0x34, 0x02, 0x6b, 0xab, 0x88, 0x54,
+	0x29, 0x41, 0xf5, 0x30, 0xec, 0x03, 0xa6, 0xc3, 0xe3, 0x3a, 0xe7, 0x94, 0x0c, 0xa6, 0x1c, 0x6c,
+	0x4b, 0x90, 0x41, 0xe9, 0x56, 0x82, 0x8c, 0x06, 0x4a, 0xf6, 0x64, 0xa5, 0x21, 0xc7, 0xd7, 0x70,
+	0xa8, 0x2b, 0xeb, 0x10, 0x9b, 0xa5, 0x3c, 0x94, 0x10, 0xa6, 0x2d, 0x42, 0xb1, 0x10, 0x1a, 0x94,
+	0x6e, 0x21, 0x34, 0x1a, 0x48, 0xb8, 0x6f, 0x91, 0xf7, 0x94, 0xe8, 0xa2, 0x9a, 0xd1, 0x94, 0x71,
+	0xa0, 0xfe, 0xb6, 0x53, 0xef, 0xb5, 0x50, 0x09, 0xa8, 0x77, 0x8b, 0x89, 0x25, 0xd0, 0x57, 0xc8,
+	0xbb, 0x92, 0x1e, 0x3c, 0x8b, 0x27, 0xcc, 0x7f, 0xc7, 0xfa, 0xac, 0x12, 0x12, 0x81, 0x72, 0xa3,
+	0x80, 0x52, 0x72, 0xfc, 0x88, 0x3c, 0x7f, 0xe5, 0x51, 0x17, 0xc6, 0x83, 0x94, 0x66, 0xd7, 0xd5,
+	0x73, 0x21, 0x14, 0x4c, 0xb5, 0xc2, 0x7a, 0x49, 0xf6, 0x3b, 0xf2, 0x5e, 0xac, 0x87, 0xe1, 0x21,
+	0xfd, 0x28, 0x09, 0x2f, 0xba, 0xf1, 0x71, 0xcc, 0xe5, 0xdf, 0xae, 0x65, 0x9b, 0x56, 0x46, 0xb9,
+	0xa0, 0x6c, 0x97, 0x74, 0x51, 0xf6, 0x7e, 0x96, 0x20, 0x2a, 0x66, 0xcd, 0x21, 0xb5, 0x8c, 0x84,
+	0x7b, 0xc5, 0x0d, 0x94, 0x66, 0x34, 0x2b, 0xc7, 0xf2, 0x28, 0xd8, 0x72, 0xa8, 0xe1, 0x7a, 0xfd,
+	0xdf, 0x2e, 0xa4, 0x95, 0x34, 0xdf, 0x23, 0xef, 0xe9, 0x9b, 0x53, 0x7a, 0x04, 0xab, 0x3c, 0x76,
+	0xd9, 0xa4, 0xcb, 0x04, 0xd1, 0x4e, 0x41, 0xb5, 0xc2, 0xd4, 0x85, 0x42, 0x4c, 0x5d, 0x28, 0xc3,
+	0xd4, 0x85, 0xb5, 0x4c, 0x69, 0xd3, 0xde, 0x83, 0x11, 0x05, 0x76, 0x2c, 0xba, 0x2c, 0x97, 0xa6,
+	0xdd, 0x24, 0x75, 0x6b, 0xda, 0xcd, 0x0e, 0xda, 0xa1, 0xc4, 0x60, 0x12, 0xe6, 0xc6, 0x0a, 0xdb,
+	0x43, 0xc9, 0x24, 0x76, 0x3d, 0x94, 0xcc, 0x1e, 0xca, 0x7c, 0xd8, 0x01, 0x9e, 0xfe, 0xf7, 0xad,
+	0x29, 0x4c, 0xc1, 0x65, 0x3e, 0xcc, 0xe9, 0xdc, 0xe6, 0x43, 0x83, 0x5c, 0x62, 0xfd, 0x8a, 0xbc,
+	0x17, 0x5a, 0x10, 0x01, 0x87, 0x5c, 0x07, 0xed, 0x37, 0x2d, 0x4f, 0x16, 0xa3, 0x5a, 0x20, 0xb6,
+	0xca, 0x99, 0x28, 0x85, 0xad, 0xcf, 0x31, 0xe5, 0x0d, 0xcc, 0x87, 0xc7, 0x87, 0x09, 0xd0, 0x8b,
+	0x30, 0x5b, 0x16, 0x36, 0x83, 0xd2, 0xad, 0xb0, 0x19, 0x0d, 0x94, 0xb3, 0xab, 0xcf, 0xe3, 0x44,
+	0x63, 0xdb, 0xb5, 0xb4, 0x8e, 0x13, 0x33, 0x5a, 0xad, 0xb0, 0x5e, 0x49, 0x0e, 0x71, 0xf6, 0x6b,
+	0x74, 0x0d, 0xa7, 0xc6, 0xc1, 0x4c, 0xd8, 0x2c, 0xe5, 0x91, 0x9b, 0xbb, 0xd5, 0x17, 0x5c, 0xe6,
+	0x6e, 0x4d, 0xe9, 0x3e, 0x77, 0xe7, 0x0c, 0x24, 0xdc, 0x9f, 0xc8, 0x7b, 0x39, 0x3b, 0x74, 0xd3,
+	0xfd, 0x09, 0xb4, 0x31, 0x25, 0x51, 0xf8, 0x7e, 0x78, 0x48, 0x43, 0xa0, 0x64, 0x72, 0xe4, 0x77,
+	0xac, 0xd6, 0xb8, 0xc4, 0x41, 0xc0, 0xee, 0x97, 0x37, 0x52, 0x7a, 0x96, 0xc5, 0x58, 0x9c, 0x27,
+	0x6e, 0xb9, 0x4c, 0xd5, 0x6b, 0x71, 0xdb, 0x25, 0x5d, 0x8c, 0x7b, 0x54, 0x16, 0xaa, 0xf4, 0xe2,
+	0x93, 0x39, 0xee, 0x51, 0x55, 0x5c, 0x6c, 0x8f, 0xea, 0x1e, 0x4a, 0xa5, 0x5c, 0xc6, 0xbe, 0x48,
+	0xa5, 0x5c, 0xa3, 0x76, 0xab, 0x94, 0x6b, 0x4d, 0xb4, 0x09, 0x23, 0x02, 0x0e, 0x72, 0x58, 0xb7,
+	0x9e, 0x30, 0x14, 0x95, 0xeb, 0x84, 0xa1, 0x89, 0x95, 0xa6, 0x26, 0xed, 0xc2, 0x4e, 0x26, 0x78,
+	0x4c, 0x86, 0xcd, 0x78, 0x32, 0x22, 0x47, 0x96, 0x4d, 0x8d, 0x2e, 0x73, 0x6b, 0x6a, 0xf2, 0xea,
+	0xdc, 0x75, 0xad, 0x0a, 0x65, 0x7f, 0x5d, 0x6b, 0xa4, 0xda, 0x2d, 0x2a, 0x57, 0x42, 0xd5, 0x2f,
+	0x16, 0xaa, 0x7e, 0xa9, 0x50, 0xf5, 0xd7, 0x87, 0x2a, 0xfb, 0xe4, 0x90, 0xfe, 0x71, 0x55, 0xac,
+	0x9a, 0xc3, 0xb6, 0x30, 0x92, 0xed, 0x15, 0x37, 0x30, 0x35, 0xa7, 0x2a, 0x9d, 0x53, 0x73, 0x6a,
+	0xc4, 0xab, 0x97, 0x70, 0xd0, 0x2f, 0xc2, 0xda, 0xa3, 0x11, 0x0c, 0x39, 0x99, 0x69, 0x21, 0xb4,
+	0x2e, 0xa1, 0x66, 0xbd, 0xf3, 0x45, 0xd8, 0x3a, 0x1b, 0xe5, 0x22, 0x2c, 0xdd, 0x9f, 0x17, 0x5f,
+	0x37, 0x0e, 0x62, 0x1c, 0xfa, 0xf6, 0x33, 0xbd, 0xd4, 0xb8, 0x5d, 0x84, 0x69, 0x52, 0x65, 0x58,
+	0x6c, 0x52, 0xc0, 0x1c, 0xfa, 0xc3, 0x63, 0x08, 0xa7, 0x11, 0x58, 0x0e, 0x8b, 0xaa, 0xc8, 0x6d,
+	0x58, 0xd4, 0xb5, 0x4a, 0x62, 0x8a, 0x23, 0x42, 0xf2, 0xb8, 0x5d, 0xbd, 0xe8, 0x44, 0x3b, 0x05,
+	0xd5, 0x4a, 0x84, 0xb2, 0xe3, 0xc0, 0x31, 0x42, 0xaa, 0xc8, 0x2d, 0x42, 0xba, 0x56, 0xd9, 0x3a,
+	0x37, 0xd3, 0x26, 0x4a, 0xc2, 0xd8, 0x6d, 0x1d, 0x45, 0xe3, 0xb6, 0x75, 0x34, 0xa9, 0x12, 0x98,
+	0xac, 0x6c, 0x38, 0x06, 0x46, 0x15, 0xb9, 0x05, 0x46, 0xd7, 0xe6, 0x73, 0x6a, 0xf1, 0x88, 0xb9,
+	0xe4, 0x94, 0xd0, 0x14, 0xc8, 0xa9, 0xa5, 0x54, 0xa0, 0x34, 0xa2, 0xd3, 0xb3, 0xa0, 0x72, 0xff,
+	0x2c, 0xa8, 0x3c, 0x38, 0x0b, 0xd0, 0x97, 0xf3, 0x00, 0xfd, 0x36, 0x0f, 0xd0, 0x3f, 0xf3, 0x00,
+	0x9d, 0xce, 0x03, 0xf4, 0xef, 0x3c, 0x40, 0xff, 0xcd, 0x83, 0xca, 0x83, 0x79, 0x80, 0xbe, 0x3b,
+	0x0f, 0x2a, 0xa7, 0xe7, 0x41, 0xe5, 0xfe, 0x79, 0x50, 0xf9, 0xe4, 0xfa, 0x51, 0xbc, 0x5c, 0x95,
+	0xc4, 0x97, 0x7c, 0x66, 0xdf, 0x5e, 0xfd, 0x79, 0xf0, 0xd8, 0xc5, 0x37, 0xf6, 0x37, 0x1e, 0x0e,
+	0x00, 0xe2, 0x99, 0x38, 0xc9, 0xf9, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -179,6 +204,59 @@ type AdminServiceClient interface {
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(ctx context.Context, in *GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*GetTaskQueueTasksResponse, error)
+	// DeleteWorkflowExecution asynchronously deletes a closed workflow execution, including its mutable state,
+	// history and visibility records. A running workflow execution is terminated first if force is set.
+	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
+	// StartBatchOperation starts a batch operation on the workflow executions of a namespace, selected either by a
+	// visibility query or by an explicit list of executions.
+	StartBatchOperation(ctx context.Context, in *StartBatchOperationRequest, opts ...grpc.CallOption) (*StartBatchOperationResponse, error)
+	// StopBatchOperation stops a running batch operation.
+	StopBatchOperation(ctx context.Context, in *StopBatchOperationRequest, opts ...grpc.CallOption) (*StopBatchOperationResponse, error)
+	// DescribeBatchOperation returns the state and progress of a batch operation.
+	DescribeBatchOperation(ctx context.Context, in *DescribeBatchOperationRequest, opts ...grpc.CallOption) (*DescribeBatchOperationResponse, error)
+	// ListBatchOperations lists the batch operations of a namespace.
+	ListBatchOperations(ctx context.Context, in *ListBatchOperationsRequest, opts ...grpc.CallOption) (*ListBatchOperationsResponse, error)
+	// UpdateWorkerBuildIdOrdering adds a worker build ID to the compatible-version graph of a workflow task queue,
+	// or promotes the set containing it to be the default one.
+	UpdateWorkerBuildIdOrdering(ctx context.Context, in *UpdateWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*UpdateWorkerBuildIdOrderingResponse, error)
+	// GetWorkerBuildIdOrdering returns the compatible-version graph of a workflow task queue.
+	GetWorkerBuildIdOrdering(ctx context.Context, in *GetWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*GetWorkerBuildIdOrderingResponse, error)
+	// DescribeTaskQueueStats returns backlog and throughput stats of a task queue aggregated across all its partitions.
+	DescribeTaskQueueStats(ctx context.Context, in *DescribeTaskQueueStatsRequest, opts ...grpc.CallOption) (*DescribeTaskQueueStatsResponse, error)
+	// UpdateWorkflowExecution delivers an update to a running workflow execution and waits for its result.
+	// The update is first validated by the worker, rejected updates are not written to history.
+	UpdateWorkflowExecution(ctx context.Context, in *UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionResponse, error)
+	// DeleteNamespace marks the namespace as deleted and renames it to free the name.
+	// Workflow executions, task queues and visibility records of the namespace are then deleted
+	// asynchronously by a system workflow, which removes the namespace metadata at the end.
+	DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error)
+	// GetDynamicConfig returns the values and the change history of a dynamic config key stored in persistence.
+	GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error)
+	// ListDynamicConfig lists dynamic config keys stored in persistence.
+	ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error)
+	// SetDynamicConfig sets the value of a dynamic config key for the given constraints.
+	SetDynamicConfig(ctx context.Context, in *SetDynamicConfigRequest, opts ...grpc.CallOption) (*SetDynamicConfigResponse, error)
+	// DeleteDynamicConfig deletes values of a dynamic config key.
+	DeleteDynamicConfig(ctx context.Context, in *DeleteDynamicConfigRequest, opts ...grpc.CallOption) (*DeleteDynamicConfigResponse, error)
+	// RefreshDynamicConfig reloads dynamic config stored in persistence on the frontend host serving the request.
+	RefreshDynamicConfig(ctx context.Context, in *RefreshDynamicConfigRequest, opts ...grpc.CallOption) (*RefreshDynamicConfigResponse, error)
+	// GetEffectiveDynamicConfig returns the values of dynamic config keys seen by the frontend host serving
+	// the request for the given namespace, task queue, shard and workflow type.
+	GetEffectiveDynamicConfig(ctx context.Context, in *GetEffectiveDynamicConfigRequest, opts ...grpc.CallOption) (*GetEffectiveDynamicConfigResponse, error)
+	// ListShardLoad returns the load on history shards across all history hosts, most loaded shards first.
+	ListShardLoad(ctx context.Context, in *ListShardLoadRequest, opts ...grpc.CallOption) (*ListShardLoadResponse, error)
+	// CreateSchedule creates a schedule which starts workflows in the namespace of the request.
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error)
+	// DescribeSchedule returns the definition and the state of a schedule.
+	DescribeSchedule(ctx context.Context, in *DescribeScheduleRequest, opts ...grpc.CallOption) (*DescribeScheduleResponse, error)
+	// UpdateSchedule replaces the definition of a schedule.
+	UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error)
+	// PatchSchedule pauses, unpauses, triggers or backfills a schedule.
+	PatchSchedule(ctx context.Context, in *PatchScheduleRequest, opts ...grpc.CallOption) (*PatchScheduleResponse, error)
+	// DeleteSchedule deletes a schedule. Workflows started by the schedule are not affected.
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
+	// ListSchedules lists the schedules of a namespace.
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
 }
 
 type adminServiceClient struct {
@@ -441,6 +519,213 @@ func (c *adminServiceClient) GetTaskQueueTasks(ctx context.Context, in *GetTaskQ
 	return out, nil
 }
 
+func (c *adminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error) {
+	out := new(DeleteWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartBatchOperation(ctx context.Context, in *StartBatchOperationRequest, opts ...grpc.CallOption) (*StartBatchOperationResponse, error) {
+	out := new(StartBatchOperationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StartBatchOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StopBatchOperation(ctx context.Context, in *StopBatchOperationRequest, opts ...grpc.CallOption) (*StopBatchOperationResponse, error) {
+	out := new(StopBatchOperationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StopBatchOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeBatchOperation(ctx context.Context, in *DescribeBatchOperationRequest, opts ...grpc.CallOption) (*DescribeBatchOperationResponse, error) {
+	out := new(DescribeBatchOperationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeBatchOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListBatchOperations(ctx context.Context, in *ListBatchOperationsRequest, opts ...grpc.CallOption) (*ListBatchOperationsResponse, error) {
+	out := new(ListBatchOperationsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListBatchOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateWorkerBuildIdOrdering(ctx context.Context, in *UpdateWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*UpdateWorkerBuildIdOrderingResponse, error) {
+	out := new(UpdateWorkerBuildIdOrderingResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkerBuildIdOrdering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWorkerBuildIdOrdering(ctx context.Context, in *GetWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*GetWorkerBuildIdOrderingResponse, error) {
+	out := new(GetWorkerBuildIdOrderingResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetWorkerBuildIdOrdering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeTaskQueueStats(ctx context.Context, in *DescribeTaskQueueStatsRequest, opts ...grpc.CallOption) (*DescribeTaskQueueStatsResponse, error) {
+	out := new(DescribeTaskQueueStatsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueueStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateWorkflowExecution(ctx context.Context, in *UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionResponse, error) {
+	out := new(UpdateWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error) {
+	out := new(DeleteNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error) {
+	out := new(GetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error) {
+	out := new(ListDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetDynamicConfig(ctx context.Context, in *SetDynamicConfigRequest, opts ...grpc.CallOption) (*SetDynamicConfigResponse, error) {
+	out := new(SetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteDynamicConfig(ctx context.Context, in *DeleteDynamicConfigRequest, opts ...grpc.CallOption) (*DeleteDynamicConfigResponse, error) {
+	out := new(DeleteDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RefreshDynamicConfig(ctx context.Context, in *RefreshDynamicConfigRequest, opts ...grpc.CallOption) (*RefreshDynamicConfigResponse, error) {
+	out := new(RefreshDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RefreshDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetEffectiveDynamicConfig(ctx context.Context, in *GetEffectiveDynamicConfigRequest, opts ...grpc.CallOption) (*GetEffectiveDynamicConfigResponse, error) {
+	out := new(GetEffectiveDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetEffectiveDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListShardLoad(ctx context.Context, in *ListShardLoadRequest, opts ...grpc.CallOption) (*ListShardLoadResponse, error) {
+	out := new(ListShardLoadResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListShardLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error) {
+	out := new(CreateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeSchedule(ctx context.Context, in *DescribeScheduleRequest, opts ...grpc.CallOption) (*DescribeScheduleResponse, error) {
+	out := new(DescribeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error) {
+	out := new(UpdateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PatchSchedule(ctx context.Context, in *PatchScheduleRequest, opts ...grpc.CallOption) (*PatchScheduleResponse, error) {
+	out := new(PatchScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PatchSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error) {
+	out := new(DeleteScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
@@ -497,6 +782,59 @@ type AdminServiceServer interface {
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error)
+	// DeleteWorkflowExecution asynchronously deletes a closed workflow execution, including its mutable state,
+	// history and visibility records. A running workflow execution is terminated first if force is set.
+	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
+	// StartBatchOperation starts a batch operation on the workflow executions of a namespace, selected either by a
+	// visibility query or by an explicit list of executions.
+	StartBatchOperation(context.Context, *StartBatchOperationRequest) (*StartBatchOperationResponse, error)
+	// StopBatchOperation stops a running batch operation.
+	StopBatchOperation(context.Context, *StopBatchOperationRequest) (*StopBatchOperationResponse, error)
+	// DescribeBatchOperation returns the state and progress of a batch operation.
+	DescribeBatchOperation(context.Context, *DescribeBatchOperationRequest) (*DescribeBatchOperationResponse, error)
+	// ListBatchOperations lists the batch operations of a namespace.
+	ListBatchOperations(context.Context, *ListBatchOperationsRequest) (*ListBatchOperationsResponse, error)
+	// UpdateWorkerBuildIdOrdering adds a worker build ID to the compatible-version graph of a workflow task queue,
+	// or promotes the set containing it to be the default one.
+	UpdateWorkerBuildIdOrdering(context.Context, *UpdateWorkerBuildIdOrderingRequest) (*UpdateWorkerBuildIdOrderingResponse, error)
+	// GetWorkerBuildIdOrdering returns the compatible-version graph of a workflow task queue.
+	GetWorkerBuildIdOrdering(context.Context, *GetWorkerBuildIdOrderingRequest) (*GetWorkerBuildIdOrderingResponse, error)
+	// DescribeTaskQueueStats returns backlog and throughput stats of a task queue aggregated across all its partitions.
+	DescribeTaskQueueStats(context.Context, *DescribeTaskQueueStatsRequest) (*DescribeTaskQueueStatsResponse, error)
+	// UpdateWorkflowExecution delivers an update to a running workflow execution and waits for its result.
+	// The update is first validated by the worker, rejected updates are not written to history.
+	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
+	// DeleteNamespace marks the namespace as deleted and renames it to free the name.
+	// Workflow executions, task queues and visibility records of the namespace are then deleted
+	// asynchronously by a system workflow, which removes the namespace metadata at the end.
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
+	// GetDynamicConfig returns the values and the change history of a dynamic config key stored in persistence.
+	GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error)
+	// ListDynamicConfig lists dynamic config keys stored in persistence.
+	ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error)
+	// SetDynamicConfig sets the value of a dynamic config key for the given constraints.
+	SetDynamicConfig(context.Context, *SetDynamicConfigRequest) (*SetDynamicConfigResponse, error)
+	// DeleteDynamicConfig deletes values of a dynamic config key.
+	DeleteDynamicConfig(context.Context, *DeleteDynamicConfigRequest) (*DeleteDynamicConfigResponse, error)
+	// RefreshDynamicConfig reloads dynamic config stored in persistence on the frontend host serving the request.
+	RefreshDynamicConfig(context.Context, *RefreshDynamicConfigRequest) (*RefreshDynamicConfigResponse, error)
+	// GetEffectiveDynamicConfig returns the values of dynamic config keys seen by the frontend host serving
+	// the request for the given namespace, task queue, shard and workflow type.
+	GetEffectiveDynamicConfig(context.Context, *GetEffectiveDynamicConfigRequest) (*GetEffectiveDynamicConfigResponse, error)
+	// ListShardLoad returns the load on history shards across all history hosts, most loaded shards first.
+	ListShardLoad(context.Context, *ListShardLoadRequest) (*ListShardLoadResponse, error)
+	// CreateSchedule creates a schedule which starts workflows in the namespace of the request.
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
+	// DescribeSchedule returns the definition and the state of a schedule.
+	DescribeSchedule(context.Context, *DescribeScheduleRequest) (*DescribeScheduleResponse, error)
+	// UpdateSchedule replaces the definition of a schedule.
+	UpdateSchedule(context.Context, *UpdateScheduleRequest) (*UpdateScheduleResponse, error)
+	// PatchSchedule pauses, unpauses, triggers or backfills a schedule.
+	PatchSchedule(context.Context, *PatchScheduleRequest) (*PatchScheduleResponse, error)
+	// DeleteSchedule deletes a schedule. Workflows started by the schedule are not affected.
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
+	// ListSchedules lists the schedules of a namespace.
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -587,6 +925,75 @@ func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Conte
 func (*UnimplementedAdminServiceServer) GetTaskQueueTasks(ctx context.Context, req *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) StartBatchOperation(ctx context.Context, req *StartBatchOperationRequest) (*StartBatchOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBatchOperation not implemented")
+}
+func (*UnimplementedAdminServiceServer) StopBatchOperation(ctx context.Context, req *StopBatchOperationRequest) (*StopBatchOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopBatchOperation not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeBatchOperation(ctx context.Context, req *DescribeBatchOperationRequest) (*DescribeBatchOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeBatchOperation not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListBatchOperations(ctx context.Context, req *ListBatchOperationsRequest) (*ListBatchOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBatchOperations not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateWorkerBuildIdOrdering(ctx context.Context, req *UpdateWorkerBuildIdOrderingRequest) (*UpdateWorkerBuildIdOrderingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerBuildIdOrdering not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetWorkerBuildIdOrdering(ctx context.Context, req *GetWorkerBuildIdOrderingRequest) (*GetWorkerBuildIdOrderingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerBuildIdOrdering not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeTaskQueueStats(ctx context.Context, req *DescribeTaskQueueStatsRequest) (*DescribeTaskQueueStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueueStats not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateWorkflowExecution(ctx context.Context, req *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteNamespace(ctx context.Context, req *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetDynamicConfig(ctx context.Context, req *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDynamicConfig(ctx context.Context, req *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) SetDynamicConfig(ctx context.Context, req *SetDynamicConfigRequest) (*SetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteDynamicConfig(ctx context.Context, req *DeleteDynamicConfigRequest) (*DeleteDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) RefreshDynamicConfig(ctx context.Context, req *RefreshDynamicConfigRequest) (*RefreshDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetEffectiveDynamicConfig(ctx context.Context, req *GetEffectiveDynamicConfigRequest) (*GetEffectiveDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveDynamicConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListShardLoad(ctx context.Context, req *ListShardLoadRequest) (*ListShardLoadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShardLoad not implemented")
+}
+func (*UnimplementedAdminServiceServer) CreateSchedule(ctx context.Context, req *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeSchedule(ctx context.Context, req *DescribeScheduleRequest) (*DescribeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateSchedule(ctx context.Context, req *UpdateScheduleRequest) (*UpdateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) PatchSchedule(ctx context.Context, req *PatchScheduleRequest) (*PatchScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteSchedule(ctx context.Context, req *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -1096,6 +1503,420 @@ func _AdminService_GetTaskQueueTasks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteWorkflowExecution(ctx, req.(*DeleteWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartBatchOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBatchOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartBatchOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StartBatchOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartBatchOperation(ctx, req.(*StartBatchOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StopBatchOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopBatchOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StopBatchOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StopBatchOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StopBatchOperation(ctx, req.(*StopBatchOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeBatchOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeBatchOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeBatchOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeBatchOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeBatchOperation(ctx, req.(*DescribeBatchOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListBatchOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBatchOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListBatchOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListBatchOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListBatchOperations(ctx, req.(*ListBatchOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateWorkerBuildIdOrdering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkerBuildIdOrderingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateWorkerBuildIdOrdering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkerBuildIdOrdering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateWorkerBuildIdOrdering(ctx, req.(*UpdateWorkerBuildIdOrderingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWorkerBuildIdOrdering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerBuildIdOrderingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWorkerBuildIdOrdering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetWorkerBuildIdOrdering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWorkerBuildIdOrdering(ctx, req.(*GetWorkerBuildIdOrderingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeTaskQueueStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueueStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeTaskQueueStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueueStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTaskQueueStats(ctx, req.(*DescribeTaskQueueStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateWorkflowExecution(ctx, req.(*UpdateWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, req.(*GetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, req.(*ListDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetDynamicConfig(ctx, req.(*SetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteDynamicConfig(ctx, req.(*DeleteDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RefreshDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RefreshDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RefreshDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RefreshDynamicConfig(ctx, req.(*RefreshDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetEffectiveDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectiveDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetEffectiveDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetEffectiveDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetEffectiveDynamicConfig(ctx, req.(*GetEffectiveDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListShardLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShardLoadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListShardLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListShardLoad",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListShardLoad(ctx, req.(*ListShardLoadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CreateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeSchedule(ctx, req.(*DescribeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateSchedule(ctx, req.(*UpdateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PatchSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PatchSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PatchSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PatchSchedule(ctx, req.(*PatchScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -1212,6 +2033,98 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskQueueTasks",
 			Handler:    _AdminService_GetTaskQueueTasks_Handler,
 		},
+		{
+			MethodName: "DeleteWorkflowExecution",
+			Handler:    _AdminService_DeleteWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "StartBatchOperation",
+			Handler:    _AdminService_StartBatchOperation_Handler,
+		},
+		{
+			MethodName: "StopBatchOperation",
+			Handler:    _AdminService_StopBatchOperation_Handler,
+		},
+		{
+			MethodName: "DescribeBatchOperation",
+			Handler:    _AdminService_DescribeBatchOperation_Handler,
+		},
+		{
+			MethodName: "ListBatchOperations",
+			Handler:    _AdminService_ListBatchOperations_Handler,
+		},
+		{
+			MethodName: "UpdateWorkerBuildIdOrdering",
+			Handler:    _AdminService_UpdateWorkerBuildIdOrdering_Handler,
+		},
+		{
+			MethodName: "GetWorkerBuildIdOrdering",
+			Handler:    _AdminService_GetWorkerBuildIdOrdering_Handler,
+		},
+		{
+			MethodName: "DescribeTaskQueueStats",
+			Handler:    _AdminService_DescribeTaskQueueStats_Handler,
+		},
+		{
+			MethodName: "UpdateWorkflowExecution",
+			Handler:    _AdminService_UpdateWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _AdminService_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "GetDynamicConfig",
+			Handler:    _AdminService_GetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfig",
+			Handler:    _AdminService_ListDynamicConfig_Handler,
+		},
+		{
+			MethodName: "SetDynamicConfig",
+			Handler:    _AdminService_SetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "DeleteDynamicConfig",
+			Handler:    _AdminService_DeleteDynamicConfig_Handler,
+		},
+		{
+			MethodName: "RefreshDynamicConfig",
+			Handler:    _AdminService_RefreshDynamicConfig_Handler,
+		},
+		{
+			MethodName: "GetEffectiveDynamicConfig",
+			Handler:    _AdminService_GetEffectiveDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListShardLoad",
+			Handler:    _AdminService_ListShardLoad_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _AdminService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "DescribeSchedule",
+			Handler:    _AdminService_DescribeSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _AdminService_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "PatchSchedule",
+			Handler:    _AdminService_PatchSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _AdminService_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _AdminService_ListSchedules_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
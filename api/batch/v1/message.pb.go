@@ -0,0 +1,1107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/batch/v1/message.proto
+
+package batch
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	v1 "go.temporal.io/server/api/enums/v1"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type BatchOperationInfo struct {
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	State         v1.BatchOperationState `protobuf:"varint,2,opt,name=state,proto3,enum=temporal.server.api.enums.v1.BatchOperationState" json:"state,omitempty"`
+	OperationType v1.BatchOperationType  `protobuf:"varint,3,opt,name=operation_type,json=operationType,proto3,enum=temporal.server.api.enums.v1.BatchOperationType" json:"operation_type,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity      string                 `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	StartTime     *time.Time             `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	CloseTime     *time.Time             `protobuf:"bytes,7,opt,name=close_time,json=closeTime,proto3,stdtime" json:"close_time,omitempty"`
+}
+
+func (m *BatchOperationInfo) Reset()      { *m = BatchOperationInfo{} }
+func (*BatchOperationInfo) ProtoMessage() {}
+func (*BatchOperationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15bd9e08246dd730, []int{0}
+}
+func (m *BatchOperationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOperationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOperationInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchOperationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOperationInfo.Merge(m, src)
+}
+func (m *BatchOperationInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOperationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOperationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOperationInfo proto.InternalMessageInfo
+
+func (m *BatchOperationInfo) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *BatchOperationInfo) GetState() v1.BatchOperationState {
+	if m != nil {
+		return m.State
+	}
+	return v1.BATCH_OPERATION_STATE_UNSPECIFIED
+}
+
+func (m *BatchOperationInfo) GetOperationType() v1.BatchOperationType {
+	if m != nil {
+		return m.OperationType
+	}
+	return v1.BATCH_OPERATION_TYPE_UNSPECIFIED
+}
+
+func (m *BatchOperationInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BatchOperationInfo) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *BatchOperationInfo) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *BatchOperationInfo) GetCloseTime() *time.Time {
+	if m != nil {
+		return m.CloseTime
+	}
+	return nil
+}
+
+type BatchOperationProgress struct {
+	// Estimated number of workflow executions the batch operation applies to.
+	TotalOperationCount    int64 `protobuf:"varint,1,opt,name=total_operation_count,json=totalOperationCount,proto3" json:"total_operation_count,omitempty"`
+	CompleteOperationCount int64 `protobuf:"varint,2,opt,name=complete_operation_count,json=completeOperationCount,proto3" json:"complete_operation_count,omitempty"`
+	FailureOperationCount  int64 `protobuf:"varint,3,opt,name=failure_operation_count,json=failureOperationCount,proto3" json:"failure_operation_count,omitempty"`
+	// Workflow ids of (a bounded number of) executions the operation failed on.
+	FailedWorkflowIds []string `protobuf:"bytes,4,rep,name=failed_workflow_ids,json=failedWorkflowIds,proto3" json:"failed_workflow_ids,omitempty"`
+}
+
+func (m *BatchOperationProgress) Reset()      { *m = BatchOperationProgress{} }
+func (*BatchOperationProgress) ProtoMessage() {}
+func (*BatchOperationProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15bd9e08246dd730, []int{1}
+}
+func (m *BatchOperationProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOperationProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOperationProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchOperationProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOperationProgress.Merge(m, src)
+}
+func (m *BatchOperationProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOperationProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOperationProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOperationProgress proto.InternalMessageInfo
+
+func (m *BatchOperationProgress) GetTotalOperationCount() int64 {
+	if m != nil {
+		return m.TotalOperationCount
+	}
+	return 0
+}
+
+func (m *BatchOperationProgress) GetCompleteOperationCount() int64 {
+	if m != nil {
+		return m.CompleteOperationCount
+	}
+	return 0
+}
+
+func (m *BatchOperationProgress) GetFailureOperationCount() int64 {
+	if m != nil {
+		return m.FailureOperationCount
+	}
+	return 0
+}
+
+func (m *BatchOperationProgress) GetFailedWorkflowIds() []string {
+	if m != nil {
+		return m.FailedWorkflowIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BatchOperationInfo)(nil), "temporal.server.api.batch.v1.BatchOperationInfo")
+	proto.RegisterType((*BatchOperationProgress)(nil), "temporal.server.api.batch.v1.BatchOperationProgress")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/batch/v1/message.proto", fileDescriptor_15bd9e08246dd730)
+}
+
+var fileDescriptor_15bd9e08246dd730 = []byte{
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x7d, 0xcd, 0x1f, 0xc8, 0x21, 0x2a, 0xe1, 0x2a, 0xc1, 0x8a, 0xd0, 0x35, 0x74, 0x8a,
+	0x18, 0xce, 0x24, 0x48, 0x08, 0x89, 0x01, 0x29, 0x0c, 0x28, 0x13, 0xc8, 0x54, 0xaa, 0xc4, 0x80,
+	0x75, 0x89, 0xdf, 0x18, 0x17, 0xdb, 0xaf, 0x75, 0x77, 0x49, 0x95, 0x0d, 0x89, 0x2f, 0xd0, 0x8f,
+	0xc1, 0x47, 0x61, 0xcc, 0xd8, 0x0d, 0xe2, 0x2c, 0x4c, 0xa8, 0x1f, 0x01, 0xf9, 0x9c, 0xa4, 0xb4,
+	0xcd, 0x00, 0xdb, 0xdd, 0xfb, 0xbc, 0xbf, 0xe7, 0xde, 0xbb, 0xc7, 0xa6, 0x4f, 0x34, 0x24, 0x19,
+	0x4a, 0x11, 0xbb, 0x0a, 0xe4, 0x0c, 0xa4, 0x2b, 0xb2, 0xc8, 0x1d, 0x09, 0x3d, 0xfe, 0xe4, 0xce,
+	0x7a, 0x6e, 0x02, 0x4a, 0x89, 0x10, 0x78, 0x26, 0x51, 0xa3, 0xfd, 0x68, 0xd3, 0xcb, 0xcb, 0x5e,
+	0x2e, 0xb2, 0x88, 0x9b, 0x5e, 0x3e, 0xeb, 0xb5, 0x0f, 0x43, 0xc4, 0x30, 0x06, 0xd7, 0xf4, 0x8e,
+	0xa6, 0x13, 0x57, 0x47, 0x09, 0x28, 0x2d, 0x92, 0xac, 0xc4, 0xdb, 0x8f, 0x03, 0xc8, 0x20, 0x0d,
+	0x20, 0x1d, 0x47, 0xa0, 0xdc, 0x10, 0x43, 0x34, 0x75, 0xb3, 0x5a, 0xb7, 0xf4, 0x77, 0x4d, 0x03,
+	0xe9, 0x34, 0x51, 0xc5, 0x34, 0xe6, 0x28, 0x1f, 0x33, 0x90, 0x42, 0x47, 0x98, 0x96, 0xcc, 0xd1,
+	0xd7, 0x0a, 0xb5, 0x07, 0x85, 0xf2, 0x76, 0x23, 0x0c, 0xd3, 0x09, 0xda, 0x4d, 0x5a, 0x3f, 0xc5,
+	0x91, 0x1f, 0x05, 0x0e, 0xe9, 0x90, 0x6e, 0xc3, 0xab, 0x9d, 0xe2, 0x68, 0x18, 0xd8, 0x6f, 0x68,
+	0x4d, 0x69, 0xa1, 0xc1, 0xd9, 0xeb, 0x90, 0xee, 0x7e, 0xbf, 0xc7, 0x77, 0xdd, 0xc9, 0x9c, 0xc8,
+	0x67, 0x3d, 0x7e, 0xdd, 0xf7, 0x7d, 0x01, 0x7a, 0x25, 0x6f, 0x9f, 0xd0, 0xfd, 0xed, 0x24, 0xbe,
+	0x9e, 0x67, 0xe0, 0x54, 0x8c, 0xe3, 0xd3, 0xff, 0x71, 0x3c, 0x9e, 0x67, 0xe0, 0xdd, 0xc7, 0xbf,
+	0xb7, 0x76, 0x8b, 0xd6, 0x25, 0x08, 0x85, 0xa9, 0x53, 0x35, 0x83, 0xaf, 0x77, 0x76, 0x9b, 0xde,
+	0x8d, 0x02, 0x48, 0x75, 0xa4, 0xe7, 0x4e, 0xcd, 0x28, 0xdb, 0xbd, 0xfd, 0x8a, 0x52, 0xa5, 0x85,
+	0xd4, 0x7e, 0xf1, 0xe6, 0x4e, 0xbd, 0x43, 0xba, 0xf7, 0xfa, 0x6d, 0x5e, 0x06, 0xc2, 0x37, 0x81,
+	0xf0, 0xe3, 0x4d, 0x20, 0x83, 0xea, 0xf9, 0x8f, 0x43, 0xe2, 0x35, 0x0c, 0x53, 0x54, 0x0b, 0x83,
+	0x71, 0x8c, 0x0a, 0x4a, 0x83, 0x3b, 0xff, 0x6a, 0x60, 0x98, 0xa2, 0x7a, 0xf4, 0x9b, 0xd0, 0xd6,
+	0xf5, 0xbb, 0xbd, 0x93, 0x18, 0x4a, 0x50, 0xca, 0xee, 0xd3, 0xa6, 0x46, 0x2d, 0xe2, 0xab, 0xe4,
+	0xfc, 0x31, 0x4e, 0x53, 0x6d, 0x82, 0xa9, 0x78, 0x07, 0x46, 0xdc, 0x62, 0xaf, 0x0b, 0xc9, 0x7e,
+	0x41, 0x9d, 0x31, 0x26, 0x59, 0x0c, 0x1a, 0x6e, 0x61, 0x7b, 0x06, 0x6b, 0x6d, 0xf4, 0x1b, 0xe4,
+	0x73, 0xfa, 0x70, 0x22, 0xa2, 0x78, 0x2a, 0x6f, 0x83, 0x15, 0x03, 0x36, 0xd7, 0xf2, 0x0d, 0x8e,
+	0xd3, 0x83, 0x42, 0x80, 0xc0, 0x3f, 0x43, 0xf9, 0x79, 0x12, 0xe3, 0x99, 0x1f, 0x05, 0xca, 0xa9,
+	0x76, 0x2a, 0xdd, 0x86, 0xf7, 0xa0, 0x94, 0x4e, 0xd6, 0xca, 0x30, 0x50, 0x83, 0x8f, 0x8b, 0x25,
+	0xb3, 0x2e, 0x96, 0xcc, 0xba, 0x5c, 0x32, 0xf2, 0x25, 0x67, 0xe4, 0x5b, 0xce, 0xc8, 0xf7, 0x9c,
+	0x91, 0x45, 0xce, 0xc8, 0xcf, 0x9c, 0x91, 0x5f, 0x39, 0xb3, 0x2e, 0x73, 0x46, 0xce, 0x57, 0xcc,
+	0x5a, 0xac, 0x98, 0x75, 0xb1, 0x62, 0xd6, 0x87, 0x6e, 0x88, 0x57, 0xdf, 0x47, 0x84, 0xbb, 0x7e,
+	0xba, 0x97, 0x66, 0x31, 0xaa, 0x9b, 0x57, 0x7f, 0xf6, 0x67, 0x00, 0x3e, 0x64, 0xe1, 0x1a, 0xa1,
+	0x03, 0x00, 0x00,
+}
+
+func (this *BatchOperationInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BatchOperationInfo)
+	if !ok {
+		that2, ok := that.(BatchOperationInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.JobId != that1.JobId {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.OperationType != that1.OperationType {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if that1.StartTime == nil {
+		if this.StartTime != nil {
+			return false
+		}
+	} else if !this.StartTime.Equal(*that1.StartTime) {
+		return false
+	}
+	if that1.CloseTime == nil {
+		if this.CloseTime != nil {
+			return false
+		}
+	} else if !this.CloseTime.Equal(*that1.CloseTime) {
+		return false
+	}
+	return true
+}
+func (this *BatchOperationProgress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BatchOperationProgress)
+	if !ok {
+		that2, ok := that.(BatchOperationProgress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TotalOperationCount != that1.TotalOperationCount {
+		return false
+	}
+	if this.CompleteOperationCount != that1.CompleteOperationCount {
+		return false
+	}
+	if this.FailureOperationCount != that1.FailureOperationCount {
+		return false
+	}
+	if len(this.FailedWorkflowIds) != len(that1.FailedWorkflowIds) {
+		return false
+	}
+	for i := range this.FailedWorkflowIds {
+		if this.FailedWorkflowIds[i] != that1.FailedWorkflowIds[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *BatchOperationInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&batch.BatchOperationInfo{")
+	s = append(s, "JobId: "+fmt.Sprintf("%#v", this.JobId)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "OperationType: "+fmt.Sprintf("%#v", this.OperationType)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "StartTime: "+fmt.Sprintf("%#v", this.StartTime)+",\n")
+	s = append(s, "CloseTime: "+fmt.Sprintf("%#v", this.CloseTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BatchOperationProgress) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&batch.BatchOperationProgress{")
+	s = append(s, "TotalOperationCount: "+fmt.Sprintf("%#v", this.TotalOperationCount)+",\n")
+	s = append(s, "CompleteOperationCount: "+fmt.Sprintf("%#v", this.CompleteOperationCount)+",\n")
+	s = append(s, "FailureOperationCount: "+fmt.Sprintf("%#v", this.FailureOperationCount)+",\n")
+	s = append(s, "FailedWorkflowIds: "+fmt.Sprintf("%#v", this.FailedWorkflowIds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessage(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *BatchOperationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOperationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOperationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CloseTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMessage(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StartTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintMessage(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OperationType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.OperationType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOperationProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOperationProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOperationProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedWorkflowIds) > 0 {
+		for iNdEx := len(m.FailedWorkflowIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedWorkflowIds[iNdEx])
+			copy(dAtA[i:], m.FailedWorkflowIds[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.FailedWorkflowIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FailureOperationCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.FailureOperationCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CompleteOperationCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.CompleteOperationCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalOperationCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TotalOperationCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BatchOperationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovMessage(uint64(m.State))
+	}
+	if m.OperationType != 0 {
+		n += 1 + sovMessage(uint64(m.OperationType))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CloseTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchOperationProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalOperationCount != 0 {
+		n += 1 + sovMessage(uint64(m.TotalOperationCount))
+	}
+	if m.CompleteOperationCount != 0 {
+		n += 1 + sovMessage(uint64(m.CompleteOperationCount))
+	}
+	if m.FailureOperationCount != 0 {
+		n += 1 + sovMessage(uint64(m.FailureOperationCount))
+	}
+	if len(m.FailedWorkflowIds) > 0 {
+		for _, s := range m.FailedWorkflowIds {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *BatchOperationInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BatchOperationInfo{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`OperationType:` + fmt.Sprintf("%v", this.OperationType) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`CloseTime:` + strings.Replace(fmt.Sprintf("%v", this.CloseTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchOperationProgress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BatchOperationProgress{`,
+		`TotalOperationCount:` + fmt.Sprintf("%v", this.TotalOperationCount) + `,`,
+		`CompleteOperationCount:` + fmt.Sprintf("%v", this.CompleteOperationCount) + `,`,
+		`FailureOperationCount:` + fmt.Sprintf("%v", this.FailureOperationCount) + `,`,
+		`FailedWorkflowIds:` + fmt.Sprintf("%v", this.FailedWorkflowIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *BatchOperationInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOperationInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOperationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= v1.BatchOperationState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationType", wireType)
+			}
+			m.OperationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationType |= v1.BatchOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CloseTime == nil {
+				m.CloseTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CloseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchOperationProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOperationProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOperationProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalOperationCount", wireType)
+			}
+			m.TotalOperationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalOperationCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteOperationCount", wireType)
+			}
+			m.CompleteOperationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompleteOperationCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureOperationCount", wireType)
+			}
+			m.FailureOperationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureOperationCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedWorkflowIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedWorkflowIds = append(m.FailedWorkflowIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMessage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMessage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMessage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMessage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMessage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMessage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMessage = fmt.Errorf("proto: unexpected end of group")
+)
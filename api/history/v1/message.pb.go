@@ -29,14 +29,20 @@ package history
 
 import (
 	bytes "bytes"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/history/v1"
 )
 
@@ -44,6 +50,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -258,11 +265,213 @@ func (m *VersionHistories) GetHistories() []*VersionHistory {
 	return nil
 }
 
+// ShardLoad is a snapshot of the load on a history shard over the most recent sampling window.
+type ShardLoad struct {
+	ShardId     int32  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	HostAddress string `protobuf:"bytes,2,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	// Length of the sampling window the rates are computed over.
+	Window            *time.Duration `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window,omitempty"`
+	RequestsPerSecond float64        `protobuf:"fixed64,4,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	// Keyed by history service API name.
+	ApiRequestsPerSecond map[string]float64 `protobuf:"bytes,5,rep,name=api_requests_per_second,json=apiRequestsPerSecond,proto3" json:"api_requests_per_second,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// Distance in task IDs between the shard max read level and the ack level, keyed by task category.
+	// Task IDs of all categories come from one shard sequence, so this is an upper bound on the
+	// number of pending tasks, not a task count.
+	TaskIdLag map[string]int64 `protobuf:"bytes,6,rep,name=task_id_lag,json=taskIdLag,proto3" json:"task_id_lag,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Time elapsed since the timer queue ack level.
+	TimerAckLag              *time.Duration `protobuf:"bytes,7,opt,name=timer_ack_lag,json=timerAckLag,proto3,stdduration" json:"timer_ack_lag,omitempty"`
+	MutableStateCacheHitRate float64        `protobuf:"fixed64,8,opt,name=mutable_state_cache_hit_rate,json=mutableStateCacheHitRate,proto3" json:"mutable_state_cache_hit_rate,omitempty"`
+	PersistenceRequests      int64          `protobuf:"varint,9,opt,name=persistence_requests,json=persistenceRequests,proto3" json:"persistence_requests,omitempty"`
+	PersistenceLatencyAvg    *time.Duration `protobuf:"bytes,10,opt,name=persistence_latency_avg,json=persistenceLatencyAvg,proto3,stdduration" json:"persistence_latency_avg,omitempty"`
+	PersistenceLatencyMax    *time.Duration `protobuf:"bytes,11,opt,name=persistence_latency_max,json=persistenceLatencyMax,proto3,stdduration" json:"persistence_latency_max,omitempty"`
+	// Workflows which received the most requests during the sampling window, most requested first.
+	TopWorkflows []*WorkflowLoad `protobuf:"bytes,12,rep,name=top_workflows,json=topWorkflows,proto3" json:"top_workflows,omitempty"`
+}
+
+func (m *ShardLoad) Reset()      { *m = ShardLoad{} }
+func (*ShardLoad) ProtoMessage() {}
+func (*ShardLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{4}
+}
+func (m *ShardLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardLoad.Merge(m, src)
+}
+func (m *ShardLoad) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardLoad.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardLoad proto.InternalMessageInfo
+
+func (m *ShardLoad) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ShardLoad) GetHostAddress() string {
+	if m != nil {
+		return m.HostAddress
+	}
+	return ""
+}
+
+func (m *ShardLoad) GetWindow() *time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *ShardLoad) GetRequestsPerSecond() float64 {
+	if m != nil {
+		return m.RequestsPerSecond
+	}
+	return 0
+}
+
+func (m *ShardLoad) GetApiRequestsPerSecond() map[string]float64 {
+	if m != nil {
+		return m.ApiRequestsPerSecond
+	}
+	return nil
+}
+
+func (m *ShardLoad) GetTaskIdLag() map[string]int64 {
+	if m != nil {
+		return m.TaskIdLag
+	}
+	return nil
+}
+
+func (m *ShardLoad) GetTimerAckLag() *time.Duration {
+	if m != nil {
+		return m.TimerAckLag
+	}
+	return nil
+}
+
+func (m *ShardLoad) GetMutableStateCacheHitRate() float64 {
+	if m != nil {
+		return m.MutableStateCacheHitRate
+	}
+	return 0
+}
+
+func (m *ShardLoad) GetPersistenceRequests() int64 {
+	if m != nil {
+		return m.PersistenceRequests
+	}
+	return 0
+}
+
+func (m *ShardLoad) GetPersistenceLatencyAvg() *time.Duration {
+	if m != nil {
+		return m.PersistenceLatencyAvg
+	}
+	return nil
+}
+
+func (m *ShardLoad) GetPersistenceLatencyMax() *time.Duration {
+	if m != nil {
+		return m.PersistenceLatencyMax
+	}
+	return nil
+}
+
+func (m *ShardLoad) GetTopWorkflows() []*WorkflowLoad {
+	if m != nil {
+		return m.TopWorkflows
+	}
+	return nil
+}
+
+type WorkflowLoad struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId  string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Requests    int64  `protobuf:"varint,3,opt,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (m *WorkflowLoad) Reset()      { *m = WorkflowLoad{} }
+func (*WorkflowLoad) ProtoMessage() {}
+func (*WorkflowLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{5}
+}
+func (m *WorkflowLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowLoad.Merge(m, src)
+}
+func (m *WorkflowLoad) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowLoad.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowLoad proto.InternalMessageInfo
+
+func (m *WorkflowLoad) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *WorkflowLoad) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *WorkflowLoad) GetRequests() int64 {
+	if m != nil {
+		return m.Requests
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TransientWorkflowTaskInfo)(nil), "temporal.server.api.history.v1.TransientWorkflowTaskInfo")
 	proto.RegisterType((*VersionHistoryItem)(nil), "temporal.server.api.history.v1.VersionHistoryItem")
 	proto.RegisterType((*VersionHistory)(nil), "temporal.server.api.history.v1.VersionHistory")
 	proto.RegisterType((*VersionHistories)(nil), "temporal.server.api.history.v1.VersionHistories")
+	proto.RegisterType((*ShardLoad)(nil), "temporal.server.api.history.v1.ShardLoad")
+	proto.RegisterMapType((map[string]float64)(nil), "temporal.server.api.history.v1.ShardLoad.ApiRequestsPerSecondEntry")
+	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.history.v1.ShardLoad.TaskIdLagEntry")
+	proto.RegisterType((*WorkflowLoad)(nil), "temporal.server.api.history.v1.WorkflowLoad")
 }
 
 func init() {
@@ -270,34 +479,62 @@ func init() {
 }
 
 var fileDescriptor_670cd05c700ece14 = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6a, 0xd4, 0x40,
-	0x1c, 0xc6, 0x33, 0x5d, 0x6a, 0x75, 0x76, 0xad, 0x32, 0xa7, 0x6d, 0xc1, 0xa1, 0x06, 0x0a, 0x3d,
-	0x94, 0x09, 0x5d, 0x8f, 0x9e, 0x14, 0x84, 0x46, 0xc4, 0x43, 0x58, 0x14, 0xbc, 0x84, 0xd9, 0xcd,
-	0xbf, 0xcd, 0xb0, 0x9b, 0x99, 0x30, 0x33, 0x8d, 0x7a, 0x10, 0x7c, 0x04, 0xdf, 0xc1, 0x8b, 0x6f,
-	0xe0, 0x2b, 0x78, 0xdc, 0x63, 0x8f, 0x6e, 0xf6, 0xe2, 0xb1, 0x8f, 0x20, 0x99, 0xcc, 0xa6, 0xac,
-	0x96, 0x42, 0x6f, 0xf3, 0x4d, 0xbe, 0xff, 0xef, 0xfb, 0xc2, 0xfc, 0xf1, 0xb1, 0x85, 0xa2, 0x54,
-	0x9a, 0xcf, 0x23, 0x03, 0xba, 0x02, 0x1d, 0xf1, 0x52, 0x44, 0xb9, 0x30, 0x56, 0xe9, 0xcf, 0x51,
-	0x75, 0x12, 0x15, 0x60, 0x0c, 0x3f, 0x07, 0x56, 0x6a, 0x65, 0x15, 0xa1, 0x6b, 0x37, 0x6b, 0xdd,
-	0x8c, 0x97, 0x82, 0x79, 0x37, 0xab, 0x4e, 0xf6, 0x0f, 0x3b, 0xda, 0x6d, 0x98, 0xf0, 0x27, 0xc2,
-	0x7b, 0x63, 0xcd, 0xa5, 0x11, 0x20, 0xed, 0x7b, 0xa5, 0x67, 0x67, 0x73, 0xf5, 0x71, 0xcc, 0xcd,
-	0x2c, 0x96, 0x67, 0x8a, 0xbc, 0xc5, 0x8f, 0xcc, 0x34, 0x87, 0xec, 0x62, 0x0e, 0x59, 0x0a, 0x15,
-	0x48, 0x3b, 0x44, 0x07, 0xe8, 0xa8, 0x3f, 0x3a, 0x64, 0x5d, 0xfc, 0x66, 0x2e, 0x3b, 0x6d, 0x8f,
-	0xaf, 0x1a, 0x73, 0xb2, 0xdb, 0x4d, 0x3b, 0x4d, 0x5e, 0xe3, 0x87, 0xc6, 0x72, 0x6d, 0x3b, 0xda,
-	0xd6, 0x5d, 0x68, 0x03, 0x3f, 0xeb, 0x54, 0x18, 0x63, 0xf2, 0x0e, 0xb4, 0x11, 0x4a, 0x7a, 0x53,
-	0x6c, 0xa1, 0x20, 0x7b, 0xf8, 0xbe, 0x23, 0xa7, 0x22, 0x73, 0x55, 0x7b, 0xc9, 0x8e, 0xd3, 0x71,
-	0x46, 0x86, 0x78, 0xa7, 0x6a, 0x07, 0x5c, 0x6c, 0x2f, 0x59, 0xcb, 0xf0, 0x0b, 0xde, 0xdd, 0x44,
-	0x91, 0xa7, 0x78, 0x30, 0xd1, 0x5c, 0x4e, 0xf3, 0xd4, 0xaa, 0x19, 0x48, 0x87, 0x1a, 0x24, 0xfd,
-	0xf6, 0x6e, 0xdc, 0x5c, 0x91, 0x53, 0xbc, 0x2d, 0x2c, 0x14, 0x66, 0xb8, 0x75, 0xd0, 0x3b, 0xea,
-	0x8f, 0x46, 0xec, 0xf6, 0x07, 0x61, 0xff, 0x97, 0x4d, 0x5a, 0x40, 0xf8, 0x1d, 0xe1, 0xc7, 0x1b,
-	0x5f, 0x05, 0x18, 0xf2, 0x02, 0x3f, 0x99, 0x5e, 0x68, 0xdd, 0xfc, 0x8a, 0xaf, 0x99, 0x7a, 0x58,
-	0x2a, 0x64, 0x06, 0x9f, 0x5c, 0xa5, 0xed, 0x64, 0xdf, 0x9b, 0xfe, 0xa1, 0x37, 0x0e, 0xf2, 0x06,
-	0x3f, 0xc8, 0xd7, 0x3c, 0xdf, 0x92, 0xdd, 0xad, 0x65, 0x72, 0x0d, 0x78, 0x39, 0x59, 0x2c, 0x69,
-	0x70, 0xb9, 0xa4, 0xc1, 0xd5, 0x92, 0xa2, 0xaf, 0x35, 0x45, 0x3f, 0x6a, 0x8a, 0x7e, 0xd5, 0x14,
-	0x2d, 0x6a, 0x8a, 0x7e, 0xd7, 0x14, 0xfd, 0xa9, 0x69, 0x70, 0x55, 0x53, 0xf4, 0x6d, 0x45, 0x83,
-	0xc5, 0x8a, 0x06, 0x97, 0x2b, 0x1a, 0x7c, 0x38, 0x3e, 0x57, 0xd7, 0x91, 0x42, 0xdd, 0xbc, 0xda,
-	0xcf, 0xfd, 0x71, 0x72, 0xcf, 0x2d, 0xe5, 0xb3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xc0,
-	0xb3, 0x54, 0x0b, 0x03, 0x00, 0x00,
+	// 875 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xc6, 0x75, 0x1c, 0xcf, 0x3a, 0xa1, 0x4c, 0x83, 0xba, 0xb1, 0x60, 0x9b, 0x5a, 0xaa,
+	0x94, 0x43, 0xb4, 0x56, 0xc2, 0x81, 0x0a, 0x10, 0x92, 0x1b, 0x2a, 0x62, 0x14, 0x10, 0x4c, 0x22,
+	0x8a, 0xb8, 0x8c, 0xc6, 0x3b, 0x2f, 0xeb, 0x91, 0xed, 0x9d, 0x65, 0x66, 0xec, 0x24, 0x48, 0x48,
+	0x7c, 0x04, 0x8e, 0xdc, 0xb9, 0xf0, 0x0d, 0xf8, 0x0a, 0x1c, 0x38, 0xe4, 0xd8, 0x1b, 0xc4, 0xb9,
+	0x70, 0xec, 0x47, 0x40, 0x3b, 0xfb, 0xa7, 0x76, 0x49, 0x9b, 0x84, 0x93, 0xe7, 0xbd, 0xf9, 0xfd,
+	0x7e, 0xf3, 0xe6, 0x37, 0xef, 0xad, 0xd1, 0xb6, 0x81, 0x71, 0x22, 0x15, 0x1b, 0x75, 0x34, 0xa8,
+	0x29, 0xa8, 0x0e, 0x4b, 0x44, 0x67, 0x20, 0xb4, 0x91, 0xea, 0xac, 0x33, 0xdd, 0xe9, 0x8c, 0x41,
+	0x6b, 0x16, 0x41, 0x90, 0x28, 0x69, 0x24, 0xf6, 0x0b, 0x74, 0x90, 0xa1, 0x03, 0x96, 0x88, 0x20,
+	0x47, 0x07, 0xd3, 0x9d, 0x96, 0x1f, 0x49, 0x19, 0x8d, 0xa0, 0x63, 0xd1, 0xfd, 0xc9, 0x71, 0x87,
+	0x4f, 0x14, 0x33, 0x42, 0xc6, 0x19, 0xbf, 0xf5, 0x90, 0x43, 0x02, 0x31, 0x87, 0x38, 0x14, 0xa0,
+	0x3b, 0x91, 0x8c, 0xa4, 0xcd, 0xdb, 0x55, 0x0e, 0x79, 0x54, 0x16, 0xf4, 0xa6, 0x4a, 0xda, 0xbf,
+	0x3b, 0x68, 0xe3, 0x48, 0xb1, 0x58, 0x0b, 0x88, 0xcd, 0x33, 0xa9, 0x86, 0xc7, 0x23, 0x79, 0x72,
+	0xc4, 0xf4, 0xb0, 0x17, 0x1f, 0x4b, 0xfc, 0x25, 0x7a, 0x4b, 0x87, 0x03, 0xe0, 0x93, 0x11, 0x70,
+	0x0a, 0x53, 0x88, 0x8d, 0xe7, 0x6c, 0x3a, 0x5b, 0xee, 0xee, 0xa3, 0xa0, 0xbc, 0xc1, 0x62, 0xe9,
+	0xc1, 0x7e, 0xb6, 0x7c, 0x9a, 0x82, 0xc9, 0x5a, 0xc9, 0xb6, 0x31, 0xfe, 0x1c, 0xad, 0x6a, 0xc3,
+	0x94, 0x29, 0xd5, 0x96, 0x6e, 0xa3, 0xd6, 0xcc, 0xb9, 0x36, 0x6a, 0xf7, 0x10, 0xfe, 0x06, 0x94,
+	0x16, 0x32, 0xce, 0x41, 0x3d, 0x03, 0x63, 0xbc, 0x81, 0x56, 0xac, 0x32, 0x15, 0xdc, 0x96, 0x5a,
+	0x25, 0x75, 0x1b, 0xf7, 0x38, 0xf6, 0x50, 0x7d, 0x9a, 0x11, 0xec, 0xb1, 0x55, 0x52, 0x84, 0xed,
+	0x1f, 0xd1, 0xda, 0xa2, 0x14, 0x7e, 0x88, 0x9a, 0x7d, 0xc5, 0xe2, 0x70, 0x40, 0x8d, 0x1c, 0x42,
+	0x6c, 0xa5, 0x9a, 0xc4, 0xcd, 0x72, 0x47, 0x69, 0x0a, 0xef, 0xa3, 0x9a, 0x30, 0x30, 0xd6, 0xde,
+	0xd2, 0x66, 0x75, 0xcb, 0xdd, 0xdd, 0x0d, 0xde, 0xfc, 0xa6, 0xc1, 0x7f, 0x8b, 0x25, 0x99, 0x40,
+	0xfb, 0x57, 0x07, 0xdd, 0x5d, 0xd8, 0x15, 0xa0, 0x71, 0x17, 0xbd, 0x17, 0x4e, 0x94, 0x4a, 0xaf,
+	0x92, 0x97, 0x49, 0x73, 0x31, 0x2a, 0x62, 0x0e, 0xa7, 0xb6, 0xa4, 0x1a, 0x69, 0xe5, 0xa0, 0x57,
+	0xd4, 0x53, 0x04, 0x3e, 0x40, 0x8d, 0x41, 0xa1, 0x97, 0x57, 0x19, 0xdc, 0xae, 0x4a, 0xf2, 0x52,
+	0xa0, 0xfd, 0x67, 0x1d, 0x35, 0x0e, 0x07, 0x4c, 0xf1, 0x03, 0xc9, 0x78, 0xea, 0xb3, 0x4e, 0x83,
+	0xc2, 0xe7, 0x1a, 0xa9, 0xdb, 0xb8, 0xc7, 0x53, 0xef, 0x06, 0x52, 0x1b, 0xca, 0x38, 0x57, 0xa0,
+	0xb5, 0x35, 0xbb, 0x41, 0xdc, 0x34, 0xd7, 0xcd, 0x52, 0xf8, 0x03, 0xb4, 0x7c, 0x22, 0x62, 0x2e,
+	0x4f, 0xbc, 0xaa, 0x6d, 0x80, 0x8d, 0x20, 0x6b, 0xf8, 0xa0, 0x68, 0xf8, 0xe0, 0xd3, 0xbc, 0xe1,
+	0x9f, 0xdc, 0xf9, 0xe5, 0xaf, 0x07, 0x0e, 0xc9, 0xe1, 0x38, 0x40, 0xf7, 0x14, 0x7c, 0x3f, 0x01,
+	0x6d, 0x34, 0x4d, 0x40, 0x51, 0x0d, 0xa1, 0x8c, 0xb9, 0x77, 0x67, 0xd3, 0xd9, 0x72, 0xc8, 0xdb,
+	0xc5, 0xd6, 0x57, 0xa0, 0x0e, 0xed, 0x06, 0xfe, 0x01, 0xdd, 0x67, 0x89, 0xa0, 0x57, 0x71, 0x6a,
+	0xd6, 0x90, 0xbd, 0xeb, 0x0c, 0x29, 0xaf, 0x1c, 0x74, 0x13, 0x41, 0x5e, 0x3d, 0xe0, 0x69, 0x6c,
+	0xd4, 0x19, 0x59, 0x67, 0x57, 0x6c, 0xe1, 0x6f, 0x91, 0x6b, 0x98, 0x1e, 0x52, 0xc1, 0xe9, 0x88,
+	0x45, 0xde, 0xb2, 0x3d, 0xef, 0xf1, 0xcd, 0xcf, 0xb3, 0x53, 0xc8, 0x0f, 0x58, 0x94, 0x1d, 0xd2,
+	0x30, 0x45, 0x8c, 0xf7, 0xd0, 0xaa, 0x11, 0x63, 0x50, 0x94, 0x85, 0x43, 0xab, 0x5d, 0xbf, 0x99,
+	0x8b, 0xae, 0x65, 0x75, 0xc3, 0x61, 0x2a, 0xf2, 0x09, 0x7a, 0x77, 0x3c, 0x31, 0xac, 0x3f, 0x02,
+	0xaa, 0x0d, 0x33, 0x40, 0x43, 0x16, 0x0e, 0x80, 0x0e, 0x84, 0xa1, 0x8a, 0x19, 0xf0, 0x56, 0xac,
+	0xa7, 0x5e, 0x8e, 0x39, 0x4c, 0x21, 0x7b, 0x29, 0x62, 0x5f, 0x18, 0xc2, 0x0c, 0xe0, 0x1d, 0xb4,
+	0x9e, 0xa4, 0xcd, 0xa2, 0x0d, 0xc4, 0x21, 0x94, 0x16, 0x7b, 0x0d, 0x3b, 0x5b, 0xf7, 0xe6, 0xf6,
+	0x0a, 0x6b, 0xf0, 0x33, 0x74, 0x7f, 0x9e, 0x32, 0x62, 0xe9, 0xef, 0x19, 0x65, 0xd3, 0xc8, 0x43,
+	0x37, 0xbb, 0xc1, 0x3b, 0x73, 0xfc, 0x83, 0x8c, 0xde, 0x9d, 0x46, 0xaf, 0x13, 0x1e, 0xb3, 0x53,
+	0xcf, 0xfd, 0xdf, 0xc2, 0x5f, 0xb0, 0x53, 0xfc, 0x35, 0x5a, 0x35, 0x32, 0xa1, 0x27, 0xf9, 0x87,
+	0x51, 0x7b, 0x4d, 0xfb, 0x8a, 0xdb, 0xd7, 0xbd, 0x62, 0xf1, 0x25, 0x4d, 0x1f, 0x92, 0x34, 0x8d,
+	0x4c, 0x8a, 0x84, 0x6e, 0x7d, 0x86, 0x36, 0x5e, 0xdb, 0x49, 0xf8, 0x2e, 0xaa, 0x0e, 0xe1, 0xcc,
+	0x4e, 0x54, 0x83, 0xa4, 0x4b, 0xbc, 0x8e, 0x6a, 0x53, 0x36, 0x9a, 0x80, 0x1d, 0x23, 0x87, 0x64,
+	0xc1, 0x87, 0x4b, 0x8f, 0x9d, 0xd6, 0xc7, 0x68, 0x6d, 0xb1, 0x45, 0xae, 0x63, 0x57, 0xe7, 0xd8,
+	0xed, 0x18, 0x35, 0xe7, 0x8b, 0x4c, 0xa7, 0x36, 0x66, 0x63, 0xd0, 0x09, 0x0b, 0xa1, 0x18, 0xea,
+	0x06, 0x71, 0xcb, 0x5c, 0x8f, 0xe3, 0x07, 0xc8, 0x2d, 0x8c, 0x48, 0x11, 0xd9, 0x5c, 0xa3, 0x22,
+	0xd5, 0xe3, 0xb8, 0x85, 0x56, 0xca, 0x36, 0xa8, 0xda, 0x03, 0xcb, 0xf8, 0x49, 0xff, 0xfc, 0xc2,
+	0xaf, 0x3c, 0xbf, 0xf0, 0x2b, 0x2f, 0x2e, 0x7c, 0xe7, 0xa7, 0x99, 0xef, 0xfc, 0x36, 0xf3, 0x9d,
+	0x3f, 0x66, 0xbe, 0x73, 0x3e, 0xf3, 0x9d, 0xbf, 0x67, 0xbe, 0xf3, 0xcf, 0xcc, 0xaf, 0xbc, 0x98,
+	0xf9, 0xce, 0xcf, 0x97, 0x7e, 0xe5, 0xfc, 0xd2, 0xaf, 0x3c, 0xbf, 0xf4, 0x2b, 0xdf, 0x6d, 0x47,
+	0xf2, 0xa5, 0xd5, 0x42, 0x5e, 0xfd, 0xe7, 0xfa, 0x51, 0xbe, 0xec, 0x2f, 0xdb, 0xd7, 0x7d, 0xff,
+	0xdf, 0x01, 0x00, 0x36, 0x8e, 0xa0, 0xac, 0x8d, 0x07, 0x00, 0x00,
 }
 
 func (this *TransientWorkflowTaskInfo) Equal(that interface{}) bool {
@@ -418,6 +655,132 @@ func (this *VersionHistories) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ShardLoad) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShardLoad)
+	if !ok {
+		that2, ok := that.(ShardLoad)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.HostAddress != that1.HostAddress {
+		return false
+	}
+	if this.Window != nil && that1.Window != nil {
+		if *this.Window != *that1.Window {
+			return false
+		}
+	} else if this.Window != nil {
+		return false
+	} else if that1.Window != nil {
+		return false
+	}
+	if this.RequestsPerSecond != that1.RequestsPerSecond {
+		return false
+	}
+	if len(this.ApiRequestsPerSecond) != len(that1.ApiRequestsPerSecond) {
+		return false
+	}
+	for i := range this.ApiRequestsPerSecond {
+		if this.ApiRequestsPerSecond[i] != that1.ApiRequestsPerSecond[i] {
+			return false
+		}
+	}
+	if len(this.TaskIdLag) != len(that1.TaskIdLag) {
+		return false
+	}
+	for i := range this.TaskIdLag {
+		if this.TaskIdLag[i] != that1.TaskIdLag[i] {
+			return false
+		}
+	}
+	if this.TimerAckLag != nil && that1.TimerAckLag != nil {
+		if *this.TimerAckLag != *that1.TimerAckLag {
+			return false
+		}
+	} else if this.TimerAckLag != nil {
+		return false
+	} else if that1.TimerAckLag != nil {
+		return false
+	}
+	if this.MutableStateCacheHitRate != that1.MutableStateCacheHitRate {
+		return false
+	}
+	if this.PersistenceRequests != that1.PersistenceRequests {
+		return false
+	}
+	if this.PersistenceLatencyAvg != nil && that1.PersistenceLatencyAvg != nil {
+		if *this.PersistenceLatencyAvg != *that1.PersistenceLatencyAvg {
+			return false
+		}
+	} else if this.PersistenceLatencyAvg != nil {
+		return false
+	} else if that1.PersistenceLatencyAvg != nil {
+		return false
+	}
+	if this.PersistenceLatencyMax != nil && that1.PersistenceLatencyMax != nil {
+		if *this.PersistenceLatencyMax != *that1.PersistenceLatencyMax {
+			return false
+		}
+	} else if this.PersistenceLatencyMax != nil {
+		return false
+	} else if that1.PersistenceLatencyMax != nil {
+		return false
+	}
+	if len(this.TopWorkflows) != len(that1.TopWorkflows) {
+		return false
+	}
+	for i := range this.TopWorkflows {
+		if !this.TopWorkflows[i].Equal(that1.TopWorkflows[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *WorkflowLoad) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WorkflowLoad)
+	if !ok {
+		that2, ok := that.(WorkflowLoad)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.Requests != that1.Requests {
+		return false
+	}
+	return true
+}
 func (this *TransientWorkflowTaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -470,6 +833,65 @@ func (this *VersionHistories) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ShardLoad) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&history.ShardLoad{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "Window: "+fmt.Sprintf("%#v", this.Window)+",\n")
+	s = append(s, "RequestsPerSecond: "+fmt.Sprintf("%#v", this.RequestsPerSecond)+",\n")
+	keysForApiRequestsPerSecond := make([]string, 0, len(this.ApiRequestsPerSecond))
+	for k, _ := range this.ApiRequestsPerSecond {
+		keysForApiRequestsPerSecond = append(keysForApiRequestsPerSecond, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForApiRequestsPerSecond)
+	mapStringForApiRequestsPerSecond := "map[string]float64{"
+	for _, k := range keysForApiRequestsPerSecond {
+		mapStringForApiRequestsPerSecond += fmt.Sprintf("%#v: %#v,", k, this.ApiRequestsPerSecond[k])
+	}
+	mapStringForApiRequestsPerSecond += "}"
+	if this.ApiRequestsPerSecond != nil {
+		s = append(s, "ApiRequestsPerSecond: "+mapStringForApiRequestsPerSecond+",\n")
+	}
+	keysForTaskIdLag := make([]string, 0, len(this.TaskIdLag))
+	for k, _ := range this.TaskIdLag {
+		keysForTaskIdLag = append(keysForTaskIdLag, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTaskIdLag)
+	mapStringForTaskIdLag := "map[string]int64{"
+	for _, k := range keysForTaskIdLag {
+		mapStringForTaskIdLag += fmt.Sprintf("%#v: %#v,", k, this.TaskIdLag[k])
+	}
+	mapStringForTaskIdLag += "}"
+	if this.TaskIdLag != nil {
+		s = append(s, "TaskIdLag: "+mapStringForTaskIdLag+",\n")
+	}
+	s = append(s, "TimerAckLag: "+fmt.Sprintf("%#v", this.TimerAckLag)+",\n")
+	s = append(s, "MutableStateCacheHitRate: "+fmt.Sprintf("%#v", this.MutableStateCacheHitRate)+",\n")
+	s = append(s, "PersistenceRequests: "+fmt.Sprintf("%#v", this.PersistenceRequests)+",\n")
+	s = append(s, "PersistenceLatencyAvg: "+fmt.Sprintf("%#v", this.PersistenceLatencyAvg)+",\n")
+	s = append(s, "PersistenceLatencyMax: "+fmt.Sprintf("%#v", this.PersistenceLatencyMax)+",\n")
+	if this.TopWorkflows != nil {
+		s = append(s, "TopWorkflows: "+fmt.Sprintf("%#v", this.TopWorkflows)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WorkflowLoad) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&history.WorkflowLoad{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "Requests: "+fmt.Sprintf("%#v", this.Requests)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -644,43 +1066,226 @@ func (m *VersionHistories) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ShardLoad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TransientWorkflowTaskInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScheduledEvent != nil {
-		l = m.ScheduledEvent.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.StartedEvent != nil {
-		l = m.StartedEvent.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	return n
+
+func (m *ShardLoad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VersionHistoryItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ShardLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EventId != 0 {
-		n += 1 + sovMessage(uint64(m.EventId))
-	}
+	if len(m.TopWorkflows) > 0 {
+		for iNdEx := len(m.TopWorkflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopWorkflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.PersistenceLatencyMax != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.PersistenceLatencyMax, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.PersistenceLatencyMax):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMessage(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.PersistenceLatencyAvg != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.PersistenceLatencyAvg, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.PersistenceLatencyAvg):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintMessage(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PersistenceRequests != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PersistenceRequests))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MutableStateCacheHitRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MutableStateCacheHitRate))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.TimerAckLag != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TimerAckLag, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TimerAckLag):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMessage(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TaskIdLag) > 0 {
+		for k := range m.TaskIdLag {
+			v := m.TaskIdLag[k]
+			baseI := i
+			i = encodeVarintMessage(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ApiRequestsPerSecond) > 0 {
+		for k := range m.ApiRequestsPerSecond {
+			v := m.ApiRequestsPerSecond[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.RequestsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RequestsPerSecond))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Window != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Window):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintMessage(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowLoad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowLoad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Requests != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Requests))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransientWorkflowTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledEvent != nil {
+		l = m.ScheduledEvent.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartedEvent != nil {
+		l = m.StartedEvent.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *VersionHistoryItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventId != 0 {
+		n += 1 + sovMessage(uint64(m.EventId))
+	}
 	if m.Version != 0 {
 		n += 1 + sovMessage(uint64(m.Version))
 	}
@@ -724,6 +1329,89 @@ func (m *VersionHistories) Size() (n int) {
 	return n
 }
 
+func (m *ShardLoad) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovMessage(uint64(m.ShardId))
+	}
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Window != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Window)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.RequestsPerSecond != 0 {
+		n += 9
+	}
+	if len(m.ApiRequestsPerSecond) > 0 {
+		for k, v := range m.ApiRequestsPerSecond {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	if len(m.TaskIdLag) > 0 {
+		for k, v := range m.TaskIdLag {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + sovMessage(uint64(v))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	if m.TimerAckLag != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TimerAckLag)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.MutableStateCacheHitRate != 0 {
+		n += 9
+	}
+	if m.PersistenceRequests != 0 {
+		n += 1 + sovMessage(uint64(m.PersistenceRequests))
+	}
+	if m.PersistenceLatencyAvg != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.PersistenceLatencyAvg)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.PersistenceLatencyMax != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.PersistenceLatencyMax)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.TopWorkflows) > 0 {
+		for _, e := range m.TopWorkflows {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowLoad) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Requests != 0 {
+		n += 1 + sovMessage(uint64(m.Requests))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -784,6 +1472,64 @@ func (this *VersionHistories) String() string {
 	}, "")
 	return s
 }
+func (this *ShardLoad) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTopWorkflows := "[]*WorkflowLoad{"
+	for _, f := range this.TopWorkflows {
+		repeatedStringForTopWorkflows += strings.Replace(f.String(), "WorkflowLoad", "WorkflowLoad", 1) + ","
+	}
+	repeatedStringForTopWorkflows += "}"
+	keysForApiRequestsPerSecond := make([]string, 0, len(this.ApiRequestsPerSecond))
+	for k, _ := range this.ApiRequestsPerSecond {
+		keysForApiRequestsPerSecond = append(keysForApiRequestsPerSecond, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForApiRequestsPerSecond)
+	mapStringForApiRequestsPerSecond := "map[string]float64{"
+	for _, k := range keysForApiRequestsPerSecond {
+		mapStringForApiRequestsPerSecond += fmt.Sprintf("%v: %v,", k, this.ApiRequestsPerSecond[k])
+	}
+	mapStringForApiRequestsPerSecond += "}"
+	keysForTaskIdLag := make([]string, 0, len(this.TaskIdLag))
+	for k, _ := range this.TaskIdLag {
+		keysForTaskIdLag = append(keysForTaskIdLag, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTaskIdLag)
+	mapStringForTaskIdLag := "map[string]int64{"
+	for _, k := range keysForTaskIdLag {
+		mapStringForTaskIdLag += fmt.Sprintf("%v: %v,", k, this.TaskIdLag[k])
+	}
+	mapStringForTaskIdLag += "}"
+	s := strings.Join([]string{`&ShardLoad{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`Window:` + strings.Replace(fmt.Sprintf("%v", this.Window), "Duration", "types.Duration", 1) + `,`,
+		`RequestsPerSecond:` + fmt.Sprintf("%v", this.RequestsPerSecond) + `,`,
+		`ApiRequestsPerSecond:` + mapStringForApiRequestsPerSecond + `,`,
+		`TaskIdLag:` + mapStringForTaskIdLag + `,`,
+		`TimerAckLag:` + strings.Replace(fmt.Sprintf("%v", this.TimerAckLag), "Duration", "types.Duration", 1) + `,`,
+		`MutableStateCacheHitRate:` + fmt.Sprintf("%v", this.MutableStateCacheHitRate) + `,`,
+		`PersistenceRequests:` + fmt.Sprintf("%v", this.PersistenceRequests) + `,`,
+		`PersistenceLatencyAvg:` + strings.Replace(fmt.Sprintf("%v", this.PersistenceLatencyAvg), "Duration", "types.Duration", 1) + `,`,
+		`PersistenceLatencyMax:` + strings.Replace(fmt.Sprintf("%v", this.PersistenceLatencyMax), "Duration", "types.Duration", 1) + `,`,
+		`TopWorkflows:` + repeatedStringForTopWorkflows + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowLoad) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowLoad{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`Requests:` + fmt.Sprintf("%v", this.Requests) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1235,6 +1981,690 @@ func (m *VersionHistories) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ShardLoad) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardLoad: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardLoad: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Window == nil {
+				m.Window = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RequestsPerSecond = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiRequestsPerSecond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApiRequestsPerSecond == nil {
+				m.ApiRequestsPerSecond = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ApiRequestsPerSecond[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskIdLag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskIdLag == nil {
+				m.TaskIdLag = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TaskIdLag[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerAckLag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimerAckLag == nil {
+				m.TimerAckLag = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TimerAckLag, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutableStateCacheHitRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MutableStateCacheHitRate = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceRequests", wireType)
+			}
+			m.PersistenceRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PersistenceRequests |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceLatencyAvg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PersistenceLatencyAvg == nil {
+				m.PersistenceLatencyAvg = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.PersistenceLatencyAvg, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceLatencyMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PersistenceLatencyMax == nil {
+				m.PersistenceLatencyMax = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.PersistenceLatencyMax, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopWorkflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopWorkflows = append(m.TopWorkflows, &WorkflowLoad{})
+			if err := m.TopWorkflows[len(m.TopWorkflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowLoad) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowLoad: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowLoad: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			m.Requests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Requests |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
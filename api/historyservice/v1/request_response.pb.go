@@ -81,6 +81,8 @@ type StartWorkflowExecutionRequest struct {
 	ContinuedFailure                *v13.Failure                      `protobuf:"bytes,7,opt,name=continued_failure,json=continuedFailure,proto3" json:"continued_failure,omitempty"`
 	LastCompletionResult            *v14.Payloads                     `protobuf:"bytes,8,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
 	FirstWorkflowTaskBackoff        *time.Duration                    `protobuf:"bytes,9,opt,name=first_workflow_task_backoff,json=firstWorkflowTaskBackoff,proto3,stdduration" json:"first_workflow_task_backoff,omitempty"`
+	// Priority of the workflow execution from 1 (highest) to 5 (lowest), 0 means default priority.
+	Priority int32 `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *StartWorkflowExecutionRequest) Reset()      { *m = StartWorkflowExecutionRequest{} }
@@ -178,6 +180,13 @@ func (m *StartWorkflowExecutionRequest) GetFirstWorkflowTaskBackoff() *time.Dura
 	return nil
 }
 
+func (m *StartWorkflowExecutionRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
@@ -829,6 +838,10 @@ type RecordWorkflowTaskStartedResponse struct {
 	ScheduledTime              *time.Time                     `protobuf:"bytes,12,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
 	StartedTime                *time.Time                     `protobuf:"bytes,13,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	Queries                    map[string]*v18.WorkflowQuery  `protobuf:"bytes,14,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Size of the workflow history in bytes when the workflow task was started.
+	HistorySizeBytes int64 `protobuf:"varint,15,opt,name=history_size_bytes,json=historySizeBytes,proto3" json:"history_size_bytes,omitempty"`
+	// Set if history size or event count crossed the soft limit and the workflow should continue-as-new.
+	SuggestContinueAsNew bool `protobuf:"varint,16,opt,name=suggest_continue_as_new,json=suggestContinueAsNew,proto3" json:"suggest_continue_as_new,omitempty"`
 }
 
 func (m *RecordWorkflowTaskStartedResponse) Reset()      { *m = RecordWorkflowTaskStartedResponse{} }
@@ -954,6 +967,20 @@ func (m *RecordWorkflowTaskStartedResponse) GetQueries() map[string]*v18.Workflo
 	return nil
 }
 
+func (m *RecordWorkflowTaskStartedResponse) GetHistorySizeBytes() int64 {
+	if m != nil {
+		return m.HistorySizeBytes
+	}
+	return 0
+}
+
+func (m *RecordWorkflowTaskStartedResponse) GetSuggestContinueAsNew() bool {
+	if m != nil {
+		return m.SuggestContinueAsNew
+	}
+	return false
+}
+
 type RecordActivityTaskStartedRequest struct {
 	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -1768,6 +1795,8 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	// (-- api-linter: core::0140::prepositions=disabled
 	//     aip.dev/not-precedent: "with" is needed here. --)
 	SignalWithStartRequest *v1.SignalWithStartWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=signal_with_start_request,json=signalWithStartRequest,proto3" json:"signal_with_start_request,omitempty"`
+	// Priority of the workflow execution if it is started, 0 means default priority.
+	Priority int32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *SignalWithStartWorkflowExecutionRequest) Reset() {
@@ -1818,6 +1847,13 @@ func (m *SignalWithStartWorkflowExecutionRequest) GetSignalWithStartRequest() *v
 	return nil
 }
 
+func (m *SignalWithStartWorkflowExecutionRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 type SignalWithStartWorkflowExecutionResponse struct {
 	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
@@ -2148,6 +2184,8 @@ var xxx_messageInfo_DeleteWorkflowExecutionResponse proto.InternalMessageInfo
 type ResetWorkflowExecutionRequest struct {
 	NamespaceId  string                            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	ResetRequest *v1.ResetWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=reset_request,json=resetRequest,proto3" json:"reset_request,omitempty"`
+	// Overrides reset_request.reset_reapply_type if set.
+	ResetReapplyType v16.ResetReapplyType `protobuf:"varint,3,opt,name=reset_reapply_type,json=resetReapplyType,proto3,enum=temporal.server.api.enums.v1.ResetReapplyType" json:"reset_reapply_type,omitempty"`
 }
 
 func (m *ResetWorkflowExecutionRequest) Reset()      { *m = ResetWorkflowExecutionRequest{} }
@@ -2196,6 +2234,13 @@ func (m *ResetWorkflowExecutionRequest) GetResetRequest() *v1.ResetWorkflowExecu
 	return nil
 }
 
+func (m *ResetWorkflowExecutionRequest) GetResetReapplyType() v16.ResetReapplyType {
+	if m != nil {
+		return m.ResetReapplyType
+	}
+	return v16.RESET_REAPPLY_TYPE_UNSPECIFIED
+}
+
 type ResetWorkflowExecutionResponse struct {
 	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
@@ -3589,6 +3634,9 @@ var xxx_messageInfo_RemoveTaskResponse proto.InternalMessageInfo
 type GetReplicationMessagesRequest struct {
 	Tokens      []*v113.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	ClusterName string                   `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// Number of history shards of the polling cluster, tokens are keyed by its shard ids.
+	// Same as the number of shards of this cluster if not set.
+	ClusterShardCount int32 `protobuf:"varint,3,opt,name=cluster_shard_count,json=clusterShardCount,proto3" json:"cluster_shard_count,omitempty"`
 }
 
 func (m *GetReplicationMessagesRequest) Reset()      { *m = GetReplicationMessagesRequest{} }
@@ -3637,6 +3685,13 @@ func (m *GetReplicationMessagesRequest) GetClusterName() string {
 	return ""
 }
 
+func (m *GetReplicationMessagesRequest) GetClusterShardCount() int32 {
+	if m != nil {
+		return m.ClusterShardCount
+	}
+	return 0
+}
+
 type GetReplicationMessagesResponse struct {
 	ShardMessages map[int32]*v113.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
@@ -4753,6 +4808,360 @@ func (m *ShardReplicationStatusPerCluster) GetAckedTaskVisibilityTime() *time.Ti
 	return nil
 }
 
+type UpdateWorkflowExecutionRequest struct {
+	NamespaceId string                               `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v114.UpdateWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *UpdateWorkflowExecutionRequest) Reset()      { *m = UpdateWorkflowExecutionRequest{} }
+func (*UpdateWorkflowExecutionRequest) ProtoMessage() {}
+func (*UpdateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{83}
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *UpdateWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *UpdateWorkflowExecutionRequest) GetRequest() *v114.UpdateWorkflowExecutionRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+type UpdateWorkflowExecutionResponse struct {
+	Response *v114.UpdateWorkflowExecutionResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (m *UpdateWorkflowExecutionResponse) Reset()      { *m = UpdateWorkflowExecutionResponse{} }
+func (*UpdateWorkflowExecutionResponse) ProtoMessage() {}
+func (*UpdateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{84}
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkflowExecutionResponse proto.InternalMessageInfo
+
+func (m *UpdateWorkflowExecutionResponse) GetResponse() *v114.UpdateWorkflowExecutionResponse {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+type RefreshDynamicConfigRequest struct {
+	HostAddress string `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+}
+
+func (m *RefreshDynamicConfigRequest) Reset()      { *m = RefreshDynamicConfigRequest{} }
+func (*RefreshDynamicConfigRequest) ProtoMessage() {}
+func (*RefreshDynamicConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{85}
+}
+func (m *RefreshDynamicConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshDynamicConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshDynamicConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefreshDynamicConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshDynamicConfigRequest.Merge(m, src)
+}
+func (m *RefreshDynamicConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshDynamicConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshDynamicConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshDynamicConfigRequest proto.InternalMessageInfo
+
+func (m *RefreshDynamicConfigRequest) GetHostAddress() string {
+	if m != nil {
+		return m.HostAddress
+	}
+	return ""
+}
+
+type RefreshDynamicConfigResponse struct {
+}
+
+func (m *RefreshDynamicConfigResponse) Reset()      { *m = RefreshDynamicConfigResponse{} }
+func (*RefreshDynamicConfigResponse) ProtoMessage() {}
+func (*RefreshDynamicConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{86}
+}
+func (m *RefreshDynamicConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshDynamicConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshDynamicConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefreshDynamicConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshDynamicConfigResponse.Merge(m, src)
+}
+func (m *RefreshDynamicConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshDynamicConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshDynamicConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshDynamicConfigResponse proto.InternalMessageInfo
+
+type GetOpenExecutionCountsRequest struct {
+	HostAddress string `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+}
+
+func (m *GetOpenExecutionCountsRequest) Reset()      { *m = GetOpenExecutionCountsRequest{} }
+func (*GetOpenExecutionCountsRequest) ProtoMessage() {}
+func (*GetOpenExecutionCountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{87}
+}
+func (m *GetOpenExecutionCountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOpenExecutionCountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOpenExecutionCountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetOpenExecutionCountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOpenExecutionCountsRequest.Merge(m, src)
+}
+func (m *GetOpenExecutionCountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOpenExecutionCountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOpenExecutionCountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOpenExecutionCountsRequest proto.InternalMessageInfo
+
+func (m *GetOpenExecutionCountsRequest) GetHostAddress() string {
+	if m != nil {
+		return m.HostAddress
+	}
+	return ""
+}
+
+type GetOpenExecutionCountsResponse struct {
+	// Keyed by namespace id.
+	NamespaceCounts map[string]*v111.OpenExecutionCounts `protobuf:"bytes,1,rep,name=namespace_counts,json=namespaceCounts,proto3" json:"namespace_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *GetOpenExecutionCountsResponse) Reset()      { *m = GetOpenExecutionCountsResponse{} }
+func (*GetOpenExecutionCountsResponse) ProtoMessage() {}
+func (*GetOpenExecutionCountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{88}
+}
+func (m *GetOpenExecutionCountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOpenExecutionCountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOpenExecutionCountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetOpenExecutionCountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOpenExecutionCountsResponse.Merge(m, src)
+}
+func (m *GetOpenExecutionCountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOpenExecutionCountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOpenExecutionCountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOpenExecutionCountsResponse proto.InternalMessageInfo
+
+func (m *GetOpenExecutionCountsResponse) GetNamespaceCounts() map[string]*v111.OpenExecutionCounts {
+	if m != nil {
+		return m.NamespaceCounts
+	}
+	return nil
+}
+
+type GetShardLoadRequest struct {
+	HostAddress string `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	// Maximum number of most requested workflows to return for each shard.
+	MaxWorkflowsPerShard int32 `protobuf:"varint,2,opt,name=max_workflows_per_shard,json=maxWorkflowsPerShard,proto3" json:"max_workflows_per_shard,omitempty"`
+}
+
+func (m *GetShardLoadRequest) Reset()      { *m = GetShardLoadRequest{} }
+func (*GetShardLoadRequest) ProtoMessage() {}
+func (*GetShardLoadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{89}
+}
+func (m *GetShardLoadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetShardLoadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetShardLoadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetShardLoadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardLoadRequest.Merge(m, src)
+}
+func (m *GetShardLoadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetShardLoadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardLoadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardLoadRequest proto.InternalMessageInfo
+
+func (m *GetShardLoadRequest) GetHostAddress() string {
+	if m != nil {
+		return m.HostAddress
+	}
+	return ""
+}
+
+func (m *GetShardLoadRequest) GetMaxWorkflowsPerShard() int32 {
+	if m != nil {
+		return m.MaxWorkflowsPerShard
+	}
+	return 0
+}
+
+type GetShardLoadResponse struct {
+	Shards []*v17.ShardLoad `protobuf:"bytes,1,rep,name=shards,proto3" json:"shards,omitempty"`
+}
+
+func (m *GetShardLoadResponse) Reset()      { *m = GetShardLoadResponse{} }
+func (*GetShardLoadResponse) ProtoMessage() {}
+func (*GetShardLoadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{90}
+}
+func (m *GetShardLoadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetShardLoadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetShardLoadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetShardLoadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardLoadResponse.Merge(m, src)
+}
+func (m *GetShardLoadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetShardLoadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardLoadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardLoadResponse proto.InternalMessageInfo
+
+func (m *GetShardLoadResponse) GetShards() []*v17.ShardLoad {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionRequest")
 	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionResponse")
@@ -4841,6 +5250,15 @@ func init() {
 	proto.RegisterMapType((map[string]*ShardReplicationStatusPerCluster)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatus.RemoteClustersEntry")
 	proto.RegisterType((*HandoverNamespaceInfo)(nil), "temporal.server.api.historyservice.v1.HandoverNamespaceInfo")
 	proto.RegisterType((*ShardReplicationStatusPerCluster)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatusPerCluster")
+	proto.RegisterType((*UpdateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.UpdateWorkflowExecutionRequest")
+	proto.RegisterType((*UpdateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.UpdateWorkflowExecutionResponse")
+	proto.RegisterType((*RefreshDynamicConfigRequest)(nil), "temporal.server.api.historyservice.v1.RefreshDynamicConfigRequest")
+	proto.RegisterType((*RefreshDynamicConfigResponse)(nil), "temporal.server.api.historyservice.v1.RefreshDynamicConfigResponse")
+	proto.RegisterType((*GetOpenExecutionCountsRequest)(nil), "temporal.server.api.historyservice.v1.GetOpenExecutionCountsRequest")
+	proto.RegisterType((*GetOpenExecutionCountsResponse)(nil), "temporal.server.api.historyservice.v1.GetOpenExecutionCountsResponse")
+	proto.RegisterMapType((map[string]*v111.OpenExecutionCounts)(nil), "temporal.server.api.historyservice.v1.GetOpenExecutionCountsResponse.NamespaceCountsEntry")
+	proto.RegisterType((*GetShardLoadRequest)(nil), "temporal.server.api.historyservice.v1.GetShardLoadRequest")
+	proto.RegisterType((*GetShardLoadResponse)(nil), "temporal.server.api.historyservice.v1.GetShardLoadResponse")
 }
 
 func init() {
@@ -4848,262 +5266,280 @@ func init() {
 }
 
 var fileDescriptor_b8c78c1d460a3711 = []byte{
-	// 4074 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x49, 0x6c, 0x1c, 0x57,
-	0x7a, 0x56, 0xb1, 0xd9, 0x64, 0xf3, 0xef, 0x66, 0x2f, 0xc5, 0xad, 0x49, 0x4a, 0x2d, 0xb2, 0x6c,
-	0x59, 0xf4, 0xa2, 0xa6, 0x25, 0xcd, 0xd8, 0x1e, 0x65, 0x3c, 0x8e, 0x44, 0x49, 0x54, 0x0b, 0x92,
-	0x86, 0x2e, 0x72, 0x64, 0xc3, 0x33, 0x9e, 0x72, 0xb1, 0xeb, 0x91, 0x5d, 0x61, 0x77, 0x55, 0xbb,
-	0x5e, 0x75, 0x93, 0xed, 0x1c, 0xb2, 0x0c, 0x12, 0x60, 0x26, 0x40, 0x62, 0x20, 0x97, 0x01, 0x32,
-	0xb9, 0x04, 0x08, 0x12, 0x04, 0x08, 0x72, 0xc8, 0x69, 0x0e, 0xb9, 0x06, 0x39, 0x25, 0x46, 0x80,
-	0x20, 0x83, 0xc9, 0x21, 0xb1, 0x8c, 0x00, 0x09, 0x92, 0xc3, 0x1c, 0x72, 0xc8, 0x31, 0x78, 0x5b,
-	0xed, 0xbd, 0x91, 0x52, 0x3c, 0xe3, 0xf1, 0x8d, 0xfd, 0xde, 0xff, 0xff, 0xef, 0xfd, 0xdb, 0xf7,
-	0xb6, 0xbf, 0x08, 0x5f, 0x77, 0x51, 0xab, 0x6d, 0x3b, 0x7a, 0x73, 0x13, 0x23, 0xa7, 0x8b, 0x9c,
-	0x4d, 0xbd, 0x6d, 0x6e, 0x36, 0x4c, 0xec, 0xda, 0x4e, 0x8f, 0xb4, 0x98, 0x75, 0xb4, 0xd9, 0xbd,
-	0xba, 0xe9, 0xa0, 0x0f, 0x3b, 0x08, 0xbb, 0x9a, 0x83, 0x70, 0xdb, 0xb6, 0x30, 0xaa, 0xb6, 0x1d,
-	0xdb, 0xb5, 0xe5, 0x4b, 0x82, 0xbb, 0xca, 0xb8, 0xab, 0x7a, 0xdb, 0xac, 0x86, 0xb9, 0xab, 0xdd,
-	0xab, 0x2b, 0x95, 0x43, 0xdb, 0x3e, 0x6c, 0xa2, 0x4d, 0xca, 0xb4, 0xdf, 0x39, 0xd8, 0x34, 0x3a,
-	0x8e, 0xee, 0x9a, 0xb6, 0xc5, 0xc4, 0xac, 0x5c, 0x8c, 0xf6, 0xbb, 0x66, 0x0b, 0x61, 0x57, 0x6f,
-	0xb5, 0x39, 0xc1, 0xba, 0x81, 0xda, 0xc8, 0x32, 0x90, 0x55, 0x37, 0x11, 0xde, 0x3c, 0xb4, 0x0f,
-	0x6d, 0xda, 0x4e, 0xff, 0xe2, 0x24, 0xcf, 0x7b, 0x8a, 0x10, 0x0d, 0xea, 0x76, 0xab, 0x65, 0x5b,
-	0x64, 0xe6, 0x2d, 0x84, 0xb1, 0x7e, 0xc8, 0x27, 0xbc, 0x72, 0x29, 0x44, 0xc5, 0x67, 0x1a, 0x27,
-	0xbb, 0x1c, 0x22, 0x73, 0x75, 0x7c, 0xf4, 0x61, 0x07, 0x75, 0x50, 0x9c, 0x30, 0x3c, 0x2a, 0xb2,
-	0x3a, 0x2d, 0x4c, 0x88, 0x8e, 0x6d, 0xe7, 0xe8, 0xa0, 0x69, 0x1f, 0x73, 0xaa, 0x17, 0x42, 0x54,
-	0xa2, 0x33, 0x2e, 0xed, 0xb9, 0x10, 0xdd, 0x87, 0x1d, 0x94, 0x34, 0xb7, 0xb0, 0x0a, 0x07, 0xba,
-	0xd9, 0xec, 0x38, 0x09, 0x33, 0x7b, 0x65, 0x80, 0x63, 0xe3, 0xd4, 0x2f, 0x26, 0x51, 0x7b, 0xea,
-	0x30, 0x6b, 0x72, 0xd2, 0x97, 0x07, 0x92, 0x46, 0x34, 0xbf, 0x3c, 0x90, 0x98, 0x18, 0x96, 0x13,
-	0x5e, 0x49, 0x22, 0xec, 0x6f, 0xa9, 0x6a, 0x12, 0xb9, 0xa5, 0xb7, 0x10, 0x6e, 0xeb, 0xf5, 0x04,
-	0x6b, 0xbc, 0x9a, 0x44, 0xef, 0xa0, 0x76, 0xd3, 0xac, 0xd3, 0x40, 0x8c, 0x73, 0x5c, 0x4f, 0xe2,
-	0x68, 0x23, 0x07, 0x9b, 0xd8, 0x45, 0x16, 0x1b, 0x03, 0x9d, 0xa0, 0x7a, 0x87, 0xb0, 0x63, 0xce,
-	0xf4, 0xd6, 0x08, 0x4c, 0x42, 0x29, 0xad, 0xd5, 0x71, 0xf5, 0xfd, 0x26, 0xd2, 0xb0, 0xab, 0xbb,
-	0x62, 0xd4, 0xd7, 0x12, 0x23, 0x65, 0x68, 0x22, 0xae, 0xdc, 0x48, 0x1a, 0x58, 0x37, 0x5a, 0xa6,
-	0x35, 0x94, 0x57, 0xf9, 0xbd, 0x29, 0xb8, 0xb0, 0xeb, 0xea, 0x8e, 0xfb, 0x0e, 0x1f, 0xee, 0x8e,
-	0x50, 0x4b, 0x65, 0x0c, 0xf2, 0x3a, 0xe4, 0x3c, 0xdb, 0x6a, 0xa6, 0x51, 0x96, 0xd6, 0xa4, 0x8d,
-	0x19, 0x35, 0xeb, 0xb5, 0xd5, 0x0c, 0xb9, 0x0e, 0xb3, 0x98, 0xc8, 0xd0, 0xf8, 0x20, 0xe5, 0x89,
-	0x35, 0x69, 0x23, 0x7b, 0xed, 0x1b, 0x9e, 0xa3, 0x28, 0x34, 0x44, 0x14, 0xaa, 0x76, 0xaf, 0x56,
-	0x07, 0x8e, 0xac, 0xe6, 0xa8, 0x50, 0x31, 0x8f, 0x06, 0x2c, 0xb4, 0x75, 0x07, 0x59, 0xae, 0xe6,
-	0x59, 0x5e, 0x33, 0xad, 0x03, 0xbb, 0x9c, 0xa2, 0x83, 0x7d, 0xa5, 0x9a, 0x04, 0x47, 0x5e, 0x44,
-	0x76, 0xaf, 0x56, 0x77, 0x28, 0xb7, 0x37, 0x4a, 0xcd, 0x3a, 0xb0, 0xd5, 0xb9, 0x76, 0xbc, 0x51,
-	0x2e, 0xc3, 0xb4, 0xee, 0x12, 0x69, 0x6e, 0x79, 0x72, 0x4d, 0xda, 0x48, 0xab, 0xe2, 0xa7, 0xdc,
-	0x02, 0xc5, 0xf3, 0xa0, 0x3f, 0x0b, 0x74, 0xd2, 0x36, 0x19, 0xa4, 0x69, 0x04, 0xbb, 0xca, 0x69,
-	0x3a, 0xa1, 0x95, 0x2a, 0x03, 0xb6, 0xaa, 0x00, 0xb6, 0xea, 0x9e, 0x00, 0xb6, 0x5b, 0x93, 0x1f,
-	0xff, 0xeb, 0x45, 0x49, 0xbd, 0x78, 0x1c, 0xd5, 0xfc, 0x8e, 0x27, 0x89, 0xd0, 0xca, 0x0d, 0x58,
-	0xae, 0xdb, 0x96, 0x6b, 0x5a, 0x1d, 0xa4, 0xe9, 0x58, 0xb3, 0xd0, 0xb1, 0x66, 0x5a, 0xa6, 0x6b,
-	0xea, 0xae, 0xed, 0x94, 0xa7, 0xd6, 0xa4, 0x8d, 0xfc, 0xb5, 0x2b, 0x61, 0x1b, 0xd3, 0xec, 0x22,
-	0xca, 0x6e, 0x71, 0xbe, 0x9b, 0xf8, 0x11, 0x3a, 0xae, 0x09, 0x26, 0x75, 0xb1, 0x9e, 0xd8, 0x2e,
-	0x3f, 0x84, 0x92, 0xe8, 0x31, 0x34, 0x0e, 0x2b, 0xe5, 0x69, 0xaa, 0xc7, 0x5a, 0x78, 0x04, 0xde,
-	0x49, 0xc6, 0xb8, 0xcb, 0xfe, 0x54, 0x8b, 0x1e, 0x2b, 0x6f, 0x91, 0x1f, 0xc3, 0x62, 0x53, 0xc7,
-	0xae, 0x56, 0xb7, 0x5b, 0xed, 0x26, 0xa2, 0x96, 0x71, 0x10, 0xee, 0x34, 0xdd, 0x72, 0x26, 0x49,
-	0x26, 0x87, 0x18, 0xea, 0xa3, 0x5e, 0xd3, 0xd6, 0x0d, 0xac, 0xce, 0x13, 0xfe, 0x2d, 0x8f, 0x5d,
-	0xa5, 0xdc, 0xf2, 0x77, 0x61, 0xf5, 0xc0, 0x74, 0xb0, 0xab, 0x79, 0x5e, 0x20, 0x28, 0xa2, 0xed,
-	0xeb, 0xf5, 0x23, 0xfb, 0xe0, 0xa0, 0x3c, 0x43, 0x85, 0x2f, 0xc7, 0x0c, 0x7f, 0x9b, 0xaf, 0x38,
-	0xb7, 0x26, 0x7f, 0x48, 0xec, 0x5e, 0xa6, 0x32, 0x44, 0xd8, 0xed, 0xe9, 0xf8, 0xe8, 0x16, 0x13,
-	0xa0, 0xbc, 0x0e, 0x95, 0x7e, 0x21, 0xc9, 0xb2, 0x46, 0x5e, 0x80, 0x29, 0xa7, 0x63, 0xf9, 0x79,
-	0x90, 0x76, 0x3a, 0x56, 0xcd, 0x50, 0xfe, 0x4b, 0x82, 0xc5, 0x6d, 0xe4, 0x3e, 0x64, 0x59, 0xbd,
-	0x4b, 0x92, 0x7a, 0x8c, 0xfc, 0xd9, 0x86, 0x19, 0x2f, 0x9a, 0x78, 0xee, 0xbc, 0xd8, 0xcf, 0x42,
-	0xf1, 0xa9, 0xf9, 0xbc, 0xf2, 0x75, 0x58, 0x44, 0x27, 0x6d, 0x54, 0x77, 0x91, 0xa1, 0x59, 0xe8,
-	0xc4, 0xd5, 0x50, 0x97, 0x24, 0x8c, 0x69, 0xd0, 0x24, 0x49, 0xa9, 0x73, 0xa2, 0xf7, 0x11, 0x3a,
-	0x71, 0xef, 0x90, 0xbe, 0x9a, 0x21, 0xbf, 0x0a, 0xf3, 0xf5, 0x8e, 0x43, 0x33, 0x6b, 0xdf, 0xd1,
-	0xad, 0x7a, 0x43, 0x73, 0xed, 0x23, 0x64, 0xd1, 0xd8, 0xcf, 0xa9, 0x32, 0xef, 0xbb, 0x45, 0xbb,
-	0xf6, 0x48, 0x8f, 0xf2, 0x17, 0x19, 0x58, 0x8a, 0x69, 0xcb, 0x0d, 0x14, 0xd2, 0x45, 0x3a, 0x83,
-	0x2e, 0x35, 0x98, 0xf5, 0xbd, 0xdc, 0x6b, 0x23, 0x6e, 0x98, 0xe7, 0x87, 0x09, 0xdb, 0xeb, 0xb5,
-	0x91, 0x9a, 0x3b, 0x0e, 0xfc, 0x92, 0x15, 0x98, 0x4d, 0xb2, 0x46, 0xd6, 0x0a, 0x58, 0xe1, 0x6b,
-	0xb0, 0xdc, 0x76, 0x50, 0xd7, 0xb4, 0x3b, 0x58, 0xa3, 0xb8, 0x83, 0x0c, 0x9f, 0x7e, 0x92, 0xd2,
-	0x2f, 0x0a, 0x82, 0x5d, 0xd6, 0x2f, 0x58, 0xaf, 0xc0, 0x1c, 0x8d, 0x76, 0x16, 0x9a, 0x1e, 0x53,
-	0x9a, 0x32, 0x15, 0x49, 0xd7, 0x5d, 0xd2, 0x23, 0xc8, 0xb7, 0x00, 0x68, 0xd4, 0xd2, 0x5d, 0x05,
-	0x4d, 0xe3, 0x98, 0x56, 0xde, 0xa6, 0x83, 0x28, 0x46, 0x02, 0xf4, 0x6d, 0xf2, 0x43, 0x9d, 0x71,
-	0xc5, 0x9f, 0xf2, 0x0e, 0x94, 0xb0, 0x6b, 0xd6, 0x8f, 0x7a, 0x5a, 0x40, 0xd6, 0xf4, 0x18, 0xb2,
-	0x0a, 0x8c, 0xdd, 0x6b, 0x90, 0x7f, 0x1d, 0x5e, 0x8e, 0x49, 0xd4, 0x70, 0xbd, 0x81, 0x8c, 0x4e,
-	0x13, 0x69, 0xae, 0xcd, 0xac, 0x42, 0x11, 0xce, 0xee, 0xb8, 0xe5, 0xec, 0x68, 0xb9, 0x76, 0x29,
-	0x32, 0xcc, 0x2e, 0x17, 0xb8, 0x67, 0x53, 0x23, 0xee, 0x31, 0x69, 0x7d, 0x63, 0x70, 0xb6, 0x5f,
-	0x0c, 0xca, 0xdf, 0x86, 0xbc, 0x17, 0x1e, 0x74, 0x11, 0x2d, 0x17, 0x28, 0x20, 0x26, 0xaf, 0x03,
-	0x1e, 0x2e, 0xc6, 0x42, 0x8e, 0x45, 0xaf, 0x17, 0x6a, 0xf4, 0xa7, 0xfc, 0x0e, 0x14, 0x42, 0xc2,
-	0x3b, 0xb8, 0x5c, 0xa4, 0xd2, 0xab, 0x7d, 0xe0, 0x36, 0x51, 0x6c, 0x07, 0xab, 0xf9, 0xa0, 0xdc,
-	0x0e, 0x96, 0xdf, 0x87, 0x52, 0x97, 0xec, 0x08, 0x6c, 0x4b, 0x63, 0xdb, 0x31, 0x13, 0xe1, 0x72,
-	0x89, 0x9a, 0xf2, 0xd5, 0xea, 0x80, 0xfd, 0x34, 0x19, 0xe3, 0x31, 0x63, 0xbc, 0x27, 0xf8, 0xd4,
-	0x62, 0x37, 0xd2, 0x22, 0x7f, 0x03, 0xce, 0x9b, 0x24, 0x7c, 0xa3, 0x6e, 0x44, 0x16, 0x49, 0x54,
-	0xa3, 0x2c, 0xaf, 0x49, 0x1b, 0x19, 0xb5, 0x6c, 0xe2, 0xdd, 0xb0, 0x57, 0xee, 0xb0, 0x7e, 0xf9,
-	0x2b, 0xb0, 0x14, 0x8b, 0x64, 0xf7, 0x84, 0xc2, 0xdd, 0x1c, 0x03, 0x90, 0x70, 0x34, 0xef, 0x9d,
-	0x58, 0x35, 0xe3, 0xfe, 0x64, 0x26, 0x53, 0x9c, 0xb9, 0x3f, 0x99, 0x99, 0x29, 0xc2, 0xfd, 0xc9,
-	0x0c, 0x14, 0xb3, 0xf7, 0x27, 0x33, 0xb9, 0xe2, 0xec, 0xfd, 0xc9, 0x4c, 0xbe, 0x58, 0x50, 0xfe,
-	0x5b, 0x82, 0xa5, 0x1d, 0xbb, 0xd9, 0xfc, 0x25, 0xc1, 0xc6, 0x7f, 0x9f, 0x86, 0x72, 0x5c, 0xdd,
-	0x2f, 0xc1, 0xf1, 0x4b, 0x70, 0x7c, 0xea, 0xe0, 0x98, 0xeb, 0x0b, 0x8e, 0x89, 0x30, 0x93, 0x7f,
-	0x6a, 0x30, 0xf3, 0x8b, 0x89, 0xbd, 0x03, 0xc0, 0xad, 0x34, 0x1e, 0xb8, 0xcd, 0x16, 0xf3, 0xca,
-	0xf7, 0x25, 0x58, 0x55, 0x11, 0x46, 0x6e, 0x04, 0x4a, 0x3f, 0x07, 0x68, 0x53, 0x2a, 0x70, 0x3e,
-	0x79, 0x2a, 0x0c, 0x76, 0x94, 0x9f, 0x4e, 0xc0, 0x9a, 0x8a, 0xea, 0xb6, 0x63, 0x04, 0x37, 0xbd,
-	0x3c, 0x51, 0xc7, 0x98, 0xf0, 0xbb, 0x20, 0xc7, 0x8f, 0x3f, 0xe3, 0xcf, 0xbc, 0x14, 0x3b, 0xf7,
-	0xc8, 0x17, 0x21, 0xeb, 0x65, 0x93, 0x07, 0x41, 0x20, 0x9a, 0x6a, 0x86, 0xbc, 0x04, 0xd3, 0x34,
-	0xf3, 0x3c, 0xbc, 0x99, 0x22, 0x3f, 0x6b, 0x86, 0x7c, 0x01, 0x40, 0x1c, 0x6d, 0x39, 0xac, 0xcc,
-	0xa8, 0x33, 0xbc, 0xa5, 0x66, 0xc8, 0x1f, 0x40, 0xae, 0x6d, 0x37, 0x9b, 0xde, 0xc9, 0x94, 0x21,
-	0xca, 0x9b, 0x43, 0x4f, 0xa6, 0x04, 0xc2, 0x83, 0xc6, 0x0a, 0xfa, 0x56, 0xcd, 0x12, 0x91, 0xfc,
-	0x87, 0xf2, 0x4f, 0xd3, 0xb0, 0x3e, 0xc0, 0xb8, 0x1c, 0xf9, 0x63, 0x80, 0x2d, 0x9d, 0x1a, 0xb0,
-	0x07, 0x82, 0xf1, 0xc4, 0x40, 0x30, 0x7e, 0x05, 0x64, 0x61, 0x53, 0x23, 0x0a, 0xf8, 0x45, 0xaf,
-	0x47, 0x50, 0x6f, 0x40, 0xb1, 0x0f, 0xd8, 0xe7, 0x71, 0x58, 0x6e, 0x6c, 0x0d, 0x49, 0xc7, 0xd7,
-	0x90, 0xc0, 0xa9, 0x7a, 0x2a, 0x7c, 0xaa, 0x7e, 0x03, 0xca, 0x1c, 0x5c, 0x03, 0x67, 0x6a, 0xbe,
-	0x63, 0x99, 0xa6, 0x3b, 0x96, 0x45, 0xd6, 0xef, 0x9f, 0x93, 0xf9, 0x7e, 0xe5, 0x30, 0x10, 0x90,
-	0x2c, 0x3c, 0xac, 0x03, 0x9b, 0x9f, 0x31, 0xbf, 0x36, 0x0c, 0xe8, 0xf6, 0x1c, 0xdd, 0xc2, 0x26,
-	0xb2, 0x42, 0x27, 0x41, 0x7a, 0x2b, 0x50, 0x3c, 0x8e, 0xb4, 0xc8, 0x87, 0x70, 0x21, 0xe1, 0xe0,
-	0x1f, 0x58, 0x5d, 0x66, 0xc6, 0x58, 0x5d, 0x56, 0x62, 0xf1, 0xef, 0x2f, 0x34, 0xeb, 0x90, 0x0b,
-	0x61, 0x7c, 0x96, 0x62, 0x7c, 0x76, 0x3f, 0x00, 0xee, 0xdb, 0x90, 0xf7, 0x9d, 0x48, 0x2f, 0x1c,
-	0x72, 0x23, 0x5e, 0x38, 0xcc, 0x7a, 0x7c, 0xf4, 0x7a, 0x61, 0x0b, 0x72, 0xc2, 0xbf, 0x54, 0xcc,
-	0xec, 0x88, 0x62, 0xb2, 0x9c, 0x8b, 0x0a, 0xb1, 0x61, 0xfa, 0xc3, 0x0e, 0xe2, 0x0b, 0x4c, 0x6a,
-	0x23, 0x7b, 0xed, 0x5b, 0xd5, 0x91, 0xee, 0x85, 0xab, 0x43, 0x73, 0xa6, 0xfa, 0x36, 0x93, 0x7b,
-	0xc7, 0x72, 0x9d, 0x9e, 0x2a, 0x46, 0x59, 0xf9, 0x00, 0x72, 0xc1, 0x0e, 0xb9, 0x08, 0xa9, 0x23,
-	0xd4, 0xe3, 0x70, 0x45, 0xfe, 0x94, 0x6f, 0x40, 0xba, 0xab, 0x37, 0x3b, 0x7d, 0x36, 0x45, 0xf4,
-	0x66, 0x35, 0x98, 0x62, 0x44, 0x5a, 0x4f, 0x65, 0x2c, 0x37, 0x26, 0xde, 0x90, 0x18, 0xcc, 0x07,
-	0x40, 0xf3, 0x66, 0xdd, 0x35, 0xbb, 0xa6, 0xdb, 0xfb, 0x12, 0x34, 0x47, 0x00, 0xcd, 0xa0, 0xb1,
-	0xfa, 0x83, 0xe6, 0x6f, 0x4f, 0x0a, 0xd0, 0x4c, 0x34, 0x2e, 0x07, 0xcd, 0x47, 0x50, 0x88, 0xc0,
-	0x15, 0x87, 0xcd, 0x4b, 0xe1, 0xa9, 0x04, 0x92, 0x9a, 0x6d, 0x52, 0x7a, 0x14, 0x74, 0xd4, 0x7c,
-	0x18, 0xd2, 0x62, 0x01, 0x3f, 0x71, 0x9a, 0x80, 0x0f, 0xe0, 0x58, 0x2a, 0x8c, 0x63, 0x08, 0x2a,
-	0x62, 0x9f, 0xc6, 0x9b, 0xb4, 0x48, 0xa2, 0x4e, 0x8e, 0x38, 0xe0, 0x2a, 0x97, 0x73, 0x93, 0x89,
-	0xd9, 0x0d, 0xa5, 0xed, 0x43, 0x28, 0x35, 0x90, 0xee, 0xb8, 0xfb, 0x48, 0x77, 0x35, 0x03, 0xb9,
-	0xba, 0xd9, 0xc4, 0xfc, 0xce, 0x71, 0xf8, 0xbd, 0x5a, 0xd1, 0x63, 0xbd, 0xcd, 0x38, 0xe3, 0x2b,
-	0xd3, 0xd4, 0xa9, 0x57, 0xa6, 0x2b, 0x81, 0x50, 0xf7, 0x52, 0x80, 0x42, 0xf8, 0x8c, 0x1f, 0xbf,
-	0x8f, 0x44, 0x87, 0xf2, 0x63, 0x09, 0x9e, 0x63, 0xbe, 0x0e, 0xc1, 0x00, 0xbf, 0xf5, 0x1b, 0x2b,
-	0xc9, 0x6c, 0x28, 0xf2, 0xbb, 0x46, 0x14, 0xb9, 0x84, 0xbe, 0x3d, 0x34, 0x6a, 0x47, 0x98, 0x82,
-	0x5a, 0x10, 0xd2, 0x45, 0x00, 0xff, 0x91, 0x04, 0xcf, 0x0f, 0x66, 0xe4, 0x31, 0x8c, 0xfd, 0x45,
-	0x54, 0x5c, 0xbd, 0xf3, 0x20, 0xbe, 0xf7, 0xb4, 0x80, 0x92, 0x1c, 0x57, 0x42, 0x0d, 0xca, 0x5f,
-	0x49, 0x04, 0xbb, 0x62, 0xb3, 0xbb, 0xab, 0x9b, 0xcd, 0xb1, 0xcc, 0xda, 0x80, 0xfc, 0x01, 0xe5,
-	0x89, 0x18, 0xf5, 0xe6, 0x69, 0x8c, 0x1a, 0x1a, 0x5d, 0x9d, 0x3d, 0x08, 0xfe, 0x54, 0x9e, 0x23,
-	0x78, 0xd0, 0x97, 0x85, 0xab, 0xf5, 0x63, 0x09, 0x94, 0x38, 0x6a, 0xdc, 0x13, 0x11, 0x3d, 0x86,
-	0x62, 0xed, 0x60, 0x0e, 0x85, 0x75, 0xdb, 0x1a, 0x41, 0xb7, 0x61, 0x53, 0x08, 0xa4, 0x99, 0x50,
-	0x70, 0x87, 0xc4, 0xfa, 0x00, 0x3e, 0x1e, 0x2e, 0x2f, 0x42, 0xb1, 0xae, 0x5b, 0x75, 0xe4, 0x81,
-	0x2f, 0x62, 0xf3, 0xcf, 0xa8, 0x05, 0xd6, 0xae, 0x8a, 0xe6, 0x60, 0xfa, 0x04, 0x65, 0x7e, 0x4e,
-	0xe9, 0x33, 0x68, 0x0a, 0xf1, 0xf4, 0x79, 0xc1, 0xcb, 0x9e, 0x3e, 0x7c, 0xf1, 0x40, 0x0e, 0x12,
-	0xfe, 0xff, 0x07, 0x72, 0xdf, 0xd1, 0xfb, 0x07, 0x72, 0x12, 0x0b, 0x57, 0xeb, 0xaf, 0x69, 0x20,
-	0xc7, 0xf5, 0xa7, 0x1e, 0x1e, 0x4b, 0xb1, 0x5f, 0x83, 0x7c, 0x38, 0x5e, 0xc6, 0x88, 0xe2, 0x61,
-	0xe3, 0xab, 0xb3, 0xa1, 0x90, 0x53, 0x2e, 0x25, 0xc7, 0x9b, 0xc7, 0xc4, 0x95, 0xfb, 0xdb, 0x09,
-	0xa8, 0xec, 0x9a, 0x87, 0x96, 0xde, 0x3c, 0xcb, 0x9b, 0xe2, 0x01, 0xe4, 0x31, 0x15, 0x12, 0x51,
-	0xec, 0xad, 0xe1, 0x8f, 0x8a, 0x03, 0xc7, 0x56, 0x67, 0x99, 0x58, 0x31, 0x15, 0x13, 0x56, 0xd1,
-	0x89, 0x8b, 0x1c, 0x32, 0x52, 0xc2, 0x3e, 0x2d, 0x35, 0xee, 0x3e, 0x6d, 0x59, 0x48, 0x8b, 0x75,
-	0xc9, 0x55, 0x98, 0xab, 0x37, 0xcc, 0xa6, 0xe1, 0x8f, 0x63, 0x5b, 0xcd, 0x1e, 0xdd, 0x14, 0x64,
-	0xd4, 0x12, 0xed, 0x12, 0x4c, 0xdf, 0xb4, 0x9a, 0x3d, 0x65, 0x1d, 0x2e, 0xf6, 0xd5, 0x85, 0xdb,
-	0xfa, 0x1f, 0x25, 0xb8, 0xcc, 0x69, 0x4c, 0xb7, 0x71, 0xe6, 0x87, 0xdc, 0xef, 0x49, 0xb0, 0xcc,
-	0xad, 0x7e, 0x6c, 0xba, 0x0d, 0x2d, 0xe9, 0x55, 0xf7, 0xde, 0xa8, 0x0e, 0x18, 0x36, 0x21, 0x75,
-	0x11, 0x87, 0x09, 0x45, 0x9c, 0xdd, 0x84, 0x8d, 0xe1, 0x22, 0x06, 0xbf, 0xc7, 0xfd, 0x8d, 0x04,
-	0x17, 0x55, 0xd4, 0xb2, 0xbb, 0x88, 0x49, 0x3a, 0xe5, 0xe5, 0xf3, 0xb3, 0xdb, 0xbb, 0x87, 0x77,
-	0xe0, 0xa9, 0xc8, 0x0e, 0x5c, 0x51, 0x08, 0xec, 0xf5, 0x9b, 0xbe, 0xf0, 0xfd, 0x04, 0xac, 0xef,
-	0x21, 0xa7, 0x65, 0x5a, 0xba, 0x8b, 0xce, 0xe2, 0x75, 0x1b, 0x4a, 0xae, 0x90, 0x13, 0x71, 0xf6,
-	0xad, 0xa1, 0xce, 0x1e, 0x3a, 0x03, 0xb5, 0xe8, 0x09, 0xff, 0x05, 0xc8, 0xb9, 0xe7, 0x41, 0x19,
-	0xa4, 0x11, 0x37, 0xfd, 0x1f, 0x4b, 0x50, 0xb9, 0x8d, 0xc8, 0x52, 0x75, 0x16, 0xbb, 0x3f, 0xb3,
-	0xe8, 0x22, 0xc8, 0xd1, 0x77, 0x7a, 0x5c, 0x85, 0x3f, 0x93, 0xe0, 0x02, 0xbd, 0x34, 0x3c, 0x63,
-	0xe1, 0x87, 0x43, 0x64, 0x8c, 0x5d, 0xf8, 0x31, 0x70, 0x64, 0x35, 0x47, 0x85, 0x0a, 0x38, 0x78,
-	0x1d, 0x2a, 0xfd, 0xc8, 0x07, 0x83, 0xc0, 0x1f, 0xa6, 0xe0, 0x12, 0x17, 0xc2, 0x16, 0xa9, 0xb3,
-	0xa8, 0xda, 0xea, 0xb3, 0xd0, 0xde, 0x1d, 0x41, 0xd7, 0x11, 0xa6, 0x10, 0x59, 0x6b, 0xe5, 0x37,
-	0x03, 0x29, 0xc2, 0x6b, 0x3e, 0xe2, 0x57, 0x76, 0x65, 0x41, 0x52, 0x13, 0x14, 0xe2, 0xb2, 0x6d,
-	0x48, 0x86, 0x4d, 0x3e, 0xfb, 0x0c, 0x4b, 0xf7, 0xcb, 0xb0, 0x0d, 0x78, 0x61, 0x98, 0x45, 0x78,
-	0x88, 0xfe, 0x83, 0x04, 0xab, 0xe2, 0xe8, 0x1b, 0x3c, 0x15, 0xfc, 0x5c, 0x00, 0xf8, 0x75, 0x58,
-	0x34, 0xb1, 0x96, 0x50, 0x8d, 0x42, 0x7d, 0x93, 0x51, 0xe7, 0x4c, 0x7c, 0x37, 0x5a, 0x66, 0xa2,
-	0x54, 0xe0, 0x7c, 0xb2, 0x42, 0x5c, 0xe3, 0xff, 0x99, 0x20, 0xfb, 0x62, 0x72, 0x4a, 0xd8, 0x22,
-	0x76, 0xf3, 0x46, 0x3b, 0xcd, 0x9e, 0xfe, 0xd9, 0xa9, 0xbe, 0x0e, 0x39, 0x3f, 0x24, 0xfd, 0x07,
-	0x43, 0xaf, 0xad, 0x66, 0xc8, 0xef, 0xc1, 0x9c, 0xd8, 0xf2, 0x1b, 0x67, 0x89, 0x3b, 0xd9, 0x93,
-	0xe2, 0x0f, 0xbf, 0xe3, 0x1d, 0x56, 0xe8, 0x45, 0x31, 0xbd, 0x16, 0x4a, 0x8f, 0x73, 0x2d, 0x54,
-	0xf0, 0xd9, 0x69, 0x83, 0x72, 0x99, 0xe0, 0xc4, 0x40, 0xab, 0x73, 0xff, 0xfc, 0x89, 0x04, 0x6b,
-	0xb7, 0x11, 0xae, 0x3b, 0xe6, 0xfe, 0x99, 0x90, 0xff, 0xdb, 0x30, 0x3d, 0xee, 0x39, 0x64, 0xd8,
-	0xb0, 0xaa, 0x90, 0xa8, 0xfc, 0xc1, 0x24, 0xac, 0x0f, 0xa0, 0xe6, 0x98, 0xf9, 0x1d, 0x28, 0xfa,
-	0x17, 0xd9, 0x75, 0xdb, 0x3a, 0x30, 0x0f, 0xf9, 0xbd, 0xc4, 0xd5, 0xe4, 0xb9, 0x24, 0x3a, 0x68,
-	0x8b, 0x32, 0xaa, 0x05, 0x14, 0x6e, 0x90, 0x0f, 0x61, 0x29, 0xe1, 0xbe, 0x9c, 0xde, 0xce, 0x33,
-	0x85, 0x37, 0xc7, 0x18, 0x84, 0xde, 0xc9, 0x2f, 0x1c, 0x27, 0x35, 0xcb, 0xdf, 0x01, 0xb9, 0x8d,
-	0x2c, 0xc3, 0xb4, 0x0e, 0x35, 0x9d, 0x1d, 0x4a, 0x4c, 0x84, 0xcb, 0x29, 0x7a, 0x13, 0x7d, 0xa5,
-	0xff, 0x18, 0x3b, 0x8c, 0x47, 0x9c, 0x63, 0xe8, 0x08, 0xa5, 0x76, 0xa8, 0xd1, 0x44, 0x58, 0xfe,
-	0x2e, 0x14, 0x85, 0x74, 0x0a, 0x64, 0x0e, 0x7d, 0xfa, 0x27, 0xb2, 0xaf, 0x0f, 0x95, 0x1d, 0x8e,
-	0x25, 0x3a, 0x42, 0xa1, 0x1d, 0xe8, 0x72, 0x90, 0x25, 0x23, 0x58, 0x10, 0xf2, 0xc3, 0x18, 0x92,
-	0x1e, 0xe6, 0x09, 0x3e, 0x48, 0xec, 0xe9, 0x62, 0xae, 0x1d, 0xef, 0x50, 0x7e, 0x2b, 0x05, 0x65,
-	0x95, 0xd7, 0xbb, 0x22, 0x1a, 0xf2, 0xf8, 0xf1, 0xb5, 0x9f, 0x0b, 0x28, 0x39, 0x80, 0x85, 0xf0,
-	0x43, 0x75, 0x4f, 0x33, 0x5d, 0xd4, 0x12, 0x1e, 0xbc, 0x36, 0xd6, 0x63, 0x75, 0xaf, 0xe6, 0xa2,
-	0x96, 0x3a, 0xd7, 0x8d, 0xb5, 0x61, 0xf9, 0x0d, 0x98, 0xa2, 0x40, 0x81, 0x39, 0x04, 0xf5, 0xbd,
-	0x28, 0xbd, 0xad, 0xbb, 0xfa, 0xad, 0xa6, 0xbd, 0xaf, 0x72, 0x7a, 0xf9, 0x2e, 0xe4, 0x2d, 0x74,
-	0xac, 0x91, 0xfd, 0x05, 0x97, 0x90, 0x1e, 0x51, 0x42, 0xce, 0x42, 0xc7, 0x6a, 0x87, 0x41, 0x0c,
-	0x56, 0x56, 0x61, 0x39, 0xc1, 0x05, 0xfe, 0x7e, 0x72, 0x71, 0xb7, 0x67, 0xd5, 0x77, 0x1b, 0xba,
-	0x63, 0xf0, 0xe7, 0x6b, 0xee, 0x9e, 0x4b, 0x90, 0xc7, 0x76, 0xc7, 0xa9, 0x23, 0xad, 0xde, 0xec,
-	0x60, 0x17, 0x39, 0xdc, 0x41, 0xb3, 0xac, 0x75, 0x8b, 0x35, 0xca, 0xcb, 0x90, 0xc1, 0x84, 0x59,
-	0xbc, 0x01, 0xa6, 0xd5, 0x69, 0xfa, 0xbb, 0x66, 0xc8, 0x37, 0x21, 0xcb, 0xde, 0xd1, 0xd9, 0x1d,
-	0x74, 0x6a, 0xc4, 0x3b, 0x68, 0x60, 0x4c, 0xa4, 0x59, 0x59, 0x86, 0xa5, 0xd8, 0xf4, 0xc4, 0x29,
-	0x24, 0x0d, 0x73, 0xa4, 0x4f, 0xa4, 0xd2, 0x18, 0x61, 0x75, 0x11, 0xb2, 0x5e, 0x58, 0xf1, 0x69,
-	0xcf, 0xa8, 0x20, 0x9a, 0x6a, 0x46, 0x60, 0x5f, 0x97, 0x0a, 0xec, 0xeb, 0xe4, 0x32, 0x4c, 0x73,
-	0x1f, 0xf3, 0x67, 0x0d, 0xf1, 0x93, 0x0c, 0xea, 0xdf, 0xb8, 0xfb, 0xcf, 0x90, 0x5e, 0x1b, 0x7d,
-	0x74, 0x8f, 0xbe, 0x9e, 0x4d, 0x9d, 0xee, 0xf5, 0xec, 0x02, 0x80, 0xb8, 0xd8, 0x35, 0xd9, 0x3b,
-	0x65, 0x4a, 0x9d, 0xe1, 0x2d, 0xb4, 0x90, 0x25, 0xfc, 0xd6, 0x90, 0x39, 0xcd, 0x5b, 0xc3, 0x0e,
-	0x2f, 0x9e, 0xf1, 0xef, 0x2a, 0xa9, 0xac, 0x99, 0x11, 0x65, 0x95, 0x08, 0xb3, 0x77, 0xc7, 0x48,
-	0x25, 0xde, 0x80, 0x69, 0xf1, 0x64, 0x00, 0x23, 0x3e, 0x19, 0x08, 0x86, 0xe0, 0xcb, 0x47, 0x36,
-	0xfc, 0xf2, 0xb1, 0x05, 0x39, 0x56, 0x5a, 0xc1, 0x2b, 0x87, 0x73, 0x23, 0x56, 0x0e, 0x67, 0x69,
-	0xc5, 0x05, 0x2f, 0x1a, 0x7e, 0x15, 0x68, 0xd1, 0x2f, 0x45, 0x42, 0xe4, 0x68, 0xa6, 0x81, 0x2c,
-	0xd7, 0x74, 0x7b, 0xf4, 0x59, 0x72, 0x46, 0x95, 0x49, 0xdf, 0x3b, 0xb4, 0xab, 0xc6, 0x7b, 0xe4,
-	0x77, 0xa0, 0x10, 0x41, 0x0f, 0x5e, 0xe4, 0x52, 0x1d, 0x0f, 0x37, 0xd4, 0x7c, 0x18, 0x33, 0x94,
-	0x45, 0x98, 0x0f, 0xc7, 0x34, 0x0f, 0xf6, 0xef, 0x4b, 0xb0, 0x2a, 0x96, 0xd6, 0xcf, 0xb9, 0x9e,
-	0x4d, 0xf9, 0x5f, 0x09, 0xce, 0x27, 0xcf, 0x85, 0xaf, 0xf0, 0x0d, 0x98, 0xab, 0xeb, 0xf5, 0x06,
-	0x0a, 0x7f, 0x6b, 0xc0, 0x17, 0xf9, 0x37, 0x12, 0x2d, 0x14, 0xf8, 0x5a, 0x21, 0x38, 0x7e, 0x48,
-	0x7c, 0x89, 0x0a, 0x0d, 0x36, 0xc9, 0x16, 0x2c, 0x1a, 0xba, 0xab, 0xef, 0xeb, 0x38, 0x3a, 0xd8,
-	0xc4, 0x19, 0x07, 0x9b, 0x17, 0x72, 0x83, 0xad, 0xca, 0x3f, 0x4b, 0xb0, 0x22, 0x54, 0xe7, 0x2e,
-	0xbb, 0x67, 0xe3, 0xe0, 0xfd, 0x7f, 0xc3, 0xc6, 0xae, 0xa6, 0x1b, 0x86, 0x83, 0x30, 0x16, 0x5e,
-	0x20, 0x6d, 0x37, 0x59, 0xd3, 0x20, 0xb8, 0x8c, 0xfa, 0x30, 0x35, 0xea, 0x7a, 0x38, 0xf9, 0x14,
-	0x0e, 0xee, 0x1f, 0x4f, 0xf8, 0x01, 0x16, 0xd2, 0x8c, 0xfb, 0xf4, 0x39, 0x98, 0xa5, 0xf3, 0xc4,
-	0x9a, 0xd5, 0x69, 0xed, 0xf3, 0xc5, 0x20, 0xad, 0xe6, 0x58, 0xe3, 0x23, 0xda, 0x26, 0xaf, 0xc2,
-	0x8c, 0x50, 0x0e, 0x97, 0x27, 0xd6, 0x52, 0x1b, 0x69, 0x35, 0xc3, 0xb5, 0xc3, 0xf2, 0xfb, 0x50,
-	0xf0, 0xd5, 0xa3, 0xae, 0x1c, 0xf8, 0x01, 0x85, 0x47, 0x4b, 0x54, 0xf0, 0x9e, 0xee, 0xb6, 0x08,
-	0x1f, 0xdd, 0x6f, 0xe4, 0xad, 0x50, 0x9b, 0xfc, 0x1a, 0x2c, 0xb1, 0xb1, 0xeb, 0xb6, 0xe5, 0x3a,
-	0x76, 0xb3, 0x89, 0x1c, 0x51, 0xc5, 0x35, 0x49, 0x0d, 0xb9, 0x40, 0xbb, 0xb7, 0xbc, 0x5e, 0x5e,
-	0x9c, 0x45, 0xb0, 0x85, 0xbb, 0x8b, 0x3d, 0x47, 0x8b, 0x9f, 0x4a, 0x15, 0x4a, 0x5b, 0x4d, 0x1b,
-	0x23, 0xba, 0xf8, 0x08, 0x17, 0x07, 0xfd, 0x27, 0x85, 0xfc, 0xa7, 0xcc, 0x83, 0x1c, 0xa4, 0xe7,
-	0x99, 0xfb, 0x0a, 0x14, 0xb6, 0x91, 0x3b, 0xaa, 0x8c, 0x0f, 0xa0, 0xe8, 0x53, 0x73, 0xd3, 0x3f,
-	0x00, 0xe0, 0xe4, 0x64, 0x17, 0xcb, 0xb2, 0xe8, 0xca, 0x28, 0x81, 0x4d, 0xc5, 0x50, 0x63, 0x31,
-	0xb7, 0x90, 0x3f, 0x95, 0x9f, 0x4a, 0x50, 0x62, 0x37, 0x7c, 0xc1, 0x13, 0x6d, 0xff, 0x29, 0xc9,
-	0x77, 0x21, 0x43, 0xb6, 0x0e, 0x87, 0x04, 0xe4, 0x26, 0x68, 0x3d, 0xdc, 0x4b, 0x83, 0xab, 0xed,
-	0xd8, 0xdd, 0x3c, 0xe3, 0x50, 0x3d, 0xde, 0x60, 0x4d, 0x40, 0x2a, 0x54, 0x13, 0x50, 0x83, 0x42,
-	0xd7, 0xc4, 0xe6, 0xbe, 0xd9, 0x34, 0xdd, 0xde, 0x78, 0xcf, 0xd5, 0x79, 0x9f, 0x91, 0x6e, 0x17,
-	0xe6, 0x41, 0x0e, 0xea, 0xc6, 0x5d, 0xf0, 0xb1, 0x04, 0x17, 0xb6, 0x91, 0xab, 0xfa, 0x1f, 0x5e,
-	0x3d, 0x64, 0x1f, 0x5d, 0x79, 0x7b, 0x9d, 0x07, 0x30, 0x45, 0xab, 0x5e, 0x48, 0xca, 0xa6, 0xfa,
-	0x86, 0x64, 0xe0, 0xcb, 0x2d, 0x76, 0xbd, 0xe2, 0xfd, 0xa4, 0xf5, 0x31, 0x2a, 0x97, 0x41, 0x12,
-	0x99, 0x6f, 0x99, 0xe8, 0x63, 0x34, 0xdf, 0x5f, 0x64, 0x79, 0x1b, 0x89, 0x65, 0xe5, 0x47, 0x13,
-	0x50, 0xe9, 0x37, 0x25, 0xee, 0xf6, 0xdf, 0x80, 0x3c, 0x73, 0x09, 0xff, 0x42, 0x4c, 0xcc, 0xed,
-	0xdd, 0x11, 0x5f, 0x6f, 0x07, 0x8b, 0x67, 0xc1, 0x21, 0x5a, 0x59, 0xa5, 0x0b, 0xcb, 0x70, 0xd1,
-	0xb6, 0xd2, 0x03, 0x39, 0x4e, 0x14, 0xac, 0x7a, 0x49, 0xb3, 0xaa, 0x97, 0x87, 0xe1, 0xaa, 0x97,
-	0xd7, 0xc7, 0xb4, 0x9d, 0x37, 0x33, 0xbf, 0x10, 0x46, 0xf9, 0x08, 0xd6, 0xb6, 0x91, 0x7b, 0xfb,
-	0xc1, 0xdb, 0x03, 0x7c, 0xf6, 0x98, 0x17, 0xec, 0x92, 0xac, 0x10, 0xb6, 0x19, 0x77, 0x6c, 0xef,
-	0xf4, 0x42, 0x6b, 0x78, 0xc9, 0x5f, 0x58, 0xf9, 0x1d, 0x09, 0xd6, 0x07, 0x0c, 0xce, 0xbd, 0xf3,
-	0x01, 0x94, 0x02, 0x62, 0xe9, 0xd9, 0x49, 0x4c, 0xe2, 0xfa, 0x29, 0x26, 0xa1, 0x16, 0x9d, 0x70,
-	0x03, 0x56, 0x7e, 0x20, 0xc1, 0x3c, 0xad, 0x10, 0x12, 0xf8, 0x3d, 0xc6, 0x5a, 0xff, 0xcd, 0xe8,
-	0x31, 0xff, 0xab, 0x43, 0x8f, 0xf9, 0x49, 0x43, 0xf9, 0x47, 0xfb, 0x23, 0x58, 0x88, 0x10, 0x70,
-	0x3b, 0xa8, 0x90, 0x89, 0x54, 0x17, 0xbc, 0x36, 0xee, 0x50, 0xbc, 0x96, 0xc0, 0x93, 0xa3, 0xfc,
-	0xbe, 0x04, 0xf3, 0x2a, 0xd2, 0xdb, 0xed, 0x26, 0xbb, 0x37, 0xc1, 0x63, 0x68, 0xbe, 0x1b, 0xd5,
-	0x3c, 0xb9, 0x1a, 0x2f, 0xf8, 0x91, 0x22, 0x73, 0x47, 0x7c, 0x38, 0x5f, 0xfb, 0x25, 0x58, 0x88,
-	0x10, 0xf0, 0x99, 0xfe, 0xe5, 0x04, 0x2c, 0xb0, 0x58, 0x89, 0x46, 0xe7, 0x1d, 0x98, 0xf4, 0xaa,
-	0x2d, 0xf3, 0xc1, 0xf3, 0x74, 0x12, 0x62, 0xde, 0x46, 0xba, 0xf1, 0x00, 0xb9, 0x2e, 0x72, 0x68,
-	0xe1, 0x12, 0x2d, 0x70, 0xa1, 0xec, 0x83, 0xb6, 0x0b, 0xf1, 0xf3, 0x59, 0x2a, 0xe9, 0x7c, 0xf6,
-	0x3a, 0x94, 0x4d, 0x8b, 0x50, 0x98, 0x5d, 0xa4, 0x21, 0xcb, 0x83, 0x13, 0xbf, 0x36, 0x6b, 0xc1,
-	0xeb, 0xbf, 0x63, 0x89, 0x64, 0xaf, 0x19, 0xf2, 0x4b, 0x50, 0x6a, 0xe9, 0x27, 0x66, 0xab, 0xd3,
-	0xd2, 0xda, 0x84, 0x1e, 0x9b, 0x1f, 0xb1, 0x2f, 0x0c, 0xd3, 0x6a, 0x81, 0x77, 0xec, 0xe8, 0x87,
-	0x68, 0xd7, 0xfc, 0x08, 0xc9, 0x2f, 0x40, 0x81, 0x96, 0x61, 0x52, 0x42, 0x56, 0x3f, 0x38, 0x45,
-	0xeb, 0x07, 0x69, 0x75, 0x26, 0x21, 0x63, 0xdf, 0x28, 0xfc, 0x27, 0xfb, 0x5a, 0x2d, 0x64, 0x2f,
-	0x1e, 0x48, 0x4f, 0xc9, 0x60, 0x89, 0x79, 0x39, 0xf1, 0x14, 0xf3, 0x32, 0x49, 0xd7, 0x54, 0x92,
-	0xae, 0xff, 0x22, 0xc1, 0xd2, 0x4e, 0xc7, 0x39, 0x44, 0x5f, 0xc4, 0xe8, 0x50, 0x56, 0xa0, 0x1c,
-	0x57, 0x4e, 0xd4, 0x4e, 0x4c, 0xc0, 0xd2, 0x43, 0xf4, 0x05, 0xd5, 0xfc, 0x99, 0xe4, 0xc5, 0x2d,
-	0x28, 0xc7, 0x0d, 0xc6, 0x13, 0x23, 0x41, 0x86, 0x94, 0x24, 0xe3, 0x47, 0xf4, 0xbb, 0x80, 0x03,
-	0x07, 0xe1, 0x46, 0xf0, 0x0e, 0x6e, 0x1c, 0xf0, 0x7c, 0x2f, 0x0a, 0x9e, 0xbf, 0x3a, 0x22, 0x78,
-	0xf6, 0x1d, 0xd5, 0xc7, 0x50, 0xfa, 0xa9, 0x40, 0x12, 0x1d, 0x0f, 0x9a, 0x1f, 0x4a, 0xf0, 0xd2,
-	0x36, 0xb2, 0x90, 0xa3, 0xbb, 0xe8, 0x81, 0x8e, 0x5d, 0x71, 0x42, 0x8e, 0xa4, 0xdf, 0xe7, 0x71,
-	0xe0, 0xbd, 0x02, 0x2f, 0x8f, 0x34, 0x33, 0xae, 0xc9, 0x5d, 0x58, 0x0d, 0xef, 0xbd, 0xc2, 0xf7,
-	0x6a, 0x97, 0xa1, 0xe0, 0xa0, 0x96, 0xed, 0x7a, 0xf1, 0xc9, 0xf6, 0x0d, 0x33, 0x6a, 0x9e, 0x35,
-	0xf3, 0x00, 0xc5, 0x4a, 0x07, 0xce, 0x27, 0xcb, 0xe1, 0x81, 0xf1, 0x2d, 0x98, 0x62, 0xa7, 0x2f,
-	0xbe, 0xef, 0x78, 0x73, 0xc4, 0x8d, 0x21, 0x3f, 0x5d, 0x44, 0xc5, 0x72, 0x61, 0xca, 0xdf, 0xa7,
-	0x61, 0x31, 0x99, 0x64, 0xd0, 0x29, 0xe1, 0xab, 0xb0, 0xd4, 0xd2, 0x4f, 0xb4, 0x28, 0xf6, 0xfa,
-	0x5f, 0x06, 0xcc, 0xb7, 0xf4, 0x93, 0xe8, 0xce, 0xcb, 0x90, 0xef, 0x43, 0x91, 0x49, 0x6c, 0xda,
-	0x75, 0xbd, 0x39, 0xde, 0x3d, 0x21, 0xdb, 0x1e, 0x3f, 0x20, 0x8c, 0xf4, 0x86, 0xe9, 0xa3, 0xb8,
-	0x61, 0xd9, 0x95, 0xf9, 0xdb, 0x67, 0x32, 0x4c, 0x55, 0x0d, 0xb9, 0x85, 0x6d, 0x95, 0x23, 0xbe,
-	0x92, 0x7f, 0x57, 0x82, 0xb9, 0x86, 0x6e, 0x19, 0x76, 0x97, 0x6f, 0xfa, 0x69, 0x10, 0x92, 0x23,
-	0xe5, 0x38, 0x95, 0xe9, 0x7d, 0x26, 0x70, 0x8f, 0x0b, 0xf6, 0x4e, 0xc1, 0x7c, 0x12, 0x72, 0x23,
-	0xd6, 0xb1, 0xf2, 0x03, 0x09, 0xe6, 0x12, 0x26, 0x9c, 0x50, 0xac, 0xfe, 0x7e, 0x78, 0xdb, 0xbe,
-	0x7d, 0xa6, 0x39, 0xee, 0x20, 0x87, 0x8f, 0x17, 0xd8, 0xc6, 0xaf, 0x7c, 0x4f, 0x82, 0xa5, 0x3e,
-	0x93, 0x4f, 0x98, 0x90, 0x1a, 0x9e, 0xd0, 0xd7, 0x47, 0x9c, 0x50, 0x6c, 0x00, 0xba, 0xa1, 0x0f,
-	0x1c, 0x26, 0xde, 0x85, 0x85, 0x44, 0x1a, 0xf9, 0x2d, 0x38, 0xef, 0xf9, 0x2c, 0x29, 0x70, 0x25,
-	0x1a, 0xb8, 0xcb, 0x82, 0x26, 0x16, 0xbd, 0xca, 0x9f, 0x4a, 0xb0, 0x36, 0xcc, 0x1e, 0xb2, 0x02,
-	0xb3, 0x7a, 0xfd, 0x08, 0x19, 0x11, 0xb1, 0x59, 0xda, 0xc8, 0xd3, 0xe0, 0x7d, 0x58, 0x09, 0xd0,
-	0x44, 0x4f, 0xc3, 0xa3, 0x56, 0x8b, 0x2f, 0x79, 0x22, 0x1f, 0x87, 0x8e, 0xc5, 0xb7, 0xda, 0x9f,
-	0x7c, 0x5a, 0x39, 0xf7, 0x93, 0x4f, 0x2b, 0xe7, 0x7e, 0xf6, 0x69, 0x45, 0xfa, 0xcd, 0x27, 0x15,
-	0xe9, 0xcf, 0x9f, 0x54, 0xa4, 0xbf, 0x7b, 0x52, 0x91, 0x3e, 0x79, 0x52, 0x91, 0xfe, 0xed, 0x49,
-	0x45, 0xfa, 0x8f, 0x27, 0x95, 0x73, 0x3f, 0x7b, 0x52, 0x91, 0x3e, 0xfe, 0xac, 0x72, 0xee, 0x93,
-	0xcf, 0x2a, 0xe7, 0x7e, 0xf2, 0x59, 0xe5, 0xdc, 0x7b, 0x37, 0x0e, 0x6d, 0xdf, 0x05, 0xa6, 0x3d,
-	0xf0, 0x5f, 0xf5, 0xfc, 0x4a, 0xb8, 0x65, 0x7f, 0x8a, 0x4e, 0xf2, 0xfa, 0xff, 0x05, 0x00, 0x00,
-	0xff, 0xff, 0x57, 0xe0, 0x7c, 0x0f, 0xe9, 0x47, 0x00, 0x00,
+	// 4359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0x47,
+	0x76, 0x6a, 0x0e, 0x3f, 0xc3, 0x47, 0x72, 0x38, 0x6c, 0xfe, 0x86, 0xa4, 0x34, 0x22, 0x5b, 0x92,
+	0x45, 0x7f, 0x34, 0xb4, 0xa4, 0xf5, 0x67, 0x95, 0xf5, 0x7a, 0x45, 0x52, 0x9f, 0x11, 0x24, 0x99,
+	0x6e, 0xd2, 0xb2, 0xe3, 0x5d, 0x6f, 0xbb, 0x39, 0x5d, 0xe4, 0x74, 0x38, 0xd3, 0x3d, 0xee, 0xaa,
+	0x21, 0x39, 0xde, 0x43, 0x3e, 0x86, 0x03, 0x64, 0x0f, 0x89, 0x81, 0x5c, 0x16, 0xc8, 0xe6, 0xb2,
+	0xc0, 0x22, 0x41, 0x80, 0x20, 0x87, 0x9c, 0x36, 0x40, 0x80, 0x9c, 0x82, 0x9c, 0x12, 0x23, 0x97,
+	0x2c, 0x36, 0x87, 0xc4, 0x32, 0x02, 0x24, 0x48, 0x10, 0xec, 0x21, 0x87, 0x1c, 0x83, 0xfa, 0xf5,
+	0xf4, 0x6f, 0x7a, 0x66, 0x48, 0x29, 0xde, 0xf5, 0xfa, 0xc6, 0xa9, 0x7a, 0xff, 0x7a, 0xef, 0x55,
+	0xd5, 0xab, 0xd7, 0x84, 0x6f, 0x10, 0x54, 0x6f, 0xb8, 0x9e, 0x59, 0x5b, 0xc3, 0xc8, 0x3b, 0x44,
+	0xde, 0x9a, 0xd9, 0xb0, 0xd7, 0xaa, 0x36, 0x26, 0xae, 0xd7, 0xa2, 0x23, 0x76, 0x05, 0xad, 0x1d,
+	0x5e, 0x5d, 0xf3, 0xd0, 0x07, 0x4d, 0x84, 0x89, 0xe1, 0x21, 0xdc, 0x70, 0x1d, 0x8c, 0x4a, 0x0d,
+	0xcf, 0x25, 0xae, 0x7a, 0x49, 0x62, 0x97, 0x38, 0x76, 0xc9, 0x6c, 0xd8, 0xa5, 0x30, 0x76, 0xe9,
+	0xf0, 0xea, 0x62, 0x71, 0xdf, 0x75, 0xf7, 0x6b, 0x68, 0x8d, 0x21, 0xed, 0x36, 0xf7, 0xd6, 0xac,
+	0xa6, 0x67, 0x12, 0xdb, 0x75, 0x38, 0x99, 0xc5, 0xf3, 0xd1, 0x79, 0x62, 0xd7, 0x11, 0x26, 0x66,
+	0xbd, 0x21, 0x00, 0x56, 0x2c, 0xd4, 0x40, 0x8e, 0x85, 0x9c, 0x8a, 0x8d, 0xf0, 0xda, 0xbe, 0xbb,
+	0xef, 0xb2, 0x71, 0xf6, 0x97, 0x00, 0xb9, 0xe8, 0x2b, 0x42, 0x35, 0xa8, 0xb8, 0xf5, 0xba, 0xeb,
+	0x50, 0xc9, 0xeb, 0x08, 0x63, 0x73, 0x5f, 0x08, 0xbc, 0x78, 0x29, 0x04, 0x25, 0x24, 0x8d, 0x83,
+	0x5d, 0x0e, 0x81, 0x11, 0x13, 0x1f, 0x7c, 0xd0, 0x44, 0x4d, 0x14, 0x07, 0x0c, 0x73, 0x45, 0x4e,
+	0xb3, 0x8e, 0x29, 0xd0, 0x91, 0xeb, 0x1d, 0xec, 0xd5, 0xdc, 0x23, 0x01, 0xf5, 0x4c, 0x08, 0x4a,
+	0x4e, 0xc6, 0xa9, 0x5d, 0x08, 0xc1, 0x7d, 0xd0, 0x44, 0x5e, 0xab, 0x9b, 0x0a, 0x7b, 0xa6, 0x5d,
+	0x6b, 0x7a, 0x09, 0x92, 0xbd, 0x90, 0xb2, 0xb0, 0x71, 0xe8, 0x67, 0x93, 0xa0, 0x7d, 0x75, 0xb8,
+	0x35, 0x05, 0xe8, 0x6a, 0x2a, 0xa8, 0x87, 0x30, 0x22, 0x02, 0xf2, 0xf9, 0x54, 0xc8, 0x88, 0x8d,
+	0x2e, 0xa7, 0x02, 0xd3, 0x25, 0x10, 0x80, 0x57, 0x92, 0x00, 0x3b, 0xdb, 0xb4, 0x94, 0x04, 0xee,
+	0x98, 0x75, 0x84, 0x1b, 0x66, 0x25, 0xc1, 0x6e, 0x2f, 0x26, 0xc1, 0x7b, 0xa8, 0x51, 0xb3, 0x2b,
+	0xcc, 0x65, 0xe3, 0x18, 0xd7, 0x93, 0x30, 0x1a, 0xc8, 0xc3, 0x36, 0x26, 0xc8, 0xe1, 0x3c, 0xd0,
+	0x31, 0xaa, 0x34, 0x29, 0x3a, 0x16, 0x48, 0xaf, 0xf7, 0x80, 0x24, 0x95, 0x32, 0xea, 0x4d, 0x62,
+	0xee, 0xd6, 0x90, 0x81, 0x89, 0x49, 0x24, 0xd7, 0x97, 0x13, 0x7d, 0xaa, 0x6b, 0xc8, 0x2e, 0xde,
+	0x48, 0x62, 0x6c, 0x5a, 0x75, 0xdb, 0xe9, 0x8a, 0xab, 0xfd, 0xc9, 0x30, 0x9c, 0xdb, 0x26, 0xa6,
+	0x47, 0xde, 0x16, 0xec, 0x6e, 0x49, 0xb5, 0x74, 0x8e, 0xa0, 0xae, 0xc0, 0xb8, 0x6f, 0x5b, 0xc3,
+	0xb6, 0x0a, 0xca, 0xb2, 0xb2, 0x3a, 0xaa, 0x8f, 0xf9, 0x63, 0x65, 0x4b, 0xad, 0xc0, 0x04, 0xa6,
+	0x34, 0x0c, 0xc1, 0xa4, 0x30, 0xb0, 0xac, 0xac, 0x8e, 0x5d, 0xfb, 0xa6, 0xbf, 0x50, 0x2c, 0x89,
+	0x44, 0x14, 0x2a, 0x1d, 0x5e, 0x2d, 0xa5, 0x72, 0xd6, 0xc7, 0x19, 0x51, 0x29, 0x47, 0x15, 0x66,
+	0x1b, 0xa6, 0x87, 0x1c, 0x62, 0xf8, 0x96, 0x37, 0x6c, 0x67, 0xcf, 0x2d, 0x64, 0x18, 0xb3, 0xaf,
+	0x95, 0x92, 0x12, 0x97, 0xef, 0x91, 0x87, 0x57, 0x4b, 0x5b, 0x0c, 0xdb, 0xe7, 0x52, 0x76, 0xf6,
+	0x5c, 0x7d, 0xba, 0x11, 0x1f, 0x54, 0x0b, 0x30, 0x62, 0x12, 0x4a, 0x8d, 0x14, 0x06, 0x97, 0x95,
+	0xd5, 0x21, 0x5d, 0xfe, 0x54, 0xeb, 0xa0, 0xf9, 0x2b, 0xd8, 0x96, 0x02, 0x1d, 0x37, 0x6c, 0x9e,
+	0xfc, 0x0c, 0x9a, 0xe5, 0x0a, 0x43, 0x4c, 0xa0, 0xc5, 0x12, 0x4f, 0x81, 0x25, 0x99, 0x02, 0x4b,
+	0x3b, 0x32, 0x05, 0xae, 0x0f, 0x7e, 0xf2, 0x2f, 0xe7, 0x15, 0xfd, 0xfc, 0x51, 0x54, 0xf3, 0x5b,
+	0x3e, 0x25, 0x0a, 0xab, 0x56, 0x61, 0xa1, 0xe2, 0x3a, 0xc4, 0x76, 0x9a, 0xc8, 0x30, 0xb1, 0xe1,
+	0xa0, 0x23, 0xc3, 0x76, 0x6c, 0x62, 0x9b, 0xc4, 0xf5, 0x0a, 0xc3, 0xcb, 0xca, 0x6a, 0xee, 0xda,
+	0x95, 0xb0, 0x8d, 0x59, 0x74, 0x51, 0x65, 0x37, 0x04, 0xde, 0x4d, 0xfc, 0x10, 0x1d, 0x95, 0x25,
+	0x92, 0x3e, 0x57, 0x49, 0x1c, 0x57, 0x1f, 0xc0, 0x94, 0x9c, 0xb1, 0x0c, 0x91, 0x80, 0x0a, 0x23,
+	0x4c, 0x8f, 0xe5, 0x30, 0x07, 0x31, 0x49, 0x79, 0xdc, 0xe6, 0x7f, 0xea, 0x79, 0x1f, 0x55, 0x8c,
+	0xa8, 0x8f, 0x60, 0xae, 0x66, 0x62, 0x62, 0x54, 0xdc, 0x7a, 0xa3, 0x86, 0x98, 0x65, 0x3c, 0x84,
+	0x9b, 0x35, 0x52, 0xc8, 0x26, 0xd1, 0x14, 0xc9, 0x88, 0xad, 0x51, 0xab, 0xe6, 0x9a, 0x16, 0xd6,
+	0x67, 0x28, 0xfe, 0x86, 0x8f, 0xae, 0x33, 0x6c, 0xf5, 0xbb, 0xb0, 0xb4, 0x67, 0x7b, 0x98, 0x18,
+	0xfe, 0x2a, 0xd0, 0x2c, 0x62, 0xec, 0x9a, 0x95, 0x03, 0x77, 0x6f, 0xaf, 0x30, 0xca, 0x88, 0x2f,
+	0xc4, 0x0c, 0xbf, 0x29, 0xf6, 0xa6, 0xf5, 0xc1, 0x1f, 0x50, 0xbb, 0x17, 0x18, 0x0d, 0xe9, 0x76,
+	0x3b, 0x26, 0x3e, 0x58, 0xe7, 0x04, 0xd4, 0x45, 0xc8, 0x36, 0x3c, 0xdb, 0xf5, 0x6c, 0xd2, 0x2a,
+	0x00, 0x5b, 0x7a, 0xff, 0xb7, 0xf6, 0x0a, 0x14, 0x3b, 0xb9, 0x2b, 0x8f, 0x28, 0x75, 0x16, 0x86,
+	0xbd, 0xa6, 0xd3, 0x8e, 0x91, 0x21, 0xaf, 0xe9, 0x94, 0x2d, 0xed, 0x3f, 0x15, 0x98, 0xbb, 0x83,
+	0xc8, 0x03, 0x1e, 0xf1, 0xdb, 0xc4, 0x24, 0xa8, 0x8f, 0xd8, 0xba, 0x03, 0xa3, 0xbe, 0xa7, 0x89,
+	0xb8, 0x7a, 0xb6, 0x93, 0xf5, 0xe2, 0xa2, 0xb5, 0x71, 0xd5, 0xeb, 0x30, 0x87, 0x8e, 0x1b, 0xa8,
+	0x42, 0x90, 0x65, 0x38, 0xe8, 0x98, 0x18, 0xe8, 0x90, 0x06, 0x93, 0x6d, 0xb1, 0x00, 0xca, 0xe8,
+	0xd3, 0x72, 0xf6, 0x21, 0x3a, 0x26, 0xb7, 0xe8, 0x5c, 0xd9, 0x52, 0x5f, 0x84, 0x99, 0x4a, 0xd3,
+	0x63, 0x51, 0xb7, 0xeb, 0x99, 0x4e, 0xa5, 0x6a, 0x10, 0xf7, 0x00, 0x39, 0x2c, 0x2e, 0xc6, 0x75,
+	0x55, 0xcc, 0xad, 0xb3, 0xa9, 0x1d, 0x3a, 0xa3, 0xfd, 0x59, 0x16, 0xe6, 0x63, 0xda, 0x0a, 0x03,
+	0x85, 0x74, 0x51, 0x4e, 0xa1, 0x4b, 0x19, 0x26, 0xda, 0x1e, 0xd0, 0x6a, 0x20, 0x61, 0x98, 0x8b,
+	0xdd, 0x88, 0xed, 0xb4, 0x1a, 0x48, 0x1f, 0x3f, 0x0a, 0xfc, 0x52, 0x35, 0x98, 0x48, 0xb2, 0xc6,
+	0x98, 0x13, 0xb0, 0xc2, 0xd7, 0x61, 0xa1, 0xe1, 0xa1, 0x43, 0xdb, 0x6d, 0x62, 0x83, 0xe5, 0x24,
+	0x64, 0xb5, 0xe1, 0x07, 0x19, 0xfc, 0x9c, 0x04, 0xd8, 0xe6, 0xf3, 0x12, 0xf5, 0x0a, 0x4c, 0xb3,
+	0x48, 0xe0, 0x6e, 0xeb, 0x23, 0x0d, 0x31, 0xa4, 0x3c, 0x9d, 0xba, 0x4d, 0x67, 0x24, 0xf8, 0x06,
+	0x00, 0xf3, 0x68, 0x76, 0x36, 0x29, 0x0c, 0x27, 0x69, 0xe5, 0x1f, 0x5d, 0xa8, 0x62, 0xd4, 0x79,
+	0xdf, 0xa4, 0x3f, 0xf4, 0x51, 0x22, 0xff, 0x54, 0xb7, 0x60, 0x0a, 0x13, 0xbb, 0x72, 0xd0, 0x32,
+	0x02, 0xb4, 0x46, 0xfa, 0xa0, 0x35, 0xc9, 0xd1, 0xfd, 0x01, 0xf5, 0x7b, 0xf0, 0x7c, 0x8c, 0xa2,
+	0x81, 0x2b, 0x55, 0x64, 0x35, 0x6b, 0xc8, 0x20, 0x2e, 0xb7, 0x0a, 0xcb, 0x7e, 0x6e, 0x93, 0x14,
+	0xc6, 0x7a, 0x8b, 0xc3, 0x4b, 0x11, 0x36, 0xdb, 0x82, 0xe0, 0x8e, 0xcb, 0x8c, 0xb8, 0xc3, 0xa9,
+	0x75, 0xf4, 0xc1, 0x89, 0x4e, 0x3e, 0xa8, 0x7e, 0x1b, 0x72, 0xbe, 0x7b, 0xb0, 0x0d, 0xb6, 0x30,
+	0xc9, 0x92, 0x65, 0xf2, 0x1e, 0xe1, 0xe7, 0xcc, 0x98, 0xcb, 0x71, 0xef, 0xf5, 0x5d, 0x8d, 0xfd,
+	0x54, 0xdf, 0x86, 0xc9, 0x10, 0xf1, 0x26, 0x2e, 0xe4, 0x19, 0xf5, 0x52, 0x87, 0x54, 0x9c, 0x48,
+	0xb6, 0x89, 0xf5, 0x5c, 0x90, 0x6e, 0x13, 0xab, 0xef, 0xc1, 0xd4, 0x21, 0xf2, 0x30, 0x4d, 0x96,
+	0xfc, 0x50, 0x67, 0x23, 0x5c, 0x98, 0x62, 0xa6, 0x7c, 0xb1, 0x94, 0x72, 0x2a, 0xa7, 0x3c, 0x1e,
+	0x71, 0xc4, 0xbb, 0x12, 0x4f, 0xcf, 0x1f, 0x46, 0x46, 0xd4, 0x6f, 0xc2, 0x59, 0x1b, 0x1b, 0xdc,
+	0xe4, 0xc1, 0x65, 0x44, 0x0e, 0x0d, 0x54, 0xab, 0xa0, 0x2e, 0x2b, 0xab, 0x59, 0xbd, 0x60, 0xe3,
+	0xed, 0xf0, 0xaa, 0xdc, 0xe2, 0xf3, 0xea, 0xd7, 0x60, 0x3e, 0xe6, 0xc9, 0xe4, 0x98, 0xa5, 0xbb,
+	0x69, 0x9e, 0x40, 0xc2, 0xde, 0xbc, 0x73, 0xec, 0x94, 0xad, 0x7b, 0x83, 0xd9, 0x6c, 0x7e, 0xf4,
+	0xde, 0x60, 0x76, 0x34, 0x0f, 0xf7, 0x06, 0xb3, 0x90, 0x1f, 0xbb, 0x37, 0x98, 0x1d, 0xcf, 0x4f,
+	0xdc, 0x1b, 0xcc, 0xe6, 0xf2, 0x93, 0xda, 0x7f, 0x29, 0x30, 0xbf, 0xe5, 0xd6, 0x6a, 0xbf, 0x22,
+	0xb9, 0xf1, 0xdf, 0x46, 0xa0, 0x10, 0x57, 0xf7, 0xab, 0xe4, 0xf8, 0x55, 0x72, 0x7c, 0xe2, 0xc9,
+	0x71, 0xbc, 0x63, 0x72, 0x4c, 0x4c, 0x33, 0xb9, 0x27, 0x96, 0x66, 0x7e, 0x39, 0x73, 0x6f, 0x4a,
+	0x72, 0x9b, 0xea, 0x2f, 0xb9, 0x4d, 0xe4, 0x73, 0xda, 0xef, 0x29, 0xb0, 0xa4, 0x23, 0x8c, 0x48,
+	0x24, 0x95, 0x7e, 0x01, 0xa9, 0x4d, 0x2b, 0xc2, 0xd9, 0x64, 0x51, 0x78, 0xda, 0xd1, 0x7e, 0x36,
+	0x00, 0xcb, 0x3a, 0xaa, 0xb8, 0x9e, 0x15, 0x3c, 0x10, 0x8b, 0x40, 0xed, 0x43, 0xe0, 0x77, 0x40,
+	0x8d, 0x5f, 0x8d, 0xfa, 0x97, 0x7c, 0x2a, 0x76, 0x27, 0x52, 0xcf, 0xc3, 0x98, 0x1f, 0x4d, 0x7e,
+	0x0a, 0x02, 0x39, 0x54, 0xb6, 0xd4, 0x79, 0x18, 0x61, 0x91, 0xe7, 0xe7, 0x9b, 0x61, 0xfa, 0xb3,
+	0x6c, 0xa9, 0xe7, 0x00, 0xe4, 0xb5, 0x57, 0xa4, 0x95, 0x51, 0x7d, 0x54, 0x8c, 0x94, 0x2d, 0xf5,
+	0x7d, 0x18, 0x6f, 0xb8, 0xb5, 0x9a, 0x7f, 0x6b, 0xe5, 0x19, 0xe5, 0xb5, 0xae, 0xb7, 0x56, 0x9a,
+	0xc2, 0x83, 0xc6, 0x0a, 0xae, 0xad, 0x3e, 0x46, 0x49, 0x8a, 0x1f, 0xda, 0x5f, 0x65, 0x61, 0x25,
+	0xc5, 0xb8, 0x22, 0xf3, 0xc7, 0x12, 0xb6, 0x72, 0xe2, 0x84, 0x9d, 0x9a, 0x8c, 0x07, 0x52, 0x93,
+	0xf1, 0x0b, 0xa0, 0x4a, 0x9b, 0x5a, 0xd1, 0x84, 0x9f, 0xf7, 0x67, 0x24, 0xf4, 0x2a, 0xe4, 0x3b,
+	0x24, 0xfb, 0x1c, 0x0e, 0xd3, 0x8d, 0xed, 0x21, 0x43, 0xf1, 0x3d, 0x24, 0x70, 0xe3, 0x1e, 0x0e,
+	0xdf, 0xb8, 0x5f, 0x85, 0x82, 0x48, 0xae, 0x81, 0xfb, 0xb6, 0x38, 0xb1, 0x8c, 0xb0, 0x13, 0xcb,
+	0x1c, 0x9f, 0x6f, 0xdf, 0xa1, 0xf9, 0xac, 0xba, 0x1f, 0x70, 0x48, 0xee, 0x1e, 0xb4, 0x58, 0xc0,
+	0xef, 0x9f, 0x5f, 0xef, 0x96, 0xe8, 0x76, 0x3c, 0xd3, 0xc1, 0x36, 0x72, 0x42, 0xb7, 0x44, 0x56,
+	0x31, 0xc8, 0x1f, 0x45, 0x46, 0xd4, 0x7d, 0x38, 0x97, 0x50, 0x14, 0x08, 0xec, 0x2e, 0xa3, 0x7d,
+	0xec, 0x2e, 0x8b, 0x31, 0xff, 0xf7, 0xe7, 0x68, 0x14, 0x86, 0x72, 0xfc, 0x18, 0xcb, 0xf1, 0x63,
+	0xbb, 0x81, 0xe4, 0x7e, 0x07, 0x72, 0xed, 0x45, 0x64, 0xc5, 0x88, 0xf1, 0x1e, 0x8b, 0x11, 0x13,
+	0x3e, 0x1e, 0x9d, 0x51, 0x37, 0x60, 0x5c, 0xae, 0x2f, 0x23, 0x33, 0xd1, 0x23, 0x99, 0x31, 0x81,
+	0xc5, 0x88, 0xb8, 0x30, 0x42, 0x2b, 0x9e, 0x7c, 0x83, 0xc9, 0xac, 0x8e, 0x5d, 0x7b, 0xab, 0xd4,
+	0x53, 0x75, 0xb9, 0xd4, 0x35, 0x66, 0x4a, 0x6f, 0x72, 0xba, 0xb7, 0x1c, 0xe2, 0xb5, 0x74, 0xc9,
+	0x85, 0xfa, 0xb0, 0x20, 0x66, 0x60, 0xfb, 0x43, 0x64, 0xec, 0xb6, 0x08, 0xc2, 0x6c, 0x03, 0xca,
+	0xe8, 0x79, 0x31, 0xb3, 0x6d, 0x7f, 0x88, 0xd6, 0xe9, 0xb8, 0xfa, 0x12, 0xcc, 0xe3, 0xe6, 0xfe,
+	0x3e, 0x62, 0x85, 0x8a, 0x50, 0x99, 0x85, 0xed, 0x2a, 0x59, 0x7d, 0x46, 0x4c, 0x87, 0x8a, 0x29,
+	0x8b, 0xef, 0xc3, 0x78, 0x90, 0xbb, 0x9a, 0x87, 0xcc, 0x01, 0x6a, 0x89, 0x9c, 0x48, 0xff, 0x54,
+	0x6f, 0xc0, 0xd0, 0xa1, 0x59, 0x6b, 0x76, 0x38, 0x79, 0xb1, 0x22, 0x70, 0x30, 0x8e, 0x29, 0xb5,
+	0x96, 0xce, 0x51, 0x6e, 0x0c, 0xbc, 0xaa, 0xf0, 0xbd, 0x24, 0x90, 0x99, 0x6f, 0x56, 0x88, 0x7d,
+	0x68, 0x93, 0xd6, 0x57, 0x99, 0xb9, 0x87, 0xcc, 0x1c, 0x34, 0x56, 0xe7, 0xcc, 0xfc, 0x3b, 0x83,
+	0x32, 0x33, 0x27, 0x1a, 0x57, 0x64, 0xe6, 0x87, 0x30, 0x19, 0xc9, 0x89, 0x22, 0x37, 0x5f, 0x0a,
+	0x8b, 0x12, 0xc8, 0x1c, 0xfc, 0x24, 0xd4, 0x62, 0x99, 0x4d, 0xcf, 0x85, 0xf3, 0x66, 0x2c, 0xaa,
+	0x06, 0x4e, 0x12, 0x55, 0x81, 0x64, 0x99, 0x09, 0x27, 0x4b, 0x04, 0x45, 0x79, 0x18, 0x14, 0x43,
+	0x46, 0x24, 0x1b, 0x0c, 0xf6, 0xc8, 0x70, 0x49, 0xd0, 0xb9, 0xc9, 0xc9, 0x6c, 0x87, 0x72, 0xc3,
+	0x03, 0x98, 0xaa, 0x22, 0xd3, 0x23, 0xbb, 0xc8, 0x24, 0x86, 0x85, 0x88, 0x69, 0xd7, 0x70, 0x61,
+	0xa8, 0xc7, 0xc2, 0x5e, 0xde, 0x47, 0xdd, 0xe4, 0x98, 0xf1, 0xed, 0x6f, 0xf8, 0xc4, 0xdb, 0xdf,
+	0x95, 0x80, 0xab, 0xfb, 0x21, 0xc0, 0xf6, 0x89, 0xd1, 0xb6, 0xff, 0x3e, 0x94, 0x13, 0xda, 0x4f,
+	0x14, 0xb8, 0xc0, 0xd7, 0x3a, 0x94, 0x6b, 0x44, 0xd9, 0xb1, 0xaf, 0x20, 0x73, 0x21, 0x2f, 0x8a,
+	0x9d, 0x28, 0x52, 0x05, 0xdf, 0xec, 0xea, 0xb5, 0x3d, 0x88, 0xa0, 0x4f, 0x4a, 0xea, 0xd2, 0x81,
+	0xff, 0x48, 0x81, 0x8b, 0xe9, 0x88, 0xc2, 0x87, 0x71, 0x7b, 0xa7, 0x96, 0xb5, 0x7f, 0xe1, 0xc4,
+	0x77, 0x9f, 0x54, 0x36, 0xa6, 0x77, 0xa2, 0xd0, 0x80, 0xf6, 0x17, 0x0a, 0x2c, 0xf3, 0x1f, 0x21,
+	0x3c, 0x5a, 0x1f, 0xee, 0xcb, 0xac, 0x55, 0xc8, 0xed, 0x31, 0x9c, 0x88, 0x51, 0x6f, 0x9e, 0xc4,
+	0xa8, 0x21, 0xee, 0xfa, 0xc4, 0x5e, 0xf0, 0xa7, 0x76, 0x01, 0x56, 0x52, 0x50, 0x84, 0x5a, 0x3f,
+	0x51, 0x40, 0x8b, 0x67, 0x8d, 0xbb, 0xd2, 0xa3, 0xfb, 0x50, 0xac, 0x11, 0x8c, 0xa1, 0xb0, 0x6e,
+	0x1b, 0x3d, 0xe8, 0xd6, 0x4d, 0x84, 0x40, 0x98, 0x49, 0x05, 0xb7, 0xe0, 0x42, 0x2a, 0x9e, 0x70,
+	0x97, 0x67, 0x21, 0x5f, 0x31, 0x9d, 0x0a, 0xf2, 0x93, 0x2f, 0xe2, 0xf2, 0x67, 0xf5, 0x49, 0x3e,
+	0xae, 0xcb, 0xe1, 0x60, 0xf8, 0x04, 0x69, 0x7e, 0x41, 0xe1, 0x93, 0x26, 0x42, 0x3c, 0x7c, 0x9e,
+	0x81, 0x8b, 0xe9, 0x78, 0x71, 0x47, 0x0e, 0x02, 0xfe, 0xff, 0x3b, 0x72, 0x47, 0xee, 0x9d, 0x1d,
+	0x39, 0x09, 0x45, 0xa8, 0xf5, 0x97, 0xcc, 0x91, 0xe3, 0xfa, 0xb3, 0x15, 0xee, 0x4b, 0xb1, 0xdf,
+	0x80, 0x5c, 0xd8, 0x5f, 0xfa, 0xf0, 0xe2, 0x6e, 0xfc, 0xf5, 0x89, 0x90, 0xcb, 0x69, 0x97, 0x92,
+	0xfd, 0xcd, 0x47, 0x12, 0xca, 0xfd, 0xed, 0x00, 0x14, 0xb7, 0xed, 0x7d, 0xc7, 0xac, 0x9d, 0xe6,
+	0x51, 0x73, 0x0f, 0x72, 0x98, 0x11, 0x89, 0x28, 0xf6, 0x7a, 0xf7, 0x57, 0xcd, 0x54, 0xde, 0xfa,
+	0x04, 0x27, 0x2b, 0x45, 0xb1, 0x61, 0x09, 0x1d, 0x13, 0xe4, 0x51, 0x4e, 0x09, 0xe7, 0xb4, 0x4c,
+	0xbf, 0xe7, 0xb4, 0x05, 0x49, 0x2d, 0x36, 0xa5, 0x96, 0x60, 0xba, 0x52, 0xb5, 0x6b, 0x56, 0x9b,
+	0x8f, 0xeb, 0xd4, 0x5a, 0xec, 0x50, 0x90, 0xd5, 0xa7, 0xd8, 0x94, 0x44, 0x7a, 0xc3, 0xa9, 0xb5,
+	0xb4, 0x15, 0x38, 0xdf, 0x51, 0x17, 0x61, 0xeb, 0xff, 0x56, 0xe0, 0xb2, 0x80, 0xb1, 0x49, 0xf5,
+	0xd4, 0x2f, 0xc9, 0x1f, 0x29, 0xb0, 0x20, 0xac, 0x7e, 0x64, 0x93, 0xaa, 0x91, 0xf4, 0xac, 0x7c,
+	0xb7, 0xd7, 0x05, 0xe8, 0x26, 0x90, 0x3e, 0x87, 0xc3, 0x80, 0x52, 0xd0, 0xe0, 0x33, 0x60, 0x26,
+	0xf2, 0x0c, 0x78, 0x13, 0x56, 0xbb, 0x93, 0x4f, 0x7f, 0x10, 0xfc, 0x6b, 0x05, 0xce, 0xeb, 0xa8,
+	0xee, 0x1e, 0x22, 0x4e, 0xe9, 0x84, 0xd5, 0xef, 0xa7, 0x77, 0xae, 0x0f, 0x9f, 0xce, 0x33, 0x91,
+	0xd3, 0xb9, 0xa6, 0xc1, 0x72, 0x67, 0xf1, 0x85, 0x5f, 0xfc, 0xe3, 0x00, 0xac, 0xec, 0x20, 0xaf,
+	0x6e, 0x3b, 0x26, 0x41, 0xa7, 0xf1, 0x08, 0x17, 0xa6, 0x88, 0xa4, 0x13, 0x71, 0x84, 0xf5, 0xae,
+	0x8e, 0xd0, 0x55, 0x02, 0x3d, 0xef, 0x13, 0xff, 0x25, 0x88, 0xc7, 0x8b, 0xa0, 0xa5, 0x69, 0x24,
+	0x4c, 0xff, 0xc7, 0x0a, 0x14, 0x37, 0x51, 0x0d, 0x9d, 0xce, 0xee, 0x4f, 0xcd, 0xbb, 0x68, 0x56,
+	0xe9, 0x28, 0x9e, 0x50, 0xe1, 0xe3, 0x01, 0x38, 0xc7, 0xaa, 0x96, 0xa7, 0xec, 0x4a, 0xf1, 0x28,
+	0x8d, 0xbe, 0xbb, 0x52, 0x52, 0x39, 0xeb, 0xe3, 0x8c, 0xa8, 0x94, 0xe3, 0x3b, 0xa0, 0x4a, 0x26,
+	0x66, 0xa3, 0x51, 0x6b, 0xf1, 0x1b, 0x4c, 0x26, 0x5a, 0x94, 0x4e, 0x2a, 0x79, 0xeb, 0x9c, 0x0e,
+	0x43, 0x63, 0x77, 0x99, 0xbc, 0x17, 0x19, 0xa1, 0x3d, 0x07, 0x9d, 0x84, 0x49, 0x4f, 0x31, 0x7f,
+	0x98, 0x81, 0x4b, 0x42, 0x44, 0xbe, 0x3d, 0x9e, 0xc6, 0x90, 0xf5, 0x0e, 0x5b, 0xfc, 0xed, 0x1e,
+	0x2c, 0xd9, 0x83, 0x08, 0x91, 0x5d, 0x5e, 0x7d, 0x2d, 0x10, 0x80, 0xa2, 0xdd, 0x25, 0x5e, 0x91,
+	0x2c, 0x48, 0x90, 0xb2, 0x84, 0x90, 0xb5, 0xc4, 0x2e, 0xf1, 0x3b, 0xf8, 0xf4, 0xe3, 0x77, 0xa8,
+	0x53, 0xfc, 0xae, 0xc2, 0x33, 0xdd, 0x2c, 0x22, 0x02, 0xe0, 0x1f, 0x14, 0x58, 0x92, 0x97, 0xee,
+	0xe0, 0x7d, 0xe4, 0x17, 0x62, 0x7b, 0xb8, 0x0e, 0x73, 0x36, 0x36, 0x12, 0x1a, 0x71, 0xd8, 0xda,
+	0x64, 0xf5, 0x69, 0x1b, 0xdf, 0x8e, 0x76, 0xd8, 0xd0, 0x77, 0x88, 0x64, 0x85, 0x84, 0xc6, 0xff,
+	0x33, 0x00, 0x17, 0xf9, 0xfd, 0x64, 0x83, 0xda, 0xcd, 0xe7, 0x76, 0x92, 0xdb, 0xc4, 0xd3, 0x53,
+	0x7d, 0x05, 0xc6, 0xdb, 0x2e, 0xd9, 0x7e, 0x0f, 0xf5, 0xc7, 0xca, 0x96, 0xfa, 0x2e, 0x4c, 0xcb,
+	0xcb, 0x86, 0x75, 0x1a, 0xbf, 0x53, 0x7d, 0x2a, 0x6d, 0xf6, 0x5b, 0xfe, 0x35, 0x89, 0xd5, 0xc1,
+	0x59, 0x41, 0x6a, 0xa8, 0x9f, 0x82, 0xd4, 0x64, 0x1b, 0x9d, 0x0d, 0x68, 0x97, 0xe1, 0x52, 0x17,
+	0xab, 0x8b, 0xf5, 0xf9, 0x91, 0x02, 0xcb, 0x9b, 0x08, 0x57, 0x3c, 0x7b, 0xf7, 0x54, 0xfb, 0xca,
+	0xb7, 0x61, 0xa4, 0xdf, 0x1b, 0x50, 0x37, 0xb6, 0xba, 0xa4, 0xa8, 0xfd, 0xc1, 0x20, 0xac, 0xa4,
+	0x40, 0x8b, 0x9c, 0xf9, 0x1d, 0xc8, 0xb7, 0xeb, 0xf4, 0x15, 0xd7, 0xd9, 0xb3, 0xf7, 0x45, 0x45,
+	0xe4, 0x6a, 0xb2, 0x2c, 0x89, 0x0b, 0xb4, 0xc1, 0x10, 0xf5, 0x49, 0x14, 0x1e, 0x50, 0xf7, 0x61,
+	0x3e, 0xe1, 0x39, 0x80, 0x3d, 0x3e, 0x70, 0x85, 0xd7, 0xfa, 0x60, 0xc2, 0x9e, 0x1c, 0x66, 0x8f,
+	0x92, 0x86, 0xe9, 0xd6, 0xd3, 0x40, 0x8e, 0x65, 0x3b, 0xfb, 0x86, 0xc9, 0xaf, 0x43, 0x36, 0xc2,
+	0x85, 0x0c, 0x2b, 0xb4, 0x5f, 0xe9, 0xcc, 0x63, 0x8b, 0xe3, 0xc8, 0x1b, 0x14, 0xe3, 0x30, 0xd5,
+	0x08, 0x0d, 0xda, 0x08, 0xab, 0xdf, 0x85, 0xbc, 0xa4, 0xce, 0x12, 0x99, 0xc7, 0x3a, 0x1b, 0x28,
+	0xed, 0xeb, 0x5d, 0x69, 0x87, 0x7d, 0x89, 0x71, 0x98, 0x6c, 0x04, 0xa6, 0x3c, 0xe4, 0xa8, 0x08,
+	0x66, 0x25, 0xfd, 0x70, 0x0e, 0x19, 0xea, 0xb6, 0x12, 0x82, 0x49, 0xec, 0x65, 0x66, 0xba, 0x11,
+	0x9f, 0xd0, 0x7e, 0x3b, 0x03, 0x05, 0x5d, 0xb4, 0xfa, 0x22, 0xe6, 0xf2, 0xf8, 0xd1, 0xb5, 0x5f,
+	0x88, 0x54, 0xb2, 0x07, 0xb3, 0xe1, 0x77, 0xf8, 0x96, 0x61, 0x13, 0x54, 0x97, 0x2b, 0x78, 0xad,
+	0xaf, 0xb7, 0xf8, 0x56, 0x99, 0xa0, 0xba, 0x3e, 0x7d, 0x18, 0x1b, 0xc3, 0xea, 0xab, 0x30, 0xcc,
+	0x12, 0x05, 0x2e, 0x0c, 0xa6, 0x97, 0x68, 0x37, 0x4d, 0x62, 0xae, 0xd7, 0xdc, 0x5d, 0x5d, 0xc0,
+	0xab, 0xb7, 0x21, 0x47, 0x5b, 0x4e, 0xe9, 0xf9, 0x42, 0x50, 0x18, 0xea, 0x91, 0xc2, 0xb8, 0x83,
+	0x8e, 0xf4, 0x26, 0x4f, 0x31, 0x58, 0x5b, 0x82, 0x85, 0x84, 0x25, 0x68, 0x9f, 0x56, 0xe7, 0xb6,
+	0x5b, 0x4e, 0x65, 0xbb, 0x6a, 0x7a, 0x96, 0x78, 0x9d, 0x17, 0xcb, 0x73, 0x09, 0x72, 0xd8, 0x6d,
+	0x7a, 0x15, 0x64, 0x54, 0x6a, 0x4d, 0x4c, 0x90, 0x27, 0x16, 0x68, 0x82, 0x8f, 0x6e, 0xf0, 0x41,
+	0x75, 0x01, 0xb2, 0x98, 0x22, 0xcb, 0x27, 0xce, 0x21, 0x7d, 0x84, 0xfd, 0x2e, 0x5b, 0xea, 0x4d,
+	0x18, 0xe3, 0x6d, 0x02, 0xbc, 0xfa, 0x9d, 0xe9, 0xb1, 0xfa, 0x0d, 0x1c, 0x89, 0x0e, 0x6b, 0x0b,
+	0x30, 0x1f, 0x13, 0x4f, 0xde, 0x71, 0x86, 0x60, 0x9a, 0xce, 0xc9, 0x50, 0xea, 0xc3, 0xad, 0xce,
+	0xc3, 0x98, 0xef, 0x56, 0x42, 0xec, 0x51, 0x1d, 0xe4, 0x50, 0xd9, 0x0a, 0x9c, 0xeb, 0x32, 0x81,
+	0x73, 0x1d, 0xad, 0xfd, 0x8b, 0x35, 0x16, 0x0f, 0x2a, 0xf2, 0x27, 0x65, 0xda, 0xae, 0xf5, 0xb7,
+	0x5f, 0x59, 0xfd, 0x31, 0xd6, 0x53, 0x10, 0x7d, 0x1c, 0x1c, 0x3e, 0xd9, 0xe3, 0xe0, 0x39, 0x00,
+	0x59, 0x52, 0xb6, 0xf9, 0x33, 0x6c, 0x46, 0x1f, 0x15, 0x23, 0x65, 0x2b, 0xf6, 0xca, 0x91, 0x3d,
+	0xc9, 0x2b, 0xc7, 0x96, 0xe8, 0x0d, 0x6a, 0x57, 0x49, 0x19, 0xad, 0xd1, 0x1e, 0x69, 0x4d, 0x51,
+	0x64, 0xbf, 0xba, 0xc9, 0x28, 0xde, 0x80, 0x11, 0xf9, 0x58, 0x01, 0x3d, 0x3e, 0x56, 0x48, 0x84,
+	0xe0, 0x9b, 0xcb, 0x58, 0xf8, 0xcd, 0x65, 0x03, 0xc6, 0x99, 0x9c, 0xb2, 0x69, 0x7a, 0xbc, 0xc7,
+	0xa6, 0xe9, 0x31, 0xd6, 0x50, 0xc2, 0x7f, 0xd0, 0x2e, 0x1e, 0x46, 0x84, 0x3a, 0x00, 0xf2, 0x0c,
+	0xdb, 0x42, 0x0e, 0xa1, 0xc5, 0x87, 0x09, 0xb6, 0xf6, 0x2a, 0x9d, 0x7b, 0x9b, 0x4d, 0x95, 0xc5,
+	0x0c, 0xed, 0x84, 0x89, 0x64, 0x0f, 0xd1, 0xc3, 0x53, 0xea, 0x2f, 0x6f, 0xe8, 0xb9, 0x70, 0xce,
+	0xd0, 0xe6, 0x60, 0x26, 0xec, 0xd3, 0xc2, 0xd9, 0x69, 0x4f, 0x8b, 0xdc, 0x5a, 0xbf, 0xe0, 0x76,
+	0x3d, 0xed, 0x7f, 0x15, 0x38, 0x9b, 0x2c, 0x8b, 0xd8, 0xe1, 0xab, 0x30, 0x5d, 0x31, 0x2b, 0x55,
+	0x14, 0xfe, 0xcc, 0x42, 0x6c, 0xf2, 0xaf, 0x26, 0x5a, 0x28, 0xf0, 0xa1, 0x46, 0x90, 0x7f, 0x88,
+	0xfc, 0x14, 0x23, 0x1a, 0x1c, 0x52, 0x1d, 0x98, 0xb3, 0x4c, 0x62, 0xee, 0x9a, 0x38, 0xca, 0x6c,
+	0xe0, 0x94, 0xcc, 0x66, 0x24, 0xdd, 0xe0, 0xa8, 0xf6, 0x4f, 0x0a, 0x2c, 0x4a, 0xd5, 0xc5, 0x92,
+	0xdd, 0x75, 0x71, 0xf0, 0xe5, 0xa1, 0xea, 0x62, 0x62, 0x98, 0x96, 0xe5, 0x21, 0x8c, 0xe5, 0x2a,
+	0xd0, 0xb1, 0x9b, 0x7c, 0x28, 0x2d, 0x5d, 0x46, 0xd7, 0x30, 0xd3, 0xeb, 0x7e, 0x38, 0xf8, 0x04,
+	0xca, 0x02, 0x9f, 0x0c, 0xc0, 0x52, 0xa2, 0x66, 0x62, 0x4d, 0x2f, 0xc0, 0x04, 0x93, 0x13, 0x1b,
+	0x4e, 0xb3, 0xbe, 0x2b, 0x36, 0x83, 0x21, 0x7d, 0x9c, 0x0f, 0x3e, 0x64, 0x63, 0xea, 0x12, 0x8c,
+	0x4a, 0xe5, 0x70, 0x61, 0x60, 0x39, 0x43, 0x4b, 0x77, 0x42, 0x3b, 0xda, 0x60, 0x3b, 0xd9, 0x56,
+	0x8f, 0x2d, 0x65, 0xea, 0xb7, 0x23, 0x3e, 0x2c, 0x55, 0xc1, 0x7f, 0x34, 0xdc, 0xa0, 0x78, 0xec,
+	0xbc, 0x91, 0x73, 0x42, 0x63, 0xea, 0xcb, 0x30, 0xcf, 0x79, 0x57, 0x5c, 0x87, 0x78, 0x6e, 0xad,
+	0x86, 0x3c, 0xd9, 0xa4, 0x36, 0xc8, 0x0c, 0x39, 0xcb, 0xa6, 0x37, 0xfc, 0x59, 0xd1, 0x7b, 0x46,
+	0x73, 0x8b, 0x58, 0x2e, 0xfe, 0x10, 0x2e, 0x7f, 0x6a, 0x25, 0x98, 0xda, 0xa8, 0xb9, 0x18, 0xb1,
+	0xcd, 0x47, 0x2e, 0x71, 0x70, 0xfd, 0x94, 0xd0, 0xfa, 0x69, 0x33, 0xa0, 0x06, 0xe1, 0x45, 0xe4,
+	0xbe, 0x00, 0x93, 0x77, 0x10, 0xe9, 0x95, 0xc6, 0xfb, 0x90, 0x6f, 0x43, 0x0b, 0xd3, 0xdf, 0x07,
+	0x10, 0xe0, 0xf4, 0x14, 0xcb, 0xa3, 0xe8, 0x4a, 0x2f, 0x8e, 0xcd, 0xc8, 0x30, 0x63, 0x8d, 0x62,
+	0xf9, 0xa7, 0xf6, 0x33, 0x05, 0xa6, 0x78, 0xfd, 0x30, 0x78, 0xa3, 0xed, 0x2c, 0x92, 0x7a, 0x1b,
+	0xb2, 0x15, 0x93, 0xa0, 0x7d, 0x9a, 0xe4, 0x06, 0x58, 0x65, 0xe5, 0xb9, 0xf4, 0xca, 0x0a, 0x7f,
+	0x15, 0xe0, 0x18, 0xba, 0x8f, 0x1b, 0xec, 0x46, 0xc8, 0x84, 0xba, 0x11, 0xca, 0x30, 0x79, 0x68,
+	0x63, 0x7b, 0xd7, 0xae, 0xd9, 0xa4, 0xd5, 0xdf, 0x43, 0x79, 0xae, 0x8d, 0xc8, 0x8e, 0x0b, 0x33,
+	0xa0, 0x06, 0x75, 0x13, 0x4b, 0xf0, 0x37, 0x0a, 0x9c, 0xbb, 0x83, 0x88, 0xde, 0xfe, 0xe6, 0xec,
+	0x01, 0xff, 0xde, 0xcc, 0x3f, 0xeb, 0xdc, 0x87, 0x61, 0xd6, 0xd4, 0x43, 0x43, 0x36, 0xd3, 0xd1,
+	0x25, 0x03, 0x1f, 0xad, 0xf1, 0xf2, 0x8a, 0xff, 0x93, 0xb5, 0xff, 0xe8, 0x82, 0x06, 0x0d, 0x64,
+	0x71, 0x64, 0x62, 0xcf, 0xe0, 0xe2, 0x7c, 0x31, 0x26, 0xc6, 0xa8, 0x2f, 0xb3, 0xda, 0x85, 0x00,
+	0x91, 0x5e, 0xdb, 0x74, 0x64, 0x47, 0xc1, 0x94, 0x98, 0xda, 0xe6, 0x0e, 0xdb, 0x74, 0x88, 0xf6,
+	0xc3, 0x01, 0x28, 0x76, 0x52, 0x41, 0xb8, 0xc9, 0x6f, 0x42, 0x8e, 0x93, 0x12, 0x1f, 0xd3, 0x49,
+	0x5d, 0xde, 0xe9, 0xf1, 0x9d, 0x39, 0x9d, 0x3c, 0x77, 0x26, 0x39, 0xca, 0x1b, 0x7f, 0x26, 0x70,
+	0x70, 0x6c, 0xb1, 0x05, 0x6a, 0x1c, 0x28, 0xd8, 0x9f, 0x33, 0xc4, 0xfb, 0x73, 0x1e, 0x84, 0xfb,
+	0x73, 0x5e, 0xe9, 0xd3, 0xd6, 0xbe, 0x64, 0xed, 0x96, 0x1d, 0xed, 0x43, 0x58, 0xbe, 0x83, 0xc8,
+	0xe6, 0xfd, 0x37, 0x53, 0xd6, 0xf8, 0x91, 0xe8, 0x5f, 0xa6, 0x51, 0x24, 0x6d, 0xd3, 0x2f, 0x6f,
+	0xff, 0xb6, 0x33, 0x4a, 0xc4, 0x5f, 0x58, 0xfb, 0x58, 0x81, 0x95, 0x14, 0xe6, 0x62, 0x75, 0xde,
+	0x87, 0xa9, 0x00, 0x59, 0x76, 0xd7, 0x92, 0x42, 0x5c, 0x3f, 0x81, 0x10, 0xb4, 0x5a, 0x19, 0x1a,
+	0xc0, 0xda, 0xf7, 0x15, 0x98, 0x61, 0xbd, 0x4c, 0x32, 0xdf, 0xf7, 0x71, 0x36, 0x78, 0x23, 0x5a,
+	0x16, 0x78, 0xa9, 0x6b, 0x59, 0x20, 0x89, 0x55, 0xbb, 0x14, 0x70, 0x00, 0xb3, 0x11, 0x00, 0x61,
+	0x07, 0x1d, 0xb2, 0x91, 0x3e, 0x88, 0x97, 0xfb, 0x65, 0xc5, 0xb1, 0x75, 0x9f, 0x8e, 0xf6, 0xfb,
+	0x0a, 0xcc, 0x88, 0xba, 0x2d, 0xbf, 0xe0, 0xf4, 0xa1, 0xf9, 0x76, 0x54, 0xf3, 0xe4, 0xe6, 0xc4,
+	0xe0, 0xf7, 0x9c, 0x7c, 0x39, 0xe2, 0xec, 0xda, 0xda, 0xcf, 0xc3, 0x6c, 0x04, 0x40, 0x48, 0xfa,
+	0xe7, 0x03, 0x30, 0xcb, 0x7d, 0x25, 0xea, 0x9d, 0xb7, 0x60, 0xd0, 0x6f, 0x3e, 0xcd, 0x05, 0xef,
+	0xdf, 0x49, 0x19, 0x76, 0x13, 0x99, 0xd6, 0x7d, 0x44, 0x08, 0xf2, 0x58, 0x8b, 0x15, 0x2b, 0x5f,
+	0x33, 0xf4, 0xb4, 0xe3, 0x45, 0xfc, 0x3e, 0x97, 0x49, 0xba, 0xcf, 0xbd, 0x02, 0x05, 0xdb, 0xa1,
+	0x10, 0xf6, 0x21, 0x32, 0x90, 0xe3, 0xa7, 0x93, 0x76, 0x17, 0xd9, 0xac, 0x3f, 0x7f, 0xcb, 0x91,
+	0xc1, 0x5e, 0xb6, 0xd4, 0xe7, 0x60, 0xaa, 0x6e, 0x1e, 0xdb, 0xf5, 0x66, 0xdd, 0x68, 0x50, 0x78,
+	0xda, 0x02, 0xc8, 0xb6, 0xd4, 0x21, 0x7d, 0x52, 0x4c, 0x6c, 0x99, 0xfb, 0x88, 0x36, 0x00, 0xaa,
+	0xcf, 0xc0, 0x24, 0xeb, 0x4a, 0x65, 0x80, 0xbc, 0x9d, 0x72, 0x98, 0xb5, 0x53, 0xb2, 0x66, 0x55,
+	0x0a, 0xc6, 0x3f, 0xd9, 0xf8, 0x0f, 0xfe, 0xf1, 0x5e, 0xc8, 0x5e, 0xc2, 0x91, 0x9e, 0x90, 0xc1,
+	0x12, 0xe3, 0x72, 0xe0, 0x09, 0xc6, 0x65, 0x92, 0xae, 0x99, 0x24, 0x5d, 0xff, 0x99, 0x7e, 0x8d,
+	0xd3, 0xf4, 0xf6, 0xd1, 0x97, 0xd1, 0x3b, 0xb4, 0x45, 0x28, 0xc4, 0x95, 0x93, 0x5d, 0x1e, 0x03,
+	0x30, 0xff, 0x00, 0x7d, 0x49, 0x35, 0x7f, 0x2a, 0x71, 0xb1, 0x0e, 0x85, 0x07, 0x28, 0xd9, 0x9a,
+	0x49, 0x34, 0x94, 0x24, 0x1a, 0x3f, 0x64, 0x9f, 0x49, 0xec, 0x79, 0x08, 0x57, 0x83, 0x35, 0xbb,
+	0x7e, 0x92, 0xe7, 0xbb, 0xd1, 0xe4, 0xf9, 0xad, 0x1e, 0x93, 0x67, 0x47, 0xae, 0xed, 0x1c, 0xca,
+	0xbe, 0x9c, 0x48, 0x82, 0x13, 0x4e, 0xf3, 0x03, 0x05, 0x9e, 0xbb, 0x83, 0x1c, 0xe4, 0x99, 0x04,
+	0xdd, 0xa7, 0xd5, 0x06, 0x71, 0xa3, 0x8e, 0x84, 0xdf, 0x17, 0x71, 0x41, 0xbe, 0x02, 0xcf, 0xf7,
+	0x24, 0x99, 0xd0, 0xe4, 0x36, 0x2c, 0x85, 0xcf, 0x5e, 0xe1, 0x3a, 0xdc, 0x65, 0x98, 0xf4, 0x50,
+	0xdd, 0x25, 0xbe, 0x7f, 0xf2, 0x73, 0xc3, 0xa8, 0x9e, 0xe3, 0xc3, 0xc2, 0x41, 0xb1, 0xd6, 0x84,
+	0xb3, 0xc9, 0x74, 0x84, 0x63, 0xbc, 0x05, 0xc3, 0xfc, 0xb6, 0x26, 0xce, 0x1d, 0xaf, 0xf5, 0x78,
+	0x30, 0x14, 0xb7, 0x91, 0x28, 0x59, 0x41, 0x4c, 0xfb, 0xfb, 0x21, 0x98, 0x4b, 0x06, 0x49, 0xbb,
+	0x55, 0xbc, 0x04, 0xf3, 0x75, 0xf3, 0xd8, 0x88, 0xe6, 0xde, 0xf6, 0x87, 0x12, 0x33, 0x75, 0xf3,
+	0x38, 0x7a, 0xf2, 0xb2, 0xd4, 0x7b, 0x90, 0xe7, 0x14, 0x6b, 0x6e, 0xc5, 0xac, 0xf5, 0x57, 0x57,
+	0xe4, 0xc7, 0xe3, 0xfb, 0x14, 0x91, 0x4e, 0xa9, 0x1f, 0xc6, 0x0d, 0xcb, 0x4b, 0xec, 0x6f, 0x9e,
+	0xca, 0x30, 0x25, 0x3d, 0xb4, 0x2c, 0xfc, 0xa8, 0x1c, 0x59, 0x2b, 0xf5, 0x77, 0x15, 0x98, 0xae,
+	0x9a, 0x8e, 0xe5, 0x1e, 0x8a, 0x4b, 0x02, 0x73, 0x42, 0x7a, 0x05, 0xed, 0xa7, 0x51, 0xbf, 0x83,
+	0x00, 0x77, 0x05, 0x61, 0xff, 0xd6, 0x2c, 0x84, 0x50, 0xab, 0xb1, 0x89, 0xc5, 0xef, 0x2b, 0x30,
+	0x9d, 0x20, 0x70, 0x42, 0x5b, 0xfd, 0x7b, 0xe1, 0x63, 0xfb, 0x9d, 0x53, 0xc9, 0xb8, 0x85, 0x3c,
+	0xc1, 0x2f, 0x70, 0x8c, 0x5f, 0xfc, 0x48, 0x81, 0xf9, 0x0e, 0xc2, 0x27, 0x08, 0xa4, 0x87, 0x05,
+	0xfa, 0x46, 0x8f, 0x02, 0xc5, 0x18, 0xb0, 0x03, 0x7d, 0xe0, 0x32, 0xf1, 0x0e, 0xcc, 0x26, 0xc2,
+	0xa8, 0xaf, 0xc3, 0x59, 0x7f, 0xcd, 0x92, 0x1c, 0x57, 0x61, 0x8e, 0xbb, 0x20, 0x61, 0x62, 0xde,
+	0xab, 0xfd, 0x58, 0x81, 0xe5, 0x6e, 0xf6, 0xa0, 0x5f, 0xec, 0x98, 0x95, 0x03, 0x64, 0x45, 0xc8,
+	0x8e, 0xb1, 0x41, 0x11, 0x06, 0xef, 0xc1, 0x62, 0x00, 0x26, 0x7a, 0x7b, 0xee, 0xb5, 0xaf, 0x7d,
+	0xde, 0x27, 0xf9, 0x28, 0x7c, 0x8d, 0xfe, 0x91, 0x02, 0xc5, 0xb7, 0x1a, 0xd6, 0x29, 0x7b, 0x87,
+	0xde, 0x83, 0x91, 0x8e, 0x4d, 0x89, 0x29, 0xbb, 0x43, 0x3a, 0xe3, 0xf6, 0x06, 0xf1, 0x91, 0x02,
+	0xe7, 0x3b, 0xc2, 0xfa, 0xb7, 0xae, 0xe8, 0x6d, 0x63, 0xf3, 0x74, 0x32, 0xc4, 0xee, 0x1e, 0xdf,
+	0xf2, 0x37, 0xd1, 0xcd, 0x96, 0x63, 0xd6, 0xed, 0x8a, 0x78, 0x98, 0xec, 0xb9, 0x22, 0x18, 0xd8,
+	0xe8, 0x22, 0x14, 0x04, 0x87, 0x75, 0x56, 0xbc, 0x78, 0xa3, 0x81, 0x9c, 0xc0, 0xe3, 0x67, 0x33,
+	0x7c, 0xcb, 0xe9, 0xc6, 0xe3, 0xc7, 0xbc, 0x7c, 0x90, 0x48, 0x44, 0x98, 0xea, 0x63, 0x05, 0xf2,
+	0x81, 0xfa, 0x1c, 0x9b, 0x14, 0x1b, 0xc5, 0xbb, 0xbd, 0x57, 0x10, 0x52, 0x38, 0x04, 0x8a, 0x78,
+	0x6c, 0x9c, 0xe7, 0xa4, 0x49, 0x27, 0x3c, 0xba, 0xf8, 0x3d, 0x98, 0x49, 0x02, 0x4c, 0x88, 0xff,
+	0x9e, 0xea, 0x08, 0x91, 0x92, 0x58, 0x92, 0x7c, 0x81, 0xd0, 0x77, 0x61, 0x5a, 0x96, 0xdf, 0xee,
+	0xbb, 0xa6, 0xd5, 0x47, 0x5d, 0x57, 0xec, 0x67, 0xfe, 0xed, 0xd5, 0x68, 0xc8, 0xd2, 0x8e, 0x38,
+	0x6f, 0xd2, 0xfd, 0x4c, 0x3a, 0x14, 0x0d, 0x77, 0xc6, 0x44, 0xfb, 0x75, 0x98, 0x09, 0x33, 0x14,
+	0xab, 0x71, 0x33, 0xb2, 0x57, 0x3f, 0xdb, 0xed, 0x5d, 0xa1, 0x4d, 0x42, 0x20, 0xae, 0x37, 0x3e,
+	0xfd, 0xac, 0x78, 0xe6, 0xa7, 0x9f, 0x15, 0xcf, 0xfc, 0xfc, 0xb3, 0xa2, 0xf2, 0x5b, 0x8f, 0x8b,
+	0xca, 0x9f, 0x3e, 0x2e, 0x2a, 0x7f, 0xf7, 0xb8, 0xa8, 0x7c, 0xfa, 0xb8, 0xa8, 0xfc, 0xeb, 0xe3,
+	0xa2, 0xf2, 0xef, 0x8f, 0x8b, 0x67, 0x7e, 0xfe, 0xb8, 0xa8, 0x7c, 0xf2, 0x79, 0xf1, 0xcc, 0xa7,
+	0x9f, 0x17, 0xcf, 0xfc, 0xf4, 0xf3, 0xe2, 0x99, 0x77, 0x6f, 0xec, 0xbb, 0x6d, 0x56, 0xb6, 0x9b,
+	0xfa, 0x4f, 0xcc, 0x7e, 0x2d, 0x3c, 0xb2, 0x3b, 0xcc, 0x32, 0xcd, 0xf5, 0xff, 0x1b, 0x00, 0x13,
+	0x7b, 0x56, 0xdb, 0x03, 0x4d, 0x00, 0x00,
 }
 
 func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
@@ -5162,6 +5598,9 @@ func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
 	} else if that1.FirstWorkflowTaskBackoff != nil {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
 func (this *StartWorkflowExecutionResponse) Equal(that interface{}) bool {
@@ -5547,6 +5986,12 @@ func (this *RecordWorkflowTaskStartedResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.HistorySizeBytes != that1.HistorySizeBytes {
+		return false
+	}
+	if this.SuggestContinueAsNew != that1.SuggestContinueAsNew {
+		return false
+	}
 	return true
 }
 func (this *RecordActivityTaskStartedRequest) Equal(that interface{}) bool {
@@ -6011,6 +6456,9 @@ func (this *SignalWithStartWorkflowExecutionRequest) Equal(that interface{}) boo
 	if !this.SignalWithStartRequest.Equal(that1.SignalWithStartRequest) {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
 func (this *SignalWithStartWorkflowExecutionResponse) Equal(that interface{}) bool {
@@ -6215,6 +6663,9 @@ func (this *ResetWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if !this.ResetRequest.Equal(that1.ResetRequest) {
 		return false
 	}
+	if this.ResetReapplyType != that1.ResetReapplyType {
+		return false
+	}
 	return true
 }
 func (this *ResetWorkflowExecutionResponse) Equal(that interface{}) bool {
@@ -7001,6 +7452,9 @@ func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
 	if this.ClusterName != that1.ClusterName {
 		return false
 	}
+	if this.ClusterShardCount != that1.ClusterShardCount {
+		return false
+	}
 	return true
 }
 func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
@@ -7639,11 +8093,216 @@ func (this *ShardReplicationStatusPerCluster) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UpdateWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(UpdateWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.Request.Equal(that1.Request) {
+		return false
+	}
+	return true
+}
+func (this *UpdateWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(UpdateWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Response.Equal(that1.Response) {
+		return false
+	}
+	return true
+}
+func (this *RefreshDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RefreshDynamicConfigRequest)
+	if !ok {
+		that2, ok := that.(RefreshDynamicConfigRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HostAddress != that1.HostAddress {
+		return false
+	}
+	return true
+}
+func (this *RefreshDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RefreshDynamicConfigResponse)
+	if !ok {
+		that2, ok := that.(RefreshDynamicConfigResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *GetOpenExecutionCountsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetOpenExecutionCountsRequest)
+	if !ok {
+		that2, ok := that.(GetOpenExecutionCountsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HostAddress != that1.HostAddress {
+		return false
+	}
+	return true
+}
+func (this *GetOpenExecutionCountsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetOpenExecutionCountsResponse)
+	if !ok {
+		that2, ok := that.(GetOpenExecutionCountsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.NamespaceCounts) != len(that1.NamespaceCounts) {
+		return false
+	}
+	for i := range this.NamespaceCounts {
+		if !this.NamespaceCounts[i].Equal(that1.NamespaceCounts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GetShardLoadRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetShardLoadRequest)
+	if !ok {
+		that2, ok := that.(GetShardLoadRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HostAddress != that1.HostAddress {
+		return false
+	}
+	if this.MaxWorkflowsPerShard != that1.MaxWorkflowsPerShard {
+		return false
+	}
+	return true
+}
+func (this *GetShardLoadResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetShardLoadResponse)
+	if !ok {
+		that2, ok := that.(GetShardLoadResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Shards) != len(that1.Shards) {
+		return false
+	}
+	for i := range this.Shards {
+		if !this.Shards[i].Equal(that1.Shards[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *StartWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 14)
 	s = append(s, "&historyservice.StartWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.StartRequest != nil {
@@ -7662,6 +8321,7 @@ func (this *StartWorkflowExecutionRequest) GoString() string {
 		s = append(s, "LastCompletionResult: "+fmt.Sprintf("%#v", this.LastCompletionResult)+",\n")
 	}
 	s = append(s, "FirstWorkflowTaskBackoff: "+fmt.Sprintf("%#v", this.FirstWorkflowTaskBackoff)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -7815,7 +8475,7 @@ func (this *RecordWorkflowTaskStartedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 19)
 	s = append(s, "&historyservice.RecordWorkflowTaskStartedResponse{")
 	if this.WorkflowType != nil {
 		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
@@ -7848,6 +8508,8 @@ func (this *RecordWorkflowTaskStartedResponse) GoString() string {
 	if this.Queries != nil {
 		s = append(s, "Queries: "+mapStringForQueries+",\n")
 	}
+	s = append(s, "HistorySizeBytes: "+fmt.Sprintf("%#v", this.HistorySizeBytes)+",\n")
+	s = append(s, "SuggestContinueAsNew: "+fmt.Sprintf("%#v", this.SuggestContinueAsNew)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8058,12 +8720,13 @@ func (this *SignalWithStartWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&historyservice.SignalWithStartWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.SignalWithStartRequest != nil {
 		s = append(s, "SignalWithStartRequest: "+fmt.Sprintf("%#v", this.SignalWithStartRequest)+",\n")
 	}
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8152,12 +8815,13 @@ func (this *ResetWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&historyservice.ResetWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.ResetRequest != nil {
 		s = append(s, "ResetRequest: "+fmt.Sprintf("%#v", this.ResetRequest)+",\n")
 	}
+	s = append(s, "ResetReapplyType: "+fmt.Sprintf("%#v", this.ResetReapplyType)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8503,12 +9167,13 @@ func (this *GetReplicationMessagesRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&historyservice.GetReplicationMessagesRequest{")
 	if this.Tokens != nil {
 		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
 	}
 	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "ClusterShardCount: "+fmt.Sprintf("%#v", this.ClusterShardCount)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -8806,54 +9471,158 @@ func (this *ShardReplicationStatusPerCluster) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringRequestResponse(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *UpdateWorkflowExecutionRequest) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.UpdateWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *UpdateWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.UpdateWorkflowExecutionResponse{")
+	if this.Response != nil {
+		s = append(s, "Response: "+fmt.Sprintf("%#v", this.Response)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *StartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *RefreshDynamicConfigRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.RefreshDynamicConfigRequest{")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FirstWorkflowTaskBackoff != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.FirstWorkflowTaskBackoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x4a
+func (this *RefreshDynamicConfigResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.LastCompletionResult != nil {
-		{
-			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.RefreshDynamicConfigResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetOpenExecutionCountsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.GetOpenExecutionCountsRequest{")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetOpenExecutionCountsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.GetOpenExecutionCountsResponse{")
+	keysForNamespaceCounts := make([]string, 0, len(this.NamespaceCounts))
+	for k, _ := range this.NamespaceCounts {
+		keysForNamespaceCounts = append(keysForNamespaceCounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNamespaceCounts)
+	mapStringForNamespaceCounts := "map[string]*v111.OpenExecutionCounts{"
+	for _, k := range keysForNamespaceCounts {
+		mapStringForNamespaceCounts += fmt.Sprintf("%#v: %#v,", k, this.NamespaceCounts[k])
+	}
+	mapStringForNamespaceCounts += "}"
+	if this.NamespaceCounts != nil {
+		s = append(s, "NamespaceCounts: "+mapStringForNamespaceCounts+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetShardLoadRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.GetShardLoadRequest{")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "MaxWorkflowsPerShard: "+fmt.Sprintf("%#v", this.MaxWorkflowsPerShard)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetShardLoadResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.GetShardLoadResponse{")
+	if this.Shards != nil {
+		s = append(s, "Shards: "+fmt.Sprintf("%#v", this.Shards)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRequestResponse(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FirstWorkflowTaskBackoff != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.FirstWorkflowTaskBackoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LastCompletionResult != nil {
+		{
+			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0x42
 	}
 	if m.ContinuedFailure != nil {
@@ -9498,6 +10267,23 @@ func (m *RecordWorkflowTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if m.SuggestContinueAsNew {
+		i--
+		if m.SuggestContinueAsNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.HistorySizeBytes != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.HistorySizeBytes))
+		i--
+		dAtA[i] = 0x78
+	}
 	if len(m.Queries) > 0 {
 		for k := range m.Queries {
 			v := m.Queries[k]
@@ -10306,6 +11092,11 @@ func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []by
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.SignalWithStartRequest != nil {
 		{
 			size, err := m.SignalWithStartRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -10602,6 +11393,11 @@ func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.ResetReapplyType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ResetReapplyType))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ResetRequest != nil {
 		{
 			size, err := m.ResetRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -11796,6 +12592,11 @@ func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.ClusterShardCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ClusterShardCount))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ClusterName) > 0 {
 		i -= len(m.ClusterName)
 		copy(dAtA[i:], m.ClusterName)
@@ -12704,91 +13505,375 @@ func (m *ShardReplicationStatusPerCluster) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRequestResponse(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UpdateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UpdateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartRequest != nil {
-		l = m.StartRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ParentExecutionInfo != nil {
-		l = m.ParentExecutionInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
-	}
-	if m.WorkflowExecutionExpirationTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ContinueAsNewInitiator != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ContinueAsNewInitiator))
-	}
-	if m.ContinuedFailure != nil {
-		l = m.ContinuedFailure.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.LastCompletionResult != nil {
-		l = m.LastCompletionResult.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.FirstWorkflowTaskBackoff != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StartWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RefreshDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ExpectedNextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ExpectedNextEventId))
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.CurrentBranchToken)
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetOpenExecutionCountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOpenExecutionCountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOpenExecutionCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetOpenExecutionCountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOpenExecutionCountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOpenExecutionCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NamespaceCounts) > 0 {
+		for k := range m.NamespaceCounts {
+			v := m.NamespaceCounts[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetShardLoadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetShardLoadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetShardLoadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxWorkflowsPerShard != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaxWorkflowsPerShard))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetShardLoadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetShardLoadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetShardLoadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequestResponse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StartWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartRequest != nil {
+		l = m.StartRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ParentExecutionInfo != nil {
+		l = m.ParentExecutionInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	}
+	if m.WorkflowExecutionExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ContinueAsNewInitiator != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ContinueAsNewInitiator))
+	}
+	if m.ContinuedFailure != nil {
+		l = m.ContinuedFailure.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.LastCompletionResult != nil {
+		l = m.LastCompletionResult.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.FirstWorkflowTaskBackoff != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *StartWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetMutableStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ExpectedNextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ExpectedNextEventId))
+	}
+	l = len(m.CurrentBranchToken)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
@@ -13050,6 +14135,12 @@ func (m *RecordWorkflowTaskStartedResponse) Size() (n int) {
 			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
 		}
 	}
+	if m.HistorySizeBytes != 0 {
+		n += 1 + sovRequestResponse(uint64(m.HistorySizeBytes))
+	}
+	if m.SuggestContinueAsNew {
+		n += 3
+	}
 	return n
 }
 
@@ -13330,6 +14421,9 @@ func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
 		l = m.SignalWithStartRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -13449,6 +14543,9 @@ func (m *ResetWorkflowExecutionRequest) Size() (n int) {
 		l = m.ResetRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.ResetReapplyType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ResetReapplyType))
+	}
 	return n
 }
 
@@ -13938,6 +15035,9 @@ func (m *GetReplicationMessagesRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.ClusterShardCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ClusterShardCount))
+	}
 	return n
 }
 
@@ -14328,13 +15428,131 @@ func (m *ShardReplicationStatusPerCluster) Size() (n int) {
 	return n
 }
 
-func sovRequestResponse(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRequestResponse(x uint64) (n int) {
-	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *StartWorkflowExecutionRequest) String() string {
+func (m *UpdateWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshDynamicConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshDynamicConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetOpenExecutionCountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetOpenExecutionCountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NamespaceCounts) > 0 {
+		for k, v := range m.NamespaceCounts {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetShardLoadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.MaxWorkflowsPerShard != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaxWorkflowsPerShard))
+	}
+	return n
+}
+
+func (m *GetShardLoadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRequestResponse(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRequestResponse(x uint64) (n int) {
+	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *StartWorkflowExecutionRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
@@ -14348,6 +15566,7 @@ func (this *StartWorkflowExecutionRequest) String() string {
 		`ContinuedFailure:` + strings.Replace(fmt.Sprintf("%v", this.ContinuedFailure), "Failure", "v13.Failure", 1) + `,`,
 		`LastCompletionResult:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionResult), "Payloads", "v14.Payloads", 1) + `,`,
 		`FirstWorkflowTaskBackoff:` + strings.Replace(fmt.Sprintf("%v", this.FirstWorkflowTaskBackoff), "Duration", "types.Duration", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14496,6 +15715,8 @@ func (this *RecordWorkflowTaskStartedResponse) String() string {
 		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Queries:` + mapStringForQueries + `,`,
+		`HistorySizeBytes:` + fmt.Sprintf("%v", this.HistorySizeBytes) + `,`,
+		`SuggestContinueAsNew:` + fmt.Sprintf("%v", this.SuggestContinueAsNew) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14682,6 +15903,7 @@ func (this *SignalWithStartWorkflowExecutionRequest) String() string {
 	s := strings.Join([]string{`&SignalWithStartWorkflowExecutionRequest{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`SignalWithStartRequest:` + strings.Replace(fmt.Sprintf("%v", this.SignalWithStartRequest), "SignalWithStartWorkflowExecutionRequest", "v1.SignalWithStartWorkflowExecutionRequest", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14766,6 +15988,7 @@ func (this *ResetWorkflowExecutionRequest) String() string {
 	s := strings.Join([]string{`&ResetWorkflowExecutionRequest{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`ResetRequest:` + strings.Replace(fmt.Sprintf("%v", this.ResetRequest), "ResetWorkflowExecutionRequest", "v1.ResetWorkflowExecutionRequest", 1) + `,`,
+		`ResetReapplyType:` + fmt.Sprintf("%v", this.ResetReapplyType) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15085,6 +16308,7 @@ func (this *GetReplicationMessagesRequest) String() string {
 	s := strings.Join([]string{`&GetReplicationMessagesRequest{`,
 		`Tokens:` + repeatedStringForTokens + `,`,
 		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`ClusterShardCount:` + fmt.Sprintf("%v", this.ClusterShardCount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15379,6 +16603,102 @@ func (this *ShardReplicationStatusPerCluster) String() string {
 	}, "")
 	return s
 }
+func (this *UpdateWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "UpdateWorkflowExecutionRequest", "v114.UpdateWorkflowExecutionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkflowExecutionResponse{`,
+		`Response:` + strings.Replace(fmt.Sprintf("%v", this.Response), "UpdateWorkflowExecutionResponse", "v114.UpdateWorkflowExecutionResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshDynamicConfigRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshDynamicConfigRequest{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshDynamicConfigResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshDynamicConfigResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetOpenExecutionCountsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetOpenExecutionCountsRequest{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetOpenExecutionCountsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForNamespaceCounts := make([]string, 0, len(this.NamespaceCounts))
+	for k, _ := range this.NamespaceCounts {
+		keysForNamespaceCounts = append(keysForNamespaceCounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNamespaceCounts)
+	mapStringForNamespaceCounts := "map[string]*v111.OpenExecutionCounts{"
+	for _, k := range keysForNamespaceCounts {
+		mapStringForNamespaceCounts += fmt.Sprintf("%v: %v,", k, this.NamespaceCounts[k])
+	}
+	mapStringForNamespaceCounts += "}"
+	s := strings.Join([]string{`&GetOpenExecutionCountsResponse{`,
+		`NamespaceCounts:` + mapStringForNamespaceCounts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetShardLoadRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetShardLoadRequest{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`MaxWorkflowsPerShard:` + fmt.Sprintf("%v", this.MaxWorkflowsPerShard) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetShardLoadResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForShards := "[]*ShardLoad{"
+	for _, f := range this.Shards {
+		repeatedStringForShards += strings.Replace(fmt.Sprintf("%v", f), "ShardLoad", "v17.ShardLoad", 1) + ","
+	}
+	repeatedStringForShards += "}"
+	s := strings.Join([]string{`&GetShardLoadResponse{`,
+		`Shards:` + repeatedStringForShards + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringRequestResponse(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -15702,6 +17022,25 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17901,6 +19240,45 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySizeBytes", wireType)
+			}
+			m.HistorySizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestContinueAsNew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuggestContinueAsNew = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -19863,6 +21241,25 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -20679,16 +22076,35 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetReapplyType", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
+			m.ResetReapplyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetReapplyType |= v16.ResetReapplyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if (iNdEx + skippy) > l {
@@ -24091,6 +25507,25 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ClusterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterShardCount", wireType)
+			}
+			m.ClusterShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -26738,6 +28173,815 @@ func (m *ShardReplicationStatusPerCluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v114.UpdateWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &v114.UpdateWorkflowExecutionResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshDynamicConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshDynamicConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshDynamicConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshDynamicConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetOpenExecutionCountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetOpenExecutionCountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetOpenExecutionCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetOpenExecutionCountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetOpenExecutionCountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetOpenExecutionCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NamespaceCounts == nil {
+				m.NamespaceCounts = make(map[string]*v111.OpenExecutionCounts)
+			}
+			var mapkey string
+			var mapvalue *v111.OpenExecutionCounts
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v111.OpenExecutionCounts{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NamespaceCounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetShardLoadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetShardLoadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetShardLoadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWorkflowsPerShard", wireType)
+			}
+			m.MaxWorkflowsPerShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWorkflowsPerShard |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetShardLoadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetShardLoadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetShardLoadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shards = append(m.Shards, &v17.ShardLoad{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRequestResponse(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -54,78 +54,82 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1121 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x8b, 0x23, 0x45,
-	0x18, 0x87, 0x53, 0x17, 0x91, 0x42, 0x57, 0x6d, 0xc5, 0x8f, 0x51, 0x1b, 0x51, 0xbc, 0x66, 0xd8,
-	0x5d, 0xd1, 0xfd, 0x98, 0x75, 0xdd, 0x64, 0x66, 0x32, 0xb3, 0x3b, 0xa3, 0x4e, 0xb2, 0x28, 0x78,
-	0x91, 0x9a, 0xce, 0xbb, 0x93, 0x66, 0x7a, 0xba, 0xdb, 0xaa, 0xea, 0x68, 0x0e, 0x82, 0xe0, 0x49,
-	0x10, 0x14, 0x41, 0xf0, 0x24, 0x78, 0x52, 0x84, 0x05, 0x41, 0x10, 0x04, 0xc1, 0x93, 0xe0, 0x49,
-	0xe6, 0xb8, 0x47, 0x27, 0x73, 0xf1, 0xb8, 0x7f, 0x82, 0x24, 0x9d, 0xaa, 0x49, 0x75, 0x57, 0x67,
-	0xab, 0xaa, 0x73, 0xdb, 0xcd, 0xf4, 0xef, 0xe9, 0xa7, 0xab, 0xde, 0xae, 0x7a, 0x53, 0xc1, 0x17,
-	0x39, 0x1c, 0xa5, 0x09, 0x25, 0xd1, 0x2a, 0x03, 0x3a, 0x04, 0xba, 0x4a, 0xd2, 0x70, 0x75, 0x10,
-	0x32, 0x9e, 0xd0, 0xd1, 0xe4, 0x93, 0x30, 0x80, 0xd5, 0xe1, 0xf9, 0xd5, 0xd9, 0x3f, 0x9b, 0x29,
-	0x4d, 0x78, 0xe2, 0xbd, 0x2a, 0x42, 0xcd, 0x3c, 0xd4, 0x24, 0x69, 0xd8, 0x54, 0x43, 0xcd, 0xe1,
-	0xf9, 0x95, 0x35, 0x33, 0x36, 0x85, 0x8f, 0x32, 0x60, 0xfc, 0x43, 0x0a, 0x2c, 0x4d, 0x62, 0x36,
-	0xbb, 0xc9, 0x85, 0xbb, 0xaf, 0xe1, 0x73, 0x5b, 0xf9, 0xc5, 0xbd, 0xfc, 0x62, 0xef, 0x47, 0x84,
-	0x9f, 0xee, 0x71, 0x42, 0xf9, 0xfb, 0x09, 0x3d, 0xbc, 0x13, 0x25, 0x1f, 0x6f, 0x7c, 0x02, 0x41,
-	0xc6, 0xc3, 0x24, 0xf6, 0xd6, 0x9b, 0x46, 0x4e, 0x4d, 0x7d, 0xbc, 0x9b, 0x2b, 0xac, 0x6c, 0xd4,
-	0xa4, 0xe4, 0x0f, 0xf0, 0x72, 0xc3, 0xfb, 0x06, 0xe1, 0xc7, 0x3a, 0xc0, 0x77, 0x33, 0x4e, 0xf6,
-	0x23, 0xe8, 0x71, 0xc2, 0xc1, 0xbb, 0x66, 0x08, 0x2f, 0xe4, 0x84, 0xdb, 0x9b, 0xae, 0x71, 0x29,
-	0xf5, 0x2d, 0xc2, 0x8f, 0xbf, 0x9b, 0x44, 0x91, 0x62, 0x65, 0x8a, 0x2d, 0x06, 0x85, 0xd6, 0x75,
-	0xe7, 0xbc, 0xf4, 0xfa, 0x01, 0xe1, 0xa7, 0xba, 0xc0, 0x80, 0xf7, 0x78, 0x18, 0x1c, 0x8e, 0x6e,
-	0x13, 0x76, 0xb8, 0x97, 0x41, 0x06, 0x5e, 0xcb, 0x90, 0xad, 0x0b, 0x0b, 0xbf, 0x76, 0x2d, 0x86,
-	0x74, 0xfc, 0x05, 0xe1, 0xe7, 0xba, 0x10, 0x24, 0xb4, 0x2f, 0xa6, 0x7d, 0x72, 0xd5, 0xb4, 0x0e,
-	0xa0, 0xef, 0x75, 0x8c, 0x6f, 0x52, 0x41, 0x10, 0xb6, 0x5b, 0xf5, 0x41, 0x1a, 0xe5, 0x1b, 0x01,
-	0x0f, 0x87, 0x21, 0x1f, 0xb9, 0x2b, 0x6b, 0x08, 0x6e, 0xca, 0x5a, 0x90, 0x54, 0xfe, 0x1d, 0xe1,
-	0x17, 0xf2, 0xff, 0x2a, 0xcf, 0xd6, 0x4e, 0x8e, 0xd2, 0x08, 0x26, 0xd6, 0x37, 0xcd, 0x67, 0xb3,
-	0x12, 0x22, 0xc4, 0x6f, 0x2d, 0x85, 0x55, 0x18, 0xee, 0xd2, 0xa5, 0x9b, 0x24, 0x8c, 0xac, 0x86,
-	0xbb, 0x82, 0x60, 0x3f, 0xdc, 0x95, 0x20, 0xa9, 0xfc, 0x1b, 0xc2, 0xcf, 0x97, 0xa7, 0x65, 0x0b,
-	0x08, 0xe5, 0xfb, 0x40, 0xb8, 0xb7, 0xed, 0x3c, 0xb5, 0x92, 0x21, 0xb4, 0x6f, 0x2e, 0x03, 0xa5,
-	0xab, 0x93, 0xf9, 0x4b, 0x9d, 0xeb, 0x44, 0x0b, 0x71, 0xac, 0x93, 0x0a, 0x96, 0xae, 0x4e, 0xe6,
-	0x2f, 0x75, 0xab, 0x93, 0x32, 0xc1, 0xb1, 0x4e, 0x74, 0xa0, 0x42, 0x9d, 0x94, 0x9f, 0x8e, 0xc4,
-	0x01, 0x4c, 0xa4, 0xb7, 0x6b, 0x8c, 0xd0, 0x8c, 0x61, 0x5f, 0x27, 0x0b, 0x50, 0x52, 0xfc, 0x67,
-	0x84, 0x9f, 0xe9, 0x85, 0x07, 0x31, 0x89, 0xca, 0x1d, 0x83, 0xf1, 0x5e, 0xaf, 0xcf, 0x0b, 0xe1,
-	0xcd, 0xba, 0x18, 0x29, 0xfb, 0x17, 0xc2, 0x2f, 0xcd, 0xae, 0x0a, 0xf9, 0xa0, 0xa2, 0xcf, 0x79,
-	0xdb, 0xee, 0x76, 0x95, 0x20, 0xa1, 0xff, 0xce, 0xd2, 0x78, 0xf2, 0x39, 0xee, 0x22, 0xfc, 0x6c,
-	0x17, 0x8e, 0x92, 0x21, 0xe4, 0x21, 0xa5, 0xdd, 0xd8, 0x34, 0x9e, 0x5f, 0x3d, 0x40, 0x78, 0x77,
-	0x6a, 0x73, 0xa4, 0xef, 0xaf, 0x08, 0xaf, 0xdc, 0x06, 0x7a, 0x14, 0xc6, 0x84, 0x43, 0x79, 0xc4,
-	0x4d, 0x5f, 0xa4, 0x6a, 0x84, 0x70, 0xde, 0x5e, 0x02, 0x49, 0x29, 0xed, 0x75, 0x98, 0x2c, 0x2e,
-	0xee, 0xa5, 0x5d, 0x91, 0xb7, 0x2d, 0xed, 0x4a, 0x8c, 0x94, 0x9d, 0x34, 0xee, 0xd3, 0x06, 0xcb,
-	0xbd, 0x71, 0xd7, 0xc7, 0x6d, 0x1b, 0xf7, 0x2a, 0x8a, 0x34, 0xfd, 0x13, 0x61, 0x7f, 0x06, 0xcd,
-	0xd7, 0x93, 0xb2, 0xf1, 0x8e, 0xf1, 0xbd, 0x16, 0x61, 0x84, 0xf9, 0xee, 0x92, 0x68, 0x4a, 0x37,
-	0xdd, 0x0b, 0x06, 0xd0, 0xcf, 0x22, 0x98, 0xdf, 0xfd, 0x8d, 0xbb, 0x69, 0x5d, 0xd8, 0xb6, 0x9b,
-	0xd6, 0x33, 0xa4, 0xe3, 0x1f, 0x08, 0xbf, 0x98, 0xef, 0xf4, 0xed, 0x41, 0x18, 0xf5, 0xe5, 0x63,
-	0x9c, 0x6d, 0xe0, 0xb7, 0xac, 0xfa, 0x85, 0x0a, 0x8a, 0xb0, 0xde, 0x59, 0x0e, 0x4c, 0xd9, 0xc2,
-	0xd7, 0x81, 0x05, 0x34, 0xdc, 0xd7, 0xbc, 0x7d, 0x1d, 0xe3, 0xd7, 0xa6, 0x82, 0x60, 0xbb, 0x85,
-	0x2f, 0x00, 0x49, 0xe5, 0xef, 0x10, 0x7e, 0xa2, 0x0b, 0x69, 0x14, 0x06, 0x84, 0xc3, 0xc6, 0x10,
-	0x62, 0xce, 0xde, 0xbb, 0xe0, 0x5d, 0x37, 0x1e, 0x98, 0x42, 0x52, 0x28, 0xbe, 0xe5, 0x0e, 0x50,
-	0xbe, 0x2b, 0xf7, 0x46, 0x71, 0xd0, 0x1b, 0x10, 0xda, 0x9f, 0x2c, 0xce, 0x19, 0x33, 0xfe, 0xae,
-	0x5c, 0xc8, 0xd9, 0x7e, 0x57, 0x2e, 0xc5, 0xa5, 0xd4, 0x17, 0x08, 0x3f, 0x32, 0xf9, 0xab, 0x68,
-	0x30, 0xbc, 0x2b, 0x16, 0x48, 0x11, 0x12, 0x3a, 0x57, 0x9d, 0xb2, 0xca, 0x1b, 0x2d, 0xe6, 0x58,
-	0xd9, 0x4c, 0x5b, 0x96, 0x05, 0xa2, 0xdb, 0x48, 0xdb, 0xb5, 0x18, 0xd2, 0xf1, 0x7b, 0x84, 0x9f,
-	0x14, 0x97, 0xcc, 0x4e, 0x6d, 0xb6, 0x12, 0xc6, 0xbd, 0x1b, 0x96, 0xf8, 0xb9, 0xac, 0x30, 0x6c,
-	0xd5, 0x41, 0x48, 0xc1, 0xcf, 0x11, 0xc6, 0xed, 0x28, 0x61, 0x30, 0x9d, 0x6f, 0xef, 0x92, 0x21,
-	0xf4, 0x2c, 0x22, 0x74, 0x2e, 0x3b, 0x24, 0xa5, 0xc5, 0xa7, 0xf8, 0xe1, 0x0e, 0xf0, 0x5c, 0xe1,
-	0x75, 0xf3, 0x03, 0x1d, 0x45, 0xe0, 0x0d, 0xeb, 0x9c, 0x32, 0x08, 0x79, 0x47, 0x34, 0xdd, 0x11,
-	0x2e, 0x59, 0x35, 0x51, 0xf3, 0xfb, 0xc0, 0x65, 0x87, 0xa4, 0xd2, 0x0d, 0x74, 0x80, 0x8b, 0x35,
-	0x21, 0x4c, 0xe2, 0x5d, 0x60, 0x8c, 0x1c, 0x00, 0x33, 0xee, 0x06, 0xf4, 0x71, 0xdb, 0x6e, 0xa0,
-	0x8a, 0xa2, 0x2c, 0xf4, 0x1d, 0xe0, 0xeb, 0x3b, 0x7b, 0x3a, 0xd9, 0x8e, 0xf9, 0x6d, 0xf4, 0x04,
-	0xdb, 0x85, 0x7e, 0x01, 0x48, 0x2a, 0x7f, 0x89, 0xf0, 0xa3, 0x7b, 0x19, 0xd0, 0x91, 0xd8, 0x0d,
-	0x3c, 0xd3, 0xd5, 0x47, 0x49, 0x09, 0xb5, 0x35, 0xb7, 0xb0, 0xa2, 0xd3, 0x05, 0x92, 0xa6, 0xd1,
-	0x28, 0x5f, 0xfa, 0x8d, 0x75, 0x94, 0x94, 0xad, 0x4e, 0x21, 0x2c, 0x75, 0xbe, 0x42, 0xf8, 0x5c,
-	0x3e, 0x8a, 0x72, 0x16, 0xd7, 0xac, 0x06, 0xbf, 0x38, 0x75, 0xd7, 0x1c, 0xd3, 0xea, 0xa1, 0x6c,
-	0x46, 0x0f, 0x60, 0xde, 0xc9, 0xf8, 0x50, 0xb6, 0x10, 0xb4, 0x3e, 0x94, 0x2d, 0xe5, 0x15, 0xaf,
-	0x5d, 0x70, 0xf4, 0x2a, 0x06, 0x6d, 0xbd, 0xca, 0xf9, 0xc2, 0x61, 0xf1, 0x1d, 0x0a, 0x6c, 0x30,
-	0xdf, 0x5c, 0x32, 0x8b, 0xc3, 0xe2, 0x72, 0xd8, 0xfe, 0xb0, 0x58, 0xc7, 0x90, 0x8e, 0xff, 0x20,
-	0xfc, 0x4a, 0x07, 0x62, 0xa0, 0x84, 0xc3, 0x0e, 0x61, 0x7c, 0xb6, 0x23, 0xcd, 0xbd, 0xb8, 0xb9,
-	0xf2, 0x9e, 0x71, 0xf1, 0x3c, 0x90, 0x25, 0x9e, 0xa0, 0xbb, 0x4c, 0xa4, 0x32, 0xe8, 0xea, 0x62,
-	0x39, 0xeb, 0xd3, 0x5a, 0x4e, 0x2b, 0xad, 0xda, 0xac, 0xb5, 0x6b, 0x31, 0x84, 0x63, 0x2b, 0x3d,
-	0x3e, 0xf1, 0x1b, 0xf7, 0x4e, 0xfc, 0xc6, 0xfd, 0x13, 0x1f, 0x7d, 0x36, 0xf6, 0xd1, 0x4f, 0x63,
-	0x1f, 0xfd, 0x3d, 0xf6, 0xd1, 0xf1, 0xd8, 0x47, 0xff, 0x8e, 0x7d, 0xf4, 0xdf, 0xd8, 0x6f, 0xdc,
-	0x1f, 0xfb, 0xe8, 0xeb, 0x53, 0xbf, 0x71, 0x7c, 0xea, 0x37, 0xee, 0x9d, 0xfa, 0x8d, 0x0f, 0xae,
-	0x1c, 0x24, 0x67, 0xb7, 0x0f, 0x93, 0x85, 0xbf, 0x54, 0x5d, 0x55, 0x3f, 0xd9, 0x7f, 0x68, 0xfa,
-	0x43, 0xd5, 0xc5, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x32, 0x6f, 0x20, 0x89, 0x44, 0x1b, 0x00,
-	0x00,
+	// 1194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcf, 0x8b, 0x23, 0x45,
+	0x14, 0xc7, 0x53, 0x17, 0x91, 0x42, 0x57, 0x6d, 0xc5, 0x1f, 0xa3, 0x36, 0xa2, 0x78, 0xcd, 0xb0,
+	0xbb, 0xa0, 0x3b, 0xbb, 0xb3, 0xae, 0x9b, 0x64, 0x26, 0x33, 0xbb, 0x89, 0xeb, 0x24, 0xab, 0x82,
+	0x17, 0xa9, 0xe9, 0xbc, 0x49, 0x9a, 0xe9, 0x74, 0xb7, 0xdd, 0xd5, 0xd1, 0x1c, 0x04, 0xc1, 0x93,
+	0x20, 0x28, 0x82, 0xe0, 0x49, 0xf0, 0xa4, 0x08, 0x82, 0xa0, 0x08, 0x82, 0xe0, 0x49, 0xf0, 0x24,
+	0x73, 0xdc, 0xa3, 0x93, 0xb9, 0x78, 0xdc, 0x3f, 0x41, 0x92, 0x4e, 0xd5, 0x74, 0x75, 0x57, 0xc7,
+	0xaa, 0xea, 0xdc, 0x76, 0x67, 0xfa, 0xfb, 0xe9, 0x4f, 0x57, 0xbd, 0xae, 0x7a, 0x5d, 0x83, 0x2f,
+	0x53, 0x18, 0x87, 0x41, 0x44, 0xbc, 0xcd, 0x18, 0xa2, 0x09, 0x44, 0x9b, 0x24, 0x74, 0x37, 0x47,
+	0x6e, 0x4c, 0x83, 0x68, 0x3a, 0xff, 0x89, 0xeb, 0xc0, 0xe6, 0xe4, 0xe2, 0xe6, 0xf2, 0x9f, 0xf5,
+	0x30, 0x0a, 0x68, 0x60, 0xbd, 0xcc, 0x42, 0xf5, 0x34, 0x54, 0x27, 0xa1, 0x5b, 0x17, 0x43, 0xf5,
+	0xc9, 0xc5, 0x8d, 0x6d, 0x35, 0x76, 0x04, 0xef, 0x27, 0x10, 0xd3, 0xf7, 0x22, 0x88, 0xc3, 0xc0,
+	0x8f, 0x97, 0x37, 0xb9, 0xf4, 0xcb, 0x16, 0xbe, 0xb0, 0x97, 0x5e, 0xdc, 0x4f, 0x2f, 0xb6, 0xbe,
+	0x43, 0xf8, 0xc9, 0x3e, 0x25, 0x11, 0x7d, 0x27, 0x88, 0x8e, 0x8f, 0xbc, 0xe0, 0x83, 0x9d, 0x0f,
+	0xc1, 0x49, 0xa8, 0x1b, 0xf8, 0x56, 0xab, 0xae, 0xe4, 0x54, 0x97, 0xc7, 0x7b, 0xa9, 0xc2, 0xc6,
+	0x4e, 0x45, 0x4a, 0xfa, 0x00, 0x2f, 0xd6, 0xac, 0x2f, 0x11, 0x7e, 0xa4, 0x0d, 0xb4, 0x9b, 0x50,
+	0x72, 0xe8, 0x41, 0x9f, 0x12, 0x0a, 0xd6, 0x75, 0x45, 0x78, 0x2e, 0xc7, 0xdc, 0x5e, 0x33, 0x8d,
+	0x73, 0xa9, 0xaf, 0x10, 0x7e, 0xf4, 0xcd, 0xc0, 0xf3, 0x04, 0x2b, 0x55, 0x6c, 0x3e, 0xc8, 0xb4,
+	0x6e, 0x18, 0xe7, 0xb9, 0xd7, 0xb7, 0x08, 0x3f, 0xd1, 0x83, 0x18, 0x68, 0x9f, 0xba, 0xce, 0xf1,
+	0xf4, 0x2e, 0x89, 0x8f, 0x0f, 0x12, 0x48, 0xc0, 0x6a, 0x28, 0xb2, 0x65, 0x61, 0xe6, 0xd7, 0xac,
+	0xc4, 0xe0, 0x8e, 0x3f, 0x21, 0xfc, 0x4c, 0x0f, 0x9c, 0x20, 0x1a, 0xb0, 0x69, 0x9f, 0x5f, 0xb5,
+	0xa8, 0x03, 0x18, 0x58, 0x6d, 0xe5, 0x9b, 0x94, 0x10, 0x98, 0xed, 0x5e, 0x75, 0x90, 0x44, 0xf9,
+	0xa6, 0x43, 0xdd, 0x89, 0x4b, 0xa7, 0xe6, 0xca, 0x12, 0x82, 0x99, 0xb2, 0x14, 0xc4, 0x95, 0x7f,
+	0x43, 0xf8, 0xb9, 0xf4, 0xbf, 0xc2, 0xb3, 0x35, 0x83, 0x71, 0xe8, 0xc1, 0xdc, 0xfa, 0x96, 0xfa,
+	0x6c, 0x96, 0x42, 0x98, 0xf8, 0xed, 0xb5, 0xb0, 0x72, 0xc3, 0x5d, 0xb8, 0x74, 0x97, 0xb8, 0x9e,
+	0xd6, 0x70, 0x97, 0x10, 0xf4, 0x87, 0xbb, 0x14, 0xc4, 0x95, 0x7f, 0x45, 0xf8, 0xd9, 0xe2, 0xb4,
+	0xec, 0x01, 0x89, 0xe8, 0x21, 0x10, 0x6a, 0xed, 0x1b, 0x4f, 0x2d, 0x67, 0x30, 0xed, 0x5b, 0xeb,
+	0x40, 0xc9, 0xea, 0x24, 0x7b, 0xa9, 0x71, 0x9d, 0x48, 0x21, 0x86, 0x75, 0x52, 0xc2, 0x92, 0xd5,
+	0x49, 0xf6, 0x52, 0xb3, 0x3a, 0x29, 0x12, 0x0c, 0xeb, 0x44, 0x06, 0xca, 0xd5, 0x49, 0xf1, 0xe9,
+	0x88, 0xef, 0xc0, 0x5c, 0x7a, 0xbf, 0xc2, 0x08, 0x2d, 0x19, 0xfa, 0x75, 0xb2, 0x02, 0xc5, 0xc5,
+	0x7f, 0x40, 0xf8, 0xa9, 0xbe, 0x3b, 0xf4, 0x89, 0x57, 0xec, 0x18, 0x94, 0xf7, 0x7a, 0x79, 0x9e,
+	0x09, 0xef, 0x56, 0xc5, 0x70, 0xd9, 0x3f, 0x11, 0x7e, 0x61, 0x79, 0x95, 0x4b, 0x47, 0x25, 0x7d,
+	0xce, 0x1b, 0x7a, 0xb7, 0x2b, 0x05, 0x31, 0xfd, 0x3b, 0x6b, 0xe3, 0xf1, 0xe7, 0xf8, 0x11, 0xe1,
+	0xa7, 0x7b, 0x30, 0x0e, 0x26, 0x90, 0x86, 0x84, 0x76, 0x63, 0x57, 0x79, 0x7e, 0xe5, 0x00, 0xe6,
+	0xdd, 0xae, 0xcc, 0xe1, 0xbe, 0x3f, 0x23, 0xbc, 0x71, 0x17, 0xa2, 0xb1, 0xeb, 0x13, 0x0a, 0xc5,
+	0x11, 0x57, 0x7d, 0x91, 0xca, 0x11, 0xcc, 0x79, 0x7f, 0x0d, 0x24, 0xa1, 0xb4, 0x5b, 0xe0, 0x01,
+	0x05, 0xf3, 0xd2, 0x2e, 0xc9, 0xeb, 0x96, 0x76, 0x29, 0x86, 0xcb, 0xce, 0x1b, 0xf7, 0x45, 0x83,
+	0x65, 0xde, 0xb8, 0xcb, 0xe3, 0xba, 0x8d, 0x7b, 0x19, 0x85, 0x9b, 0xfe, 0x81, 0xb0, 0xbd, 0x84,
+	0xa6, 0xeb, 0x49, 0xd1, 0xb8, 0xa3, 0x7c, 0xaf, 0x55, 0x18, 0x66, 0xde, 0x5d, 0x13, 0x4d, 0xe8,
+	0xa6, 0xfb, 0xce, 0x08, 0x06, 0x89, 0x07, 0xd9, 0xdd, 0x5f, 0xb9, 0x9b, 0x96, 0x85, 0x75, 0xbb,
+	0x69, 0x39, 0x83, 0x3b, 0xfe, 0x8e, 0xf0, 0xf3, 0xe9, 0x4e, 0xdf, 0x1c, 0xb9, 0xde, 0x80, 0x3f,
+	0xc6, 0xf9, 0x06, 0x7e, 0x5b, 0xab, 0x5f, 0x28, 0xa1, 0x30, 0xeb, 0xce, 0x7a, 0x60, 0xc2, 0x16,
+	0xde, 0x82, 0xd8, 0x89, 0xdc, 0x43, 0xc9, 0xdb, 0xd7, 0x56, 0x7e, 0x6d, 0x4a, 0x08, 0xba, 0x5b,
+	0xf8, 0x0a, 0x10, 0x57, 0xfe, 0x1a, 0xe1, 0xc7, 0x7a, 0x10, 0x7a, 0xae, 0x43, 0x28, 0xec, 0x4c,
+	0xc0, 0xa7, 0xf1, 0xdb, 0x97, 0xac, 0x1b, 0xca, 0x03, 0x93, 0x4b, 0x32, 0xc5, 0xd7, 0xcd, 0x01,
+	0xc2, 0xb7, 0x72, 0x7f, 0xea, 0x3b, 0xfd, 0x11, 0x89, 0x06, 0xf3, 0xc5, 0x39, 0x89, 0x95, 0xbf,
+	0x95, 0x73, 0x39, 0xdd, 0x6f, 0xe5, 0x42, 0x9c, 0x4b, 0x7d, 0x8a, 0xf0, 0x43, 0xf3, 0xdf, 0xb2,
+	0x06, 0xc3, 0xba, 0xaa, 0x81, 0x64, 0x21, 0xa6, 0x73, 0xcd, 0x28, 0x2b, 0xbc, 0xd1, 0x6c, 0x8e,
+	0x85, 0xcd, 0xb4, 0xa1, 0x59, 0x20, 0xb2, 0x8d, 0xb4, 0x59, 0x89, 0xc1, 0x1d, 0xbf, 0x41, 0xf8,
+	0x71, 0x76, 0xc9, 0xf2, 0xd4, 0x66, 0x2f, 0x88, 0xa9, 0x75, 0x53, 0x13, 0x9f, 0xc9, 0x32, 0xc3,
+	0x46, 0x15, 0x04, 0x17, 0xfc, 0x04, 0x61, 0xdc, 0xf4, 0x82, 0x18, 0x16, 0xf3, 0x6d, 0x5d, 0x51,
+	0x84, 0x9e, 0x47, 0x98, 0xce, 0x96, 0x41, 0x92, 0x5b, 0x7c, 0x84, 0x1f, 0x6c, 0x03, 0x4d, 0x15,
+	0x5e, 0x51, 0x3f, 0xd0, 0x11, 0x04, 0x5e, 0xd5, 0xce, 0x09, 0x83, 0x90, 0x76, 0x44, 0x8b, 0x1d,
+	0xe1, 0x8a, 0x56, 0x13, 0x95, 0xdd, 0x07, 0xb6, 0x0c, 0x92, 0x42, 0x37, 0xd0, 0x06, 0xca, 0xd6,
+	0x04, 0x37, 0xf0, 0xbb, 0x10, 0xc7, 0x64, 0x08, 0xb1, 0x72, 0x37, 0x20, 0x8f, 0xeb, 0x76, 0x03,
+	0x65, 0x14, 0x61, 0xa1, 0x6f, 0x03, 0x6d, 0x75, 0x0e, 0x64, 0xb2, 0x6d, 0xf5, 0xdb, 0xc8, 0x09,
+	0xba, 0x0b, 0xfd, 0x0a, 0x10, 0x57, 0xfe, 0x0c, 0xe1, 0x87, 0x0f, 0x12, 0x88, 0xa6, 0x6c, 0x37,
+	0xb0, 0x54, 0x57, 0x1f, 0x21, 0xc5, 0xd4, 0xb6, 0xcd, 0xc2, 0x82, 0x4e, 0x0f, 0x48, 0x18, 0x7a,
+	0xd3, 0x74, 0xe9, 0x57, 0xd6, 0x11, 0x52, 0xba, 0x3a, 0xb9, 0x30, 0xd7, 0xf9, 0x1c, 0xe1, 0x0b,
+	0xe9, 0x28, 0xf2, 0x59, 0xdc, 0xd6, 0x1a, 0xfc, 0xfc, 0xd4, 0x5d, 0x37, 0x4c, 0x8b, 0x87, 0xb2,
+	0x49, 0x34, 0x84, 0xac, 0x93, 0xf2, 0xa1, 0x6c, 0x2e, 0xa8, 0x7d, 0x28, 0x5b, 0xc8, 0x0b, 0x5e,
+	0x5d, 0x30, 0xf4, 0xea, 0x42, 0x35, 0xaf, 0x2e, 0x94, 0x7a, 0xa5, 0x87, 0xc5, 0x47, 0x11, 0xc4,
+	0xa3, 0x6c, 0x73, 0x19, 0x6b, 0x1c, 0x16, 0x17, 0xc3, 0xfa, 0x87, 0xc5, 0x32, 0x06, 0x77, 0xfc,
+	0x1b, 0xe1, 0x97, 0xda, 0xe0, 0x43, 0x44, 0x28, 0x74, 0x48, 0x4c, 0x97, 0x3b, 0x52, 0xe6, 0xc5,
+	0x4d, 0x95, 0x0f, 0x94, 0x8b, 0xe7, 0x7f, 0x59, 0xec, 0x09, 0x7a, 0xeb, 0x44, 0x0a, 0x83, 0x2e,
+	0x2e, 0x96, 0xcb, 0x3e, 0xad, 0x61, 0xb4, 0xd2, 0x8a, 0xcd, 0x5a, 0xb3, 0x12, 0x43, 0xf8, 0x20,
+	0x7e, 0x2b, 0x1c, 0x90, 0x2a, 0x1f, 0xc4, 0x25, 0x79, 0xdd, 0x0f, 0xe2, 0x52, 0x8c, 0xac, 0x8a,
+	0x5b, 0x53, 0x9f, 0x8c, 0x5d, 0xa7, 0x19, 0xf8, 0x47, 0xee, 0x50, 0xb7, 0x8a, 0x85, 0xb0, 0x61,
+	0x15, 0xe7, 0x18, 0xf9, 0x6d, 0xfa, 0x4e, 0x08, 0x7e, 0xe6, 0x6b, 0x28, 0xf1, 0xa9, 0xd6, 0x36,
+	0x2d, 0x89, 0x1b, 0x6c, 0xd3, 0x52, 0x8a, 0xd0, 0xac, 0xb3, 0x6e, 0xa7, 0x13, 0x90, 0x81, 0x72,
+	0xb3, 0x9e, 0x0d, 0xe9, 0x36, 0xeb, 0x62, 0x96, 0xb9, 0x34, 0xc2, 0x93, 0x53, 0xbb, 0x76, 0xef,
+	0xd4, 0xae, 0xdd, 0x3f, 0xb5, 0xd1, 0xc7, 0x33, 0x1b, 0x7d, 0x3f, 0xb3, 0xd1, 0x5f, 0x33, 0x1b,
+	0x9d, 0xcc, 0x6c, 0xf4, 0xcf, 0xcc, 0x46, 0xff, 0xce, 0xec, 0xda, 0xfd, 0x99, 0x8d, 0xbe, 0x38,
+	0xb3, 0x6b, 0x27, 0x67, 0x76, 0xed, 0xde, 0x99, 0x5d, 0x7b, 0xf7, 0xea, 0x30, 0x38, 0xbf, 0xad,
+	0x1b, 0xac, 0xfc, 0x83, 0xe9, 0x35, 0xf1, 0x27, 0x87, 0x0f, 0x2c, 0xfe, 0x5e, 0x7a, 0xf9, 0xbf,
+	0x01, 0x00, 0x45, 0xc7, 0x47, 0x45, 0xcb, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -276,6 +280,15 @@ type HistoryServiceClient interface {
 	// GenerateLastHistoryReplicationTasks generate a replication task for last history event for requested workflow execution
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error)
+	// UpdateWorkflowExecution validates an update with the worker, records it in the workflow history
+	// and waits for the worker to complete it.
+	UpdateWorkflowExecution(ctx context.Context, in *UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionResponse, error)
+	// RefreshDynamicConfig reloads dynamic config stored in persistence on the given host.
+	RefreshDynamicConfig(ctx context.Context, in *RefreshDynamicConfigRequest, opts ...grpc.CallOption) (*RefreshDynamicConfigResponse, error)
+	// GetOpenExecutionCounts returns the approximate number of open workflow executions on shards owned by the given host.
+	GetOpenExecutionCounts(ctx context.Context, in *GetOpenExecutionCountsRequest, opts ...grpc.CallOption) (*GetOpenExecutionCountsResponse, error)
+	// GetShardLoad returns the load on each shard owned by the given host.
+	GetShardLoad(ctx context.Context, in *GetShardLoadRequest, opts ...grpc.CallOption) (*GetShardLoadResponse, error)
 }
 
 type historyServiceClient struct {
@@ -646,6 +659,42 @@ func (c *historyServiceClient) GetReplicationStatus(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *historyServiceClient) UpdateWorkflowExecution(ctx context.Context, in *UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionResponse, error) {
+	out := new(UpdateWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UpdateWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) RefreshDynamicConfig(ctx context.Context, in *RefreshDynamicConfigRequest, opts ...grpc.CallOption) (*RefreshDynamicConfigResponse, error) {
+	out := new(RefreshDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/RefreshDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) GetOpenExecutionCounts(ctx context.Context, in *GetOpenExecutionCountsRequest, opts ...grpc.CallOption) (*GetOpenExecutionCountsResponse, error) {
+	out := new(GetOpenExecutionCountsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/GetOpenExecutionCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) GetShardLoad(ctx context.Context, in *GetShardLoadRequest, opts ...grpc.CallOption) (*GetShardLoadResponse, error) {
+	out := new(GetShardLoadResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/GetShardLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 type HistoryServiceServer interface {
 	// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -784,6 +833,15 @@ type HistoryServiceServer interface {
 	// GenerateLastHistoryReplicationTasks generate a replication task for last history event for requested workflow execution
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error)
+	// UpdateWorkflowExecution validates an update with the worker, records it in the workflow history
+	// and waits for the worker to complete it.
+	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
+	// RefreshDynamicConfig reloads dynamic config stored in persistence on the given host.
+	RefreshDynamicConfig(context.Context, *RefreshDynamicConfigRequest) (*RefreshDynamicConfigResponse, error)
+	// GetOpenExecutionCounts returns the approximate number of open workflow executions on shards owned by the given host.
+	GetOpenExecutionCounts(context.Context, *GetOpenExecutionCountsRequest) (*GetOpenExecutionCountsResponse, error)
+	// GetShardLoad returns the load on each shard owned by the given host.
+	GetShardLoad(context.Context, *GetShardLoadRequest) (*GetShardLoadResponse, error)
 }
 
 // UnimplementedHistoryServiceServer can be embedded to have forward compatible implementations.
@@ -910,6 +968,18 @@ func (*UnimplementedHistoryServiceServer) GenerateLastHistoryReplicationTasks(ct
 func (*UnimplementedHistoryServiceServer) GetReplicationStatus(ctx context.Context, req *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationStatus not implemented")
 }
+func (*UnimplementedHistoryServiceServer) UpdateWorkflowExecution(ctx context.Context, req *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) RefreshDynamicConfig(ctx context.Context, req *RefreshDynamicConfigRequest) (*RefreshDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshDynamicConfig not implemented")
+}
+func (*UnimplementedHistoryServiceServer) GetOpenExecutionCounts(ctx context.Context, req *GetOpenExecutionCountsRequest) (*GetOpenExecutionCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpenExecutionCounts not implemented")
+}
+func (*UnimplementedHistoryServiceServer) GetShardLoad(ctx context.Context, req *GetShardLoadRequest) (*GetShardLoadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardLoad not implemented")
+}
 
 func RegisterHistoryServiceServer(s *grpc.Server, srv HistoryServiceServer) {
 	s.RegisterService(&_HistoryService_serviceDesc, srv)
@@ -1635,6 +1705,78 @@ func _HistoryService_GetReplicationStatus_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_UpdateWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpdateWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UpdateWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpdateWorkflowExecution(ctx, req.(*UpdateWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_RefreshDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RefreshDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/RefreshDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RefreshDynamicConfig(ctx, req.(*RefreshDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_GetOpenExecutionCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpenExecutionCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetOpenExecutionCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/GetOpenExecutionCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetOpenExecutionCounts(ctx, req.(*GetOpenExecutionCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_GetShardLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShardLoadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetShardLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/GetShardLoad",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetShardLoad(ctx, req.(*GetShardLoadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HistoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.historyservice.v1.HistoryService",
 	HandlerType: (*HistoryServiceServer)(nil),
@@ -1799,6 +1941,22 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetReplicationStatus",
 			Handler:    _HistoryService_GetReplicationStatus_Handler,
 		},
+		{
+			MethodName: "UpdateWorkflowExecution",
+			Handler:    _HistoryService_UpdateWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "RefreshDynamicConfig",
+			Handler:    _HistoryService_RefreshDynamicConfig_Handler,
+		},
+		{
+			MethodName: "GetOpenExecutionCounts",
+			Handler:    _HistoryService_GetOpenExecutionCounts_Handler,
+		},
+		{
+			MethodName: "GetShardLoad",
+			Handler:    _HistoryService_GetShardLoad_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/historyservice/v1/service.proto",
@@ -240,6 +240,26 @@ func (mr *MockHistoryServiceClientMockRecorder) GetMutableState(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetMutableState), varargs...)
 }
 
+// GetOpenExecutionCounts mocks base method.
+func (m *MockHistoryServiceClient) GetOpenExecutionCounts(ctx context.Context, in *historyservice.GetOpenExecutionCountsRequest, opts ...grpc.CallOption) (*historyservice.GetOpenExecutionCountsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOpenExecutionCounts", varargs...)
+	ret0, _ := ret[0].(*historyservice.GetOpenExecutionCountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenExecutionCounts indicates an expected call of GetOpenExecutionCounts.
+func (mr *MockHistoryServiceClientMockRecorder) GetOpenExecutionCounts(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenExecutionCounts", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetOpenExecutionCounts), varargs...)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockHistoryServiceClient) GetReplicationMessages(ctx context.Context, in *historyservice.GetReplicationMessagesRequest, opts ...grpc.CallOption) (*historyservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -300,6 +320,26 @@ func (mr *MockHistoryServiceClientMockRecorder) GetShard(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetShard), varargs...)
 }
 
+// GetShardLoad mocks base method.
+func (m *MockHistoryServiceClient) GetShardLoad(ctx context.Context, in *historyservice.GetShardLoadRequest, opts ...grpc.CallOption) (*historyservice.GetShardLoadResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetShardLoad", varargs...)
+	ret0, _ := ret[0].(*historyservice.GetShardLoadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShardLoad indicates an expected call of GetShardLoad.
+func (mr *MockHistoryServiceClientMockRecorder) GetShardLoad(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardLoad", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetShardLoad), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockHistoryServiceClient) MergeDLQMessages(ctx context.Context, in *historyservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*historyservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -480,6 +520,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RecordWorkflowTaskStarted(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkflowTaskStarted", reflect.TypeOf((*MockHistoryServiceClient)(nil).RecordWorkflowTaskStarted), varargs...)
 }
 
+// RefreshDynamicConfig mocks base method.
+func (m *MockHistoryServiceClient) RefreshDynamicConfig(ctx context.Context, in *historyservice.RefreshDynamicConfigRequest, opts ...grpc.CallOption) (*historyservice.RefreshDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*historyservice.RefreshDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshDynamicConfig indicates an expected call of RefreshDynamicConfig.
+func (mr *MockHistoryServiceClientMockRecorder) RefreshDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshDynamicConfig", reflect.TypeOf((*MockHistoryServiceClient)(nil).RefreshDynamicConfig), varargs...)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockHistoryServiceClient) RefreshWorkflowTasks(ctx context.Context, in *historyservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*historyservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -860,6 +920,26 @@ func (mr *MockHistoryServiceClientMockRecorder) TerminateWorkflowExecution(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).TerminateWorkflowExecution), varargs...)
 }
 
+// UpdateWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) UpdateWorkflowExecution(ctx context.Context, in *historyservice.UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) UpdateWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpdateWorkflowExecution), varargs...)
+}
+
 // MockHistoryServiceServer is a mock of HistoryServiceServer interface.
 type MockHistoryServiceServer struct {
 	ctrl     *gomock.Controller
@@ -1018,6 +1098,21 @@ func (mr *MockHistoryServiceServerMockRecorder) GetMutableState(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetMutableState), arg0, arg1)
 }
 
+// GetOpenExecutionCounts mocks base method.
+func (m *MockHistoryServiceServer) GetOpenExecutionCounts(arg0 context.Context, arg1 *historyservice.GetOpenExecutionCountsRequest) (*historyservice.GetOpenExecutionCountsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenExecutionCounts", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.GetOpenExecutionCountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenExecutionCounts indicates an expected call of GetOpenExecutionCounts.
+func (mr *MockHistoryServiceServerMockRecorder) GetOpenExecutionCounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenExecutionCounts", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetOpenExecutionCounts), arg0, arg1)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockHistoryServiceServer) GetReplicationMessages(arg0 context.Context, arg1 *historyservice.GetReplicationMessagesRequest) (*historyservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1063,6 +1158,21 @@ func (mr *MockHistoryServiceServerMockRecorder) GetShard(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetShard), arg0, arg1)
 }
 
+// GetShardLoad mocks base method.
+func (m *MockHistoryServiceServer) GetShardLoad(arg0 context.Context, arg1 *historyservice.GetShardLoadRequest) (*historyservice.GetShardLoadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShardLoad", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.GetShardLoadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShardLoad indicates an expected call of GetShardLoad.
+func (mr *MockHistoryServiceServerMockRecorder) GetShardLoad(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardLoad", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetShardLoad), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockHistoryServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *historyservice.MergeDLQMessagesRequest) (*historyservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1198,6 +1308,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RecordWorkflowTaskStarted(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkflowTaskStarted", reflect.TypeOf((*MockHistoryServiceServer)(nil).RecordWorkflowTaskStarted), arg0, arg1)
 }
 
+// RefreshDynamicConfig mocks base method.
+func (m *MockHistoryServiceServer) RefreshDynamicConfig(arg0 context.Context, arg1 *historyservice.RefreshDynamicConfigRequest) (*historyservice.RefreshDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.RefreshDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshDynamicConfig indicates an expected call of RefreshDynamicConfig.
+func (mr *MockHistoryServiceServerMockRecorder) RefreshDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshDynamicConfig", reflect.TypeOf((*MockHistoryServiceServer)(nil).RefreshDynamicConfig), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockHistoryServiceServer) RefreshWorkflowTasks(arg0 context.Context, arg1 *historyservice.RefreshWorkflowTasksRequest) (*historyservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1482,3 +1607,18 @@ func (mr *MockHistoryServiceServerMockRecorder) TerminateWorkflowExecution(arg0,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).TerminateWorkflowExecution), arg0, arg1)
 }
+
+// UpdateWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) UpdateWorkflowExecution(arg0 context.Context, arg1 *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) UpdateWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpdateWorkflowExecution), arg0, arg1)
+}
@@ -49,6 +49,8 @@ import (
 	v1 "go.temporal.io/api/workflowservice/v1"
 	v15 "go.temporal.io/server/api/enums/v1"
 	v13 "go.temporal.io/server/api/history/v1"
+	v18 "go.temporal.io/server/api/persistence/v1"
+	v17 "go.temporal.io/server/api/taskqueue/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -147,6 +149,8 @@ type PollWorkflowTaskQueueResponse struct {
 	ScheduledTime              *time.Time                     `protobuf:"bytes,15,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
 	StartedTime                *time.Time                     `protobuf:"bytes,16,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	Queries                    map[string]*v12.WorkflowQuery  `protobuf:"bytes,17,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HistorySizeBytes           int64                          `protobuf:"varint,18,opt,name=history_size_bytes,json=historySizeBytes,proto3" json:"history_size_bytes,omitempty"`
+	SuggestContinueAsNew       bool                           `protobuf:"varint,19,opt,name=suggest_continue_as_new,json=suggestContinueAsNew,proto3" json:"suggest_continue_as_new,omitempty"`
 }
 
 func (m *PollWorkflowTaskQueueResponse) Reset()      { *m = PollWorkflowTaskQueueResponse{} }
@@ -293,6 +297,20 @@ func (m *PollWorkflowTaskQueueResponse) GetQueries() map[string]*v12.WorkflowQue
 	return nil
 }
 
+func (m *PollWorkflowTaskQueueResponse) GetHistorySizeBytes() int64 {
+	if m != nil {
+		return m.HistorySizeBytes
+	}
+	return 0
+}
+
+func (m *PollWorkflowTaskQueueResponse) GetSuggestContinueAsNew() bool {
+	if m != nil {
+		return m.SuggestContinueAsNew
+	}
+	return false
+}
+
 type PollActivityTaskQueueRequest struct {
 	NamespaceId     string                           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	PollerId        string                           `protobuf:"bytes,2,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`
@@ -537,6 +555,8 @@ type AddWorkflowTaskRequest struct {
 	ScheduleToStartTimeout *time.Duration `protobuf:"bytes,5,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3,stdduration" json:"schedule_to_start_timeout,omitempty"`
 	ForwardedSource        string         `protobuf:"bytes,6,opt,name=forwarded_source,json=forwardedSource,proto3" json:"forwarded_source,omitempty"`
 	Source                 v15.TaskSource `protobuf:"varint,7,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
+	WorkerBuildId          string         `protobuf:"bytes,8,opt,name=worker_build_id,json=workerBuildId,proto3" json:"worker_build_id,omitempty"`
+	Priority               int32          `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *AddWorkflowTaskRequest) Reset()      { *m = AddWorkflowTaskRequest{} }
@@ -620,6 +640,20 @@ func (m *AddWorkflowTaskRequest) GetSource() v15.TaskSource {
 	return v15.TASK_SOURCE_UNSPECIFIED
 }
 
+func (m *AddWorkflowTaskRequest) GetWorkerBuildId() string {
+	if m != nil {
+		return m.WorkerBuildId
+	}
+	return ""
+}
+
+func (m *AddWorkflowTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 type AddWorkflowTaskResponse struct {
 }
 
@@ -666,6 +700,7 @@ type AddActivityTaskRequest struct {
 	ScheduleToStartTimeout *time.Duration `protobuf:"bytes,6,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3,stdduration" json:"schedule_to_start_timeout,omitempty"`
 	ForwardedSource        string         `protobuf:"bytes,7,opt,name=forwarded_source,json=forwardedSource,proto3" json:"forwarded_source,omitempty"`
 	Source                 v15.TaskSource `protobuf:"varint,8,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
+	Priority               int32          `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *AddActivityTaskRequest) Reset()      { *m = AddActivityTaskRequest{} }
@@ -756,6 +791,13 @@ func (m *AddActivityTaskRequest) GetSource() v15.TaskSource {
 	return v15.TASK_SOURCE_UNSPECIFIED
 }
 
+func (m *AddActivityTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 type AddActivityTaskResponse struct {
 }
 
@@ -1167,6 +1209,8 @@ func (m *DescribeTaskQueueRequest) GetDescRequest() *v1.DescribeTaskQueueRequest
 type DescribeTaskQueueResponse struct {
 	Pollers         []*v14.PollerInfo    `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
 	TaskQueueStatus *v14.TaskQueueStatus `protobuf:"bytes,2,opt,name=task_queue_status,json=taskQueueStatus,proto3" json:"task_queue_status,omitempty"`
+	// Set together with task_queue_status.
+	Stats *v17.TaskQueueStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
 func (m *DescribeTaskQueueResponse) Reset()      { *m = DescribeTaskQueueResponse{} }
@@ -1215,6 +1259,13 @@ func (m *DescribeTaskQueueResponse) GetTaskQueueStatus() *v14.TaskQueueStatus {
 	return nil
 }
 
+func (m *DescribeTaskQueueResponse) GetStats() *v17.TaskQueueStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
 type ListTaskQueuePartitionsRequest struct {
 	Namespace string         `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	TaskQueue *v14.TaskQueue `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
@@ -1317,6 +1368,298 @@ func (m *ListTaskQueuePartitionsResponse) GetWorkflowTaskQueuePartitions() []*v1
 	return nil
 }
 
+type UpdateWorkerBuildIdOrderingRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	BuildId     string `protobuf:"bytes,3,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// When set, build_id is added to the set containing this build ID instead of a new set.
+	CompatibleBuildId string `protobuf:"bytes,4,opt,name=compatible_build_id,json=compatibleBuildId,proto3" json:"compatible_build_id,omitempty"`
+	// When set, the set containing build_id becomes the default one.
+	BecomeDefault bool `protobuf:"varint,5,opt,name=become_default,json=becomeDefault,proto3" json:"become_default,omitempty"`
+}
+
+func (m *UpdateWorkerBuildIdOrderingRequest) Reset()      { *m = UpdateWorkerBuildIdOrderingRequest{} }
+func (*UpdateWorkerBuildIdOrderingRequest) ProtoMessage() {}
+func (*UpdateWorkerBuildIdOrderingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{18}
+}
+func (m *UpdateWorkerBuildIdOrderingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkerBuildIdOrderingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkerBuildIdOrderingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWorkerBuildIdOrderingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkerBuildIdOrderingRequest.Merge(m, src)
+}
+func (m *UpdateWorkerBuildIdOrderingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkerBuildIdOrderingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkerBuildIdOrderingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkerBuildIdOrderingRequest proto.InternalMessageInfo
+
+func (m *UpdateWorkerBuildIdOrderingRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *UpdateWorkerBuildIdOrderingRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
+	}
+	return ""
+}
+
+func (m *UpdateWorkerBuildIdOrderingRequest) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+func (m *UpdateWorkerBuildIdOrderingRequest) GetCompatibleBuildId() string {
+	if m != nil {
+		return m.CompatibleBuildId
+	}
+	return ""
+}
+
+func (m *UpdateWorkerBuildIdOrderingRequest) GetBecomeDefault() bool {
+	if m != nil {
+		return m.BecomeDefault
+	}
+	return false
+}
+
+type UpdateWorkerBuildIdOrderingResponse struct {
+	VersioningData *v18.VersioningData `protobuf:"bytes,1,opt,name=versioning_data,json=versioningData,proto3" json:"versioning_data,omitempty"`
+}
+
+func (m *UpdateWorkerBuildIdOrderingResponse) Reset()      { *m = UpdateWorkerBuildIdOrderingResponse{} }
+func (*UpdateWorkerBuildIdOrderingResponse) ProtoMessage() {}
+func (*UpdateWorkerBuildIdOrderingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{19}
+}
+func (m *UpdateWorkerBuildIdOrderingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkerBuildIdOrderingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkerBuildIdOrderingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWorkerBuildIdOrderingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkerBuildIdOrderingResponse.Merge(m, src)
+}
+func (m *UpdateWorkerBuildIdOrderingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkerBuildIdOrderingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkerBuildIdOrderingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkerBuildIdOrderingResponse proto.InternalMessageInfo
+
+func (m *UpdateWorkerBuildIdOrderingResponse) GetVersioningData() *v18.VersioningData {
+	if m != nil {
+		return m.VersioningData
+	}
+	return nil
+}
+
+type GetWorkerBuildIdOrderingRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+}
+
+func (m *GetWorkerBuildIdOrderingRequest) Reset()      { *m = GetWorkerBuildIdOrderingRequest{} }
+func (*GetWorkerBuildIdOrderingRequest) ProtoMessage() {}
+func (*GetWorkerBuildIdOrderingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{20}
+}
+func (m *GetWorkerBuildIdOrderingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkerBuildIdOrderingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkerBuildIdOrderingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetWorkerBuildIdOrderingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkerBuildIdOrderingRequest.Merge(m, src)
+}
+func (m *GetWorkerBuildIdOrderingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkerBuildIdOrderingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkerBuildIdOrderingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkerBuildIdOrderingRequest proto.InternalMessageInfo
+
+func (m *GetWorkerBuildIdOrderingRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *GetWorkerBuildIdOrderingRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
+	}
+	return ""
+}
+
+type GetWorkerBuildIdOrderingResponse struct {
+	VersioningData *v18.VersioningData `protobuf:"bytes,1,opt,name=versioning_data,json=versioningData,proto3" json:"versioning_data,omitempty"`
+}
+
+func (m *GetWorkerBuildIdOrderingResponse) Reset()      { *m = GetWorkerBuildIdOrderingResponse{} }
+func (*GetWorkerBuildIdOrderingResponse) ProtoMessage() {}
+func (*GetWorkerBuildIdOrderingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{21}
+}
+func (m *GetWorkerBuildIdOrderingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkerBuildIdOrderingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkerBuildIdOrderingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetWorkerBuildIdOrderingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkerBuildIdOrderingResponse.Merge(m, src)
+}
+func (m *GetWorkerBuildIdOrderingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkerBuildIdOrderingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkerBuildIdOrderingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkerBuildIdOrderingResponse proto.InternalMessageInfo
+
+func (m *GetWorkerBuildIdOrderingResponse) GetVersioningData() *v18.VersioningData {
+	if m != nil {
+		return m.VersioningData
+	}
+	return nil
+}
+
+type RefreshDynamicConfigRequest struct {
+	HostAddress string `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+}
+
+func (m *RefreshDynamicConfigRequest) Reset()      { *m = RefreshDynamicConfigRequest{} }
+func (*RefreshDynamicConfigRequest) ProtoMessage() {}
+func (*RefreshDynamicConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{22}
+}
+func (m *RefreshDynamicConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshDynamicConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshDynamicConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefreshDynamicConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshDynamicConfigRequest.Merge(m, src)
+}
+func (m *RefreshDynamicConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshDynamicConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshDynamicConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshDynamicConfigRequest proto.InternalMessageInfo
+
+func (m *RefreshDynamicConfigRequest) GetHostAddress() string {
+	if m != nil {
+		return m.HostAddress
+	}
+	return ""
+}
+
+type RefreshDynamicConfigResponse struct {
+}
+
+func (m *RefreshDynamicConfigResponse) Reset()      { *m = RefreshDynamicConfigResponse{} }
+func (*RefreshDynamicConfigResponse) ProtoMessage() {}
+func (*RefreshDynamicConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{23}
+}
+func (m *RefreshDynamicConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshDynamicConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshDynamicConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefreshDynamicConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshDynamicConfigResponse.Merge(m, src)
+}
+func (m *RefreshDynamicConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshDynamicConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshDynamicConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshDynamicConfigResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*PollWorkflowTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueRequest")
 	proto.RegisterType((*PollWorkflowTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse")
@@ -1337,6 +1680,12 @@ func init() {
 	proto.RegisterType((*DescribeTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueResponse")
 	proto.RegisterType((*ListTaskQueuePartitionsRequest)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsRequest")
 	proto.RegisterType((*ListTaskQueuePartitionsResponse)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsResponse")
+	proto.RegisterType((*UpdateWorkerBuildIdOrderingRequest)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdOrderingRequest")
+	proto.RegisterType((*UpdateWorkerBuildIdOrderingResponse)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdOrderingResponse")
+	proto.RegisterType((*GetWorkerBuildIdOrderingRequest)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdOrderingRequest")
+	proto.RegisterType((*GetWorkerBuildIdOrderingResponse)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdOrderingResponse")
+	proto.RegisterType((*RefreshDynamicConfigRequest)(nil), "temporal.server.api.matchingservice.v1.RefreshDynamicConfigRequest")
+	proto.RegisterType((*RefreshDynamicConfigResponse)(nil), "temporal.server.api.matchingservice.v1.RefreshDynamicConfigResponse")
 }
 
 func init() {
@@ -1344,114 +1693,134 @@ func init() {
 }
 
 var fileDescriptor_a429a3813476c583 = []byte{
-	// 1712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x18, 0x4f, 0x73, 0xdb, 0x58,
-	0x3d, 0x72, 0x12, 0x27, 0x7e, 0xb2, 0x13, 0x47, 0x0b, 0x59, 0x25, 0x6d, 0x94, 0xd4, 0xbb, 0xec,
-	0x66, 0x99, 0x45, 0x9e, 0x86, 0xd9, 0xce, 0xb6, 0xd0, 0x81, 0x34, 0xcd, 0xb4, 0x86, 0xb6, 0xa4,
-	0x8a, 0x07, 0x98, 0x0e, 0x33, 0xea, 0x8b, 0xf4, 0xe2, 0x88, 0xc8, 0x7a, 0x8e, 0xde, 0x93, 0x53,
-	0x73, 0x62, 0xa6, 0xc3, 0xbd, 0x33, 0x5c, 0x60, 0xf8, 0x02, 0x70, 0x86, 0x0f, 0xc1, 0x81, 0x43,
-	0x8f, 0xbd, 0x41, 0x93, 0x0b, 0x33, 0x5c, 0xca, 0x37, 0x60, 0xde, 0x1f, 0xc9, 0x92, 0x6c, 0x27,
-	0x4e, 0x9a, 0xa1, 0x7b, 0xb3, 0x7e, 0xff, 0xff, 0xff, 0x7e, 0xcf, 0xe0, 0x2e, 0x45, 0xed, 0x0e,
-	0x0e, 0xa1, 0x5f, 0x27, 0x28, 0xec, 0xa2, 0xb0, 0x0e, 0x3b, 0x5e, 0xbd, 0x0d, 0xa9, 0x73, 0xe0,
-	0x05, 0x2d, 0x06, 0xf2, 0x1c, 0x54, 0xef, 0xde, 0xac, 0x87, 0xe8, 0x28, 0x42, 0x84, 0xda, 0x21,
-	0x22, 0x1d, 0x1c, 0x10, 0x64, 0x76, 0x42, 0x4c, 0xb1, 0xf6, 0x59, 0xcc, 0x6e, 0x0a, 0x76, 0x13,
-	0x76, 0x3c, 0x33, 0xc7, 0x6e, 0x76, 0x6f, 0x2e, 0x1b, 0x2d, 0x8c, 0x5b, 0x3e, 0xaa, 0x73, 0xae,
-	0xbd, 0x68, 0xbf, 0xee, 0x46, 0x21, 0xa4, 0x1e, 0x0e, 0x84, 0x9c, 0xe5, 0xd5, 0x3c, 0x9e, 0x7a,
-	0x6d, 0x44, 0x28, 0x6c, 0x77, 0x24, 0xc1, 0x0d, 0x17, 0x75, 0x50, 0xe0, 0xa2, 0xc0, 0xf1, 0x10,
-	0xa9, 0xb7, 0x70, 0x0b, 0x73, 0x38, 0xff, 0x25, 0x49, 0x3e, 0x4d, 0x5c, 0x61, 0x3e, 0x38, 0xb8,
-	0xdd, 0xc6, 0x01, 0x33, 0xbd, 0x8d, 0x08, 0x81, 0x2d, 0x69, 0xf1, 0xf2, 0x67, 0x19, 0x2a, 0x14,
-	0x44, 0x6d, 0xc2, 0x88, 0x28, 0x24, 0x87, 0xf6, 0x51, 0x84, 0xa2, 0x98, 0xee, 0xf3, 0x0c, 0x1d,
-	0x43, 0x73, 0xec, 0xa0, 0xc0, 0x4f, 0x32, 0x84, 0x47, 0x11, 0x0a, 0x7b, 0x83, 0x44, 0x9f, 0x0f,
-	0x0b, 0x73, 0x46, 0xb9, 0x24, 0xfc, 0x72, 0x18, 0xe1, 0x81, 0x47, 0x28, 0x1e, 0x26, 0xf6, 0x56,
-	0x46, 0xf7, 0x31, 0x0e, 0x0f, 0xf7, 0x7d, 0x7c, 0x7c, 0x6e, 0xda, 0x6a, 0xff, 0x51, 0xc0, 0xf5,
-	0x1d, 0xec, 0xfb, 0xbf, 0x90, 0x1c, 0x4d, 0x48, 0x0e, 0x9f, 0x32, 0xf7, 0x2c, 0x41, 0xaf, 0xdd,
-	0x00, 0xe5, 0x00, 0xb6, 0x11, 0xe9, 0x40, 0x07, 0xd9, 0x9e, 0xab, 0x2b, 0x6b, 0xca, 0x7a, 0xc9,
-	0x52, 0x13, 0x58, 0xc3, 0xd5, 0xae, 0x81, 0x52, 0x07, 0xfb, 0x3e, 0x0a, 0x19, 0xbe, 0xc0, 0xf1,
-	0xb3, 0x02, 0xd0, 0x70, 0xb5, 0xe7, 0xa0, 0xcc, 0x7e, 0xdb, 0x52, 0xbf, 0x3e, 0xb9, 0xa6, 0xac,
-	0xab, 0x1b, 0x77, 0xcd, 0xa4, 0x5c, 0x58, 0x9d, 0xe4, 0xec, 0x35, 0xbb, 0x37, 0xcd, 0xb3, 0x8c,
-	0xb2, 0x54, 0x26, 0x32, 0xb6, 0xf0, 0x0b, 0x50, 0xdd, 0xc7, 0xe1, 0x31, 0x0c, 0x5d, 0xe4, 0xda,
-	0x04, 0x47, 0xa1, 0x83, 0xf4, 0x29, 0x6e, 0xc5, 0x7c, 0x02, 0xdf, 0xe5, 0xe0, 0xda, 0xcb, 0x12,
-	0x58, 0x19, 0x21, 0x58, 0x44, 0x45, 0x5b, 0x01, 0x80, 0x17, 0x00, 0xc5, 0x87, 0x28, 0xe0, 0xce,
-	0x96, 0xad, 0x12, 0x83, 0x34, 0x19, 0x40, 0xfb, 0x25, 0xd0, 0x62, 0x5b, 0x6d, 0xf4, 0x02, 0x39,
-	0x11, 0xab, 0x5c, 0xee, 0xb3, 0xba, 0xf1, 0x45, 0xd6, 0x27, 0x51, 0x76, 0xcc, 0x95, 0x58, 0xdb,
-	0x76, 0xcc, 0x60, 0x2d, 0x1c, 0xe7, 0x41, 0x5a, 0x03, 0x54, 0x12, 0xc9, 0xb4, 0xd7, 0x41, 0x32,
-	0x50, 0x9f, 0x9e, 0x27, 0xb4, 0xd9, 0xeb, 0x20, 0xab, 0x7c, 0x9c, 0xfa, 0xd2, 0x6e, 0x83, 0xa5,
-	0x4e, 0x88, 0xba, 0x1e, 0x8e, 0x88, 0x4d, 0x28, 0x0c, 0x29, 0x72, 0x6d, 0xd4, 0x45, 0x01, 0x65,
-	0xf9, 0x61, 0x91, 0x99, 0xb4, 0x16, 0x63, 0x82, 0x5d, 0x81, 0xdf, 0x66, 0xe8, 0x86, 0xab, 0xad,
-	0x83, 0xea, 0x00, 0xc7, 0x34, 0xe7, 0x98, 0x23, 0x59, 0x4a, 0x1d, 0xcc, 0x40, 0xca, 0x6c, 0xa3,
-	0x7a, 0x71, 0x4d, 0x59, 0x9f, 0xb6, 0xe2, 0x4f, 0xad, 0x06, 0x2a, 0x01, 0x7a, 0x41, 0xfb, 0x02,
-	0x66, 0xb8, 0x00, 0x95, 0x01, 0x63, 0xee, 0x2f, 0x81, 0xb6, 0x07, 0x9d, 0x43, 0x1f, 0xb7, 0x6c,
-	0x07, 0x47, 0x01, 0xb5, 0x0f, 0xbc, 0x80, 0xea, 0xb3, 0x9c, 0xb0, 0x2a, 0x31, 0x5b, 0x0c, 0xf1,
-	0xd0, 0x0b, 0xa8, 0xf6, 0x35, 0xd0, 0x09, 0xf5, 0x9c, 0xc3, 0x5e, 0x3f, 0xe6, 0x36, 0x0a, 0xe0,
-	0x9e, 0x8f, 0x5c, 0xbd, 0xb4, 0xa6, 0xac, 0xcf, 0x5a, 0x8b, 0x02, 0x9f, 0x84, 0x73, 0x5b, 0x60,
-	0xb5, 0x3b, 0x60, 0x9a, 0xf7, 0xa1, 0x0e, 0x86, 0x45, 0x93, 0xa3, 0xd2, 0xc1, 0x7c, 0xca, 0x00,
-	0x96, 0x60, 0xd1, 0x5a, 0xa9, 0x5c, 0xf3, 0x9a, 0xf0, 0x82, 0x7d, 0xac, 0xab, 0x5c, 0xd0, 0x6d,
-	0x73, 0xd8, 0xb8, 0x93, 0xdd, 0xc9, 0x24, 0x36, 0x43, 0x18, 0x10, 0x0f, 0x05, 0x34, 0x5d, 0x6a,
-	0x8d, 0x60, 0x1f, 0x5b, 0xd5, 0xe3, 0x1c, 0x44, 0x6b, 0x81, 0x95, 0xc1, 0xa2, 0xb2, 0xfb, 0x73,
-	0x48, 0x2f, 0x0f, 0x33, 0x3e, 0x19, 0x44, 0x5c, 0x5d, 0x52, 0xc8, 0xcb, 0x03, 0xa5, 0x95, 0xe0,
-	0x58, 0x2f, 0xef, 0x85, 0x30, 0x70, 0x0e, 0x64, 0x79, 0xcf, 0xf1, 0xf2, 0x56, 0x05, 0x4c, 0x14,
-	0xf8, 0x03, 0x30, 0x47, 0x9c, 0x03, 0xe4, 0x46, 0x3e, 0x72, 0x6d, 0x36, 0x7a, 0xf5, 0x79, 0xae,
-	0x7c, 0xd9, 0x14, 0x73, 0xd9, 0x8c, 0xe7, 0xb2, 0xd9, 0x8c, 0xe7, 0xf2, 0xbd, 0xa9, 0x57, 0xff,
-	0x5c, 0x55, 0xac, 0x4a, 0xc2, 0xc7, 0x30, 0xda, 0x16, 0x28, 0xc7, 0x95, 0xc4, 0xc5, 0x54, 0xc7,
-	0x14, 0xa3, 0x4a, 0x2e, 0x2e, 0xc4, 0x07, 0x33, 0x2c, 0x17, 0x1e, 0x22, 0xfa, 0xc2, 0xda, 0xe4,
-	0xba, 0xba, 0x61, 0x99, 0xe3, 0xad, 0x19, 0xf3, 0xcc, 0x2e, 0x37, 0x9f, 0x0a, 0xa1, 0xdb, 0x01,
-	0x0d, 0x7b, 0x56, 0xac, 0x62, 0xf9, 0x39, 0x28, 0xa7, 0x11, 0x5a, 0x15, 0x4c, 0x1e, 0xa2, 0x9e,
-	0x9c, 0x78, 0xec, 0x27, 0x2b, 0xa7, 0x2e, 0xf4, 0x23, 0x24, 0x3b, 0x7e, 0xcc, 0x72, 0xe2, 0x2c,
-	0x77, 0x0a, 0x5f, 0x2b, 0x3f, 0x99, 0x9a, 0xad, 0x54, 0xe7, 0x92, 0x99, 0xbb, 0xe9, 0x50, 0xaf,
-	0xeb, 0xd1, 0xde, 0x37, 0x6a, 0xe6, 0x8e, 0x32, 0xea, 0xd2, 0x33, 0xf7, 0x1f, 0xb3, 0x62, 0xe6,
-	0x0e, 0x11, 0xfc, 0xa1, 0x67, 0xee, 0x2a, 0x50, 0xa1, 0xb4, 0x8a, 0x85, 0x71, 0x92, 0x3b, 0x00,
-	0x62, 0x50, 0xc3, 0x65, 0x43, 0x39, 0x21, 0xe0, 0x43, 0x79, 0xea, 0xec, 0xa1, 0x9c, 0xf8, 0xc8,
-	0x87, 0x32, 0x4c, 0x7d, 0x69, 0xb7, 0xc0, 0xb4, 0x17, 0x74, 0x22, 0xca, 0xc7, 0xa9, 0xba, 0xb1,
-	0x36, 0x4a, 0xc4, 0x0e, 0xec, 0xf9, 0x18, 0xba, 0xc4, 0x12, 0xe4, 0x43, 0x1a, 0xb2, 0x78, 0xb9,
-	0x86, 0x7c, 0x06, 0x96, 0x62, 0x80, 0x4d, 0xb1, 0xed, 0xf8, 0x98, 0x20, 0x2e, 0x10, 0x47, 0x94,
-	0x8f, 0x68, 0x75, 0x63, 0x69, 0x40, 0xe6, 0x7d, 0x79, 0x9c, 0xdd, 0x9b, 0xfa, 0x03, 0x13, 0xb9,
-	0x18, 0x4b, 0x68, 0xe2, 0x2d, 0xc6, 0xdf, 0x14, 0xec, 0x03, 0xcd, 0x3e, 0x7b, 0x99, 0x66, 0x6f,
-	0x82, 0x45, 0xfe, 0x39, 0x68, 0x5d, 0x69, 0x3c, 0xeb, 0x3e, 0xe2, 0xec, 0x39, 0xd3, 0x1e, 0x81,
-	0x85, 0x03, 0x04, 0x43, 0xba, 0x87, 0x20, 0x4d, 0x04, 0x82, 0xf1, 0x04, 0x56, 0x13, 0xce, 0x58,
-	0x5a, 0x6a, 0xeb, 0xa9, 0xd9, 0xad, 0x87, 0x80, 0xe1, 0x44, 0x61, 0xc8, 0x56, 0x9e, 0x04, 0xd9,
-	0xb9, 0xbc, 0x95, 0xc7, 0x0c, 0xca, 0x35, 0x29, 0x67, 0x53, 0x88, 0xd9, 0xcd, 0x64, 0xf1, 0x71,
-	0xda, 0x1d, 0x17, 0x51, 0xe8, 0xf9, 0x44, 0xaf, 0x8c, 0x59, 0x52, 0x7d, 0x7f, 0xee, 0x0b, 0xce,
-	0xc1, 0xab, 0x63, 0xee, 0xd2, 0x57, 0xc7, 0xf7, 0x52, 0x6d, 0x9a, 0x4c, 0x2a, 0xbe, 0x3d, 0x4a,
-	0xfd, 0xde, 0x7b, 0x12, 0x23, 0xb4, 0x5b, 0xa0, 0x78, 0x80, 0xa0, 0x8b, 0x42, 0xb9, 0x19, 0x8c,
-	0x51, 0x2a, 0x1f, 0x72, 0x2a, 0x4b, 0x52, 0xd7, 0xfe, 0x3a, 0x09, 0x16, 0x37, 0x5d, 0x37, 0x3d,
-	0xdb, 0x2f, 0x30, 0x36, 0x1f, 0x80, 0xd2, 0x7b, 0x8c, 0x90, 0x3e, 0xaf, 0xb6, 0x25, 0x67, 0x96,
-	0x58, 0xd0, 0x93, 0x17, 0x58, 0xd0, 0x7c, 0xb2, 0x89, 0x7d, 0xbc, 0x0a, 0xd4, 0xa4, 0x25, 0x93,
-	0xd3, 0x0c, 0xc4, 0xa0, 0x86, 0x9b, 0xef, 0x59, 0xd9, 0x1e, 0xb2, 0x88, 0xa7, 0x2f, 0xdc, 0xb3,
-	0xfc, 0xd8, 0x8b, 0x4b, 0x79, 0xd8, 0x08, 0x2f, 0x0e, 0x1d, 0xe1, 0xda, 0x8f, 0x41, 0x51, 0x12,
-	0xb0, 0x39, 0x31, 0xb7, 0xb1, 0x3e, 0x74, 0x0b, 0xf3, 0x47, 0x4c, 0xec, 0xab, 0xe0, 0xb4, 0x24,
-	0x5f, 0x6d, 0x09, 0x7c, 0x3c, 0x90, 0x34, 0x31, 0xfd, 0x6b, 0xa7, 0x22, 0xa1, 0xe9, 0xf5, 0xf0,
-	0x21, 0x12, 0x6a, 0x82, 0x8f, 0x84, 0xad, 0x76, 0x46, 0xa5, 0xd8, 0x09, 0x0b, 0x02, 0xf5, 0x24,
-	0xa5, 0x38, 0x5b, 0x00, 0x53, 0x57, 0x52, 0x00, 0xd3, 0x17, 0x2b, 0x80, 0xe2, 0xd5, 0x17, 0xc0,
-	0xcc, 0x79, 0x05, 0x30, 0xfb, 0x5e, 0x05, 0x90, 0x4d, 0xb2, 0x2c, 0x80, 0xdf, 0x15, 0xc0, 0xb7,
-	0xf8, 0xa5, 0x14, 0xe7, 0xe7, 0x02, 0xe9, 0xcf, 0x66, 0xa1, 0x70, 0xb9, 0x2c, 0x3c, 0x03, 0x15,
-	0x7e, 0xba, 0xe5, 0xee, 0xa5, 0xaf, 0xce, 0xbd, 0x97, 0x86, 0x59, 0x6d, 0x95, 0xb9, 0xac, 0x4b,
-	0x1c, 0x4a, 0x7f, 0x51, 0xc0, 0xb7, 0x73, 0x12, 0xe5, 0x81, 0xb4, 0x05, 0xca, 0xb1, 0x81, 0x24,
-	0xf2, 0x29, 0x0f, 0xc4, 0x38, 0xf3, 0x5e, 0x95, 0xa6, 0x30, 0x26, 0xed, 0xa7, 0x60, 0x2e, 0x16,
-	0xf2, 0x6b, 0xe4, 0x50, 0xe4, 0x9e, 0x73, 0xc4, 0x8a, 0xe3, 0x55, 0xd2, 0x5a, 0x95, 0xa3, 0xf4,
-	0x67, 0xed, 0xf7, 0x05, 0xb0, 0x26, 0xcc, 0x73, 0x39, 0x1d, 0x8b, 0xeb, 0x16, 0x6e, 0x77, 0x7c,
-	0xc4, 0x88, 0xff, 0xcf, 0xf9, 0xfb, 0x18, 0xcc, 0x88, 0xf7, 0x59, 0xdc, 0xae, 0x45, 0xf6, 0xd9,
-	0x70, 0xb5, 0x00, 0x2c, 0x38, 0xb1, 0x51, 0x49, 0x72, 0x45, 0xab, 0x6e, 0x9e, 0x9b, 0xdc, 0xf3,
-	0xdc, 0xb3, 0xaa, 0x4e, 0x0e, 0x52, 0xfb, 0x04, 0xdc, 0x38, 0x83, 0x4b, 0x96, 0xfb, 0x7f, 0x15,
-	0x70, 0x7d, 0x0b, 0x06, 0x0e, 0xf2, 0x7f, 0x16, 0x51, 0x42, 0x61, 0xe0, 0x7a, 0x41, 0x6b, 0x27,
-	0x75, 0x5b, 0x8f, 0x11, 0xb6, 0x47, 0x60, 0xbe, 0x1f, 0x36, 0xb1, 0xb8, 0x0b, 0xbc, 0x31, 0x73,
-	0xb1, 0xcb, 0x74, 0x24, 0x0f, 0x16, 0x5f, 0xdc, 0x15, 0x9a, 0xfe, 0xbc, 0x9a, 0x5d, 0x96, 0x79,
-	0x90, 0x4c, 0x65, 0x1f, 0x24, 0xb5, 0x55, 0xb0, 0x32, 0xc2, 0x65, 0x19, 0x94, 0x3f, 0x29, 0x40,
-	0xbf, 0x8f, 0x88, 0x13, 0x7a, 0x7b, 0xe8, 0x32, 0xcf, 0xa1, 0x5f, 0x81, 0xb2, 0x8b, 0x88, 0x93,
-	0x24, 0xb9, 0x90, 0x7f, 0xa5, 0x8f, 0x48, 0xf2, 0x28, 0x9d, 0x96, 0xca, 0xc4, 0xc5, 0x79, 0xfd,
-	0x9b, 0x02, 0x96, 0x86, 0x50, 0xca, 0xee, 0xfc, 0x11, 0x98, 0x11, 0x8e, 0x12, 0x5d, 0xe1, 0x8f,
-	0xd4, 0xef, 0x9c, 0x11, 0xbb, 0x1d, 0x11, 0x92, 0x60, 0x1f, 0x5b, 0x31, 0x97, 0xf6, 0x73, 0xb0,
-	0x90, 0xca, 0x26, 0xa1, 0x90, 0x46, 0x44, 0x7a, 0xf0, 0xdd, 0x71, 0xd2, 0xb0, 0xcb, 0x39, 0xac,
-	0x79, 0x9a, 0x05, 0xd4, 0x5e, 0x2a, 0xc0, 0x78, 0xe4, 0x11, 0x9a, 0x10, 0xee, 0xc0, 0x90, 0x7a,
-	0x6c, 0x33, 0x90, 0x38, 0xb4, 0xd7, 0x41, 0xa9, 0x7f, 0xab, 0x89, 0xb8, 0xf6, 0x01, 0x57, 0xd2,
-	0x9d, 0xb5, 0x3f, 0x16, 0xc0, 0xea, 0x48, 0x2b, 0x64, 0x08, 0x7f, 0x03, 0x8c, 0xfe, 0x3b, 0xab,
-	0x1f, 0x8a, 0x4e, 0x42, 0x29, 0x23, 0xfb, 0xd5, 0x38, 0xca, 0x13, 0xf9, 0x8f, 0x11, 0x85, 0x2e,
-	0xa4, 0xd0, 0xba, 0x06, 0xf3, 0x6f, 0xcf, 0xbe, 0x0d, 0x4c, 0x77, 0xf6, 0x6f, 0x9e, 0x01, 0xdd,
-	0x85, 0xf7, 0xd2, 0x7d, 0x9c, 0xff, 0x17, 0xa2, 0xaf, 0xfb, 0x5e, 0xf8, 0xfa, 0xad, 0x31, 0xf1,
-	0xe6, 0xad, 0x31, 0xf1, 0xee, 0xad, 0xa1, 0xfc, 0xf6, 0xc4, 0x50, 0xfe, 0x7c, 0x62, 0x28, 0x7f,
-	0x3f, 0x31, 0x94, 0xd7, 0x27, 0x86, 0xf2, 0xaf, 0x13, 0x43, 0xf9, 0xf7, 0x89, 0x31, 0xf1, 0xee,
-	0xc4, 0x50, 0x5e, 0x9d, 0x1a, 0x13, 0xaf, 0x4f, 0x8d, 0x89, 0x37, 0xa7, 0xc6, 0xc4, 0xb3, 0x1f,
-	0xb6, 0x70, 0xdf, 0x16, 0x0f, 0x9f, 0xfd, 0x7f, 0xfd, 0x0f, 0x72, 0xa0, 0xbd, 0x22, 0xbf, 0x13,
-	0xbe, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x11, 0x60, 0x20, 0xf0, 0x17, 0x00, 0x00,
+	// 2030 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4b, 0x73, 0xdb, 0xd6,
+	0x15, 0x16, 0xa8, 0x17, 0x79, 0x48, 0xea, 0x01, 0xa7, 0x36, 0x24, 0x5b, 0x94, 0x4c, 0x27, 0x8e,
+	0xd2, 0x49, 0xa9, 0x5a, 0x1d, 0x7b, 0x92, 0xb4, 0x99, 0x46, 0x0f, 0xd7, 0x51, 0xeb, 0x38, 0x32,
+	0xa4, 0x26, 0x1d, 0xb7, 0x33, 0xc8, 0x25, 0x70, 0x44, 0xa1, 0x02, 0x01, 0x1a, 0xf7, 0x82, 0x0a,
+	0xbd, 0x69, 0xa7, 0x9d, 0xee, 0x33, 0xd3, 0x4d, 0x3b, 0xfd, 0x03, 0xed, 0x3f, 0xe9, 0xa2, 0x0b,
+	0x2f, 0xbd, 0x6b, 0x2d, 0x6f, 0x32, 0xd3, 0x4d, 0xba, 0xea, 0xa6, 0x33, 0xed, 0xdc, 0x07, 0x40,
+	0x80, 0x0f, 0x89, 0x52, 0xdc, 0x24, 0x3b, 0xe2, 0xbc, 0xee, 0x79, 0x7c, 0xe7, 0xdc, 0x03, 0x10,
+	0xde, 0x65, 0xd8, 0x6c, 0x05, 0x21, 0xf1, 0xd6, 0x28, 0x86, 0x6d, 0x0c, 0xd7, 0x48, 0xcb, 0x5d,
+	0x6b, 0x12, 0x66, 0x1f, 0xba, 0x7e, 0x83, 0x93, 0x5c, 0x1b, 0xd7, 0xda, 0xb7, 0xd6, 0x42, 0x7c,
+	0x1c, 0x21, 0x65, 0x56, 0x88, 0xb4, 0x15, 0xf8, 0x14, 0x6b, 0xad, 0x30, 0x60, 0x81, 0x7e, 0x33,
+	0x56, 0xaf, 0x49, 0xf5, 0x1a, 0x69, 0xb9, 0xb5, 0x1e, 0xf5, 0x5a, 0xfb, 0xd6, 0x62, 0xa5, 0x11,
+	0x04, 0x0d, 0x0f, 0xd7, 0x84, 0x56, 0x3d, 0x3a, 0x58, 0x73, 0xa2, 0x90, 0x30, 0x37, 0xf0, 0xa5,
+	0x9d, 0xc5, 0xe5, 0x5e, 0x3e, 0x73, 0x9b, 0x48, 0x19, 0x69, 0xb6, 0x94, 0xc0, 0x75, 0x07, 0x5b,
+	0xe8, 0x3b, 0xe8, 0xdb, 0x2e, 0xd2, 0xb5, 0x46, 0xd0, 0x08, 0x04, 0x5d, 0xfc, 0x52, 0x22, 0xaf,
+	0x26, 0xa1, 0xf0, 0x18, 0xec, 0xa0, 0xd9, 0x0c, 0x7c, 0xee, 0x7a, 0x13, 0x29, 0x25, 0x0d, 0xe5,
+	0xf1, 0xe2, 0xcd, 0x8c, 0x14, 0xfa, 0x51, 0x93, 0x72, 0x21, 0x46, 0xe8, 0x91, 0xf5, 0x38, 0xc2,
+	0x28, 0x96, 0x7b, 0x3d, 0x23, 0xc7, 0xd9, 0x82, 0xdb, 0x6f, 0xf0, 0x46, 0x46, 0xf0, 0x71, 0x84,
+	0x61, 0xa7, 0x5f, 0xe8, 0xf5, 0x41, 0x69, 0xce, 0x1c, 0xae, 0x04, 0xdf, 0x1c, 0x24, 0x78, 0xe8,
+	0x52, 0x16, 0x0c, 0x32, 0x5b, 0x1b, 0x24, 0xdd, 0xc2, 0x90, 0xba, 0x94, 0xa1, 0x6f, 0x63, 0x6c,
+	0x9c, 0x9e, 0x26, 0x7f, 0x4a, 0x6c, 0x77, 0x32, 0xb1, 0x1d, 0x07, 0xe1, 0xd1, 0x81, 0x17, 0x1c,
+	0x9f, 0x09, 0x8b, 0xea, 0x3f, 0x35, 0xb8, 0xb6, 0x1b, 0x78, 0xde, 0xc7, 0x4a, 0x63, 0x9f, 0xd0,
+	0xa3, 0x87, 0xfc, 0x08, 0x53, 0xca, 0xeb, 0xd7, 0xa1, 0xe4, 0x93, 0x26, 0xd2, 0x16, 0xb1, 0xd1,
+	0x72, 0x1d, 0x43, 0x5b, 0xd1, 0x56, 0x0b, 0x66, 0x31, 0xa1, 0xed, 0x38, 0xfa, 0x55, 0x28, 0xb4,
+	0x02, 0xcf, 0xc3, 0x90, 0xf3, 0x73, 0x82, 0x9f, 0x97, 0x84, 0x1d, 0x47, 0xff, 0x04, 0x4a, 0xfc,
+	0xb7, 0xa5, 0xce, 0x37, 0xc6, 0x57, 0xb4, 0xd5, 0xe2, 0xfa, 0xbb, 0x49, 0x7c, 0x02, 0x87, 0x3d,
+	0xfe, 0xd6, 0xda, 0xb7, 0x6a, 0xa7, 0x39, 0x65, 0x16, 0xb9, 0xc9, 0xd8, 0xc3, 0x37, 0x60, 0xee,
+	0x20, 0x08, 0x8f, 0x49, 0xe8, 0xa0, 0x63, 0xd1, 0x20, 0x0a, 0x6d, 0x34, 0x26, 0x84, 0x17, 0xb3,
+	0x09, 0x7d, 0x4f, 0x90, 0xab, 0x9f, 0x17, 0x60, 0x69, 0x88, 0x61, 0x99, 0x15, 0x7d, 0x09, 0x40,
+	0x00, 0x8c, 0x05, 0x47, 0xe8, 0x8b, 0x60, 0x4b, 0x66, 0x81, 0x53, 0xf6, 0x39, 0x41, 0xff, 0x19,
+	0xe8, 0xb1, 0xaf, 0x16, 0x7e, 0x8a, 0x76, 0xc4, 0x3b, 0x43, 0xc4, 0x5c, 0x5c, 0x7f, 0x23, 0x1b,
+	0x93, 0x84, 0x35, 0x0f, 0x25, 0x3e, 0xed, 0x6e, 0xac, 0x60, 0xce, 0x1f, 0xf7, 0x92, 0xf4, 0x1d,
+	0x28, 0x27, 0x96, 0x59, 0xa7, 0x85, 0x2a, 0x51, 0xaf, 0x9e, 0x65, 0x74, 0xbf, 0xd3, 0x42, 0xb3,
+	0x74, 0x9c, 0x7a, 0xd2, 0xdf, 0x86, 0x85, 0x56, 0x88, 0x6d, 0x37, 0x88, 0xa8, 0x45, 0x19, 0x09,
+	0x19, 0x3a, 0x16, 0xb6, 0xd1, 0x67, 0xbc, 0x3e, 0x3c, 0x33, 0xe3, 0xe6, 0xe5, 0x58, 0x60, 0x4f,
+	0xf2, 0xef, 0x72, 0xf6, 0x8e, 0xa3, 0xaf, 0xc2, 0x5c, 0x9f, 0xc6, 0xa4, 0xd0, 0x98, 0xa1, 0x59,
+	0x49, 0x03, 0xa6, 0x09, 0xe3, 0xbe, 0x31, 0x63, 0x6a, 0x45, 0x5b, 0x9d, 0x34, 0xe3, 0x47, 0xbd,
+	0x0a, 0x65, 0x1f, 0x3f, 0x65, 0x5d, 0x03, 0xd3, 0xc2, 0x40, 0x91, 0x13, 0x63, 0xed, 0x37, 0x41,
+	0xaf, 0x13, 0xfb, 0xc8, 0x0b, 0x1a, 0x96, 0x1d, 0x44, 0x3e, 0xb3, 0x0e, 0x5d, 0x9f, 0x19, 0x79,
+	0x21, 0x38, 0xa7, 0x38, 0x5b, 0x9c, 0xf1, 0xbe, 0xeb, 0x33, 0xfd, 0x2d, 0x30, 0x28, 0x73, 0xed,
+	0xa3, 0x4e, 0x37, 0xe7, 0x16, 0xfa, 0xa4, 0xee, 0xa1, 0x63, 0x14, 0x56, 0xb4, 0xd5, 0xbc, 0x79,
+	0x59, 0xf2, 0x93, 0x74, 0xde, 0x95, 0x5c, 0xfd, 0x1d, 0x98, 0x14, 0x7d, 0x6e, 0xc0, 0xa0, 0x6c,
+	0x0a, 0x56, 0x3a, 0x99, 0x0f, 0x39, 0xc1, 0x94, 0x2a, 0x7a, 0x23, 0x55, 0x6b, 0x81, 0x09, 0xd7,
+	0x3f, 0x08, 0x8c, 0xa2, 0x30, 0xf4, 0x76, 0x6d, 0xd0, 0x38, 0x55, 0xdd, 0xcf, 0x2d, 0xee, 0x87,
+	0xc4, 0xa7, 0x2e, 0xfa, 0x2c, 0x0d, 0xb5, 0x1d, 0xff, 0x20, 0x30, 0xe7, 0x8e, 0x7b, 0x28, 0x7a,
+	0x03, 0x96, 0xfa, 0x41, 0x65, 0x75, 0xe7, 0x9c, 0x51, 0x1a, 0xe4, 0x7c, 0x32, 0x0c, 0xc4, 0x71,
+	0x09, 0x90, 0x17, 0xfb, 0xa0, 0x95, 0xf0, 0x78, 0x2f, 0xd7, 0x43, 0xe2, 0xdb, 0x87, 0x0a, 0xde,
+	0x33, 0x02, 0xde, 0x45, 0x49, 0x93, 0x00, 0xbf, 0x07, 0x33, 0xd4, 0x3e, 0x44, 0x27, 0xf2, 0xd0,
+	0xb1, 0xf8, 0x68, 0x37, 0x66, 0xc5, 0xe1, 0x8b, 0x35, 0x39, 0xf7, 0x6b, 0xf1, 0xdc, 0xaf, 0xed,
+	0xc7, 0x73, 0x7f, 0x73, 0xe2, 0xb3, 0xbf, 0x2f, 0x6b, 0x66, 0x39, 0xd1, 0xe3, 0x1c, 0x7d, 0x0b,
+	0x4a, 0x31, 0x92, 0x84, 0x99, 0xb9, 0x11, 0xcd, 0x14, 0x95, 0x96, 0x30, 0xe2, 0xc1, 0x34, 0xaf,
+	0x85, 0x8b, 0xd4, 0x98, 0x5f, 0x19, 0x5f, 0x2d, 0xae, 0x9b, 0xb5, 0xd1, 0xae, 0xb1, 0xda, 0xa9,
+	0x5d, 0x5e, 0x7b, 0x28, 0x8d, 0xde, 0xf5, 0x59, 0xd8, 0x31, 0xe3, 0x23, 0x38, 0x28, 0x55, 0x05,
+	0x2d, 0xea, 0x3e, 0x41, 0xab, 0xde, 0x61, 0x48, 0x0d, 0x5d, 0x82, 0x52, 0x71, 0xf6, 0xdc, 0x27,
+	0xb8, 0xc9, 0xe9, 0xfa, 0x6d, 0xb8, 0x42, 0xa3, 0x46, 0x83, 0xcf, 0x54, 0x3b, 0xf0, 0x99, 0xeb,
+	0x47, 0x68, 0x11, 0x6a, 0xf9, 0x78, 0x6c, 0x5c, 0x12, 0x98, 0x7c, 0x45, 0xb1, 0xb7, 0x14, 0x77,
+	0x83, 0x3e, 0xc0, 0xe3, 0xc5, 0x4f, 0xa0, 0x94, 0x3e, 0x5d, 0x9f, 0x83, 0xf1, 0x23, 0xec, 0xa8,
+	0xb1, 0xca, 0x7f, 0x72, 0xcc, 0xb6, 0x89, 0x17, 0xa1, 0x91, 0x1b, 0x54, 0xf6, 0x61, 0x98, 0x15,
+	0x2a, 0xef, 0xe4, 0xde, 0xd2, 0x7e, 0x3c, 0x91, 0x2f, 0xcf, 0xcd, 0x24, 0x83, 0x7d, 0xc3, 0x66,
+	0x6e, 0xdb, 0x65, 0x9d, 0x6f, 0xd4, 0x60, 0x1f, 0xe6, 0xd4, 0x85, 0x07, 0xfb, 0xdf, 0xf2, 0xb0,
+	0x34, 0xc4, 0xf0, 0xd7, 0x3d, 0xd8, 0x97, 0xa1, 0x48, 0x94, 0x57, 0x3c, 0x8d, 0xe3, 0x22, 0x00,
+	0x88, 0x49, 0x3b, 0x0e, 0x9f, 0xfc, 0x89, 0x80, 0x98, 0xfc, 0x13, 0xa7, 0x4f, 0xfe, 0x24, 0x46,
+	0x31, 0xf9, 0x49, 0xea, 0x49, 0xbf, 0x03, 0x93, 0xae, 0xdf, 0x8a, 0x98, 0x98, 0xd9, 0xc5, 0xf5,
+	0x95, 0x61, 0x26, 0x76, 0x49, 0xc7, 0x0b, 0x88, 0x43, 0x4d, 0x29, 0x3e, 0xa0, 0xeb, 0xa7, 0x2e,
+	0xd6, 0xf5, 0x8f, 0x60, 0x21, 0x26, 0x58, 0x2c, 0xb0, 0x6c, 0x2f, 0xa0, 0x28, 0x0c, 0x06, 0x11,
+	0x13, 0xf7, 0x40, 0x71, 0x7d, 0xa1, 0xcf, 0xe6, 0xb6, 0xda, 0x30, 0x37, 0x27, 0xfe, 0xc0, 0x4d,
+	0x5e, 0x8e, 0x2d, 0xec, 0x07, 0x5b, 0x5c, 0x7f, 0x5f, 0xaa, 0xf7, 0x4d, 0x94, 0xfc, 0x45, 0x26,
+	0xca, 0x3e, 0x5c, 0x16, 0x8f, 0xfd, 0xde, 0x15, 0x46, 0xf3, 0xee, 0x92, 0x50, 0xef, 0x71, 0xed,
+	0x3e, 0xcc, 0x1f, 0x22, 0x09, 0x59, 0x1d, 0x09, 0x4b, 0x0c, 0xc2, 0x68, 0x06, 0xe7, 0x12, 0xcd,
+	0xd8, 0x5a, 0xea, 0x6a, 0x2d, 0x66, 0xaf, 0x56, 0x84, 0x8a, 0x1d, 0x85, 0x21, 0xbf, 0x57, 0x15,
+	0xc9, 0xea, 0xa9, 0x5b, 0x69, 0xc4, 0xa4, 0x5c, 0x55, 0x76, 0x36, 0xa4, 0x99, 0xbd, 0x4c, 0x15,
+	0x3f, 0x48, 0x87, 0xe3, 0x20, 0x23, 0xae, 0x47, 0x8d, 0xf2, 0x88, 0x90, 0xea, 0xc6, 0xb3, 0x2d,
+	0x35, 0xfb, 0x57, 0x9b, 0x99, 0x0b, 0xaf, 0x36, 0xdf, 0x49, 0xb5, 0x69, 0x32, 0xa9, 0xc4, 0x15,
+	0x55, 0xe8, 0xf6, 0xde, 0x83, 0x98, 0xa1, 0xdf, 0x81, 0xa9, 0x43, 0x24, 0x0e, 0x86, 0xea, 0xfa,
+	0xa9, 0x0c, 0x3b, 0xf2, 0x7d, 0x21, 0x65, 0x2a, 0xe9, 0xea, 0xbf, 0xc7, 0xe1, 0xf2, 0x86, 0xe3,
+	0xa4, 0x2f, 0x90, 0x73, 0x8c, 0xcd, 0x7b, 0x50, 0xf8, 0x12, 0x23, 0xa4, 0xab, 0xab, 0x6f, 0xa9,
+	0x99, 0x25, 0xb7, 0x80, 0xf1, 0x73, 0x6c, 0x01, 0x05, 0x16, 0xff, 0xe4, 0xf3, 0x27, 0x69, 0xc9,
+	0x64, 0xff, 0x83, 0x98, 0xb4, 0xe3, 0xf4, 0xf6, 0xac, 0x6a, 0x0f, 0x05, 0xe2, 0xc9, 0x73, 0xf7,
+	0xac, 0xd8, 0x28, 0x63, 0x28, 0x0f, 0x1a, 0xe1, 0x53, 0x03, 0x47, 0xb8, 0xfe, 0x1e, 0x4c, 0x29,
+	0x01, 0x3e, 0x27, 0x66, 0xd6, 0x57, 0x07, 0x5e, 0xf5, 0xe2, 0x4d, 0x2c, 0x8e, 0x55, 0x6a, 0x9a,
+	0x4a, 0x4f, 0xbf, 0x09, 0xb3, 0x1c, 0x02, 0x18, 0x5a, 0xf5, 0xc8, 0xf5, 0x1c, 0x1e, 0x6d, 0x5e,
+	0x9c, 0x55, 0x96, 0xe4, 0x4d, 0x4e, 0xdd, 0x71, 0xf4, 0x45, 0xc8, 0xb7, 0x42, 0x37, 0x08, 0x5d,
+	0xd6, 0x11, 0x5d, 0x3f, 0x69, 0x26, 0xcf, 0xd5, 0x05, 0xb8, 0xd2, 0x57, 0x78, 0x79, 0x83, 0x54,
+	0xff, 0x2b, 0x41, 0x91, 0xbe, 0x62, 0xbe, 0x0e, 0x50, 0xd4, 0xe0, 0x92, 0x8c, 0xd7, 0xca, 0x1c,
+	0x29, 0xef, 0x95, 0x79, 0xc9, 0x7a, 0x90, 0x3a, 0x38, 0x0b, 0xa2, 0x89, 0x97, 0x02, 0xa2, 0xc9,
+	0xf3, 0x81, 0x68, 0xea, 0xe5, 0x83, 0x68, 0xfa, 0x2c, 0x10, 0xe5, 0x2f, 0x08, 0xa2, 0xb3, 0xc1,
+	0x91, 0x05, 0x80, 0x02, 0xc7, 0xef, 0x72, 0xf0, 0x8a, 0xd8, 0xc4, 0xe2, 0xda, 0x9d, 0x03, 0x1a,
+	0xd9, 0x0a, 0xe5, 0x2e, 0x56, 0xa1, 0x47, 0x50, 0x16, 0xab, 0x61, 0xcf, 0x3e, 0x76, 0xfb, 0xcc,
+	0x7d, 0x6c, 0x90, 0xd7, 0x66, 0x49, 0xd8, 0xba, 0xc0, 0x22, 0xf6, 0x17, 0x0d, 0xbe, 0xd5, 0x63,
+	0x51, 0x2d, 0x60, 0x5b, 0x50, 0x8a, 0x1d, 0xa4, 0x91, 0xc7, 0x0c, 0x6d, 0xc4, 0xfb, 0xa4, 0xa8,
+	0x5c, 0xe1, 0x4a, 0xfa, 0x4f, 0x60, 0x26, 0x36, 0xf2, 0x4b, 0xb4, 0x19, 0x3a, 0x67, 0x2c, 0xc9,
+	0x72, 0x39, 0x56, 0xb2, 0x66, 0xf9, 0x71, 0xfa, 0xb1, 0xfa, 0xfb, 0x1c, 0xac, 0x48, 0xf7, 0x1c,
+	0x21, 0xc7, 0xf3, 0xba, 0x15, 0x34, 0x5b, 0x1e, 0x72, 0xe1, 0xaf, 0xb8, 0x7e, 0x57, 0x60, 0x5a,
+	0x18, 0x49, 0x5a, 0x79, 0x8a, 0x3f, 0xee, 0x38, 0xba, 0x0f, 0xf3, 0x76, 0xec, 0x54, 0x52, 0x5c,
+	0xd9, 0xc6, 0x1b, 0x67, 0x16, 0xf7, 0xac, 0xf0, 0xcc, 0x39, 0xbb, 0x87, 0x52, 0xbd, 0x01, 0xd7,
+	0x4f, 0xd1, 0x52, 0x70, 0xff, 0x97, 0x06, 0xd7, 0xb6, 0x88, 0x6f, 0xa3, 0xf7, 0x61, 0xc4, 0x28,
+	0x23, 0xbe, 0xe3, 0xfa, 0x8d, 0xdd, 0xd4, 0xee, 0x3e, 0x42, 0xda, 0xee, 0xc3, 0x6c, 0x37, 0x6d,
+	0x72, 0x31, 0xc8, 0x89, 0xa6, 0xed, 0xc9, 0x5d, 0xa6, 0x5b, 0x45, 0xb2, 0xc4, 0x62, 0x50, 0x66,
+	0xe9, 0xc7, 0x97, 0x73, 0x57, 0x66, 0x5e, 0x78, 0x26, 0xb2, 0x2f, 0x3c, 0xd5, 0x65, 0x58, 0x1a,
+	0x12, 0xb2, 0x4a, 0xca, 0x9f, 0x34, 0x30, 0xb6, 0x91, 0xda, 0xa1, 0x5b, 0xc7, 0x8b, 0xbc, 0x6e,
+	0xfd, 0x02, 0x4a, 0x0e, 0x52, 0x3b, 0x29, 0x72, 0xae, 0xf7, 0x53, 0xc3, 0x90, 0x22, 0x0f, 0x3b,
+	0xd3, 0x2c, 0x72, 0x73, 0x71, 0x5d, 0xff, 0xa3, 0xc1, 0xc2, 0x00, 0x49, 0xd5, 0x9d, 0x3f, 0x84,
+	0x69, 0x19, 0x28, 0x35, 0x34, 0xf1, 0xa6, 0xfd, 0xda, 0x29, 0xb9, 0xdb, 0x95, 0x29, 0xe1, 0x5f,
+	0x33, 0x62, 0x2d, 0xfd, 0x23, 0x98, 0x4f, 0x55, 0x93, 0x32, 0xc2, 0x22, 0xaa, 0x22, 0xf8, 0xf6,
+	0x28, 0x65, 0xd8, 0x13, 0x1a, 0xe6, 0x2c, 0xcb, 0x12, 0xf4, 0x1f, 0xc1, 0x24, 0x37, 0x46, 0x55,
+	0x49, 0xbf, 0x3b, 0x70, 0xa0, 0x0f, 0x37, 0x49, 0x4d, 0xa9, 0x5e, 0xfd, 0xad, 0x06, 0x95, 0xfb,
+	0x2e, 0x65, 0x09, 0x77, 0x97, 0x84, 0xcc, 0xe5, 0xb7, 0x0f, 0x8d, 0x4b, 0x74, 0x0d, 0x0a, 0xdd,
+	0x9d, 0x52, 0xd6, 0xa7, 0x4b, 0x78, 0x29, 0x5d, 0x5e, 0xfd, 0x63, 0x0e, 0x96, 0x87, 0x7a, 0xa1,
+	0x4a, 0xf1, 0x04, 0x2a, 0xdd, 0xf7, 0xc1, 0x6e, 0x4a, 0x5b, 0x89, 0xa4, 0xaa, 0xd0, 0xed, 0x51,
+	0x0e, 0x4f, 0xec, 0x7f, 0x80, 0x8c, 0x38, 0x84, 0x11, 0xf3, 0x2a, 0xe9, 0x7d, 0x47, 0xee, 0xfa,
+	0xc0, 0xcf, 0xce, 0x7e, 0xf3, 0xea, 0x3b, 0x3b, 0xf7, 0xa5, 0xce, 0x3e, 0xee, 0xfd, 0x24, 0xd3,
+	0x3d, 0xbb, 0xfa, 0x4c, 0x83, 0xea, 0x4f, 0x5b, 0x0e, 0x61, 0xf8, 0x71, 0x7a, 0x5d, 0xfb, 0x30,
+	0x74, 0x30, 0x74, 0xfd, 0xc6, 0x39, 0x1a, 0x69, 0xa9, 0xaf, 0x54, 0x85, 0x74, 0x97, 0x2f, 0x40,
+	0x3e, 0x59, 0x10, 0xe5, 0xac, 0x9d, 0xae, 0xab, 0xd5, 0xb0, 0x06, 0x97, 0xf8, 0x40, 0x24, 0xcc,
+	0xad, 0x7b, 0xd8, 0x5d, 0x23, 0xe5, 0x28, 0x98, 0xef, 0xb2, 0xe2, 0x55, 0xf2, 0x35, 0x98, 0xa9,
+	0xa3, 0x1d, 0x34, 0xd1, 0x72, 0xf0, 0x80, 0xf0, 0x6b, 0x6d, 0x52, 0x7c, 0xfb, 0x29, 0x4b, 0xea,
+	0xb6, 0x24, 0x56, 0x7f, 0xa3, 0xc1, 0x8d, 0x53, 0x43, 0x53, 0xa5, 0xff, 0x39, 0xcc, 0xb6, 0x31,
+	0xa4, 0x6e, 0xe0, 0xbb, 0x7e, 0xc3, 0xe2, 0x29, 0x53, 0xd7, 0xe4, 0xfa, 0x40, 0xd8, 0xa7, 0xfe,
+	0x3f, 0xe0, 0x89, 0xff, 0x28, 0x51, 0xdd, 0xe6, 0xc9, 0x9e, 0x69, 0x67, 0x9e, 0xab, 0x36, 0x2c,
+	0xdf, 0x43, 0xf6, 0xff, 0xcd, 0x6d, 0xf5, 0x57, 0xb0, 0x32, 0xfc, 0x90, 0xaf, 0x22, 0xca, 0xf7,
+	0xe0, 0xaa, 0x89, 0x07, 0x21, 0xd2, 0xc3, 0xed, 0x8e, 0x4f, 0x9a, 0xae, 0xbd, 0x15, 0xf8, 0x07,
+	0x6e, 0x3a, 0xc2, 0xc3, 0x80, 0x32, 0x8b, 0x38, 0x4e, 0x88, 0x94, 0xc6, 0x11, 0x72, 0xda, 0x86,
+	0x24, 0x55, 0x2b, 0x70, 0x6d, 0xb0, 0x05, 0xe9, 0xfe, 0x66, 0xf8, 0xf4, 0x79, 0x65, 0xec, 0xd9,
+	0xf3, 0xca, 0xd8, 0x17, 0xcf, 0x2b, 0xda, 0xaf, 0x4f, 0x2a, 0xda, 0x9f, 0x4f, 0x2a, 0xda, 0x5f,
+	0x4f, 0x2a, 0xda, 0xd3, 0x93, 0x8a, 0xf6, 0x8f, 0x93, 0x8a, 0xf6, 0xf9, 0x49, 0x65, 0xec, 0x8b,
+	0x93, 0x8a, 0xf6, 0xd9, 0x8b, 0xca, 0xd8, 0xd3, 0x17, 0x95, 0xb1, 0x67, 0x2f, 0x2a, 0x63, 0x8f,
+	0x7e, 0xd0, 0x08, 0xba, 0xd1, 0xb9, 0xc1, 0xe9, 0x7f, 0xe2, 0x7d, 0xbf, 0x87, 0x54, 0x9f, 0x12,
+	0x3b, 0xf3, 0xf7, 0xfe, 0x37, 0x00, 0xe1, 0x21, 0x1a, 0x00, 0x05, 0x1c, 0x00, 0x00,
 }
 
 func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
@@ -1567,6 +1936,12 @@ func (this *PollWorkflowTaskQueueResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.HistorySizeBytes != that1.HistorySizeBytes {
+		return false
+	}
+	if this.SuggestContinueAsNew != that1.SuggestContinueAsNew {
+		return false
+	}
 	return true
 }
 func (this *PollActivityTaskQueueRequest) Equal(that interface{}) bool {
@@ -1747,6 +2122,12 @@ func (this *AddWorkflowTaskRequest) Equal(that interface{}) bool {
 	if this.Source != that1.Source {
 		return false
 	}
+	if this.WorkerBuildId != that1.WorkerBuildId {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
 func (this *AddWorkflowTaskResponse) Equal(that interface{}) bool {
@@ -1819,6 +2200,9 @@ func (this *AddActivityTaskRequest) Equal(that interface{}) bool {
 	if this.Source != that1.Source {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
 func (this *AddActivityTaskResponse) Equal(that interface{}) bool {
@@ -2067,6 +2451,9 @@ func (this *DescribeTaskQueueResponse) Equal(that interface{}) bool {
 	if !this.TaskQueueStatus.Equal(that1.TaskQueueStatus) {
 		return false
 	}
+	if !this.Stats.Equal(that1.Stats) {
+		return false
+	}
 	return true
 }
 func (this *ListTaskQueuePartitionsRequest) Equal(that interface{}) bool {
@@ -2133,30 +2520,186 @@ func (this *ListTaskQueuePartitionsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *PollWorkflowTaskQueueRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateWorkerBuildIdOrderingRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.PollWorkflowTaskQueueRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
-	if this.PollRequest != nil {
-		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
+
+	that1, ok := that.(*UpdateWorkerBuildIdOrderingRequest)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdOrderingRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PollWorkflowTaskQueueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 20)
-	s = append(s, "&matchingservice.PollWorkflowTaskQueueResponse{")
-	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	if this.CompatibleBuildId != that1.CompatibleBuildId {
+		return false
+	}
+	if this.BecomeDefault != that1.BecomeDefault {
+		return false
+	}
+	return true
+}
+func (this *UpdateWorkerBuildIdOrderingResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateWorkerBuildIdOrderingResponse)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdOrderingResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.VersioningData.Equal(that1.VersioningData) {
+		return false
+	}
+	return true
+}
+func (this *GetWorkerBuildIdOrderingRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetWorkerBuildIdOrderingRequest)
+	if !ok {
+		that2, ok := that.(GetWorkerBuildIdOrderingRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	return true
+}
+func (this *GetWorkerBuildIdOrderingResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetWorkerBuildIdOrderingResponse)
+	if !ok {
+		that2, ok := that.(GetWorkerBuildIdOrderingResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.VersioningData.Equal(that1.VersioningData) {
+		return false
+	}
+	return true
+}
+func (this *RefreshDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RefreshDynamicConfigRequest)
+	if !ok {
+		that2, ok := that.(RefreshDynamicConfigRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HostAddress != that1.HostAddress {
+		return false
+	}
+	return true
+}
+func (this *RefreshDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RefreshDynamicConfigResponse)
+	if !ok {
+		that2, ok := that.(RefreshDynamicConfigResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *PollWorkflowTaskQueueRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.PollWorkflowTaskQueueRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
+	if this.PollRequest != nil {
+		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
+	}
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PollWorkflowTaskQueueResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 22)
+	s = append(s, "&matchingservice.PollWorkflowTaskQueueResponse{")
+	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
 	if this.WorkflowType != nil {
 		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
@@ -2192,6 +2735,8 @@ func (this *PollWorkflowTaskQueueResponse) GoString() string {
 	if this.Queries != nil {
 		s = append(s, "Queries: "+mapStringForQueries+",\n")
 	}
+	s = append(s, "HistorySizeBytes: "+fmt.Sprintf("%#v", this.HistorySizeBytes)+",\n")
+	s = append(s, "SuggestContinueAsNew: "+fmt.Sprintf("%#v", this.SuggestContinueAsNew)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2251,7 +2796,7 @@ func (this *AddWorkflowTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 13)
 	s = append(s, "&matchingservice.AddWorkflowTaskRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.Execution != nil {
@@ -2264,6 +2809,8 @@ func (this *AddWorkflowTaskRequest) GoString() string {
 	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
 	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
 	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	s = append(s, "WorkerBuildId: "+fmt.Sprintf("%#v", this.WorkerBuildId)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2280,7 +2827,7 @@ func (this *AddActivityTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&matchingservice.AddActivityTaskRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.Execution != nil {
@@ -2294,6 +2841,7 @@ func (this *AddActivityTaskRequest) GoString() string {
 	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
 	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
 	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2405,7 +2953,7 @@ func (this *DescribeTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&matchingservice.DescribeTaskQueueResponse{")
 	if this.Pollers != nil {
 		s = append(s, "Pollers: "+fmt.Sprintf("%#v", this.Pollers)+",\n")
@@ -2413,6 +2961,9 @@ func (this *DescribeTaskQueueResponse) GoString() string {
 	if this.TaskQueueStatus != nil {
 		s = append(s, "TaskQueueStatus: "+fmt.Sprintf("%#v", this.TaskQueueStatus)+",\n")
 	}
+	if this.Stats != nil {
+		s = append(s, "Stats: "+fmt.Sprintf("%#v", this.Stats)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2444,6 +2995,74 @@ func (this *ListTaskQueuePartitionsResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *UpdateWorkerBuildIdOrderingRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdOrderingRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "CompatibleBuildId: "+fmt.Sprintf("%#v", this.CompatibleBuildId)+",\n")
+	s = append(s, "BecomeDefault: "+fmt.Sprintf("%#v", this.BecomeDefault)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkerBuildIdOrderingResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdOrderingResponse{")
+	if this.VersioningData != nil {
+		s = append(s, "VersioningData: "+fmt.Sprintf("%#v", this.VersioningData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkerBuildIdOrderingRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.GetWorkerBuildIdOrderingRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkerBuildIdOrderingResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.GetWorkerBuildIdOrderingResponse{")
+	if this.VersioningData != nil {
+		s = append(s, "VersioningData: "+fmt.Sprintf("%#v", this.VersioningData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RefreshDynamicConfigRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.RefreshDynamicConfigRequest{")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RefreshDynamicConfigResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.RefreshDynamicConfigResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringRequestResponse(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2528,6 +3147,25 @@ func (m *PollWorkflowTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.SuggestContinueAsNew {
+		i--
+		if m.SuggestContinueAsNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.HistorySizeBytes != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.HistorySizeBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
 	if len(m.Queries) > 0 {
 		for k := range m.Queries {
 			v := m.Queries[k]
@@ -2949,6 +3587,18 @@ func (m *AddWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.WorkerBuildId) > 0 {
+		i -= len(m.WorkerBuildId)
+		copy(dAtA[i:], m.WorkerBuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkerBuildId)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Source != 0 {
 		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Source))
 		i--
@@ -3053,6 +3703,11 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Source != 0 {
 		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Source))
 		i--
@@ -3475,6 +4130,18 @@ func (m *DescribeTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TaskQueueStatus != nil {
 		{
 			size, err := m.TaskQueueStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -3597,33 +4264,254 @@ func (m *ListTaskQueuePartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRequestResponse(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UpdateWorkerBuildIdOrderingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PollWorkflowTaskQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UpdateWorkerBuildIdOrderingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdOrderingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.BecomeDefault {
+		i--
+		if m.BecomeDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.CompatibleBuildId) > 0 {
+		i -= len(m.CompatibleBuildId)
+		copy(dAtA[i:], m.CompatibleBuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CompatibleBuildId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateWorkerBuildIdOrderingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkerBuildIdOrderingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdOrderingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VersioningData != nil {
+		{
+			size, err := m.VersioningData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkerBuildIdOrderingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkerBuildIdOrderingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkerBuildIdOrderingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkerBuildIdOrderingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkerBuildIdOrderingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkerBuildIdOrderingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VersioningData != nil {
+		{
+			size, err := m.VersioningData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequestResponse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PollWorkflowTaskQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.PollerId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	l = len(m.ForwardedSource)
@@ -3706,6 +4594,12 @@ func (m *PollWorkflowTaskQueueResponse) Size() (n int) {
 			n += mapEntrySize + 2 + sovRequestResponse(uint64(mapEntrySize))
 		}
 	}
+	if m.HistorySizeBytes != 0 {
+		n += 2 + sovRequestResponse(uint64(m.HistorySizeBytes))
+	}
+	if m.SuggestContinueAsNew {
+		n += 3
+	}
 	return n
 }
 
@@ -3838,6 +4732,13 @@ func (m *AddWorkflowTaskRequest) Size() (n int) {
 	if m.Source != 0 {
 		n += 1 + sovRequestResponse(uint64(m.Source))
 	}
+	l = len(m.WorkerBuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -3886,6 +4787,9 @@ func (m *AddActivityTaskRequest) Size() (n int) {
 	if m.Source != 0 {
 		n += 1 + sovRequestResponse(uint64(m.Source))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -4040,6 +4944,10 @@ func (m *DescribeTaskQueueResponse) Size() (n int) {
 		l = m.TaskQueueStatus.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -4081,44 +4989,137 @@ func (m *ListTaskQueuePartitionsResponse) Size() (n int) {
 	return n
 }
 
-func sovRequestResponse(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRequestResponse(x uint64) (n int) {
-	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *UpdateWorkerBuildIdOrderingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.CompatibleBuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.BecomeDefault {
+		n += 2
+	}
+	return n
 }
-func (this *PollWorkflowTaskQueueRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UpdateWorkerBuildIdOrderingResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PollWorkflowTaskQueueRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
-		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.VersioningData != nil {
+		l = m.VersioningData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func (this *PollWorkflowTaskQueueResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetWorkerBuildIdOrderingRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForQueries := make([]string, 0, len(this.Queries))
-	for k, _ := range this.Queries {
-		keysForQueries = append(keysForQueries, k)
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
-	for _, k := range keysForQueries {
-		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	mapStringForQueries += "}"
-	s := strings.Join([]string{`&PollWorkflowTaskQueueResponse{`,
-		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
-		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
+	return n
+}
+
+func (m *GetWorkerBuildIdOrderingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VersioningData != nil {
+		l = m.VersioningData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshDynamicConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshDynamicConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovRequestResponse(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRequestResponse(x uint64) (n int) {
+	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *PollWorkflowTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollWorkflowTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
+		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollWorkflowTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForQueries := make([]string, 0, len(this.Queries))
+	for k, _ := range this.Queries {
+		keysForQueries = append(keysForQueries, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
+	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
+	for _, k := range keysForQueries {
+		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
+	}
+	mapStringForQueries += "}"
+	s := strings.Join([]string{`&PollWorkflowTaskQueueResponse{`,
+		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
+		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
 		`StartedEventId:` + fmt.Sprintf("%v", this.StartedEventId) + `,`,
 		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
 		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
@@ -4131,6 +5132,8 @@ func (this *PollWorkflowTaskQueueResponse) String() string {
 		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Queries:` + mapStringForQueries + `,`,
+		`HistorySizeBytes:` + fmt.Sprintf("%v", this.HistorySizeBytes) + `,`,
+		`SuggestContinueAsNew:` + fmt.Sprintf("%v", this.SuggestContinueAsNew) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4185,6 +5188,8 @@ func (this *AddWorkflowTaskRequest) String() string {
 		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
 		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`WorkerBuildId:` + fmt.Sprintf("%v", this.WorkerBuildId) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4211,6 +5216,7 @@ func (this *AddActivityTaskRequest) String() string {
 		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
 		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4315,6 +5321,7 @@ func (this *DescribeTaskQueueResponse) String() string {
 	s := strings.Join([]string{`&DescribeTaskQueueResponse{`,
 		`Pollers:` + repeatedStringForPollers + `,`,
 		`TaskQueueStatus:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueueStatus), "TaskQueueStatus", "v14.TaskQueueStatus", 1) + `,`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "TaskQueueStats", "v17.TaskQueueStats", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4351,6 +5358,70 @@ func (this *ListTaskQueuePartitionsResponse) String() string {
 	}, "")
 	return s
 }
+func (this *UpdateWorkerBuildIdOrderingRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdOrderingRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`CompatibleBuildId:` + fmt.Sprintf("%v", this.CompatibleBuildId) + `,`,
+		`BecomeDefault:` + fmt.Sprintf("%v", this.BecomeDefault) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdOrderingResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdOrderingResponse{`,
+		`VersioningData:` + strings.Replace(fmt.Sprintf("%v", this.VersioningData), "VersioningData", "v18.VersioningData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkerBuildIdOrderingRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkerBuildIdOrderingRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkerBuildIdOrderingResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkerBuildIdOrderingResponse{`,
+		`VersioningData:` + strings.Replace(fmt.Sprintf("%v", this.VersioningData), "VersioningData", "v18.VersioningData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshDynamicConfigRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshDynamicConfigRequest{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshDynamicConfigResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshDynamicConfigResponse{`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringRequestResponse(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -5137,6 +6208,45 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySizeBytes", wireType)
+			}
+			m.HistorySizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestContinueAsNew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuggestContinueAsNew = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -6187,6 +7297,57 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerBuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -6535,6 +7696,25 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7613,6 +8793,42 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &v17.TaskQueueStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7879,6 +9095,640 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdateWorkerBuildIdOrderingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdOrderingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdOrderingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompatibleBuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompatibleBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BecomeDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BecomeDefault = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkerBuildIdOrderingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdOrderingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdOrderingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersioningData == nil {
+				m.VersioningData = &v18.VersioningData{}
+			}
+			if err := m.VersioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkerBuildIdOrderingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkerBuildIdOrderingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkerBuildIdOrderingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkerBuildIdOrderingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkerBuildIdOrderingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkerBuildIdOrderingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersioningData == nil {
+				m.VersioningData = &v18.VersioningData{}
+			}
+			if err := m.VersioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshDynamicConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshDynamicConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshDynamicConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshDynamicConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRequestResponse(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
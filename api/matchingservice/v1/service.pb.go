@@ -54,36 +54,41 @@ func init() {
 }
 
 var fileDescriptor_1a5c83076e651916 = []byte{
-	// 456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbf, 0x6e, 0x1a, 0x31,
-	0x1c, 0x80, 0xcf, 0x4b, 0x07, 0x57, 0x15, 0xea, 0x49, 0x55, 0x55, 0x06, 0x0f, 0x1d, 0x3a, 0xde,
-	0x89, 0xb6, 0x5b, 0xa1, 0x2d, 0x85, 0xfe, 0x93, 0x5a, 0x15, 0xda, 0x4a, 0x95, 0xba, 0x54, 0xe6,
-	0xce, 0xa5, 0x16, 0xc7, 0xf9, 0x6a, 0xfb, 0x2e, 0x62, 0xcb, 0x13, 0x44, 0x19, 0x32, 0xe5, 0x01,
-	0xa2, 0x0c, 0x99, 0xf2, 0x14, 0x19, 0x32, 0x30, 0x32, 0x86, 0x63, 0xc9, 0xc8, 0x23, 0x44, 0x70,
-	0xf8, 0x80, 0x03, 0x22, 0x73, 0x64, 0x03, 0xe3, 0xef, 0xf3, 0x67, 0x89, 0x9f, 0x0c, 0x5f, 0x4a,
-	0xd2, 0x0d, 0x18, 0xc7, 0x9e, 0x2d, 0x08, 0x8f, 0x08, 0xb7, 0x71, 0x40, 0xed, 0x2e, 0x96, 0xce,
-	0x3f, 0xea, 0xb7, 0x27, 0x4b, 0xd4, 0x21, 0x76, 0x54, 0xb2, 0x67, 0x1f, 0xad, 0x80, 0x33, 0xc9,
-	0xcc, 0x67, 0x8a, 0xb2, 0x12, 0xca, 0xc2, 0x01, 0xb5, 0x32, 0x94, 0x15, 0x95, 0x8a, 0x15, 0x4d,
-	0x3b, 0x27, 0xff, 0x43, 0x22, 0xe4, 0x1f, 0x4e, 0x44, 0xc0, 0x7c, 0x31, 0x3b, 0xe6, 0xf9, 0xe5,
-	0x7d, 0x58, 0xf8, 0x3a, 0xdb, 0xfd, 0x23, 0xd9, 0x6d, 0x9e, 0x00, 0xf8, 0xa8, 0xc1, 0x3c, 0xef,
-	0x17, 0xe3, 0x9d, 0xbf, 0x1e, 0xdb, 0xfb, 0x89, 0x45, 0xa7, 0x19, 0x92, 0x90, 0x98, 0x75, 0x4b,
-	0xaf, 0xca, 0x5a, 0x8b, 0x7f, 0x4f, 0x12, 0x8a, 0xef, 0x77, 0xb4, 0x24, 0x17, 0x78, 0x6a, 0xa4,
-	0xa1, 0x55, 0x47, 0xd2, 0x88, 0xca, 0x5e, 0xce, 0xd0, 0x15, 0x3c, 0x57, 0xe8, 0x1a, 0x4b, 0x1a,
-	0x7a, 0x04, 0x60, 0xa1, 0xea, 0xba, 0x8b, 0x77, 0x31, 0x5f, 0xeb, 0xca, 0x33, 0xa0, 0x8a, 0x7b,
-	0x93, 0x9b, 0xcf, 0x66, 0x2d, 0x96, 0x6f, 0x95, 0xb5, 0x08, 0xe6, 0xc9, 0x5a, 0xe6, 0xd3, 0xac,
-	0x03, 0x00, 0x1f, 0x34, 0x43, 0xc2, 0x7b, 0x2a, 0xdb, 0x2c, 0xeb, 0x4a, 0x97, 0x30, 0x95, 0x54,
-	0xc9, 0x49, 0xa7, 0x41, 0xe7, 0x00, 0x3e, 0x49, 0xbe, 0xba, 0xd3, 0x2d, 0x93, 0xde, 0x1a, 0xeb,
-	0x06, 0x1e, 0x91, 0xc4, 0x35, 0x3f, 0xe9, 0xea, 0x37, 0x2a, 0x54, 0xe8, 0xe7, 0x3b, 0x30, 0x2d,
-	0x0d, 0x47, 0x0d, 0xfb, 0x0e, 0xf1, 0xbe, 0x85, 0x52, 0x48, 0xec, 0xbb, 0xd4, 0x6f, 0x4f, 0xfe,
-	0xa8, 0xfa, 0xc3, 0xb1, 0x16, 0xdf, 0x7a, 0x38, 0x36, 0x58, 0xd2, 0xd0, 0x63, 0x00, 0x1f, 0xd6,
-	0x89, 0x70, 0x38, 0x6d, 0x91, 0xf9, 0x04, 0xbf, 0xd5, 0xd5, 0xaf, 0xa0, 0x2a, 0xb0, 0xba, 0x83,
-	0x21, 0x8d, 0x3b, 0x03, 0xf0, 0xf1, 0x17, 0x2a, 0x64, 0xfa, 0x5b, 0x03, 0x73, 0x49, 0x25, 0x65,
-	0xbe, 0x30, 0x3f, 0xe8, 0x1e, 0xb0, 0x41, 0xa0, 0x42, 0x3f, 0xee, 0xec, 0x51, 0xb9, 0xef, 0x78,
-	0x7f, 0x88, 0x8c, 0xc1, 0x10, 0x19, 0xe3, 0x21, 0x02, 0xfb, 0x31, 0x02, 0xa7, 0x31, 0x02, 0x17,
-	0x31, 0x02, 0xfd, 0x18, 0x81, 0xab, 0x18, 0x81, 0xeb, 0x18, 0x19, 0xe3, 0x18, 0x81, 0xc3, 0x11,
-	0x32, 0xfa, 0x23, 0x64, 0x0c, 0x46, 0xc8, 0xf8, 0x5d, 0x6e, 0xb3, 0x79, 0x02, 0x65, 0xb7, 0xbf,
-	0x24, 0xaf, 0x32, 0x4b, 0xad, 0x7b, 0xd3, 0x97, 0xe4, 0xc5, 0x4d, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x04, 0x9e, 0xce, 0x0a, 0xe8, 0x06, 0x00, 0x00,
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x3f, 0x6f, 0xd4, 0x3e,
+	0x18, 0xc7, 0xe3, 0xe5, 0x37, 0x58, 0xfa, 0xe9, 0x44, 0x04, 0x02, 0x8a, 0xe4, 0x81, 0x81, 0x31,
+	0x51, 0x81, 0x8d, 0x16, 0xb8, 0xde, 0x41, 0x5b, 0xfe, 0xa8, 0x7f, 0x00, 0x21, 0xb1, 0x20, 0x37,
+	0x79, 0x2e, 0xb5, 0x9a, 0x8b, 0x83, 0xed, 0x1c, 0xba, 0x8d, 0x57, 0x80, 0x18, 0x98, 0x58, 0x91,
+	0x10, 0x03, 0x12, 0x12, 0x13, 0x2f, 0x81, 0xf1, 0xc6, 0x6e, 0x70, 0xb9, 0x85, 0xb1, 0x2f, 0x01,
+	0x5d, 0x73, 0x76, 0xef, 0x6f, 0xe5, 0x26, 0xdd, 0x12, 0xe7, 0xf9, 0x7e, 0x9e, 0x8f, 0x23, 0x3f,
+	0x92, 0xf1, 0x6d, 0x05, 0xed, 0x94, 0x0b, 0x1a, 0xfb, 0x12, 0x44, 0x07, 0x84, 0x4f, 0x53, 0xe6,
+	0xb7, 0xa9, 0x0a, 0xf6, 0x59, 0x12, 0x0d, 0x97, 0x58, 0x00, 0x7e, 0x67, 0xd9, 0x1f, 0x3d, 0x7a,
+	0xa9, 0xe0, 0x8a, 0xbb, 0x37, 0x74, 0xca, 0x2b, 0x52, 0x1e, 0x4d, 0x99, 0x37, 0x95, 0xf2, 0x3a,
+	0xcb, 0x4b, 0xab, 0x96, 0x74, 0x01, 0x6f, 0x32, 0x90, 0xea, 0xb5, 0x00, 0x99, 0xf2, 0x44, 0x8e,
+	0xda, 0xdc, 0xfc, 0x5d, 0xc3, 0xb5, 0xa7, 0xa3, 0xea, 0x67, 0x45, 0xb5, 0xfb, 0x05, 0xe1, 0x4b,
+	0xdb, 0x3c, 0x8e, 0x5f, 0x72, 0x71, 0xd0, 0x8a, 0xf9, 0xdb, 0xe7, 0x54, 0x1e, 0xec, 0x64, 0x90,
+	0x81, 0xdb, 0xf4, 0xec, 0xac, 0xbc, 0xb9, 0xf1, 0xdd, 0x42, 0x61, 0xe9, 0x41, 0x45, 0x4a, 0xb1,
+	0x81, 0xeb, 0x8e, 0x11, 0xad, 0x07, 0x8a, 0x75, 0x98, 0xea, 0x96, 0x14, 0x9d, 0x89, 0x97, 0x12,
+	0x9d, 0x43, 0x31, 0xa2, 0x1f, 0x11, 0xae, 0xd5, 0xc3, 0x70, 0x7c, 0x2f, 0xee, 0x5d, 0x5b, 0xf8,
+	0x54, 0x50, 0xcb, 0xdd, 0x2b, 0x9d, 0x9f, 0xd6, 0x1a, 0x37, 0x3f, 0x93, 0xd6, 0x78, 0xb0, 0x8c,
+	0xd6, 0x64, 0xde, 0x68, 0xbd, 0x47, 0xf8, 0xff, 0x9d, 0x0c, 0x44, 0x57, 0x6b, 0xbb, 0x2b, 0xb6,
+	0xd0, 0x89, 0x98, 0x56, 0x5a, 0x2d, 0x99, 0x36, 0x42, 0x3f, 0x10, 0xbe, 0x5a, 0xbc, 0x86, 0xc7,
+	0x25, 0x43, 0xdf, 0x06, 0x6f, 0xa7, 0x31, 0x28, 0x08, 0xdd, 0x0d, 0x5b, 0xfc, 0x42, 0x84, 0x16,
+	0xdd, 0x3c, 0x07, 0xd2, 0xc4, 0x70, 0x34, 0x68, 0x12, 0x40, 0xbc, 0x95, 0x29, 0xa9, 0x68, 0x12,
+	0xb2, 0x24, 0x1a, 0x1e, 0x54, 0xfb, 0xe1, 0x98, 0x1b, 0x3f, 0xf3, 0x70, 0x2c, 0xa0, 0x18, 0xd1,
+	0x4f, 0x08, 0x5f, 0x68, 0x82, 0x0c, 0x04, 0xdb, 0x83, 0x93, 0x09, 0xbe, 0x6f, 0x8b, 0x9f, 0x89,
+	0x6a, 0xc1, 0x7a, 0x05, 0x82, 0x91, 0xfb, 0x86, 0xf0, 0xe5, 0x27, 0x4c, 0x2a, 0xf3, 0x6d, 0x9b,
+	0x0a, 0xc5, 0x14, 0xe3, 0x89, 0x74, 0x1f, 0xda, 0x36, 0x58, 0x00, 0xd0, 0xa2, 0xeb, 0x95, 0x39,
+	0x46, 0xf7, 0x27, 0xc2, 0xd7, 0x5e, 0xa4, 0x21, 0x55, 0x30, 0x3c, 0xc6, 0x20, 0xd6, 0x32, 0x16,
+	0x87, 0x9b, 0xe1, 0x96, 0x08, 0x41, 0xb0, 0x24, 0x72, 0x1f, 0xd9, 0xb6, 0x3a, 0x05, 0xa2, 0xb5,
+	0x1f, 0x9f, 0x0b, 0xcb, 0xa8, 0x7f, 0x47, 0xf8, 0xca, 0x3a, 0xa8, 0xf9, 0xde, 0xd6, 0xbf, 0x68,
+	0x11, 0x41, 0x4b, 0x6f, 0x54, 0x07, 0x19, 0xe3, 0xcf, 0x08, 0x5f, 0xdc, 0x85, 0x96, 0x00, 0xb9,
+	0xdf, 0xec, 0x26, 0xb4, 0xcd, 0x82, 0x06, 0x4f, 0x5a, 0x2c, 0x72, 0x1b, 0xf6, 0x73, 0x3c, 0x9b,
+	0xd6, 0xa6, 0xcd, 0x6a, 0x10, 0x6d, 0xb9, 0x26, 0x7a, 0x7d, 0xe2, 0x1c, 0xf6, 0x89, 0x73, 0xd4,
+	0x27, 0xe8, 0x5d, 0x4e, 0xd0, 0xd7, 0x9c, 0xa0, 0x5f, 0x39, 0x41, 0xbd, 0x9c, 0xa0, 0x3f, 0x39,
+	0x41, 0x7f, 0x73, 0xe2, 0x1c, 0xe5, 0x04, 0x7d, 0x18, 0x10, 0xa7, 0x37, 0x20, 0xce, 0xe1, 0x80,
+	0x38, 0xaf, 0x56, 0x22, 0x7e, 0xd2, 0x9f, 0xf1, 0xd3, 0x2f, 0x17, 0x77, 0xa6, 0x96, 0xf6, 0xfe,
+	0x3b, 0xbe, 0x5c, 0xdc, 0xfa, 0x37, 0x00, 0x7d, 0x8f, 0xce, 0x3f, 0xfb, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -127,6 +132,12 @@ type MatchingServiceClient interface {
 	DescribeTaskQueue(ctx context.Context, in *DescribeTaskQueueRequest, opts ...grpc.CallOption) (*DescribeTaskQueueResponse, error)
 	// ListTaskQueuePartitions returns a map of partitionKey and hostAddress for a task queue.
 	ListTaskQueuePartitions(ctx context.Context, in *ListTaskQueuePartitionsRequest, opts ...grpc.CallOption) (*ListTaskQueuePartitionsResponse, error)
+	// UpdateWorkerBuildIdOrdering adds a worker build ID to the compatible-version graph of a task queue.
+	UpdateWorkerBuildIdOrdering(ctx context.Context, in *UpdateWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*UpdateWorkerBuildIdOrderingResponse, error)
+	// GetWorkerBuildIdOrdering returns the compatible-version graph of a task queue.
+	GetWorkerBuildIdOrdering(ctx context.Context, in *GetWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*GetWorkerBuildIdOrderingResponse, error)
+	// RefreshDynamicConfig reloads dynamic config stored in persistence on the given host.
+	RefreshDynamicConfig(ctx context.Context, in *RefreshDynamicConfigRequest, opts ...grpc.CallOption) (*RefreshDynamicConfigResponse, error)
 }
 
 type matchingServiceClient struct {
@@ -218,6 +229,33 @@ func (c *matchingServiceClient) ListTaskQueuePartitions(ctx context.Context, in
 	return out, nil
 }
 
+func (c *matchingServiceClient) UpdateWorkerBuildIdOrdering(ctx context.Context, in *UpdateWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*UpdateWorkerBuildIdOrderingResponse, error) {
+	out := new(UpdateWorkerBuildIdOrderingResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerBuildIdOrdering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetWorkerBuildIdOrdering(ctx context.Context, in *GetWorkerBuildIdOrderingRequest, opts ...grpc.CallOption) (*GetWorkerBuildIdOrderingResponse, error) {
+	out := new(GetWorkerBuildIdOrderingResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerBuildIdOrdering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) RefreshDynamicConfig(ctx context.Context, in *RefreshDynamicConfigRequest, opts ...grpc.CallOption) (*RefreshDynamicConfigResponse, error) {
+	out := new(RefreshDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/RefreshDynamicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MatchingServiceServer is the server API for MatchingService service.
 type MatchingServiceServer interface {
 	// PollWorkflowTaskQueue is called by frontend to process WorkflowTask from a specific task queue.  A
@@ -249,6 +287,12 @@ type MatchingServiceServer interface {
 	DescribeTaskQueue(context.Context, *DescribeTaskQueueRequest) (*DescribeTaskQueueResponse, error)
 	// ListTaskQueuePartitions returns a map of partitionKey and hostAddress for a task queue.
 	ListTaskQueuePartitions(context.Context, *ListTaskQueuePartitionsRequest) (*ListTaskQueuePartitionsResponse, error)
+	// UpdateWorkerBuildIdOrdering adds a worker build ID to the compatible-version graph of a task queue.
+	UpdateWorkerBuildIdOrdering(context.Context, *UpdateWorkerBuildIdOrderingRequest) (*UpdateWorkerBuildIdOrderingResponse, error)
+	// GetWorkerBuildIdOrdering returns the compatible-version graph of a task queue.
+	GetWorkerBuildIdOrdering(context.Context, *GetWorkerBuildIdOrderingRequest) (*GetWorkerBuildIdOrderingResponse, error)
+	// RefreshDynamicConfig reloads dynamic config stored in persistence on the given host.
+	RefreshDynamicConfig(context.Context, *RefreshDynamicConfigRequest) (*RefreshDynamicConfigResponse, error)
 }
 
 // UnimplementedMatchingServiceServer can be embedded to have forward compatible implementations.
@@ -282,6 +326,15 @@ func (*UnimplementedMatchingServiceServer) DescribeTaskQueue(ctx context.Context
 func (*UnimplementedMatchingServiceServer) ListTaskQueuePartitions(ctx context.Context, req *ListTaskQueuePartitionsRequest) (*ListTaskQueuePartitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueuePartitions not implemented")
 }
+func (*UnimplementedMatchingServiceServer) UpdateWorkerBuildIdOrdering(ctx context.Context, req *UpdateWorkerBuildIdOrderingRequest) (*UpdateWorkerBuildIdOrderingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerBuildIdOrdering not implemented")
+}
+func (*UnimplementedMatchingServiceServer) GetWorkerBuildIdOrdering(ctx context.Context, req *GetWorkerBuildIdOrderingRequest) (*GetWorkerBuildIdOrderingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerBuildIdOrdering not implemented")
+}
+func (*UnimplementedMatchingServiceServer) RefreshDynamicConfig(ctx context.Context, req *RefreshDynamicConfigRequest) (*RefreshDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshDynamicConfig not implemented")
+}
 
 func RegisterMatchingServiceServer(s *grpc.Server, srv MatchingServiceServer) {
 	s.RegisterService(&_MatchingService_serviceDesc, srv)
@@ -449,6 +502,60 @@ func _MatchingService_ListTaskQueuePartitions_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_UpdateWorkerBuildIdOrdering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkerBuildIdOrderingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateWorkerBuildIdOrdering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerBuildIdOrdering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateWorkerBuildIdOrdering(ctx, req.(*UpdateWorkerBuildIdOrderingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetWorkerBuildIdOrdering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerBuildIdOrderingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetWorkerBuildIdOrdering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/GetWorkerBuildIdOrdering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetWorkerBuildIdOrdering(ctx, req.(*GetWorkerBuildIdOrderingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_RefreshDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).RefreshDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/RefreshDynamicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).RefreshDynamicConfig(ctx, req.(*RefreshDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MatchingService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.matchingservice.v1.MatchingService",
 	HandlerType: (*MatchingServiceServer)(nil),
@@ -489,6 +596,18 @@ var _MatchingService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListTaskQueuePartitions",
 			Handler:    _MatchingService_ListTaskQueuePartitions_Handler,
 		},
+		{
+			MethodName: "UpdateWorkerBuildIdOrdering",
+			Handler:    _MatchingService_UpdateWorkerBuildIdOrdering_Handler,
+		},
+		{
+			MethodName: "GetWorkerBuildIdOrdering",
+			Handler:    _MatchingService_GetWorkerBuildIdOrdering_Handler,
+		},
+		{
+			MethodName: "RefreshDynamicConfig",
+			Handler:    _MatchingService_RefreshDynamicConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/matchingservice/v1/service.proto",
@@ -82,6 +82,10 @@ type ShardInfo struct {
 	ClusterReplicationLevel      map[string]int64      `protobuf:"bytes,12,rep,name=cluster_replication_level,json=clusterReplicationLevel,proto3" json:"cluster_replication_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	ReplicationDlqAckLevel       map[string]int64      `protobuf:"bytes,13,rep,name=replication_dlq_ack_level,json=replicationDlqAckLevel,proto3" json:"replication_dlq_ack_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	VisibilityAckLevel           int64                 `protobuf:"varint,14,opt,name=visibility_ack_level,json=visibilityAckLevel,proto3" json:"visibility_ack_level,omitempty"`
+	// Approximate number of open workflow executions on the shard keyed by namespace id.
+	OpenExecutionCounts map[string]*OpenExecutionCounts `protobuf:"bytes,16,rep,name=open_execution_counts,json=openExecutionCounts,proto3" json:"open_execution_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Replication ack levels of remote clusters with more history shards than this cluster, keyed by cluster name.
+	ClusterShardReplicationLevel map[string]*RemoteShardReplicationLevels `protobuf:"bytes,17,rep,name=cluster_shard_replication_level,json=clusterShardReplicationLevel,proto3" json:"cluster_shard_replication_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *ShardInfo) Reset()      { *m = ShardInfo{} }
@@ -214,6 +218,116 @@ func (m *ShardInfo) GetVisibilityAckLevel() int64 {
 	return 0
 }
 
+func (m *ShardInfo) GetOpenExecutionCounts() map[string]*OpenExecutionCounts {
+	if m != nil {
+		return m.OpenExecutionCounts
+	}
+	return nil
+}
+
+func (m *ShardInfo) GetClusterShardReplicationLevel() map[string]*RemoteShardReplicationLevels {
+	if m != nil {
+		return m.ClusterShardReplicationLevel
+	}
+	return nil
+}
+
+type RemoteShardReplicationLevels struct {
+	// Keyed by shard id of the remote cluster.
+	ShardLevels map[int32]int64 `protobuf:"bytes,1,rep,name=shard_levels,json=shardLevels,proto3" json:"shard_levels,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *RemoteShardReplicationLevels) Reset()      { *m = RemoteShardReplicationLevels{} }
+func (*RemoteShardReplicationLevels) ProtoMessage() {}
+func (*RemoteShardReplicationLevels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a714d0e7ba9f37, []int{1}
+}
+func (m *RemoteShardReplicationLevels) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteShardReplicationLevels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteShardReplicationLevels.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteShardReplicationLevels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteShardReplicationLevels.Merge(m, src)
+}
+func (m *RemoteShardReplicationLevels) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteShardReplicationLevels) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteShardReplicationLevels.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteShardReplicationLevels proto.InternalMessageInfo
+
+func (m *RemoteShardReplicationLevels) GetShardLevels() map[int32]int64 {
+	if m != nil {
+		return m.ShardLevels
+	}
+	return nil
+}
+
+type OpenExecutionCounts struct {
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// Keyed by workflow type name.
+	WorkflowTypes map[string]int64 `protobuf:"bytes,2,rep,name=workflow_types,json=workflowTypes,proto3" json:"workflow_types,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *OpenExecutionCounts) Reset()      { *m = OpenExecutionCounts{} }
+func (*OpenExecutionCounts) ProtoMessage() {}
+func (*OpenExecutionCounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a714d0e7ba9f37, []int{2}
+}
+func (m *OpenExecutionCounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenExecutionCounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenExecutionCounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OpenExecutionCounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenExecutionCounts.Merge(m, src)
+}
+func (m *OpenExecutionCounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenExecutionCounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenExecutionCounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenExecutionCounts proto.InternalMessageInfo
+
+func (m *OpenExecutionCounts) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *OpenExecutionCounts) GetWorkflowTypes() map[string]int64 {
+	if m != nil {
+		return m.WorkflowTypes
+	}
+	return nil
+}
+
 // execution column
 type WorkflowExecutionInfo struct {
 	NamespaceId                       string         `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -271,12 +385,22 @@ type WorkflowExecutionInfo struct {
 	ExecutionTime        *time.Time `protobuf:"bytes,60,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time,omitempty"`
 	// If continued-as-new, or retried, or cron, holds the new run id.
 	NewExecutionRunId string `protobuf:"bytes,61,opt,name=new_execution_run_id,json=newExecutionRunId,proto3" json:"new_execution_run_id,omitempty"`
+	// Build ID (binary checksum) of the worker that completed the last workflow task.
+	WorkerBuildId string `protobuf:"bytes,62,opt,name=worker_build_id,json=workerBuildId,proto3" json:"worker_build_id,omitempty"`
+	// Updates accepted by the workflow execution, keyed by update id. Completed updates are kept
+	// to deduplicate retries, up to the history.maxCompletedUpdates most recent ones.
+	UpdateInfos map[string]*UpdateInfo `protobuf:"bytes,63,rep,name=update_infos,json=updateInfos,proto3" json:"update_infos,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Set once history size or event count crossed the soft limit which suggests continue-as-new.
+	SuggestContinueAsNew bool `protobuf:"varint,64,opt,name=suggest_continue_as_new,json=suggestContinueAsNew,proto3" json:"suggest_continue_as_new,omitempty"`
+	// Priority of the workflow tasks and the default priority of the activity tasks of this execution,
+	// 0 means default priority.
+	Priority int32 `protobuf:"varint,65,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *WorkflowExecutionInfo) Reset()      { *m = WorkflowExecutionInfo{} }
 func (*WorkflowExecutionInfo) ProtoMessage() {}
 func (*WorkflowExecutionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{1}
+	return fileDescriptor_67a714d0e7ba9f37, []int{3}
 }
 func (m *WorkflowExecutionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -662,6 +786,95 @@ func (m *WorkflowExecutionInfo) GetNewExecutionRunId() string {
 	return ""
 }
 
+func (m *WorkflowExecutionInfo) GetWorkerBuildId() string {
+	if m != nil {
+		return m.WorkerBuildId
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetUpdateInfos() map[string]*UpdateInfo {
+	if m != nil {
+		return m.UpdateInfos
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetSuggestContinueAsNew() bool {
+	if m != nil {
+		return m.SuggestContinueAsNew
+	}
+	return false
+}
+
+func (m *WorkflowExecutionInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type UpdateInfo struct {
+	// WorkflowExecutionSignaled event which recorded the accepted update.
+	AcceptedEventId int64 `protobuf:"varint,1,opt,name=accepted_event_id,json=acceptedEventId,proto3" json:"accepted_event_id,omitempty"`
+	// MarkerRecorded event with the update outcome, empty until the update is completed.
+	CompletedEventId      int64 `protobuf:"varint,2,opt,name=completed_event_id,json=completedEventId,proto3" json:"completed_event_id,omitempty"`
+	CompletedEventBatchId int64 `protobuf:"varint,3,opt,name=completed_event_batch_id,json=completedEventBatchId,proto3" json:"completed_event_batch_id,omitempty"`
+}
+
+func (m *UpdateInfo) Reset()      { *m = UpdateInfo{} }
+func (*UpdateInfo) ProtoMessage() {}
+func (*UpdateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a714d0e7ba9f37, []int{4}
+}
+func (m *UpdateInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateInfo.Merge(m, src)
+}
+func (m *UpdateInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateInfo proto.InternalMessageInfo
+
+func (m *UpdateInfo) GetAcceptedEventId() int64 {
+	if m != nil {
+		return m.AcceptedEventId
+	}
+	return 0
+}
+
+func (m *UpdateInfo) GetCompletedEventId() int64 {
+	if m != nil {
+		return m.CompletedEventId
+	}
+	return 0
+}
+
+func (m *UpdateInfo) GetCompletedEventBatchId() int64 {
+	if m != nil {
+		return m.CompletedEventBatchId
+	}
+	return 0
+}
+
 type ExecutionStats struct {
 	HistorySize int64 `protobuf:"varint,1,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
 }
@@ -669,7 +882,7 @@ type ExecutionStats struct {
 func (m *ExecutionStats) Reset()      { *m = ExecutionStats{} }
 func (*ExecutionStats) ProtoMessage() {}
 func (*ExecutionStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{2}
+	return fileDescriptor_67a714d0e7ba9f37, []int{5}
 }
 func (m *ExecutionStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +929,7 @@ type WorkflowExecutionState struct {
 func (m *WorkflowExecutionState) Reset()      { *m = WorkflowExecutionState{} }
 func (*WorkflowExecutionState) ProtoMessage() {}
 func (*WorkflowExecutionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{3}
+	return fileDescriptor_67a714d0e7ba9f37, []int{6}
 }
 func (m *WorkflowExecutionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -793,7 +1006,7 @@ type TransferTaskInfo struct {
 func (m *TransferTaskInfo) Reset()      { *m = TransferTaskInfo{} }
 func (*TransferTaskInfo) ProtoMessage() {}
 func (*TransferTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{4}
+	return fileDescriptor_67a714d0e7ba9f37, []int{7}
 }
 func (m *TransferTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,7 +1145,7 @@ type ReplicationTaskInfo struct {
 func (m *ReplicationTaskInfo) Reset()      { *m = ReplicationTaskInfo{} }
 func (*ReplicationTaskInfo) ProtoMessage() {}
 func (*ReplicationTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{5}
+	return fileDescriptor_67a714d0e7ba9f37, []int{8}
 }
 func (m *ReplicationTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1060,7 +1273,7 @@ type VisibilityTaskInfo struct {
 func (m *VisibilityTaskInfo) Reset()      { *m = VisibilityTaskInfo{} }
 func (*VisibilityTaskInfo) ProtoMessage() {}
 func (*VisibilityTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{6}
+	return fileDescriptor_67a714d0e7ba9f37, []int{9}
 }
 func (m *VisibilityTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1163,7 +1376,7 @@ type TimerTaskInfo struct {
 func (m *TimerTaskInfo) Reset()      { *m = TimerTaskInfo{} }
 func (*TimerTaskInfo) ProtoMessage() {}
 func (*TimerTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{7}
+	return fileDescriptor_67a714d0e7ba9f37, []int{10}
 }
 func (m *TimerTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1307,12 +1520,14 @@ type ActivityInfo struct {
 	ScheduleId                  int64          `protobuf:"varint,30,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 	LastHeartbeatDetails        *v11.Payloads  `protobuf:"bytes,31,opt,name=last_heartbeat_details,json=lastHeartbeatDetails,proto3" json:"last_heartbeat_details,omitempty"`
 	LastHeartbeatUpdateTime     *time.Time     `protobuf:"bytes,32,opt,name=last_heartbeat_update_time,json=lastHeartbeatUpdateTime,proto3,stdtime" json:"last_heartbeat_update_time,omitempty"`
+	// Priority of the activity task, 0 means default priority.
+	Priority int32 `protobuf:"varint,33,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *ActivityInfo) Reset()      { *m = ActivityInfo{} }
 func (*ActivityInfo) ProtoMessage() {}
 func (*ActivityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{8}
+	return fileDescriptor_67a714d0e7ba9f37, []int{11}
 }
 func (m *ActivityInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,6 +1766,13 @@ func (m *ActivityInfo) GetLastHeartbeatUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *ActivityInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 // timer_map column
 type TimerInfo struct {
 	Version    int64      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -1564,7 +1786,7 @@ type TimerInfo struct {
 func (m *TimerInfo) Reset()      { *m = TimerInfo{} }
 func (*TimerInfo) ProtoMessage() {}
 func (*TimerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{9}
+	return fileDescriptor_67a714d0e7ba9f37, []int{12}
 }
 func (m *TimerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,12 +1862,14 @@ type ChildExecutionInfo struct {
 	WorkflowTypeName      string                `protobuf:"bytes,10,opt,name=workflow_type_name,json=workflowTypeName,proto3" json:"workflow_type_name,omitempty"`
 	ParentClosePolicy     v14.ParentClosePolicy `protobuf:"varint,11,opt,name=parent_close_policy,json=parentClosePolicy,proto3,enum=temporal.api.enums.v1.ParentClosePolicy" json:"parent_close_policy,omitempty"`
 	InitiatedId           int64                 `protobuf:"varint,12,opt,name=initiated_id,json=initiatedId,proto3" json:"initiated_id,omitempty"`
+	// Priority of the child workflow execution, 0 means default priority.
+	Priority int32 `protobuf:"varint,13,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *ChildExecutionInfo) Reset()      { *m = ChildExecutionInfo{} }
 func (*ChildExecutionInfo) ProtoMessage() {}
 func (*ChildExecutionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{10}
+	return fileDescriptor_67a714d0e7ba9f37, []int{13}
 }
 func (m *ChildExecutionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1744,6 +1968,13 @@ func (m *ChildExecutionInfo) GetInitiatedId() int64 {
 	return 0
 }
 
+func (m *ChildExecutionInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 // request_cancel_map column
 type RequestCancelInfo struct {
 	Version               int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -1755,7 +1986,7 @@ type RequestCancelInfo struct {
 func (m *RequestCancelInfo) Reset()      { *m = RequestCancelInfo{} }
 func (*RequestCancelInfo) ProtoMessage() {}
 func (*RequestCancelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{11}
+	return fileDescriptor_67a714d0e7ba9f37, []int{14}
 }
 func (m *RequestCancelInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1823,7 +2054,7 @@ type SignalInfo struct {
 func (m *SignalInfo) Reset()      { *m = SignalInfo{} }
 func (*SignalInfo) ProtoMessage() {}
 func (*SignalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{12}
+	return fileDescriptor_67a714d0e7ba9f37, []int{15}
 }
 func (m *SignalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1890,7 +2121,7 @@ type Checksum struct {
 func (m *Checksum) Reset()      { *m = Checksum{} }
 func (*Checksum) ProtoMessage() {}
 func (*Checksum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{13}
+	return fileDescriptor_67a714d0e7ba9f37, []int{16}
 }
 func (m *Checksum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,12 +2174,20 @@ func (m *Checksum) GetValue() []byte {
 func init() {
 	proto.RegisterType((*ShardInfo)(nil), "temporal.server.api.persistence.v1.ShardInfo")
 	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.persistence.v1.ShardInfo.ClusterReplicationLevelEntry")
+	proto.RegisterMapType((map[string]*RemoteShardReplicationLevels)(nil), "temporal.server.api.persistence.v1.ShardInfo.ClusterShardReplicationLevelEntry")
 	proto.RegisterMapType((map[string]*time.Time)(nil), "temporal.server.api.persistence.v1.ShardInfo.ClusterTimerAckLevelEntry")
 	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.persistence.v1.ShardInfo.ClusterTransferAckLevelEntry")
+	proto.RegisterMapType((map[string]*OpenExecutionCounts)(nil), "temporal.server.api.persistence.v1.ShardInfo.OpenExecutionCountsEntry")
 	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.persistence.v1.ShardInfo.ReplicationDlqAckLevelEntry")
+	proto.RegisterType((*RemoteShardReplicationLevels)(nil), "temporal.server.api.persistence.v1.RemoteShardReplicationLevels")
+	proto.RegisterMapType((map[int32]int64)(nil), "temporal.server.api.persistence.v1.RemoteShardReplicationLevels.ShardLevelsEntry")
+	proto.RegisterType((*OpenExecutionCounts)(nil), "temporal.server.api.persistence.v1.OpenExecutionCounts")
+	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.persistence.v1.OpenExecutionCounts.WorkflowTypesEntry")
 	proto.RegisterType((*WorkflowExecutionInfo)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo")
 	proto.RegisterMapType((map[string]*v11.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.MemoEntry")
 	proto.RegisterMapType((map[string]*v11.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.SearchAttributesEntry")
+	proto.RegisterMapType((map[string]*UpdateInfo)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.UpdateInfosEntry")
+	proto.RegisterType((*UpdateInfo)(nil), "temporal.server.api.persistence.v1.UpdateInfo")
 	proto.RegisterType((*ExecutionStats)(nil), "temporal.server.api.persistence.v1.ExecutionStats")
 	proto.RegisterType((*WorkflowExecutionState)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionState")
 	proto.RegisterType((*TransferTaskInfo)(nil), "temporal.server.api.persistence.v1.TransferTaskInfo")
@@ -1968,205 +2207,228 @@ func init() {
 }
 
 var fileDescriptor_67a714d0e7ba9f37 = []byte{
-	// 3160 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x1a, 0x4d, 0x73, 0xdb, 0xd6,
-	0xd1, 0xb4, 0x20, 0x11, 0x5c, 0x4a, 0x14, 0x04, 0x7d, 0x41, 0xb2, 0x4c, 0xc9, 0x8c, 0x9d, 0xc8,
-	0x89, 0x43, 0x59, 0xb2, 0xd3, 0x7c, 0x76, 0x32, 0xb6, 0x6c, 0x27, 0xe4, 0x24, 0x8e, 0x03, 0x29,
-	0x71, 0x26, 0x9d, 0x0c, 0x07, 0x02, 0x1e, 0x25, 0x54, 0x20, 0x40, 0x03, 0x20, 0x65, 0x66, 0x7a,
-	0xc8, 0xa1, 0xd3, 0x1e, 0xda, 0x43, 0xa6, 0xa7, 0x5e, 0x7b, 0xeb, 0x1f, 0xc8, 0xa1, 0xe7, 0x5e,
-	0x7a, 0xcc, 0x31, 0x97, 0x4e, 0x1b, 0xa5, 0x87, 0xde, 0x9a, 0x99, 0xfe, 0x81, 0xce, 0xdb, 0xf7,
-	0x00, 0x3c, 0x80, 0x90, 0x4c, 0xb9, 0xf1, 0xc1, 0x37, 0x62, 0xbf, 0xb0, 0xbb, 0x6f, 0xdf, 0x7e,
-	0x81, 0x70, 0x23, 0x24, 0x9d, 0xae, 0xe7, 0x1b, 0xce, 0x46, 0x40, 0xfc, 0x3e, 0xf1, 0x37, 0x8c,
-	0xae, 0xbd, 0xd1, 0x25, 0x7e, 0x60, 0x07, 0x21, 0x71, 0x4d, 0xb2, 0xd1, 0xdf, 0xdc, 0x20, 0x8f,
-	0x89, 0xd9, 0x0b, 0x6d, 0xcf, 0x0d, 0xea, 0x5d, 0xdf, 0x0b, 0x3d, 0xb5, 0x16, 0x31, 0xd5, 0x19,
-	0x53, 0xdd, 0xe8, 0xda, 0x75, 0x81, 0xa9, 0xde, 0xdf, 0x5c, 0xae, 0xee, 0x7b, 0xde, 0xbe, 0x43,
-	0x36, 0x90, 0x63, 0xaf, 0xd7, 0xde, 0xb0, 0x7a, 0xbe, 0x41, 0x85, 0x30, 0x19, 0xcb, 0xab, 0x59,
-	0x7c, 0x68, 0x77, 0x48, 0x10, 0x1a, 0x9d, 0x2e, 0x27, 0xb8, 0x64, 0x91, 0x2e, 0x71, 0x2d, 0xe2,
-	0x9a, 0x36, 0x09, 0x36, 0xf6, 0xbd, 0x7d, 0x0f, 0xe1, 0xf8, 0x8b, 0x93, 0x5c, 0x8e, 0x95, 0xa7,
-	0x5a, 0x9b, 0x5e, 0xa7, 0xe3, 0xb9, 0x54, 0xe1, 0x0e, 0x09, 0x02, 0x63, 0x9f, 0xe4, 0x52, 0x11,
-	0xb7, 0xd7, 0x09, 0x28, 0xd1, 0x91, 0xe7, 0x1f, 0xb6, 0x1d, 0xef, 0x88, 0x53, 0x5d, 0x49, 0x51,
-	0xb5, 0x0d, 0xdb, 0xe9, 0xf9, 0x64, 0x58, 0x58, 0x9a, 0xec, 0xc0, 0x0e, 0x42, 0xcf, 0x1f, 0x0c,
-	0x93, 0xbd, 0x98, 0x22, 0x8b, 0x5e, 0x35, 0x4c, 0x77, 0x35, 0xcf, 0xfd, 0xb1, 0x8a, 0xcc, 0x22,
-	0x4e, 0xfa, 0xca, 0xa9, 0xa4, 0x19, 0x6b, 0x5e, 0x3a, 0x95, 0x38, 0x34, 0x82, 0x43, 0x4e, 0x78,
-	0x2d, 0x8f, 0xf0, 0x24, 0xb3, 0x6a, 0xff, 0x02, 0x28, 0xed, 0x1c, 0x18, 0xbe, 0xd5, 0x70, 0xdb,
-	0x9e, 0xba, 0x04, 0x72, 0x40, 0x1f, 0x5a, 0xb6, 0xa5, 0x15, 0xd6, 0x0a, 0xeb, 0xe3, 0x7a, 0x11,
-	0x9f, 0x1b, 0x16, 0x45, 0xf9, 0x86, 0xbb, 0x4f, 0x28, 0xea, 0xfc, 0x5a, 0x61, 0x7d, 0x4c, 0x2f,
-	0xe2, 0x73, 0xc3, 0x52, 0xe7, 0x60, 0xdc, 0x3b, 0x72, 0x89, 0xaf, 0x8d, 0xad, 0x15, 0xd6, 0x4b,
-	0x3a, 0x7b, 0x50, 0xb7, 0x60, 0xde, 0x27, 0x5d, 0xc7, 0x36, 0x31, 0x46, 0x5a, 0x86, 0x79, 0xd8,
-	0x72, 0x48, 0x9f, 0x38, 0x9a, 0x84, 0xdc, 0xb3, 0x02, 0xf2, 0x96, 0x79, 0xf8, 0x01, 0x45, 0xa9,
-	0xd7, 0x40, 0x0d, 0x7d, 0xc3, 0x0d, 0xda, 0xc4, 0x17, 0x18, 0xc6, 0x91, 0x41, 0x89, 0x30, 0x22,
-	0x75, 0x10, 0x7a, 0x0e, 0x71, 0x5b, 0x81, 0xed, 0x9a, 0xa4, 0xe5, 0x13, 0x97, 0x1c, 0x69, 0x13,
-	0xa8, 0xb7, 0xc2, 0x30, 0x3b, 0x14, 0xa1, 0x53, 0xb8, 0x7a, 0x0b, 0xca, 0xbd, 0xae, 0x65, 0x84,
-	0xa4, 0x45, 0xe3, 0x52, 0x2b, 0xae, 0x15, 0xd6, 0xcb, 0x5b, 0xcb, 0x75, 0x16, 0xb4, 0xf5, 0x28,
-	0x68, 0xeb, 0xbb, 0x51, 0xd0, 0xde, 0x96, 0xbe, 0xfe, 0xc7, 0x6a, 0x41, 0x07, 0xc6, 0x44, 0xc1,
-	0xea, 0xc7, 0x30, 0x47, 0x79, 0x05, 0xdd, 0x98, 0x2c, 0x79, 0x44, 0x59, 0x33, 0xc8, 0x1d, 0xe9,
-	0x8f, 0x22, 0xef, 0x40, 0xd5, 0x35, 0x3a, 0x24, 0xe8, 0x1a, 0x26, 0x69, 0xb9, 0x5e, 0x68, 0xb7,
-	0x23, 0x87, 0xf5, 0xe9, 0xed, 0xf3, 0x5c, 0xad, 0x84, 0xd6, 0xaf, 0xc4, 0x54, 0xf7, 0x05, 0xa2,
-	0x4f, 0x19, 0x8d, 0xfa, 0xdb, 0x02, 0x2c, 0x9b, 0x4e, 0x2f, 0x08, 0x89, 0xdf, 0xca, 0x71, 0x20,
-	0xac, 0x8d, 0xad, 0x97, 0xb7, 0x9a, 0xf5, 0x27, 0x5f, 0xf2, 0x7a, 0x1c, 0x0b, 0xf5, 0x6d, 0x26,
-	0x6f, 0x37, 0xe3, 0xf5, 0xbb, 0x6e, 0xe8, 0x0f, 0xf4, 0x45, 0x33, 0x1f, 0xab, 0xfe, 0xba, 0x00,
-	0x8b, 0xb1, 0x26, 0x69, 0x5f, 0x69, 0x65, 0x54, 0xe3, 0xbd, 0xa7, 0x53, 0x43, 0xf4, 0x1c, 0xea,
-	0xc0, 0x7d, 0x3a, 0x67, 0xe6, 0x10, 0xa8, 0xbf, 0x29, 0xc0, 0x52, 0xa4, 0x86, 0x18, 0x85, 0x4c,
-	0x91, 0xc9, 0xff, 0xc3, 0x1f, 0x7a, 0x22, 0x2d, 0xc7, 0x1f, 0x59, 0x2c, 0xf5, 0xc7, 0x92, 0xa8,
-	0x80, 0xe5, 0x3c, 0x12, 0x3c, 0x32, 0x85, 0x8a, 0x34, 0xce, 0xa6, 0x88, 0xf0, 0x8e, 0x3b, 0xce,
-	0xa3, 0xf4, 0xb9, 0x2c, 0xf8, 0xb9, 0x48, 0xf5, 0x3a, 0xcc, 0xf5, 0xed, 0xc0, 0xde, 0xb3, 0x1d,
-	0x3b, 0x1c, 0x08, 0x0a, 0x54, 0x30, 0xb8, 0xd4, 0x04, 0x17, 0x71, 0x2c, 0x37, 0x61, 0xe5, 0xb4,
-	0x08, 0x50, 0x15, 0x18, 0x3b, 0x24, 0x03, 0xcc, 0x12, 0x25, 0x9d, 0xfe, 0xa4, 0x69, 0xa0, 0x6f,
-	0x38, 0x3d, 0xc2, 0xd3, 0x03, 0x7b, 0x78, 0xeb, 0xfc, 0x1b, 0x85, 0x65, 0x13, 0x96, 0x4e, 0x3c,
-	0xc6, 0x1c, 0x41, 0xd7, 0x45, 0x41, 0xa7, 0xde, 0x2b, 0xf1, 0x25, 0x89, 0xc2, 0xb9, 0x47, 0x74,
-	0x26, 0x85, 0x1b, 0x70, 0xe1, 0x14, 0x2f, 0x9f, 0x45, 0x54, 0x53, 0x92, 0xa7, 0x15, 0xa5, 0xf6,
-	0x87, 0x15, 0x98, 0x7f, 0xc8, 0x13, 0xfa, 0xdd, 0xa8, 0xf8, 0x62, 0xca, 0xbd, 0x04, 0x93, 0x49,
-	0x02, 0xe0, 0x69, 0xb7, 0xa4, 0x97, 0x63, 0x58, 0xc3, 0x52, 0x57, 0xa1, 0x1c, 0x15, 0x83, 0x28,
-	0xfb, 0x96, 0x74, 0x88, 0x40, 0x0d, 0x4b, 0xad, 0xc3, 0x6c, 0xd7, 0xf0, 0x89, 0x1b, 0xb6, 0x52,
-	0xa2, 0x58, 0x3a, 0x9e, 0x61, 0xa8, 0xfb, 0x82, 0xc0, 0x6b, 0xa0, 0x72, 0x7a, 0x51, 0xae, 0x84,
-	0xe4, 0x0a, 0xc3, 0x3c, 0x4c, 0xa4, 0xd7, 0x60, 0x8a, 0x53, 0xfb, 0x3d, 0x97, 0x12, 0x8e, 0x33,
-	0x15, 0x19, 0x50, 0xef, 0xb9, 0x0d, 0x8b, 0x5a, 0x61, 0xbb, 0x76, 0x68, 0x1b, 0x21, 0xc1, 0xe2,
-	0x31, 0x81, 0x6e, 0x28, 0xc7, 0xb0, 0x86, 0xa5, 0xbe, 0x09, 0x4b, 0xa6, 0xd7, 0xe9, 0x3a, 0x04,
-	0xef, 0x01, 0xe9, 0x53, 0x81, 0x7b, 0x46, 0x68, 0x1e, 0x50, 0xfa, 0x22, 0xd2, 0x2f, 0x24, 0x04,
-	0x77, 0x29, 0xfe, 0x36, 0x45, 0x37, 0x2c, 0xf5, 0x22, 0x00, 0x2d, 0x70, 0xad, 0x47, 0x3d, 0xd2,
-	0x23, 0x98, 0x10, 0x4b, 0x7a, 0x89, 0x42, 0x3e, 0xa6, 0x00, 0x6a, 0x4e, 0x6c, 0x47, 0x38, 0xe8,
-	0x12, 0xf4, 0x82, 0x06, 0xcc, 0x9c, 0x08, 0xb3, 0x3b, 0xe8, 0x12, 0xea, 0x03, 0xf5, 0x0b, 0x58,
-	0x8e, 0xa9, 0xe3, 0x3e, 0x08, 0x73, 0x95, 0xd7, 0x0b, 0xb5, 0x32, 0x86, 0xdc, 0xd2, 0x50, 0xc8,
-	0xdd, 0xe1, 0xbd, 0xce, 0x6d, 0xe9, 0x8f, 0x34, 0xeb, 0x68, 0x47, 0xd9, 0xc3, 0xdc, 0x65, 0x02,
-	0x68, 0x8d, 0x88, 0xc5, 0x53, 0x7f, 0x45, 0x82, 0x27, 0x47, 0x13, 0x1c, 0x5b, 0xa2, 0xf7, 0x62,
-	0x91, 0x7b, 0x70, 0xd1, 0x22, 0x6d, 0xa3, 0xe7, 0x08, 0xe7, 0x85, 0xfe, 0x88, 0x64, 0x4f, 0x8d,
-	0x26, 0x7b, 0x99, 0x4b, 0x89, 0xce, 0x76, 0xd7, 0x08, 0x0e, 0xa3, 0x77, 0xbc, 0x02, 0xaa, 0x63,
-	0x04, 0x21, 0x3f, 0x17, 0x94, 0x6e, 0x5b, 0xda, 0x0c, 0x1e, 0xcb, 0x34, 0xc5, 0xe0, 0x81, 0x50,
-	0x8e, 0x86, 0xa5, 0xbe, 0x0a, 0xb3, 0x48, 0xdc, 0xb6, 0xfd, 0x98, 0xc5, 0xb6, 0x34, 0x95, 0xd5,
-	0x69, 0x8a, 0xba, 0x47, 0x31, 0xc8, 0xd2, 0xb0, 0xd4, 0x77, 0xe0, 0x02, 0x92, 0xa7, 0x95, 0x0f,
-	0x42, 0xc3, 0x47, 0xb6, 0x59, 0x64, 0x5b, 0xa4, 0x24, 0xa2, 0x66, 0x3b, 0x14, 0xdf, 0xb0, 0xd4,
-	0x77, 0x01, 0x18, 0x29, 0x96, 0xda, 0xb9, 0x11, 0x4b, 0x6d, 0x09, 0x79, 0xb0, 0xc4, 0x36, 0x01,
-	0x55, 0x6a, 0x89, 0xd5, 0x7f, 0x7e, 0x44, 0x31, 0x15, 0xca, 0xf9, 0x49, 0xd2, 0x01, 0x6c, 0xc1,
-	0x7c, 0xda, 0x8a, 0xa8, 0x4a, 0x2f, 0xb0, 0xa6, 0xe6, 0x48, 0x30, 0x20, 0x2a, 0xce, 0x6f, 0xc2,
-	0x52, 0xc6, 0x72, 0xf3, 0x80, 0x58, 0x3d, 0x07, 0xef, 0xe8, 0x22, 0x0b, 0x7c, 0x91, 0x6f, 0x87,
-	0xa3, 0x1b, 0x96, 0xfa, 0x3a, 0x68, 0x39, 0x4e, 0x63, 0x57, 0x4c, 0x43, 0xce, 0xf9, 0xa3, 0xac,
-	0xcb, 0xf0, 0xb2, 0xed, 0x64, 0xf5, 0x8c, 0x42, 0x65, 0x69, 0xb4, 0x50, 0x49, 0x19, 0x12, 0xc5,
-	0xc8, 0x90, 0xf1, 0x46, 0x48, 0x0b, 0x57, 0xa8, 0x2d, 0x63, 0xcb, 0x95, 0xe2, 0xb9, 0xc5, 0x50,
-	0xa9, 0xdb, 0x96, 0xb2, 0x00, 0x8f, 0xe1, 0xc2, 0x88, 0xc7, 0xb0, 0x98, 0x63, 0x25, 0x9e, 0x87,
-	0x01, 0x2b, 0xf9, 0xbe, 0xe5, 0x2f, 0x58, 0x19, 0xf1, 0x05, 0x4b, 0x79, 0x07, 0xc0, 0x5e, 0x71,
-	0x15, 0x14, 0xd3, 0x70, 0x4d, 0xe2, 0xb4, 0x7c, 0xf2, 0xa8, 0x47, 0x82, 0x90, 0x58, 0xda, 0xc5,
-	0xb5, 0xc2, 0xba, 0xac, 0x4f, 0x33, 0xb8, 0x1e, 0x81, 0x55, 0x1f, 0xae, 0xa4, 0xb5, 0xf1, 0x7c,
-	0x7b, 0xdf, 0x76, 0x0d, 0x27, 0xab, 0x56, 0x75, 0x44, 0xb5, 0x2e, 0x89, 0x6a, 0x7d, 0xc4, 0x85,
-	0xa5, 0xd5, 0x1b, 0x0a, 0x11, 0xae, 0x25, 0x0d, 0x91, 0x55, 0x4c, 0x81, 0xa9, 0x10, 0xe1, 0xca,
-	0x36, 0x2c, 0xf5, 0x65, 0x98, 0x49, 0xdb, 0x45, 0x39, 0xd6, 0x90, 0x23, 0x6d, 0x18, 0xa3, 0x0d,
-	0x42, 0xdb, 0x3c, 0x1c, 0xb4, 0x84, 0x3c, 0x7c, 0x89, 0xd1, 0x32, 0xc4, 0x6e, 0x9c, 0x8d, 0xf7,
-	0x61, 0x8d, 0xd3, 0xc6, 0x71, 0x1e, 0x7a, 0xad, 0xe4, 0x0a, 0xd3, 0x28, 0xac, 0x8d, 0x16, 0x85,
-	0x2b, 0x4c, 0x50, 0x64, 0xf0, 0xae, 0xb7, 0x13, 0x5d, 0x6a, 0x1a, 0x8e, 0x1a, 0x14, 0xa3, 0x00,
-	0x7c, 0x81, 0xcd, 0x2a, 0xfc, 0x51, 0xfd, 0x04, 0x16, 0x7c, 0x12, 0xfa, 0x83, 0x16, 0xab, 0x3f,
-	0x4e, 0xcb, 0x76, 0x43, 0xe2, 0xf7, 0x0d, 0x47, 0xbb, 0x3c, 0xda, 0x8b, 0xe7, 0x90, 0xbd, 0xc1,
-	0xb8, 0x1b, 0x9c, 0x39, 0x11, 0xdb, 0x31, 0x1e, 0xdb, 0x9d, 0x5e, 0x27, 0x11, 0x7b, 0xe5, 0x2c,
-	0x62, 0x3f, 0x64, 0xdc, 0xb1, 0xd8, 0x9b, 0x59, 0xb1, 0xdc, 0x8c, 0x40, 0x7b, 0x11, 0xcd, 0x4a,
-	0x71, 0xf1, 0x7b, 0x15, 0xa8, 0x6f, 0xd1, 0xbe, 0x92, 0x72, 0xed, 0x19, 0xe6, 0xa1, 0xd7, 0x6e,
-	0xb7, 0x4c, 0x8f, 0xb4, 0xdb, 0xb6, 0x69, 0x13, 0x37, 0xd4, 0x5e, 0x5a, 0x2b, 0xac, 0x17, 0xf4,
-	0x45, 0x24, 0xb8, 0xcd, 0xf0, 0xdb, 0x09, 0x5a, 0xed, 0x40, 0x2d, 0xa7, 0x04, 0x92, 0xc7, 0x5d,
-	0x9b, 0xa9, 0xcb, 0x82, 0x74, 0x7d, 0xc4, 0x20, 0x5d, 0x1d, 0xaa, 0x85, 0x77, 0x63, 0x49, 0x7c,
-	0xc6, 0x59, 0x65, 0xaa, 0xba, 0x9e, 0xdb, 0xc2, 0x5f, 0xc6, 0x9e, 0x43, 0x5a, 0xc4, 0xf7, 0x3d,
-	0x1f, 0x0b, 0x76, 0xa0, 0x5d, 0x5d, 0x1b, 0x5b, 0x2f, 0xe9, 0x17, 0x10, 0x79, 0xdf, 0x73, 0xf5,
-	0x88, 0xe8, 0x2e, 0xa5, 0xa1, 0xa5, 0x3b, 0x50, 0xd7, 0x41, 0x39, 0x30, 0x02, 0xc6, 0xdf, 0xea,
-	0x7a, 0x8e, 0x6d, 0x0e, 0xb4, 0x97, 0xf1, 0x1e, 0x56, 0x0e, 0x8c, 0x00, 0x39, 0x1e, 0x20, 0x54,
-	0x7d, 0x01, 0xa6, 0x4c, 0xdf, 0x73, 0xe3, 0xf8, 0xd3, 0x5e, 0xc1, 0x48, 0x9d, 0xa4, 0xc0, 0x28,
-	0x96, 0x68, 0xc7, 0x12, 0xd8, 0xfb, 0xf4, 0x6e, 0x9a, 0x5e, 0xcf, 0x0d, 0xb5, 0x3a, 0xeb, 0x58,
-	0x18, 0x6c, 0x9b, 0x82, 0xd4, 0x8f, 0x61, 0xc6, 0xe8, 0x85, 0x5e, 0xcb, 0x27, 0x01, 0x09, 0x5b,
-	0x5d, 0xcf, 0x76, 0xc3, 0x40, 0xbb, 0x81, 0x5e, 0xb9, 0x92, 0xb4, 0xec, 0xb4, 0x57, 0x8f, 0x67,
-	0xf5, 0xfe, 0x66, 0x5d, 0xa7, 0xd4, 0x0f, 0x90, 0x58, 0x9f, 0xa6, 0xfc, 0x02, 0x40, 0xfd, 0x15,
-	0xcc, 0x04, 0xc4, 0xf0, 0xcd, 0x03, 0x7a, 0xc8, 0xbe, 0xbd, 0xd7, 0x0b, 0x49, 0xa0, 0xdd, 0xc4,
-	0x29, 0xe0, 0xa3, 0x51, 0xa6, 0x80, 0xdc, 0x1e, 0xb2, 0xbe, 0x83, 0x22, 0x6f, 0xc5, 0x12, 0xd9,
-	0x2c, 0xa0, 0x04, 0x19, 0xb0, 0xfa, 0x10, 0xa4, 0x0e, 0xe9, 0x78, 0xda, 0x6b, 0xf8, 0xc2, 0xed,
-	0xa7, 0x7f, 0xe1, 0x87, 0xa4, 0xe3, 0xb1, 0x97, 0xa0, 0x40, 0xf5, 0x0b, 0x98, 0xe1, 0x85, 0xb0,
-	0xc5, 0x36, 0x0d, 0x36, 0x09, 0xb4, 0x9f, 0xa1, 0xa7, 0xae, 0xe7, 0xbe, 0x85, 0xef, 0x23, 0xe8,
-	0x1b, 0x78, 0x99, 0x7c, 0x3f, 0xe2, 0xd3, 0x95, 0x7e, 0x06, 0xa2, 0xde, 0x80, 0x05, 0xde, 0x6a,
-	0xc4, 0xc1, 0xca, 0x5b, 0xd1, 0xd7, 0xf1, 0x64, 0x67, 0x11, 0x1b, 0xab, 0xc8, 0x5a, 0xd2, 0x5f,
-	0xc0, 0x74, 0x42, 0x1e, 0x84, 0x46, 0x18, 0x68, 0x6f, 0xa0, 0x46, 0x5b, 0xa3, 0xd8, 0x1d, 0x0b,
-	0xdb, 0xa1, 0x9c, 0x7a, 0x85, 0xa4, 0x9e, 0x53, 0x75, 0x87, 0xaa, 0x92, 0xbd, 0x3b, 0x6f, 0x9e,
-	0xb5, 0xee, 0xe8, 0xbd, 0xec, 0xad, 0xb9, 0x09, 0x8b, 0x43, 0x4d, 0x56, 0xf8, 0x18, 0xad, 0x7e,
-	0x8b, 0x35, 0x1b, 0xe9, 0x46, 0x6b, 0xf7, 0x31, 0xb5, 0xfa, 0x26, 0x2c, 0x50, 0x5b, 0x09, 0x5b,
-	0x03, 0xd8, 0xa8, 0x11, 0x0b, 0xf0, 0xb7, 0x91, 0x69, 0x0e, 0xb1, 0xbb, 0x31, 0x92, 0x45, 0xfa,
-	0x7b, 0x50, 0x49, 0xb7, 0xc2, 0xda, 0x3b, 0x23, 0x1a, 0x30, 0x45, 0xc4, 0x06, 0x58, 0xdd, 0x80,
-	0x39, 0x97, 0x1c, 0x0d, 0x9f, 0xd3, 0xcf, 0xd9, 0x28, 0xe2, 0x92, 0xa3, 0xf4, 0x29, 0x2d, 0x5b,
-	0x30, 0x9f, 0x1b, 0xbd, 0x39, 0x33, 0xd6, 0x6b, 0xe9, 0xb1, 0x70, 0x35, 0x7d, 0x05, 0xf9, 0x66,
-	0xad, 0xbf, 0x59, 0x7f, 0x60, 0x0c, 0x1c, 0xcf, 0xb0, 0xc4, 0x79, 0xee, 0x33, 0x28, 0xc5, 0x21,
-	0xfb, 0x93, 0x4a, 0x6e, 0x4a, 0xb2, 0xac, 0x94, 0x9a, 0x92, 0x5c, 0x51, 0xa6, 0xd9, 0xa8, 0xd7,
-	0x94, 0x64, 0x45, 0x99, 0x69, 0x4a, 0xf2, 0x35, 0xe5, 0xd5, 0xa6, 0x24, 0xbf, 0xaa, 0xd4, 0x9b,
-	0x92, 0xbc, 0xa1, 0x5c, 0x6f, 0x4a, 0xf2, 0x75, 0x65, 0xb3, 0x29, 0xc9, 0x9b, 0xca, 0x56, 0x53,
-	0x92, 0xb7, 0x94, 0x1b, 0xb5, 0x1b, 0x50, 0x49, 0x87, 0x19, 0x4d, 0x4a, 0xfc, 0x66, 0xb4, 0x02,
-	0xfb, 0x4b, 0x82, 0x3a, 0x8e, 0xe9, 0x65, 0x0e, 0xdb, 0xb1, 0xbf, 0x24, 0xb5, 0xff, 0x14, 0x60,
-	0x61, 0xe8, 0x52, 0x52, 0x6e, 0x82, 0x15, 0xdd, 0x27, 0xf4, 0xf0, 0x85, 0x8a, 0x5e, 0xe0, 0x15,
-	0x1d, 0x11, 0x49, 0x45, 0x9f, 0x87, 0x09, 0x7e, 0x34, 0x6c, 0x9c, 0x1c, 0xf7, 0xf1, 0xd2, 0x34,
-	0x61, 0x1c, 0x03, 0x04, 0x67, 0xc7, 0xca, 0xd6, 0xcd, 0xdc, 0xab, 0x82, 0x5b, 0xc7, 0xdc, 0xe4,
-	0x80, 0x7a, 0xe8, 0x4c, 0x84, 0x7a, 0x0f, 0x26, 0xe8, 0x8f, 0x5e, 0x80, 0x93, 0x65, 0x65, 0xab,
-	0x9e, 0x76, 0xeb, 0xe9, 0x52, 0x7a, 0x81, 0xce, 0xb9, 0x6b, 0xdf, 0x48, 0xa0, 0x44, 0x3b, 0x08,
-	0x1c, 0x40, 0x7e, 0xaa, 0xb1, 0x39, 0xf1, 0xc1, 0x98, 0xe8, 0x83, 0x6d, 0x28, 0xb1, 0x96, 0x79,
-	0xd0, 0x25, 0x5c, 0xf5, 0x17, 0x4f, 0xf7, 0x03, 0x36, 0xc9, 0x83, 0x2e, 0xd1, 0xe5, 0x90, 0xff,
-	0xa2, 0x23, 0x79, 0x68, 0xf8, 0xfb, 0x24, 0x33, 0x92, 0xb3, 0xd1, 0x79, 0x86, 0xa1, 0x32, 0x23,
-	0x39, 0xa7, 0x17, 0x75, 0x9e, 0x60, 0x33, 0x2c, 0xc3, 0xa4, 0x47, 0x72, 0x4e, 0xcd, 0x0d, 0x28,
-	0x32, 0xf3, 0x19, 0x90, 0xe5, 0xbf, 0xf4, 0xd0, 0x2c, 0x67, 0x87, 0xe6, 0xb7, 0x61, 0x99, 0x8b,
-	0x30, 0x0f, 0x6c, 0xc7, 0x4a, 0x5e, 0xeb, 0xb9, 0xce, 0x00, 0x67, 0x6c, 0x59, 0x5f, 0x64, 0x14,
-	0xdb, 0x94, 0x20, 0x7a, 0xfb, 0x47, 0xae, 0x33, 0xa0, 0xae, 0x15, 0x87, 0x18, 0xc0, 0x30, 0x85,
-	0x20, 0x19, 0x5c, 0x34, 0x28, 0x46, 0x93, 0x51, 0x99, 0x2d, 0x8b, 0xf9, 0xa3, 0xba, 0x08, 0xc5,
-	0x68, 0xba, 0x9c, 0x44, 0xcc, 0x44, 0xc8, 0x86, 0xca, 0x06, 0x4c, 0x0b, 0x2b, 0x2a, 0x4c, 0x42,
-	0x53, 0xa3, 0x4e, 0x69, 0x09, 0x23, 0x45, 0xb1, 0xeb, 0x58, 0xfb, 0xbd, 0x04, 0xb3, 0xc2, 0x16,
-	0xe7, 0xb9, 0x09, 0x1d, 0xc1, 0x77, 0xe3, 0x69, 0xdf, 0x5d, 0x86, 0x4a, 0x66, 0xe4, 0x66, 0x7b,
-	0x96, 0xc9, 0xb6, 0x38, 0x6e, 0xd7, 0x60, 0xca, 0x25, 0x8f, 0x05, 0x22, 0xb6, 0x5c, 0x29, 0x53,
-	0x60, 0x44, 0x43, 0xbb, 0x9f, 0x78, 0x24, 0xb1, 0x2d, 0x0c, 0x0f, 0xda, 0xfd, 0x44, 0x30, 0x46,
-	0xb2, 0xe7, 0x1b, 0xae, 0x79, 0xd0, 0x0a, 0xbd, 0x43, 0xc2, 0xce, 0x71, 0x52, 0x2f, 0x33, 0xd8,
-	0x2e, 0x05, 0x45, 0xd9, 0x9e, 0x7a, 0x22, 0x45, 0x3a, 0x85, 0xa4, 0x34, 0xdb, 0xeb, 0x3d, 0xf7,
-	0xb6, 0xc0, 0x20, 0x1c, 0xfe, 0xf4, 0x93, 0x0e, 0x5f, 0x79, 0xea, 0xc3, 0x2f, 0x29, 0xd0, 0x94,
-	0x64, 0x50, 0xca, 0x4d, 0x49, 0x9e, 0x54, 0xa6, 0x78, 0x38, 0xfc, 0xf7, 0x3c, 0xa8, 0x9f, 0x26,
-	0xa4, 0xcf, 0x7f, 0x34, 0x08, 0xce, 0x9c, 0x78, 0x92, 0x33, 0x8b, 0x4f, 0xe7, 0x4c, 0xf5, 0x5d,
-	0x00, 0xd3, 0xf1, 0x02, 0x72, 0xb6, 0xef, 0x1c, 0x25, 0xe4, 0xa1, 0xd0, 0xda, 0x9f, 0x24, 0x98,
-	0xc2, 0xa5, 0xef, 0x73, 0xe3, 0xf0, 0xbb, 0x30, 0xc9, 0xc7, 0x54, 0x26, 0x67, 0x1c, 0xe5, 0xd4,
-	0x4e, 0x28, 0x5e, 0x7c, 0x18, 0x45, 0x19, 0xe5, 0x30, 0x79, 0x50, 0x89, 0xb0, 0x2c, 0x89, 0x46,
-	0x34, 0x94, 0x37, 0x81, 0xf2, 0x36, 0x47, 0xab, 0xac, 0x7c, 0x78, 0x43, 0xf1, 0xf1, 0x7e, 0x45,
-	0x00, 0x8a, 0xe1, 0x51, 0x4c, 0x87, 0xc7, 0x55, 0x50, 0xe2, 0x1c, 0x1d, 0xcd, 0xc9, 0x32, 0x0e,
-	0x94, 0xd3, 0x11, 0x3c, 0x5a, 0xd2, 0x2c, 0x81, 0x1c, 0x27, 0x0b, 0xf6, 0xb9, 0xa9, 0x48, 0x78,
-	0xa2, 0x10, 0x82, 0x0c, 0x9e, 0x14, 0x64, 0xe5, 0xa7, 0x0b, 0xb2, 0xda, 0xef, 0x2a, 0x30, 0x79,
-	0xcb, 0x0c, 0xed, 0xbe, 0x1d, 0x0e, 0x30, 0x44, 0x04, 0xa3, 0x0a, 0x69, 0xa3, 0x5e, 0x07, 0x2d,
-	0xc9, 0x5b, 0x99, 0x1d, 0x32, 0x5b, 0xbd, 0xcf, 0xc7, 0xf8, 0xd4, 0x0a, 0xf9, 0x3d, 0xa8, 0x64,
-	0x76, 0x30, 0xd2, 0xa8, 0x1d, 0x6e, 0x90, 0xda, 0xb7, 0x5c, 0xe4, 0xeb, 0x48, 0x96, 0x37, 0xd9,
-	0x95, 0x2c, 0x05, 0xf1, 0xe2, 0x6d, 0x1b, 0x26, 0x53, 0x1b, 0xae, 0x51, 0x2f, 0x5e, 0x39, 0x10,
-	0xb6, 0x5a, 0xab, 0x50, 0x36, 0xb8, 0x3f, 0xa2, 0xe4, 0x5c, 0xd2, 0x21, 0x02, 0xb1, 0xda, 0x2e,
-	0xb4, 0x78, 0x7c, 0x21, 0xee, 0xc7, 0xcd, 0xdd, 0xe7, 0xb0, 0x74, 0xf2, 0xee, 0x05, 0x46, 0xdb,
-	0x55, 0x2c, 0x04, 0xf9, 0x5b, 0x97, 0x8c, 0xec, 0x24, 0x3b, 0x9c, 0x61, 0x7b, 0x2e, 0xc8, 0xde,
-	0x8e, 0x32, 0x05, 0x95, 0xbd, 0x8b, 0xc3, 0x0b, 0xd5, 0x35, 0x2b, 0x78, 0xc4, 0xed, 0xf9, 0x2c,
-	0xdb, 0xfa, 0xa6, 0xa5, 0x7e, 0x00, 0x33, 0x07, 0xc4, 0xf0, 0xc3, 0x3d, 0x62, 0x84, 0x67, 0x5d,
-	0x99, 0x2b, 0x31, 0x67, 0x24, 0x2d, 0x6f, 0x1d, 0x58, 0xc9, 0x5f, 0x07, 0xe6, 0x6e, 0xd8, 0x58,
-	0xdd, 0xcb, 0xdb, 0xb0, 0xb1, 0xcf, 0xa5, 0xd1, 0x92, 0x94, 0xf6, 0xcd, 0x0a, 0xbb, 0xae, 0x61,
-	0x94, 0x3f, 0x59, 0x63, 0x2c, 0x2e, 0xbe, 0x66, 0xd2, 0x8b, 0xaf, 0x74, 0xcf, 0xa7, 0x66, 0x7b,
-	0x3e, 0x9a, 0x12, 0xe2, 0xd8, 0x25, 0x6e, 0x68, 0x87, 0x03, 0xdc, 0xbe, 0xe3, 0x16, 0x8f, 0x47,
-	0x30, 0x03, 0xe7, 0x6e, 0x5b, 0xe6, 0x72, 0xb7, 0x2d, 0x27, 0x2f, 0xdb, 0xe6, 0x9f, 0xcd, 0xb2,
-	0x6d, 0xe1, 0xd9, 0x2c, 0xdb, 0x16, 0x4f, 0x59, 0xb6, 0xed, 0xc2, 0x3c, 0xe3, 0xca, 0xce, 0xf9,
-	0xda, 0x88, 0xd7, 0x7b, 0x16, 0xd9, 0x33, 0x13, 0xfe, 0xa9, 0x2b, 0xbc, 0xa5, 0xd3, 0x57, 0x78,
-	0x23, 0xec, 0xd4, 0x96, 0x9f, 0xbc, 0x53, 0xbb, 0x0f, 0x2a, 0x93, 0xc2, 0x36, 0x0d, 0xec, 0x2f,
-	0x32, 0x7c, 0x2b, 0xbf, 0x96, 0xae, 0x78, 0x1c, 0x49, 0x8b, 0xd3, 0x3d, 0xf6, 0x53, 0x57, 0x90,
-	0xf7, 0x03, 0x23, 0x08, 0x39, 0x84, 0x0e, 0x15, 0x82, 0x3c, 0x5a, 0xaf, 0x88, 0x9f, 0x84, 0xda,
-	0x0a, 0x86, 0xda, 0x62, 0xcc, 0xf5, 0x10, 0xf1, 0x71, 0xc8, 0x65, 0x1b, 0x83, 0x8b, 0xb9, 0x8d,
-	0x81, 0x38, 0x77, 0x54, 0x87, 0xe6, 0x8e, 0x4f, 0x61, 0x01, 0x5f, 0x9d, 0x5c, 0x78, 0x8b, 0x84,
-	0x86, 0xed, 0x04, 0xb8, 0x0b, 0x1f, 0x32, 0x6a, 0x68, 0xb4, 0x0f, 0xf4, 0x39, 0xca, 0xff, 0x7e,
-	0xc4, 0x7e, 0x87, 0x71, 0xab, 0x5f, 0xc0, 0x72, 0x46, 0xae, 0xf8, 0x35, 0x69, 0x6d, 0xd4, 0xcf,
-	0x18, 0x29, 0xd9, 0xc9, 0x67, 0xa5, 0xa6, 0x24, 0x8f, 0x29, 0x52, 0x53, 0x92, 0x27, 0x94, 0x62,
-	0xed, 0xaf, 0x05, 0x28, 0x61, 0xc7, 0xf4, 0x84, 0x52, 0x98, 0x2e, 0x44, 0xe7, 0xb3, 0x85, 0xe8,
-	0x16, 0x94, 0x31, 0x58, 0x79, 0x6d, 0x1e, 0x1b, 0xf5, 0xef, 0x2e, 0x8c, 0x29, 0x2a, 0x43, 0x62,
-	0x36, 0x62, 0xff, 0xdb, 0xc1, 0x04, 0xc3, 0x13, 0xd1, 0x12, 0xc8, 0x2c, 0x69, 0xc5, 0x93, 0x6d,
-	0x11, 0x9f, 0x1b, 0x56, 0xed, 0xef, 0x63, 0xa0, 0xe2, 0xdc, 0x98, 0xfe, 0xda, 0x7d, 0x6a, 0x65,
-	0x4f, 0xbe, 0x20, 0xe7, 0x57, 0xf6, 0x18, 0x9f, 0xfd, 0x38, 0x2c, 0xf8, 0x61, 0x2c, 0xeb, 0x87,
-	0x3a, 0xcc, 0x46, 0x68, 0xb1, 0xa7, 0xe4, 0x83, 0x38, 0x47, 0x09, 0xa3, 0xf5, 0x65, 0xa8, 0x44,
-	0xf4, 0xbc, 0xc5, 0x64, 0x43, 0x78, 0x54, 0xd6, 0xd9, 0x70, 0x9d, 0xbb, 0x6a, 0x91, 0xf3, 0x57,
-	0x2d, 0x2b, 0x50, 0x8a, 0x63, 0x38, 0xaa, 0xd5, 0x31, 0xe0, 0x8c, 0x1f, 0xaf, 0x3f, 0x8b, 0xbf,
-	0xf4, 0xb3, 0xfa, 0xc8, 0x33, 0x73, 0x19, 0x7b, 0xca, 0xf5, 0x13, 0x7a, 0xd4, 0x07, 0xc8, 0x81,
-	0x35, 0x91, 0xe5, 0xec, 0xe8, 0x3f, 0x01, 0x02, 0x68, 0xe8, 0x0b, 0xfe, 0xe4, 0xd0, 0x17, 0xfc,
-	0xa6, 0x24, 0x4b, 0xca, 0x78, 0x53, 0x92, 0x8b, 0x8a, 0x5c, 0xfb, 0xa6, 0x00, 0x33, 0xdc, 0xc4,
-	0x6d, 0x2c, 0x65, 0xcf, 0xea, 0x78, 0x73, 0x8b, 0xe8, 0x58, 0xfe, 0x67, 0xaa, 0xac, 0x0d, 0xd2,
-	0x90, 0x0d, 0xb5, 0xbf, 0x14, 0x00, 0x76, 0x70, 0xc7, 0xff, 0x0c, 0xe3, 0x71, 0x48, 0x53, 0xa1,
-	0x37, 0xcb, 0xea, 0x58, 0x3c, 0xd9, 0xcf, 0xe3, 0xca, 0x04, 0xcb, 0x09, 0x6c, 0xcb, 0x58, 0xfb,
-	0xaa, 0x00, 0xf2, 0xf6, 0x01, 0x31, 0x0f, 0x83, 0x5e, 0x27, 0xab, 0xf9, 0x78, 0xa2, 0xf9, 0x1d,
-	0x98, 0x68, 0x3b, 0x46, 0xdf, 0xf3, 0x51, 0xcf, 0xca, 0xd6, 0xb5, 0xd3, 0x47, 0x8d, 0x48, 0xe2,
-	0x3d, 0xe4, 0xd1, 0x39, 0x6f, 0xf2, 0x8f, 0x96, 0x31, 0x1c, 0xe6, 0xd9, 0xc3, 0xed, 0x5f, 0x7e,
-	0xfb, 0x7d, 0xf5, 0xdc, 0x77, 0xdf, 0x57, 0xcf, 0xfd, 0xf8, 0x7d, 0xb5, 0xf0, 0xd5, 0x71, 0xb5,
-	0xf0, 0xe7, 0xe3, 0x6a, 0xe1, 0x6f, 0xc7, 0xd5, 0xc2, 0xb7, 0xc7, 0xd5, 0xc2, 0x3f, 0x8f, 0xab,
-	0x85, 0x7f, 0x1f, 0x57, 0xcf, 0xfd, 0x78, 0x5c, 0x2d, 0x7c, 0xfd, 0x43, 0xf5, 0xdc, 0xb7, 0x3f,
-	0x54, 0xcf, 0x7d, 0xf7, 0x43, 0xf5, 0xdc, 0xe7, 0x37, 0xf7, 0xbd, 0x44, 0x07, 0xdb, 0x3b, 0xf9,
-	0x8f, 0xa9, 0x6f, 0x0b, 0x8f, 0x7b, 0x13, 0x98, 0xa4, 0x6e, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff,
-	0x82, 0xb9, 0x8f, 0x17, 0xd1, 0x2a, 0x00, 0x00,
+	// 3527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0xcd, 0x73, 0xdb, 0xd6,
+	0xb5, 0x37, 0x2c, 0x4a, 0x22, 0x0f, 0x25, 0x0a, 0x82, 0xbe, 0x20, 0x59, 0xa6, 0x64, 0xc6, 0x76,
+	0xe4, 0xc4, 0xa1, 0x6c, 0xd9, 0x79, 0xce, 0xd7, 0x4b, 0x22, 0xcb, 0x76, 0x42, 0x4e, 0xe2, 0x0f,
+	0x48, 0xb1, 0x33, 0x79, 0x93, 0xc1, 0x40, 0xe0, 0xa5, 0x84, 0x27, 0x10, 0xa0, 0xf1, 0x21, 0x99,
+	0x99, 0x37, 0xf3, 0xb2, 0xe8, 0xb4, 0x9b, 0x4e, 0x27, 0xdd, 0x74, 0xba, 0xeb, 0x74, 0xd5, 0xfe,
+	0x03, 0x59, 0x74, 0xdd, 0x4d, 0x97, 0x59, 0x74, 0x91, 0x5d, 0x13, 0x67, 0xd3, 0x45, 0x67, 0x9a,
+	0x99, 0xae, 0xba, 0xeb, 0xdc, 0x73, 0x2f, 0x80, 0x0b, 0x10, 0x92, 0x28, 0x27, 0x5e, 0x64, 0x47,
+	0x9c, 0xaf, 0x7b, 0xee, 0xbd, 0xe7, 0x9e, 0x7b, 0xce, 0x0f, 0x20, 0x5c, 0x0b, 0x48, 0xa7, 0xeb,
+	0x7a, 0x86, 0xbd, 0xea, 0x13, 0x6f, 0x9f, 0x78, 0xab, 0x46, 0xd7, 0x5a, 0xed, 0x12, 0xcf, 0xb7,
+	0xfc, 0x80, 0x38, 0x26, 0x59, 0xdd, 0xbf, 0xba, 0x4a, 0x9e, 0x10, 0x33, 0x0c, 0x2c, 0xd7, 0xf1,
+	0xeb, 0x5d, 0xcf, 0x0d, 0x5c, 0xa5, 0x16, 0x29, 0xd5, 0x99, 0x52, 0xdd, 0xe8, 0x5a, 0x75, 0x41,
+	0xa9, 0xbe, 0x7f, 0x75, 0xa1, 0xba, 0xe3, 0xba, 0x3b, 0x36, 0x59, 0x45, 0x8d, 0xed, 0xb0, 0xbd,
+	0xda, 0x0a, 0x3d, 0x83, 0x1a, 0x61, 0x36, 0x16, 0x96, 0xb2, 0xfc, 0xc0, 0xea, 0x10, 0x3f, 0x30,
+	0x3a, 0x5d, 0x2e, 0x70, 0xae, 0x45, 0xba, 0xc4, 0x69, 0x11, 0xc7, 0xb4, 0x88, 0xbf, 0xba, 0xe3,
+	0xee, 0xb8, 0x48, 0xc7, 0x5f, 0x5c, 0xe4, 0x7c, 0xec, 0x3c, 0xf5, 0xda, 0x74, 0x3b, 0x1d, 0xd7,
+	0xa1, 0x0e, 0x77, 0x88, 0xef, 0x1b, 0x3b, 0x24, 0x57, 0x8a, 0x38, 0x61, 0xc7, 0xa7, 0x42, 0x07,
+	0xae, 0xb7, 0xd7, 0xb6, 0xdd, 0x03, 0x2e, 0x75, 0x21, 0x25, 0xd5, 0x36, 0x2c, 0x3b, 0xf4, 0x48,
+	0xbf, 0xb1, 0xb4, 0xd8, 0xae, 0xe5, 0x07, 0xae, 0xd7, 0xeb, 0x17, 0xbb, 0x98, 0x12, 0x8b, 0x86,
+	0xea, 0x97, 0xbb, 0x94, 0xb7, 0xfc, 0xb1, 0x8b, 0x6c, 0x46, 0x5c, 0xf4, 0xe5, 0x23, 0x45, 0x33,
+	0xb3, 0x79, 0xf1, 0x48, 0xe1, 0xc0, 0xf0, 0xf7, 0xb8, 0xe0, 0xe5, 0x3c, 0xc1, 0xc3, 0xa6, 0x55,
+	0xfb, 0xd5, 0x04, 0x94, 0x36, 0x77, 0x0d, 0xaf, 0xd5, 0x70, 0xda, 0xae, 0x32, 0x0f, 0x45, 0x9f,
+	0x3e, 0xe8, 0x56, 0x4b, 0x95, 0x96, 0xa5, 0x95, 0x61, 0x6d, 0x14, 0x9f, 0x1b, 0x2d, 0xca, 0xf2,
+	0x0c, 0x67, 0x87, 0x50, 0xd6, 0xe9, 0x65, 0x69, 0x65, 0x48, 0x1b, 0xc5, 0xe7, 0x46, 0x4b, 0x99,
+	0x86, 0x61, 0xf7, 0xc0, 0x21, 0x9e, 0x3a, 0xb4, 0x2c, 0xad, 0x94, 0x34, 0xf6, 0xa0, 0xac, 0xc1,
+	0x8c, 0x47, 0xba, 0xb6, 0x65, 0x62, 0x8c, 0xe8, 0x86, 0xb9, 0xa7, 0xdb, 0x64, 0x9f, 0xd8, 0x6a,
+	0x01, 0xb5, 0xa7, 0x04, 0xe6, 0xba, 0xb9, 0xf7, 0x01, 0x65, 0x29, 0x97, 0x41, 0x09, 0x3c, 0xc3,
+	0xf1, 0xdb, 0xc4, 0x13, 0x14, 0x86, 0x51, 0x41, 0x8e, 0x38, 0xa2, 0xb4, 0x1f, 0xb8, 0x36, 0x71,
+	0x74, 0xdf, 0x72, 0x4c, 0xa2, 0x7b, 0xc4, 0x21, 0x07, 0xea, 0x08, 0xfa, 0x2d, 0x33, 0xce, 0x26,
+	0x65, 0x68, 0x94, 0xae, 0xac, 0x43, 0x39, 0xec, 0xb6, 0x8c, 0x80, 0xe8, 0x34, 0x2e, 0xd5, 0xd1,
+	0x65, 0x69, 0xa5, 0xbc, 0xb6, 0x50, 0x67, 0x41, 0x5b, 0x8f, 0x82, 0xb6, 0xbe, 0x15, 0x05, 0xed,
+	0xcd, 0xc2, 0x17, 0x7f, 0x5b, 0x92, 0x34, 0x60, 0x4a, 0x94, 0xac, 0x3c, 0x80, 0x69, 0xaa, 0x2b,
+	0xf8, 0xc6, 0x6c, 0x15, 0x07, 0xb4, 0x35, 0x89, 0xda, 0x91, 0xff, 0x68, 0xf2, 0x16, 0x54, 0x1d,
+	0xa3, 0x43, 0xfc, 0xae, 0x61, 0x12, 0xdd, 0x71, 0x03, 0xab, 0x1d, 0x2d, 0xd8, 0x3e, 0x3d, 0x7d,
+	0xae, 0xa3, 0x96, 0x70, 0xf6, 0x8b, 0xb1, 0xd4, 0x5d, 0x41, 0xe8, 0x21, 0x93, 0x51, 0x7e, 0x21,
+	0xc1, 0x82, 0x69, 0x87, 0x7e, 0x40, 0x3c, 0x3d, 0x67, 0x01, 0x61, 0x79, 0x68, 0xa5, 0xbc, 0xd6,
+	0xac, 0x1f, 0x7f, 0xc8, 0xeb, 0x71, 0x2c, 0xd4, 0x37, 0x98, 0xbd, 0xad, 0xcc, 0xaa, 0xdf, 0x76,
+	0x02, 0xaf, 0xa7, 0xcd, 0x99, 0xf9, 0x5c, 0xe5, 0x67, 0x12, 0xcc, 0xc5, 0x9e, 0xa4, 0xd7, 0x4a,
+	0x2d, 0xa3, 0x1b, 0xef, 0x3d, 0x9b, 0x1b, 0x56, 0x27, 0xe3, 0x03, 0x5f, 0xd3, 0x69, 0x33, 0x47,
+	0x40, 0xf9, 0xb9, 0x04, 0xf3, 0x91, 0x1b, 0x62, 0x14, 0x32, 0x47, 0xc6, 0x7e, 0xc0, 0x7a, 0x68,
+	0x89, 0xb5, 0x9c, 0xf5, 0xc8, 0x72, 0xe9, 0x7a, 0xcc, 0x8b, 0x0e, 0xb4, 0xec, 0xc7, 0xc2, 0x8a,
+	0x8c, 0xa3, 0x23, 0x8d, 0x93, 0x39, 0x22, 0x8c, 0x71, 0xcb, 0x7e, 0x9c, 0xde, 0x97, 0x59, 0x2f,
+	0x97, 0xa9, 0x5c, 0x81, 0xe9, 0x7d, 0xcb, 0xb7, 0xb6, 0x2d, 0xdb, 0x0a, 0x7a, 0x82, 0x03, 0x15,
+	0x0c, 0x2e, 0x25, 0xe1, 0xc5, 0x1a, 0x9f, 0xc1, 0x8c, 0xdb, 0x25, 0x8e, 0x1e, 0x5f, 0x15, 0xba,
+	0xe9, 0x86, 0x4e, 0xe0, 0xab, 0x32, 0xfa, 0x7c, 0xe7, 0x64, 0x3e, 0xdf, 0xeb, 0x12, 0xe7, 0x76,
+	0x64, 0x69, 0x03, 0x0d, 0x31, 0x87, 0xa7, 0xdc, 0x7e, 0x8e, 0xf2, 0x1b, 0x09, 0x96, 0xa2, 0xdd,
+	0x63, 0xf9, 0xa8, 0x7f, 0x0f, 0x27, 0xd1, 0x8d, 0x7b, 0xcf, 0xb4, 0x87, 0x48, 0xc8, 0xdf, 0xc8,
+	0x45, 0xf3, 0x08, 0x91, 0x85, 0x26, 0x2c, 0x1e, 0x75, 0x2c, 0x14, 0x19, 0x86, 0xf6, 0x48, 0x0f,
+	0x53, 0x67, 0x49, 0xa3, 0x3f, 0x69, 0x6e, 0xdc, 0x37, 0xec, 0x90, 0xf0, 0x9c, 0xc9, 0x1e, 0xde,
+	0x38, 0xfd, 0x9a, 0xb4, 0x60, 0xc2, 0xfc, 0xa1, 0xb1, 0x9d, 0x63, 0xe8, 0x8a, 0x68, 0xe8, 0xc8,
+	0x64, 0x23, 0x0e, 0x92, 0x38, 0x9c, 0x3b, 0xdd, 0x13, 0x39, 0xdc, 0x80, 0x33, 0x47, 0x84, 0xde,
+	0x89, 0x4c, 0xfd, 0x3f, 0xa8, 0x87, 0x45, 0x44, 0x8e, 0x9d, 0x0f, 0xd3, 0x53, 0xbf, 0x31, 0xc8,
+	0x9e, 0xe7, 0x98, 0x17, 0x1d, 0xf8, 0xb5, 0x04, 0xe7, 0x8e, 0x0d, 0x86, 0x1c, 0x57, 0x1e, 0xa6,
+	0x5d, 0x79, 0x77, 0x10, 0x57, 0x34, 0xd2, 0x71, 0x03, 0x92, 0x3b, 0x8c, 0xe8, 0x53, 0xb3, 0x50,
+	0x9c, 0x90, 0xe5, 0xda, 0x5f, 0x25, 0x58, 0x3c, 0x4a, 0x43, 0x09, 0x60, 0x8c, 0x9d, 0x09, 0x3c,
+	0x07, 0xbe, 0x2a, 0xe1, 0x41, 0x78, 0xf0, 0x43, 0x3d, 0x61, 0xa7, 0x84, 0xfd, 0x66, 0x47, 0xa1,
+	0xec, 0x27, 0x94, 0x85, 0xb7, 0x41, 0xce, 0x0a, 0x88, 0xcb, 0x33, 0x7c, 0xcc, 0x8e, 0xd7, 0xbe,
+	0x91, 0x60, 0x2a, 0x67, 0x4f, 0xa8, 0x46, 0xe0, 0x06, 0x86, 0x8d, 0x56, 0x86, 0x34, 0xf6, 0xa0,
+	0x3c, 0x86, 0x4a, 0x54, 0xfe, 0xe8, 0x41, 0xaf, 0x4b, 0x7c, 0xf5, 0xf4, 0xe0, 0x29, 0x3b, 0x67,
+	0x98, 0xfa, 0x23, 0x6e, 0x6d, 0x8b, 0x1a, 0x63, 0xd3, 0x1b, 0x3f, 0x10, 0x69, 0x0b, 0xef, 0x82,
+	0xd2, 0x2f, 0x74, 0x92, 0xa0, 0xae, 0xfd, 0xa3, 0x0a, 0x33, 0x91, 0x89, 0x78, 0x7c, 0x2c, 0xab,
+	0xce, 0xc1, 0x58, 0x72, 0xc9, 0xf3, 0xd2, 0xaa, 0xa4, 0x95, 0x63, 0x5a, 0xa3, 0xa5, 0x2c, 0x41,
+	0x39, 0x9e, 0x31, 0xaf, 0xb0, 0x4a, 0x1a, 0x44, 0xa4, 0x46, 0x4b, 0xa9, 0xc3, 0x54, 0xd7, 0xf0,
+	0x88, 0x13, 0xe8, 0x29, 0x53, 0xac, 0xe4, 0x9a, 0x64, 0xac, 0xbb, 0x82, 0xc1, 0xcb, 0xa0, 0x70,
+	0x79, 0xd1, 0x6e, 0x01, 0xc5, 0x65, 0xc6, 0x79, 0x94, 0x58, 0xaf, 0xc1, 0x38, 0x97, 0xf6, 0x42,
+	0x87, 0x0a, 0x0e, 0x33, 0x17, 0x19, 0x51, 0x0b, 0x9d, 0x46, 0x8b, 0xce, 0xc2, 0x72, 0xac, 0xc0,
+	0x32, 0x02, 0x82, 0x05, 0xe2, 0x08, 0x2e, 0x40, 0x39, 0xa6, 0x35, 0x5a, 0xca, 0xeb, 0x30, 0x6f,
+	0xba, 0x9d, 0xae, 0x4d, 0x30, 0x51, 0x93, 0x7d, 0x6a, 0x70, 0xdb, 0x08, 0xcc, 0x5d, 0x2a, 0x3f,
+	0x8a, 0xf2, 0xb3, 0x89, 0xc0, 0x6d, 0xca, 0xbf, 0x49, 0xd9, 0x8d, 0x96, 0x72, 0x16, 0x80, 0x16,
+	0xb1, 0xfa, 0xe3, 0x90, 0x84, 0x04, 0x8b, 0x9e, 0x92, 0x56, 0xa2, 0x94, 0x07, 0x94, 0x40, 0xa7,
+	0x93, 0x8a, 0x08, 0x5c, 0x05, 0x15, 0xd8, 0x74, 0xc4, 0x9d, 0xa4, 0x6b, 0xa0, 0x7c, 0x0a, 0x0b,
+	0xb1, 0x74, 0x72, 0x81, 0xd1, 0x7a, 0xc4, 0x0d, 0x03, 0xb5, 0x8c, 0x67, 0x77, 0xbe, 0x2f, 0x83,
+	0xde, 0xe2, 0xfd, 0xcc, 0xcd, 0xc2, 0x6f, 0x69, 0x65, 0xa1, 0x1e, 0x64, 0x37, 0x73, 0x8b, 0x19,
+	0xa0, 0x75, 0x60, 0x6c, 0xde, 0x0b, 0x13, 0xc3, 0x63, 0x83, 0x19, 0x8e, 0x67, 0xa2, 0x85, 0xb1,
+	0xc9, 0x6d, 0x38, 0xdb, 0x22, 0x6d, 0x23, 0xb4, 0x85, 0xfd, 0xc2, 0xf5, 0x88, 0x6c, 0x8f, 0x0f,
+	0x66, 0x7b, 0x81, 0x5b, 0x89, 0x63, 0xd9, 0xf0, 0xf7, 0xa2, 0x31, 0x5e, 0x06, 0xc5, 0x36, 0xfc,
+	0x80, 0xef, 0x0b, 0x5a, 0xb7, 0x5a, 0xea, 0x24, 0x6e, 0xcb, 0x04, 0xe5, 0xe0, 0x86, 0x50, 0x8d,
+	0x46, 0x4b, 0x79, 0x05, 0xa6, 0x50, 0xb8, 0x6d, 0x79, 0xb1, 0x8a, 0xd5, 0x52, 0x15, 0x56, 0x8b,
+	0x53, 0xd6, 0x1d, 0xcb, 0xe3, 0x2a, 0x8d, 0x96, 0xf2, 0x16, 0x9c, 0x41, 0xf1, 0xb4, 0xf3, 0x7e,
+	0x60, 0x78, 0xa8, 0x36, 0x85, 0x6a, 0x73, 0x54, 0x44, 0xf4, 0x6c, 0x93, 0xf2, 0x1b, 0x2d, 0xe5,
+	0x1d, 0x00, 0x26, 0x8a, 0xe5, 0xf4, 0xf4, 0x80, 0xe5, 0x74, 0x09, 0x75, 0x28, 0x55, 0x69, 0x02,
+	0xba, 0xa4, 0x8b, 0x15, 0xfe, 0xcc, 0x80, 0x66, 0x2a, 0x54, 0xf3, 0xa3, 0xa4, 0xca, 0x5f, 0x83,
+	0x99, 0xf4, 0x2c, 0xa2, 0x4a, 0x7c, 0x96, 0x35, 0x2e, 0x07, 0xc2, 0x04, 0xa2, 0x02, 0xfc, 0x75,
+	0x98, 0xcf, 0xcc, 0xdc, 0xdc, 0x25, 0xad, 0xd0, 0xc6, 0x33, 0x3a, 0xc7, 0x02, 0x5f, 0xd4, 0xdb,
+	0xe4, 0xec, 0x46, 0x4b, 0xb9, 0x01, 0x6a, 0xce, 0xa2, 0xb1, 0x23, 0xa6, 0xa2, 0xe6, 0xcc, 0x41,
+	0x76, 0xc9, 0xf0, 0xb0, 0x6d, 0x66, 0xfd, 0x8c, 0x42, 0x65, 0x7e, 0xb0, 0x50, 0x49, 0x4d, 0x24,
+	0x8a, 0x91, 0xbe, 0xc9, 0x1b, 0x01, 0x4d, 0xb9, 0x81, 0xba, 0x80, 0x59, 0x3e, 0xa5, 0xb3, 0xce,
+	0x58, 0xa9, 0xd3, 0x96, 0x9a, 0x01, 0x6e, 0xc3, 0x99, 0x01, 0xb7, 0x61, 0x2e, 0x67, 0x96, 0xb8,
+	0x1f, 0x06, 0x2c, 0xe6, 0xaf, 0x2d, 0x1f, 0x60, 0x71, 0xc0, 0x01, 0xe6, 0xf3, 0x36, 0x80, 0x0d,
+	0x71, 0x09, 0x64, 0xd3, 0x70, 0x4c, 0x62, 0xeb, 0x1e, 0x79, 0x1c, 0x12, 0x3f, 0x20, 0x2d, 0xf5,
+	0xec, 0xb2, 0xb4, 0x52, 0xd4, 0x26, 0x18, 0x5d, 0x8b, 0xc8, 0x8a, 0x07, 0x17, 0xd2, 0xde, 0xb8,
+	0x9e, 0xb5, 0x63, 0x39, 0x86, 0x9d, 0x75, 0xab, 0x3a, 0xa0, 0x5b, 0xe7, 0x44, 0xb7, 0xee, 0x71,
+	0x63, 0x69, 0xf7, 0xfa, 0x42, 0x84, 0x7b, 0x49, 0x43, 0x64, 0x09, 0x53, 0x60, 0x2a, 0x44, 0xb8,
+	0xb3, 0x8d, 0x96, 0xf2, 0x12, 0x4c, 0xa6, 0xe7, 0x45, 0x35, 0x96, 0x51, 0x23, 0x3d, 0x31, 0x26,
+	0xeb, 0x07, 0x96, 0xb9, 0xd7, 0xd3, 0x85, 0x3c, 0x7c, 0x8e, 0xc9, 0x32, 0xc6, 0x56, 0x9c, 0x8d,
+	0x77, 0x60, 0x99, 0xcb, 0xc6, 0x71, 0x1e, 0xb8, 0x7a, 0x72, 0x84, 0x69, 0x14, 0xd6, 0x06, 0x8b,
+	0xc2, 0x45, 0x66, 0x28, 0x9a, 0xf0, 0x96, 0xbb, 0x19, 0x1d, 0x6a, 0x1a, 0x8e, 0x2a, 0x8c, 0x46,
+	0x01, 0xf8, 0x02, 0xc3, 0x23, 0xf8, 0xa3, 0xf2, 0x11, 0xcc, 0x7a, 0x24, 0xf0, 0x7a, 0x3a, 0xbb,
+	0x7f, 0x6c, 0xdd, 0x72, 0x02, 0xe2, 0xed, 0x1b, 0xb6, 0x7a, 0x7e, 0xb0, 0x81, 0xa7, 0x51, 0xbd,
+	0xc1, 0xb4, 0x1b, 0x5c, 0x39, 0x31, 0xdb, 0x31, 0x9e, 0x58, 0x9d, 0xb0, 0x93, 0x98, 0xbd, 0x70,
+	0x12, 0xb3, 0x1f, 0x32, 0xed, 0xd8, 0xec, 0xf5, 0xac, 0x59, 0x3e, 0x0d, 0x5f, 0xbd, 0x88, 0xd3,
+	0x4a, 0x69, 0xf1, 0x73, 0xe5, 0x2b, 0x6f, 0xc0, 0x3c, 0xd3, 0xda, 0x36, 0xcc, 0x3d, 0xb7, 0xdd,
+	0xd6, 0x4d, 0x97, 0xb4, 0xdb, 0x96, 0x69, 0x11, 0x27, 0x50, 0x5f, 0x5c, 0x96, 0x56, 0x24, 0x6d,
+	0x0e, 0x05, 0x6e, 0x32, 0xfe, 0x46, 0xc2, 0x56, 0x3a, 0x50, 0xcb, 0xb9, 0x02, 0xc9, 0x93, 0xae,
+	0xc5, 0xdc, 0x65, 0x41, 0xba, 0x32, 0x60, 0x90, 0x2e, 0xf5, 0xdd, 0x85, 0xb7, 0x63, 0x4b, 0x1c,
+	0xc7, 0x58, 0x62, 0xae, 0x3a, 0xae, 0xa3, 0xe3, 0x2f, 0x63, 0xdb, 0x26, 0x3a, 0xf1, 0x3c, 0xd7,
+	0xe3, 0x25, 0xdc, 0xa5, 0xe5, 0xa1, 0x95, 0x92, 0x76, 0x06, 0x99, 0x77, 0x5d, 0x47, 0x8b, 0x84,
+	0x6e, 0x53, 0x19, 0xac, 0xb9, 0x94, 0x15, 0x90, 0x77, 0x0d, 0x9f, 0xe9, 0xeb, 0x5d, 0xd7, 0xb6,
+	0xcc, 0x9e, 0xfa, 0x12, 0x9e, 0xc3, 0xca, 0xae, 0xe1, 0xa3, 0xc6, 0x7d, 0xa4, 0x2a, 0x2f, 0xc0,
+	0xb8, 0xe9, 0xb9, 0x4e, 0x1c, 0x7f, 0xea, 0xcb, 0x18, 0xa9, 0x63, 0x94, 0x18, 0xc5, 0x12, 0xad,
+	0x58, 0x7c, 0x6b, 0x87, 0x9e, 0x4d, 0xec, 0x5d, 0xd5, 0x3a, 0xab, 0x58, 0x18, 0x0d, 0x2b, 0x43,
+	0xe5, 0x01, 0x4c, 0x1a, 0x61, 0xe0, 0xea, 0x1e, 0xf1, 0x49, 0xa0, 0x77, 0x5d, 0x8b, 0xb6, 0xb8,
+	0xd7, 0x70, 0x55, 0x2e, 0x24, 0xc5, 0x26, 0xad, 0x32, 0x63, 0x3c, 0x0e, 0x0b, 0x69, 0x9f, 0x04,
+	0xf7, 0x51, 0x58, 0x9b, 0xa0, 0xfa, 0x02, 0x41, 0xf9, 0x3f, 0x98, 0xf4, 0x89, 0xe1, 0x99, 0xbb,
+	0x74, 0x93, 0x3d, 0x6b, 0x3b, 0x0c, 0x88, 0xaf, 0x5e, 0x1f, 0xbc, 0x5d, 0xcd, 0xad, 0x21, 0xeb,
+	0x9b, 0x68, 0x72, 0x3d, 0xb6, 0xc8, 0x8a, 0x58, 0xd9, 0xcf, 0x90, 0x95, 0x47, 0x50, 0xe8, 0x90,
+	0x8e, 0xab, 0xbe, 0x8a, 0x03, 0x6e, 0x3c, 0xfb, 0x80, 0x1f, 0x92, 0x8e, 0xcb, 0x06, 0x41, 0x83,
+	0xca, 0xa7, 0x30, 0xc9, 0x2f, 0x42, 0x9d, 0xa1, 0x89, 0x16, 0xf1, 0xd5, 0xff, 0xc2, 0x95, 0xba,
+	0x92, 0x3b, 0x0a, 0x93, 0xea, 0xd1, 0x11, 0xf8, 0x35, 0xf9, 0x7e, 0xa4, 0xa7, 0xc9, 0xfb, 0x19,
+	0x8a, 0x72, 0x0d, 0x66, 0x79, 0xa9, 0x11, 0x07, 0x2b, 0x2f, 0x45, 0x6f, 0xe0, 0xce, 0x4e, 0x21,
+	0x37, 0x76, 0x91, 0x95, 0xa4, 0xff, 0x03, 0x13, 0x89, 0xb8, 0x1f, 0x18, 0x81, 0xaf, 0xbe, 0x86,
+	0x1e, 0xad, 0x0d, 0x32, 0xef, 0xd8, 0xd8, 0x26, 0xd5, 0xd4, 0x2a, 0x24, 0xf5, 0x9c, 0xba, 0x77,
+	0xbc, 0xb0, 0xff, 0xec, 0xbc, 0x7e, 0xd2, 0x7b, 0x47, 0x0b, 0xb3, 0xa7, 0xe6, 0x3a, 0xcc, 0xf5,
+	0x15, 0x59, 0xc1, 0x13, 0x9c, 0xf5, 0x1b, 0xac, 0xd8, 0x48, 0x17, 0x5a, 0x5b, 0x4f, 0xe8, 0xac,
+	0xaf, 0xc3, 0x2c, 0x9d, 0x2b, 0x61, 0x50, 0x9f, 0x95, 0x80, 0x33, 0xea, 0x9b, 0xa8, 0x34, 0x8d,
+	0xdc, 0xad, 0x98, 0xc9, 0x22, 0xfd, 0x3d, 0xa8, 0xa4, 0x4b, 0x61, 0xf5, 0xad, 0x01, 0x27, 0x30,
+	0x4e, 0xc4, 0x02, 0x58, 0x59, 0x85, 0x69, 0x87, 0x1c, 0xf4, 0xef, 0xd3, 0x7f, 0xb3, 0x56, 0xc4,
+	0x21, 0x07, 0x99, 0x5d, 0xba, 0x08, 0x13, 0x74, 0x09, 0x88, 0xa7, 0x6f, 0x87, 0x96, 0x8d, 0x85,
+	0xcd, 0xdb, 0x28, 0x3b, 0xce, 0xc8, 0x37, 0x29, 0xb5, 0xd1, 0x52, 0x3a, 0x30, 0xc6, 0xeb, 0x37,
+	0xcb, 0x69, 0xbb, 0xbe, 0xfa, 0xce, 0xe0, 0x3d, 0x5f, 0x7e, 0x08, 0xb3, 0xa2, 0x8e, 0xfe, 0x8c,
+	0x5a, 0xda, 0x30, 0xa1, 0x28, 0xaf, 0xc2, 0x9c, 0x1f, 0xee, 0xec, 0xd0, 0x5b, 0xd1, 0x74, 0x9d,
+	0xc0, 0x72, 0x42, 0xa2, 0x1b, 0xbe, 0x4e, 0x31, 0xe4, 0x77, 0x31, 0xe7, 0x4c, 0x73, 0xf6, 0x06,
+	0xe7, 0xae, 0xfb, 0x77, 0xc9, 0x81, 0xb2, 0x00, 0xc5, 0xae, 0x67, 0xb9, 0x9e, 0x15, 0xf4, 0xd4,
+	0x75, 0x4c, 0xde, 0xf1, 0xf3, 0x42, 0x0b, 0x66, 0x72, 0xcf, 0x69, 0x4e, 0x1f, 0xf9, 0x6a, 0x1a,
+	0x49, 0x58, 0x4a, 0x27, 0x1b, 0xfe, 0x9e, 0x60, 0xff, 0x6a, 0xfd, 0xbe, 0xd1, 0xb3, 0x5d, 0xa3,
+	0x25, 0x82, 0x17, 0x1f, 0x43, 0x29, 0x3e, 0x9c, 0x3f, 0xae, 0x65, 0x07, 0xe4, 0xec, 0x9a, 0xe5,
+	0x0c, 0x70, 0x2b, 0x3d, 0x40, 0x7d, 0x90, 0x0d, 0x4a, 0xcc, 0xa6, 0x21, 0x8f, 0xa2, 0x5c, 0x6a,
+	0x16, 0x8a, 0x15, 0x79, 0x82, 0xc1, 0x1f, 0xcd, 0x42, 0x51, 0x96, 0x27, 0x9b, 0x85, 0xe2, 0x65,
+	0xf9, 0x95, 0x66, 0xa1, 0xf8, 0x8a, 0x5c, 0x6f, 0x16, 0x8a, 0xab, 0xf2, 0x95, 0x66, 0xa1, 0x78,
+	0x45, 0xbe, 0xda, 0x2c, 0x14, 0xaf, 0xca, 0x6b, 0xcd, 0x42, 0x71, 0x4d, 0xbe, 0x56, 0xfb, 0x9d,
+	0x04, 0x90, 0x58, 0xa5, 0xe5, 0x8b, 0x61, 0x9a, 0xa4, 0x4b, 0xeb, 0xce, 0xb8, 0x5b, 0x61, 0xa0,
+	0xc2, 0x44, 0xc4, 0x88, 0x9a, 0x95, 0xcb, 0xa0, 0xf0, 0x2e, 0x54, 0x14, 0x66, 0x0d, 0xbd, 0x1c,
+	0x73, 0x22, 0xe9, 0x1b, 0xa0, 0x66, 0xa5, 0xe3, 0x9e, 0x76, 0x88, 0x15, 0xe8, 0x69, 0x1d, 0xde,
+	0xd2, 0xd6, 0xae, 0x41, 0x25, 0x9d, 0x62, 0xe8, 0x85, 0xc4, 0xb3, 0xa2, 0xee, 0x5b, 0x9f, 0x11,
+	0xee, 0x5f, 0x99, 0xd3, 0x36, 0xad, 0xcf, 0x48, 0xed, 0x9f, 0x12, 0xcc, 0xf6, 0x45, 0x33, 0xd5,
+	0x26, 0x58, 0xcd, 0x79, 0x84, 0x9e, 0x0f, 0xa1, 0x9a, 0x93, 0x78, 0x35, 0x87, 0x8c, 0xa4, 0x9a,
+	0x9b, 0x81, 0x11, 0x7e, 0x2c, 0x19, 0x94, 0x30, 0xec, 0xe1, 0x51, 0x6c, 0xc2, 0x30, 0x26, 0x07,
+	0x74, 0xbc, 0xb2, 0x76, 0x3d, 0x77, 0xeb, 0xf0, 0xad, 0x52, 0xee, 0xa9, 0x42, 0x3f, 0x34, 0x66,
+	0x42, 0xb9, 0x03, 0x23, 0xf4, 0x47, 0xe8, 0x23, 0xaa, 0x50, 0x11, 0xe3, 0xe0, 0x78, 0x2b, 0xa1,
+	0xaf, 0x71, 0xed, 0xda, 0x97, 0x05, 0x90, 0x23, 0x38, 0x15, 0x9b, 0xcf, 0x1f, 0x0b, 0x32, 0x49,
+	0xd6, 0x60, 0x48, 0x5c, 0x83, 0x0d, 0x28, 0xb1, 0x76, 0xa9, 0xd7, 0x25, 0xdc, 0xf5, 0x8b, 0x47,
+	0xaf, 0x03, 0x36, 0x48, 0xbd, 0x2e, 0xd1, 0x8a, 0x01, 0xff, 0x45, 0xe1, 0x98, 0xc0, 0xf0, 0x76,
+	0x48, 0x06, 0x8e, 0x61, 0xb0, 0xc9, 0x24, 0x63, 0x65, 0xe0, 0x18, 0x2e, 0x2f, 0xfa, 0x3c, 0xc2,
+	0xf0, 0x0b, 0xc6, 0x49, 0xc3, 0x31, 0x5c, 0x9a, 0x4f, 0x60, 0x94, 0x4d, 0x9f, 0x11, 0x59, 0x56,
+	0x4d, 0x03, 0x26, 0xc5, 0x2c, 0x60, 0xf2, 0x26, 0x2c, 0x70, 0x13, 0xe6, 0x2e, 0x4d, 0xba, 0xf1,
+	0xb0, 0xae, 0x63, 0xf7, 0x10, 0x5f, 0x29, 0x6a, 0x73, 0x4c, 0x62, 0x83, 0x0a, 0x44, 0xa3, 0xdf,
+	0x73, 0xec, 0x1e, 0x5d, 0x5a, 0xb1, 0x81, 0x05, 0x0c, 0x53, 0xf0, 0x93, 0xa6, 0x55, 0x85, 0xd1,
+	0xa8, 0x2b, 0x2e, 0x23, 0x33, 0x7a, 0x54, 0xe6, 0x60, 0x34, 0x42, 0x16, 0xc6, 0x90, 0x33, 0x12,
+	0x30, 0x40, 0xa1, 0x01, 0x13, 0xc2, 0x2b, 0x08, 0xbc, 0x80, 0xc6, 0x07, 0xed, 0xd0, 0x13, 0x45,
+	0xca, 0x62, 0x09, 0xa3, 0xf6, 0xcb, 0x02, 0x4c, 0x09, 0x30, 0xe6, 0x4f, 0x26, 0x74, 0x84, 0xb5,
+	0x1b, 0x4e, 0xaf, 0xdd, 0x79, 0xa8, 0x64, 0xe0, 0x16, 0x86, 0xb1, 0x8d, 0xb5, 0x45, 0xa8, 0xa5,
+	0x06, 0xe3, 0x0e, 0x79, 0x22, 0x08, 0x31, 0x60, 0xad, 0x4c, 0x89, 0x91, 0x0c, 0xad, 0x7c, 0xe3,
+	0x76, 0xd4, 0x6a, 0xa9, 0x45, 0x5e, 0xf9, 0x46, 0x34, 0x26, 0xb2, 0xed, 0x19, 0x8e, 0xb9, 0xab,
+	0x07, 0xee, 0x1e, 0x61, 0xfb, 0x38, 0xa6, 0x95, 0x19, 0x6d, 0x8b, 0x92, 0xa2, 0x9b, 0x9e, 0xae,
+	0x44, 0x4a, 0x74, 0x1c, 0x45, 0xe9, 0x4d, 0xaf, 0x85, 0xce, 0x4d, 0x41, 0x41, 0xd8, 0xfc, 0x89,
+	0xe3, 0x36, 0x5f, 0x7e, 0xe6, 0xcd, 0x2f, 0xc9, 0xd0, 0x2c, 0x14, 0x41, 0x2e, 0x37, 0x0b, 0xc5,
+	0x31, 0x79, 0x9c, 0x87, 0xc3, 0xbf, 0x4e, 0x83, 0xf2, 0x30, 0x11, 0xfd, 0xe9, 0x47, 0x83, 0xb0,
+	0x98, 0x23, 0xc7, 0x2d, 0xe6, 0xe8, 0xb3, 0x2d, 0x26, 0x05, 0xde, 0x4c, 0xdb, 0xf5, 0xc9, 0xc9,
+	0xde, 0x63, 0x97, 0x50, 0x87, 0x52, 0x6b, 0xbf, 0x2f, 0xc0, 0x38, 0xfd, 0xf1, 0xd3, 0xc9, 0xdc,
+	0xb7, 0x61, 0x8c, 0x43, 0x14, 0xcc, 0xce, 0x30, 0xda, 0xa9, 0x1d, 0x72, 0x79, 0x71, 0x20, 0x02,
+	0x6d, 0x94, 0x83, 0xe4, 0x41, 0x21, 0x02, 0x50, 0x16, 0xb5, 0xe7, 0x68, 0x6f, 0x04, 0xed, 0x5d,
+	0x1d, 0xec, 0x66, 0xe5, 0x8d, 0x3b, 0x9a, 0x9f, 0x3a, 0xe8, 0x27, 0x8a, 0xe1, 0x31, 0x9a, 0x0e,
+	0x8f, 0x4b, 0x20, 0xc7, 0x39, 0x3a, 0xc2, 0x48, 0x8a, 0x58, 0x8f, 0x4e, 0x44, 0xf4, 0x08, 0xa0,
+	0x9b, 0x87, 0x62, 0x9c, 0x2c, 0xd8, 0xe7, 0x04, 0xa3, 0x84, 0x27, 0x0a, 0x21, 0xc8, 0xe0, 0xb8,
+	0x20, 0x2b, 0x3f, 0x5b, 0x90, 0xd5, 0xfe, 0x50, 0x81, 0xb1, 0x75, 0x33, 0xb0, 0xf6, 0xad, 0xa0,
+	0x87, 0x21, 0x22, 0x4c, 0x4a, 0x4a, 0x4f, 0xea, 0x06, 0xa8, 0x49, 0xde, 0xca, 0xd4, 0x5a, 0xac,
+	0x3e, 0x9b, 0x89, 0xf9, 0xa9, 0xd7, 0x07, 0xef, 0x41, 0x25, 0x83, 0xbf, 0x15, 0x06, 0xed, 0x6e,
+	0xfc, 0x14, 0xd6, 0x76, 0x96, 0x43, 0xd1, 0x2c, 0x6f, 0xb2, 0x23, 0x59, 0xf2, 0x63, 0xd0, 0x75,
+	0x03, 0xc6, 0x52, 0xe8, 0xe6, 0xa0, 0x07, 0xaf, 0xec, 0x0b, 0x88, 0xe6, 0x12, 0x94, 0x0d, 0xbe,
+	0x1e, 0x51, 0x72, 0x2e, 0x69, 0x10, 0x91, 0xd8, 0xdd, 0x2e, 0x94, 0x78, 0xfc, 0x65, 0x88, 0x17,
+	0x17, 0x77, 0x9f, 0xc0, 0xfc, 0xe1, 0xb8, 0x1b, 0x0c, 0x86, 0x53, 0xcd, 0xfa, 0xf9, 0x88, 0x5b,
+	0xc6, 0x76, 0x92, 0x1d, 0x4e, 0xf0, 0xe6, 0x44, 0xb0, 0xbd, 0x11, 0x65, 0x0a, 0x6a, 0x7b, 0x0b,
+	0x66, 0xb9, 0xaf, 0x59, 0xc3, 0x03, 0xbe, 0x39, 0x99, 0x42, 0xf5, 0x8c, 0xd5, 0x0f, 0x60, 0x72,
+	0x97, 0x18, 0x5e, 0xb0, 0x4d, 0x8c, 0xe0, 0xa4, 0xaf, 0x4b, 0xe4, 0x58, 0x33, 0xb2, 0x96, 0x07,
+	0x05, 0x57, 0xf2, 0xa1, 0xe0, 0x5c, 0x74, 0x95, 0xdd, 0x7b, 0x79, 0xe8, 0x2a, 0xfb, 0x1c, 0x26,
+	0x02, 0xc8, 0x69, 0xdd, 0x2c, 0xb3, 0xe3, 0x1a, 0x44, 0xf9, 0x93, 0x15, 0xc6, 0x22, 0xe8, 0x39,
+	0x99, 0x06, 0x3d, 0xd3, 0x35, 0x9f, 0x92, 0xad, 0xf9, 0x68, 0x4a, 0x88, 0x63, 0x97, 0x38, 0x01,
+	0x6d, 0x51, 0xa7, 0x22, 0x04, 0x97, 0x47, 0x30, 0x23, 0xe7, 0x22, 0x6d, 0xd3, 0xb9, 0x48, 0xdb,
+	0xe1, 0x40, 0xeb, 0xcc, 0xf3, 0x01, 0x5a, 0x67, 0x9f, 0x0f, 0xd0, 0x3a, 0x77, 0x04, 0xd0, 0xba,
+	0x05, 0x33, 0x4c, 0x2b, 0x8b, 0xf1, 0xa8, 0x03, 0x1e, 0xef, 0x29, 0x54, 0xcf, 0xa0, 0x3b, 0x47,
+	0xc2, 0xb7, 0xf3, 0x47, 0xc3, 0xb7, 0x03, 0xe0, 0xa9, 0x0b, 0xc7, 0xe3, 0xa9, 0x77, 0x41, 0x61,
+	0x56, 0x18, 0xca, 0xc4, 0x3e, 0x81, 0xe4, 0x6f, 0x64, 0x96, 0xd3, 0x37, 0x1e, 0x67, 0xd2, 0xcb,
+	0xe9, 0x0e, 0xfb, 0xa9, 0xc9, 0xa8, 0xfb, 0x01, 0x45, 0xa0, 0x18, 0x85, 0x36, 0x15, 0x82, 0x3d,
+	0x0e, 0xea, 0xc4, 0xa1, 0xb6, 0x88, 0xa1, 0x36, 0x17, 0x6b, 0x3d, 0x42, 0x7e, 0x1c, 0x72, 0xd9,
+	0xc2, 0xe0, 0x6c, 0x6e, 0x61, 0x20, 0xf6, 0x1d, 0xd5, 0xbe, 0xbe, 0xe3, 0x21, 0xcc, 0xe2, 0xd0,
+	0xc9, 0x81, 0x6f, 0x91, 0xc0, 0xb0, 0x6c, 0x5f, 0x5d, 0xca, 0x9b, 0x54, 0x1f, 0xd8, 0xe1, 0x6b,
+	0xd3, 0x54, 0xff, 0xfd, 0x48, 0xfd, 0x16, 0xd3, 0xa6, 0xaf, 0xb0, 0x32, 0x76, 0xc5, 0x37, 0x89,
+	0xcb, 0x83, 0xbe, 0xc2, 0x4a, 0xd9, 0x16, 0x5e, 0x29, 0x8a, 0x98, 0xd1, 0xb9, 0x34, 0x66, 0xd4,
+	0x2c, 0x14, 0x87, 0xe4, 0x42, 0xb3, 0x50, 0x1c, 0x91, 0x47, 0x6b, 0x7f, 0x96, 0xa0, 0x44, 0x15,
+	0xbc, 0x63, 0xae, 0xc9, 0xf4, 0x25, 0x75, 0x3a, 0x7b, 0x49, 0xad, 0x43, 0x19, 0x03, 0x99, 0xdf,
+	0xdb, 0x43, 0x03, 0xba, 0x0f, 0x4c, 0x29, 0xba, 0xa2, 0xc4, 0x4c, 0xc5, 0xbe, 0xd9, 0x84, 0x20,
+	0x49, 0x52, 0xf3, 0x50, 0x64, 0x09, 0x2d, 0xee, 0x7a, 0x47, 0xf1, 0xb9, 0xd1, 0xaa, 0xfd, 0x7b,
+	0x08, 0x14, 0xec, 0x29, 0xd3, 0x5f, 0x41, 0x1c, 0x79, 0xeb, 0x27, 0x5f, 0x16, 0xe4, 0xdf, 0xfa,
+	0x31, 0x3f, 0xfb, 0xd1, 0x80, 0xb0, 0x0e, 0x43, 0xd9, 0x75, 0xa8, 0xc3, 0x54, 0xc4, 0x16, 0xeb,
+	0x4d, 0xde, 0xa4, 0x73, 0x96, 0xd0, 0x76, 0x9f, 0x87, 0x4a, 0x24, 0xcf, 0xcb, 0x4f, 0xd6, 0xa0,
+	0x47, 0x57, 0x3e, 0x6b, 0xbc, 0x73, 0x61, 0x98, 0x62, 0x3e, 0x0c, 0xb3, 0x08, 0xa5, 0x38, 0xbe,
+	0xa3, 0x7b, 0x3c, 0x26, 0x9c, 0xf0, 0xa3, 0x86, 0x8f, 0xe3, 0x2f, 0x40, 0xd8, 0xdd, 0xc9, 0xb3,
+	0x76, 0x19, 0xeb, 0xcd, 0x95, 0x43, 0xea, 0xd7, 0xfb, 0xa8, 0x81, 0xf7, 0x25, 0xcb, 0xe7, 0xd1,
+	0xb7, 0x22, 0x02, 0xa9, 0xef, 0xcb, 0x8e, 0xb1, 0xfe, 0x2f, 0x3b, 0xc4, 0x08, 0x1e, 0xef, 0x8b,
+	0xe0, 0x82, 0x3c, 0xdc, 0x2c, 0x14, 0x47, 0xe5, 0x62, 0xed, 0x4b, 0x09, 0x26, 0xf9, 0xf4, 0x37,
+	0xf0, 0x0a, 0x7c, 0x5e, 0x5b, 0x9f, 0x7b, 0xf9, 0x0e, 0xe5, 0xbf, 0xda, 0xcc, 0xce, 0xaf, 0xd0,
+	0x37, 0xbf, 0xda, 0x9f, 0x24, 0x80, 0x4d, 0x7c, 0x2f, 0xf4, 0x1c, 0x63, 0xb5, 0xcf, 0xd3, 0x92,
+	0x77, 0xa8, 0x8f, 0xa3, 0x7d, 0x3e, 0xc6, 0xeb, 0x3c, 0x2c, 0x8f, 0xb0, 0x7c, 0xc1, 0xf0, 0xd3,
+	0xda, 0xe7, 0x12, 0x14, 0x37, 0x76, 0x89, 0xb9, 0xe7, 0x87, 0x9d, 0xac, 0xe7, 0xc3, 0x89, 0xe7,
+	0xb7, 0x60, 0xa4, 0x6d, 0x1b, 0xfb, 0xae, 0x87, 0x7e, 0x56, 0xd6, 0x2e, 0x1f, 0xdd, 0xa2, 0x44,
+	0x16, 0xef, 0xa0, 0x8e, 0xc6, 0x75, 0x93, 0xef, 0x9f, 0x86, 0x10, 0x04, 0x60, 0x0f, 0x37, 0xff,
+	0xf7, 0xab, 0x6f, 0xab, 0xa7, 0xbe, 0xfe, 0xb6, 0x7a, 0xea, 0xfb, 0x6f, 0xab, 0xd2, 0xe7, 0x4f,
+	0xab, 0xd2, 0x1f, 0x9f, 0x56, 0xa5, 0xbf, 0x3c, 0xad, 0x4a, 0x5f, 0x3d, 0xad, 0x4a, 0xdf, 0x3c,
+	0xad, 0x4a, 0x7f, 0x7f, 0x5a, 0x3d, 0xf5, 0xfd, 0xd3, 0xaa, 0xf4, 0xc5, 0x77, 0xd5, 0x53, 0x5f,
+	0x7d, 0x57, 0x3d, 0xf5, 0xf5, 0x77, 0xd5, 0x53, 0x9f, 0x5c, 0xdf, 0x71, 0x13, 0x1f, 0x2c, 0xf7,
+	0xf0, 0x3f, 0x2c, 0xbc, 0x29, 0x3c, 0x6e, 0x8f, 0x60, 0x02, 0xbb, 0xf6, 0x9f, 0x01, 0x00, 0x3c,
+	0x73, 0x95, 0xdb, 0xe9, 0x30, 0x00, 0x00,
 }
 
 func (this *ShardInfo) Equal(that interface{}) bool {
@@ -2258,6 +2520,83 @@ func (this *ShardInfo) Equal(that interface{}) bool {
 	if this.VisibilityAckLevel != that1.VisibilityAckLevel {
 		return false
 	}
+	if len(this.OpenExecutionCounts) != len(that1.OpenExecutionCounts) {
+		return false
+	}
+	for i := range this.OpenExecutionCounts {
+		if !this.OpenExecutionCounts[i].Equal(that1.OpenExecutionCounts[i]) {
+			return false
+		}
+	}
+	if len(this.ClusterShardReplicationLevel) != len(that1.ClusterShardReplicationLevel) {
+		return false
+	}
+	for i := range this.ClusterShardReplicationLevel {
+		if !this.ClusterShardReplicationLevel[i].Equal(that1.ClusterShardReplicationLevel[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RemoteShardReplicationLevels) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemoteShardReplicationLevels)
+	if !ok {
+		that2, ok := that.(RemoteShardReplicationLevels)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ShardLevels) != len(that1.ShardLevels) {
+		return false
+	}
+	for i := range this.ShardLevels {
+		if this.ShardLevels[i] != that1.ShardLevels[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *OpenExecutionCounts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OpenExecutionCounts)
+	if !ok {
+		that2, ok := that.(OpenExecutionCounts)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Total != that1.Total {
+		return false
+	}
+	if len(this.WorkflowTypes) != len(that1.WorkflowTypes) {
+		return false
+	}
+	for i := range this.WorkflowTypes {
+		if this.WorkflowTypes[i] != that1.WorkflowTypes[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
@@ -2521,6 +2860,53 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	if this.NewExecutionRunId != that1.NewExecutionRunId {
 		return false
 	}
+	if this.WorkerBuildId != that1.WorkerBuildId {
+		return false
+	}
+	if len(this.UpdateInfos) != len(that1.UpdateInfos) {
+		return false
+	}
+	for i := range this.UpdateInfos {
+		if !this.UpdateInfos[i].Equal(that1.UpdateInfos[i]) {
+			return false
+		}
+	}
+	if this.SuggestContinueAsNew != that1.SuggestContinueAsNew {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	return true
+}
+func (this *UpdateInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateInfo)
+	if !ok {
+		that2, ok := that.(UpdateInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AcceptedEventId != that1.AcceptedEventId {
+		return false
+	}
+	if this.CompletedEventId != that1.CompletedEventId {
+		return false
+	}
+	if this.CompletedEventBatchId != that1.CompletedEventBatchId {
+		return false
+	}
 	return true
 }
 func (this *ExecutionStats) Equal(that interface{}) bool {
@@ -2982,6 +3368,9 @@ func (this *ActivityInfo) Equal(that interface{}) bool {
 	} else if !this.LastHeartbeatUpdateTime.Equal(*that1.LastHeartbeatUpdateTime) {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
 func (this *TimerInfo) Equal(that interface{}) bool {
@@ -3073,6 +3462,9 @@ func (this *ChildExecutionInfo) Equal(that interface{}) bool {
 	if this.InitiatedId != that1.InitiatedId {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
 func (this *RequestCancelInfo) Equal(that interface{}) bool {
@@ -3175,7 +3567,7 @@ func (this *ShardInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 18)
+	s := make([]string, 0, 20)
 	s = append(s, "&persistence.ShardInfo{")
 	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
 	s = append(s, "RangeId: "+fmt.Sprintf("%#v", this.RangeId)+",\n")
@@ -3239,6 +3631,77 @@ func (this *ShardInfo) GoString() string {
 		s = append(s, "ReplicationDlqAckLevel: "+mapStringForReplicationDlqAckLevel+",\n")
 	}
 	s = append(s, "VisibilityAckLevel: "+fmt.Sprintf("%#v", this.VisibilityAckLevel)+",\n")
+	keysForOpenExecutionCounts := make([]string, 0, len(this.OpenExecutionCounts))
+	for k, _ := range this.OpenExecutionCounts {
+		keysForOpenExecutionCounts = append(keysForOpenExecutionCounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForOpenExecutionCounts)
+	mapStringForOpenExecutionCounts := "map[string]*OpenExecutionCounts{"
+	for _, k := range keysForOpenExecutionCounts {
+		mapStringForOpenExecutionCounts += fmt.Sprintf("%#v: %#v,", k, this.OpenExecutionCounts[k])
+	}
+	mapStringForOpenExecutionCounts += "}"
+	if this.OpenExecutionCounts != nil {
+		s = append(s, "OpenExecutionCounts: "+mapStringForOpenExecutionCounts+",\n")
+	}
+	keysForClusterShardReplicationLevel := make([]string, 0, len(this.ClusterShardReplicationLevel))
+	for k, _ := range this.ClusterShardReplicationLevel {
+		keysForClusterShardReplicationLevel = append(keysForClusterShardReplicationLevel, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForClusterShardReplicationLevel)
+	mapStringForClusterShardReplicationLevel := "map[string]*RemoteShardReplicationLevels{"
+	for _, k := range keysForClusterShardReplicationLevel {
+		mapStringForClusterShardReplicationLevel += fmt.Sprintf("%#v: %#v,", k, this.ClusterShardReplicationLevel[k])
+	}
+	mapStringForClusterShardReplicationLevel += "}"
+	if this.ClusterShardReplicationLevel != nil {
+		s = append(s, "ClusterShardReplicationLevel: "+mapStringForClusterShardReplicationLevel+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemoteShardReplicationLevels) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.RemoteShardReplicationLevels{")
+	keysForShardLevels := make([]int32, 0, len(this.ShardLevels))
+	for k, _ := range this.ShardLevels {
+		keysForShardLevels = append(keysForShardLevels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardLevels)
+	mapStringForShardLevels := "map[int32]int64{"
+	for _, k := range keysForShardLevels {
+		mapStringForShardLevels += fmt.Sprintf("%#v: %#v,", k, this.ShardLevels[k])
+	}
+	mapStringForShardLevels += "}"
+	if this.ShardLevels != nil {
+		s = append(s, "ShardLevels: "+mapStringForShardLevels+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OpenExecutionCounts) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.OpenExecutionCounts{")
+	s = append(s, "Total: "+fmt.Sprintf("%#v", this.Total)+",\n")
+	keysForWorkflowTypes := make([]string, 0, len(this.WorkflowTypes))
+	for k, _ := range this.WorkflowTypes {
+		keysForWorkflowTypes = append(keysForWorkflowTypes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForWorkflowTypes)
+	mapStringForWorkflowTypes := "map[string]int64{"
+	for _, k := range keysForWorkflowTypes {
+		mapStringForWorkflowTypes += fmt.Sprintf("%#v: %#v,", k, this.WorkflowTypes[k])
+	}
+	mapStringForWorkflowTypes += "}"
+	if this.WorkflowTypes != nil {
+		s = append(s, "WorkflowTypes: "+mapStringForWorkflowTypes+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3246,7 +3709,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 55)
+	s := make([]string, 0, 59)
 	s = append(s, "&persistence.WorkflowExecutionInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -3329,6 +3792,34 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	s = append(s, "StateTransitionCount: "+fmt.Sprintf("%#v", this.StateTransitionCount)+",\n")
 	s = append(s, "ExecutionTime: "+fmt.Sprintf("%#v", this.ExecutionTime)+",\n")
 	s = append(s, "NewExecutionRunId: "+fmt.Sprintf("%#v", this.NewExecutionRunId)+",\n")
+	s = append(s, "WorkerBuildId: "+fmt.Sprintf("%#v", this.WorkerBuildId)+",\n")
+	keysForUpdateInfos := make([]string, 0, len(this.UpdateInfos))
+	for k, _ := range this.UpdateInfos {
+		keysForUpdateInfos = append(keysForUpdateInfos, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUpdateInfos)
+	mapStringForUpdateInfos := "map[string]*UpdateInfo{"
+	for _, k := range keysForUpdateInfos {
+		mapStringForUpdateInfos += fmt.Sprintf("%#v: %#v,", k, this.UpdateInfos[k])
+	}
+	mapStringForUpdateInfos += "}"
+	if this.UpdateInfos != nil {
+		s = append(s, "UpdateInfos: "+mapStringForUpdateInfos+",\n")
+	}
+	s = append(s, "SuggestContinueAsNew: "+fmt.Sprintf("%#v", this.SuggestContinueAsNew)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&persistence.UpdateInfo{")
+	s = append(s, "AcceptedEventId: "+fmt.Sprintf("%#v", this.AcceptedEventId)+",\n")
+	s = append(s, "CompletedEventId: "+fmt.Sprintf("%#v", this.CompletedEventId)+",\n")
+	s = append(s, "CompletedEventBatchId: "+fmt.Sprintf("%#v", this.CompletedEventBatchId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3439,7 +3930,7 @@ func (this *ActivityInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 34)
+	s := make([]string, 0, 35)
 	s = append(s, "&persistence.ActivityInfo{")
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "ScheduledEventBatchId: "+fmt.Sprintf("%#v", this.ScheduledEventBatchId)+",\n")
@@ -3475,6 +3966,7 @@ func (this *ActivityInfo) GoString() string {
 		s = append(s, "LastHeartbeatDetails: "+fmt.Sprintf("%#v", this.LastHeartbeatDetails)+",\n")
 	}
 	s = append(s, "LastHeartbeatUpdateTime: "+fmt.Sprintf("%#v", this.LastHeartbeatUpdateTime)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3496,7 +3988,7 @@ func (this *ChildExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 14)
+	s := make([]string, 0, 15)
 	s = append(s, "&persistence.ChildExecutionInfo{")
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "InitiatedEventBatchId: "+fmt.Sprintf("%#v", this.InitiatedEventBatchId)+",\n")
@@ -3508,6 +4000,7 @@ func (this *ChildExecutionInfo) GoString() string {
 	s = append(s, "WorkflowTypeName: "+fmt.Sprintf("%#v", this.WorkflowTypeName)+",\n")
 	s = append(s, "ParentClosePolicy: "+fmt.Sprintf("%#v", this.ParentClosePolicy)+",\n")
 	s = append(s, "InitiatedId: "+fmt.Sprintf("%#v", this.InitiatedId)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3577,6 +4070,62 @@ func (m *ShardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClusterShardReplicationLevel) > 0 {
+		for k := range m.ClusterShardReplicationLevel {
+			v := m.ClusterShardReplicationLevel[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintExecutions(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintExecutions(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintExecutions(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.OpenExecutionCounts) > 0 {
+		for k := range m.OpenExecutionCounts {
+			v := m.OpenExecutionCounts[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintExecutions(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintExecutions(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintExecutions(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if m.VisibilityAckLevel != 0 {
 		i = encodeVarintExecutions(dAtA, i, uint64(m.VisibilityAckLevel))
 		i--
@@ -3621,12 +4170,12 @@ func (m *ShardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			v := m.ClusterTimerAckLevel[k]
 			baseI := i
 			if v != nil {
-				n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo((*v), dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime((*v)):])
-				if err1 != nil {
-					return 0, err1
+				n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo((*v), dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime((*v)):])
+				if err3 != nil {
+					return 0, err3
 				}
-				i -= n1
-				i = encodeVarintExecutions(dAtA, i, uint64(n1))
+				i -= n3
+				i = encodeVarintExecutions(dAtA, i, uint64(n3))
 				i--
 				dAtA[i] = 0x12
 			}
@@ -3663,22 +4212,22 @@ func (m *ShardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if m.TimerAckLevelTime != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimerAckLevelTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimerAckLevelTime):])
-		if err2 != nil {
-			return 0, err2
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimerAckLevelTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimerAckLevelTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n2
-		i = encodeVarintExecutions(dAtA, i, uint64(n2))
+		i -= n4
+		i = encodeVarintExecutions(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x42
 	}
 	if m.UpdateTime != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdateTime):])
-		if err3 != nil {
-			return 0, err3
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdateTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n3
-		i = encodeVarintExecutions(dAtA, i, uint64(n3))
+		i -= n5
+		i = encodeVarintExecutions(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -3717,6 +4266,89 @@ func (m *ShardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RemoteShardReplicationLevels) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteShardReplicationLevels) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteShardReplicationLevels) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShardLevels) > 0 {
+		for k := range m.ShardLevels {
+			v := m.ShardLevels[k]
+			baseI := i
+			i = encodeVarintExecutions(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintExecutions(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintExecutions(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenExecutionCounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenExecutionCounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenExecutionCounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkflowTypes) > 0 {
+		for k := range m.WorkflowTypes {
+			v := m.WorkflowTypes[k]
+			baseI := i
+			i = encodeVarintExecutions(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintExecutions(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintExecutions(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Total != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkflowExecutionInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3737,6 +4369,62 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.SuggestContinueAsNew {
+		i--
+		if m.SuggestContinueAsNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.UpdateInfos) > 0 {
+		for k := range m.UpdateInfos {
+			v := m.UpdateInfos[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintExecutions(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintExecutions(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintExecutions(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.WorkerBuildId) > 0 {
+		i -= len(m.WorkerBuildId)
+		copy(dAtA[i:], m.WorkerBuildId)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.WorkerBuildId)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf2
+	}
 	if len(m.NewExecutionRunId) > 0 {
 		i -= len(m.NewExecutionRunId)
 		copy(dAtA[i:], m.NewExecutionRunId)
@@ -3747,12 +4435,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xea
 	}
 	if m.ExecutionTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecutionTime):])
-		if err4 != nil {
-			return 0, err4
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecutionTime):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n4
-		i = encodeVarintExecutions(dAtA, i, uint64(n4))
+		i -= n7
+		i = encodeVarintExecutions(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x3
 		i--
@@ -3773,12 +4461,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd0
 	}
 	if m.WorkflowRunExpirationTime != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
-		if err5 != nil {
-			return 0, err5
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n5
-		i = encodeVarintExecutions(dAtA, i, uint64(n5))
+		i -= n8
+		i = encodeVarintExecutions(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x3
 		i--
@@ -3931,12 +4619,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.WorkflowExecutionExpirationTime != nil {
-		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
-		if err11 != nil {
-			return 0, err11
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n11
-		i = encodeVarintExecutions(dAtA, i, uint64(n11))
+		i -= n14
+		i = encodeVarintExecutions(dAtA, i, uint64(n14))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -3958,24 +4646,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.RetryMaximumInterval != nil {
-		n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err12 != nil {
-			return 0, err12
+		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n12
-		i = encodeVarintExecutions(dAtA, i, uint64(n12))
+		i -= n15
+		i = encodeVarintExecutions(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x2
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.RetryInitialInterval != nil {
-		n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err13 != nil {
-			return 0, err13
+		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err16 != nil {
+			return 0, err16
 		}
-		i -= n13
-		i = encodeVarintExecutions(dAtA, i, uint64(n13))
+		i -= n16
+		i = encodeVarintExecutions(dAtA, i, uint64(n16))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -3989,12 +4677,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x98
 	}
 	if m.StickyScheduleToStartTimeout != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
-		if err14 != nil {
-			return 0, err14
+		n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n14
-		i = encodeVarintExecutions(dAtA, i, uint64(n14))
+		i -= n17
+		i = encodeVarintExecutions(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4028,12 +4716,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xfa
 	}
 	if m.WorkflowTaskOriginalScheduledTime != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
-		if err15 != nil {
-			return 0, err15
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
+		if err18 != nil {
+			return 0, err18
 		}
-		i -= n15
-		i = encodeVarintExecutions(dAtA, i, uint64(n15))
+		i -= n18
+		i = encodeVarintExecutions(dAtA, i, uint64(n18))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4052,24 +4740,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xe8
 	}
 	if m.WorkflowTaskScheduledTime != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
-		if err16 != nil {
-			return 0, err16
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
+		if err19 != nil {
+			return 0, err19
 		}
-		i -= n16
-		i = encodeVarintExecutions(dAtA, i, uint64(n16))
+		i -= n19
+		i = encodeVarintExecutions(dAtA, i, uint64(n19))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xe2
 	}
 	if m.WorkflowTaskStartedTime != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
-		if err17 != nil {
-			return 0, err17
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n17
-		i = encodeVarintExecutions(dAtA, i, uint64(n17))
+		i -= n20
+		i = encodeVarintExecutions(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4083,13 +4771,13 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd0
 	}
 	if m.WorkflowTaskTimeout != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
-		if err18 != nil {
-			return 0, err18
+		n21, err21 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n18
-		i = encodeVarintExecutions(dAtA, i, uint64(n18))
-		i--
+		i -= n21
+		i = encodeVarintExecutions(dAtA, i, uint64(n21))
+		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xca
@@ -4116,24 +4804,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.LastUpdateTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
-		if err19 != nil {
-			return 0, err19
+		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err22 != nil {
+			return 0, err22
 		}
-		i -= n19
-		i = encodeVarintExecutions(dAtA, i, uint64(n19))
+		i -= n22
+		i = encodeVarintExecutions(dAtA, i, uint64(n22))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.StartTime != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
-		if err20 != nil {
-			return 0, err20
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err23 != nil {
+			return 0, err23
 		}
-		i -= n20
-		i = encodeVarintExecutions(dAtA, i, uint64(n20))
+		i -= n23
+		i = encodeVarintExecutions(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4161,32 +4849,32 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x88
 	}
 	if m.DefaultWorkflowTaskTimeout != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
-		if err21 != nil {
-			return 0, err21
+		n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
+		if err24 != nil {
+			return 0, err24
 		}
-		i -= n21
-		i = encodeVarintExecutions(dAtA, i, uint64(n21))
+		i -= n24
+		i = encodeVarintExecutions(dAtA, i, uint64(n24))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.WorkflowRunTimeout != nil {
-		n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
-		if err22 != nil {
-			return 0, err22
+		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
+		if err25 != nil {
+			return 0, err25
 		}
-		i -= n22
-		i = encodeVarintExecutions(dAtA, i, uint64(n22))
+		i -= n25
+		i = encodeVarintExecutions(dAtA, i, uint64(n25))
 		i--
 		dAtA[i] = 0x62
 	}
 	if m.WorkflowExecutionTimeout != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
-		if err23 != nil {
-			return 0, err23
+		n26, err26 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
+		if err26 != nil {
+			return 0, err26
 		}
-		i -= n23
-		i = encodeVarintExecutions(dAtA, i, uint64(n23))
+		i -= n26
+		i = encodeVarintExecutions(dAtA, i, uint64(n26))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -4252,6 +4940,44 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdateInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedEventBatchId != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.CompletedEventBatchId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CompletedEventId != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.CompletedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AcceptedEventId != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.AcceptedEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExecutionStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4348,12 +5074,12 @@ func (m *TransferTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err24 != nil {
-			return 0, err24
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err27 != nil {
+			return 0, err27
 		}
-		i -= n24
-		i = encodeVarintExecutions(dAtA, i, uint64(n24))
+		i -= n27
+		i = encodeVarintExecutions(dAtA, i, uint64(n27))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -4460,12 +5186,12 @@ func (m *ReplicationTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err25 != nil {
-			return 0, err25
+		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err28 != nil {
+			return 0, err28
 		}
-		i -= n25
-		i = encodeVarintExecutions(dAtA, i, uint64(n25))
+		i -= n28
+		i = encodeVarintExecutions(dAtA, i, uint64(n28))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4560,22 +5286,22 @@ func (m *VisibilityTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.CloseTime != nil {
-		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
-		if err26 != nil {
-			return 0, err26
+		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
+		if err29 != nil {
+			return 0, err29
 		}
-		i -= n26
-		i = encodeVarintExecutions(dAtA, i, uint64(n26))
+		i -= n29
+		i = encodeVarintExecutions(dAtA, i, uint64(n29))
 		i--
 		dAtA[i] = 0x42
 	}
 	if m.VisibilityTime != nil {
-		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err27 != nil {
-			return 0, err27
+		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err30 != nil {
+			return 0, err30
 		}
-		i -= n27
-		i = encodeVarintExecutions(dAtA, i, uint64(n27))
+		i -= n30
+		i = encodeVarintExecutions(dAtA, i, uint64(n30))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -4639,12 +5365,12 @@ func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err28 != nil {
-			return 0, err28
+		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err31 != nil {
+			return 0, err31
 		}
-		i -= n28
-		i = encodeVarintExecutions(dAtA, i, uint64(n28))
+		i -= n31
+		i = encodeVarintExecutions(dAtA, i, uint64(n31))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -4727,13 +5453,20 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.LastHeartbeatUpdateTime != nil {
-		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
-		if err29 != nil {
-			return 0, err29
+		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
+		if err32 != nil {
+			return 0, err32
 		}
-		i -= n29
-		i = encodeVarintExecutions(dAtA, i, uint64(n29))
+		i -= n32
+		i = encodeVarintExecutions(dAtA, i, uint64(n32))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4812,12 +5545,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc9
 	}
 	if m.RetryExpirationTime != nil {
-		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
-		if err32 != nil {
-			return 0, err32
+		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err35 != nil {
+			return 0, err35
 		}
-		i -= n32
-		i = encodeVarintExecutions(dAtA, i, uint64(n32))
+		i -= n35
+		i = encodeVarintExecutions(dAtA, i, uint64(n35))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4831,24 +5564,24 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb8
 	}
 	if m.RetryMaximumInterval != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err33 != nil {
-			return 0, err33
+		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n33
-		i = encodeVarintExecutions(dAtA, i, uint64(n33))
+		i -= n36
+		i = encodeVarintExecutions(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xb2
 	}
 	if m.RetryInitialInterval != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err34 != nil {
-			return 0, err34
+		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n34
-		i = encodeVarintExecutions(dAtA, i, uint64(n34))
+		i -= n37
+		i = encodeVarintExecutions(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4914,42 +5647,42 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.HeartbeatTimeout != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
-		if err35 != nil {
-			return 0, err35
+		n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err38 != nil {
+			return 0, err38
 		}
-		i -= n35
-		i = encodeVarintExecutions(dAtA, i, uint64(n35))
+		i -= n38
+		i = encodeVarintExecutions(dAtA, i, uint64(n38))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.StartToCloseTimeout != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
-		if err36 != nil {
-			return 0, err36
+		n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err39 != nil {
+			return 0, err39
 		}
-		i -= n36
-		i = encodeVarintExecutions(dAtA, i, uint64(n36))
+		i -= n39
+		i = encodeVarintExecutions(dAtA, i, uint64(n39))
 		i--
 		dAtA[i] = 0x62
 	}
 	if m.ScheduleToCloseTimeout != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
-		if err37 != nil {
-			return 0, err37
+		n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+		if err40 != nil {
+			return 0, err40
 		}
-		i -= n37
-		i = encodeVarintExecutions(dAtA, i, uint64(n37))
+		i -= n40
+		i = encodeVarintExecutions(dAtA, i, uint64(n40))
 		i--
 		dAtA[i] = 0x5a
 	}
 	if m.ScheduleToStartTimeout != nil {
-		n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
-		if err38 != nil {
-			return 0, err38
+		n41, err41 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err41 != nil {
+			return 0, err41
 		}
-		i -= n38
-		i = encodeVarintExecutions(dAtA, i, uint64(n38))
+		i -= n41
+		i = encodeVarintExecutions(dAtA, i, uint64(n41))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -4968,12 +5701,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if m.StartedTime != nil {
-		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err39 != nil {
-			return 0, err39
+		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err42 != nil {
+			return 0, err42
 		}
-		i -= n39
-		i = encodeVarintExecutions(dAtA, i, uint64(n39))
+		i -= n42
+		i = encodeVarintExecutions(dAtA, i, uint64(n42))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -4983,12 +5716,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if m.ScheduledTime != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err40 != nil {
-			return 0, err40
+		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err43 != nil {
+			return 0, err43
 		}
-		i -= n40
-		i = encodeVarintExecutions(dAtA, i, uint64(n40))
+		i -= n43
+		i = encodeVarintExecutions(dAtA, i, uint64(n43))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5038,12 +5771,12 @@ func (m *TimerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.ExpiryTime != nil {
-		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
-		if err41 != nil {
-			return 0, err41
+		n44, err44 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err44 != nil {
+			return 0, err44
 		}
-		i -= n41
-		i = encodeVarintExecutions(dAtA, i, uint64(n41))
+		i -= n44
+		i = encodeVarintExecutions(dAtA, i, uint64(n44))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5080,6 +5813,11 @@ func (m *ChildExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.InitiatedId != 0 {
 		i = encodeVarintExecutions(dAtA, i, uint64(m.InitiatedId))
 		i--
@@ -5360,6 +6098,69 @@ func (m *ShardInfo) Size() (n int) {
 	if m.VisibilityAckLevel != 0 {
 		n += 1 + sovExecutions(uint64(m.VisibilityAckLevel))
 	}
+	if len(m.OpenExecutionCounts) > 0 {
+		for k, v := range m.OpenExecutionCounts {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovExecutions(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovExecutions(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovExecutions(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ClusterShardReplicationLevel) > 0 {
+		for k, v := range m.ClusterShardReplicationLevel {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovExecutions(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovExecutions(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovExecutions(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *RemoteShardReplicationLevels) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShardLevels) > 0 {
+		for k, v := range m.ShardLevels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovExecutions(uint64(k)) + 1 + sovExecutions(uint64(v))
+			n += mapEntrySize + 1 + sovExecutions(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *OpenExecutionCounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovExecutions(uint64(m.Total))
+	}
+	if len(m.WorkflowTypes) > 0 {
+		for k, v := range m.WorkflowTypes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovExecutions(uint64(len(k))) + 1 + sovExecutions(uint64(v))
+			n += mapEntrySize + 1 + sovExecutions(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -5576,22 +6377,63 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovExecutions(uint64(l))
 	}
+	l = len(m.WorkerBuildId)
+	if l > 0 {
+		n += 2 + l + sovExecutions(uint64(l))
+	}
+	if len(m.UpdateInfos) > 0 {
+		for k, v := range m.UpdateInfos {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovExecutions(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovExecutions(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovExecutions(uint64(mapEntrySize))
+		}
+	}
+	if m.SuggestContinueAsNew {
+		n += 3
+	}
+	if m.Priority != 0 {
+		n += 2 + sovExecutions(uint64(m.Priority))
+	}
 	return n
 }
 
-func (m *ExecutionStats) Size() (n int) {
+func (m *UpdateInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.HistorySize != 0 {
-		n += 1 + sovExecutions(uint64(m.HistorySize))
+	if m.AcceptedEventId != 0 {
+		n += 1 + sovExecutions(uint64(m.AcceptedEventId))
+	}
+	if m.CompletedEventId != 0 {
+		n += 1 + sovExecutions(uint64(m.CompletedEventId))
+	}
+	if m.CompletedEventBatchId != 0 {
+		n += 1 + sovExecutions(uint64(m.CompletedEventBatchId))
 	}
 	return n
 }
 
-func (m *WorkflowExecutionState) Size() (n int) {
+func (m *ExecutionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HistorySize != 0 {
+		n += 1 + sovExecutions(uint64(m.HistorySize))
+	}
+	return n
+}
+
+func (m *WorkflowExecutionState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5922,6 +6764,9 @@ func (m *ActivityInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime)
 		n += 2 + l + sovExecutions(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 2 + sovExecutions(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -5992,6 +6837,9 @@ func (m *ChildExecutionInfo) Size() (n int) {
 	if m.InitiatedId != 0 {
 		n += 1 + sovExecutions(uint64(m.InitiatedId))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovExecutions(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -6108,6 +6956,26 @@ func (this *ShardInfo) String() string {
 		mapStringForReplicationDlqAckLevel += fmt.Sprintf("%v: %v,", k, this.ReplicationDlqAckLevel[k])
 	}
 	mapStringForReplicationDlqAckLevel += "}"
+	keysForOpenExecutionCounts := make([]string, 0, len(this.OpenExecutionCounts))
+	for k, _ := range this.OpenExecutionCounts {
+		keysForOpenExecutionCounts = append(keysForOpenExecutionCounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForOpenExecutionCounts)
+	mapStringForOpenExecutionCounts := "map[string]*OpenExecutionCounts{"
+	for _, k := range keysForOpenExecutionCounts {
+		mapStringForOpenExecutionCounts += fmt.Sprintf("%v: %v,", k, this.OpenExecutionCounts[k])
+	}
+	mapStringForOpenExecutionCounts += "}"
+	keysForClusterShardReplicationLevel := make([]string, 0, len(this.ClusterShardReplicationLevel))
+	for k, _ := range this.ClusterShardReplicationLevel {
+		keysForClusterShardReplicationLevel = append(keysForClusterShardReplicationLevel, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForClusterShardReplicationLevel)
+	mapStringForClusterShardReplicationLevel := "map[string]*RemoteShardReplicationLevels{"
+	for _, k := range keysForClusterShardReplicationLevel {
+		mapStringForClusterShardReplicationLevel += fmt.Sprintf("%v: %v,", k, this.ClusterShardReplicationLevel[k])
+	}
+	mapStringForClusterShardReplicationLevel += "}"
 	s := strings.Join([]string{`&ShardInfo{`,
 		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
 		`RangeId:` + fmt.Sprintf("%v", this.RangeId) + `,`,
@@ -6123,6 +6991,49 @@ func (this *ShardInfo) String() string {
 		`ClusterReplicationLevel:` + mapStringForClusterReplicationLevel + `,`,
 		`ReplicationDlqAckLevel:` + mapStringForReplicationDlqAckLevel + `,`,
 		`VisibilityAckLevel:` + fmt.Sprintf("%v", this.VisibilityAckLevel) + `,`,
+		`OpenExecutionCounts:` + mapStringForOpenExecutionCounts + `,`,
+		`ClusterShardReplicationLevel:` + mapStringForClusterShardReplicationLevel + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoteShardReplicationLevels) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForShardLevels := make([]int32, 0, len(this.ShardLevels))
+	for k, _ := range this.ShardLevels {
+		keysForShardLevels = append(keysForShardLevels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardLevels)
+	mapStringForShardLevels := "map[int32]int64{"
+	for _, k := range keysForShardLevels {
+		mapStringForShardLevels += fmt.Sprintf("%v: %v,", k, this.ShardLevels[k])
+	}
+	mapStringForShardLevels += "}"
+	s := strings.Join([]string{`&RemoteShardReplicationLevels{`,
+		`ShardLevels:` + mapStringForShardLevels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OpenExecutionCounts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForWorkflowTypes := make([]string, 0, len(this.WorkflowTypes))
+	for k, _ := range this.WorkflowTypes {
+		keysForWorkflowTypes = append(keysForWorkflowTypes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForWorkflowTypes)
+	mapStringForWorkflowTypes := "map[string]int64{"
+	for _, k := range keysForWorkflowTypes {
+		mapStringForWorkflowTypes += fmt.Sprintf("%v: %v,", k, this.WorkflowTypes[k])
+	}
+	mapStringForWorkflowTypes += "}"
+	s := strings.Join([]string{`&OpenExecutionCounts{`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`WorkflowTypes:` + mapStringForWorkflowTypes + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6151,6 +7062,16 @@ func (this *WorkflowExecutionInfo) String() string {
 		mapStringForMemo += fmt.Sprintf("%v: %v,", k, this.Memo[k])
 	}
 	mapStringForMemo += "}"
+	keysForUpdateInfos := make([]string, 0, len(this.UpdateInfos))
+	for k, _ := range this.UpdateInfos {
+		keysForUpdateInfos = append(keysForUpdateInfos, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUpdateInfos)
+	mapStringForUpdateInfos := "map[string]*UpdateInfo{"
+	for _, k := range keysForUpdateInfos {
+		mapStringForUpdateInfos += fmt.Sprintf("%v: %v,", k, this.UpdateInfos[k])
+	}
+	mapStringForUpdateInfos += "}"
 	s := strings.Join([]string{`&WorkflowExecutionInfo{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
@@ -6203,6 +7124,22 @@ func (this *WorkflowExecutionInfo) String() string {
 		`StateTransitionCount:` + fmt.Sprintf("%v", this.StateTransitionCount) + `,`,
 		`ExecutionTime:` + strings.Replace(fmt.Sprintf("%v", this.ExecutionTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`NewExecutionRunId:` + fmt.Sprintf("%v", this.NewExecutionRunId) + `,`,
+		`WorkerBuildId:` + fmt.Sprintf("%v", this.WorkerBuildId) + `,`,
+		`UpdateInfos:` + mapStringForUpdateInfos + `,`,
+		`SuggestContinueAsNew:` + fmt.Sprintf("%v", this.SuggestContinueAsNew) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateInfo{`,
+		`AcceptedEventId:` + fmt.Sprintf("%v", this.AcceptedEventId) + `,`,
+		`CompletedEventId:` + fmt.Sprintf("%v", this.CompletedEventId) + `,`,
+		`CompletedEventBatchId:` + fmt.Sprintf("%v", this.CompletedEventBatchId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6345,6 +7282,7 @@ func (this *ActivityInfo) String() string {
 		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
 		`LastHeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatDetails), "Payloads", "v11.Payloads", 1) + `,`,
 		`LastHeartbeatUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6378,6 +7316,7 @@ func (this *ChildExecutionInfo) String() string {
 		`WorkflowTypeName:` + fmt.Sprintf("%v", this.WorkflowTypeName) + `,`,
 		`ParentClosePolicy:` + fmt.Sprintf("%v", this.ParentClosePolicy) + `,`,
 		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7161,64 +8100,11 @@ func (m *ShardInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExecutions(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExecutions
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowExecutionInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowExecutionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenExecutionCounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7228,61 +8114,129 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecutions
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExecutions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			if m.OpenExecutionCounts == nil {
+				m.OpenExecutionCounts = make(map[string]*OpenExecutionCounts)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecutions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *OpenExecutionCounts
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &OpenExecutionCounts{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutions(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			m.OpenExecutionCounts[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentNamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterShardReplicationLevel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7292,61 +8246,823 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecutions
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExecutions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentNamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentWorkflowId", wireType)
+			if m.ClusterShardReplicationLevel == nil {
+				m.ClusterShardReplicationLevel = make(map[string]*RemoteShardReplicationLevels)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecutions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+			var mapkey string
+			var mapvalue *RemoteShardReplicationLevels
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RemoteShardReplicationLevels{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutions(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ClusterShardReplicationLevel[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteShardReplicationLevels) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteShardReplicationLevels: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteShardReplicationLevels: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardLevels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShardLevels == nil {
+				m.ShardLevels = make(map[int32]int64)
+			}
+			var mapkey int32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutions(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ShardLevels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpenExecutionCounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenExecutionCounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenExecutionCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowTypes == nil {
+				m.WorkflowTypes = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutions(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.WorkflowTypes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowExecutionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowExecutionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentNamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentNamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentWorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentWorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
+			}
+			m.InitiatedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEventBatchId", wireType)
+			}
+			m.CompletionEventBatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionEventBatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecutions
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExecutions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentWorkflowId = string(dAtA[iNdEx:postIndex])
+			if m.WorkflowExecutionTimeout == nil {
+				m.WorkflowExecutionTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.WorkflowExecutionTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentRunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowRunTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7356,29 +9072,69 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecutions
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExecutions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentRunId = string(dAtA[iNdEx:postIndex])
+			if m.WorkflowRunTimeout == nil {
+				m.WorkflowRunTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.WorkflowRunTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultWorkflowTaskTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultWorkflowTaskTimeout == nil {
+				m.DefaultWorkflowTaskTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.DefaultWorkflowTaskTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventTaskId", wireType)
 			}
-			m.InitiatedId = 0
+			m.LastEventTaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7388,16 +9144,16 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitiatedId |= int64(b&0x7F) << shift
+				m.LastEventTaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEventBatchId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
 			}
-			m.CompletionEventBatchId = 0
+			m.LastFirstEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7407,16 +9163,35 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompletionEventBatchId |= int64(b&0x7F) << shift
+				m.LastFirstEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkflowTaskStartId", wireType)
+			}
+			m.LastWorkflowTaskStartId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastWorkflowTaskStartId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7426,29 +9201,33 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecutions
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExecutions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTypeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7458,27 +9237,88 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecutions
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExecutions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowTypeName = string(dAtA[iNdEx:postIndex])
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskVersion", wireType)
+			}
+			m.WorkflowTaskVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowTaskVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskScheduleId", wireType)
+			}
+			m.WorkflowTaskScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowTaskScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskStartedId", wireType)
+			}
+			m.WorkflowTaskStartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowTaskStartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7505,16 +9345,35 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionTimeout == nil {
-				m.WorkflowExecutionTimeout = new(time.Duration)
+			if m.WorkflowTaskTimeout == nil {
+				m.WorkflowTaskTimeout = new(time.Duration)
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.WorkflowExecutionTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.WorkflowTaskTimeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskAttempt", wireType)
+			}
+			m.WorkflowTaskAttempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowTaskAttempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowRunTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskStartedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7541,16 +9400,16 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowRunTimeout == nil {
-				m.WorkflowRunTimeout = new(time.Duration)
+			if m.WorkflowTaskStartedTime == nil {
+				m.WorkflowTaskStartedTime = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.WorkflowRunTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowTaskStartedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultWorkflowTaskTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskScheduledTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7577,56 +9436,18 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DefaultWorkflowTaskTimeout == nil {
-				m.DefaultWorkflowTaskTimeout = new(time.Duration)
+			if m.WorkflowTaskScheduledTime == nil {
+				m.WorkflowTaskScheduledTime = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.DefaultWorkflowTaskTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowTaskScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEventTaskId", wireType)
-			}
-			m.LastEventTaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecutions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastEventTaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
-			}
-			m.LastFirstEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecutions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastFirstEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 19:
+		case 29:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkflowTaskStartId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
 			}
-			m.LastWorkflowTaskStartId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7636,14 +9457,15 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastWorkflowTaskStartId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+			m.CancelRequested = bool(v != 0)
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskOriginalScheduledTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7670,18 +9492,18 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartTime == nil {
-				m.StartTime = new(time.Time)
+			if m.WorkflowTaskOriginalScheduledTime == nil {
+				m.WorkflowTaskOriginalScheduledTime = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowTaskOriginalScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskRequestId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7691,33 +9513,29 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExecutions
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExecutions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastUpdateTime == nil {
-				m.LastUpdateTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkflowTaskRequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskVersion", wireType)
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequestId", wireType)
 			}
-			m.WorkflowTaskVersion = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7727,35 +9545,29 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowTaskVersion |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskScheduleId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
 			}
-			m.WorkflowTaskScheduleId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecutions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowTaskScheduleId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
 			}
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskStartedId", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowTaskStartedId = 0
+			m.CancelRequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueue", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7765,14 +9577,27 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowTaskStartedId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 25:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StickyTaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyScheduleToStartTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7799,18 +9624,18 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowTaskTimeout == nil {
-				m.WorkflowTaskTimeout = new(time.Duration)
+			if m.StickyScheduleToStartTimeout == nil {
+				m.StickyScheduleToStartTimeout = new(time.Duration)
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.WorkflowTaskTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StickyScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 35:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskAttempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			m.WorkflowTaskAttempt = 0
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7820,14 +9645,14 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowTaskAttempt |= int32(b&0x7F) << shift
+				m.Attempt |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 27:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskStartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryInitialInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7854,16 +9679,16 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowTaskStartedTime == nil {
-				m.WorkflowTaskStartedTime = new(time.Time)
+			if m.RetryInitialInterval == nil {
+				m.RetryInitialInterval = new(time.Duration)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowTaskStartedTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.RetryInitialInterval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryMaximumInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7890,18 +9715,18 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowTaskScheduledTime == nil {
-				m.WorkflowTaskScheduledTime = new(time.Time)
+			if m.RetryMaximumInterval == nil {
+				m.RetryMaximumInterval = new(time.Duration)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowTaskScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.RetryMaximumInterval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
+		case 38:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryMaximumAttempts", wireType)
 			}
-			var v int
+			m.RetryMaximumAttempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7911,15 +9736,25 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.RetryMaximumAttempts |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CancelRequested = bool(v != 0)
-		case 30:
+		case 39:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoffCoefficient", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RetryBackoffCoefficient = float64(math.Float64frombits(v))
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskOriginalScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionExpirationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7946,16 +9781,16 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowTaskOriginalScheduledTime == nil {
-				m.WorkflowTaskOriginalScheduledTime = new(time.Time)
+			if m.WorkflowExecutionExpirationTime == nil {
+				m.WorkflowExecutionExpirationTime = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowTaskOriginalScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowExecutionExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskRequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryNonRetryableErrorTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7983,13 +9818,13 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowTaskRequestId = string(dAtA[iNdEx:postIndex])
+			m.RetryNonRetryableErrorTypes = append(m.RetryNonRetryableErrorTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 32:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequestId", wireType)
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRetryPolicy", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -7999,27 +9834,15 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CancelRequestId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 33:
+			m.HasRetryPolicy = bool(v != 0)
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CronSchedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8047,11 +9870,30 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StickyTaskQueue = string(dAtA[iNdEx:postIndex])
+			m.CronSchedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 34:
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalCount", wireType)
+			}
+			m.SignalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignalCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoResetPoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8078,18 +9920,18 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyScheduleToStartTimeout == nil {
-				m.StickyScheduleToStartTimeout = new(time.Duration)
+			if m.AutoResetPoints == nil {
+				m.AutoResetPoints = &v1.ResetPoints{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StickyScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AutoResetPoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 35:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
 			}
-			m.Attempt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -8099,14 +9941,124 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 36:
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttributes == nil {
+				m.SearchAttributes = make(map[string]*v11.Payload)
+			}
+			var mapkey string
+			var mapvalue *v11.Payload
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.Payload{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutions(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SearchAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryInitialInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8133,16 +10085,109 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryInitialInterval == nil {
-				m.RetryInitialInterval = new(time.Duration)
+			if m.Memo == nil {
+				m.Memo = make(map[string]*v11.Payload)
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.RetryInitialInterval, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue *v11.Payload
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.Payload{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutions(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Memo[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 37:
+		case 54:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryMaximumInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8169,18 +10214,18 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryMaximumInterval == nil {
-				m.RetryMaximumInterval = new(time.Duration)
+			if m.VersionHistories == nil {
+				m.VersionHistories = &v12.VersionHistories{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.RetryMaximumInterval, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 38:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryMaximumAttempts", wireType)
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstExecutionRunId", wireType)
 			}
-			m.RetryMaximumAttempts = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -8190,25 +10235,27 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RetryMaximumAttempts |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 39:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoffCoefficient", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.RetryBackoffCoefficient = float64(math.Float64frombits(v))
-		case 40:
+			m.FirstExecutionRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 56:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8235,18 +10282,18 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionExpirationTime == nil {
-				m.WorkflowExecutionExpirationTime = new(time.Time)
+			if m.ExecutionStats == nil {
+				m.ExecutionStats = &ExecutionStats{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowExecutionExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutionStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 41:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryNonRetryableErrorTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowRunExpirationTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -8256,49 +10303,33 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecutions
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExecutions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetryNonRetryableErrorTypes = append(m.RetryNonRetryableErrorTypes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 42:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasRetryPolicy", wireType)
+			if m.WorkflowRunExpirationTime == nil {
+				m.WorkflowRunExpirationTime = new(time.Time)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecutions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowRunExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.HasRetryPolicy = bool(v != 0)
-		case 43:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CronSchedule", wireType)
+			iNdEx = postIndex
+		case 58:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventTxnId", wireType)
 			}
-			var stringLen uint64
+			m.LastFirstEventTxnId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -8308,29 +10339,16 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastFirstEventTxnId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CronSchedule = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 46:
+		case 59:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateTransitionCount", wireType)
 			}
-			m.SignalCount = 0
+			m.StateTransitionCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -8340,14 +10358,14 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignalCount |= int64(b&0x7F) << shift
+				m.StateTransitionCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 51:
+		case 60:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoResetPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8374,18 +10392,18 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AutoResetPoints == nil {
-				m.AutoResetPoints = &v1.ResetPoints{}
+			if m.ExecutionTime == nil {
+				m.ExecutionTime = new(time.Time)
 			}
-			if err := m.AutoResetPoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 52:
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewExecutionRunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -8395,124 +10413,59 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExecutions
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExecutions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SearchAttributes == nil {
-				m.SearchAttributes = make(map[string]*v11.Payload)
+			m.NewExecutionRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerBuildId", wireType)
 			}
-			var mapkey string
-			var mapvalue *v11.Payload
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowExecutions
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowExecutions
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthExecutions
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthExecutions
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowExecutions
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthExecutions
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthExecutions
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v11.Payload{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipExecutions(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthExecutions
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.SearchAttributes[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerBuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 53:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8539,11 +10492,11 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Memo == nil {
-				m.Memo = make(map[string]*v11.Payload)
+			if m.UpdateInfos == nil {
+				m.UpdateInfos = make(map[string]*UpdateInfo)
 			}
 			var mapkey string
-			var mapvalue *v11.Payload
+			var mapvalue *UpdateInfo
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -8617,7 +10570,7 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &v11.Payload{}
+					mapvalue = &UpdateInfo{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -8631,19 +10584,22 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 					if skippy < 0 {
 						return ErrInvalidLengthExecutions
 					}
+					if (iNdEx + skippy) < 0 {
+						return ErrInvalidLengthExecutions
+					}
 					if (iNdEx + skippy) > postIndex {
 						return io.ErrUnexpectedEOF
 					}
 					iNdEx += skippy
 				}
 			}
-			m.Memo[mapkey] = mapvalue
+			m.UpdateInfos[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 54:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+		case 64:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestContinueAsNew", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -8653,33 +10609,17 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VersionHistories == nil {
-				m.VersionHistories = &v12.VersionHistories{}
-			}
-			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 55:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstExecutionRunId", wireType)
+			m.SuggestContinueAsNew = bool(v != 0)
+		case 65:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var stringLen uint64
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -8689,101 +10629,69 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Priority |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FirstExecutionRunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 56:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecutions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthExecutions
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthExecutions
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutionStats == nil {
-				m.ExecutionStats = &ExecutionStats{}
-			}
-			if err := m.ExecutionStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 57:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowRunExpirationTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecutions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExecutions
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowRunExpirationTime == nil {
-				m.WorkflowRunExpirationTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowRunExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 58:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventTxnId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedEventId", wireType)
 			}
-			m.LastFirstEventTxnId = 0
+			m.AcceptedEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -8793,35 +10701,16 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastFirstEventTxnId |= int64(b&0x7F) << shift
+				m.AcceptedEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 59:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateTransitionCount", wireType)
-			}
-			m.StateTransitionCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecutions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StateTransitionCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 60:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedEventId", wireType)
 			}
-			var msglen int
+			m.CompletedEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -8831,33 +10720,16 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CompletedEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExecutionTime == nil {
-				m.ExecutionTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 61:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewExecutionRunId", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedEventBatchId", wireType)
 			}
-			var stringLen uint64
+			m.CompletedEventBatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -8867,24 +10739,11 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CompletedEventBatchId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthExecutions
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewExecutionRunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -11396,6 +13255,25 @@ func (m *ActivityInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -11882,6 +13760,25 @@ func (m *ChildExecutionInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -114,6 +114,10 @@ type TaskInfo struct {
 	ScheduleId  int64      `protobuf:"varint,4,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 	CreateTime  *time.Time `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time,omitempty"`
 	ExpiryTime  *time.Time `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
+	// Build ID of the worker that last completed a workflow task for this execution, if any.
+	WorkerBuildId string `protobuf:"bytes,7,opt,name=worker_build_id,json=workerBuildId,proto3" json:"worker_build_id,omitempty"`
+	// Priority of the task from 1 (highest) to 5 (lowest), 0 means default priority.
+	Priority int32 `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (m *TaskInfo) Reset()      { *m = TaskInfo{} }
@@ -190,6 +194,20 @@ func (m *TaskInfo) GetExpiryTime() *time.Time {
 	return nil
 }
 
+func (m *TaskInfo) GetWorkerBuildId() string {
+	if m != nil {
+		return m.WorkerBuildId
+	}
+	return ""
+}
+
+func (m *TaskInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 // task_queue column
 type TaskQueueInfo struct {
 	NamespaceId    string           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -199,6 +217,8 @@ type TaskQueueInfo struct {
 	AckLevel       int64            `protobuf:"varint,5,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
 	ExpiryTime     *time.Time       `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
 	LastUpdateTime *time.Time       `protobuf:"bytes,7,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
+	// Only set on the root partition of a workflow task queue.
+	VersioningData *VersioningData `protobuf:"bytes,8,opt,name=versioning_data,json=versioningData,proto3" json:"versioning_data,omitempty"`
 }
 
 func (m *TaskQueueInfo) Reset()      { *m = TaskQueueInfo{} }
@@ -282,10 +302,108 @@ func (m *TaskQueueInfo) GetLastUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *TaskQueueInfo) GetVersioningData() *VersioningData {
+	if m != nil {
+		return m.VersioningData
+	}
+	return nil
+}
+
+// VersioningData is the compatible-version graph of worker build IDs for a task queue.
+// Build IDs within a set are compatible with each other. The last set is the default one:
+// new workflows are dispatched to it.
+type VersioningData struct {
+	VersionSets []*CompatibleVersionSet `protobuf:"bytes,1,rep,name=version_sets,json=versionSets,proto3" json:"version_sets,omitempty"`
+}
+
+func (m *VersioningData) Reset()      { *m = VersioningData{} }
+func (*VersioningData) ProtoMessage() {}
+func (*VersioningData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{3}
+}
+func (m *VersioningData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersioningData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersioningData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersioningData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersioningData.Merge(m, src)
+}
+func (m *VersioningData) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersioningData) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersioningData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersioningData proto.InternalMessageInfo
+
+func (m *VersioningData) GetVersionSets() []*CompatibleVersionSet {
+	if m != nil {
+		return m.VersionSets
+	}
+	return nil
+}
+
+type CompatibleVersionSet struct {
+	BuildIds []string `protobuf:"bytes,1,rep,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
+}
+
+func (m *CompatibleVersionSet) Reset()      { *m = CompatibleVersionSet{} }
+func (*CompatibleVersionSet) ProtoMessage() {}
+func (*CompatibleVersionSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{4}
+}
+func (m *CompatibleVersionSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompatibleVersionSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompatibleVersionSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompatibleVersionSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompatibleVersionSet.Merge(m, src)
+}
+func (m *CompatibleVersionSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompatibleVersionSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompatibleVersionSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompatibleVersionSet proto.InternalMessageInfo
+
+func (m *CompatibleVersionSet) GetBuildIds() []string {
+	if m != nil {
+		return m.BuildIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AllocatedTaskInfo)(nil), "temporal.server.api.persistence.v1.AllocatedTaskInfo")
 	proto.RegisterType((*TaskInfo)(nil), "temporal.server.api.persistence.v1.TaskInfo")
 	proto.RegisterType((*TaskQueueInfo)(nil), "temporal.server.api.persistence.v1.TaskQueueInfo")
+	proto.RegisterType((*VersioningData)(nil), "temporal.server.api.persistence.v1.VersioningData")
+	proto.RegisterType((*CompatibleVersionSet)(nil), "temporal.server.api.persistence.v1.CompatibleVersionSet")
 }
 
 func init() {
@@ -293,41 +411,49 @@ func init() {
 }
 
 var fileDescriptor_f9c734e3b35cf986 = []byte{
-	// 541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xbf, 0x6e, 0x13, 0x4b,
-	0x14, 0xc6, 0x77, 0x63, 0xc7, 0xb1, 0xc7, 0xf7, 0x46, 0x30, 0x12, 0xc2, 0x32, 0xd2, 0x38, 0xb1,
-	0x10, 0x4a, 0x81, 0x66, 0x95, 0x40, 0x81, 0x44, 0x83, 0xd3, 0x19, 0x68, 0x58, 0x99, 0x86, 0xc6,
-	0x9a, 0xec, 0x1c, 0x9b, 0x65, 0xd7, 0x33, 0xc3, 0xce, 0xac, 0x83, 0x3b, 0x1e, 0x80, 0x22, 0x8f,
-	0xc1, 0xa3, 0x50, 0xba, 0x4c, 0x07, 0x5e, 0x37, 0x74, 0xe4, 0x11, 0xd0, 0xcc, 0x66, 0x9d, 0x34,
-	0x08, 0x17, 0x74, 0x73, 0xfe, 0x7c, 0xdf, 0x39, 0xfe, 0x1d, 0x2f, 0xa2, 0x06, 0x66, 0x4a, 0x66,
-	0x2c, 0x0d, 0x34, 0x64, 0x73, 0xc8, 0x02, 0xa6, 0xe2, 0x40, 0x41, 0xa6, 0x63, 0x6d, 0x40, 0x44,
-	0x10, 0xcc, 0x8f, 0x03, 0xc3, 0x74, 0xa2, 0xa9, 0xca, 0xa4, 0x91, 0xb8, 0x5f, 0xf5, 0xd3, 0xb2,
-	0x9f, 0x32, 0x15, 0xd3, 0x5b, 0xfd, 0x74, 0x7e, 0xdc, 0xed, 0x4d, 0xa5, 0x9c, 0xa6, 0x10, 0x38,
-	0xc5, 0x59, 0x3e, 0x09, 0x4c, 0x3c, 0x03, 0x6d, 0xd8, 0x4c, 0x95, 0x26, 0xdd, 0x43, 0x0e, 0x0a,
-	0x04, 0x07, 0x11, 0xc5, 0xa0, 0x83, 0xa9, 0x9c, 0x4a, 0x97, 0x77, 0xaf, 0xeb, 0x96, 0x47, 0x9b,
-	0xbd, 0xec, 0x42, 0x20, 0xf2, 0x99, 0xae, 0x56, 0x19, 0x7f, 0xcc, 0x21, 0x87, 0xb2, 0xaf, 0x2f,
-	0xd0, 0xdd, 0x41, 0x9a, 0xca, 0x88, 0x19, 0xe0, 0x23, 0xa6, 0x93, 0xa1, 0x98, 0x48, 0xfc, 0x02,
-	0xd5, 0x39, 0x33, 0xac, 0xe3, 0x1f, 0xf8, 0x47, 0xed, 0x93, 0xc7, 0xf4, 0xef, 0x3b, 0xd3, 0x4a,
-	0x1b, 0x3a, 0x25, 0xbe, 0x8f, 0xf6, 0xdc, 0xa8, 0x98, 0x77, 0x76, 0x0e, 0xfc, 0xa3, 0x5a, 0xd8,
-	0xb0, 0xe1, 0x90, 0xf7, 0xbf, 0xec, 0xa0, 0xe6, 0x66, 0xce, 0x21, 0xfa, 0x4f, 0xb0, 0x19, 0x68,
-	0xc5, 0x22, 0xb0, 0xad, 0x76, 0x5e, 0x2b, 0x6c, 0x6f, 0x72, 0x43, 0x8e, 0x7b, 0xa8, 0x7d, 0x2e,
-	0xb3, 0x64, 0x92, 0xca, 0xf3, 0xca, 0xac, 0x15, 0xa2, 0x2a, 0x35, 0xe4, 0xf8, 0x1e, 0x6a, 0x64,
-	0xb9, 0xb0, 0xb5, 0x9a, 0xab, 0xed, 0x66, 0xb9, 0x28, 0x75, 0x3a, 0x7a, 0x0f, 0x3c, 0x4f, 0x9d,
-	0x73, 0xdd, 0x2d, 0x81, 0xaa, 0xd4, 0x90, 0xe3, 0x01, 0x6a, 0x47, 0x19, 0x30, 0x03, 0x63, 0x4b,
-	0xb7, 0xb3, 0xeb, 0x7e, 0x6a, 0x97, 0x96, 0xe8, 0x69, 0x85, 0x9e, 0x8e, 0x2a, 0xf4, 0xa7, 0xf5,
-	0x8b, 0xef, 0x3d, 0x3f, 0x44, 0xa5, 0xc8, 0xa6, 0xad, 0x05, 0x7c, 0x52, 0x71, 0xb6, 0x28, 0x2d,
-	0x1a, 0xdb, 0x5a, 0x94, 0x22, 0x9b, 0xee, 0xff, 0xda, 0x41, 0xff, 0x5b, 0x1c, 0x6f, 0xec, 0x49,
-	0xb6, 0x65, 0x82, 0x51, 0xdd, 0x86, 0xd7, 0x30, 0xdc, 0x1b, 0x0f, 0x50, 0xcb, 0x01, 0x37, 0x0b,
-	0x05, 0x8e, 0xc4, 0xfe, 0xc9, 0xc3, 0x9b, 0xbb, 0xd9, 0x83, 0xb9, 0xff, 0x40, 0x75, 0x2a, 0x37,
-	0x6f, 0xb4, 0x50, 0x10, 0x36, 0xad, 0xcc, 0xbe, 0xf0, 0x33, 0x54, 0x4f, 0x62, 0x51, 0xb2, 0xda,
-	0x42, 0xfd, 0x2a, 0x16, 0x3c, 0x74, 0x0a, 0xfc, 0x00, 0xb5, 0x58, 0x94, 0x8c, 0x53, 0x98, 0x43,
-	0xea, 0x48, 0xd6, 0xc2, 0x26, 0x8b, 0x92, 0xd7, 0x36, 0xfe, 0x07, 0x94, 0xf0, 0x4b, 0x74, 0x27,
-	0x65, 0xda, 0x8c, 0x73, 0xc5, 0x37, 0x07, 0xdb, 0xdb, 0xd2, 0x67, 0xdf, 0x2a, 0xdf, 0x3a, 0xa1,
-	0x2d, 0x9d, 0x7e, 0x58, 0xae, 0x88, 0x77, 0xb9, 0x22, 0xde, 0xd5, 0x8a, 0xf8, 0x9f, 0x0b, 0xe2,
-	0x7f, 0x2d, 0x88, 0xff, 0xad, 0x20, 0xfe, 0xb2, 0x20, 0xfe, 0x8f, 0x82, 0xf8, 0x3f, 0x0b, 0xe2,
-	0x5d, 0x15, 0xc4, 0xbf, 0x58, 0x13, 0x6f, 0xb9, 0x26, 0xde, 0xe5, 0x9a, 0x78, 0xef, 0x9e, 0x4e,
-	0xe5, 0x0d, 0x8f, 0x58, 0xfe, 0xf9, 0x63, 0x7f, 0x7e, 0x2b, 0x3c, 0x6b, 0xb8, 0xad, 0x9e, 0xfc,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xd6, 0x4b, 0x05, 0x25, 0x04, 0x00, 0x00,
+	// 667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x31, 0x6f, 0xd3, 0x4c,
+	0x18, 0x8e, 0x9b, 0x34, 0x4d, 0x2e, 0x6d, 0xfa, 0x7d, 0x16, 0x88, 0x28, 0x48, 0x6e, 0x1a, 0xa1,
+	0x2a, 0x03, 0xb2, 0xd5, 0x94, 0xa1, 0x12, 0x0b, 0x2d, 0x2c, 0x01, 0x16, 0x4c, 0x61, 0xa0, 0x83,
+	0x75, 0xf1, 0xbd, 0x0d, 0x47, 0x6c, 0xdf, 0xe1, 0x3b, 0xbb, 0x64, 0xe3, 0x27, 0xf4, 0x67, 0xf0,
+	0x53, 0x18, 0x18, 0x32, 0x76, 0x83, 0xba, 0x0b, 0x63, 0x7f, 0x02, 0xba, 0x73, 0xec, 0xb6, 0x12,
+	0x88, 0x0c, 0x6c, 0xf7, 0xbe, 0x79, 0x9e, 0xe7, 0x7d, 0xef, 0x79, 0xce, 0x41, 0xb6, 0x84, 0x90,
+	0xb3, 0x18, 0x07, 0x8e, 0x80, 0x38, 0x85, 0xd8, 0xc1, 0x9c, 0x3a, 0x1c, 0x62, 0x41, 0x85, 0x84,
+	0xc8, 0x07, 0x27, 0xdd, 0x75, 0x24, 0x16, 0x53, 0x61, 0xf3, 0x98, 0x49, 0x66, 0xf6, 0x0b, 0xbc,
+	0x9d, 0xe3, 0x6d, 0xcc, 0xa9, 0x7d, 0x03, 0x6f, 0xa7, 0xbb, 0xdd, 0xad, 0x09, 0x63, 0x93, 0x00,
+	0x1c, 0xcd, 0x18, 0x27, 0x27, 0x8e, 0xa4, 0x21, 0x08, 0x89, 0x43, 0x9e, 0x8b, 0x74, 0xb7, 0x09,
+	0x70, 0x88, 0x08, 0x44, 0x3e, 0x05, 0xe1, 0x4c, 0xd8, 0x84, 0xe9, 0xbe, 0x3e, 0x2d, 0x20, 0x3b,
+	0xe5, 0x5e, 0x6a, 0x21, 0x88, 0x92, 0x50, 0x14, 0xab, 0x78, 0x1f, 0x13, 0x48, 0x20, 0xc7, 0xf5,
+	0x23, 0xf4, 0xff, 0x41, 0x10, 0x30, 0x1f, 0x4b, 0x20, 0x47, 0x58, 0x4c, 0x47, 0xd1, 0x09, 0x33,
+	0x9f, 0xa0, 0x1a, 0xc1, 0x12, 0x77, 0x8c, 0x9e, 0x31, 0x68, 0x0d, 0x1f, 0xda, 0x7f, 0xdf, 0xd9,
+	0x2e, 0xb8, 0xae, 0x66, 0x9a, 0xf7, 0xd0, 0x9a, 0x1e, 0x45, 0x49, 0x67, 0xa5, 0x67, 0x0c, 0xaa,
+	0x6e, 0x5d, 0x95, 0x23, 0xd2, 0x9f, 0xaf, 0xa0, 0x46, 0x39, 0x67, 0x1b, 0xad, 0x47, 0x38, 0x04,
+	0xc1, 0xb1, 0x0f, 0x0a, 0xaa, 0xe6, 0x35, 0xdd, 0x56, 0xd9, 0x1b, 0x11, 0x73, 0x0b, 0xb5, 0x4e,
+	0x59, 0x3c, 0x3d, 0x09, 0xd8, 0x69, 0x21, 0xd6, 0x74, 0x51, 0xd1, 0x1a, 0x11, 0xf3, 0x2e, 0xaa,
+	0xc7, 0x49, 0xa4, 0x7e, 0xab, 0xea, 0xdf, 0x56, 0xe3, 0x24, 0xca, 0x79, 0xc2, 0x7f, 0x0f, 0x24,
+	0x09, 0xb4, 0x72, 0x4d, 0x2f, 0x81, 0x8a, 0xd6, 0x88, 0x98, 0x07, 0xa8, 0xe5, 0xc7, 0x80, 0x25,
+	0x78, 0xca, 0xdd, 0xce, 0xaa, 0xbe, 0x6a, 0xd7, 0xce, 0xad, 0xb7, 0x0b, 0xeb, 0xed, 0xa3, 0xc2,
+	0xfa, 0xc3, 0xda, 0xd9, 0xf7, 0x2d, 0xc3, 0x45, 0x39, 0x49, 0xb5, 0x95, 0x04, 0x7c, 0xe2, 0x34,
+	0x9e, 0xe5, 0x12, 0xf5, 0x65, 0x25, 0x72, 0x92, 0x96, 0xd8, 0x41, 0x9b, 0xea, 0x2e, 0x10, 0x7b,
+	0xe3, 0x84, 0x06, 0x44, 0xad, 0xba, 0xa6, 0xaf, 0xb1, 0x91, 0xb7, 0x0f, 0x55, 0x77, 0x44, 0xcc,
+	0x2e, 0x6a, 0xf0, 0x98, 0xb2, 0x98, 0xca, 0x59, 0xa7, 0xd1, 0x33, 0x06, 0xab, 0x6e, 0x59, 0xf7,
+	0xbf, 0x55, 0xd1, 0x86, 0xb2, 0xf4, 0x95, 0x8a, 0x75, 0x59, 0x5f, 0x4d, 0x54, 0x53, 0xe5, 0xc2,
+	0x50, 0x7d, 0x36, 0x0f, 0x50, 0x53, 0x87, 0x26, 0x67, 0x1c, 0xb4, 0x9b, 0xed, 0xe1, 0x83, 0xeb,
+	0xec, 0x55, 0xe8, 0xfa, 0x1d, 0x15, 0x71, 0xeb, 0x79, 0x47, 0x33, 0x0e, 0x6e, 0x43, 0xd1, 0xd4,
+	0xc9, 0xdc, 0x47, 0xb5, 0x29, 0x8d, 0x72, 0xbf, 0x97, 0x60, 0xbf, 0xa0, 0x11, 0x71, 0x35, 0xc3,
+	0xbc, 0x8f, 0x9a, 0xd8, 0x9f, 0x7a, 0x01, 0xa4, 0x10, 0xe8, 0x34, 0xaa, 0x6e, 0x03, 0xfb, 0xd3,
+	0x97, 0xaa, 0xfe, 0x17, 0x4e, 0x3f, 0x47, 0xff, 0x05, 0x58, 0x48, 0x2f, 0xe1, 0xa4, 0x0c, 0x7d,
+	0x6d, 0x49, 0x9d, 0xb6, 0x62, 0xbe, 0xd1, 0x44, 0xad, 0x75, 0x8c, 0x36, 0x53, 0xf5, 0xfc, 0x59,
+	0x44, 0xa3, 0x89, 0xa7, 0x3f, 0x95, 0x86, 0x96, 0x1a, 0x2e, 0xf3, 0xa9, 0xbc, 0x2d, 0xa9, 0xcf,
+	0xb0, 0xc4, 0x6e, 0x3b, 0xbd, 0x55, 0xf7, 0x43, 0xd4, 0xbe, 0x8d, 0x30, 0x8f, 0xd1, 0xfa, 0x02,
+	0xe3, 0x09, 0x90, 0xa2, 0x63, 0xf4, 0xaa, 0x83, 0xd6, 0x70, 0x7f, 0x99, 0x59, 0x4f, 0x59, 0xc8,
+	0xb1, 0xa4, 0xe3, 0x00, 0x16, 0x9a, 0xaf, 0x41, 0xba, 0xad, 0xb4, 0x3c, 0x8b, 0xfe, 0x1e, 0xba,
+	0xf3, 0x3b, 0x90, 0xca, 0xa3, 0x78, 0x92, 0xf9, 0xc4, 0xa6, 0xdb, 0x18, 0xe7, 0xaf, 0x51, 0x1c,
+	0x7e, 0x98, 0x5f, 0x58, 0x95, 0xf3, 0x0b, 0xab, 0x72, 0x75, 0x61, 0x19, 0x9f, 0x33, 0xcb, 0xf8,
+	0x92, 0x59, 0xc6, 0xd7, 0xcc, 0x32, 0xe6, 0x99, 0x65, 0xfc, 0xc8, 0x2c, 0xe3, 0x67, 0x66, 0x55,
+	0xae, 0x32, 0xcb, 0x38, 0xbb, 0xb4, 0x2a, 0xf3, 0x4b, 0xab, 0x72, 0x7e, 0x69, 0x55, 0xde, 0x3d,
+	0x9a, 0xb0, 0xeb, 0x9d, 0x29, 0xfb, 0xf3, 0x3f, 0xe6, 0xe3, 0x1b, 0xe5, 0xb8, 0xae, 0x63, 0xd9,
+	0xfb, 0x35, 0x00, 0x0c, 0x9d, 0x3a, 0xa7, 0x6a, 0x05, 0x00, 0x00,
 }
 
 func (this *AllocatedTaskInfo) Equal(that interface{}) bool {
@@ -402,6 +528,12 @@ func (this *TaskInfo) Equal(that interface{}) bool {
 	} else if !this.ExpiryTime.Equal(*that1.ExpiryTime) {
 		return false
 	}
+	if this.WorkerBuildId != that1.WorkerBuildId {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
 	return true
 }
 func (this *TaskQueueInfo) Equal(that interface{}) bool {
@@ -452,6 +584,67 @@ func (this *TaskQueueInfo) Equal(that interface{}) bool {
 	} else if !this.LastUpdateTime.Equal(*that1.LastUpdateTime) {
 		return false
 	}
+	if !this.VersioningData.Equal(that1.VersioningData) {
+		return false
+	}
+	return true
+}
+func (this *VersioningData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VersioningData)
+	if !ok {
+		that2, ok := that.(VersioningData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.VersionSets) != len(that1.VersionSets) {
+		return false
+	}
+	for i := range this.VersionSets {
+		if !this.VersionSets[i].Equal(that1.VersionSets[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CompatibleVersionSet) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CompatibleVersionSet)
+	if !ok {
+		that2, ok := that.(CompatibleVersionSet)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.BuildIds) != len(that1.BuildIds) {
+		return false
+	}
+	for i := range this.BuildIds {
+		if this.BuildIds[i] != that1.BuildIds[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *AllocatedTaskInfo) GoString() string {
@@ -471,7 +664,7 @@ func (this *TaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 12)
 	s = append(s, "&persistence.TaskInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -479,6 +672,8 @@ func (this *TaskInfo) GoString() string {
 	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
 	s = append(s, "CreateTime: "+fmt.Sprintf("%#v", this.CreateTime)+",\n")
 	s = append(s, "ExpiryTime: "+fmt.Sprintf("%#v", this.ExpiryTime)+",\n")
+	s = append(s, "WorkerBuildId: "+fmt.Sprintf("%#v", this.WorkerBuildId)+",\n")
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -486,7 +681,7 @@ func (this *TaskQueueInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&persistence.TaskQueueInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
@@ -495,6 +690,31 @@ func (this *TaskQueueInfo) GoString() string {
 	s = append(s, "AckLevel: "+fmt.Sprintf("%#v", this.AckLevel)+",\n")
 	s = append(s, "ExpiryTime: "+fmt.Sprintf("%#v", this.ExpiryTime)+",\n")
 	s = append(s, "LastUpdateTime: "+fmt.Sprintf("%#v", this.LastUpdateTime)+",\n")
+	if this.VersioningData != nil {
+		s = append(s, "VersioningData: "+fmt.Sprintf("%#v", this.VersioningData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VersioningData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.VersioningData{")
+	if this.VersionSets != nil {
+		s = append(s, "VersionSets: "+fmt.Sprintf("%#v", this.VersionSets)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CompatibleVersionSet) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.CompatibleVersionSet{")
+	s = append(s, "BuildIds: "+fmt.Sprintf("%#v", this.BuildIds)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -566,6 +786,18 @@ func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.WorkerBuildId) > 0 {
+		i -= len(m.WorkerBuildId)
+		copy(dAtA[i:], m.WorkerBuildId)
+		i = encodeVarintTasks(dAtA, i, uint64(len(m.WorkerBuildId)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.ExpiryTime != nil {
 		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
 		if err2 != nil {
@@ -635,24 +867,36 @@ func (m *TaskQueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.LastUpdateTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
-		if err4 != nil {
-			return 0, err4
+	if m.VersioningData != nil {
+		{
+			size, err := m.VersioningData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTasks(dAtA, i, uint64(size))
 		}
-		i -= n4
-		i = encodeVarintTasks(dAtA, i, uint64(n4))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x42
 	}
-	if m.ExpiryTime != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+	if m.LastUpdateTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
 		if err5 != nil {
 			return 0, err5
 		}
 		i -= n5
 		i = encodeVarintTasks(dAtA, i, uint64(n5))
 		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTasks(dAtA, i, uint64(n6))
+		i--
 		dAtA[i] = 0x32
 	}
 	if m.AckLevel != 0 {
@@ -687,6 +931,75 @@ func (m *TaskQueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VersioningData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersioningData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersioningData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VersionSets) > 0 {
+		for iNdEx := len(m.VersionSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTasks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompatibleVersionSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompatibleVersionSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompatibleVersionSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildIds) > 0 {
+		for iNdEx := len(m.BuildIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BuildIds[iNdEx])
+			copy(dAtA[i:], m.BuildIds[iNdEx])
+			i = encodeVarintTasks(dAtA, i, uint64(len(m.BuildIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTasks(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTasks(v)
 	base := offset
@@ -743,6 +1056,13 @@ func (m *TaskInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
 		n += 1 + l + sovTasks(uint64(l))
 	}
+	l = len(m.WorkerBuildId)
+	if l > 0 {
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTasks(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -777,6 +1097,40 @@ func (m *TaskQueueInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime)
 		n += 1 + l + sovTasks(uint64(l))
 	}
+	if m.VersioningData != nil {
+		l = m.VersioningData.Size()
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	return n
+}
+
+func (m *VersioningData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VersionSets) > 0 {
+		for _, e := range m.VersionSets {
+			l = e.Size()
+			n += 1 + l + sovTasks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompatibleVersionSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BuildIds) > 0 {
+		for _, s := range m.BuildIds {
+			l = len(s)
+			n += 1 + l + sovTasks(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -808,6 +1162,8 @@ func (this *TaskInfo) String() string {
 		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
 		`CreateTime:` + strings.Replace(fmt.Sprintf("%v", this.CreateTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`ExpiryTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpiryTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`WorkerBuildId:` + fmt.Sprintf("%v", this.WorkerBuildId) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -824,6 +1180,32 @@ func (this *TaskQueueInfo) String() string {
 		`AckLevel:` + fmt.Sprintf("%v", this.AckLevel) + `,`,
 		`ExpiryTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpiryTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`VersioningData:` + strings.Replace(this.VersioningData.String(), "VersioningData", "VersioningData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersioningData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVersionSets := "[]*CompatibleVersionSet{"
+	for _, f := range this.VersionSets {
+		repeatedStringForVersionSets += strings.Replace(f.String(), "CompatibleVersionSet", "CompatibleVersionSet", 1) + ","
+	}
+	repeatedStringForVersionSets += "}"
+	s := strings.Join([]string{`&VersioningData{`,
+		`VersionSets:` + repeatedStringForVersionSets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CompatibleVersionSet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompatibleVersionSet{`,
+		`BuildIds:` + fmt.Sprintf("%v", this.BuildIds) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1160,6 +1542,57 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerBuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTasks(dAtA[iNdEx:])
@@ -1406,6 +1839,214 @@ func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersioningData == nil {
+				m.VersioningData = &VersioningData{}
+			}
+			if err := m.VersioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersioningData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersioningData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersioningData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionSets = append(m.VersionSets, &CompatibleVersionSet{})
+			if err := m.VersionSets[len(m.VersionSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompatibleVersionSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompatibleVersionSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompatibleVersionSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildIds = append(m.BuildIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTasks(dAtA[iNdEx:])
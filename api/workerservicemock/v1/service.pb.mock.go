@@ -0,0 +1,119 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: workerservice/v1/service.pb.go
+
+// Package workerservicemock is a generated GoMock package.
+package workerservicemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	workerservice "go.temporal.io/server/api/workerservice/v1"
+	grpc "google.golang.org/grpc"
+)
+
+// MockWorkerServiceClient is a mock of WorkerServiceClient interface.
+type MockWorkerServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkerServiceClientMockRecorder
+}
+
+// MockWorkerServiceClientMockRecorder is the mock recorder for MockWorkerServiceClient.
+type MockWorkerServiceClientMockRecorder struct {
+	mock *MockWorkerServiceClient
+}
+
+// NewMockWorkerServiceClient creates a new mock instance.
+func NewMockWorkerServiceClient(ctrl *gomock.Controller) *MockWorkerServiceClient {
+	mock := &MockWorkerServiceClient{ctrl: ctrl}
+	mock.recorder = &MockWorkerServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkerServiceClient) EXPECT() *MockWorkerServiceClientMockRecorder {
+	return m.recorder
+}
+
+// RefreshDynamicConfig mocks base method.
+func (m *MockWorkerServiceClient) RefreshDynamicConfig(ctx context.Context, in *workerservice.RefreshDynamicConfigRequest, opts ...grpc.CallOption) (*workerservice.RefreshDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*workerservice.RefreshDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshDynamicConfig indicates an expected call of RefreshDynamicConfig.
+func (mr *MockWorkerServiceClientMockRecorder) RefreshDynamicConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshDynamicConfig", reflect.TypeOf((*MockWorkerServiceClient)(nil).RefreshDynamicConfig), varargs...)
+}
+
+// MockWorkerServiceServer is a mock of WorkerServiceServer interface.
+type MockWorkerServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkerServiceServerMockRecorder
+}
+
+// MockWorkerServiceServerMockRecorder is the mock recorder for MockWorkerServiceServer.
+type MockWorkerServiceServerMockRecorder struct {
+	mock *MockWorkerServiceServer
+}
+
+// NewMockWorkerServiceServer creates a new mock instance.
+func NewMockWorkerServiceServer(ctrl *gomock.Controller) *MockWorkerServiceServer {
+	mock := &MockWorkerServiceServer{ctrl: ctrl}
+	mock.recorder = &MockWorkerServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkerServiceServer) EXPECT() *MockWorkerServiceServerMockRecorder {
+	return m.recorder
+}
+
+// RefreshDynamicConfig mocks base method.
+func (m *MockWorkerServiceServer) RefreshDynamicConfig(arg0 context.Context, arg1 *workerservice.RefreshDynamicConfigRequest) (*workerservice.RefreshDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*workerservice.RefreshDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshDynamicConfig indicates an expected call of RefreshDynamicConfig.
+func (mr *MockWorkerServiceServerMockRecorder) RefreshDynamicConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshDynamicConfig", reflect.TypeOf((*MockWorkerServiceServer)(nil).RefreshDynamicConfig), arg0, arg1)
+}
@@ -541,3 +541,394 @@ func (c *metricClient) GetTaskQueueTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartBatchOperationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStartBatchOperationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStartBatchOperationScope, metrics.ClientLatency)
+	resp, err := c.client.StartBatchOperation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStartBatchOperationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StopBatchOperation(
+	ctx context.Context,
+	request *adminservice.StopBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StopBatchOperationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStopBatchOperationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStopBatchOperationScope, metrics.ClientLatency)
+	resp, err := c.client.StopBatchOperation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStopBatchOperationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeBatchOperation(
+	ctx context.Context,
+	request *adminservice.DescribeBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeBatchOperationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeBatchOperationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeBatchOperationScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeBatchOperation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeBatchOperationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListBatchOperations(
+	ctx context.Context,
+	request *adminservice.ListBatchOperationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListBatchOperationsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListBatchOperationsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListBatchOperationsScope, metrics.ClientLatency)
+	resp, err := c.client.ListBatchOperations(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListBatchOperationsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerBuildIdOrderingRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkerBuildIdOrderingResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkerBuildIdOrderingScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateWorkerBuildIdOrderingScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateWorkerBuildIdOrdering(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkerBuildIdOrderingScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *adminservice.GetWorkerBuildIdOrderingRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkerBuildIdOrderingResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetWorkerBuildIdOrderingScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetWorkerBuildIdOrderingScope, metrics.ClientLatency)
+	resp, err := c.client.GetWorkerBuildIdOrdering(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetWorkerBuildIdOrderingScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeTaskQueueStats(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueStatsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueStatsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskQueueStatsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeTaskQueueStatsScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeTaskQueueStats(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskQueueStatsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteNamespace(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.GetDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.ListDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientSetDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientSetDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.SetDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientSetDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RefreshDynamicConfig(
+	ctx context.Context,
+	request *adminservice.RefreshDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RefreshDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRefreshDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRefreshDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.RefreshDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRefreshDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetEffectiveDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetEffectiveDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetEffectiveDynamicConfigResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetEffectiveDynamicConfigScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetEffectiveDynamicConfigScope, metrics.ClientLatency)
+	resp, err := c.client.GetEffectiveDynamicConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetEffectiveDynamicConfigScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListShardLoad(
+	ctx context.Context,
+	request *adminservice.ListShardLoadRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListShardLoadResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListShardLoadScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListShardLoadScope, metrics.ClientLatency)
+	resp, err := c.client.ListShardLoad(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListShardLoadScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) CreateSchedule(
+	ctx context.Context,
+	request *adminservice.CreateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientCreateScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientCreateScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.CreateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientCreateScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateSchedule(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PatchSchedule(
+	ctx context.Context,
+	request *adminservice.PatchScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PatchScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPatchScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPatchScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.PatchSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPatchScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteSchedule(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListSchedules(
+	ctx context.Context,
+	request *adminservice.ListSchedulesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSchedulesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListSchedulesScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListSchedulesScope, metrics.ClientLatency)
+	resp, err := c.client.ListSchedules(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListSchedulesScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
@@ -37,6 +37,7 @@ import (
 	v10 "go.temporal.io/server/api/adminservice/v1"
 	v11 "go.temporal.io/server/api/historyservice/v1"
 	v12 "go.temporal.io/server/api/matchingservice/v1"
+	v13 "go.temporal.io/server/api/workerservice/v1"
 	common "go.temporal.io/server/common"
 	dynamicconfig "go.temporal.io/server/common/dynamicconfig"
 	log "go.temporal.io/server/common/log"
@@ -170,6 +171,20 @@ func (mr *MockFactoryMockRecorder) NewMatchingClientWithTimeout(namespaceIDToNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMatchingClientWithTimeout", reflect.TypeOf((*MockFactory)(nil).NewMatchingClientWithTimeout), namespaceIDToName, timeout, longPollTimeout)
 }
 
+// NewWorkerClient mocks base method.
+func (m *MockFactory) NewWorkerClient(rpcAddress string) v13.WorkerServiceClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewWorkerClient", rpcAddress)
+	ret0, _ := ret[0].(v13.WorkerServiceClient)
+	return ret0
+}
+
+// NewWorkerClient indicates an expected call of NewWorkerClient.
+func (mr *MockFactoryMockRecorder) NewWorkerClient(rpcAddress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWorkerClient", reflect.TypeOf((*MockFactory)(nil).NewWorkerClient), rpcAddress)
+}
+
 // MockFactoryProvider is a mock of FactoryProvider interface.
 type MockFactoryProvider struct {
 	ctrl     *gomock.Controller
@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/workerservice/v1"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/client/history"
@@ -61,6 +62,7 @@ type (
 		NewMatchingClientWithTimeout(namespaceIDToName NamespaceIDToNameFunc, timeout time.Duration, longPollTimeout time.Duration) (matchingservice.MatchingServiceClient, error)
 		NewFrontendClientWithTimeout(rpcAddress string, timeout time.Duration, longPollTimeout time.Duration) workflowservice.WorkflowServiceClient
 		NewAdminClientWithTimeout(rpcAddress string, timeout time.Duration, largeTimeout time.Duration) adminservice.AdminServiceClient
+		NewWorkerClient(rpcAddress string) workerservice.WorkerServiceClient
 	}
 
 	// FactoryProvider can be used to provide a customized client Factory implementation.
@@ -221,6 +223,11 @@ func (cf *rpcClientFactory) NewAdminClientWithTimeout(
 	return client
 }
 
+func (cf *rpcClientFactory) NewWorkerClient(rpcAddress string) workerservice.WorkerServiceClient {
+	connection := cf.rpcFactory.CreateInternodeGRPCConnection(rpcAddress)
+	return workerservice.NewWorkerServiceClient(connection)
+}
+
 func newServiceKeyResolver(resolver membership.ServiceResolver) *serviceKeyResolverImpl {
 	return &serviceKeyResolverImpl{
 		resolver: resolver,
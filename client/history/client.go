@@ -878,6 +878,76 @@ func (c *clientImpl) QueryWorkflow(
 	return response, nil
 }
 
+func (c *clientImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+
+	var response *historyservice.UpdateWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) RefreshDynamicConfig(
+	ctx context.Context,
+	request *historyservice.RefreshDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RefreshDynamicConfigResponse, error) {
+	ret, err := c.clients.GetClientForClientKey(request.GetHostAddress())
+	if err != nil {
+		return nil, err
+	}
+	client := ret.(historyservice.HistoryServiceClient)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RefreshDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetOpenExecutionCounts(
+	ctx context.Context,
+	request *historyservice.GetOpenExecutionCountsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetOpenExecutionCountsResponse, error) {
+	ret, err := c.clients.GetClientForClientKey(request.GetHostAddress())
+	if err != nil {
+		return nil, err
+	}
+	client := ret.(historyservice.HistoryServiceClient)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetOpenExecutionCounts(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetShardLoad(
+	ctx context.Context,
+	request *historyservice.GetShardLoadRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetShardLoadResponse, error) {
+	ret, err := c.clients.GetClientForClientKey(request.GetHostAddress())
+	if err != nil {
+		return nil, err
+	}
+	client := ret.(historyservice.HistoryServiceClient)
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetShardLoad(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetReplicationMessages(
 	ctx context.Context,
 	request *historyservice.GetReplicationMessagesRequest,
@@ -886,14 +956,16 @@ func (c *clientImpl) GetReplicationMessages(
 	requestsByClient := make(map[historyservice.HistoryServiceClient]*historyservice.GetReplicationMessagesRequest)
 
 	for _, token := range request.Tokens {
-		client, err := c.getClientForShardID(token.GetShardId())
+		// tokens of a polling cluster with more shards are served by the shard which owns their workflows
+		client, err := c.getClientForShardID(common.SourceHistoryShardID(token.GetShardId(), c.numberOfShards))
 		if err != nil {
 			return nil, err
 		}
 
 		if _, ok := requestsByClient[client]; !ok {
 			requestsByClient[client] = &historyservice.GetReplicationMessagesRequest{
-				ClusterName: request.ClusterName,
+				ClusterName:       request.ClusterName,
+				ClusterShardCount: request.ClusterShardCount,
 			}
 		}
 
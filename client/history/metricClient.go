@@ -504,6 +504,62 @@ func (c *metricClient) QueryWorkflow(
 	return c.client.QueryWorkflow(context, request, opts...)
 }
 
+func (c *metricClient) UpdateWorkflowExecution(
+	context context.Context,
+	request *historyservice.UpdateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UpdateWorkflowExecutionResponse, retError error) {
+
+	scope, stopwatch := c.startMetricsRecording(metrics.HistoryClientUpdateWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(scope, stopwatch, retError)
+	}()
+
+	return c.client.UpdateWorkflowExecution(context, request, opts...)
+}
+
+func (c *metricClient) RefreshDynamicConfig(
+	ctx context.Context,
+	request *historyservice.RefreshDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.RefreshDynamicConfigResponse, retError error) {
+
+	scope, stopwatch := c.startMetricsRecording(metrics.HistoryClientRefreshDynamicConfigScope)
+	defer func() {
+		c.finishMetricsRecording(scope, stopwatch, retError)
+	}()
+
+	return c.client.RefreshDynamicConfig(ctx, request, opts...)
+}
+
+func (c *metricClient) GetOpenExecutionCounts(
+	ctx context.Context,
+	request *historyservice.GetOpenExecutionCountsRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.GetOpenExecutionCountsResponse, retError error) {
+
+	scope, stopwatch := c.startMetricsRecording(metrics.HistoryClientGetOpenExecutionCountsScope)
+	defer func() {
+		c.finishMetricsRecording(scope, stopwatch, retError)
+	}()
+
+	return c.client.GetOpenExecutionCounts(ctx, request, opts...)
+}
+
+func (c *metricClient) GetShardLoad(
+	ctx context.Context,
+	request *historyservice.GetShardLoadRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.GetShardLoadResponse, retError error) {
+
+	scope, stopwatch := c.startMetricsRecording(metrics.HistoryClientGetShardLoadScope)
+	defer func() {
+		c.finishMetricsRecording(scope, stopwatch, retError)
+	}()
+
+	return c.client.GetShardLoad(ctx, request, opts...)
+}
+
 func (c *metricClient) ReapplyEvents(
 	context context.Context,
 	request *historyservice.ReapplyEventsRequest,
@@ -575,6 +575,69 @@ func (c *retryableClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowExecutionRequest,
+	opts ...grpc.CallOption) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+	var resp *historyservice.UpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RefreshDynamicConfig(
+	ctx context.Context,
+	request *historyservice.RefreshDynamicConfigRequest,
+	opts ...grpc.CallOption) (*historyservice.RefreshDynamicConfigResponse, error) {
+
+	var resp *historyservice.RefreshDynamicConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RefreshDynamicConfig(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetOpenExecutionCounts(
+	ctx context.Context,
+	request *historyservice.GetOpenExecutionCountsRequest,
+	opts ...grpc.CallOption) (*historyservice.GetOpenExecutionCountsResponse, error) {
+
+	var resp *historyservice.GetOpenExecutionCountsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetOpenExecutionCounts(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetShardLoad(
+	ctx context.Context,
+	request *historyservice.GetShardLoadRequest,
+	opts ...grpc.CallOption) (*historyservice.GetShardLoadResponse, error) {
+
+	var resp *historyservice.GetShardLoadResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetShardLoad(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReapplyEvents(
 	ctx context.Context,
 	request *historyservice.ReapplyEventsRequest,
@@ -221,6 +221,48 @@ func (c *metricClient) ListTaskQueuePartitions(
 	return c.client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *matchingservice.UpdateWorkerBuildIdOrderingRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.UpdateWorkerBuildIdOrderingResponse, retError error) {
+
+	scope, stopwatch := c.startMetricsRecording(metrics.MatchingClientUpdateWorkerBuildIdOrderingScope)
+	defer func() {
+		c.finishMetricsRecording(scope, stopwatch, retError)
+	}()
+
+	return c.client.UpdateWorkerBuildIdOrdering(ctx, request, opts...)
+}
+
+func (c *metricClient) GetWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *matchingservice.GetWorkerBuildIdOrderingRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.GetWorkerBuildIdOrderingResponse, retError error) {
+
+	scope, stopwatch := c.startMetricsRecording(metrics.MatchingClientGetWorkerBuildIdOrderingScope)
+	defer func() {
+		c.finishMetricsRecording(scope, stopwatch, retError)
+	}()
+
+	return c.client.GetWorkerBuildIdOrdering(ctx, request, opts...)
+}
+
+func (c *metricClient) RefreshDynamicConfig(
+	ctx context.Context,
+	request *matchingservice.RefreshDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.RefreshDynamicConfigResponse, retError error) {
+
+	scope, stopwatch := c.startMetricsRecording(metrics.MatchingClientRefreshDynamicConfigScope)
+	defer func() {
+		c.finishMetricsRecording(scope, stopwatch, retError)
+	}()
+
+	return c.client.RefreshDynamicConfig(ctx, request, opts...)
+}
+
 func (c *metricClient) emitForwardedSourceStats(
 	scope metrics.Scope,
 	forwardedFrom string,
@@ -34,6 +34,7 @@ import (
 	_ "time/tzdata" // embed tzdata as a fallback
 
 	"github.com/urfave/cli/v2"
+	"go.uber.org/multierr"
 
 	"go.temporal.io/server/build"
 	"go.temporal.io/server/common/authorization"
@@ -179,6 +180,41 @@ func buildCLI() *cli.App {
 				return cli.Exit("All services are stopped.", 0)
 			},
 		},
+		{
+			Name:      "validate-dynamic-config",
+			Usage:     "Validate dynamic config files against registered keys",
+			ArgsUsage: "[file ...]",
+			Action: func(c *cli.Context) error {
+				files := c.Args().Slice()
+				if len(files) == 0 {
+					// validate the file the server would load
+					cfg, err := config.LoadConfig(c.String("env"), path.Join(c.String("root"), c.String("config")), c.String("zone"))
+					if err != nil {
+						return cli.Exit(fmt.Sprintf("Unable to load configuration: %v.", err), 1)
+					}
+					if cfg.DynamicConfigClient == nil {
+						return cli.Exit("Dynamic config client is not configured.", 1)
+					}
+					files = []string{cfg.DynamicConfigClient.Filepath}
+				}
+
+				valid := true
+				for _, file := range files {
+					if err := dynamicconfig.ValidateFile(file); err != nil {
+						valid = false
+						for _, e := range multierr.Errors(err) {
+							fmt.Printf("%s: %v\n", file, e)
+						}
+						continue
+					}
+					fmt.Printf("%s: OK\n", file)
+				}
+				if !valid {
+					return cli.Exit("Dynamic config is not valid.", 1)
+				}
+				return nil
+			},
+		},
 	}
 	return app
 }
@@ -0,0 +1,127 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination audit_mock.go
+
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	// ClaimsSourceNone means that the caller presented no credentials
+	ClaimsSourceNone = "none"
+	// ClaimsSourceAuthToken means that claims were mapped from the authorization header
+	ClaimsSourceAuthToken = "authToken"
+	// ClaimsSourceTLS means that claims were mapped from the client certificate only
+	ClaimsSourceTLS = "tls"
+)
+
+type (
+	// AuditRecord describes an authorization decision
+	AuditRecord struct {
+		Time time.Time `json:"time"`
+		// Subject is the identity of the caller from claims
+		Subject string `json:"subject,omitempty"`
+		// ClaimsSource is the kind of credentials claims were mapped from
+		ClaimsSource string `json:"claimsSource"`
+		// APIName is the full API function name
+		APIName    string `json:"apiName"`
+		Namespace  string `json:"namespace,omitempty"`
+		WorkflowID string `json:"workflowId,omitempty"`
+		RunID      string `json:"runId,omitempty"`
+		Decision   string `json:"decision"`
+		Reason     string `json:"reason,omitempty"`
+	}
+
+	// AuditSink records authorization decisions
+	AuditSink interface {
+		Write(ctx context.Context, record *AuditRecord) error
+	}
+
+	// fileAuditSink appends audit records to a file as JSON lines
+	fileAuditSink struct {
+		sync.Mutex
+		file *os.File
+	}
+)
+
+var _ AuditSink = (*fileAuditSink)(nil)
+
+// NewFileAuditSink creates an audit sink which appends records to a file as JSON lines
+func NewFileAuditSink(filepath string) (AuditSink, error) {
+	file, err := os.OpenFile(filepath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0640)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open authorization audit log %s: %w", filepath, err)
+	}
+	return &fileAuditSink{file: file}, nil
+}
+
+// GetAuditSinkFromConfig creates the audit sink configured by the config or returns nil if
+// audit log is disabled
+func GetAuditSinkFromConfig(config *config.AuthorizationAuditLog) (AuditSink, error) {
+	if config.Filepath == "" {
+		return nil, nil
+	}
+	return NewFileAuditSink(config.Filepath)
+}
+
+func (s *fileAuditSink) Write(_ context.Context, record *AuditRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	s.Lock()
+	defer s.Unlock()
+	_, err = s.file.Write(data)
+	return err
+}
+
+// Close closes the audit log file
+func (s *fileAuditSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	return s.file.Close()
+}
+
+func (d Decision) String() string {
+	switch d {
+	case DecisionAllow:
+		return "allow"
+	case DecisionDeny:
+		return "deny"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,84 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/config"
+)
+
+func TestFileAuditSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := GetAuditSinkFromConfig(&config.AuthorizationAuditLog{Filepath: path})
+	require.NoError(t, err)
+
+	records := []*AuditRecord{
+		{
+			Time:         time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+			Subject:      "alice",
+			ClaimsSource: ClaimsSourceAuthToken,
+			APIName:      "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution",
+			Namespace:    "prod",
+			WorkflowID:   "wid",
+			Decision:     DecisionAllow.String(),
+		},
+		{
+			Time:         time.Date(2022, 2, 1, 0, 0, 1, 0, time.UTC),
+			ClaimsSource: ClaimsSourceNone,
+			APIName:      "/temporal.api.workflowservice.v1.WorkflowService/DescribeNamespace",
+			Namespace:    "prod",
+			Decision:     DecisionDeny.String(),
+		},
+	}
+	for _, record := range records {
+		require.NoError(t, sink.Write(context.Background(), record))
+	}
+	require.NoError(t, sink.(*fileAuditSink).Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, len(records))
+	for i, line := range lines {
+		var record AuditRecord
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		require.Equal(t, records[i], &record)
+	}
+}
+
+func TestGetAuditSinkFromConfigDisabled(t *testing.T) {
+	sink, err := GetAuditSinkFromConfig(&config.AuthorizationAuditLog{})
+	require.NoError(t, err)
+	require.Nil(t, sink)
+}
@@ -31,6 +31,8 @@ import (
 	"fmt"
 	"strings"
 
+	commonpb "go.temporal.io/api/common/v1"
+
 	"go.temporal.io/server/common/config"
 )
 
@@ -52,6 +54,8 @@ type CallTarget struct {
 	Namespace string
 	// Request contains a deserialized copy of the API request object
 	Request interface{}
+
+	workflowTypeResolver WorkflowTypeResolver
 }
 
 // @@@SNIPEND
@@ -76,17 +80,74 @@ type Authorizer interface {
 
 // @@@SNIPEND
 
+// WorkflowTypeResolver looks up the type of a workflow execution targeted by a call
+type WorkflowTypeResolver interface {
+	GetWorkflowType(ctx context.Context, namespace string, execution *commonpb.WorkflowExecution) (string, error)
+}
+
 type hasNamespace interface {
 	GetNamespace() string
 }
 
+type hasWorkflowType interface {
+	GetWorkflowType() *commonpb.WorkflowType
+}
+
+type hasWorkflowExecution interface {
+	GetWorkflowExecution() *commonpb.WorkflowExecution
+}
+
+type hasExecution interface {
+	GetExecution() *commonpb.WorkflowExecution
+}
+
+type hasWorkflowID interface {
+	GetWorkflowId() string
+}
+
+// WorkflowType returns the type of the workflow targeted by the call or an empty string if the call
+// doesn't target a workflow. It looks up the workflow execution if the type is not in the request.
+func (t *CallTarget) WorkflowType(ctx context.Context) (string, error) {
+	if request, ok := t.Request.(hasWorkflowType); ok {
+		return request.GetWorkflowType().GetName(), nil
+	}
+	request, ok := t.Request.(hasWorkflowExecution)
+	if !ok || request.GetWorkflowExecution().GetWorkflowId() == "" || t.workflowTypeResolver == nil {
+		return "", nil
+	}
+	return t.workflowTypeResolver.GetWorkflowType(ctx, t.Namespace, request.GetWorkflowExecution())
+}
+
+// targetExecution returns the workflow ID and run ID of the workflow execution targeted by the request if any
+func targetExecution(request interface{}) (string, string) {
+	switch r := request.(type) {
+	case hasWorkflowExecution:
+		return r.GetWorkflowExecution().GetWorkflowId(), r.GetWorkflowExecution().GetRunId()
+	case hasExecution:
+		return r.GetExecution().GetWorkflowId(), r.GetExecution().GetRunId()
+	case hasWorkflowID:
+		return r.GetWorkflowId(), ""
+	}
+	return "", ""
+}
+
 func GetAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
 
 	switch strings.ToLower(config.Authorizer) {
 	case "":
 		return NewNoopAuthorizer(), nil
 	case "default":
-		return NewDefaultAuthorizer(), nil
+		policy := config.Policy
+		if policy == nil && config.PolicyFile != "" {
+			var err error
+			if policy, err = LoadPolicyFile(config.PolicyFile); err != nil {
+				return nil, err
+			}
+		}
+		if policy == nil {
+			return NewDefaultAuthorizer(), nil
+		}
+		return NewDefaultAuthorizerWithPolicy(policy)
 	}
 	return nil, fmt.Errorf("unknown authorizer: %s", config.Authorizer)
 }
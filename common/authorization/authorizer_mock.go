@@ -33,6 +33,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	v1 "go.temporal.io/api/common/v1"
 )
 
 // MockAuthorizer is a mock of Authorizer interface.
@@ -73,6 +74,44 @@ func (mr *MockAuthorizerMockRecorder) Authorize(ctx, caller, target interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthorizer)(nil).Authorize), ctx, caller, target)
 }
 
+// MockWorkflowTypeResolver is a mock of WorkflowTypeResolver interface.
+type MockWorkflowTypeResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkflowTypeResolverMockRecorder
+}
+
+// MockWorkflowTypeResolverMockRecorder is the mock recorder for MockWorkflowTypeResolver.
+type MockWorkflowTypeResolverMockRecorder struct {
+	mock *MockWorkflowTypeResolver
+}
+
+// NewMockWorkflowTypeResolver creates a new mock instance.
+func NewMockWorkflowTypeResolver(ctrl *gomock.Controller) *MockWorkflowTypeResolver {
+	mock := &MockWorkflowTypeResolver{ctrl: ctrl}
+	mock.recorder = &MockWorkflowTypeResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkflowTypeResolver) EXPECT() *MockWorkflowTypeResolverMockRecorder {
+	return m.recorder
+}
+
+// GetWorkflowType mocks base method.
+func (m *MockWorkflowTypeResolver) GetWorkflowType(ctx context.Context, namespace string, execution *v1.WorkflowExecution) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowType", ctx, namespace, execution)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowType indicates an expected call of GetWorkflowType.
+func (mr *MockWorkflowTypeResolverMockRecorder) GetWorkflowType(ctx, namespace, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowType", reflect.TypeOf((*MockWorkflowTypeResolver)(nil).GetWorkflowType), ctx, namespace, execution)
+}
+
 // MockhasNamespace is a mock of hasNamespace interface.
 type MockhasNamespace struct {
 	ctrl     *gomock.Controller
@@ -109,3 +148,151 @@ func (mr *MockhasNamespaceMockRecorder) GetNamespace() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockhasNamespace)(nil).GetNamespace))
 }
+
+// MockhasWorkflowType is a mock of hasWorkflowType interface.
+type MockhasWorkflowType struct {
+	ctrl     *gomock.Controller
+	recorder *MockhasWorkflowTypeMockRecorder
+}
+
+// MockhasWorkflowTypeMockRecorder is the mock recorder for MockhasWorkflowType.
+type MockhasWorkflowTypeMockRecorder struct {
+	mock *MockhasWorkflowType
+}
+
+// NewMockhasWorkflowType creates a new mock instance.
+func NewMockhasWorkflowType(ctrl *gomock.Controller) *MockhasWorkflowType {
+	mock := &MockhasWorkflowType{ctrl: ctrl}
+	mock.recorder = &MockhasWorkflowTypeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhasWorkflowType) EXPECT() *MockhasWorkflowTypeMockRecorder {
+	return m.recorder
+}
+
+// GetWorkflowType mocks base method.
+func (m *MockhasWorkflowType) GetWorkflowType() *v1.WorkflowType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowType")
+	ret0, _ := ret[0].(*v1.WorkflowType)
+	return ret0
+}
+
+// GetWorkflowType indicates an expected call of GetWorkflowType.
+func (mr *MockhasWorkflowTypeMockRecorder) GetWorkflowType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowType", reflect.TypeOf((*MockhasWorkflowType)(nil).GetWorkflowType))
+}
+
+// MockhasWorkflowExecution is a mock of hasWorkflowExecution interface.
+type MockhasWorkflowExecution struct {
+	ctrl     *gomock.Controller
+	recorder *MockhasWorkflowExecutionMockRecorder
+}
+
+// MockhasWorkflowExecutionMockRecorder is the mock recorder for MockhasWorkflowExecution.
+type MockhasWorkflowExecutionMockRecorder struct {
+	mock *MockhasWorkflowExecution
+}
+
+// NewMockhasWorkflowExecution creates a new mock instance.
+func NewMockhasWorkflowExecution(ctrl *gomock.Controller) *MockhasWorkflowExecution {
+	mock := &MockhasWorkflowExecution{ctrl: ctrl}
+	mock.recorder = &MockhasWorkflowExecutionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhasWorkflowExecution) EXPECT() *MockhasWorkflowExecutionMockRecorder {
+	return m.recorder
+}
+
+// GetWorkflowExecution mocks base method.
+func (m *MockhasWorkflowExecution) GetWorkflowExecution() *v1.WorkflowExecution {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecution")
+	ret0, _ := ret[0].(*v1.WorkflowExecution)
+	return ret0
+}
+
+// GetWorkflowExecution indicates an expected call of GetWorkflowExecution.
+func (mr *MockhasWorkflowExecutionMockRecorder) GetWorkflowExecution() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecution", reflect.TypeOf((*MockhasWorkflowExecution)(nil).GetWorkflowExecution))
+}
+
+// MockhasExecution is a mock of hasExecution interface.
+type MockhasExecution struct {
+	ctrl     *gomock.Controller
+	recorder *MockhasExecutionMockRecorder
+}
+
+// MockhasExecutionMockRecorder is the mock recorder for MockhasExecution.
+type MockhasExecutionMockRecorder struct {
+	mock *MockhasExecution
+}
+
+// NewMockhasExecution creates a new mock instance.
+func NewMockhasExecution(ctrl *gomock.Controller) *MockhasExecution {
+	mock := &MockhasExecution{ctrl: ctrl}
+	mock.recorder = &MockhasExecutionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhasExecution) EXPECT() *MockhasExecutionMockRecorder {
+	return m.recorder
+}
+
+// GetExecution mocks base method.
+func (m *MockhasExecution) GetExecution() *v1.WorkflowExecution {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExecution")
+	ret0, _ := ret[0].(*v1.WorkflowExecution)
+	return ret0
+}
+
+// GetExecution indicates an expected call of GetExecution.
+func (mr *MockhasExecutionMockRecorder) GetExecution() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecution", reflect.TypeOf((*MockhasExecution)(nil).GetExecution))
+}
+
+// MockhasWorkflowID is a mock of hasWorkflowID interface.
+type MockhasWorkflowID struct {
+	ctrl     *gomock.Controller
+	recorder *MockhasWorkflowIDMockRecorder
+}
+
+// MockhasWorkflowIDMockRecorder is the mock recorder for MockhasWorkflowID.
+type MockhasWorkflowIDMockRecorder struct {
+	mock *MockhasWorkflowID
+}
+
+// NewMockhasWorkflowID creates a new mock instance.
+func NewMockhasWorkflowID(ctrl *gomock.Controller) *MockhasWorkflowID {
+	mock := &MockhasWorkflowID{ctrl: ctrl}
+	mock.recorder = &MockhasWorkflowIDMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockhasWorkflowID) EXPECT() *MockhasWorkflowIDMockRecorder {
+	return m.recorder
+}
+
+// GetWorkflowId mocks base method.
+func (m *MockhasWorkflowID) GetWorkflowId() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowId")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetWorkflowId indicates an expected call of GetWorkflowId.
+func (mr *MockhasWorkflowIDMockRecorder) GetWorkflowId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowId", reflect.TypeOf((*MockhasWorkflowID)(nil).GetWorkflowId))
+}
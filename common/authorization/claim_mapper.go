@@ -76,6 +76,9 @@ func GetClaimMapperFromConfig(config *config.Authorization, logger log.Logger) (
 	case "":
 		return NewNoopClaimMapper(), nil
 	case "default":
+		if len(config.Issuers) > 0 {
+			return NewJWTClaimMapperWithIssuers(config, logger)
+		}
 		return NewDefaultJWTClaimMapper(NewDefaultTokenKeyProvider(config, logger), config, logger), nil
 	}
 	return nil, fmt.Errorf("unknown claim mapper: %s", config.ClaimMapper)
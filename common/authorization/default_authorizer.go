@@ -26,11 +26,15 @@ package authorization
 
 import (
 	"context"
+	"fmt"
 	"strings"
+
+	"go.temporal.io/server/common/config"
 )
 
 type (
 	defaultAuthorizer struct {
+		policy *policy
 	}
 )
 
@@ -41,10 +45,20 @@ func NewDefaultAuthorizer() Authorizer {
 	return &defaultAuthorizer{}
 }
 
+// NewDefaultAuthorizerWithPolicy creates a default authorizer which also authorizes custom roles
+// granted by claims according to the policy
+func NewDefaultAuthorizerWithPolicy(cfg *config.AuthorizationPolicy) (Authorizer, error) {
+	p, err := newPolicy(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authorization policy: %w", err)
+	}
+	return &defaultAuthorizer{policy: p}, nil
+}
+
 var resultAllow = Result{Decision: DecisionAllow}
 var resultDeny = Result{Decision: DecisionDeny}
 
-func (a *defaultAuthorizer) Authorize(_ context.Context, claims *Claims, target *CallTarget) (Result, error) {
+func (a *defaultAuthorizer) Authorize(ctx context.Context, claims *Claims, target *CallTarget) (Result, error) {
 
 	// TODO: This is a temporary workaround to allow calls to system namespace and
 	// calls with no namespace to pass through. When handling of mTLS data is added,
@@ -57,28 +71,43 @@ func (a *defaultAuthorizer) Authorize(_ context.Context, claims *Claims, target
 	if claims == nil {
 		return resultDeny, nil
 	}
+
+	api := ApiName(target.APIName)
+
+	// Deny rules of custom roles take precedence over any other permissions
+	var customResult Result
+	if a.policy != nil {
+		var err error
+		customResult, err = a.policy.authorize(ctx, claims.customRoles(target.Namespace), target, api)
+		if err != nil {
+			return resultDeny, err
+		}
+		if customResult.Decision == DecisionDeny {
+			return customResult, nil
+		}
+	}
+
 	// Check system level permissions
 	if claims.System >= RoleWriter {
 		return resultAllow, nil
 	}
 
-	api := ApiName(target.APIName)
 	readOnlyNamespaceAPI := IsReadOnlyNamespaceAPI(api)
 	readOnlyGlobalAPI := IsReadOnlyGlobalAPI(api)
 	if claims.System >= RoleReader && (readOnlyNamespaceAPI || readOnlyGlobalAPI) {
 		return resultAllow, nil
 	}
 
-	role, found := claims.Namespaces[strings.ToLower(target.Namespace)]
-	if !found || role == RoleUndefined {
-		return resultDeny, nil
-	}
+	role := claims.namespaceRole(target.Namespace, a.policy != nil && a.policy.namespacePatterns)
 	if role >= RoleWriter {
 		return resultAllow, nil
 	}
 	if role >= RoleReader && readOnlyNamespaceAPI {
 		return resultAllow, nil
 	}
+	if customResult.Decision == DecisionAllow {
+		return resultAllow, nil
+	}
 
 	return resultDeny, nil
 }
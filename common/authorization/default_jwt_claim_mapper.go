@@ -27,7 +27,9 @@ package authorization
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v4"
 	"go.temporal.io/api/serviceerror"
@@ -52,6 +54,22 @@ type defaultJWTClaimMapper struct {
 	keyProvider          TokenKeyProvider
 	logger               log.Logger
 	permissionsClaimName string
+	// issuers are trusted issuers by the value of "iss" claim. If set, keyProvider is not used
+	// and tokens from other issuers are rejected.
+	issuers map[string]*jwtIssuer
+}
+
+type jwtIssuer struct {
+	config               config.JWTIssuer
+	keyProvider          TokenKeyProvider
+	permissionsClaimName string
+	claimMappings        []*jwtClaimMapping
+}
+
+type jwtClaimMapping struct {
+	claimPath   []string
+	match       *regexp.Regexp
+	permissions []string
 }
 
 func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorization, logger log.Logger) ClaimMapper {
@@ -62,6 +80,52 @@ func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorizati
 	return &defaultJWTClaimMapper{keyProvider: provider, logger: logger, permissionsClaimName: claimName}
 }
 
+// NewJWTClaimMapperWithIssuers creates a claim mapper which accepts tokens of the issuers from config
+func NewJWTClaimMapperWithIssuers(cfg *config.Authorization, logger log.Logger) (ClaimMapper, error) {
+	return newJWTClaimMapperWithIssuers(cfg, logger, func(issuer *config.JWTIssuer) TokenKeyProvider {
+		return NewIssuerTokenKeyProvider(issuer, logger)
+	})
+}
+
+func newJWTClaimMapperWithIssuers(
+	cfg *config.Authorization,
+	logger log.Logger,
+	newKeyProvider func(issuer *config.JWTIssuer) TokenKeyProvider,
+) (*defaultJWTClaimMapper, error) {
+	mapper := NewDefaultJWTClaimMapper(nil, cfg, logger).(*defaultJWTClaimMapper)
+	mapper.issuers = make(map[string]*jwtIssuer, len(cfg.Issuers))
+	for i := range cfg.Issuers {
+		issuerConfig := cfg.Issuers[i]
+		if issuerConfig.Issuer == "" {
+			return nil, fmt.Errorf("JWT issuer %d has no issuer name", i)
+		}
+		if _, ok := mapper.issuers[issuerConfig.Issuer]; ok {
+			return nil, fmt.Errorf("JWT issuer %s is configured more than once", issuerConfig.Issuer)
+		}
+		issuer := &jwtIssuer{
+			config:               issuerConfig,
+			permissionsClaimName: issuerConfig.PermissionsClaimName,
+		}
+		if issuer.permissionsClaimName == "" {
+			issuer.permissionsClaimName = mapper.permissionsClaimName
+		}
+		for _, mapping := range issuerConfig.ClaimMappings {
+			match, err := regexp.Compile("^(?:" + mapping.Match + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("JWT issuer %s: invalid claim mapping expression %q: %w", issuerConfig.Issuer, mapping.Match, err)
+			}
+			issuer.claimMappings = append(issuer.claimMappings, &jwtClaimMapping{
+				claimPath:   strings.Split(mapping.Claim, "."),
+				match:       match,
+				permissions: mapping.Permissions,
+			})
+		}
+		issuer.keyProvider = newKeyProvider(&issuer.config)
+		mapper.issuers[issuerConfig.Issuer] = issuer
+	}
+	return mapper, nil
+}
+
 var _ ClaimMapper = (*defaultJWTClaimMapper)(nil)
 
 func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
@@ -79,7 +143,14 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	if !strings.EqualFold(parts[0], authorizationBearer) {
 		return nil, serviceerror.NewPermissionDenied("unexpected name in authorization token", "")
 	}
-	jwtClaims, err := parseJWTWithAudience(parts[1], a.keyProvider, authInfo.Audience)
+	var jwtClaims jwt.MapClaims
+	var issuer *jwtIssuer
+	var err error
+	if a.issuers != nil {
+		jwtClaims, issuer, err = a.parseIssuerJWT(parts[1], authInfo.Audience)
+	} else {
+		jwtClaims, err = parseJWTWithAudience(parts[1], a.keyProvider, authInfo.Audience)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -88,8 +159,15 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 		return nil, serviceerror.NewPermissionDenied("unexpected value type of \"sub\" claim", "")
 	}
 	claims.Subject = subject
-	permissions, ok := jwtClaims[a.permissionsClaimName].([]interface{})
-	if ok {
+	permissionsClaimName := a.permissionsClaimName
+	if issuer != nil {
+		permissionsClaimName = issuer.permissionsClaimName
+	}
+	permissions, _ := jwtClaims[permissionsClaimName].([]interface{})
+	if issuer != nil {
+		permissions = append(permissions, issuer.mapClaims(jwtClaims)...)
+	}
+	if len(permissions) > 0 {
 		err := a.extractPermissions(permissions, &claims)
 		if err != nil {
 			return nil, err
@@ -98,6 +176,81 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	return &claims, nil
 }
 
+// parseIssuerJWT validates the token with keys of its issuer and validates its issuer, audience,
+// expiration and not before claims
+func (a *defaultJWTClaimMapper) parseIssuerJWT(tokenString string, audience string) (jwt.MapClaims, *jwtIssuer, error) {
+	unverifiedClaims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(tokenString, unverifiedClaims); err != nil {
+		return nil, nil, err
+	}
+	issuerName, _ := unverifiedClaims["iss"].(string)
+	issuer, ok := a.issuers[issuerName]
+	if !ok {
+		return nil, nil, serviceerror.NewPermissionDenied(fmt.Sprintf("untrusted token issuer %q", issuerName), "")
+	}
+	if issuer.config.Audience != "" {
+		audience = issuer.config.Audience
+	}
+
+	claims, err := parseJWTWithOptions(tokenString, issuer.keyProvider, audience, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	if !claims.VerifyIssuer(issuer.config.Issuer, true) {
+		return nil, nil, serviceerror.NewPermissionDenied("issuer mismatch", "")
+	}
+	if !claims.VerifyExpiresAt(now.Add(-issuer.config.ClockSkew).Unix(), true) {
+		return nil, nil, serviceerror.NewPermissionDenied("token is expired or has no expiration time", "")
+	}
+	if !claims.VerifyNotBefore(now.Add(issuer.config.ClockSkew).Unix(), false) {
+		return nil, nil, serviceerror.NewPermissionDenied("token is not valid yet", "")
+	}
+	return claims, issuer, nil
+}
+
+// mapClaims returns permissions granted by claim mappings of the issuer
+func (i *jwtIssuer) mapClaims(jwtClaims jwt.MapClaims) []interface{} {
+	var result []interface{}
+	for _, mapping := range i.claimMappings {
+		for _, value := range claimStrings(jwtClaims, mapping.claimPath) {
+			submatches := mapping.match.FindStringSubmatchIndex(value)
+			if submatches == nil {
+				continue
+			}
+			for _, permission := range mapping.permissions {
+				result = append(result, string(mapping.match.ExpandString(nil, permission, value, submatches)))
+			}
+		}
+	}
+	return result
+}
+
+// claimStrings returns values of a claim which is either a string or a list of strings
+func claimStrings(jwtClaims map[string]interface{}, path []string) []string {
+	var value interface{} = jwtClaims
+	for _, name := range path {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
 func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, claims *Claims) error {
 	for _, permission := range permissions {
 		p, ok := permission.(string)
@@ -111,15 +264,23 @@ func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, cl
 			continue
 		}
 		namespace := strings.ToLower(parts[0])
-		if strings.EqualFold(namespace, permissionScopeSystem) {
-			claims.System |= permissionToRole(parts[1])
+		role := permissionToRole(parts[1])
+		if role == RoleUndefined {
+			// permissions with other roles grant custom roles defined by authorization policy
+			if namespace == permissionScopeSystem {
+				namespace = policyWildcard
+			}
+			if claims.CustomRoles == nil {
+				claims.CustomRoles = make(map[string][]string)
+			}
+			claims.CustomRoles[namespace] = append(claims.CustomRoles[namespace], strings.ToLower(parts[1]))
+		} else if namespace == permissionScopeSystem {
+			claims.System |= role
 		} else {
 			if claims.Namespaces == nil {
 				claims.Namespaces = make(map[string]Role)
 			}
-			role := claims.Namespaces[namespace]
-			role |= permissionToRole(parts[1])
-			claims.Namespaces[namespace] = role
+			claims.Namespaces[namespace] |= role
 		}
 	}
 	return nil
@@ -130,8 +291,18 @@ func parseJWT(tokenString string, keyProvider TokenKeyProvider) (jwt.MapClaims,
 }
 
 func parseJWTWithAudience(tokenString string, keyProvider TokenKeyProvider, audience string) (jwt.MapClaims, error) {
+	return parseJWTWithOptions(tokenString, keyProvider, audience, true)
+}
 
-	parser := jwt.NewParser(jwt.WithValidMethods(keyProvider.SupportedMethods()))
+// parseJWTWithOptions verifies the token signature and audience. Time based claims are only validated
+// if validateClaims is true.
+func parseJWTWithOptions(tokenString string, keyProvider TokenKeyProvider, audience string, validateClaims bool) (jwt.MapClaims, error) {
+
+	options := []jwt.ParserOption{jwt.WithValidMethods(keyProvider.SupportedMethods())}
+	if !validateClaims {
+		options = append(options, jwt.WithoutClaimsValidation())
+	}
+	parser := jwt.NewParser(options...)
 
 	var keyFunc jwt.Keyfunc
 	if provider, _ := keyProvider.(RawTokenKeyProvider); provider != nil {
@@ -170,8 +341,10 @@ func parseJWTWithAudience(tokenString string, keyProvider TokenKeyProvider, audi
 	if !ok {
 		return nil, serviceerror.NewPermissionDenied("invalid token with no claims", "")
 	}
-	if err := claims.Valid(); err != nil {
-		return nil, err
+	if validateClaims {
+		if err := claims.Valid(); err != nil {
+			return nil, err
+		}
 	}
 	if strings.TrimSpace(audience) != "" && !claims.VerifyAudience(audience, true) {
 		return nil, serviceerror.NewPermissionDenied("audience mismatch", "")
@@ -29,7 +29,10 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 	"time"
@@ -38,6 +41,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/square/go-jose.v2"
 
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
@@ -67,6 +71,7 @@ const (
 var (
 	permissionsAdmin              = []string{"system:admin", "default:read"}
 	permissionsReaderWriterWorker = []string{"default:read", "default:write", "default:worker"}
+	permissionsCustomRoles        = []string{"system:OnCall", "prod-*:read", "prod-*:payments-operator"}
 )
 
 type (
@@ -188,6 +193,22 @@ func (s *defaultClaimMapperSuite) testTokenWithReaderWriterWorkerPermissions(alg
 	defaultRole := claims.Namespaces[defaultNamespace]
 	s.Equal(RoleReader|RoleWriter|RoleWorker, defaultRole)
 }
+func (s *defaultClaimMapperSuite) TestTokenWithCustomRoles() {
+	tokenString, err := s.tokenGenerator.generateRSAToken(testSubject, permissionsCustomRoles, errorTestOptionNoError)
+	s.NoError(err)
+	authInfo := &AuthInfo{
+		AuthToken: AddBearer(tokenString),
+	}
+	claims, err := s.claimMapper.GetClaims(authInfo)
+	s.NoError(err)
+	s.Equal(RoleUndefined, claims.System)
+	s.Equal(map[string]Role{"prod-*": RoleReader}, claims.Namespaces)
+	s.Equal(map[string][]string{
+		"*":      {"oncall"},
+		"prod-*": {"payments-operator"},
+	}, claims.CustomRoles)
+}
+
 func (s *defaultClaimMapperSuite) TestGetClaimMapperFromConfigNoop() {
 	s.testGetClaimMapperFromConfig("", true, reflect.TypeOf(&noopClaimMapper{}))
 }
@@ -241,6 +262,165 @@ func (s *defaultClaimMapperSuite) TestIgnoreAudience() {
 	s.NoError(err)
 }
 
+func (s *defaultClaimMapperSuite) TestIssuersWithOIDCDiscovery() {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case oidcDiscoveryPath:
+			_ = json.NewEncoder(w).Encode(oidcDiscoveryDocument{Issuer: server.URL, JWKSURI: server.URL + "/keys"})
+		case "/keys":
+			_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+				{Key: s.tokenGenerator.rsaPublicKey, KeyID: "test-key", Algorithm: "RS256", Use: "sig"},
+			}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config.Authorization{
+		ClaimMapper: "default",
+		Issuers: []config.JWTIssuer{
+			{
+				Issuer:   server.URL,
+				Audience: "temporal",
+				ClaimMappings: []config.JWTClaimMapping{
+					{Claim: "groups", Match: "temporal-(.+)-writers", Permissions: []string{"$1:write"}},
+					{Claim: "realm_access.roles", Match: "oncall", Permissions: []string{"system:oncall"}},
+				},
+			},
+		},
+	}
+	claimMapper, err := GetClaimMapperFromConfig(cfg, s.logger)
+	s.NoError(err)
+
+	token := s.issuerToken(jwt.MapClaims{
+		"iss":          server.URL,
+		"sub":          testSubject,
+		"aud":          "temporal",
+		"exp":          time.Now().Add(time.Hour).Unix(),
+		"permissions":  []string{"default:read"},
+		"groups":       []string{"temporal-payments-writers", "engineering"},
+		"realm_access": map[string]interface{}{"roles": []string{"oncall"}},
+	})
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+	s.NoError(err)
+	s.Equal(testSubject, claims.Subject)
+	s.Equal(map[string]Role{"default": RoleReader, "payments": RoleWriter}, claims.Namespaces)
+	s.Equal(map[string][]string{"*": {"oncall"}}, claims.CustomRoles)
+}
+
+func (s *defaultClaimMapperSuite) TestIssuerKeyRetrievalTimeout() {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	provider := &defaultTokenKeyProvider{
+		discoveryURL: server.URL + oidcDiscoveryPath,
+		httpClient:   &http.Client{Timeout: 100 * time.Millisecond},
+		logger:       s.logger,
+	}
+	start := time.Now()
+	provider.initialize()
+	s.Less(time.Since(start), 5*time.Second)
+
+	_, err := provider.RsaKey("RS256", "test-key")
+	s.Error(err)
+}
+
+func (s *defaultClaimMapperSuite) TestIssuersValidation() {
+	cfg := &config.Authorization{
+		Issuers: []config.JWTIssuer{
+			{Issuer: "https://corp.example.com", ClockSkew: time.Minute},
+			{Issuer: "https://ci.example.com", Audience: "temporal", PermissionsClaimName: "temporal"},
+		},
+	}
+	claimMapper, err := newJWTClaimMapperWithIssuers(cfg, s.logger, func(*config.JWTIssuer) TokenKeyProvider {
+		return s.tokenGenerator
+	})
+	s.NoError(err)
+
+	now := time.Now()
+	testCases := []struct {
+		name   string
+		claims jwt.MapClaims
+		valid  bool
+	}{
+		{
+			name:   "valid",
+			claims: jwt.MapClaims{"iss": "https://corp.example.com", "sub": testSubject, "exp": now.Add(time.Hour).Unix()},
+			valid:  true,
+		},
+		{
+			name:   "unknown issuer",
+			claims: jwt.MapClaims{"iss": "https://other.example.com", "sub": testSubject, "exp": now.Add(time.Hour).Unix()},
+		},
+		{
+			name:   "no expiration",
+			claims: jwt.MapClaims{"iss": "https://corp.example.com", "sub": testSubject},
+		},
+		{
+			name:   "expired",
+			claims: jwt.MapClaims{"iss": "https://corp.example.com", "sub": testSubject, "exp": now.Add(-2 * time.Minute).Unix()},
+		},
+		{
+			name:   "expired within clock skew",
+			claims: jwt.MapClaims{"iss": "https://corp.example.com", "sub": testSubject, "exp": now.Add(-30 * time.Second).Unix()},
+			valid:  true,
+		},
+		{
+			name: "not valid yet",
+			claims: jwt.MapClaims{"iss": "https://corp.example.com", "sub": testSubject,
+				"exp": now.Add(time.Hour).Unix(), "nbf": now.Add(2 * time.Minute).Unix()},
+		},
+		{
+			name:   "audience mismatch",
+			claims: jwt.MapClaims{"iss": "https://ci.example.com", "sub": testSubject, "exp": now.Add(time.Hour).Unix(), "aud": "other"},
+		},
+		{
+			name: "issuer with own permissions claim",
+			claims: jwt.MapClaims{"iss": "https://ci.example.com", "sub": testSubject, "exp": now.Add(time.Hour).Unix(),
+				"aud": "temporal", "temporal": []string{"ci:write"}},
+			valid: true,
+		},
+	}
+	for _, tc := range testCases {
+		claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(s.issuerToken(tc.claims))})
+		if tc.valid {
+			s.NoError(err, tc.name)
+			s.Equal(testSubject, claims.Subject, tc.name)
+		} else {
+			s.Error(err, tc.name)
+		}
+	}
+
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(s.issuerToken(testCases[len(testCases)-1].claims))})
+	s.NoError(err)
+	s.Equal(map[string]Role{"ci": RoleWriter}, claims.Namespaces)
+}
+
+func (s *defaultClaimMapperSuite) TestIssuersInvalidConfig() {
+	_, err := GetClaimMapperFromConfig(&config.Authorization{
+		ClaimMapper: "default",
+		Issuers: []config.JWTIssuer{
+			{Issuer: "https://corp.example.com", KeySourceURIs: []string{"http://127.0.0.1:0/keys"},
+				ClaimMappings: []config.JWTClaimMapping{{Claim: "groups", Match: "(", Permissions: []string{"$1:read"}}}},
+		},
+	}, s.logger)
+	s.Error(err)
+}
+
+func (s *defaultClaimMapperSuite) issuerToken(claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test-key"
+	tokenString, err := token.SignedString(s.tokenGenerator.rsaPrivateKey)
+	s.NoError(err)
+	return tokenString
+}
+
 func (s *defaultClaimMapperSuite) testGetClaimMapperFromConfig(name string, valid bool, cmType reflect.Type) {
 
 	cfg := config.Authorization{}
@@ -42,21 +42,63 @@ import (
 	"go.temporal.io/server/common/log/tag"
 )
 
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+	// keyRetrievalTimeout bounds each request for the OIDC discovery document or token keys,
+	// including the initial one made by the constructors
+	keyRetrievalTimeout = 10 * time.Second
+)
+
 // Default token key provider
 type defaultTokenKeyProvider struct {
-	config   config.JWTKeyProvider
-	rsaKeys  map[string]*rsa.PublicKey
-	ecKeys   map[string]*ecdsa.PublicKey
-	keysLock sync.RWMutex
-	ticker   *time.Ticker
-	logger   log.Logger
-	stop     chan bool
+	config config.JWTKeyProvider
+	// discoveryURL is the URL of OIDC discovery document with the JWKS URI, KeySourceURIs are used if it is empty
+	discoveryURL string
+	// issuer is the expected issuer of the OIDC discovery document
+	issuer     string
+	httpClient *http.Client
+	rsaKeys    map[string]*rsa.PublicKey
+	ecKeys     map[string]*ecdsa.PublicKey
+	keysLock   sync.RWMutex
+	ticker     *time.Ticker
+	logger     log.Logger
+	stop       chan bool
+}
+
+// oidcDiscoveryDocument contains the fields of OIDC discovery document used for retrieving token keys
+type oidcDiscoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
 }
 
 var _ TokenKeyProvider = (*defaultTokenKeyProvider)(nil)
 
 func NewDefaultTokenKeyProvider(cfg *config.Authorization, logger log.Logger) *defaultTokenKeyProvider {
-	provider := defaultTokenKeyProvider{config: cfg.JWTKeyProvider, logger: logger}
+	provider := defaultTokenKeyProvider{
+		config:     cfg.JWTKeyProvider,
+		httpClient: &http.Client{Timeout: keyRetrievalTimeout},
+		logger:     logger,
+	}
+	provider.initialize()
+	return &provider
+}
+
+// NewIssuerTokenKeyProvider creates a token key provider for the keys of a JWT issuer. The keys are
+// retrieved from KeySourceURIs of the issuer or from the JWKS URI of its OIDC discovery document.
+func NewIssuerTokenKeyProvider(issuer *config.JWTIssuer, logger log.Logger) *defaultTokenKeyProvider {
+	provider := defaultTokenKeyProvider{
+		config: config.JWTKeyProvider{
+			KeySourceURIs:   issuer.KeySourceURIs,
+			RefreshInterval: issuer.RefreshInterval,
+		},
+		discoveryURL: issuer.DiscoveryURL,
+		issuer:       issuer.Issuer,
+		httpClient:   &http.Client{Timeout: keyRetrievalTimeout},
+		logger:       logger,
+	}
+	if provider.discoveryURL == "" && !provider.config.HasSourceURIsConfigured() {
+		provider.discoveryURL = strings.TrimSuffix(issuer.Issuer, "/") + oidcDiscoveryPath
+	}
 	provider.initialize()
 	return &provider
 }
@@ -64,7 +106,7 @@ func NewDefaultTokenKeyProvider(cfg *config.Authorization, logger log.Logger) *d
 func (a *defaultTokenKeyProvider) initialize() {
 	a.rsaKeys = make(map[string]*rsa.PublicKey)
 	a.ecKeys = make(map[string]*ecdsa.PublicKey)
-	if a.config.HasSourceURIsConfigured() {
+	if a.hasKeySources() {
 		err := a.updateKeys()
 		if err != nil {
 			a.logger.Error("error during initial retrieval of token keys: ", tag.Error(err))
@@ -122,7 +164,7 @@ func (a *defaultTokenKeyProvider) timerCallback() {
 			break
 		case <-a.ticker.C:
 		}
-		if a.config.HasSourceURIsConfigured() {
+		if a.hasKeySources() {
 			err := a.updateKeys()
 			if err != nil {
 				a.logger.Error("error while refreshing token keys: ", tag.Error(err))
@@ -131,15 +173,28 @@ func (a *defaultTokenKeyProvider) timerCallback() {
 	}
 }
 
+func (a *defaultTokenKeyProvider) hasKeySources() bool {
+	return a.discoveryURL != "" || a.config.HasSourceURIsConfigured()
+}
+
 func (a *defaultTokenKeyProvider) updateKeys() error {
-	if !a.config.HasSourceURIsConfigured() {
+	if !a.hasKeySources() {
 		return fmt.Errorf("no URIs configured for retrieving token keys")
 	}
 
+	uris := a.config.KeySourceURIs
+	if a.discoveryURL != "" {
+		jwksURI, err := a.discoverJWKSURI()
+		if err != nil {
+			return err
+		}
+		uris = []string{jwksURI}
+	}
+
 	rsaKeys := make(map[string]*rsa.PublicKey)
 	ecKeys := make(map[string]*ecdsa.PublicKey)
 
-	for _, uri := range a.config.KeySourceURIs {
+	for _, uri := range uris {
 		if strings.TrimSpace(uri) == "" {
 			continue
 		}
@@ -156,13 +211,37 @@ func (a *defaultTokenKeyProvider) updateKeys() error {
 	return nil
 }
 
+// discoverJWKSURI retrieves the JWKS URI from the OIDC discovery document
+func (a *defaultTokenKeyProvider) discoverJWKSURI() (string, error) {
+	resp, err := a.httpClient.Get(a.discoveryURL)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s of OIDC discovery document %s", resp.Status, a.discoveryURL)
+	}
+
+	var document oidcDiscoveryDocument
+	if err := json.NewDecoder(resp.Body).Decode(&document); err != nil {
+		return "", err
+	}
+	if a.issuer != "" && document.Issuer != a.issuer {
+		return "", fmt.Errorf("OIDC discovery document %s is for issuer %s, expected %s", a.discoveryURL, document.Issuer, a.issuer)
+	}
+	if document.JWKSURI == "" {
+		return "", fmt.Errorf("OIDC discovery document %s has no jwks_uri", a.discoveryURL)
+	}
+	return document.JWKSURI, nil
+}
+
 func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	uri string,
 	rsaKeys map[string]*rsa.PublicKey,
 	ecKeys map[string]*ecdsa.PublicKey,
 ) error {
 
-	resp, err := http.Get(uri)
+	resp, err := a.httpClient.Get(uri)
 	if err != nil {
 		return err
 	}
@@ -24,6 +24,14 @@
 
 package authorization
 
+import (
+	"reflect"
+
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
 var readOnlyNamespaceAPI = map[string]struct{}{
 	"DescribeNamespace":              {},
 	"GetWorkflowExecutionHistory":    {},
@@ -38,6 +46,8 @@ var readOnlyNamespaceAPI = map[string]struct{}{
 	"DescribeTaskQueue":              {},
 	"GetSystemInfo":                  {},
 	"ListTaskQueuePartitions":        {},
+	"DescribeSchedule":               {},
+	"ListSchedules":                  {},
 }
 
 var readOnlyGlobalAPI = map[string]struct{}{
@@ -46,6 +56,24 @@ var readOnlyGlobalAPI = map[string]struct{}{
 	"GetClusterInfo":      {},
 }
 
+// workerAPI contains high volume APIs called by workers
+var workerAPI = map[string]struct{}{
+	"PollWorkflowTaskQueue":            {},
+	"PollActivityTaskQueue":            {},
+	"RespondWorkflowTaskCompleted":     {},
+	"RespondWorkflowTaskFailed":        {},
+	"RespondQueryTaskCompleted":        {},
+	"RespondActivityTaskCompleted":     {},
+	"RespondActivityTaskCompletedById": {},
+	"RespondActivityTaskFailed":        {},
+	"RespondActivityTaskFailedById":    {},
+	"RespondActivityTaskCanceled":      {},
+	"RespondActivityTaskCanceledById":  {},
+	"RecordActivityTaskHeartbeat":      {},
+	"RecordActivityTaskHeartbeatById":  {},
+	"ResetStickyTaskQueue":             {},
+}
+
 func IsReadOnlyNamespaceAPI(api string) bool {
 	_, found := readOnlyNamespaceAPI[api]
 	return found
@@ -55,3 +83,27 @@ func IsReadOnlyGlobalAPI(api string) bool {
 	_, found := readOnlyGlobalAPI[api]
 	return found
 }
+
+// frontendAPI contains names of all APIs served by frontend
+var frontendAPI = func() map[string]struct{} {
+	result := make(map[string]struct{})
+	for _, server := range []reflect.Type{
+		reflect.TypeOf((*workflowservice.WorkflowServiceServer)(nil)).Elem(),
+		reflect.TypeOf((*adminservice.AdminServiceServer)(nil)).Elem(),
+	} {
+		for i := 0; i < server.NumMethod(); i++ {
+			result[server.Method(i).Name] = struct{}{}
+		}
+	}
+	return result
+}()
+
+func IsWorkerAPI(api string) bool {
+	_, found := workerAPI[api]
+	return found
+}
+
+func IsFrontendAPI(api string) bool {
+	_, found := frontendAPI[api]
+	return found
+}
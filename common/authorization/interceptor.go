@@ -28,6 +28,8 @@ import (
 	"context"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"math/rand"
+	"time"
 
 	"go.temporal.io/api/serviceerror"
 	"google.golang.org/grpc"
@@ -71,6 +73,7 @@ func (a *interceptor) Interceptor(
 ) (interface{}, error) {
 
 	var claims *Claims
+	claimsSource := ClaimsSourceNone
 
 	if a.claimMapper != nil && a.authorizer != nil {
 		var tlsSubject *pkix.Name
@@ -118,6 +121,9 @@ func (a *interceptor) Interceptor(
 			ctx = context.WithValue(ctx, MappedClaims, mappedClaims)
 			if authHeader != "" {
 				ctx = context.WithValue(ctx, AuthHeader, authHeader)
+				claimsSource = ClaimsSourceAuthToken
+			} else {
+				claimsSource = ClaimsSourceTLS
 			}
 		}
 	}
@@ -130,11 +136,16 @@ func (a *interceptor) Interceptor(
 		}
 
 		scope := a.getMetricsScope(metrics.AuthorizationScope, namespace)
-		result, err := a.authorize(ctx, claims, &CallTarget{
-			Namespace: namespace,
-			APIName:   info.FullMethod,
-			Request:   req,
-		}, scope)
+		target := &CallTarget{
+			Namespace:            namespace,
+			APIName:              info.FullMethod,
+			Request:              req,
+			workflowTypeResolver: a.workflowTypeResolver,
+		}
+		result, err := a.authorize(ctx, claims, target, scope)
+		if a.auditSink != nil {
+			a.audit(ctx, claims, claimsSource, target, result, err)
+		}
 		if err != nil {
 			scope.IncCounter(metrics.ServiceErrAuthorizeFailedCounter)
 			a.logAuthError(err)
@@ -158,6 +169,46 @@ func (a *interceptor) authorize(ctx context.Context, claims *Claims, callTarget
 	return a.authorizer.Authorize(ctx, claims, callTarget)
 }
 
+// audit records the authorization decision. Allowed calls of read-only and worker APIs are sampled.
+func (a *interceptor) audit(
+	ctx context.Context,
+	claims *Claims,
+	claimsSource string,
+	target *CallTarget,
+	result Result,
+	authErr error,
+) {
+	if authErr == nil && result.Decision == DecisionAllow {
+		api := ApiName(target.APIName)
+		if IsReadOnlyNamespaceAPI(api) || IsReadOnlyGlobalAPI(api) || IsWorkerAPI(api) {
+			if a.auditReadSampleRate == nil || rand.Float64() >= a.auditReadSampleRate() {
+				return
+			}
+		}
+	}
+
+	record := &AuditRecord{
+		Time:         time.Now().UTC(),
+		ClaimsSource: claimsSource,
+		APIName:      target.APIName,
+		Namespace:    target.Namespace,
+		Decision:     result.Decision.String(),
+		Reason:       result.Reason,
+	}
+	if authErr != nil {
+		record.Decision = DecisionDeny.String()
+		record.Reason = authErr.Error()
+	}
+	if claims != nil {
+		record.Subject = claims.Subject
+	}
+	record.WorkflowID, record.RunID = targetExecution(target.Request)
+
+	if err := a.auditSink.Write(ctx, record); err != nil {
+		a.logger.Error("Unable to write authorization audit record", tag.Error(err))
+	}
+}
+
 func (a *interceptor) logAuthError(err error) {
 	a.logger.Error("Authorization error", tag.Error(err))
 }
@@ -168,6 +219,11 @@ type interceptor struct {
 	metricsClient  metrics.Client
 	logger         log.Logger
 	audienceGetter JWTAudienceMapper
+	// workflowTypeResolver is optional, workflow types of existing workflows are unknown to authorizer without it
+	workflowTypeResolver WorkflowTypeResolver
+	// auditSink is optional, authorization decisions are not recorded without it
+	auditSink           AuditSink
+	auditReadSampleRate func() float64
 }
 
 // NewAuthorizationInterceptor creates an authorization interceptor and return a func that points to its Interceptor method
@@ -177,13 +233,19 @@ func NewAuthorizationInterceptor(
 	metrics metrics.Client,
 	logger log.Logger,
 	audienceGetter JWTAudienceMapper,
+	workflowTypeResolver WorkflowTypeResolver,
+	auditSink AuditSink,
+	auditReadSampleRate func() float64,
 ) grpc.UnaryServerInterceptor {
 	return (&interceptor{
-		claimMapper:    claimMapper,
-		authorizer:     authorizer,
-		metricsClient:  metrics,
-		logger:         logger,
-		audienceGetter: audienceGetter,
+		claimMapper:          claimMapper,
+		authorizer:           authorizer,
+		metricsClient:        metrics,
+		logger:               logger,
+		audienceGetter:       audienceGetter,
+		workflowTypeResolver: workflowTypeResolver,
+		auditSink:            auditSink,
+		auditReadSampleRate:  auditReadSampleRate,
 	}).Interceptor
 }
 
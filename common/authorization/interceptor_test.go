@@ -31,6 +31,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/api/workflowservicemock/v1"
 	"google.golang.org/grpc"
@@ -94,6 +95,9 @@ func (s *authorizerInterceptorSuite) SetupTest() {
 		s.mockAuthorizer,
 		s.mockMetricsClient,
 		log.NewNoopLogger(),
+		nil,
+		nil,
+		nil,
 		nil)
 	s.handler = func(ctx context.Context, req interface{}) (interface{}, error) { return true, nil }
 }
@@ -139,3 +143,61 @@ func (s *authorizerInterceptorSuite) TestAuthorizationFailed() {
 	s.Nil(res)
 	s.Error(err)
 }
+
+func (s *authorizerInterceptorSuite) TestAuditLog() {
+	mockAuditSink := NewMockAuditSink(s.controller)
+	sampleRate := 0.0
+	interceptor := NewAuthorizationInterceptor(
+		s.mockClaimMapper,
+		s.mockAuthorizer,
+		s.mockMetricsClient,
+		log.NewNoopLogger(),
+		nil,
+		nil,
+		mockAuditSink,
+		func() float64 { return sampleRate },
+	)
+	s.mockMetricsClient.EXPECT().Scope(metrics.AuthorizationScope).Return(s.mockMetricsScope).AnyTimes()
+	s.mockMetricsScope.EXPECT().Tagged(metrics.NamespaceTag(testNamespace)).Return(s.mockMetricsScope).AnyTimes()
+	s.mockMetricsScope.EXPECT().StartTimer(metrics.ServiceAuthorizationLatency).Return(s.mockStopwatch).AnyTimes()
+
+	terminateRequest := &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace:         testNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+	}
+	terminateInfo := &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution"}
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, gomock.Any()).Return(Result{Decision: DecisionAllow}, nil)
+	mockAuditSink.EXPECT().Write(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, record *AuditRecord) error {
+		s.Equal(ClaimsSourceNone, record.ClaimsSource)
+		s.Equal(terminateInfo.FullMethod, record.APIName)
+		s.Equal(testNamespace, record.Namespace)
+		s.Equal("wid", record.WorkflowID)
+		s.Equal("rid", record.RunID)
+		s.Equal("allow", record.Decision)
+		return nil
+	})
+	_, err := interceptor(ctx, terminateRequest, terminateInfo, s.handler)
+	s.NoError(err)
+
+	// allowed reads are not recorded with zero sample rate
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, describeNamespaceTarget).Return(Result{Decision: DecisionAllow}, nil)
+	_, err = interceptor(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
+	s.NoError(err)
+
+	// denied reads are always recorded
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, describeNamespaceTarget).Return(Result{Decision: DecisionDeny, Reason: "no"}, nil)
+	s.mockMetricsScope.EXPECT().IncCounter(metrics.ServiceErrUnauthorizedCounter)
+	mockAuditSink.EXPECT().Write(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, record *AuditRecord) error {
+		s.Equal("deny", record.Decision)
+		s.Equal("no", record.Reason)
+		return nil
+	})
+	_, err = interceptor(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
+	s.Error(err)
+
+	sampleRate = 1.0
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, describeNamespaceTarget).Return(Result{Decision: DecisionAllow}, nil)
+	mockAuditSink.EXPECT().Write(ctx, gomock.Any()).Return(nil)
+	_, err = interceptor(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
+	s.NoError(err)
+}
@@ -0,0 +1,181 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	policyEffectAllow = "allow"
+	policyEffectDeny  = "deny"
+	policyWildcard    = "*"
+)
+
+type (
+	// policy is the compiled form of config.AuthorizationPolicy
+	policy struct {
+		roles             map[string][]*policyRule
+		namespacePatterns bool
+	}
+
+	policyRule struct {
+		deny          bool
+		apis          map[string]struct{}
+		namespaces    []string
+		workflowTypes []string
+	}
+)
+
+// LoadPolicyFile reads an authorization policy from a yaml file
+func LoadPolicyFile(filepath string) (*config.AuthorizationPolicy, error) {
+	data, err := ioutil.ReadFile(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read authorization policy file %s: %w", filepath, err)
+	}
+	var result config.AuthorizationPolicy
+	if err := yaml.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("unable to decode authorization policy file %s: %w", filepath, err)
+	}
+	return &result, nil
+}
+
+func newPolicy(cfg *config.AuthorizationPolicy) (*policy, error) {
+	result := &policy{
+		roles:             make(map[string][]*policyRule, len(cfg.Roles)),
+		namespacePatterns: cfg.NamespacePatterns,
+	}
+	for name, role := range cfg.Roles {
+		rules := make([]*policyRule, 0, len(role.Rules))
+		for i, rule := range role.Rules {
+			compiled, err := newPolicyRule(rule)
+			if err != nil {
+				return nil, fmt.Errorf("role %s rule %d: %w", name, i, err)
+			}
+			rules = append(rules, compiled)
+		}
+		result.roles[strings.ToLower(name)] = rules
+	}
+	return result, nil
+}
+
+func newPolicyRule(rule config.AuthorizationRule) (*policyRule, error) {
+	result := &policyRule{
+		apis:          make(map[string]struct{}, len(rule.APIs)),
+		namespaces:    rule.Namespaces,
+		workflowTypes: rule.WorkflowTypes,
+	}
+	switch strings.ToLower(rule.Effect) {
+	case "", policyEffectAllow:
+	case policyEffectDeny:
+		result.deny = true
+	default:
+		return nil, fmt.Errorf("unknown effect %q", rule.Effect)
+	}
+	if len(rule.APIs) == 0 {
+		return nil, fmt.Errorf("no APIs")
+	}
+	for _, api := range rule.APIs {
+		if api != policyWildcard && !IsFrontendAPI(api) {
+			return nil, fmt.Errorf("unknown API %s", api)
+		}
+		result.apis[api] = struct{}{}
+	}
+	for _, pattern := range append(rule.Namespaces, rule.WorkflowTypes...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+	return result, nil
+}
+
+// authorize evaluates rules of the custom roles for the call. The result is a deny if any deny rule
+// applies to the call, an allow if any allow rule applies and has no decision if no rule applies.
+func (p *policy) authorize(ctx context.Context, roles []string, target *CallTarget, api string) (Result, error) {
+	var result Result
+	var workflowType *string
+	for _, role := range roles {
+		for _, rule := range p.roles[role] {
+			if !rule.matchesAPI(api) || !matchAnyPattern(rule.namespaces, target.Namespace) {
+				continue
+			}
+			if len(rule.workflowTypes) > 0 {
+				if workflowType == nil {
+					wt, err := target.WorkflowType(ctx)
+					if err != nil {
+						return Result{}, err
+					}
+					workflowType = &wt
+				}
+				if *workflowType == "" || !matchAnyPattern(rule.workflowTypes, *workflowType) {
+					continue
+				}
+			}
+			if rule.deny {
+				return Result{
+					Decision: DecisionDeny,
+					Reason:   fmt.Sprintf("%s is denied by role %s", api, role),
+				}, nil
+			}
+			result = resultAllow
+		}
+	}
+	return result, nil
+}
+
+func (r *policyRule) matchesAPI(api string) bool {
+	if _, ok := r.apis[policyWildcard]; ok {
+		return true
+	}
+	_, ok := r.apis[api]
+	return ok
+}
+
+// matchAnyPattern returns true if the patterns are empty or any of them matches the name
+func matchAnyPattern(patterns []string, name string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if matchPattern(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPattern matches the name against a case insensitive pattern which may contain "*" wildcards
+func matchPattern(pattern string, name string) bool {
+	matched, err := path.Match(strings.ToLower(pattern), strings.ToLower(name))
+	return err == nil && matched
+}
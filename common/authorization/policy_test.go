@@ -0,0 +1,218 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	apiTerminateWorkflowExecution = "/temporal.api.workflowservice.v1.WorkflowService/TerminateWorkflowExecution"
+	apiSignalWorkflowExecution    = "/temporal.api.workflowservice.v1.WorkflowService/SignalWorkflowExecution"
+	apiStartWorkflowExecution     = "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"
+	apiDescribeWorkflowExecution  = "/temporal.api.workflowservice.v1.WorkflowService/DescribeWorkflowExecution"
+)
+
+var testPolicy = &config.AuthorizationPolicy{
+	Roles: map[string]config.AuthorizationRole{
+		"oncall": {
+			Rules: []config.AuthorizationRule{
+				{APIs: []string{"TerminateWorkflowExecution", "DescribeWorkflowExecution"}},
+			},
+		},
+		"payments-operator": {
+			Rules: []config.AuthorizationRule{
+				{
+					APIs:          []string{"SignalWorkflowExecution", "StartWorkflowExecution"},
+					WorkflowTypes: []string{"payments-*"},
+				},
+			},
+		},
+		"no-terminate": {
+			Rules: []config.AuthorizationRule{
+				{Effect: "deny", APIs: []string{"TerminateWorkflowExecution"}, Namespaces: []string{"prod-*"}},
+			},
+		},
+	},
+}
+
+type (
+	policySuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller   *gomock.Controller
+		mockResolver *MockWorkflowTypeResolver
+		authorizer   Authorizer
+	}
+)
+
+func TestPolicySuite(t *testing.T) {
+	s := new(policySuite)
+	suite.Run(t, s)
+}
+
+func (s *policySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockResolver = NewMockWorkflowTypeResolver(s.controller)
+
+	var err error
+	s.authorizer, err = NewDefaultAuthorizerWithPolicy(testPolicy)
+	s.NoError(err)
+}
+
+func (s *policySuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *policySuite) TestInvalidPolicy() {
+	testCases := []config.AuthorizationRule{
+		{APIs: []string{"NoSuchAPI"}},
+		{Effect: "maybe", APIs: []string{"*"}},
+		{},
+		{APIs: []string{"*"}, Namespaces: []string{"[prod"}},
+	}
+	for _, rule := range testCases {
+		_, err := NewDefaultAuthorizerWithPolicy(&config.AuthorizationPolicy{
+			Roles: map[string]config.AuthorizationRole{"role": {Rules: []config.AuthorizationRule{rule}}},
+		})
+		s.Error(err)
+	}
+}
+
+func (s *policySuite) TestCustomRoleWithNamespacePattern() {
+	claims := &Claims{CustomRoles: map[string][]string{"prod-*": {"oncall"}}}
+
+	s.authorize(claims, &CallTarget{APIName: apiTerminateWorkflowExecution, Namespace: "prod-payments"}, DecisionAllow)
+	s.authorize(claims, &CallTarget{APIName: apiDescribeWorkflowExecution, Namespace: "PROD-payments"}, DecisionAllow)
+	s.authorize(claims, &CallTarget{APIName: apiSignalWorkflowExecution, Namespace: "prod-payments"}, DecisionDeny)
+	s.authorize(claims, &CallTarget{APIName: apiTerminateWorkflowExecution, Namespace: "staging-payments"}, DecisionDeny)
+}
+
+func (s *policySuite) TestCustomRoleWithWorkflowTypes() {
+	claims := &Claims{CustomRoles: map[string][]string{"*": {"payments-operator"}}}
+	execution := &commonpb.WorkflowExecution{WorkflowId: "wid"}
+
+	s.mockResolver.EXPECT().GetWorkflowType(gomock.Any(), "default", execution).Return("payments-refund", nil)
+	s.authorize(claims, &CallTarget{
+		APIName:              apiSignalWorkflowExecution,
+		Namespace:            "default",
+		Request:              &workflowservice.SignalWorkflowExecutionRequest{Namespace: "default", WorkflowExecution: execution},
+		workflowTypeResolver: s.mockResolver,
+	}, DecisionAllow)
+
+	s.mockResolver.EXPECT().GetWorkflowType(gomock.Any(), "default", execution).Return("billing", nil)
+	s.authorize(claims, &CallTarget{
+		APIName:              apiSignalWorkflowExecution,
+		Namespace:            "default",
+		Request:              &workflowservice.SignalWorkflowExecutionRequest{Namespace: "default", WorkflowExecution: execution},
+		workflowTypeResolver: s.mockResolver,
+	}, DecisionDeny)
+
+	s.authorize(claims, &CallTarget{
+		APIName:   apiStartWorkflowExecution,
+		Namespace: "default",
+		Request: &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:    "default",
+			WorkflowType: &commonpb.WorkflowType{Name: "payments-charge"},
+		},
+	}, DecisionAllow)
+}
+
+func (s *policySuite) TestDenyRuleOverridesStandardRoles() {
+	claims := &Claims{
+		System:      RoleAdmin,
+		CustomRoles: map[string][]string{"*": {"no-terminate"}},
+	}
+
+	result, err := s.authorizer.Authorize(context.Background(), claims,
+		&CallTarget{APIName: apiTerminateWorkflowExecution, Namespace: "prod-payments"})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	s.NotEmpty(result.Reason)
+
+	s.authorize(claims, &CallTarget{APIName: apiTerminateWorkflowExecution, Namespace: "staging-payments"}, DecisionAllow)
+	s.authorize(claims, &CallTarget{APIName: apiSignalWorkflowExecution, Namespace: "prod-payments"}, DecisionAllow)
+}
+
+func (s *policySuite) TestNamespacePatternForStandardRoles() {
+	authorizer, err := NewDefaultAuthorizerWithPolicy(&config.AuthorizationPolicy{NamespacePatterns: true})
+	s.NoError(err)
+	s.authorizer = authorizer
+	claims := &Claims{Namespaces: map[string]Role{"prod-*": RoleReader}}
+
+	s.authorize(claims, &CallTarget{APIName: apiDescribeWorkflowExecution, Namespace: "prod-payments"}, DecisionAllow)
+	s.authorize(claims, &CallTarget{APIName: apiTerminateWorkflowExecution, Namespace: "prod-payments"}, DecisionDeny)
+	s.authorize(claims, &CallTarget{APIName: apiDescribeWorkflowExecution, Namespace: "staging-payments"}, DecisionDeny)
+}
+
+func (s *policySuite) TestNamespacePatternForStandardRolesDisabled() {
+	claims := &Claims{Namespaces: map[string]Role{"prod-*": RoleWriter, "*": RoleWriter}}
+
+	s.authorize(claims, &CallTarget{APIName: apiDescribeWorkflowExecution, Namespace: "prod-payments"}, DecisionDeny)
+	s.authorize(claims, &CallTarget{APIName: apiTerminateWorkflowExecution, Namespace: "staging-payments"}, DecisionDeny)
+
+	defaultAuthorizer := NewDefaultAuthorizer()
+	result, err := defaultAuthorizer.Authorize(context.Background(), claims,
+		&CallTarget{APIName: apiDescribeWorkflowExecution, Namespace: "prod-payments"})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *policySuite) TestGetAuthorizerFromConfigWithPolicyFile() {
+	policyFile := filepath.Join(s.T().TempDir(), "policy.yaml")
+	s.NoError(os.WriteFile(policyFile, []byte(`
+roles:
+  oncall:
+    rules:
+      - apis: [TerminateWorkflowExecution]
+        namespaces: ["prod-*"]
+`), 0644))
+
+	authorizer, err := GetAuthorizerFromConfig(&config.Authorization{Authorizer: "default", PolicyFile: policyFile})
+	s.NoError(err)
+	s.NotNil(authorizer.(*defaultAuthorizer).policy)
+
+	_, err = GetAuthorizerFromConfig(&config.Authorization{Authorizer: "default", PolicyFile: policyFile + ".missing"})
+	s.Error(err)
+}
+
+func (s *policySuite) authorize(claims *Claims, target *CallTarget, expected Decision) {
+	result, err := s.authorizer.Authorize(context.Background(), claims, target)
+	s.NoError(err)
+	s.Equal(expected, result.Decision, "%v %v", target.APIName, target.Namespace)
+}
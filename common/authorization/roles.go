@@ -24,6 +24,10 @@
 
 package authorization
 
+import (
+	"strings"
+)
+
 type Role int16
 
 // @@@SNIPSTART temporal-common-authorization-role-enum
@@ -51,10 +55,41 @@ type Claims struct {
 	Subject string
 	// Role within the context of the whole Temporal cluster or a multi-cluster setup
 	System Role
-	// Roles within specific namespaces
+	// Roles within specific namespaces. Keys may be patterns with "*" wildcards, e.g. "prod-*",
+	// if the authorization policy enables namespace patterns.
 	Namespaces map[string]Role
+	// Custom roles defined by the authorization policy, by namespace name or pattern.
+	// Custom roles granted at the system level are stored under "*".
+	CustomRoles map[string][]string
 	// Free form bucket for extra data
 	Extensions interface{}
 }
 
 // @@@SNIPEND
+
+// namespaceRole returns the roles within the namespace granted for the namespace name
+// and, if patterns is true, for patterns matching it
+func (c *Claims) namespaceRole(namespace string, patterns bool) Role {
+	namespace = strings.ToLower(namespace)
+	role := c.Namespaces[namespace]
+	if !patterns {
+		return role
+	}
+	for pattern, patternRole := range c.Namespaces {
+		if strings.Contains(pattern, policyWildcard) && matchPattern(pattern, namespace) {
+			role |= patternRole
+		}
+	}
+	return role
+}
+
+// customRoles returns the names of custom roles granted within the namespace
+func (c *Claims) customRoles(namespace string) []string {
+	var result []string
+	for pattern, roles := range c.CustomRoles {
+		if matchPattern(pattern, namespace) {
+			result = append(result, roles...)
+		}
+	}
+	return result
+}
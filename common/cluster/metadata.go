@@ -99,6 +99,8 @@ type (
 		InitialFailoverVersion int64 `yaml:"initialFailoverVersion"`
 		// Address indicate the remote service address(Host:Port). Host can be DNS name.
 		RPCAddress string `yaml:"rpcAddress"`
+		// ShardCount is the number of history shards of the cluster. Zero if unknown.
+		ShardCount int32 `yaml:"-"`
 		// private field to track cluster infomation updates
 		version int64
 	}
@@ -347,6 +349,7 @@ func (m *metadataImpl) RegisterMetadataChangeCallback(callbackId string, cb Call
 			Enabled:                clusterInfo.Enabled,
 			InitialFailoverVersion: clusterInfo.InitialFailoverVersion,
 			RPCAddress:             clusterInfo.RPCAddress,
+			ShardCount:             clusterInfo.ShardCount,
 			version:                clusterInfo.version,
 		}
 	}
@@ -400,12 +403,14 @@ func (m *metadataImpl) refreshClusterMetadata(_ context.Context) error {
 				Enabled:                newClusterInfo.Enabled,
 				InitialFailoverVersion: newClusterInfo.InitialFailoverVersion,
 				RPCAddress:             newClusterInfo.RPCAddress,
+				ShardCount:             newClusterInfo.ShardCount,
 				version:                newClusterInfo.version,
 			}
 		} else if newClusterInfo.version > oldClusterInfo.version {
 			if newClusterInfo.Enabled == oldClusterInfo.Enabled &&
 				newClusterInfo.RPCAddress == oldClusterInfo.RPCAddress &&
-				newClusterInfo.InitialFailoverVersion == oldClusterInfo.InitialFailoverVersion {
+				newClusterInfo.InitialFailoverVersion == oldClusterInfo.InitialFailoverVersion &&
+				newClusterInfo.ShardCount == oldClusterInfo.ShardCount {
 				// key cluster info does not change
 				continue
 			}
@@ -414,12 +419,14 @@ func (m *metadataImpl) refreshClusterMetadata(_ context.Context) error {
 				Enabled:                oldClusterInfo.Enabled,
 				InitialFailoverVersion: oldClusterInfo.InitialFailoverVersion,
 				RPCAddress:             oldClusterInfo.RPCAddress,
+				ShardCount:             oldClusterInfo.ShardCount,
 				version:                oldClusterInfo.version,
 			}
 			newEntries[clusterName] = &ClusterInformation{
 				Enabled:                newClusterInfo.Enabled,
 				InitialFailoverVersion: newClusterInfo.InitialFailoverVersion,
 				RPCAddress:             newClusterInfo.RPCAddress,
+				ShardCount:             newClusterInfo.ShardCount,
 				version:                newClusterInfo.version,
 			}
 		}
@@ -520,6 +527,7 @@ func (m *metadataImpl) listAllClusterMetadataFromDB() (map[string]*ClusterInform
 			Enabled:                getClusterResp.GetIsConnectionEnabled(),
 			InitialFailoverVersion: getClusterResp.GetInitialFailoverVersion(),
 			RPCAddress:             getClusterResp.GetClusterAddress(),
+			ShardCount:             getClusterResp.GetHistoryShardCount(),
 			version:                getClusterResp.Version,
 		}
 	}
@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common/masker"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/tracing"
 )
 
 type (
@@ -62,6 +63,9 @@ type (
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
 		// Filepath should be relative to the root directory
 		DynamicConfigClient *dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// PersistenceDynamicConfigClient enables dynamic config values stored in persistence and managed
+		// with the admin APIs. Keys without a stored value fall back to the file based client.
+		PersistenceDynamicConfigClient *dynamicconfig.PersistenceBasedClientConfig `yaml:"persistenceDynamicConfigClient"`
 		// NamespaceDefaults is the default config for every namespace
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
 	}
@@ -106,6 +110,8 @@ type (
 		Metrics *metrics.Config `yaml:"metrics"`
 		// Settings for authentication and authorization
 		Authorization Authorization `yaml:"authorization"`
+		// Tracing is the distributed tracing configuration
+		Tracing *tracing.Config `yaml:"tracing"`
 	}
 
 	// RootTLS contains all TLS settings for the Temporal server
@@ -216,6 +222,28 @@ type (
 		// This is generally used when BindOnIP would be the same across several nodes (ie: 0.0.0.0)
 		// and for nat traversal scenarios. Check net.ParseIP for supported syntax, only IPv4 is supported.
 		BroadcastAddress string `yaml:"broadcastAddress"`
+		// Provider selects the membership implementation: ringpop (default), static or dns
+		Provider string `yaml:"provider"`
+		// Static is the configuration of the static host list membership provider
+		Static StaticMembership `yaml:"static"`
+		// DNS is the configuration of the DNS polling membership provider
+		DNS DNSMembership `yaml:"dns"`
+	}
+
+	// StaticMembership contains the fixed list of members of each service
+	StaticMembership struct {
+		// Hosts is a map of service name to the host:port gRPC addresses of its members
+		Hosts map[string][]string `yaml:"hosts"`
+	}
+
+	// DNSMembership contains the DNS records which are polled to discover the members of each service
+	DNSMembership struct {
+		// Records is a map of service name to DNS name. Names with a leading underscore
+		// (eg. `_grpc._tcp.history.temporal.svc.cluster.local`) are resolved as SRV records,
+		// any other name is resolved to its addresses and combined with the service gRPC port.
+		Records map[string]string `yaml:"records"`
+		// RefreshInterval is the interval at which the records are resolved again
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
 	}
 
 	// Persistence contains the configuration for data store / persistence layer
@@ -227,7 +255,9 @@ type (
 		// AdvancedVisibilityStore is the name of the datastore to be used for visibility records
 		AdvancedVisibilityStore string `yaml:"advancedVisibilityStore"`
 		// NumHistoryShards is the desired number of history shards. This config doesn't
-		// belong here, needs refactoring
+		// belong here, needs refactoring. It cannot be changed once the cluster is created,
+		// instead namespaces can be migrated to a new cluster whose shard count is a multiple
+		// of this one with `tctl admin cluster migrate-shard-count`.
 		NumHistoryShards int32 `yaml:"numHistoryShards" validate:"nonzero"`
 		// DataStores contains the configuration for all datastores
 		DataStores map[string]DataStore `yaml:"datastores"`
@@ -452,6 +482,88 @@ type (
 		Authorizer string `yaml:"authorizer"`
 		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
 		ClaimMapper string `yaml:"claimMapper"`
+		// Policy defines custom roles for defaultAuthorizer
+		Policy *AuthorizationPolicy `yaml:"policy"`
+		// PolicyFile is the path of a yaml file with the policy for defaultAuthorizer.
+		// It is used when Policy is not set.
+		PolicyFile string `yaml:"policyFile"`
+		// AuditLog is the config of the authorization audit log
+		AuditLog AuthorizationAuditLog `yaml:"auditLog"`
+		// Issuers are JWT issuers trusted by defaultJWTClaimMapper. If set, tokens are only accepted
+		// from these issuers and JWTKeyProvider is not used.
+		Issuers []JWTIssuer `yaml:"issuers"`
+	}
+
+	// JWTIssuer contains the config for validating JWT tokens of an issuer and mapping their claims
+	JWTIssuer struct {
+		// Issuer is the expected value of the "iss" claim
+		Issuer string `yaml:"issuer"`
+		// DiscoveryURL is the URL of the OIDC discovery document of the issuer.
+		// Defaults to Issuer + "/.well-known/openid-configuration" if KeySourceURIs are not set.
+		DiscoveryURL string `yaml:"discoveryURL"`
+		// KeySourceURIs are JWKS URIs of the issuer which are used instead of OIDC discovery
+		KeySourceURIs []string `yaml:"keySourceURIs"`
+		// RefreshInterval is the interval of refreshing signing keys of the issuer
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// Audience is the expected value of the "aud" claim. If empty, the audience from JWTAudienceMapper is used.
+		Audience string `yaml:"audience"`
+		// PermissionsClaimName is the name of the claim with permissions in "namespace:role" format.
+		// Defaults to Authorization.PermissionsClaimName.
+		PermissionsClaimName string `yaml:"permissionsClaimName"`
+		// ClockSkew is the allowed clock skew for validating "exp" and "nbf" claims
+		ClockSkew time.Duration `yaml:"clockSkew"`
+		// ClaimMappings map other claims of the token to permissions
+		ClaimMappings []JWTClaimMapping `yaml:"claimMappings"`
+	}
+
+	// JWTClaimMapping grants permissions to subjects with a claim matching an expression
+	JWTClaimMapping struct {
+		// Claim is the name of a claim with a string or a list of strings, e.g. "groups".
+		// Nested claims are separated by dots, e.g. "realm_access.roles".
+		Claim string `yaml:"claim"`
+		// Match is a regular expression which must match the whole claim value, e.g. "temporal-(.+)-writers"
+		Match string `yaml:"match"`
+		// Permissions are granted when a claim value matches, in "namespace:role" format.
+		// They may refer to submatches of Match, e.g. "$1:write".
+		Permissions []string `yaml:"permissions"`
+	}
+
+	// AuthorizationAuditLog contains the config for recording authorization decisions
+	AuthorizationAuditLog struct {
+		// Filepath is the path of the file authorization decisions are appended to as JSON lines.
+		// Audit log is disabled if it is empty.
+		Filepath string `yaml:"filepath"`
+	}
+
+	// AuthorizationPolicy maps custom roles granted by claims to the APIs they allow or deny
+	AuthorizationPolicy struct {
+		// Roles are custom roles by name
+		Roles map[string]AuthorizationRole `yaml:"roles"`
+		// NamespacePatterns enables standard roles granted for namespace patterns with "*" wildcards,
+		// e.g. "prod-*:writer". It is off by default because "*" in namespaces of tokens issued
+		// before is not a wildcard.
+		NamespacePatterns bool `yaml:"namespacePatterns"`
+	}
+
+	// AuthorizationRole is a custom role defined by a set of rules
+	AuthorizationRole struct {
+		Rules []AuthorizationRule `yaml:"rules"`
+	}
+
+	// AuthorizationRule allows or denies calls to a set of APIs
+	AuthorizationRule struct {
+		// Effect is either "allow" or "deny", empty string means "allow".
+		// Deny rules take precedence over allow rules and over the standard roles of the subject.
+		Effect string `yaml:"effect"`
+		// APIs are names of the APIs the rule applies to, such as "TerminateWorkflowExecution".
+		// "*" matches any API.
+		APIs []string `yaml:"apis"`
+		// Namespaces restricts the rule to the namespaces matching these patterns, e.g. "prod-*".
+		// Empty means any namespace the role is granted on.
+		Namespaces []string `yaml:"namespaces"`
+		// WorkflowTypes restricts the rule to calls targeting workflows of types matching these patterns.
+		// Empty means any workflow type.
+		WorkflowTypes []string `yaml:"workflowTypes"`
 	}
 
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
@@ -473,6 +585,10 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.Global.Membership.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
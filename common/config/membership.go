@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+	"net"
+)
+
+const (
+	// MembershipProviderRingpop discovers members through the ringpop gossip protocol
+	MembershipProviderRingpop = "ringpop"
+	// MembershipProviderStatic reads members from a fixed host list
+	MembershipProviderStatic = "static"
+	// MembershipProviderDNS discovers members by periodically resolving DNS records
+	MembershipProviderDNS = "dns"
+)
+
+// Validate validates the membership config
+func (m *Membership) Validate() error {
+	switch m.Provider {
+	case "", MembershipProviderRingpop:
+		return nil
+	case MembershipProviderStatic:
+		if len(m.Static.Hosts) == 0 {
+			return fmt.Errorf("membership provider %q requires at least one host", m.Provider)
+		}
+		for service, hosts := range m.Static.Hosts {
+			for _, host := range hosts {
+				if _, _, err := net.SplitHostPort(host); err != nil {
+					return fmt.Errorf("invalid static membership host %q for service %v: %w", host, service, err)
+				}
+			}
+		}
+		return nil
+	case MembershipProviderDNS:
+		if len(m.DNS.Records) == 0 {
+			return fmt.Errorf("membership provider %q requires at least one record", m.Provider)
+		}
+		if m.DNS.RefreshInterval < 0 {
+			return fmt.Errorf("invalid dns membership refresh interval: %v", m.DNS.RefreshInterval)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown membership provider: %q", m.Provider)
+	}
+}
@@ -0,0 +1,57 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMembershipValidate(t *testing.T) {
+	var cfg Membership
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Provider = MembershipProviderRingpop
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Provider = MembershipProviderStatic
+	assert.Error(t, cfg.Validate())
+	cfg.Static.Hosts = map[string][]string{"history": {"10.0.0.1"}}
+	assert.Error(t, cfg.Validate())
+	cfg.Static.Hosts = map[string][]string{"history": {"10.0.0.1:7234"}}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Provider = MembershipProviderDNS
+	assert.Error(t, cfg.Validate())
+	cfg.DNS.Records = map[string]string{"history": "_grpc._tcp.history"}
+	assert.NoError(t, cfg.Validate())
+	cfg.DNS.RefreshInterval = -time.Second
+	assert.Error(t, cfg.Validate())
+
+	cfg.Provider = "consul"
+	assert.Error(t, cfg.Validate())
+}
@@ -81,6 +81,14 @@ func (c *Persistence) AdvancedVisibilityConfigExist() bool {
 	return c.AdvancedVisibilityStore != ""
 }
 
+// AdvancedVisibilitySQLConfigExist returns whether advancedVisibilityStore points to a SQL datastore
+func (c *Persistence) AdvancedVisibilitySQLConfigExist() bool {
+	if !c.AdvancedVisibilityConfigExist() {
+		return false
+	}
+	return c.DataStores[c.AdvancedVisibilityStore].SQL != nil
+}
+
 func (c *Persistence) validateAdvancedVisibility() error {
 	if !c.StandardVisibilityConfigExist() && !c.AdvancedVisibilityConfigExist() {
 		return errors.New("persistence config: one of visibilityStore or advancedVisibilityStore must be specified")
@@ -95,6 +103,16 @@ func (c *Persistence) validateAdvancedVisibility() error {
 		return fmt.Errorf("persistence config: advanced visibility datastore %q: missing config", c.AdvancedVisibilityStore)
 	}
 
+	if advancedVisibilityDataStore.SQL != nil {
+		if advancedVisibilityDataStore.Elasticsearch != nil {
+			return fmt.Errorf("persistence config: advanced visibility datastore %q: must provide config for one and only one of \"sql\" or \"elasticsearch\"", c.AdvancedVisibilityStore)
+		}
+		if err := advancedVisibilityDataStore.Validate(); err != nil {
+			return fmt.Errorf("persistence config: advanced visibility datastore %q: %s", c.AdvancedVisibilityStore, err.Error())
+		}
+		return nil
+	}
+
 	if err := advancedVisibilityDataStore.Elasticsearch.Validate(c.AdvancedVisibilityStore); err != nil {
 		return err
 	}
@@ -29,6 +29,8 @@ import (
 	"testing"
 
 	"github.com/gocql/gocql"
+
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 )
 
 func TestCassandraStoreConsistency_GetConsistency(t *testing.T) {
@@ -230,3 +232,51 @@ func TestCassandraStoreConsistency_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestPersistence_validateAdvancedVisibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		dataStore DataStore
+		wantErr   bool
+	}{
+		{
+			name:      "sql",
+			dataStore: DataStore{SQL: &SQL{PluginName: "mysql"}},
+			wantErr:   false,
+		},
+		{
+			name: "sql and elasticsearch",
+			dataStore: DataStore{
+				SQL:           &SQL{PluginName: "mysql"},
+				Elasticsearch: &client.Config{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "sql and cassandra",
+			dataStore: DataStore{
+				SQL:       &SQL{PluginName: "mysql"},
+				Cassandra: &Cassandra{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Persistence{
+				AdvancedVisibilityStore: "advanced",
+				DataStores: map[string]DataStore{
+					"advanced": tt.dataStore,
+				},
+			}
+			if err := c.validateAdvancedVisibility(); (err != nil) != tt.wantErr {
+				t.Errorf("Persistence.validateAdvancedVisibility() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := c.AdvancedVisibilitySQLConfigExist(); got != (tt.dataStore.SQL != nil) {
+				t.Errorf("Persistence.AdvancedVisibilitySQLConfigExist() = %v", got)
+			}
+		})
+	}
+}
@@ -36,6 +36,8 @@ import (
 
 var _ Client = (*basicClient)(nil)
 
+var errKeyNotFound = errors.New("unable to find key")
+
 type configValueMap map[string][]*constrainedValue
 
 type constrainedValue struct {
@@ -192,7 +194,7 @@ func (bc *basicClient) getValueWithFilters(
 		}
 	}
 	if !found {
-		return defaultValue, errors.New("unable to find key")
+		return defaultValue, errKeyNotFound
 	}
 	return defaultValue, nil
 }
@@ -92,6 +92,9 @@ type IntPropertyFnWithNamespaceFilter func(namespace string) int
 // IntPropertyFnWithTaskQueueInfoFilters is a wrapper to get int property from dynamic config with three filters: namespace, taskQueue, taskType
 type IntPropertyFnWithTaskQueueInfoFilters func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) int
 
+// IntPropertyFnWithWorkflowTypeFilter is a wrapper to get int property from dynamic config with namespace and workflowType as filters
+type IntPropertyFnWithWorkflowTypeFilter func(namespace string, workflowType string) int
+
 // IntPropertyFnWithShardIDFilter is a wrapper to get int property from dynamic config with shardID as filter
 type IntPropertyFnWithShardIDFilter func(shardID int32) int
 
@@ -222,6 +225,37 @@ func (c *Collection) GetIntPropertyFilteredByTaskQueueInfo(key Key, defaultValue
 	}
 }
 
+// GetIntPropertyFilteredByWorkflowType gets property with namespace and workflowType as filters and asserts that it's an integer
+func (c *Collection) GetIntPropertyFilteredByWorkflowType(key Key, defaultValue int) IntPropertyFnWithWorkflowTypeFilter {
+	return func(namespace string, workflowType string) int {
+		val := defaultValue
+		var err error
+
+		filterMaps := []map[Filter]interface{}{
+			getFilterMap(NamespaceFilter(namespace), WorkflowTypeFilter(workflowType)),
+			getFilterMap(NamespaceFilter(namespace)),
+		}
+
+		for _, filterMap := range filterMaps {
+			val, err = c.client.GetIntValue(
+				key,
+				filterMap,
+				defaultValue,
+			)
+			if err != nil {
+				c.logError(key, err)
+			}
+
+			if val != defaultValue {
+				break
+			}
+		}
+
+		c.logValue(key, val, defaultValue, intCompareEquals)
+		return val
+	}
+}
+
 // GetIntPropertyFilteredByShardID gets property with shardID as filter and asserts that it's an integer
 func (c *Collection) GetIntPropertyFilteredByShardID(key Key, defaultValue int) IntPropertyFnWithShardIDFilter {
 	return func(shardID int32) int {
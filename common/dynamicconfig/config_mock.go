@@ -47,6 +47,11 @@ func GetIntPropertyFilteredByTaskQueueInfo(value int) func(namespace string, tas
 	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) int { return value }
 }
 
+// GetIntPropertyFilteredByWorkflowType returns value as IntPropertyFnWithWorkflowTypeFilter
+func GetIntPropertyFilteredByWorkflowType(value int) func(namespace string, workflowType string) int {
+	return func(namespace string, workflowType string) int { return value }
+}
+
 // GetFloatPropertyFn returns value as FloatPropertyFn
 func GetFloatPropertyFn(value float64) func(opts ...FilterOption) float64 {
 	return func(...FilterOption) float64 { return value }
@@ -174,6 +174,15 @@ func (s *configSuite) TestGetIntPropertyFilteredByTaskQueueInfo() {
 	s.Equal(50, value(namespace, taskQueue, 0))
 }
 
+func (s *configSuite) TestGetIntPropertyFilteredByWorkflowType() {
+	namespace := "testNamespace"
+	workflowType := "testWorkflowType"
+	value := s.cln.GetIntPropertyFilteredByWorkflowType(testGetIntPropertyFilteredByWorkflowTypeKey, 10)
+	s.Equal(10, value(namespace, workflowType))
+	s.client.SetValue(testGetIntPropertyFilteredByWorkflowTypeKey, 50)
+	s.Equal(50, value(namespace, workflowType))
+}
+
 func (s *configSuite) TestGetFloat64Property() {
 	value := s.cln.GetFloat64Property(testGetFloat64PropertyKey, 0.1)
 	s.Equal(0.1, value())
@@ -53,6 +53,7 @@ const (
 	testGetDurationPropertyFilteredByTaskQueueInfoKey = "testGetDurationPropertyFilteredByTaskQueueInfoKey"
 	testGetBoolPropertyFilteredByNamespaceIDKey       = "testGetBoolPropertyFilteredByNamespaceIDKey"
 	testGetBoolPropertyFilteredByTaskQueueInfoKey     = "testGetBoolPropertyFilteredByTaskQueueInfoKey"
+	testGetIntPropertyFilteredByWorkflowTypeKey       = "testGetIntPropertyFilteredByWorkflowTypeKey"
 
 	// key for admin
 
@@ -128,6 +129,12 @@ const (
 	HistoryCountLimitError = "limit.historyCount.error"
 	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning
 	HistoryCountLimitWarn = "limit.historyCount.warn"
+	// HistorySizeSuggestContinueAsNew is the per workflow execution history size soft limit after which
+	// the workflow is suggested to continue-as-new
+	HistorySizeSuggestContinueAsNew = "limit.historySize.suggestContinueAsNew"
+	// HistoryCountSuggestContinueAsNew is the per workflow execution history event count soft limit after which
+	// the workflow is suggested to continue-as-new
+	HistoryCountSuggestContinueAsNew = "limit.historyCount.suggestContinueAsNew"
 	// MaxIDLengthLimit is the length limit for various IDs, including: Namespace, TaskQueue, WorkflowID, ActivityID, TimerID,
 	// WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID
 	MaxIDLengthLimit = "limit.maxIDLength"
@@ -208,6 +215,9 @@ const (
 	// KeepAliveTimeout After having pinged for keepalive check, the server waits for a duration
 	// of Timeout and if no activity is seen even after that the connection is closed.
 	KeepAliveTimeout = "frontend.keepAliveTimeout"
+	// FrontendAuthorizationAuditReadSampleRate is the fraction of allowed read-only and worker API calls
+	// recorded in the authorization audit log. Mutating API calls and denials are always recorded.
+	FrontendAuthorizationAuditReadSampleRate = "frontend.authorizationAuditReadSampleRate"
 
 	// key for matching
 
@@ -253,6 +263,14 @@ const (
 	MatchingForwarderMaxChildrenPerNode = "matching.forwarderMaxChildrenPerNode"
 	// MatchingShutdownDrainDuration is the duration of traffic drain during shutdown
 	MatchingShutdownDrainDuration = "matching.shutdownDrainDuration"
+	// MatchingVersionBuildIDLimitPerQueue is the max number of worker build IDs in the version graph of a task queue
+	MatchingVersionBuildIDLimitPerQueue = "matching.versionBuildIDLimitPerQueue"
+	// MatchingVersioningDataRefreshInterval is how often task queue partitions refresh the version graph from the root
+	// partition and re-evaluate which version set a backlog task is dispatched to
+	MatchingVersioningDataRefreshInterval = "matching.versioningDataRefreshInterval"
+	// MatchingBacklogPriorityAgingInterval is how much later a backlog task is considered created for each priority
+	// level below the highest when ordering backlog tasks for dispatch
+	MatchingBacklogPriorityAgingInterval = "matching.backlogPriorityAgingInterval"
 
 	// key for history
 
@@ -270,7 +288,9 @@ const (
 	HistoryCacheMaxSize = "history.cacheMaxSize"
 	// HistoryCacheTTL is TTL of history cache
 	HistoryCacheTTL = "history.cacheTTL"
-	// HistoryShutdownDrainDuration is the duration of traffic drain during shutdown
+	// HistoryShutdownDrainDuration is the duration of traffic drain during shutdown. Shards are flushed and
+	// handed off to their new owners only within this duration, so the graceful shutdown is opt-in: with the
+	// default of 0 the host stops right away and other hosts steal its shards
 	HistoryShutdownDrainDuration = "history.shutdownDrainDuration"
 	// EventsCacheInitialSize is initial size of events cache
 	EventsCacheInitialSize = "history.eventsCacheInitialSize"
@@ -282,6 +302,11 @@ const (
 	AcquireShardInterval = "history.acquireShardInterval"
 	// AcquireShardConcurrency is number of goroutines that can be used to acquire shards in the shard controller.
 	AcquireShardConcurrency = "history.acquireShardConcurrency"
+	// ShardLoadWindow is the length of the sampling window over which shard load is reported
+	ShardLoadWindow = "history.shardLoadWindow"
+	// ShardLoadMaxTrackedWorkflows is the max number of workflows per shard whose request counts are tracked
+	// to find the most requested workflows
+	ShardLoadMaxTrackedWorkflows = "history.shardLoadMaxTrackedWorkflows"
 	// StandbyClusterDelay is the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay = "history.standbyClusterDelay"
 	// StandbyTaskMissingEventsResendDelay is the amount of time standby cluster's will wait (if events are missing)
@@ -422,6 +447,19 @@ const (
 	MaximumBufferedEventsBatch = "history.maximumBufferedEventsBatch"
 	// MaximumSignalsPerExecution is max number of signals supported by single execution
 	MaximumSignalsPerExecution = "history.maximumSignalsPerExecution"
+	// MaxOpenWorkflowExecutionsPerNamespace is max number of open workflow executions per namespace, 0 means no limit
+	MaxOpenWorkflowExecutionsPerNamespace = "history.maxOpenWorkflowExecutionsPerNamespace"
+	// MaxOpenWorkflowExecutionsPerWorkflowType is max number of open workflow executions per workflow type
+	// within a namespace, 0 means no limit
+	MaxOpenWorkflowExecutionsPerWorkflowType = "history.maxOpenWorkflowExecutionsPerWorkflowType"
+	// OpenExecutionCountRefreshInterval is the interval at which history hosts collect open workflow execution
+	// counts from each other to enforce open workflow execution limits
+	OpenExecutionCountRefreshInterval = "history.openExecutionCountRefreshInterval"
+	// TaskSchedulerNamespaceWeight is the weight of a namespace when history task workers pick the next task to execute
+	TaskSchedulerNamespaceWeight = "history.taskSchedulerNamespaceWeight"
+	// TaskSchedulerNamespaceMaxWorkerShare is the max fraction of a host's transfer, timer and visibility task workers
+	// that can execute tasks of a single namespace at the same time
+	TaskSchedulerNamespaceMaxWorkerShare = "history.taskSchedulerNamespaceMaxWorkerShare"
 	// ShardUpdateMinInterval is the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval = "history.shardUpdateMinInterval"
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
@@ -465,6 +503,10 @@ const (
 	StandbyTaskReReplicationContextTimeout = "history.standbyTaskReReplicationContextTimeout"
 	// MaxBufferedQueryCount indicates max buffer query count
 	MaxBufferedQueryCount = "history.MaxBufferedQueryCount"
+	// MaxInFlightUpdates is the max number of updates that can be accepted but not yet completed per workflow execution
+	MaxInFlightUpdates = "history.maxInFlightUpdates"
+	// MaxCompletedUpdates is the max number of completed updates per workflow execution whose outcome is kept to deduplicate retries
+	MaxCompletedUpdates = "history.maxCompletedUpdates"
 	// MutableStateChecksumGenProbability is the probability [0-100] that checksum will be generated for mutable state
 	MutableStateChecksumGenProbability = "history.mutableStateChecksumGenProbability"
 	// MutableStateChecksumVerifyProbability is the probability [0-100] that checksum will be verified for mutable state
@@ -578,7 +620,7 @@ const (
 type Filter int
 
 func (f Filter) String() string {
-	if f <= unknownFilter || f > TaskType {
+	if f <= unknownFilter || f >= lastFilterTypeForTest {
 		return filters[unknownFilter]
 	}
 	return filters[f]
@@ -591,6 +633,7 @@ var filters = []string{
 	"taskQueueName",
 	"taskType",
 	"shardID",
+	"workflowType",
 }
 
 const (
@@ -605,6 +648,8 @@ const (
 	TaskType
 	// ShardID is the shard id
 	ShardID
+	// WorkflowType is the workflow type name
+	WorkflowType
 
 	// lastFilterTypeForTest must be the last one in this const group for testing purpose
 	lastFilterTypeForTest
@@ -643,6 +688,13 @@ func TaskTypeFilter(taskType enumspb.TaskQueueType) FilterOption {
 	}
 }
 
+// WorkflowTypeFilter filters by workflow type name
+func WorkflowTypeFilter(name string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[WorkflowType] = name
+	}
+}
+
 // ShardIDFilter filters by shard id
 func ShardIDFilter(shardID int32) FilterOption {
 	return func(filterMap map[Filter]interface{}) {
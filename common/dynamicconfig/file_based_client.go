@@ -56,12 +56,18 @@ type fileBasedClient struct {
 	*basicClient
 	lastUpdatedTime time.Time
 	config          *FileBasedClientConfig
+	validate        bool
 	doneCh          <-chan interface{}
 	logger          log.Logger
 }
 
-// NewFileBasedClient creates a file based client.
+// NewFileBasedClient creates a file based client. The config file is validated against the registered
+// keys when it is loaded, and changes which fail validation are rejected.
 func NewFileBasedClient(config *FileBasedClientConfig, logger log.Logger, doneCh <-chan interface{}) (Client, error) {
+	return newFileBasedClient(config, logger, doneCh, true)
+}
+
+func newFileBasedClient(config *FileBasedClientConfig, logger log.Logger, doneCh <-chan interface{}, validate bool) (Client, error) {
 	if err := validateConfig(config); err != nil {
 		return nil, fmt.Errorf("unable to validate dynamic config: %w", err)
 	}
@@ -69,6 +75,7 @@ func NewFileBasedClient(config *FileBasedClientConfig, logger log.Logger, doneCh
 	client := &fileBasedClient{
 		basicClient: newBasicClient(),
 		config:      config,
+		validate:    validate,
 		doneCh:      doneCh,
 		logger:      logger,
 	}
@@ -93,13 +100,21 @@ func NewFileBasedClient(config *FileBasedClientConfig, logger log.Logger, doneCh
 	return client, nil
 }
 
+// ValidateFile checks that all keys in the dynamic config file are registered and that their values
+// and constraints are valid. All problems found are returned.
+func ValidateFile(filepath string) error {
+	values, err := loadFile(filepath)
+	if err != nil {
+		return err
+	}
+	return validateConfigValues(values)
+}
+
 func (fc *fileBasedClient) update() error {
 	defer func() {
 		fc.lastUpdatedTime = time.Now().UTC()
 	}()
 
-	newValues := make(configValueMap)
-
 	info, err := os.Stat(fc.config.Filepath)
 	if err != nil {
 		return fmt.Errorf("dynamic config file: %s: %w", fc.config.Filepath, err)
@@ -108,19 +123,32 @@ func (fc *fileBasedClient) update() error {
 		return nil
 	}
 
-	confContent, err := os.ReadFile(fc.config.Filepath)
+	newValues, err := loadFile(fc.config.Filepath)
 	if err != nil {
-		return fmt.Errorf("dynamic config file: %s: %w", fc.config.Filepath, err)
+		return err
 	}
-
-	if err = yaml.Unmarshal(confContent, newValues); err != nil {
-		return fmt.Errorf("unable to decode dynamic config: %w", err)
+	if fc.validate {
+		if err := validateConfigValues(newValues); err != nil {
+			return fmt.Errorf("invalid dynamic config: %w", err)
+		}
 	}
 
-	return fc.storeValues(newValues)
+	fc.values.Store(newValues)
+	fc.logger.Info("Updated dynamic config")
+	return nil
 }
 
-func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue) error {
+func loadFile(filepath string) (configValueMap, error) {
+	confContent, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("dynamic config file: %s: %w", filepath, err)
+	}
+
+	newValues := make(map[string][]*constrainedValue)
+	if err = yaml.Unmarshal(confContent, newValues); err != nil {
+		return nil, fmt.Errorf("unable to decode dynamic config: %w", err)
+	}
+
 	formattedNewValues := make(configValueMap, len(newValues))
 
 	// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
@@ -132,15 +160,16 @@ func (fc *fileBasedClient) storeValues(newValues map[string][]*constrainedValue)
 			var err error
 			cv.Value, err = convertKeyTypeToString(cv.Value)
 			if err != nil {
-				return err
+				return nil, err
+			}
+			// shard id filter values are int32, but yaml decodes integers as int
+			if shardID, ok := cv.Constraints[ShardID.String()].(int); ok {
+				cv.Constraints[ShardID.String()] = int32(shardID)
 			}
 		}
 		formattedNewValues[strings.ToLower(key)] = valuesSlice
 	}
-
-	fc.values.Store(formattedNewValues)
-	fc.logger.Info("Updated dynamic config")
-	return nil
+	return formattedNewValues, nil
 }
 
 func validateConfig(config *FileBasedClientConfig) error {
@@ -49,10 +49,11 @@ func TestFileBasedClientSuite(t *testing.T) {
 func (s *fileBasedClientSuite) SetupSuite() {
 	var err error
 	s.doneCh = make(chan interface{})
-	s.client, err = NewFileBasedClient(&FileBasedClientConfig{
+	// testConfig.yaml has values of wrong types to test lookups, so it is not validated
+	s.client, err = newFileBasedClient(&FileBasedClientConfig{
 		Filepath:     "config/testConfig.yaml",
 		PollInterval: time.Second * 5,
-	}, log.NewNoopLogger(), s.doneCh)
+	}, log.NewNoopLogger(), s.doneCh, false)
 	s.Require().NoError(err)
 }
 
@@ -282,6 +283,20 @@ func (s *fileBasedClientSuite) TestValidateConfig_ShortPollInterval() {
 	s.Error(err)
 }
 
+func (s *fileBasedClientSuite) TestValidateConfig_InvalidValues() {
+	_, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     "config/testConfig.yaml",
+		PollInterval: time.Second * 10,
+	}, log.NewNoopLogger(), nil)
+	s.Error(err)
+	s.Error(ValidateFile("config/testConfig.yaml"))
+}
+
+func (s *fileBasedClientSuite) TestValidateFile() {
+	s.NoError(ValidateFile("../../config/dynamicconfig/development.yaml"))
+	s.NoError(ValidateFile("../../config/dynamicconfig/development_es.yaml"))
+}
+
 func (s *fileBasedClientSuite) TestMatch() {
 	testCases := []struct {
 		v       *constrainedValue
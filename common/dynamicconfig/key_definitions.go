@@ -0,0 +1,301 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+// keyDefinitions registers all keys declared in constants.go. A key has to be registered here
+// before it can be set in dynamic config.
+var keyDefinitions = []*KeyDefinition{
+	// key for admin
+	defineFloat(AdminMatchingNamespaceToPartitionDispatchRate, PrecedenceNamespace, 10000, "Max qps of any task queue partition for a given namespace"),
+	defineFloat(AdminMatchingNamespaceTaskqueueToPartitionDispatchRate, PrecedenceTaskQueue, 1000, "Max qps of a task queue partition for a given namespace & task queue"),
+
+	// key for system
+	defineInt(StandardVisibilityPersistenceMaxReadQPS, PrecedenceGlobal, 9000, "Max QPC system host can query standard visibility DB (SQL or Cassandra) for read"),
+	defineInt(StandardVisibilityPersistenceMaxWriteQPS, PrecedenceGlobal, 9000, "Max QPC system host can query standard visibility DB (SQL or Cassandra) for write"),
+	defineInt(AdvancedVisibilityPersistenceMaxReadQPS, PrecedenceGlobal, 9000, "Max QPC system host can query advanced visibility DB (Elasticsearch) for read"),
+	defineInt(AdvancedVisibilityPersistenceMaxWriteQPS, PrecedenceGlobal, 9000, "Max QPC system host can query advanced visibility DB (Elasticsearch) for write"),
+	{Key: AdvancedVisibilityWritingMode, Type: TypeString, Precedence: PrecedenceGlobal, Description: "How to write to advanced visibility. Defaults to \"on\" if advanced visibility is configured and \"off\" otherwise."},
+	defineBool(EnableWriteToSecondaryAdvancedVisibility, PrecedenceGlobal, false, "Enable write to secondary visibility for Elasticsearch"),
+	{Key: EnableReadVisibilityFromES, Type: TypeBool, Precedence: PrecedenceNamespace, Description: "Enable read from Elasticsearch. Defaults to true if advanced visibility is configured."},
+	defineBool(EnableReadFromSecondaryAdvancedVisibility, PrecedenceNamespace, false, "Enable read from secondary Elasticsearch"),
+	{Key: HistoryArchivalState, Type: TypeString, Precedence: PrecedenceGlobal, Description: "The state of history archival. Defaults to the history archival state in the static config."},
+	{Key: EnableReadFromHistoryArchival, Type: TypeBool, Precedence: PrecedenceGlobal, Description: "Enabling reading history from archival store. Defaults to the history archival read setting in the static config."},
+	{Key: VisibilityArchivalState, Type: TypeString, Precedence: PrecedenceGlobal, Description: "The state of visibility archival. Defaults to the visibility archival state in the static config."},
+	{Key: EnableReadFromVisibilityArchival, Type: TypeBool, Precedence: PrecedenceGlobal, Description: "Enabling reading visibility from archival store. Defaults to the visibility archival read setting in the static config."},
+	defineBool(EnableNamespaceNotActiveAutoForwarding, PrecedenceNamespace, true, "Whether enabling DC auto forwarding to active cluster for signal / start / signal with start API if namespace is not active"),
+	defineInt(TransactionSizeLimit, PrecedenceGlobal, 4*1024*1024, "Largest allowed transaction size to persistence"),
+	defineBool(DisallowQuery, PrecedenceNamespace, false, "Disallow query for a namespace"),
+	defineBool(EnableAuthorization, PrecedenceNamespace, false, "Enable authorization for a namespace"),
+	defineBool(EnableCrossNamespaceCommands, PrecedenceGlobal, true, "Enable commands for external namespaces"),
+	defineDuration(ClusterMetadataRefreshInterval, PrecedenceGlobal, time.Minute, "Manage cluster metadata table refresh interval"),
+	defineBool(ForceSearchAttributesCacheRefreshOnRead, PrecedenceGlobal, false, "Forces refreshing search attributes cache on a read operation, so we always get the latest data from DB. This effectively bypasses cache value and is used to facilitate testing of changes in search attributes. This should not be turned on in production"),
+	defineBool(EnableRingpopTLS, PrecedenceGlobal, false, "Enables TLS for ringpop membership traffic"),
+	defineBool(EnableParentClosePolicyWorker, PrecedenceGlobal, true, "Decides whether or not enable system workers for processing parent close policy task"),
+	defineBool(EnableStickyQuery, PrecedenceNamespace, true, "Indicates if sticky query should be enabled per namespace"),
+
+	// key for size limit
+	defineInt(BlobSizeLimitError, PrecedenceNamespace, 2*1024*1024, "Per event blob size limit"),
+	defineInt(BlobSizeLimitWarn, PrecedenceNamespace, 256*1024, "Per event blob size limit for warning. The history service defaults to 512KB"),
+	defineInt(MemoSizeLimitError, PrecedenceNamespace, 2*1024*1024, "Per event memo size limit"),
+	defineInt(MemoSizeLimitWarn, PrecedenceNamespace, 2*1024, "Per event memo size limit for warning"),
+	defineInt(HistorySizeLimitError, PrecedenceNamespace, 50*1024*1024, "Per workflow execution history size limit"),
+	defineInt(HistorySizeLimitWarn, PrecedenceNamespace, 10*1024*1024, "Per workflow execution history size limit for warning"),
+	defineInt(HistoryCountLimitError, PrecedenceNamespace, 50*1024, "Per workflow execution history event count limit"),
+	defineInt(HistoryCountLimitWarn, PrecedenceNamespace, 10*1024, "Per workflow execution history event count limit for warning"),
+	defineInt(HistorySizeSuggestContinueAsNew, PrecedenceWorkflowType, 10*1024*1024, "Per workflow execution history size soft limit after which the workflow is suggested to continue-as-new"),
+	defineInt(HistoryCountSuggestContinueAsNew, PrecedenceWorkflowType, 10*1024, "Per workflow execution history event count soft limit after which the workflow is suggested to continue-as-new"),
+	defineInt(MaxIDLengthLimit, PrecedenceGlobal, 1000, "Length limit for various IDs, including: Namespace, TaskQueue, WorkflowID, ActivityID, TimerID, WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID"),
+
+	// key for frontend
+	defineInt(FrontendPersistenceMaxQPS, PrecedenceGlobal, 2000, "Max qps frontend host can query DB"),
+	defineInt(FrontendPersistenceGlobalMaxQPS, PrecedenceGlobal, 0, "Max qps frontend cluster can query DB"),
+	defineInt(FrontendVisibilityMaxPageSize, PrecedenceNamespace, 1000, "Default max size for ListWorkflowExecutions in one page"),
+	defineInt(FrontendESIndexMaxResultWindow, PrecedenceGlobal, 10000, "ElasticSearch index setting max_result_window"),
+	defineInt(FrontendHistoryMaxPageSize, PrecedenceNamespace, 256, "Default max size for GetWorkflowExecutionHistory in one page"),
+	defineInt(FrontendRPS, PrecedenceGlobal, 2400, "Workflow rate limit per second"),
+	defineInt(FrontendMaxNamespaceRPSPerInstance, PrecedenceNamespace, 2400, "Workflow namespace rate limit per second"),
+	defineInt(FrontendMaxNamespaceBurstPerInstance, PrecedenceNamespace, 4800, "Workflow namespace burst limit"),
+	defineInt(FrontendMaxNamespaceCountPerInstance, PrecedenceNamespace, 1200, "Workflow namespace count limit per second"),
+	defineInt(FrontendGlobalNamespaceRPS, PrecedenceNamespace, 0, "Workflow namespace rate limit per second for the whole cluster"),
+	defineInt(FrontendThrottledLogRPS, PrecedenceGlobal, 20, "Rate limit on number of log messages emitted per second for throttled logger"),
+	defineDuration(FrontendShutdownDrainDuration, PrecedenceGlobal, 0, "Duration of traffic drain during shutdown"),
+	defineBool(EnableClientVersionCheck, PrecedenceGlobal, true, "Enables client version check for frontend"),
+	defineInt(FrontendMaxBadBinaries, PrecedenceNamespace, 10, "Max number of bad binaries in namespace config"),
+	defineBool(SendRawWorkflowHistory, PrecedenceNamespace, false, "Whether to enable raw history retrieving"),
+	defineInt(SearchAttributesNumberOfKeysLimit, PrecedenceNamespace, 100, "Limit of number of keys"),
+	defineInt(SearchAttributesSizeOfValueLimit, PrecedenceNamespace, 2*1024, "Size limit of each value"),
+	defineInt(SearchAttributesTotalSizeLimit, PrecedenceNamespace, 40*1024, "Size limit of the whole map"),
+	defineInt(VisibilityArchivalQueryMaxPageSize, PrecedenceGlobal, 10000, "Maximum page size for a visibility archival query"),
+	defineInt(VisibilityArchivalQueryMaxRangeInDays, PrecedenceGlobal, 60, "Maximum number of days for a visibility archival query"),
+	defineInt(VisibilityArchivalQueryMaxQPS, PrecedenceGlobal, 1, "Timeout for a visibility archival query"),
+	{Key: EnableServerVersionCheck, Type: TypeBool, Precedence: PrecedenceGlobal, Description: "Controls whether or not periodic version checking is enabled. Defaults to true unless TEMPORAL_VERSION_CHECK_DISABLED is set."},
+	defineBool(EnableTokenNamespaceEnforcement, PrecedenceGlobal, false, "Enables enforcement that namespace in completion token matches namespace of the request"),
+	defineBool(DisableListVisibilityByFilter, PrecedenceNamespace, false, "Disable list open/close workflow using filter"),
+	defineDuration(KeepAliveMinTime, PrecedenceGlobal, 10*time.Second, "Minimum amount of time a client should wait before sending a keepalive ping"),
+	defineBool(KeepAlivePermitWithoutStream, PrecedenceGlobal, true, "If true, server allows keepalive pings even when there are no active streams(RPCs). If false, and client sends ping when there are no active streams, server will send GOAWAY and close the connection"),
+	defineDuration(KeepAliveMaxConnectionIdle, PrecedenceGlobal, 2*time.Minute, "A duration for the amount of time after which an idle connection would be closed by sending a GoAway. Idleness duration is defined since the most recent time the number of outstanding RPCs became zero or the connection establishment"),
+	defineDuration(KeepAliveMaxConnectionAge, PrecedenceGlobal, 5*time.Minute, "A duration for the maximum amount of time a connection may exist before it will be closed by sending a GoAway. A random jitter of +/-10% will be added to MaxConnectionAge to spread out connection storms"),
+	defineDuration(KeepAliveMaxConnectionAgeGrace, PrecedenceGlobal, 70*time.Second, "An additive period after MaxConnectionAge after which the connection will be forcibly closed"),
+	defineDuration(KeepAliveTime, PrecedenceGlobal, 1*time.Minute, "After a duration of this time if the server doesn't see any activity it pings the client to see if the transport is still alive. If set below 1s, a minimum value of 1s will be used instead"),
+	defineDuration(KeepAliveTimeout, PrecedenceGlobal, 10*time.Second, "After having pinged for keepalive check, the server waits for a duration of Timeout and if no activity is seen even after that the connection is closed"),
+	defineFloat(FrontendAuthorizationAuditReadSampleRate, PrecedenceGlobal, 0, "Fraction of allowed read-only and worker API calls recorded in the authorization audit log"),
+
+	// key for matching
+	defineInt(MatchingRPS, PrecedenceGlobal, 1200, "Request rate per second for each matching host"),
+	defineInt(MatchingPersistenceMaxQPS, PrecedenceGlobal, 3000, "Max qps matching host can query DB"),
+	defineInt(MatchingPersistenceGlobalMaxQPS, PrecedenceGlobal, 0, "Max qps matching cluster can query DB"),
+	defineInt(MatchingMinTaskThrottlingBurstSize, PrecedenceTaskQueue, 1, "Minimum burst size for task queue throttling"),
+	defineInt(MatchingGetTasksBatchSize, PrecedenceTaskQueue, 1000, "Maximum batch size to fetch from the task buffer"),
+	defineDuration(MatchingLongPollExpirationInterval, PrecedenceTaskQueue, time.Minute, "Long poll expiration interval in the matching service"),
+	defineDuration(MatchingSyncMatchWaitDuration, PrecedenceTaskQueue, 200*time.Millisecond, "To wait time for sync match"),
+	defineDuration(MatchingUpdateAckInterval, PrecedenceTaskQueue, 1*time.Minute, "Interval for update ack"),
+	defineDuration(MatchingIdleTaskqueueCheckInterval, PrecedenceTaskQueue, 5*time.Minute, "IdleTaskqueueCheckInterval"),
+	defineDuration(MaxTaskqueueIdleTime, PrecedenceTaskQueue, 5*time.Minute, "Max time taskqueue being idle"),
+	defineInt(MatchingOutstandingTaskAppendsThreshold, PrecedenceTaskQueue, 250, "Threshold for outstanding task appends"),
+	defineInt(MatchingMaxTaskBatchSize, PrecedenceTaskQueue, 100, "Max batch size for task writer"),
+	defineInt(MatchingMaxTaskDeleteBatchSize, PrecedenceTaskQueue, 100, "Max batch size for range deletion of tasks"),
+	defineInt(MatchingThrottledLogRPS, PrecedenceGlobal, 20, "Rate limit on number of log messages emitted per second for throttled logger"),
+	defineInt(MatchingNumTaskqueueWritePartitions, PrecedenceTaskQueue, DefaultNumTaskQueuePartitions, "Number of write partitions for a task queue"),
+	defineInt(MatchingNumTaskqueueReadPartitions, PrecedenceTaskQueue, DefaultNumTaskQueuePartitions, "Number of read partitions for a task queue"),
+	defineInt(MatchingForwarderMaxOutstandingPolls, PrecedenceTaskQueue, 1, "Max number of inflight polls from the forwarder"),
+	defineInt(MatchingForwarderMaxOutstandingTasks, PrecedenceTaskQueue, 1, "Max number of inflight addTask/queryTask from the forwarder"),
+	defineInt(MatchingForwarderMaxRatePerSecond, PrecedenceTaskQueue, 10, "Max rate at which add/query can be forwarded"),
+	defineInt(MatchingForwarderMaxChildrenPerNode, PrecedenceTaskQueue, 20, "Max number of children per node in the task queue partition tree"),
+	defineDuration(MatchingShutdownDrainDuration, PrecedenceGlobal, 0, "Duration of traffic drain during shutdown"),
+	defineInt(MatchingVersionBuildIDLimitPerQueue, PrecedenceNamespace, 100, "Max number of worker build IDs in the version graph of a task queue"),
+	defineDuration(MatchingVersioningDataRefreshInterval, PrecedenceTaskQueue, time.Minute, "How often task queue partitions refresh the version graph from the root partition and re-evaluate which version set a backlog task is dispatched to"),
+	defineDuration(MatchingBacklogPriorityAgingInterval, PrecedenceTaskQueue, time.Minute, "How much later a backlog task is considered created for each priority level below the highest when ordering backlog tasks for dispatch, so low priority tasks are not starved"),
+
+	// key for history
+	defineInt(HistoryRPS, PrecedenceGlobal, 3000, "Request rate per second for each history host"),
+	defineInt(HistoryPersistenceMaxQPS, PrecedenceGlobal, 9000, "Max qps history host can query DB"),
+	defineInt(HistoryPersistenceGlobalMaxQPS, PrecedenceGlobal, 0, "Max qps history cluster can query DB"),
+	defineDuration(HistoryLongPollExpirationInterval, PrecedenceNamespace, 20*time.Second, "Long poll expiration interval in the history service"),
+	defineInt(HistoryCacheInitialSize, PrecedenceGlobal, 128, "Initial size of history cache"),
+	defineInt(HistoryCacheMaxSize, PrecedenceGlobal, 512, "Max size of history cache"),
+	defineDuration(HistoryCacheTTL, PrecedenceGlobal, time.Hour, "TTL of history cache"),
+	defineDuration(HistoryShutdownDrainDuration, PrecedenceGlobal, 0, "Duration of traffic drain during shutdown, shards are flushed and handed off only within it so 0 disables the graceful shutdown"),
+	defineInt(EventsCacheInitialSize, PrecedenceGlobal, 128, "Initial size of events cache"),
+	defineInt(EventsCacheMaxSize, PrecedenceGlobal, 512, "Max size of events cache"),
+	defineDuration(EventsCacheTTL, PrecedenceGlobal, time.Hour, "TTL of events cache"),
+	defineDuration(AcquireShardInterval, PrecedenceGlobal, time.Minute, "Interval that timer used to acquire shard"),
+	defineInt(AcquireShardConcurrency, PrecedenceGlobal, 10, "Number of goroutines that can be used to acquire shards in the shard controller"),
+	defineDuration(ShardLoadWindow, PrecedenceGlobal, time.Minute, "Length of the sampling window over which shard load is reported"),
+	defineInt(ShardLoadMaxTrackedWorkflows, PrecedenceGlobal, 1000, "Max number of workflows per shard whose request counts are tracked to find the most requested workflows"),
+	defineDuration(StandbyClusterDelay, PrecedenceGlobal, 5*time.Minute, "Artificial delay added to standby cluster's view of active cluster's time"),
+	defineDuration(StandbyTaskMissingEventsResendDelay, PrecedenceGlobal, 10*time.Minute, "Amount of time standby cluster's will wait (if events are missing) before calling remote for missing events"),
+	defineDuration(StandbyTaskMissingEventsDiscardDelay, PrecedenceGlobal, 15*time.Minute, "Amount of time standby cluster's will wait (if events are missing) before discarding the task"),
+	defineInt(TimerTaskBatchSize, PrecedenceGlobal, 100, "Batch size for timer processor to process tasks"),
+	defineInt(TimerTaskWorkerCount, PrecedenceGlobal, 10, "Number of task workers for timer processor"),
+	defineInt(TimerTaskMaxRetryCount, PrecedenceGlobal, 100, "Max retry count for timer processor"),
+	defineInt(TimerProcessorCompleteTimerFailureRetryCount, PrecedenceGlobal, 10, "Retry count for timer processor complete timer operation"),
+	defineDuration(TimerProcessorUpdateAckInterval, PrecedenceGlobal, 30*time.Second, "Update interval for timer processor"),
+	defineFloat(TimerProcessorUpdateAckIntervalJitterCoefficient, PrecedenceGlobal, 0.15, "Update interval jitter coefficient"),
+	defineDuration(TimerProcessorCompleteTimerInterval, PrecedenceGlobal, 60*time.Second, "Complete timer interval for timer processor"),
+	defineInt(TimerProcessorFailoverMaxPollRPS, PrecedenceGlobal, 1, "Max poll rate per second for timer processor"),
+	defineInt(TimerProcessorMaxPollRPS, PrecedenceGlobal, 20, "Max poll rate per second for timer processor"),
+	defineDuration(TimerProcessorMaxPollInterval, PrecedenceGlobal, 5*time.Minute, "Max poll interval for timer processor"),
+	defineFloat(TimerProcessorMaxPollIntervalJitterCoefficient, PrecedenceGlobal, 0.15, "Max poll interval jitter coefficient"),
+	defineDuration(TimerProcessorRedispatchInterval, PrecedenceGlobal, 5*time.Second, "Redispatch interval for timer processor"),
+	defineFloat(TimerProcessorRedispatchIntervalJitterCoefficient, PrecedenceGlobal, 0.15, "Redispatch interval jitter coefficient"),
+	defineInt(TimerProcessorMaxRedispatchQueueSize, PrecedenceGlobal, 10000, "Threshold of the number of tasks in the redispatch queue for timer processor"),
+	defineBool(TimerProcessorEnablePriorityTaskProcessor, PrecedenceGlobal, false, "Indicates whether priority task processor should be used for timer processor"),
+	defineDuration(TimerProcessorMaxTimeShift, PrecedenceGlobal, 1*time.Second, "Max shift timer processor can have"),
+	defineInt(TimerProcessorHistoryArchivalSizeLimit, PrecedenceGlobal, 500*1024, "Max history size for inline archival"),
+	defineDuration(TimerProcessorArchivalTimeLimit, PrecedenceGlobal, 1*time.Second, "Upper time limit for inline history archival"),
+	defineInt(TransferTaskBatchSize, PrecedenceGlobal, 100, "Batch size for transferQueueProcessor"),
+	defineInt(TransferProcessorFailoverMaxPollRPS, PrecedenceGlobal, 1, "Max poll rate per second for transferQueueProcessor"),
+	defineInt(TransferProcessorMaxPollRPS, PrecedenceGlobal, 20, "Max poll rate per second for transferQueueProcessor"),
+	defineInt(TransferTaskWorkerCount, PrecedenceGlobal, 10, "Number of worker for transferQueueProcessor"),
+	defineInt(TransferTaskMaxRetryCount, PrecedenceGlobal, 100, "Max times of retry for transferQueueProcessor"),
+	defineInt(TransferProcessorCompleteTransferFailureRetryCount, PrecedenceGlobal, 10, "Times of retry for failure"),
+	defineInt(TransferProcessorUpdateShardTaskCount, PrecedenceGlobal, 100, "Update shard count for transferQueueProcessor"),
+	defineDuration(TransferProcessorMaxPollInterval, PrecedenceGlobal, 1*time.Minute, "Max poll interval for transferQueueProcessor"),
+	defineFloat(TransferProcessorMaxPollIntervalJitterCoefficient, PrecedenceGlobal, 0.15, "Max poll interval jitter coefficient"),
+	defineDuration(TransferProcessorUpdateAckInterval, PrecedenceGlobal, 30*time.Second, "Update interval for transferQueueProcessor"),
+	defineFloat(TransferProcessorUpdateAckIntervalJitterCoefficient, PrecedenceGlobal, 0.15, "Update interval jitter coefficient"),
+	defineDuration(TransferProcessorCompleteTransferInterval, PrecedenceGlobal, 60*time.Second, "Complete timer interval for transferQueueProcessor"),
+	defineDuration(TransferProcessorRedispatchInterval, PrecedenceGlobal, 5*time.Second, "Redispatch interval for transferQueueProcessor"),
+	defineFloat(TransferProcessorRedispatchIntervalJitterCoefficient, PrecedenceGlobal, 0.15, "Redispatch interval jitter coefficient"),
+	defineInt(TransferProcessorMaxRedispatchQueueSize, PrecedenceGlobal, 10000, "Threshold of the number of tasks in the redispatch queue for transferQueueProcessor"),
+	defineBool(TransferProcessorEnablePriorityTaskProcessor, PrecedenceGlobal, false, "Indicates whether priority task processor should be used for transferQueueProcessor"),
+	defineDuration(TransferProcessorVisibilityArchivalTimeLimit, PrecedenceGlobal, 200*time.Millisecond, "Upper time limit for archiving visibility records"),
+	defineInt(VisibilityTaskBatchSize, PrecedenceGlobal, 100, "Batch size for visibilityQueueProcessor"),
+	defineInt(VisibilityProcessorFailoverMaxPollRPS, PrecedenceGlobal, 1, "Max poll rate per second for visibilityQueueProcessor"),
+	defineInt(VisibilityProcessorMaxPollRPS, PrecedenceGlobal, 20, "Max poll rate per second for visibilityQueueProcessor"),
+	defineInt(VisibilityTaskWorkerCount, PrecedenceGlobal, 10, "Number of worker for visibilityQueueProcessor"),
+	defineInt(VisibilityTaskMaxRetryCount, PrecedenceGlobal, 100, "Max times of retry for visibilityQueueProcessor"),
+	defineInt(VisibilityProcessorCompleteTaskFailureRetryCount, PrecedenceGlobal, 10, "Times of retry for failure"),
+	defineDuration(VisibilityProcessorMaxPollInterval, PrecedenceGlobal, 1*time.Minute, "Max poll interval for visibilityQueueProcessor"),
+	defineFloat(VisibilityProcessorMaxPollIntervalJitterCoefficient, PrecedenceGlobal, 0.15, "Max poll interval jitter coefficient"),
+	defineDuration(VisibilityProcessorUpdateAckInterval, PrecedenceGlobal, 30*time.Second, "Update interval for visibilityQueueProcessor"),
+	defineFloat(VisibilityProcessorUpdateAckIntervalJitterCoefficient, PrecedenceGlobal, 0.15, "Update interval jitter coefficient"),
+	defineDuration(VisibilityProcessorCompleteTaskInterval, PrecedenceGlobal, 60*time.Second, "Complete timer interval for visibilityQueueProcessor"),
+	defineDuration(VisibilityProcessorRedispatchInterval, PrecedenceGlobal, 5*time.Second, "Redispatch interval for visibilityQueueProcessor"),
+	defineFloat(VisibilityProcessorRedispatchIntervalJitterCoefficient, PrecedenceGlobal, 0.15, "Redispatch interval jitter coefficient"),
+	defineInt(VisibilityProcessorMaxRedispatchQueueSize, PrecedenceGlobal, 10000, "Threshold of the number of tasks in the redispatch queue for visibilityQueueProcessor"),
+	defineBool(VisibilityProcessorEnablePriorityTaskProcessor, PrecedenceGlobal, false, "Indicates whether priority task processor should be used for visibilityQueueProcessor"),
+	defineDuration(VisibilityProcessorVisibilityArchivalTimeLimit, PrecedenceGlobal, 200*time.Millisecond, "Upper time limit for archiving visibility records"),
+	defineInt(ReplicatorTaskBatchSize, PrecedenceGlobal, 100, "Batch size for ReplicatorProcessor. The replication task fetcher defaults to 25"),
+	defineInt(ReplicatorTaskWorkerCount, PrecedenceGlobal, 10, "Number of worker for ReplicatorProcessor"),
+	defineInt(ReplicatorTaskMaxRetryCount, PrecedenceGlobal, 100, "Max times of retry for ReplicatorProcessor"),
+	defineInt(ReplicatorProcessorMaxPollRPS, PrecedenceGlobal, 20, "Max poll rate per second for ReplicatorProcessor"),
+	defineDuration(ReplicatorProcessorMaxPollInterval, PrecedenceGlobal, 1*time.Minute, "Max poll interval for ReplicatorProcessor"),
+	defineFloat(ReplicatorProcessorMaxPollIntervalJitterCoefficient, PrecedenceGlobal, 0.15, "Max poll interval jitter coefficient"),
+	defineDuration(ReplicatorProcessorUpdateAckInterval, PrecedenceGlobal, 5*time.Second, "Update interval for ReplicatorProcessor"),
+	defineFloat(ReplicatorProcessorUpdateAckIntervalJitterCoefficient, PrecedenceGlobal, 0.15, "Update interval jitter coefficient"),
+	defineDuration(ReplicatorProcessorRedispatchInterval, PrecedenceGlobal, 5*time.Second, "Redispatch interval for ReplicatorProcessor"),
+	defineFloat(ReplicatorProcessorRedispatchIntervalJitterCoefficient, PrecedenceGlobal, 0.15, "Redispatch interval jitter coefficient"),
+	defineInt(ReplicatorProcessorMaxRedispatchQueueSize, PrecedenceGlobal, 10000, "Threshold of the number of tasks in the redispatch queue for ReplicatorProcessor"),
+	defineBool(ReplicatorProcessorEnablePriorityTaskProcessor, PrecedenceGlobal, false, "Indicates whether priority task processor should be used for ReplicatorProcessor"),
+	defineInt(MaximumBufferedEventsBatch, PrecedenceGlobal, 100, "Max number of buffer event in mutable state"),
+	defineInt(MaximumSignalsPerExecution, PrecedenceNamespace, 0, "Max number of signals supported by single execution"),
+	defineInt(MaxOpenWorkflowExecutionsPerNamespace, PrecedenceNamespace, 0, "Max number of open workflow executions per namespace, 0 means no limit"),
+	defineInt(MaxOpenWorkflowExecutionsPerWorkflowType, PrecedenceWorkflowType, 0, "Max number of open workflow executions per workflow type within a namespace, 0 means no limit"),
+	defineDuration(OpenExecutionCountRefreshInterval, PrecedenceGlobal, 10*time.Second, "Interval at which history hosts collect open workflow execution counts from each other"),
+	defineInt(TaskSchedulerNamespaceWeight, PrecedenceNamespace, 1, "Weight of a namespace when history task workers pick the next task to execute"),
+	defineFloat(TaskSchedulerNamespaceMaxWorkerShare, PrecedenceNamespace, 1, "Max fraction of a host's history task workers that can execute tasks of a single namespace at the same time"),
+	defineDuration(ShardUpdateMinInterval, PrecedenceGlobal, 5*time.Minute, "Minimal time interval which the shard info can be updated"),
+	defineDuration(ShardSyncMinInterval, PrecedenceGlobal, 5*time.Minute, "Minimal time interval which the shard info should be sync to remote"),
+	defineBool(EmitShardDiffLog, PrecedenceGlobal, false, "Whether emit the shard diff log"),
+	defineString(DefaultEventEncoding, PrecedenceNamespace, enumspb.ENCODING_TYPE_PROTO3.String(), "Encoding type for history events"),
+	defineInt(NumArchiveSystemWorkflows, PrecedenceGlobal, 1000, "Number of archive system workflows running in total"),
+	defineInt(ArchiveRequestRPS, PrecedenceGlobal, 300, "Rate limit on the number of archive request per second"),
+	{Key: DefaultActivityRetryPolicy, Type: TypeMap, Precedence: PrecedenceNamespace, Description: "Represents the out-of-box retry policy for activities where the user has not specified an explicit RetryPolicy. Defaults to the server default retry policy."},
+	{Key: DefaultWorkflowRetryPolicy, Type: TypeMap, Precedence: PrecedenceNamespace, Description: "Represents the out-of-box retry policy for unset fields where the user has set an explicit RetryPolicy, but not specified all the fields. Defaults to the server default retry policy."},
+	defineInt(HistoryMaxAutoResetPoints, PrecedenceNamespace, 20, "Max number of auto reset points stored in mutableState"),
+	defineBool(EnableParentClosePolicy, PrecedenceNamespace, true, "Whether to ParentClosePolicy"),
+	defineInt(ParentClosePolicyThreshold, PrecedenceNamespace, 10, "Decides that parent close policy will be processed by sys workers(if enabled) if the number of children greater than or equal to this threshold"),
+	defineInt(NumParentClosePolicySystemWorkflows, PrecedenceGlobal, 10, "Number of parentClosePolicy system workflows running in total"),
+	defineInt(HistoryThrottledLogRPS, PrecedenceGlobal, 4, "Rate limit on number of log messages emitted per second for throttled logger"),
+	defineDuration(StickyTTL, PrecedenceNamespace, 365*24*time.Hour, "To expire a sticky taskqueue if no update more than this duration"),
+	defineDuration(WorkflowTaskHeartbeatTimeout, PrecedenceNamespace, 30*time.Minute, "For workflow task heartbeat"),
+	defineInt(WorkflowTaskCriticalAttempts, PrecedenceGlobal, 10, "Number of attempts for a workflow task that's regarded as critical"),
+	defineDuration(DefaultWorkflowTaskTimeout, PrecedenceNamespace, 10*time.Second, "For a workflow task"),
+	defineBool(SkipReapplicationByNamespaceID, PrecedenceNamespaceID, false, "Whether skipping a event re-application for a namespace"),
+	defineDuration(StandbyTaskReReplicationContextTimeout, PrecedenceNamespaceID, 3*time.Minute, "Context timeout for standby task re-replication"),
+	defineInt(MaxBufferedQueryCount, PrecedenceGlobal, 1, "Indicates max buffer query count"),
+	defineInt(MaxInFlightUpdates, PrecedenceNamespace, 10, "Max number of updates that can be accepted but not yet completed per workflow execution"),
+	defineInt(MaxCompletedUpdates, PrecedenceNamespace, 100, "Max number of completed updates per workflow execution whose outcome is kept to deduplicate retries"),
+	defineInt(MutableStateChecksumGenProbability, PrecedenceNamespace, 0, "Probability [0-100] that checksum will be generated for mutable state"),
+	defineInt(MutableStateChecksumVerifyProbability, PrecedenceNamespace, 0, "Probability [0-100] that checksum will be verified for mutable state"),
+	defineFloat(MutableStateChecksumInvalidateBefore, PrecedenceGlobal, 0, "Epoch timestamp before which all checksums are to be discarded"),
+	defineInt(ReplicationTaskFetcherParallelism, PrecedenceGlobal, 4, "Determines how many go routines we spin up for fetching tasks"),
+	defineDuration(ReplicationTaskFetcherAggregationInterval, PrecedenceGlobal, 2*time.Second, "Determines how frequently the fetch requests are sent"),
+	defineFloat(ReplicationTaskFetcherTimerJitterCoefficient, PrecedenceGlobal, 0.15, "Jitter for fetcher timer"),
+	defineDuration(ReplicationTaskFetcherErrorRetryWait, PrecedenceGlobal, time.Second, "Wait time when fetcher encounters error"),
+	defineDuration(ReplicationTaskProcessorErrorRetryWait, PrecedenceShardID, 1*time.Second, "Initial retry wait when we see errors in applying replication tasks"),
+	defineFloat(ReplicationTaskProcessorErrorRetryBackoffCoefficient, PrecedenceShardID, 1.2, "Retry wait backoff time coefficient"),
+	defineDuration(ReplicationTaskProcessorErrorRetryMaxInterval, PrecedenceShardID, 5*time.Second, "Retry wait backoff max duration"),
+	defineInt(ReplicationTaskProcessorErrorRetryMaxAttempts, PrecedenceShardID, 80, "Max retry attempts for applying replication tasks"),
+	defineDuration(ReplicationTaskProcessorErrorRetryExpiration, PrecedenceShardID, 5*time.Minute, "Max retry duration for applying replication tasks"),
+	defineDuration(ReplicationTaskProcessorNoTaskInitialWait, PrecedenceShardID, 2*time.Second, "Wait time when not ask is returned"),
+	defineDuration(ReplicationTaskProcessorCleanupInterval, PrecedenceShardID, 1*time.Minute, "Determines how frequently the cleanup replication queue"),
+	defineFloat(ReplicationTaskProcessorCleanupJitterCoefficient, PrecedenceShardID, 0.15, "Jitter for cleanup timer"),
+	defineDuration(ReplicationTaskProcessorStartWait, PrecedenceShardID, 5*time.Second, "Wait time before each task processing batch"),
+	defineFloat(ReplicationTaskProcessorHostQPS, PrecedenceGlobal, 1500, "Qps of task processing rate limiter on host level"),
+	defineFloat(ReplicationTaskProcessorShardQPS, PrecedenceGlobal, 30, "Qps of task processing rate limiter on shard level"),
+
+	// key for worker
+	defineInt(WorkerPersistenceMaxQPS, PrecedenceGlobal, 500, "Max qps worker host can query DB"),
+	defineInt(WorkerPersistenceGlobalMaxQPS, PrecedenceGlobal, 0, "Max qps worker cluster can query DB"),
+	defineInt(WorkerIndexerConcurrency, PrecedenceGlobal, 100, "Max concurrent messages to be processed at any given time"),
+	defineInt(WorkerESProcessorNumOfWorkers, PrecedenceGlobal, 1, "Num of workers for esProcessor"),
+	defineInt(WorkerESProcessorBulkActions, PrecedenceGlobal, 500, "Max number of requests in bulk for esProcessor"),
+	defineInt(WorkerESProcessorBulkSize, PrecedenceGlobal, 16*1024*1024, "Max total size of bulk in bytes for esProcessor"),
+	defineDuration(WorkerESProcessorFlushInterval, PrecedenceGlobal, 1*time.Second, "Flush interval for esProcessor"),
+	defineDuration(WorkerESProcessorAckTimeout, PrecedenceGlobal, 1*time.Minute, "Timeout that store will wait to get ack signal from ES processor. Should be at least WorkerESProcessorFlushInterval+<time to process request>"),
+	defineInt(WorkerArchiverMaxConcurrentActivityExecutionSize, PrecedenceGlobal, 1000, "Indicates worker archiver max concurrent activity execution size"),
+	defineInt(WorkerArchiverMaxConcurrentWorkflowTaskExecutionSize, PrecedenceGlobal, 1000, "Indicates worker archiver max concurrent workflow execution size"),
+	defineInt(WorkerArchiverMaxConcurrentActivityTaskPollers, PrecedenceGlobal, 4, "Indicates worker archiver max concurrent activity pollers"),
+	defineInt(WorkerArchiverMaxConcurrentWorkflowTaskPollers, PrecedenceGlobal, 4, "Indicates worker archiver max concurrent workflow pollers"),
+	defineInt(WorkerArchiverConcurrency, PrecedenceGlobal, 50, "Controls the number of coroutines handling archival work per archival workflow"),
+	defineInt(WorkerArchivalsPerIteration, PrecedenceGlobal, 1000, "Controls the number of archivals handled in each iteration of archival workflow"),
+	defineDuration(WorkerTimeLimitPerArchivalIteration, PrecedenceGlobal, 15*24*time.Hour, "Controls the time limit of each iteration of archival workflow"),
+	defineInt(WorkerThrottledLogRPS, PrecedenceGlobal, 20, "Rate limit on number of log messages emitted per second for throttled logger"),
+	defineInt(WorkerScannerMaxConcurrentActivityExecutionSize, PrecedenceGlobal, 10, "Indicates worker scanner max concurrent activity execution size"),
+	defineInt(WorkerScannerMaxConcurrentWorkflowTaskExecutionSize, PrecedenceGlobal, 10, "Indicates worker scanner max concurrent workflow execution size"),
+	defineInt(WorkerScannerMaxConcurrentActivityTaskPollers, PrecedenceGlobal, 8, "Indicates worker scanner max concurrent activity pollers"),
+	defineInt(WorkerScannerMaxConcurrentWorkflowTaskPollers, PrecedenceGlobal, 8, "Indicates worker scanner max concurrent workflow pollers"),
+	defineInt(ScannerPersistenceMaxQPS, PrecedenceGlobal, 100, "Maximum rate of persistence calls from worker.Scanner"),
+	defineBool(TaskQueueScannerEnabled, PrecedenceGlobal, true, "Indicates if task queue scanner should be started as part of worker.Scanner"),
+	defineBool(HistoryScannerEnabled, PrecedenceGlobal, true, "Indicates if history scanner should be started as part of worker.Scanner"),
+	defineBool(ExecutionsScannerEnabled, PrecedenceGlobal, false, "Indicates if executions scanner should be started as part of worker.Scanner"),
+	defineInt(WorkerBatcherMaxConcurrentActivityExecutionSize, PrecedenceGlobal, 1000, "Indicates worker batcher max concurrent activity execution size"),
+	defineInt(WorkerBatcherMaxConcurrentWorkflowTaskExecutionSize, PrecedenceGlobal, 1000, "Indicates worker batcher max concurrent workflow execution size"),
+	defineInt(WorkerBatcherMaxConcurrentActivityTaskPollers, PrecedenceGlobal, 4, "Indicates worker batcher max concurrent activity pollers"),
+	defineInt(WorkerBatcherMaxConcurrentWorkflowTaskPollers, PrecedenceGlobal, 4, "Indicates worker batcher max concurrent workflow pollers"),
+	defineBool(EnableBatcher, PrecedenceGlobal, true, "Decides whether start batcher in our worker"),
+	defineInt(WorkerParentCloseMaxConcurrentActivityExecutionSize, PrecedenceGlobal, 1000, "Indicates worker parent close worker max concurrent activity execution size"),
+	defineInt(WorkerParentCloseMaxConcurrentWorkflowTaskExecutionSize, PrecedenceGlobal, 1000, "Indicates worker parent close worker max concurrent workflow execution size"),
+	defineInt(WorkerParentCloseMaxConcurrentActivityTaskPollers, PrecedenceGlobal, 4, "Indicates worker parent close worker max concurrent activity pollers"),
+	defineInt(WorkerParentCloseMaxConcurrentWorkflowTaskPollers, PrecedenceGlobal, 4, "Indicates worker parent close worker max concurrent workflow pollers"),
+}
@@ -0,0 +1,336 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+var _ Client = (*persistenceBasedClient)(nil)
+var _ Refresher = (*persistenceBasedClient)(nil)
+
+type (
+	// PersistenceBasedClientConfig is the config for the dynamic config client which reads values
+	// stored in the cluster metadata store. Values are managed with the admin dynamic config APIs.
+	PersistenceBasedClientConfig struct {
+		// PollInterval is how often stored values are reloaded. Hosts are also asked to reload
+		// right after a value is changed through the admin APIs, so this only bounds the delay
+		// for hosts which missed that notification.
+		PollInterval time.Duration `yaml:"pollInterval"`
+	}
+
+	// Refresher is implemented by dynamic config clients which can reload their values on demand.
+	Refresher interface {
+		Refresh() error
+	}
+
+	// PersistenceEntryLoader loads all dynamic config entries stored in persistence.
+	PersistenceEntryLoader func() ([]*persistencespb.DynamicConfigEntry, error)
+
+	persistenceBasedClient struct {
+		*basicClient
+		fallback Client
+		loader   PersistenceEntryLoader
+		config   *PersistenceBasedClientConfig
+		doneCh   <-chan interface{}
+		logger   log.Logger
+
+		refreshLock sync.Mutex
+	}
+)
+
+// NewPersistenceBasedClient creates a client which serves values stored in persistence.
+// Keys which have no stored value matching the filters are looked up in the fallback client.
+func NewPersistenceBasedClient(
+	config *PersistenceBasedClientConfig,
+	loader PersistenceEntryLoader,
+	fallback Client,
+	logger log.Logger,
+	doneCh <-chan interface{},
+) (Client, error) {
+	if config == nil {
+		return nil, errors.New("configuration for persistence based dynamic config client is nil")
+	}
+	if config.PollInterval < minPollInterval {
+		return nil, fmt.Errorf("poll interval should be at least %v", minPollInterval)
+	}
+
+	client := &persistenceBasedClient{
+		basicClient: newBasicClient(),
+		fallback:    fallback,
+		loader:      loader,
+		config:      config,
+		doneCh:      doneCh,
+		logger:      logger,
+	}
+	if err := client.Refresh(); err != nil {
+		return nil, fmt.Errorf("unable to load dynamic config from persistence: %w", err)
+	}
+	go func() {
+		ticker := time.NewTicker(client.config.PollInterval)
+		for {
+			select {
+			case <-ticker.C:
+				err := client.Refresh()
+				if err != nil {
+					client.logger.Error("Unable to load dynamic config from persistence.", tag.Error(err))
+				}
+			case <-client.doneCh:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return client, nil
+}
+
+// Refresh reloads all values from persistence. A key whose stored values can't be decoded keeps
+// its previously loaded values, it doesn't keep the other keys from being reloaded.
+func (pc *persistenceBasedClient) Refresh() error {
+	pc.refreshLock.Lock()
+	defer pc.refreshLock.Unlock()
+
+	entries, err := pc.loader()
+	if err != nil {
+		return err
+	}
+
+	oldValues := pc.values.Load().(configValueMap)
+	newValues := make(configValueMap, len(entries))
+	for _, entry := range entries {
+		key := strings.ToLower(entry.GetKey())
+		values, err := convertPersistenceValues(entry.GetValues())
+		if err != nil {
+			pc.logger.Error("Unable to load dynamic config key from persistence.", tag.Key(entry.GetKey()), tag.Error(err))
+			values = oldValues[key]
+		}
+		if len(values) > 0 {
+			newValues[key] = values
+		}
+	}
+
+	if reflect.DeepEqual(newValues, pc.values.Load()) {
+		return nil
+	}
+	pc.values.Store(newValues)
+	pc.logger.Info("Updated dynamic config from persistence")
+	return nil
+}
+
+func (pc *persistenceBasedClient) GetValue(
+	name Key,
+	defaultValue interface{},
+) (interface{}, error) {
+	val, err := pc.basicClient.GetValue(name, defaultValue)
+	if errors.Is(err, errKeyNotFound) {
+		return pc.fallback.GetValue(name, defaultValue)
+	}
+	return val, err
+}
+
+func (pc *persistenceBasedClient) GetValueWithFilters(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue interface{},
+) (interface{}, error) {
+	val, err := pc.basicClient.GetValueWithFilters(name, filters, defaultValue)
+	if errors.Is(err, errKeyNotFound) {
+		return pc.fallback.GetValueWithFilters(name, filters, defaultValue)
+	}
+	return val, err
+}
+
+func (pc *persistenceBasedClient) GetIntValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue int,
+) (int, error) {
+	val, err := pc.basicClient.GetIntValue(name, filters, defaultValue)
+	if errors.Is(err, errKeyNotFound) {
+		return pc.fallback.GetIntValue(name, filters, defaultValue)
+	}
+	return val, err
+}
+
+func (pc *persistenceBasedClient) GetFloatValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue float64,
+) (float64, error) {
+	val, err := pc.basicClient.GetFloatValue(name, filters, defaultValue)
+	if errors.Is(err, errKeyNotFound) {
+		return pc.fallback.GetFloatValue(name, filters, defaultValue)
+	}
+	return val, err
+}
+
+func (pc *persistenceBasedClient) GetBoolValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue bool,
+) (bool, error) {
+	val, err := pc.basicClient.GetBoolValue(name, filters, defaultValue)
+	if errors.Is(err, errKeyNotFound) {
+		return pc.fallback.GetBoolValue(name, filters, defaultValue)
+	}
+	return val, err
+}
+
+func (pc *persistenceBasedClient) GetStringValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue string,
+) (string, error) {
+	val, err := pc.basicClient.GetStringValue(name, filters, defaultValue)
+	if errors.Is(err, errKeyNotFound) {
+		return pc.fallback.GetStringValue(name, filters, defaultValue)
+	}
+	return val, err
+}
+
+func (pc *persistenceBasedClient) GetMapValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue map[string]interface{},
+) (map[string]interface{}, error) {
+	val, err := pc.basicClient.GetMapValue(name, filters, defaultValue)
+	if errors.Is(err, errKeyNotFound) {
+		return pc.fallback.GetMapValue(name, filters, defaultValue)
+	}
+	return val, err
+}
+
+func (pc *persistenceBasedClient) GetDurationValue(
+	name Key,
+	filters map[Filter]interface{},
+	defaultValue time.Duration,
+) (time.Duration, error) {
+	val, err := pc.basicClient.GetDurationValue(name, filters, defaultValue)
+	if errors.Is(err, errKeyNotFound) {
+		return pc.fallback.GetDurationValue(name, filters, defaultValue)
+	}
+	return val, err
+}
+
+// ValidatePersistenceValue checks that the key is registered and that the value and its constraints
+// can be stored for the key.
+func ValidatePersistenceValue(key Key, value *persistencespb.DynamicConfigValue) error {
+	definition, ok := GetKeyDefinition(key)
+	if !ok {
+		return fmt.Errorf("unknown key %s", key)
+	}
+	v, err := decodeJSONValue(value.GetValue())
+	if err != nil {
+		return err
+	}
+	return definition.Validate(v, convertPersistenceConstraints(value.GetConstraints()))
+}
+
+// convertPersistenceValues converts values stored in persistence to the constrained values
+// used by the clients. JSON numbers are converted to int when they have no fractional part
+// and to float64 otherwise, which matches values decoded from the YAML config file.
+func convertPersistenceValues(values []*persistencespb.DynamicConfigValue) ([]*constrainedValue, error) {
+	result := make([]*constrainedValue, 0, len(values))
+	for _, v := range values {
+		value, err := decodeJSONValue(v.GetValue())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &constrainedValue{
+			Value:       value,
+			Constraints: convertPersistenceConstraints(v.GetConstraints()),
+		})
+	}
+	return result, nil
+}
+
+func convertPersistenceConstraints(c *persistencespb.DynamicConfigConstraints) map[string]interface{} {
+	constraints := make(map[string]interface{})
+	if c.GetNamespace() != "" {
+		constraints[Namespace.String()] = c.GetNamespace()
+	}
+	if c.GetNamespaceId() != "" {
+		constraints[NamespaceID.String()] = c.GetNamespaceId()
+	}
+	if c.GetTaskQueueName() != "" {
+		constraints[TaskQueueName.String()] = c.GetTaskQueueName()
+	}
+	if c.GetTaskQueueType() != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		constraints[TaskType.String()] = enumspb.TaskQueueType_name[int32(c.GetTaskQueueType())]
+	}
+	if c.GetShardId() != 0 {
+		constraints[ShardID.String()] = c.GetShardId()
+	}
+	if c.GetWorkflowType() != "" {
+		constraints[WorkflowType.String()] = c.GetWorkflowType()
+	}
+	return constraints
+}
+
+func decodeJSONValue(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("unable to decode value: %w", err)
+	}
+	return convertJSONNumbers(value), nil
+}
+
+func convertJSONNumbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i)
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = convertJSONNumbers(value)
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = convertJSONNumbers(value)
+		}
+		return v
+	default:
+		return v
+	}
+}
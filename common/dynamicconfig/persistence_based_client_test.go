@@ -0,0 +1,234 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+)
+
+type persistenceBasedClientSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	entries  []*persistencespb.DynamicConfigEntry
+	fallback *MutableEphemeralClient
+	client   Client
+	doneCh   chan interface{}
+}
+
+func TestPersistenceBasedClientSuite(t *testing.T) {
+	s := new(persistenceBasedClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *persistenceBasedClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.entries = []*persistencespb.DynamicConfigEntry{
+		{
+			Key: "testGetIntPropertyKey",
+			Values: []*persistencespb.DynamicConfigValue{
+				{Value: []byte("1000")},
+				{Value: []byte("2000"), Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "samples-namespace"}},
+				{Value: []byte("3000"), Constraints: &persistencespb.DynamicConfigConstraints{
+					TaskQueueName: "sample-task-queue",
+					TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+				}},
+				{Value: []byte("4000"), Constraints: &persistencespb.DynamicConfigConstraints{ShardId: 7}},
+			},
+		},
+		{
+			Key: "testGetFloat64PropertyKey",
+			Values: []*persistencespb.DynamicConfigValue{
+				{Value: []byte("12.5")},
+			},
+		},
+		{
+			Key: "testGetMapPropertyKey",
+			Values: []*persistencespb.DynamicConfigValue{
+				{Value: []byte(`{"key1": "1", "key2": 1, "key3": [false, {"key4": 1.5}]}`)},
+			},
+		},
+		{
+			// all values were deleted, only history is kept
+			Key: "testGetBoolPropertyKey",
+		},
+	}
+	s.fallback = NewMutableEphemeralClient()
+	s.doneCh = make(chan interface{})
+
+	var err error
+	s.client, err = NewPersistenceBasedClient(
+		&PersistenceBasedClientConfig{PollInterval: time.Minute},
+		func() ([]*persistencespb.DynamicConfigEntry, error) { return s.entries, nil },
+		s.fallback,
+		log.NewNoopLogger(),
+		s.doneCh,
+	)
+	s.NoError(err)
+}
+
+func (s *persistenceBasedClientSuite) TearDownTest() {
+	close(s.doneCh)
+}
+
+func (s *persistenceBasedClientSuite) TestNewPersistenceBasedClient_InvalidConfig() {
+	_, err := NewPersistenceBasedClient(
+		&PersistenceBasedClientConfig{PollInterval: time.Millisecond},
+		func() ([]*persistencespb.DynamicConfigEntry, error) { return nil, nil },
+		s.fallback,
+		log.NewNoopLogger(),
+		s.doneCh,
+	)
+	s.Error(err)
+
+	_, err = NewPersistenceBasedClient(
+		&PersistenceBasedClientConfig{PollInterval: time.Minute},
+		func() ([]*persistencespb.DynamicConfigEntry, error) { return nil, errors.New("unavailable") },
+		s.fallback,
+		log.NewNoopLogger(),
+		s.doneCh,
+	)
+	s.Error(err)
+}
+
+func (s *persistenceBasedClientSuite) TestGetIntValue() {
+	v, err := s.client.GetIntValue(testGetIntPropertyKey, nil, 1)
+	s.NoError(err)
+	s.Equal(1000, v)
+
+	v, err = s.client.GetIntValue(testGetIntPropertyKey, map[Filter]interface{}{Namespace: "samples-namespace"}, 1)
+	s.NoError(err)
+	s.Equal(2000, v)
+
+	v, err = s.client.GetIntValue(testGetIntPropertyKey, map[Filter]interface{}{Namespace: "other-namespace"}, 1)
+	s.NoError(err)
+	s.Equal(1000, v)
+}
+
+func (s *persistenceBasedClientSuite) TestGetIntValue_TaskQueueAndShardFilters() {
+	filters := map[Filter]interface{}{}
+	TaskQueueFilter("sample-task-queue")(filters)
+	TaskTypeFilter(enumspb.TASK_QUEUE_TYPE_ACTIVITY)(filters)
+	v, err := s.client.GetIntValue(testGetIntPropertyKey, filters, 1)
+	s.NoError(err)
+	s.Equal(3000, v)
+
+	filters = map[Filter]interface{}{}
+	ShardIDFilter(7)(filters)
+	v, err = s.client.GetIntValue(testGetIntPropertyKey, filters, 1)
+	s.NoError(err)
+	s.Equal(4000, v)
+}
+
+func (s *persistenceBasedClientSuite) TestGetFloatValue() {
+	v, err := s.client.GetFloatValue(testGetFloat64PropertyKey, nil, 1)
+	s.NoError(err)
+	s.Equal(12.5, v)
+}
+
+func (s *persistenceBasedClientSuite) TestGetMapValue() {
+	v, err := s.client.GetMapValue(testGetMapPropertyKey, nil, nil)
+	s.NoError(err)
+	s.Equal(map[string]interface{}{
+		"key1": "1",
+		"key2": 1,
+		"key3": []interface{}{false, map[string]interface{}{"key4": 1.5}},
+	}, v)
+}
+
+func (s *persistenceBasedClientSuite) TestFallback() {
+	s.fallback.Set(testGetBoolPropertyKey, true)
+	s.fallback.Set(testGetStringPropertyKey, "fallback")
+
+	b, err := s.client.GetBoolValue(testGetBoolPropertyKey, nil, false)
+	s.NoError(err)
+	s.True(b)
+
+	str, err := s.client.GetStringValue(testGetStringPropertyKey, nil, "default")
+	s.NoError(err)
+	s.Equal("fallback", str)
+
+	str, err = s.client.GetStringValue(unknownKey, nil, "default")
+	s.Error(err)
+	s.Equal("default", str)
+}
+
+func (s *persistenceBasedClientSuite) TestRefresh() {
+	s.entries = []*persistencespb.DynamicConfigEntry{
+		{
+			Key: "testGetIntPropertyKey",
+			Values: []*persistencespb.DynamicConfigValue{
+				{Value: []byte("5000")},
+			},
+		},
+	}
+	s.NoError(s.client.(Refresher).Refresh())
+
+	v, err := s.client.GetIntValue(testGetIntPropertyKey, map[Filter]interface{}{Namespace: "samples-namespace"}, 1)
+	s.NoError(err)
+	s.Equal(5000, v)
+
+	f, err := s.client.GetFloatValue(testGetFloat64PropertyKey, nil, 1)
+	s.Error(err)
+	s.Equal(float64(1), f)
+}
+
+func (s *persistenceBasedClientSuite) TestRefresh_InvalidValue() {
+	s.entries = []*persistencespb.DynamicConfigEntry{
+		{
+			Key: "testGetIntPropertyKey",
+			Values: []*persistencespb.DynamicConfigValue{
+				{Value: []byte("{")},
+			},
+		},
+		{
+			Key: "testGetFloat64PropertyKey",
+			Values: []*persistencespb.DynamicConfigValue{
+				{Value: []byte("25.5")},
+			},
+		},
+	}
+	s.NoError(s.client.(Refresher).Refresh())
+
+	// previously loaded values of the invalid key are kept, other keys are reloaded
+	v, err := s.client.GetIntValue(testGetIntPropertyKey, nil, 1)
+	s.NoError(err)
+	s.Equal(1000, v)
+	f, err := s.client.GetFloatValue(testGetFloat64PropertyKey, nil, 1)
+	s.NoError(err)
+	s.Equal(25.5, f)
+	_, err = s.client.GetValue(testGetMapPropertyKey, nil)
+	s.Error(err)
+}
@@ -0,0 +1,340 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.uber.org/multierr"
+
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	// ValueType is the type of the values of a dynamic config key
+	ValueType int
+
+	// Precedence describes which constraints can be set on the values of a dynamic config key.
+	// Values are looked up from the most specific constraints to the least specific ones,
+	// and the value without constraints is used if none of them matches.
+	Precedence int
+
+	// KeyDefinition describes a registered dynamic config key
+	KeyDefinition struct {
+		Key        Key
+		Type       ValueType
+		Precedence Precedence
+		// Default is the value used when the key is not set. It is nil for keys whose default
+		// depends on the static config of the service.
+		Default     interface{}
+		Description string
+	}
+)
+
+const (
+	TypeBool ValueType = iota
+	TypeInt
+	TypeFloat
+	TypeString
+	TypeMap
+	TypeDuration
+)
+
+const (
+	// PrecedenceGlobal keys only have values without constraints
+	PrecedenceGlobal Precedence = iota
+	// PrecedenceNamespace keys can be constrained by namespace
+	PrecedenceNamespace
+	// PrecedenceNamespaceID keys can be constrained by namespace id
+	PrecedenceNamespaceID
+	// PrecedenceTaskQueue keys can be constrained by namespace, task queue and task type,
+	// or by namespace and task queue
+	PrecedenceTaskQueue
+	// PrecedenceWorkflowType keys can be constrained by namespace and workflow type, or by namespace
+	PrecedenceWorkflowType
+	// PrecedenceShardID keys can be constrained by shard id
+	PrecedenceShardID
+)
+
+var valueTypeNames = map[ValueType]string{
+	TypeBool:     "bool",
+	TypeInt:      "int",
+	TypeFloat:    "float",
+	TypeString:   "string",
+	TypeMap:      "map",
+	TypeDuration: "duration",
+}
+
+var precedenceNames = map[Precedence]string{
+	PrecedenceGlobal:       "global",
+	PrecedenceNamespace:    "namespace",
+	PrecedenceNamespaceID:  "namespaceID",
+	PrecedenceTaskQueue:    "taskQueue",
+	PrecedenceWorkflowType: "workflowType",
+	PrecedenceShardID:      "shardID",
+}
+
+// keyRegistry holds definitions of all known keys by lower case key name
+var keyRegistry = make(map[string]*KeyDefinition)
+
+func init() {
+	for _, definition := range keyDefinitions {
+		registerKey(definition)
+	}
+}
+
+func registerKey(definition *KeyDefinition) {
+	name := strings.ToLower(definition.Key.String())
+	if _, ok := keyRegistry[name]; ok {
+		panic(fmt.Sprintf("dynamic config key %s is registered more than once", definition.Key))
+	}
+	keyRegistry[name] = definition
+}
+
+// GetKeyDefinition returns the definition of a registered key. Key names are case insensitive.
+func GetKeyDefinition(key Key) (*KeyDefinition, bool) {
+	definition, ok := keyRegistry[strings.ToLower(key.String())]
+	return definition, ok
+}
+
+// ListKeyDefinitions returns definitions of all registered keys sorted by key
+func ListKeyDefinitions() []*KeyDefinition {
+	result := make([]*KeyDefinition, 0, len(keyRegistry))
+	for _, definition := range keyRegistry {
+		result = append(result, definition)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
+
+func (t ValueType) String() string {
+	if name, ok := valueTypeNames[t]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+func (p Precedence) String() string {
+	if name, ok := precedenceNames[p]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// filterSets returns the constraints which can be set on values of keys with this precedence,
+// in the order they are looked up. The empty set of constraints is always allowed and not returned.
+func (p Precedence) filterSets() [][]Filter {
+	switch p {
+	case PrecedenceNamespace:
+		return [][]Filter{{Namespace}}
+	case PrecedenceNamespaceID:
+		return [][]Filter{{NamespaceID}}
+	case PrecedenceTaskQueue:
+		return [][]Filter{{Namespace, TaskQueueName, TaskType}, {Namespace, TaskQueueName}}
+	case PrecedenceWorkflowType:
+		return [][]Filter{{Namespace, WorkflowType}, {Namespace}}
+	case PrecedenceShardID:
+		return [][]Filter{{ShardID}}
+	default:
+		return nil
+	}
+}
+
+// Validate checks that the value and its constraints can be set for the key
+func (d *KeyDefinition) Validate(value interface{}, constraints map[string]interface{}) error {
+	return multierr.Combine(
+		d.validateValue(value),
+		d.validateConstraints(constraints),
+	)
+}
+
+func (d *KeyDefinition) validateValue(value interface{}) error {
+	var ok bool
+	switch d.Type {
+	case TypeBool:
+		_, ok = value.(bool)
+	case TypeInt:
+		_, ok = value.(int)
+	case TypeFloat:
+		switch value.(type) {
+		case float64, int:
+			ok = true
+		}
+	case TypeString:
+		_, ok = value.(string)
+	case TypeMap:
+		_, ok = value.(map[string]interface{})
+	case TypeDuration:
+		switch v := value.(type) {
+		case time.Duration:
+			ok = true
+		case string:
+			if _, err := timestamp.ParseDurationDefaultDays(v); err != nil {
+				return fmt.Errorf("key %s: invalid duration %q: %v", d.Key, v, err)
+			}
+			ok = true
+		}
+	}
+	if !ok {
+		return fmt.Errorf("key %s: value %v of type %T is not a valid %v", d.Key, value, value, d.Type)
+	}
+	return nil
+}
+
+func (d *KeyDefinition) validateConstraints(constraints map[string]interface{}) error {
+	if len(constraints) == 0 {
+		return nil
+	}
+
+	var errs error
+	for name, value := range constraints {
+		if err := validateConstraintValue(name, value); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("key %s: %w", d.Key, err))
+		}
+	}
+	if errs != nil {
+		return errs
+	}
+
+	for _, filterSet := range d.Precedence.filterSets() {
+		if len(filterSet) != len(constraints) {
+			continue
+		}
+		matched := true
+		for _, filter := range filterSet {
+			if _, ok := constraints[filter.String()]; !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return nil
+		}
+	}
+
+	names := make([]string, 0, len(constraints))
+	for name := range constraints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Errorf("key %s: constraints %v are not applicable to a key with %v precedence",
+		d.Key, strings.Join(names, ", "), d.Precedence)
+}
+
+func validateConstraintValue(name string, value interface{}) error {
+	switch name {
+	case Namespace.String(), NamespaceID.String(), TaskQueueName.String(), WorkflowType.String():
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("constraint %s must be a string", name)
+		}
+	case TaskType.String():
+		s, ok := value.(string)
+		if !ok || enumspb.TaskQueueType_value[s] == int32(enumspb.TASK_QUEUE_TYPE_UNSPECIFIED) {
+			return fmt.Errorf("constraint %s must be one of %v, %v",
+				name, enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+		}
+	case ShardID.String():
+		switch value.(type) {
+		case int, int32:
+		default:
+			return fmt.Errorf("constraint %s must be an integer", name)
+		}
+	default:
+		return fmt.Errorf("unknown constraint %s", name)
+	}
+	return nil
+}
+
+// validateConfigValues checks that all keys are registered and all values and constraints are valid
+func validateConfigValues(values configValueMap) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs error
+	for _, key := range keys {
+		definition, ok := GetKeyDefinition(Key(key))
+		if !ok {
+			errs = multierr.Append(errs, fmt.Errorf("unknown key %s", key))
+			continue
+		}
+		for _, v := range values[key] {
+			errs = multierr.Append(errs, definition.Validate(v.Value, v.Constraints))
+		}
+	}
+	return errs
+}
+
+// EffectiveValue returns the value of the key read with the given filters the same way the
+// property functions of Collection do. It returns the default value and true if the key is not set
+// for the filters.
+func (d *KeyDefinition) EffectiveValue(client Client, filters map[Filter]interface{}) (interface{}, bool) {
+	for _, filterSet := range d.Precedence.filterSets() {
+		filterMap := make(map[Filter]interface{}, len(filterSet))
+		for _, filter := range filterSet {
+			if value, ok := filters[filter]; ok {
+				filterMap[filter] = value
+			}
+		}
+		if len(filterMap) != len(filterSet) {
+			continue
+		}
+		if value, err := client.GetValueWithFilters(d.Key, filterMap, nil); err == nil && value != nil {
+			return value, false
+		}
+	}
+	if value, err := client.GetValueWithFilters(d.Key, nil, nil); err == nil && value != nil {
+		return value, false
+	}
+	return d.Default, true
+}
+
+func defineBool(key Key, precedence Precedence, defaultValue bool, description string) *KeyDefinition {
+	return &KeyDefinition{Key: key, Type: TypeBool, Precedence: precedence, Default: defaultValue, Description: description}
+}
+
+func defineInt(key Key, precedence Precedence, defaultValue int, description string) *KeyDefinition {
+	return &KeyDefinition{Key: key, Type: TypeInt, Precedence: precedence, Default: defaultValue, Description: description}
+}
+
+func defineFloat(key Key, precedence Precedence, defaultValue float64, description string) *KeyDefinition {
+	return &KeyDefinition{Key: key, Type: TypeFloat, Precedence: precedence, Default: defaultValue, Description: description}
+}
+
+func defineString(key Key, precedence Precedence, defaultValue string, description string) *KeyDefinition {
+	return &KeyDefinition{Key: key, Type: TypeString, Precedence: precedence, Default: defaultValue, Description: description}
+}
+
+func defineDuration(key Key, precedence Precedence, defaultValue time.Duration, description string) *KeyDefinition {
+	return &KeyDefinition{Key: key, Type: TypeDuration, Precedence: precedence, Default: defaultValue, Description: description}
+}
@@ -0,0 +1,261 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/multierr"
+)
+
+type registrySuite struct {
+	suite.Suite
+	*require.Assertions
+}
+
+func TestRegistrySuite(t *testing.T) {
+	s := new(registrySuite)
+	suite.Run(t, s)
+}
+
+func (s *registrySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *registrySuite) TestAllKeysRegistered() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "constants.go", nil, 0)
+	s.NoError(err)
+
+	var names []string
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			if len(valueSpec.Values) != 1 {
+				continue
+			}
+			if lit, ok := valueSpec.Values[0].(*ast.BasicLit); !ok || lit.Kind != token.STRING {
+				continue
+			}
+			for _, name := range valueSpec.Names {
+				if name.IsExported() {
+					names = append(names, name.Name)
+				}
+			}
+		}
+	}
+	s.NotEmpty(names)
+
+	registered := make(map[string]struct{}, len(keyDefinitions))
+	for _, definition := range keyDefinitions {
+		registered[definition.Key.String()] = struct{}{}
+	}
+	s.Equal(len(names), len(keyDefinitions))
+	for _, name := range names {
+		obj := file.Scope.Lookup(name)
+		value := obj.Decl.(*ast.ValueSpec).Values[0].(*ast.BasicLit).Value
+		_, ok := registered[value[1:len(value)-1]]
+		s.True(ok, "key %s is not registered", name)
+	}
+}
+
+func (s *registrySuite) TestGetKeyDefinition() {
+	definition, ok := GetKeyDefinition("FRONTEND.NAMESPACErps")
+	s.True(ok)
+	s.Equal(Key(FrontendMaxNamespaceRPSPerInstance), definition.Key)
+	s.Equal(TypeInt, definition.Type)
+	s.Equal(PrecedenceNamespace, definition.Precedence)
+	s.Equal(2400, definition.Default)
+
+	_, ok = GetKeyDefinition("unknown.key")
+	s.False(ok)
+}
+
+func (s *registrySuite) TestValidate() {
+	testCases := []struct {
+		name        string
+		key         Key
+		value       interface{}
+		constraints map[string]interface{}
+		valid       bool
+	}{
+		{
+			name:  "int",
+			key:   FrontendRPS,
+			value: 100,
+			valid: true,
+		},
+		{
+			name:  "int of wrong type",
+			key:   FrontendRPS,
+			value: "100",
+		},
+		{
+			name:  "float from int",
+			key:   TimerProcessorUpdateAckIntervalJitterCoefficient,
+			value: 10,
+			valid: true,
+		},
+		{
+			name:  "duration string",
+			key:   AcquireShardInterval,
+			value: "2m",
+			valid: true,
+		},
+		{
+			name:  "duration in days",
+			key:   AcquireShardInterval,
+			value: "1d",
+			valid: true,
+		},
+		{
+			name:  "invalid duration",
+			key:   AcquireShardInterval,
+			value: "one minute",
+		},
+		{
+			name:        "namespace constraint",
+			key:         FrontendMaxNamespaceRPSPerInstance,
+			value:       100,
+			constraints: map[string]interface{}{"namespace": "samples-namespace"},
+			valid:       true,
+		},
+		{
+			name:        "constraint not applicable to global key",
+			key:         FrontendRPS,
+			value:       100,
+			constraints: map[string]interface{}{"namespace": "samples-namespace"},
+		},
+		{
+			name:  "task queue constraints",
+			key:   MatchingNumTaskqueueReadPartitions,
+			value: 4,
+			constraints: map[string]interface{}{
+				"namespace":     "samples-namespace",
+				"taskQueueName": "sample-task-queue",
+				"taskType":      "Activity",
+			},
+			valid: true,
+		},
+		{
+			name:        "incomplete task queue constraints",
+			key:         MatchingNumTaskqueueReadPartitions,
+			value:       4,
+			constraints: map[string]interface{}{"taskQueueName": "sample-task-queue"},
+		},
+		{
+			name:  "invalid task type",
+			key:   MatchingNumTaskqueueReadPartitions,
+			value: 4,
+			constraints: map[string]interface{}{
+				"namespace":     "samples-namespace",
+				"taskQueueName": "sample-task-queue",
+				"taskType":      "Query",
+			},
+		},
+		{
+			name:        "unknown constraint",
+			key:         FrontendMaxNamespaceRPSPerInstance,
+			value:       100,
+			constraints: map[string]interface{}{"cluster": "active"},
+		},
+	}
+
+	for _, tc := range testCases {
+		definition, ok := GetKeyDefinition(tc.key)
+		s.True(ok, tc.name)
+		err := definition.Validate(tc.value, tc.constraints)
+		if tc.valid {
+			s.NoError(err, tc.name)
+		} else {
+			s.Error(err, tc.name)
+		}
+	}
+}
+
+func (s *registrySuite) TestValidateConfigValues() {
+	values := configValueMap{
+		"frontend.rps": {
+			{Value: "100"},
+		},
+		"frontend.namespacerps": {
+			{Value: 100, Constraints: map[string]interface{}{"namespace": "samples-namespace"}},
+			{Value: 200},
+		},
+		"unknown.key": {
+			{Value: true},
+		},
+	}
+	err := validateConfigValues(values)
+	s.Error(err)
+	errs := multierr.Errors(err)
+	s.Len(errs, 2)
+	s.Contains(errs[0].Error(), "frontend.rps")
+	s.Contains(errs[1].Error(), "unknown key unknown.key")
+}
+
+func (s *registrySuite) TestEffectiveValue() {
+	client := NewMutableEphemeralClient(
+		Set(MatchingNumTaskqueueReadPartitions, 8, ForNamespace("samples-namespace"), ForTaskQueueName("sample-task-queue")),
+		Set(FrontendMaxNamespaceRPSPerInstance, 100),
+		Add(FrontendMaxNamespaceRPSPerInstance, 200, ForNamespace("samples-namespace")),
+	)
+
+	definition, ok := GetKeyDefinition(MatchingNumTaskqueueReadPartitions)
+	s.True(ok)
+	value, isDefault := definition.EffectiveValue(client, map[Filter]interface{}{
+		Namespace:     "samples-namespace",
+		TaskQueueName: "sample-task-queue",
+		TaskType:      "Workflow",
+	})
+	s.False(isDefault)
+	s.Equal(8, value)
+
+	value, isDefault = definition.EffectiveValue(client, map[Filter]interface{}{
+		Namespace:     "samples-namespace",
+		TaskQueueName: "other-task-queue",
+	})
+	s.True(isDefault)
+	s.Equal(DefaultNumTaskQueuePartitions, value)
+
+	definition, ok = GetKeyDefinition(FrontendMaxNamespaceRPSPerInstance)
+	s.True(ok)
+	value, isDefault = definition.EffectiveValue(client, map[Filter]interface{}{Namespace: "samples-namespace"})
+	s.False(isDefault)
+	s.Equal(200, value)
+
+	value, isDefault = definition.EffectiveValue(client, map[Filter]interface{}{Namespace: "other-namespace"})
+	s.False(isDefault)
+	s.Equal(100, value)
+}
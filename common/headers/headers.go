@@ -36,6 +36,12 @@ const (
 	SupportedServerVersionsHeaderName = "supported-server-versions"
 	SupportedFeaturesHeaderName       = "supported-features"
 	SupportedFeaturesHeaderDelim      = ","
+
+	// HistorySizeBytesHeaderName and SuggestContinueAsNewHeaderName are response headers of
+	// workflow task polls, they carry history size and continue-as-new suggestion of the
+	// workflow execution until the public API has fields for them.
+	HistorySizeBytesHeaderName     = "history-size-bytes"
+	SuggestContinueAsNewHeaderName = "suggest-continue-as-new"
 )
 
 var (
@@ -0,0 +1,123 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	dnsLookupTimeout = 5 * time.Second
+)
+
+type (
+	// DNSResolver is the subset of net.Resolver used to discover members through DNS
+	DNSResolver interface {
+		LookupHost(ctx context.Context, host string) ([]string, error)
+		LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+	}
+
+	dnsHostListProvider struct {
+		records      map[string]string
+		servicePorts map[string]int
+		resolver     DNSResolver
+	}
+)
+
+var _ HostListProvider = (*dnsHostListProvider)(nil)
+
+// NewDNSHostListProvider returns a provider resolving the members of each service from a DNS name.
+// Names with a leading underscore are resolved as SRV records and use the ports from the records,
+// other names are resolved to their addresses and use the service gRPC port from servicePorts.
+// SRV targets are resolved to addresses as well, so that members are identified by the same
+// ip:port they advertise as their broadcast address.
+func NewDNSHostListProvider(
+	records map[string]string,
+	servicePorts map[string]int,
+	resolver DNSResolver,
+) HostListProvider {
+	return &dnsHostListProvider{
+		records:      records,
+		servicePorts: servicePorts,
+		resolver:     resolver,
+	}
+}
+
+func (p *dnsHostListProvider) Hosts(service string) ([]string, error) {
+	name, ok := p.records[service]
+	if !ok {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dnsLookupTimeout)
+	defer cancel()
+
+	set := make(map[string]struct{})
+	if strings.HasPrefix(name, "_") {
+		_, records, err := p.resolver.LookupSRV(ctx, "", "", name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve SRV record %v: %w", name, err)
+		}
+		for _, record := range records {
+			addrs, err := p.resolver.LookupHost(ctx, strings.TrimSuffix(record.Target, "."))
+			if err != nil {
+				return nil, fmt.Errorf("unable to resolve SRV target %v: %w", record.Target, err)
+			}
+			for _, addr := range addrs {
+				set[net.JoinHostPort(addr, strconv.Itoa(int(record.Port)))] = struct{}{}
+			}
+		}
+	} else {
+		port, ok := p.servicePorts[service]
+		if !ok {
+			return nil, ErrUnknownService
+		}
+		addrs, err := p.resolver.LookupHost(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve host %v: %w", name, err)
+		}
+		for _, addr := range addrs {
+			set[net.JoinHostPort(addr, strconv.Itoa(port))] = struct{}{}
+		}
+	}
+
+	if len(set) == 0 {
+		// an empty answer, e.g. during a rollout or a DNS outage, must not empty the ring
+		return nil, fmt.Errorf("DNS name %v resolved to no addresses", name)
+	}
+
+	hosts := make([]string, 0, len(set))
+	for host := range set {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts, nil
+}
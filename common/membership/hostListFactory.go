@@ -0,0 +1,126 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+// HostListFactory builds membership monitors backed by the static or dns membership providers
+type HostListFactory struct {
+	config         *config.Membership
+	serviceName    string
+	servicePortMap map[string]int
+	rpcFactory     common.RPCFactory
+	resolver       DNSResolver
+	logger         log.Logger
+
+	sync.Mutex
+	membershipMonitor Monitor
+}
+
+// NewHostListFactory builds a host list factory conforming
+// to the underlying configuration
+func NewHostListFactory(
+	cfg *config.Membership,
+	serviceName string,
+	servicePortMap map[string]int,
+	rpcFactory common.RPCFactory,
+	logger log.Logger,
+) (*HostListFactory, error) {
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Provider != config.MembershipProviderStatic && cfg.Provider != config.MembershipProviderDNS {
+		return nil, fmt.Errorf("membership provider %q is not backed by a host list", cfg.Provider)
+	}
+	return &HostListFactory{
+		config:         cfg,
+		serviceName:    serviceName,
+		servicePortMap: servicePortMap,
+		rpcFactory:     rpcFactory,
+		resolver:       net.DefaultResolver,
+		logger:         logger,
+	}, nil
+}
+
+// GetMembershipMonitor return a membership monitor
+func (factory *HostListFactory) GetMembershipMonitor() (Monitor, error) {
+	factory.Lock()
+	defer factory.Unlock()
+
+	if factory.membershipMonitor != nil {
+		return factory.membershipMonitor, nil
+	}
+
+	var provider HostListProvider
+	refreshInterval := defaultRefreshInterval
+	switch factory.config.Provider {
+	case config.MembershipProviderStatic:
+		provider = NewStaticHostListProvider(factory.config.Static.Hosts)
+	case config.MembershipProviderDNS:
+		provider = NewDNSHostListProvider(factory.config.DNS.Records, factory.servicePortMap, factory.resolver)
+		if factory.config.DNS.RefreshInterval > 0 {
+			refreshInterval = factory.config.DNS.RefreshInterval
+		}
+	}
+
+	factory.membershipMonitor = NewHostListMonitor(
+		factory.serviceName,
+		factory.servicePortMap,
+		provider,
+		refreshInterval,
+		factory.logger,
+		factory.broadcastAddressResolver,
+	)
+	return factory.membershipMonitor, nil
+}
+
+// broadcastAddressResolver returns the address this host is known by in the host lists:
+// the configured broadcast address if any, otherwise the address of the gRPC listener.
+func (factory *HostListFactory) broadcastAddressResolver() (string, error) {
+	port, ok := factory.servicePortMap[factory.serviceName]
+	if !ok {
+		return "", ErrUnknownService
+	}
+	if factory.config.BroadcastAddress != "" {
+		return net.JoinHostPort(factory.config.BroadcastAddress, strconv.Itoa(port)), nil
+	}
+
+	addr, ok := factory.rpcFactory.GetGRPCListener().Addr().(*net.TCPAddr)
+	if !ok || addr.IP.IsUnspecified() {
+		return "", errors.New("broadcastAddress must be set when the gRPC listener is not bound to a specific IP")
+	}
+	return addr.String(), nil
+}
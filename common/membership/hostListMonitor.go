@@ -0,0 +1,190 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type hostListMonitor struct {
+	status int32
+
+	serviceName               string
+	services                  map[string]int
+	rings                     map[string]*hostListServiceResolver
+	logger                    log.Logger
+	broadcastHostPortResolver func() (string, error)
+}
+
+var _ Monitor = (*hostListMonitor)(nil)
+
+// NewHostListMonitor returns a membership monitor whose rings are built from the
+// host lists returned by the given provider, refreshed every refreshInterval.
+// Members are not gossiped: the provider is the only source of truth, which makes it
+// possible to let an orchestrator such as Kubernetes drive shard and task queue ownership.
+func NewHostListMonitor(
+	serviceName string,
+	services map[string]int,
+	provider HostListProvider,
+	refreshInterval time.Duration,
+	logger log.Logger,
+	broadcastHostPortResolver func() (string, error),
+) Monitor {
+
+	if refreshInterval <= 0 {
+		refreshInterval = defaultRefreshInterval
+	}
+	m := &hostListMonitor{
+		status:                    common.DaemonStatusInitialized,
+		serviceName:               serviceName,
+		services:                  services,
+		rings:                     make(map[string]*hostListServiceResolver),
+		logger:                    logger,
+		broadcastHostPortResolver: broadcastHostPortResolver,
+	}
+	for service := range services {
+		m.rings[service] = newHostListServiceResolver(service, provider, refreshInterval, logger)
+	}
+	return m
+}
+
+func (m *hostListMonitor) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	if _, err := m.broadcastHostPortResolver(); err != nil {
+		m.logger.Fatal("unable to resolve broadcast address", tag.Error(err))
+	}
+
+	for _, ring := range m.rings {
+		ring.Start()
+	}
+}
+
+func (m *hostListMonitor) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	for _, ring := range m.rings {
+		ring.Stop()
+	}
+}
+
+// WhoAmI returns the broadcast address of this host, which is expected
+// to match the address returned for it by the host list provider.
+func (m *hostListMonitor) WhoAmI() (*HostInfo, error) {
+	address, err := m.broadcastHostPortResolver()
+	if err != nil {
+		return nil, err
+	}
+	return NewHostInfo(address, map[string]string{RoleKey: m.serviceName}), nil
+}
+
+// EvictSelf removes this host from the local view of the ring of its own service.
+// Other members only stop routing to this host once the provider stops returning it,
+// eg. when the orchestrator removes the terminating pod from the DNS records.
+func (m *hostListMonitor) EvictSelf() error {
+	ring, ok := m.rings[m.serviceName]
+	if !ok {
+		return ErrUnknownService
+	}
+	address, err := m.broadcastHostPortResolver()
+	if err != nil {
+		return err
+	}
+	return ring.exclude(address)
+}
+
+func (m *hostListMonitor) GetResolver(service string) (ServiceResolver, error) {
+	ring, found := m.rings[service]
+	if !found {
+		return nil, ErrUnknownService
+	}
+	return ring, nil
+}
+
+func (m *hostListMonitor) Lookup(service string, key string) (*HostInfo, error) {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return nil, err
+	}
+	return ring.Lookup(key)
+}
+
+func (m *hostListMonitor) AddListener(service string, name string, notifyChannel chan<- *ChangedEvent) error {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.AddListener(name, notifyChannel)
+}
+
+func (m *hostListMonitor) RemoveListener(service string, name string) error {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.RemoveListener(name)
+}
+
+func (m *hostListMonitor) GetReachableMembers() ([]string, error) {
+	set := make(map[string]struct{})
+	for _, ring := range m.rings {
+		for _, host := range ring.Members() {
+			set[host.GetAddress()] = struct{}{}
+		}
+	}
+
+	members := make([]string, 0, len(set))
+	for addr := range set {
+		members = append(members, addr)
+	}
+	sort.Strings(members)
+	return members, nil
+}
+
+func (m *hostListMonitor) GetMemberCount(service string) (int, error) {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return 0, err
+	}
+	return ring.MemberCount(), nil
+}
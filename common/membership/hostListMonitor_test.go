@@ -0,0 +1,301 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	hostListMonitorSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	testHostListProvider struct {
+		sync.Mutex
+		hosts map[string][]string
+	}
+
+	testDNSResolver struct {
+		sync.Mutex
+		hosts map[string][]string
+		srvs  map[string][]*net.SRV
+	}
+)
+
+func TestHostListMonitorSuite(t *testing.T) {
+	suite.Run(t, new(hostListMonitorSuite))
+}
+
+func (s *hostListMonitorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *hostListMonitorSuite) TestStaticProvider() {
+	hosts := map[string][]string{
+		primitives.HistoryService: {"10.0.0.1:7234", "10.0.0.2:7234"},
+	}
+	monitor := s.newMonitor(NewStaticHostListProvider(hosts), "10.0.0.1:7234")
+	monitor.Start()
+	defer monitor.Stop()
+
+	count, err := monitor.GetMemberCount(primitives.HistoryService)
+	s.NoError(err)
+	s.Equal(2, count)
+
+	host, err := monitor.Lookup(primitives.HistoryService, "key")
+	s.NoError(err)
+	s.Contains(hosts[primitives.HistoryService], host.GetAddress())
+
+	_, err = monitor.Lookup(primitives.MatchingService, "key")
+	s.Equal(ErrInsufficientHosts, err)
+	_, err = monitor.GetResolver("unknown")
+	s.Equal(ErrUnknownService, err)
+
+	self, err := monitor.WhoAmI()
+	s.NoError(err)
+	s.Equal("10.0.0.1:7234", self.GetAddress())
+
+	members, err := monitor.GetReachableMembers()
+	s.NoError(err)
+	s.Equal([]string{"10.0.0.1:7234", "10.0.0.2:7234"}, members)
+}
+
+func (s *hostListMonitorSuite) TestMembershipChange() {
+	provider := &testHostListProvider{
+		hosts: map[string][]string{
+			primitives.HistoryService: {"10.0.0.1:7234", "10.0.0.2:7234"},
+		},
+	}
+	monitor := s.newMonitor(provider, "10.0.0.1:7234")
+	monitor.Start()
+	defer monitor.Stop()
+
+	listenCh := make(chan *ChangedEvent, 5)
+	s.NoError(monitor.AddListener(primitives.HistoryService, "test-listener", listenCh))
+	s.Equal(ErrListenerAlreadyExist, monitor.AddListener(primitives.HistoryService, "test-listener", listenCh))
+
+	provider.set(primitives.HistoryService, []string{"10.0.0.1:7234", "10.0.0.3:7234"})
+
+	select {
+	case e := <-listenCh:
+		s.Len(e.HostsAdded, 1)
+		s.Equal("10.0.0.3:7234", e.HostsAdded[0].GetAddress())
+		s.Len(e.HostsRemoved, 1)
+		s.Equal("10.0.0.2:7234", e.HostsRemoved[0].GetAddress())
+		s.Nil(e.HostsUpdated)
+	case <-time.After(5 * time.Second):
+		s.Fail("Timed out waiting for membership change")
+	}
+
+	for i := 0; i < 100; i++ {
+		host, err := monitor.Lookup(primitives.HistoryService, string(rune('a'+i)))
+		s.NoError(err)
+		s.NotEqual("10.0.0.2:7234", host.GetAddress())
+	}
+
+	s.NoError(monitor.RemoveListener(primitives.HistoryService, "test-listener"))
+}
+
+func (s *hostListMonitorSuite) TestEvictSelf() {
+	provider := NewStaticHostListProvider(map[string][]string{
+		primitives.HistoryService: {"10.0.0.1:7234", "10.0.0.2:7234"},
+	})
+	monitor := s.newMonitor(provider, "10.0.0.1:7234")
+	monitor.Start()
+	defer monitor.Stop()
+
+	listenCh := make(chan *ChangedEvent, 5)
+	s.NoError(monitor.AddListener(primitives.HistoryService, "test-listener", listenCh))
+
+	s.NoError(monitor.EvictSelf())
+
+	select {
+	case e := <-listenCh:
+		s.Len(e.HostsRemoved, 1)
+		s.Equal("10.0.0.1:7234", e.HostsRemoved[0].GetAddress())
+	default:
+		s.Fail("EvictSelf did not notify listeners")
+	}
+
+	resolver, err := monitor.GetResolver(primitives.HistoryService)
+	s.NoError(err)
+	s.Equal(1, resolver.MemberCount())
+	s.Equal("10.0.0.2:7234", resolver.Members()[0].GetAddress())
+}
+
+func (s *hostListMonitorSuite) TestDNSProvider_SRV() {
+	resolver := &testDNSResolver{
+		srvs: map[string][]*net.SRV{
+			"_grpc._tcp.history.temporal": {
+				{Target: "history-0.history.temporal.", Port: 7234},
+				{Target: "history-1.history.temporal.", Port: 7234},
+			},
+		},
+		hosts: map[string][]string{
+			"history-0.history.temporal": {"10.0.0.1"},
+			"history-1.history.temporal": {"10.0.0.2"},
+		},
+	}
+	provider := NewDNSHostListProvider(
+		map[string]string{primitives.HistoryService: "_grpc._tcp.history.temporal"},
+		map[string]int{primitives.HistoryService: 7234},
+		resolver,
+	)
+
+	hosts, err := provider.Hosts(primitives.HistoryService)
+	s.NoError(err)
+	s.Equal([]string{"10.0.0.1:7234", "10.0.0.2:7234"}, hosts)
+
+	hosts, err = provider.Hosts(primitives.MatchingService)
+	s.NoError(err)
+	s.Empty(hosts)
+}
+
+func (s *hostListMonitorSuite) TestDNSProvider_Host() {
+	resolver := &testDNSResolver{
+		hosts: map[string][]string{
+			"matching.temporal": {"10.0.1.2", "10.0.1.1", "10.0.1.2"},
+		},
+	}
+	provider := NewDNSHostListProvider(
+		map[string]string{
+			primitives.MatchingService: "matching.temporal",
+			primitives.WorkerService:   "worker.temporal",
+		},
+		map[string]int{primitives.MatchingService: 7235, primitives.WorkerService: 7239},
+		resolver,
+	)
+
+	hosts, err := provider.Hosts(primitives.MatchingService)
+	s.NoError(err)
+	s.Equal([]string{"10.0.1.1:7235", "10.0.1.2:7235"}, hosts)
+
+	_, err = provider.Hosts(primitives.WorkerService)
+	s.Error(err)
+}
+
+func (s *hostListMonitorSuite) TestDNSProvider_EmptyAnswer() {
+	resolver := &testDNSResolver{
+		srvs: map[string][]*net.SRV{
+			"_grpc._tcp.history.temporal": {
+				{Target: "history-0.history.temporal.", Port: 7234},
+			},
+		},
+		hosts: map[string][]string{
+			"history-0.history.temporal": {"10.0.0.1"},
+			"matching.temporal":          {"10.0.1.1"},
+		},
+	}
+	provider := NewDNSHostListProvider(
+		map[string]string{
+			primitives.HistoryService:  "_grpc._tcp.history.temporal",
+			primitives.MatchingService: "matching.temporal",
+		},
+		map[string]int{primitives.HistoryService: 7234, primitives.MatchingService: 7235},
+		resolver,
+	)
+	monitor := s.newMonitor(provider, "10.0.0.1:7234")
+	monitor.Start()
+	defer monitor.Stop()
+
+	resolver.Lock()
+	resolver.srvs["_grpc._tcp.history.temporal"] = nil
+	resolver.hosts["matching.temporal"] = nil
+	resolver.Unlock()
+
+	_, err := provider.Hosts(primitives.HistoryService)
+	s.Error(err)
+	_, err = provider.Hosts(primitives.MatchingService)
+	s.Error(err)
+
+	// the ring keeps the last known members while DNS answers are empty
+	time.Sleep(300 * time.Millisecond)
+	host, err := monitor.Lookup(primitives.HistoryService, "key")
+	s.NoError(err)
+	s.Equal("10.0.0.1:7234", host.GetAddress())
+	host, err = monitor.Lookup(primitives.MatchingService, "key")
+	s.NoError(err)
+	s.Equal("10.0.1.1:7235", host.GetAddress())
+}
+
+func (s *hostListMonitorSuite) newMonitor(provider HostListProvider, self string) Monitor {
+	services := map[string]int{
+		primitives.HistoryService:  7234,
+		primitives.MatchingService: 7235,
+	}
+	return NewHostListMonitor(
+		primitives.HistoryService,
+		services,
+		provider,
+		100*time.Millisecond,
+		log.NewNoopLogger(),
+		func() (string, error) { return self, nil },
+	)
+}
+
+func (p *testHostListProvider) Hosts(service string) ([]string, error) {
+	p.Lock()
+	defer p.Unlock()
+	return p.hosts[service], nil
+}
+
+func (p *testHostListProvider) set(service string, hosts []string) {
+	p.Lock()
+	defer p.Unlock()
+	p.hosts[service] = hosts
+}
+
+func (r *testDNSResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	r.Lock()
+	defer r.Unlock()
+	addrs, ok := r.hosts[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return addrs, nil
+}
+
+func (r *testDNSResolver) LookupSRV(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+	r.Lock()
+	defer r.Unlock()
+	srvs, ok := r.srvs[name]
+	if !ok {
+		return "", nil, errors.New("no such host")
+	}
+	return name, srvs, nil
+}
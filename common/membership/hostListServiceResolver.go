@@ -0,0 +1,297 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/temporalio/ringpop-go/hashring"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type hostListServiceResolver struct {
+	status          int32
+	service         string
+	provider        HostListProvider
+	refreshInterval time.Duration
+	refreshChan     chan struct{}
+	shutdownCh      chan struct{}
+	shutdownWG      sync.WaitGroup
+	logger          log.Logger
+
+	ringValue atomic.Value // this stores the current hashring
+
+	refreshLock     sync.Mutex
+	lastRefreshTime time.Time
+	membersMap      map[string]struct{} // for computing change notifications
+	excluded        map[string]struct{} // members removed from the ring regardless of the provider
+
+	listenerLock sync.RWMutex
+	listeners    map[string]chan<- *ChangedEvent
+}
+
+var _ ServiceResolver = (*hostListServiceResolver)(nil)
+
+func newHostListServiceResolver(
+	service string,
+	provider HostListProvider,
+	refreshInterval time.Duration,
+	logger log.Logger,
+) *hostListServiceResolver {
+
+	resolver := &hostListServiceResolver{
+		status:          common.DaemonStatusInitialized,
+		service:         service,
+		provider:        provider,
+		refreshInterval: refreshInterval,
+		refreshChan:     make(chan struct{}),
+		shutdownCh:      make(chan struct{}),
+		logger:          log.With(logger, tag.ComponentServiceResolver, tag.Service(service)),
+		membersMap:      make(map[string]struct{}),
+		excluded:        make(map[string]struct{}),
+		listeners:       make(map[string]chan<- *ChangedEvent),
+	}
+	resolver.ringValue.Store(newHashRing())
+	return resolver
+}
+
+// Start starts the resolver
+func (r *hostListServiceResolver) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	// unlike ringpop, the provider may legitimately be unavailable at startup (eg. DNS records
+	// not yet published by the orchestrator), so the ring is filled in by the refresh worker
+	if err := r.refresh(); err != nil {
+		r.logger.Error("unable to resolve initial members", tag.Error(err))
+	}
+
+	r.shutdownWG.Add(1)
+	go r.refreshRingWorker()
+}
+
+// Stop stops the resolver
+func (r *hostListServiceResolver) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&r.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	// wait for the refresh worker first, as it notifies listeners under the listener lock
+	close(r.shutdownCh)
+	if success := common.AwaitWaitGroup(&r.shutdownWG, time.Minute); !success {
+		r.logger.Warn("service resolver timed out on shutdown.")
+	}
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	r.ringValue.Store(newHashRing())
+	r.listeners = make(map[string]chan<- *ChangedEvent)
+}
+
+// Lookup finds the host in the ring responsible for serving the given key
+func (r *hostListServiceResolver) Lookup(
+	key string,
+) (*HostInfo, error) {
+
+	addr, found := r.ring().Lookup(key)
+	if !found {
+		select {
+		case r.refreshChan <- struct{}{}:
+		default:
+		}
+		return nil, ErrInsufficientHosts
+	}
+
+	return NewHostInfo(addr, r.getLabelsMap()), nil
+}
+
+func (r *hostListServiceResolver) AddListener(
+	name string,
+	notifyChannel chan<- *ChangedEvent,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	_, ok := r.listeners[name]
+	if ok {
+		return ErrListenerAlreadyExist
+	}
+	r.listeners[name] = notifyChannel
+	return nil
+}
+
+func (r *hostListServiceResolver) RemoveListener(
+	name string,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	delete(r.listeners, name)
+	return nil
+}
+
+func (r *hostListServiceResolver) MemberCount() int {
+	return r.ring().ServerCount()
+}
+
+func (r *hostListServiceResolver) Members() []*HostInfo {
+	var servers []*HostInfo
+	for _, s := range r.ring().Servers() {
+		servers = append(servers, NewHostInfo(s, r.getLabelsMap()))
+	}
+
+	return servers
+}
+
+// exclude removes the given member from the ring, even if it is still returned by the provider
+func (r *hostListServiceResolver) exclude(addr string) error {
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+	r.excluded[addr] = struct{}{}
+	return r.refreshNoLock()
+}
+
+func (r *hostListServiceResolver) refresh() error {
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+	return r.refreshNoLock()
+}
+
+func (r *hostListServiceResolver) refreshWithBackoff() error {
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+	if r.lastRefreshTime.After(time.Now().UTC().Add(-minRefreshInternal)) {
+		// refresh too frequently
+		return nil
+	}
+	return r.refreshNoLock()
+}
+
+func (r *hostListServiceResolver) refreshNoLock() error {
+	hosts, err := r.provider.Hosts(r.service)
+	if err != nil {
+		return err
+	}
+	r.lastRefreshTime = time.Now().UTC()
+
+	newMembersMap := make(map[string]struct{}, len(hosts))
+	for _, addr := range hosts {
+		if _, ok := r.excluded[addr]; !ok {
+			newMembersMap[addr] = struct{}{}
+		}
+	}
+
+	event := &ChangedEvent{}
+	for addr := range newMembersMap {
+		if _, ok := r.membersMap[addr]; !ok {
+			event.HostsAdded = append(event.HostsAdded, NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	for addr := range r.membersMap {
+		if _, ok := newMembersMap[addr]; !ok {
+			event.HostsRemoved = append(event.HostsRemoved, NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	if len(event.HostsAdded) == 0 && len(event.HostsRemoved) == 0 {
+		return nil
+	}
+
+	addrs := make([]string, 0, len(newMembersMap))
+	ring := newHashRing()
+	for addr := range newMembersMap {
+		addrs = append(addrs, addr)
+		ring.AddMembers(NewHostInfo(addr, r.getLabelsMap()))
+	}
+	sort.Strings(addrs)
+
+	r.membersMap = newMembersMap
+	r.ringValue.Store(ring)
+	r.logger.Info("Current reachable members", tag.Addresses(addrs))
+	r.emitEvent(event)
+	return nil
+}
+
+func (r *hostListServiceResolver) emitEvent(
+	event *ChangedEvent,
+) {
+
+	r.listenerLock.RLock()
+	defer r.listenerLock.RUnlock()
+
+	for name, ch := range r.listeners {
+		select {
+		case ch <- event:
+		default:
+			r.logger.Error("Failed to send listener notification, channel full", tag.ListenerName(name))
+		}
+	}
+}
+
+func (r *hostListServiceResolver) refreshRingWorker() {
+	defer r.shutdownWG.Done()
+
+	refreshTicker := time.NewTicker(r.refreshInterval)
+	defer refreshTicker.Stop()
+
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-r.refreshChan:
+			if err := r.refreshWithBackoff(); err != nil {
+				r.logger.Error("error refreshing ring", tag.Error(err))
+			}
+		case <-refreshTicker.C:
+			if err := r.refresh(); err != nil {
+				r.logger.Error("error periodically refreshing ring", tag.Error(err))
+			}
+		}
+	}
+}
+
+func (r *hostListServiceResolver) ring() *hashring.HashRing {
+	return r.ringValue.Load().(*hashring.HashRing)
+}
+
+func (r *hostListServiceResolver) getLabelsMap() map[string]string {
+	labels := make(map[string]string)
+	labels[RoleKey] = r.service
+	return labels
+}
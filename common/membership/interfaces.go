@@ -99,4 +99,11 @@ type (
 		// Members returns all host addresses in hashring for any particular role
 		Members() []*HostInfo
 	}
+
+	// HostListProvider returns the current members of a temporal service. It is used to drive
+	// the membership ring from an external source of truth, such as a static list or DNS.
+	HostListProvider interface {
+		// Hosts returns the host:port addresses of all members of the given service
+		Hosts(service string) ([]string, error)
+	}
 )
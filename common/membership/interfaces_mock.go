@@ -291,3 +291,41 @@ func (mr *MockServiceResolverMockRecorder) RemoveListener(name interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveListener", reflect.TypeOf((*MockServiceResolver)(nil).RemoveListener), name)
 }
+
+// MockHostListProvider is a mock of HostListProvider interface.
+type MockHostListProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockHostListProviderMockRecorder
+}
+
+// MockHostListProviderMockRecorder is the mock recorder for MockHostListProvider.
+type MockHostListProviderMockRecorder struct {
+	mock *MockHostListProvider
+}
+
+// NewMockHostListProvider creates a new mock instance.
+func NewMockHostListProvider(ctrl *gomock.Controller) *MockHostListProvider {
+	mock := &MockHostListProvider{ctrl: ctrl}
+	mock.recorder = &MockHostListProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHostListProvider) EXPECT() *MockHostListProviderMockRecorder {
+	return m.recorder
+}
+
+// Hosts mocks base method.
+func (m *MockHostListProvider) Hosts(service string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hosts", service)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hosts indicates an expected call of Hosts.
+func (mr *MockHostListProviderMockRecorder) Hosts(service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hosts", reflect.TypeOf((*MockHostListProvider)(nil).Hosts), service)
+}
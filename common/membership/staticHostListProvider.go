@@ -0,0 +1,44 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+type staticHostListProvider struct {
+	hosts map[string][]string
+}
+
+var _ HostListProvider = (*staticHostListProvider)(nil)
+
+// NewStaticHostListProvider returns a provider serving a fixed map of service name to host:port addresses
+func NewStaticHostListProvider(hosts map[string][]string) HostListProvider {
+	copied := make(map[string][]string, len(hosts))
+	for service, serviceHosts := range hosts {
+		copied[service] = append([]string(nil), serviceHosts...)
+	}
+	return &staticHostListProvider{hosts: copied}
+}
+
+func (p *staticHostListProvider) Hosts(service string) ([]string, error) {
+	return p.hosts[service], nil
+}
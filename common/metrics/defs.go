@@ -266,6 +266,8 @@ const (
 	PersistenceDeleteNamespaceScope
 	// PersistenceDeleteNamespaceByNameScope tracks DeleteNamespaceByName calls made by service to persistence layer
 	PersistenceDeleteNamespaceByNameScope
+	// PersistenceRenameNamespaceScope tracks RenameNamespace calls made by service to persistence layer
+	PersistenceRenameNamespaceScope
 	// PersistenceListNamespaceScope tracks DeleteNamespaceByName calls made by service to persistence layer
 	PersistenceListNamespaceScope
 	// PersistenceGetMetadataScope tracks DeleteNamespaceByName calls made by service to persistence layer
@@ -330,6 +332,12 @@ const (
 	PersistenceSaveClusterMetadataScope
 	// PersistenceDeleteClusterMetadataScope tracks DeleteClusterMetadata calls made by service to persistence layer
 	PersistenceDeleteClusterMetadataScope
+	// PersistenceListDynamicConfigScope tracks ListDynamicConfig calls made by service to persistence layer
+	PersistenceListDynamicConfigScope
+	// PersistenceGetDynamicConfigScope tracks GetDynamicConfig calls made by service to persistence layer
+	PersistenceGetDynamicConfigScope
+	// PersistenceSaveDynamicConfigScope tracks SaveDynamicConfig calls made by service to persistence layer
+	PersistenceSaveDynamicConfigScope
 	// PersistenceUpsertClusterMembershipScope tracks UpsertClusterMembership calls made by service to persistence layer
 	PersistenceUpsertClusterMembershipScope
 	// PersistencePruneClusterMembershipScope tracks PruneClusterMembership calls made by service to persistence layer
@@ -406,6 +414,14 @@ const (
 	HistoryClientGenerateLastHistoryReplicationTasksScope
 	// HistoryClientGetReplicationStatusScope tracks RPC calls to history service
 	HistoryClientGetReplicationStatusScope
+	// HistoryClientUpdateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowExecutionScope
+	// HistoryClientRefreshDynamicConfigScope tracks RPC calls to history service
+	HistoryClientRefreshDynamicConfigScope
+	// HistoryClientGetOpenExecutionCountsScope tracks RPC calls to history service
+	HistoryClientGetOpenExecutionCountsScope
+	// HistoryClientGetShardLoadScope tracks RPC calls to history service
+	HistoryClientGetShardLoadScope
 	// MatchingClientPollWorkflowTaskQueueScope tracks RPC calls to matching service
 	MatchingClientPollWorkflowTaskQueueScope
 	// MatchingClientPollActivityTaskQueueScope tracks RPC calls to matching service
@@ -424,6 +440,12 @@ const (
 	MatchingClientDescribeTaskQueueScope
 	// MatchingClientListTaskQueuePartitionsScope tracks RPC calls to matching service
 	MatchingClientListTaskQueuePartitionsScope
+	// MatchingClientUpdateWorkerBuildIdOrderingScope tracks RPC calls to matching service
+	MatchingClientUpdateWorkerBuildIdOrderingScope
+	// MatchingClientGetWorkerBuildIdOrderingScope tracks RPC calls to matching service
+	MatchingClientGetWorkerBuildIdOrderingScope
+	// MatchingClientRefreshDynamicConfigScope tracks RPC calls to matching service
+	MatchingClientRefreshDynamicConfigScope
 	// FrontendClientDeprecateNamespaceScope tracks RPC calls to frontend service
 	FrontendClientDeprecateNamespaceScope
 	// FrontendClientDescribeNamespaceScope tracks RPC calls to frontend service
@@ -564,6 +586,52 @@ const (
 	AdminClientResendReplicationTasksScope
 	// AdminClientGetTaskQueueTasksScope tracks RPC calls to admin service
 	AdminClientGetTaskQueueTasksScope
+	// AdminClientDeleteWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientDeleteWorkflowExecutionScope
+	// AdminClientStartBatchOperationScope tracks RPC calls to admin service
+	AdminClientStartBatchOperationScope
+	// AdminClientStopBatchOperationScope tracks RPC calls to admin service
+	AdminClientStopBatchOperationScope
+	// AdminClientDescribeBatchOperationScope tracks RPC calls to admin service
+	AdminClientDescribeBatchOperationScope
+	// AdminClientListBatchOperationsScope tracks RPC calls to admin service
+	AdminClientListBatchOperationsScope
+	// AdminClientUpdateWorkerBuildIdOrderingScope tracks RPC calls to admin service
+	AdminClientUpdateWorkerBuildIdOrderingScope
+	// AdminClientGetWorkerBuildIdOrderingScope tracks RPC calls to admin service
+	AdminClientGetWorkerBuildIdOrderingScope
+	// AdminClientDescribeTaskQueueStatsScope tracks RPC calls to admin service
+	AdminClientDescribeTaskQueueStatsScope
+	// AdminClientUpdateWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientUpdateWorkflowExecutionScope
+	// AdminClientDeleteNamespaceScope tracks RPC calls to admin service
+	AdminClientDeleteNamespaceScope
+	// AdminClientGetDynamicConfigScope tracks RPC calls to admin service
+	AdminClientGetDynamicConfigScope
+	// AdminClientListDynamicConfigScope tracks RPC calls to admin service
+	AdminClientListDynamicConfigScope
+	// AdminClientSetDynamicConfigScope tracks RPC calls to admin service
+	AdminClientSetDynamicConfigScope
+	// AdminClientDeleteDynamicConfigScope tracks RPC calls to admin service
+	AdminClientDeleteDynamicConfigScope
+	// AdminClientRefreshDynamicConfigScope tracks RPC calls to admin service
+	AdminClientRefreshDynamicConfigScope
+	// AdminClientGetEffectiveDynamicConfigScope tracks RPC calls to admin service
+	AdminClientGetEffectiveDynamicConfigScope
+	// AdminClientListShardLoadScope tracks RPC calls to admin service
+	AdminClientListShardLoadScope
+	// AdminClientCreateScheduleScope tracks RPC calls to admin service
+	AdminClientCreateScheduleScope
+	// AdminClientDescribeScheduleScope tracks RPC calls to admin service
+	AdminClientDescribeScheduleScope
+	// AdminClientUpdateScheduleScope tracks RPC calls to admin service
+	AdminClientUpdateScheduleScope
+	// AdminClientPatchScheduleScope tracks RPC calls to admin service
+	AdminClientPatchScheduleScope
+	// AdminClientDeleteScheduleScope tracks RPC calls to admin service
+	AdminClientDeleteScheduleScope
+	// AdminClientListSchedulesScope tracks RPC calls to admin service
+	AdminClientListSchedulesScope
 	// DCRedirectionDeprecateNamespaceScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateNamespaceScope
 	// DCRedirectionDescribeNamespaceScope tracks RPC calls for dc redirection
@@ -758,6 +826,52 @@ const (
 	AdminResendReplicationTasksScope
 	// AdminGetTaskQueueTasksScope is the metric scope for admin.GetTaskQueueTasks
 	AdminGetTaskQueueTasksScope
+	// AdminDeleteWorkflowExecutionScope is the metric scope for admin.DeleteWorkflowExecution
+	AdminDeleteWorkflowExecutionScope
+	// AdminStartBatchOperationScope is the metric scope for admin.StartBatchOperation
+	AdminStartBatchOperationScope
+	// AdminStopBatchOperationScope is the metric scope for admin.StopBatchOperation
+	AdminStopBatchOperationScope
+	// AdminDescribeBatchOperationScope is the metric scope for admin.DescribeBatchOperation
+	AdminDescribeBatchOperationScope
+	// AdminListBatchOperationsScope is the metric scope for admin.ListBatchOperations
+	AdminListBatchOperationsScope
+	// AdminUpdateWorkerBuildIdOrderingScope is the metric scope for admin.UpdateWorkerBuildIdOrdering
+	AdminUpdateWorkerBuildIdOrderingScope
+	// AdminGetWorkerBuildIdOrderingScope is the metric scope for admin.GetWorkerBuildIdOrdering
+	AdminGetWorkerBuildIdOrderingScope
+	// AdminDescribeTaskQueueStatsScope is the metric scope for admin.DescribeTaskQueueStats
+	AdminDescribeTaskQueueStatsScope
+	// AdminUpdateWorkflowExecutionScope is the metric scope for admin.UpdateWorkflowExecution
+	AdminUpdateWorkflowExecutionScope
+	// AdminDeleteNamespaceScope is the metric scope for admin.DeleteNamespace
+	AdminDeleteNamespaceScope
+	// AdminGetDynamicConfigScope is the metric scope for admin.GetDynamicConfig
+	AdminGetDynamicConfigScope
+	// AdminListDynamicConfigScope is the metric scope for admin.ListDynamicConfig
+	AdminListDynamicConfigScope
+	// AdminSetDynamicConfigScope is the metric scope for admin.SetDynamicConfig
+	AdminSetDynamicConfigScope
+	// AdminDeleteDynamicConfigScope is the metric scope for admin.DeleteDynamicConfig
+	AdminDeleteDynamicConfigScope
+	// AdminRefreshDynamicConfigScope is the metric scope for admin.RefreshDynamicConfig
+	AdminRefreshDynamicConfigScope
+	// AdminGetEffectiveDynamicConfigScope is the metric scope for admin.GetEffectiveDynamicConfig
+	AdminGetEffectiveDynamicConfigScope
+	// AdminListShardLoadScope is the metric scope for admin.ListShardLoad
+	AdminListShardLoadScope
+	// AdminCreateScheduleScope is the metric scope for admin.CreateSchedule
+	AdminCreateScheduleScope
+	// AdminDescribeScheduleScope is the metric scope for admin.DescribeSchedule
+	AdminDescribeScheduleScope
+	// AdminUpdateScheduleScope is the metric scope for admin.UpdateSchedule
+	AdminUpdateScheduleScope
+	// AdminPatchScheduleScope is the metric scope for admin.PatchSchedule
+	AdminPatchScheduleScope
+	// AdminDeleteScheduleScope is the metric scope for admin.DeleteSchedule
+	AdminDeleteScheduleScope
+	// AdminListSchedulesScope is the metric scope for admin.ListSchedules
+	AdminListSchedulesScope
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTaskScope
 	AdminRemoveTaskScope
 	// AdminCloseShardScope is the metric scope for admin.AdminCloseShardScope
@@ -957,6 +1071,14 @@ const (
 	HistoryGenerateLastHistoryReplicationTasksScope
 	// HistoryGetReplicationStatusScope is the scope used by GetReplicationStatus API
 	HistoryGetReplicationStatusScope
+	// HistoryUpdateWorkflowExecutionScope tracks UpdateWorkflowExecution API calls received by service
+	HistoryUpdateWorkflowExecutionScope
+	// HistoryRefreshDynamicConfigScope tracks RefreshDynamicConfig API calls received by service
+	HistoryRefreshDynamicConfigScope
+	// HistoryGetOpenExecutionCountsScope tracks GetOpenExecutionCounts API calls received by service
+	HistoryGetOpenExecutionCountsScope
+	// HistoryGetShardLoadScope tracks GetShardLoad API calls received by service
+	HistoryGetShardLoadScope
 	// HistoryHistoryRemoveTaskScope is the scope used by remove task API
 	HistoryHistoryRemoveTaskScope
 	// HistoryCloseShard is the scope used by close shard API
@@ -1133,6 +1255,12 @@ const (
 	MatchingDescribeTaskQueueScope
 	// MatchingListTaskQueuePartitionsScope tracks ListTaskQueuePartitions API calls received by service
 	MatchingListTaskQueuePartitionsScope
+	// MatchingUpdateWorkerBuildIdOrderingScope tracks UpdateWorkerBuildIdOrdering API calls received by service
+	MatchingUpdateWorkerBuildIdOrderingScope
+	// MatchingGetWorkerBuildIdOrderingScope tracks GetWorkerBuildIdOrdering API calls received by service
+	MatchingGetWorkerBuildIdOrderingScope
+	// MatchingRefreshDynamicConfigScope tracks RefreshDynamicConfig API calls received by service
+	MatchingRefreshDynamicConfigScope
 
 	NumMatchingScopes
 )
@@ -1181,6 +1309,10 @@ const (
 	AddSearchAttributesWorkflowScope
 	// MigrationWorkflowScope is scope used by metrics emitted by migration related workflows
 	MigrationWorkflowScope
+	// SchedulerScope is scope used by metrics emitted by worker.scheduler module
+	SchedulerScope
+	// DeleteNamespaceWorkflowScope is scope used by metrics emitted by worker.deletenamespace module
+	DeleteNamespaceWorkflowScope
 
 	NumWorkerScopes
 )
@@ -1256,6 +1388,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceUpdateNamespaceScope:                   {operation: "UpdateNamespace"},
 		PersistenceDeleteNamespaceScope:                   {operation: "DeleteNamespace"},
 		PersistenceDeleteNamespaceByNameScope:             {operation: "DeleteNamespaceByName"},
+		PersistenceRenameNamespaceScope:                   {operation: "RenameNamespace"},
 		PersistenceListNamespaceScope:                     {operation: "ListNamespace"},
 		PersistenceGetMetadataScope:                       {operation: "GetMetadata"},
 
@@ -1300,6 +1433,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceGetClusterMetadataScope:         {operation: "GetClusterMetadata"},
 		PersistenceSaveClusterMetadataScope:        {operation: "SaveClusterMetadata"},
 		PersistenceDeleteClusterMetadataScope:      {operation: "DeleteClusterMetadata"},
+		PersistenceListDynamicConfigScope:          {operation: "ListDynamicConfig"},
+		PersistenceGetDynamicConfigScope:           {operation: "GetDynamicConfig"},
+		PersistenceSaveDynamicConfigScope:          {operation: "SaveDynamicConfig"},
 		PersistencePruneClusterMembershipScope:     {operation: "PruneClusterMembership"},
 		PersistenceGetClusterMembersScope:          {operation: "GetClusterMembership"},
 		PersistenceUpsertClusterMembershipScope:    {operation: "UpsertClusterMembership"},
@@ -1341,6 +1477,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientGenerateLastHistoryReplicationTasksScope: {operation: "HistoryClientGenerateLastHistoryReplicationTasksScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientGetReplicationStatusScope:                {operation: "HistoryClientGetReplicationStatusScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpdateWorkflowExecutionScope:             {operation: "HistoryClientUpdateWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRefreshDynamicConfigScope:                {operation: "HistoryClientRefreshDynamicConfig", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetOpenExecutionCountsScope:              {operation: "HistoryClientGetOpenExecutionCounts", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetShardLoadScope:                        {operation: "HistoryClientGetShardLoad", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollWorkflowTaskQueueScope:              {operation: "MatchingClientPollWorkflowTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollActivityTaskQueueScope:              {operation: "MatchingClientPollActivityTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                    {operation: "MatchingClientAddActivityTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
@@ -1350,6 +1490,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientCancelOutstandingPollScope:              {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientDescribeTaskQueueScope:                  {operation: "MatchingClientDescribeTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientListTaskQueuePartitionsScope:            {operation: "MatchingClientListTaskQueuePartitions", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientUpdateWorkerBuildIdOrderingScope:        {operation: "MatchingClientUpdateWorkerBuildIdOrdering", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientGetWorkerBuildIdOrderingScope:           {operation: "MatchingClientGetWorkerBuildIdOrdering", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientRefreshDynamicConfigScope:               {operation: "MatchingClientRefreshDynamicConfig", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		FrontendClientDeprecateNamespaceScope:                 {operation: "FrontendClientDeprecateNamespace", tags: map[string]string{ServiceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeNamespaceScope:                  {operation: "FrontendClientDescribeNamespace", tags: map[string]string{ServiceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeTaskQueueScope:                  {operation: "FrontendClientDescribeTaskQueue", tags: map[string]string{ServiceRoleTagName: FrontendRoleTagValue}},
@@ -1410,6 +1553,29 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetTaskQueueTasksScope:                     {operation: "AdminClientGetTaskQueueTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteWorkflowExecutionScope:               {operation: "AdminClientDeleteWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStartBatchOperationScope:                   {operation: "AdminClientStartBatchOperation", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStopBatchOperationScope:                    {operation: "AdminClientStopBatchOperation", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeBatchOperationScope:                {operation: "AdminClientDescribeBatchOperation", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListBatchOperationsScope:                   {operation: "AdminClientListBatchOperations", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateWorkerBuildIdOrderingScope:           {operation: "AdminClientUpdateWorkerBuildIdOrdering", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetWorkerBuildIdOrderingScope:              {operation: "AdminClientGetWorkerBuildIdOrdering", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeTaskQueueStatsScope:                {operation: "AdminClientDescribeTaskQueueStats", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateWorkflowExecutionScope:               {operation: "AdminClientUpdateWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteNamespaceScope:                       {operation: "AdminClientDeleteNamespace", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetDynamicConfigScope:                      {operation: "AdminClientGetDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListDynamicConfigScope:                     {operation: "AdminClientListDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientSetDynamicConfigScope:                      {operation: "AdminClientSetDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteDynamicConfigScope:                   {operation: "AdminClientDeleteDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientRefreshDynamicConfigScope:                  {operation: "AdminClientRefreshDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetEffectiveDynamicConfigScope:             {operation: "AdminClientGetEffectiveDynamicConfig", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListShardLoadScope:                         {operation: "AdminClientListShardLoad", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientCreateScheduleScope:                        {operation: "AdminClientCreateSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeScheduleScope:                      {operation: "AdminClientDescribeSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateScheduleScope:                        {operation: "AdminClientUpdateSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientPatchScheduleScope:                         {operation: "AdminClientPatchSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteScheduleScope:                        {operation: "AdminClientDeleteSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListSchedulesScope:                         {operation: "AdminClientListSchedules", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientListClusterMembersScope:                    {operation: "AdminClientListClusterMembers", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetShardScope:                              {operation: "AdminClientGetShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
@@ -1518,6 +1684,29 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminRefreshWorkflowTasksScope:             {operation: "RefreshWorkflowTasks"},
 		AdminResendReplicationTasksScope:           {operation: "ResendReplicationTasks"},
 		AdminGetTaskQueueTasksScope:                {operation: "GetTaskQueueTasks"},
+		AdminDeleteWorkflowExecutionScope:          {operation: "AdminDeleteWorkflowExecution"},
+		AdminStartBatchOperationScope:              {operation: "AdminStartBatchOperation"},
+		AdminStopBatchOperationScope:               {operation: "AdminStopBatchOperation"},
+		AdminDescribeBatchOperationScope:           {operation: "AdminDescribeBatchOperation"},
+		AdminListBatchOperationsScope:              {operation: "AdminListBatchOperations"},
+		AdminUpdateWorkerBuildIdOrderingScope:      {operation: "AdminUpdateWorkerBuildIdOrdering"},
+		AdminGetWorkerBuildIdOrderingScope:         {operation: "AdminGetWorkerBuildIdOrdering"},
+		AdminDescribeTaskQueueStatsScope:           {operation: "AdminDescribeTaskQueueStats"},
+		AdminUpdateWorkflowExecutionScope:          {operation: "AdminUpdateWorkflowExecution"},
+		AdminDeleteNamespaceScope:                  {operation: "AdminDeleteNamespace"},
+		AdminGetDynamicConfigScope:                 {operation: "AdminGetDynamicConfig"},
+		AdminListDynamicConfigScope:                {operation: "AdminListDynamicConfig"},
+		AdminSetDynamicConfigScope:                 {operation: "AdminSetDynamicConfig"},
+		AdminDeleteDynamicConfigScope:              {operation: "AdminDeleteDynamicConfig"},
+		AdminRefreshDynamicConfigScope:             {operation: "AdminRefreshDynamicConfig"},
+		AdminGetEffectiveDynamicConfigScope:        {operation: "AdminGetEffectiveDynamicConfig"},
+		AdminListShardLoadScope:                    {operation: "AdminListShardLoad"},
+		AdminCreateScheduleScope:                   {operation: "AdminCreateSchedule"},
+		AdminDescribeScheduleScope:                 {operation: "AdminDescribeSchedule"},
+		AdminUpdateScheduleScope:                   {operation: "AdminUpdateSchedule"},
+		AdminPatchScheduleScope:                    {operation: "AdminPatchSchedule"},
+		AdminDeleteScheduleScope:                   {operation: "AdminDeleteSchedule"},
+		AdminListSchedulesScope:                    {operation: "AdminListSchedules"},
 		AdminDescribeClusterScope:                  {operation: "AdminDescribeCluster"},
 		AdminListClustersScope:                     {operation: "AdminListClusters"},
 		AdminAddOrUpdateRemoteClusterScope:         {operation: "AdminAddOrUpdateRemoteCluster"},
@@ -1608,6 +1797,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryRefreshWorkflowTasksScope:                {operation: "RefreshWorkflowTasks"},
 		HistoryGenerateLastHistoryReplicationTasksScope: {operation: "GenerateLastHistoryReplicationTasks"},
 		HistoryGetReplicationStatusScope:                {operation: "GetReplicationStatus"},
+		HistoryUpdateWorkflowExecutionScope:             {operation: "UpdateWorkflowExecution"},
+		HistoryRefreshDynamicConfigScope:                {operation: "RefreshDynamicConfig"},
+		HistoryGetOpenExecutionCountsScope:              {operation: "GetOpenExecutionCounts"},
+		HistoryGetShardLoadScope:                        {operation: "GetShardLoad"},
 		HistoryHistoryRemoveTaskScope:                   {operation: "RemoveTask"},
 		HistoryCloseShard:                               {operation: "CloseShard"},
 		HistoryGetShard:                                 {operation: "GetShard"},
@@ -1687,17 +1880,20 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	},
 	// Matching Scope Names
 	Matching: {
-		MatchingPollWorkflowTaskQueueScope:     {operation: "PollWorkflowTaskQueue"},
-		MatchingPollActivityTaskQueueScope:     {operation: "PollActivityTaskQueue"},
-		MatchingAddActivityTaskScope:           {operation: "AddActivityTask"},
-		MatchingAddWorkflowTaskScope:           {operation: "AddWorkflowTask"},
-		MatchingTaskQueueMgrScope:              {operation: "TaskQueueMgr"},
-		MatchingEngineScope:                    {operation: "MatchingEngine"},
-		MatchingQueryWorkflowScope:             {operation: "QueryWorkflow"},
-		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
-		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
-		MatchingDescribeTaskQueueScope:         {operation: "DescribeTaskQueue"},
-		MatchingListTaskQueuePartitionsScope:   {operation: "ListTaskQueuePartitions"},
+		MatchingPollWorkflowTaskQueueScope:       {operation: "PollWorkflowTaskQueue"},
+		MatchingPollActivityTaskQueueScope:       {operation: "PollActivityTaskQueue"},
+		MatchingAddActivityTaskScope:             {operation: "AddActivityTask"},
+		MatchingAddWorkflowTaskScope:             {operation: "AddWorkflowTask"},
+		MatchingTaskQueueMgrScope:                {operation: "TaskQueueMgr"},
+		MatchingEngineScope:                      {operation: "MatchingEngine"},
+		MatchingQueryWorkflowScope:               {operation: "QueryWorkflow"},
+		MatchingRespondQueryTaskCompletedScope:   {operation: "RespondQueryTaskCompleted"},
+		MatchingCancelOutstandingPollScope:       {operation: "CancelOutstandingPoll"},
+		MatchingDescribeTaskQueueScope:           {operation: "DescribeTaskQueue"},
+		MatchingListTaskQueuePartitionsScope:     {operation: "ListTaskQueuePartitions"},
+		MatchingUpdateWorkerBuildIdOrderingScope: {operation: "UpdateWorkerBuildIdOrdering"},
+		MatchingGetWorkerBuildIdOrderingScope:    {operation: "GetWorkerBuildIdOrdering"},
+		MatchingRefreshDynamicConfigScope:        {operation: "RefreshDynamicConfig"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -1722,6 +1918,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
 		AddSearchAttributesWorkflowScope:       {operation: "AddSearchAttributesWorkflow"},
 		MigrationWorkflowScope:                 {operation: "MigrationWorkflow"},
+		SchedulerScope:                         {operation: "Scheduler"},
+		DeleteNamespaceWorkflowScope:           {operation: "DeleteNamespaceWorkflow"},
 	},
 	Server: {
 		ServerTlsScope: {operation: "ServerTls"},
@@ -1885,6 +2083,9 @@ const (
 	AddSearchAttributesWorkflowSuccessCount
 	AddSearchAttributesWorkflowFailuresCount
 
+	DeleteNamespaceWorkflowSuccessCount
+	DeleteNamespaceWorkflowFailuresCount
+
 	ElasticsearchDocumentParseFailuresCount
 	ElasticsearchDocumentGenerateFailuresCount
 
@@ -1913,6 +2114,8 @@ const (
 	TaskNoUserQueueLatency
 	TaskRedispatchQueuePendingTasksTimer
 	TaskScheduleToStartLatency
+	TaskSchedulerLatency
+	TaskWorkerShareThrottledCounter
 
 	TransferTaskMissingEventCounter
 
@@ -1979,6 +2182,9 @@ const (
 	GetEngineForShardErrorCounter
 	GetEngineForShardLatency
 	RemoveEngineForShardLatency
+	ShardHandoffCounter
+	ShardHandoffFailedCounter
+	ShardHandoffLatency
 	CompleteWorkflowTaskWithStickyEnabledCounter
 	CompleteWorkflowTaskWithStickyDisabledCounter
 	WorkflowTaskHeartbeatTimeoutCounter
@@ -2174,6 +2380,7 @@ const (
 	ExecutorTasksDroppedCount
 	BatcherProcessorSuccess
 	BatcherProcessorFailures
+	BatcherProcessorThrottled
 	HistoryScavengerSuccessCount
 	HistoryScavengerErrorCount
 	HistoryScavengerSkipCount
@@ -2183,6 +2390,11 @@ const (
 	AddSearchAttributesFailuresCount
 	CatchUpReadyShardCountGauge
 	HandoverReadyShardCountGauge
+	SchedulerStartWorkflowSuccess
+	SchedulerStartWorkflowFailures
+	DeleteExecutionsSuccessCount
+	DeleteExecutionsFailureCount
+	DeleteNamespaceSuccessCount
 
 	NumWorkerMetrics
 )
@@ -2321,6 +2533,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 
 		AddSearchAttributesWorkflowSuccessCount:  NewCounterDef("add_search_attributes_workflow_success"),
 		AddSearchAttributesWorkflowFailuresCount: NewCounterDef("add_search_attributes_workflow_failure"),
+		DeleteNamespaceWorkflowSuccessCount:      NewCounterDef("delete_namespace_workflow_success"),
+		DeleteNamespaceWorkflowFailuresCount:     NewCounterDef("delete_namespace_workflow_failure"),
 
 		MatchingClientForwardedCounter:     NewCounterDef("forwarded"),
 		MatchingClientInvalidTaskQueueName: NewCounterDef("invalid_task_queue_name"),
@@ -2372,6 +2586,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 
 		TaskScheduleToStartLatency: NewTimerDef("task_schedule_to_start_latency"),
 
+		TaskSchedulerLatency:            NewTimerDef("task_latency_scheduler"), // from task loaded to task picked up by a worker
+		TaskWorkerShareThrottledCounter: NewCounterDef("task_worker_share_throttled_counter"),
+
 		TaskProcessingLatency:       NewTimerDef("task_latency_processing"),               // per-attempt
 		TaskNoUserProcessingLatency: NewTimerDef("task_latency_processing_nouserlatency"), // per-attempt
 
@@ -2443,6 +2660,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		GetEngineForShardErrorCounter:                     NewCounterDef("get_engine_for_shard_errors"),
 		GetEngineForShardLatency:                          NewTimerDef("get_engine_for_shard_latency"),
 		RemoveEngineForShardLatency:                       NewTimerDef("remove_engine_for_shard_latency"),
+		ShardHandoffCounter:                               NewCounterDef("shard_handoff_count"),
+		ShardHandoffFailedCounter:                         NewCounterDef("shard_handoff_failed_count"),
+		ShardHandoffLatency:                               NewTimerDef("shard_handoff_latency"),
 		CompleteWorkflowTaskWithStickyEnabledCounter:      NewCounterDef("complete_workflow_task_sticky_enabled_count"),
 		CompleteWorkflowTaskWithStickyDisabledCounter:     NewCounterDef("complete_workflow_task_sticky_disabled_count"),
 		WorkflowTaskHeartbeatTimeoutCounter:               NewCounterDef("workflow_task_heartbeat_timeout_count"),
@@ -2627,6 +2847,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ExecutorTasksDroppedCount:                     NewCounterDef("executor_dropped"),
 		BatcherProcessorSuccess:                       NewCounterDef("batcher_processor_requests"),
 		BatcherProcessorFailures:                      NewCounterDef("batcher_processor_errors"),
+		BatcherProcessorThrottled:                     NewCounterDef("batcher_processor_throttled"),
 		HistoryScavengerSuccessCount:                  NewCounterDef("scavenger_success"),
 		HistoryScavengerErrorCount:                    NewCounterDef("scavenger_errors"),
 		HistoryScavengerSkipCount:                     NewCounterDef("scavenger_skips"),
@@ -2636,6 +2857,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		AddSearchAttributesFailuresCount:              NewCounterDef("add_search_attributes_failures"),
 		CatchUpReadyShardCountGauge:                   NewGaugeDef("catchup_ready_shard_count"),
 		HandoverReadyShardCountGauge:                  NewGaugeDef("handover_ready_shard_count"),
+		SchedulerStartWorkflowSuccess:                 NewCounterDef("scheduler_start_workflow_success"),
+		SchedulerStartWorkflowFailures:                NewCounterDef("scheduler_start_workflow_failures"),
+		DeleteExecutionsSuccessCount:                  NewCounterDef("delete_executions_success"),
+		DeleteExecutionsFailureCount:                  NewCounterDef("delete_executions_failure"),
+		DeleteNamespaceSuccessCount:                   NewCounterDef("delete_namespace_success"),
 	},
 	Server: {
 		TlsCertsExpired:  NewGaugeDef("certificates_expired"),
@@ -429,6 +429,10 @@ UpdateLoop:
 			// will be loaded into cache in the next refresh
 			break UpdateLoop
 		}
+		if cachedNS, ok := newCacheByID.Get(namespace.ID()).(*Namespace); ok && cachedNS.Name() != namespace.Name() {
+			// namespace was renamed, old name must not resolve to it anymore
+			newCacheNameToID.Delete(cachedNS.Name())
+		}
 		oldNS := r.updateIDToNamespaceCache(newCacheByID, namespace.ID(), namespace)
 		r.updateNameToIDCache(newCacheNameToID, namespace.Name(), namespace.ID())
 
@@ -459,6 +459,86 @@ func (s *registrySuite) TestUpdateCache_TriggerCallBack() {
 	s.Equal([]*namespace.Namespace{entry2New, entry1New}, entriesNew)
 }
 
+func (s *registrySuite) TestUpdateCache_RenamedNamespace() {
+	namespaceNotificationVersion := int64(0)
+	namespaceRecordOld := &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   namespace.NewID().String(),
+				Name: "some random namespace name",
+				Data: make(map[string]string)},
+			Config: &persistencespb.NamespaceConfig{
+				Retention: timestamp.DurationFromDays(1),
+				BadBinaries: &namespacepb.BadBinaries{
+					Binaries: map[string]*namespacepb.BadBinaryInfo{},
+				}},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []string{
+					cluster.TestCurrentClusterName,
+				},
+			},
+		},
+		NotificationVersion: namespaceNotificationVersion,
+	}
+	namespaceNotificationVersion++
+
+	s.regPersistence.EXPECT().GetMetadata().Return(
+		&persistence.GetMetadataResponse{
+			NotificationVersion: namespaceNotificationVersion,
+		}, nil)
+	s.regPersistence.EXPECT().ListNamespaces(&persistence.ListNamespacesRequest{
+		PageSize:      namespace.CacheRefreshPageSize,
+		NextPageToken: nil,
+	}).Return(&persistence.ListNamespacesResponse{
+		Namespaces:    []*persistence.GetNamespaceResponse{namespaceRecordOld},
+		NextPageToken: nil,
+	}, nil)
+
+	// load namespaces
+	s.registry.Start()
+	defer s.registry.Stop()
+
+	namespaceRecordNew := &persistence.GetNamespaceResponse{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   namespaceRecordOld.Namespace.Info.Id,
+				Name: "some random namespace name-deleted",
+				Data: make(map[string]string)},
+			Config:            namespaceRecordOld.Namespace.Config,
+			ReplicationConfig: namespaceRecordOld.Namespace.ReplicationConfig,
+		},
+		NotificationVersion: namespaceNotificationVersion,
+	}
+	entryNew := namespace.FromPersistentState(namespaceRecordNew)
+	namespaceNotificationVersion++
+
+	s.regPersistence.EXPECT().GetMetadata().Return(
+		&persistence.GetMetadataResponse{
+			NotificationVersion: namespaceNotificationVersion,
+		}, nil)
+	s.regPersistence.EXPECT().ListNamespaces(&persistence.ListNamespacesRequest{
+		PageSize:      namespace.CacheRefreshPageSize,
+		NextPageToken: nil,
+	}).Return(&persistence.ListNamespacesResponse{
+		Namespaces:    []*persistence.GetNamespaceResponse{namespaceRecordNew},
+		NextPageToken: nil,
+	}, nil)
+
+	s.registry.Refresh()
+
+	entryByName, err := s.registry.GetNamespace(namespace.Name(namespaceRecordNew.Namespace.Info.Name))
+	s.NoError(err)
+	s.Equal(entryNew, entryByName)
+	entryByID, err := s.registry.GetNamespaceByID(namespace.ID(namespaceRecordNew.Namespace.Info.Id))
+	s.NoError(err)
+	s.Equal(entryNew, entryByID)
+
+	_, err = s.registry.GetNamespace(namespace.Name(namespaceRecordOld.Namespace.Info.Name))
+	s.Error(err)
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
 func (s *registrySuite) TestGetTriggerListAndUpdateCache_ConcurrentAccess() {
 	namespaceNotificationVersion := int64(999999) // make this notification version really large for test
 	s.regPersistence.EXPECT().GetMetadata().Return(
@@ -40,6 +40,10 @@ import (
 const constMetadataPartition = 0
 const constMembershipPartition = 0
 
+// constDynamicConfigMetadataPartition is the metadata partition of cluster_metadata_info
+// which stores dynamic config entries, keyed by dynamic config key instead of cluster name.
+const constDynamicConfigMetadataPartition = 1
+
 const (
 	// ****** CLUSTER_METADATA_INFO TABLE ******
 	templateListClusterMetadata   = `SELECT data, data_encoding, version FROM cluster_metadata_info WHERE metadata_partition = ?`
@@ -183,6 +187,93 @@ func (m *ClusterMetadataStore) DeleteClusterMetadata(request *p.InternalDeleteCl
 	return nil
 }
 
+func (m *ClusterMetadataStore) ListDynamicConfig(
+	request *p.InternalListDynamicConfigRequest,
+) (*p.InternalListDynamicConfigResponse, error) {
+	query := m.session.Query(templateListClusterMetadata, constDynamicConfigMetadataPartition)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+
+	response := &p.InternalListDynamicConfigResponse{}
+	for {
+		var entry []byte
+		var encoding string
+		var version int64
+
+		if !iter.Scan(
+			&entry,
+			&encoding,
+			&version) {
+			break
+		}
+		response.Entries = append(
+			response.Entries,
+			&p.InternalGetDynamicConfigResponse{
+				Entry:   p.NewDataBlob(entry, encoding),
+				Version: version,
+			},
+		)
+	}
+
+	if len(iter.PageState()) > 0 {
+		response.NextPageToken = iter.PageState()
+	}
+	if err := iter.Close(); err != nil {
+		return nil, gocql.ConvertError("ListDynamicConfig", err)
+	}
+	return response, nil
+}
+
+func (m *ClusterMetadataStore) GetDynamicConfig(
+	request *p.InternalGetDynamicConfigRequest,
+) (*p.InternalGetDynamicConfigResponse, error) {
+
+	var entry []byte
+	var encoding string
+	var version int64
+
+	query := m.session.Query(templateGetClusterMetadata, constDynamicConfigMetadataPartition, request.Key)
+	err := query.Scan(&entry, &encoding, &version)
+	if err != nil {
+		return nil, gocql.ConvertError("GetDynamicConfig", err)
+	}
+
+	return &p.InternalGetDynamicConfigResponse{
+		Entry:   p.NewDataBlob(entry, encoding),
+		Version: version,
+	}, nil
+}
+
+func (m *ClusterMetadataStore) SaveDynamicConfig(request *p.InternalSaveDynamicConfigRequest) (bool, error) {
+	var query gocql.Query
+	if request.Version == 0 {
+		query = m.session.Query(
+			templateCreateClusterMetadata,
+			constDynamicConfigMetadataPartition,
+			request.Key,
+			request.Entry.Data,
+			request.Entry.EncodingType.String(),
+			1,
+		)
+	} else {
+		query = m.session.Query(
+			templateUpdateClusterMetadata,
+			request.Entry.Data,
+			request.Entry.EncodingType.String(),
+			request.Version+1,
+			constDynamicConfigMetadataPartition,
+			request.Key,
+			request.Version,
+		)
+	}
+
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return false, gocql.ConvertError("SaveDynamicConfig", err)
+	}
+	return applied, nil
+}
+
 func (m *ClusterMetadataStore) GetClusterMembers(request *p.GetClusterMembersRequest) (*p.GetClusterMembersResponse, error) {
 	var queryString strings.Builder
 	var operands []interface{}
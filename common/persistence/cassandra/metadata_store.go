@@ -50,6 +50,10 @@ const (
 		`FROM namespaces_by_id ` +
 		`WHERE id = ?`
 
+	templateUpdateNamespaceNameQuery = `UPDATE namespaces_by_id ` +
+		`SET name = ? ` +
+		`WHERE id = ?`
+
 	templateDeleteNamespaceQuery = `DELETE FROM namespaces_by_id ` +
 		`WHERE id = ?`
 
@@ -198,6 +202,46 @@ func (m *MetadataStore) UpdateNamespace(request *p.InternalUpdateNamespaceReques
 	return nil
 }
 
+// RenameNamespace renames namespace.
+// Namespace record is moved to the new name in namespaces table together with the metadata record update,
+// then namespaces_by_id table is updated. If the second update fails, GetNamespace by ID fails
+// until RenameNamespace is retried.
+func (m *MetadataStore) RenameNamespace(request *p.InternalRenameNamespaceRequest) error {
+	batch := m.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateCreateNamespaceByNameQueryWithinBatchV2,
+		constNamespacePartition,
+		request.Id,
+		request.Name,
+		request.Namespace.Data,
+		request.Namespace.EncodingType.String(),
+		request.NotificationVersion,
+		request.IsGlobal,
+	)
+	batch.Query(templateDeleteNamespaceByNameQueryV2,
+		constNamespacePartition,
+		request.PreviousName,
+	)
+	m.updateMetadataBatch(batch, request.NotificationVersion)
+
+	previous := make(map[string]interface{})
+	applied, iter, err := m.session.MapExecuteBatchCAS(batch, previous)
+	if err != nil {
+		return serviceerror.NewUnavailable(fmt.Sprintf("RenameNamespace operation failed. Error: %v", err))
+	}
+	defer func() { _ = iter.Close() }()
+
+	if !applied {
+		return serviceerror.NewUnavailable(fmt.Sprintf("RenameNamespace operation failed because of conditional failure."))
+	}
+
+	query := m.session.Query(templateUpdateNamespaceNameQuery, request.Name, request.Id)
+	if err := query.Exec(); err != nil {
+		return serviceerror.NewUnavailable(fmt.Sprintf("RenameNamespace operation failed. Updating namespaces_by_id table. Error: %v", err))
+	}
+
+	return nil
+}
+
 func (m *MetadataStore) GetNamespace(request *p.GetNamespaceRequest) (*p.InternalGetNamespaceResponse, error) {
 	var query gocql.Query
 	var err error
@@ -185,6 +185,7 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if f.metricsClient != nil {
 		result = p.NewTaskPersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewTaskPersistenceTracingClient(result)
 	return result, nil
 }
 
@@ -202,6 +203,7 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if f.metricsClient != nil {
 		result = p.NewShardPersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewShardPersistenceTracingClient(result)
 	return result, nil
 }
 
@@ -222,6 +224,7 @@ func (f *factoryImpl) NewMetadataManager() (p.MetadataManager, error) {
 	if f.metricsClient != nil {
 		result = p.NewMetadataPersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewMetadataPersistenceTracingClient(result)
 	return result, nil
 }
 
@@ -242,6 +245,7 @@ func (f *factoryImpl) NewClusterMetadataManager() (p.ClusterMetadataManager, err
 	if f.metricsClient != nil {
 		result = p.NewClusterMetadataPersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewClusterMetadataPersistenceTracingClient(result)
 	return result, nil
 }
 
@@ -260,6 +264,7 @@ func (f *factoryImpl) NewExecutionManager() (p.ExecutionManager, error) {
 	if f.metricsClient != nil {
 		result = p.NewExecutionPersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewExecutionPersistenceTracingClient(result)
 	return result, nil
 }
 
@@ -275,6 +280,7 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (p.NamespaceReplicationQueu
 	if f.metricsClient != nil {
 		result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
+	result = p.NewQueuePersistenceTracingClient(result)
 
 	return p.NewNamespaceReplicationQueue(result, f.serializer, f.clusterName, f.metricsClient, f.logger)
 }
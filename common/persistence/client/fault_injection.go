@@ -748,6 +748,33 @@ func (c *FaultInjectionClusterMetadataStore) DeleteClusterMetadata(
 	return c.baseCMStore.DeleteClusterMetadata(request)
 }
 
+func (c *FaultInjectionClusterMetadataStore) ListDynamicConfig(
+	request *persistence.InternalListDynamicConfigRequest,
+) (*persistence.InternalListDynamicConfigResponse, error) {
+	if err := c.ErrorGenerator.Generate(); err != nil {
+		return nil, err
+	}
+	return c.baseCMStore.ListDynamicConfig(request)
+}
+
+func (c *FaultInjectionClusterMetadataStore) GetDynamicConfig(
+	request *persistence.InternalGetDynamicConfigRequest,
+) (*persistence.InternalGetDynamicConfigResponse, error) {
+	if err := c.ErrorGenerator.Generate(); err != nil {
+		return nil, err
+	}
+	return c.baseCMStore.GetDynamicConfig(request)
+}
+
+func (c *FaultInjectionClusterMetadataStore) SaveDynamicConfig(
+	request *persistence.InternalSaveDynamicConfigRequest,
+) (bool, error) {
+	if err := c.ErrorGenerator.Generate(); err != nil {
+		return false, err
+	}
+	return c.baseCMStore.SaveDynamicConfig(request)
+}
+
 func (c *FaultInjectionClusterMetadataStore) GetClusterMembers(request *persistence.GetClusterMembersRequest) (
 	*persistence.GetClusterMembersResponse,
 	error,
@@ -822,6 +849,13 @@ func (m *FaultInjectionMetadataStore) UpdateNamespace(request *persistence.Inter
 	return m.baseMetadataStore.UpdateNamespace(request)
 }
 
+func (m *FaultInjectionMetadataStore) RenameNamespace(request *persistence.InternalRenameNamespaceRequest) error {
+	if err := m.ErrorGenerator.Generate(); err != nil {
+		return err
+	}
+	return m.baseMetadataStore.RenameNamespace(request)
+}
+
 func (m *FaultInjectionMetadataStore) DeleteNamespace(request *persistence.DeleteNamespaceRequest) error {
 	if err := m.ErrorGenerator.Generate(); err != nil {
 		return err
@@ -26,6 +26,7 @@ package persistence
 
 import (
 	"errors"
+	"strings"
 
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
@@ -191,6 +192,57 @@ func (m *clusterMetadataManagerImpl) DeleteClusterMetadata(request *DeleteCluste
 	return m.persistence.DeleteClusterMetadata(&InternalDeleteClusterMetadataRequest{ClusterName: request.ClusterName})
 }
 
+func (m *clusterMetadataManagerImpl) ListDynamicConfig(request *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error) {
+	resp, err := m.persistence.ListDynamicConfig(&InternalListDynamicConfigRequest{
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*GetDynamicConfigResponse, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		entry, err := m.serializer.DynamicConfigEntryFromBlob(e.Entry)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &GetDynamicConfigResponse{Entry: entry, Version: e.Version})
+	}
+	return &ListDynamicConfigResponse{Entries: entries, NextPageToken: resp.NextPageToken}, nil
+}
+
+func (m *clusterMetadataManagerImpl) GetDynamicConfig(request *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	resp, err := m.persistence.GetDynamicConfig(&InternalGetDynamicConfigRequest{Key: strings.ToLower(request.Key)})
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := m.serializer.DynamicConfigEntryFromBlob(resp.Entry)
+	if err != nil {
+		return nil, err
+	}
+	return &GetDynamicConfigResponse{Entry: entry, Version: resp.Version}, nil
+}
+
+func (m *clusterMetadataManagerImpl) SaveDynamicConfig(request *SaveDynamicConfigRequest) (bool, error) {
+	if request.Entry.GetKey() == "" {
+		return false, serviceerror.NewInvalidArgument("Dynamic config key is not set")
+	}
+	// dynamic config keys are case insensitive, they are stored in lower case
+	request.Entry.Key = strings.ToLower(request.Entry.GetKey())
+
+	blob, err := m.serializer.DynamicConfigEntryToBlob(request.Entry, clusterMetadataEncoding)
+	if err != nil {
+		return false, err
+	}
+	return m.persistence.SaveDynamicConfig(&InternalSaveDynamicConfigRequest{
+		Key:     request.Entry.GetKey(),
+		Entry:   blob,
+		Version: request.Version,
+	})
+}
+
 func (m *clusterMetadataManagerImpl) convertInternalGetClusterMetadataResponse(
 	resp *InternalGetClusterMetadataResponse,
 ) (*GetClusterMetadataResponse, error) {
@@ -749,6 +749,12 @@ type (
 		Name string
 	}
 
+	// RenameNamespaceRequest is used to rename namespace.
+	RenameNamespaceRequest struct {
+		PreviousName string
+		NewName      string
+	}
+
 	// ListNamespacesRequest is used to list namespaces
 	ListNamespacesRequest struct {
 		PageSize      int
@@ -1034,6 +1040,36 @@ type (
 		ClusterName string
 	}
 
+	// ListDynamicConfigRequest is the request to ListDynamicConfig
+	ListDynamicConfigRequest struct {
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ListDynamicConfigResponse is the response to ListDynamicConfig
+	ListDynamicConfigResponse struct {
+		Entries       []*GetDynamicConfigResponse
+		NextPageToken []byte
+	}
+
+	// GetDynamicConfigRequest is the request to GetDynamicConfig
+	GetDynamicConfigRequest struct {
+		Key string
+	}
+
+	// GetDynamicConfigResponse is the response to GetDynamicConfig
+	GetDynamicConfigResponse struct {
+		Entry   *persistencespb.DynamicConfigEntry
+		Version int64
+	}
+
+	// SaveDynamicConfigRequest is the request to SaveDynamicConfig.
+	// Version must match the version of the stored entry, or be 0 if the entry doesn't exist yet.
+	SaveDynamicConfigRequest struct {
+		Entry   *persistencespb.DynamicConfigEntry
+		Version int64
+	}
+
 	// GetClusterMembersRequest is the request to GetClusterMembers
 	GetClusterMembersRequest struct {
 		LastHeartbeatWithin time.Duration
@@ -1203,6 +1239,7 @@ type (
 		CreateNamespace(request *CreateNamespaceRequest) (*CreateNamespaceResponse, error)
 		GetNamespace(request *GetNamespaceRequest) (*GetNamespaceResponse, error)
 		UpdateNamespace(request *UpdateNamespaceRequest) error
+		RenameNamespace(request *RenameNamespaceRequest) error
 		DeleteNamespace(request *DeleteNamespaceRequest) error
 		DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error
 		ListNamespaces(request *ListNamespacesRequest) (*ListNamespacesResponse, error)
@@ -1222,6 +1259,9 @@ type (
 		GetClusterMetadata(request *GetClusterMetadataRequest) (*GetClusterMetadataResponse, error)
 		SaveClusterMetadata(request *SaveClusterMetadataRequest) (bool, error)
 		DeleteClusterMetadata(request *DeleteClusterMetadataRequest) error
+		ListDynamicConfig(request *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error)
+		GetDynamicConfig(request *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error)
+		SaveDynamicConfig(request *SaveDynamicConfigRequest) (bool, error)
 	}
 )
 
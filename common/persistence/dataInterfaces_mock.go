@@ -1114,6 +1114,20 @@ func (mr *MockMetadataManagerMockRecorder) ListNamespaces(request interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaces", reflect.TypeOf((*MockMetadataManager)(nil).ListNamespaces), request)
 }
 
+// RenameNamespace mocks base method.
+func (m *MockMetadataManager) RenameNamespace(request *RenameNamespaceRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameNamespace", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameNamespace indicates an expected call of RenameNamespace.
+func (mr *MockMetadataManagerMockRecorder) RenameNamespace(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNamespace", reflect.TypeOf((*MockMetadataManager)(nil).RenameNamespace), request)
+}
+
 // UpdateNamespace mocks base method.
 func (m *MockMetadataManager) UpdateNamespace(request *UpdateNamespaceRequest) error {
 	m.ctrl.T.Helper()
@@ -1222,6 +1236,21 @@ func (mr *MockClusterMetadataManagerMockRecorder) GetCurrentClusterMetadata() *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentClusterMetadata", reflect.TypeOf((*MockClusterMetadataManager)(nil).GetCurrentClusterMetadata))
 }
 
+// GetDynamicConfig mocks base method.
+func (m *MockClusterMetadataManager) GetDynamicConfig(request *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig", request)
+	ret0, _ := ret[0].(*GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockClusterMetadataManagerMockRecorder) GetDynamicConfig(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockClusterMetadataManager)(nil).GetDynamicConfig), request)
+}
+
 // GetName mocks base method.
 func (m *MockClusterMetadataManager) GetName() string {
 	m.ctrl.T.Helper()
@@ -1251,6 +1280,21 @@ func (mr *MockClusterMetadataManagerMockRecorder) ListClusterMetadata(request in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterMetadata", reflect.TypeOf((*MockClusterMetadataManager)(nil).ListClusterMetadata), request)
 }
 
+// ListDynamicConfig mocks base method.
+func (m *MockClusterMetadataManager) ListDynamicConfig(request *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfig", request)
+	ret0, _ := ret[0].(*ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockClusterMetadataManagerMockRecorder) ListDynamicConfig(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockClusterMetadataManager)(nil).ListDynamicConfig), request)
+}
+
 // PruneClusterMembership mocks base method.
 func (m *MockClusterMetadataManager) PruneClusterMembership(request *PruneClusterMembershipRequest) error {
 	m.ctrl.T.Helper()
@@ -1280,6 +1324,21 @@ func (mr *MockClusterMetadataManagerMockRecorder) SaveClusterMetadata(request in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveClusterMetadata", reflect.TypeOf((*MockClusterMetadataManager)(nil).SaveClusterMetadata), request)
 }
 
+// SaveDynamicConfig mocks base method.
+func (m *MockClusterMetadataManager) SaveDynamicConfig(request *SaveDynamicConfigRequest) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDynamicConfig", request)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveDynamicConfig indicates an expected call of SaveDynamicConfig.
+func (mr *MockClusterMetadataManagerMockRecorder) SaveDynamicConfig(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDynamicConfig", reflect.TypeOf((*MockClusterMetadataManager)(nil).SaveDynamicConfig), request)
+}
+
 // UpsertClusterMembership mocks base method.
 func (m *MockClusterMetadataManager) UpsertClusterMembership(request *UpsertClusterMembershipRequest) error {
 	m.ctrl.T.Helper()
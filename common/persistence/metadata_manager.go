@@ -105,6 +105,41 @@ func (m *metadataManagerImpl) UpdateNamespace(request *UpdateNamespaceRequest) e
 	})
 }
 
+func (m *metadataManagerImpl) RenameNamespace(request *RenameNamespaceRequest) error {
+	ns, err := m.GetNamespace(&GetNamespaceRequest{
+		Name: request.PreviousName,
+	})
+	if err != nil {
+		return err
+	}
+
+	metadata, err := m.GetMetadata()
+	if err != nil {
+		return err
+	}
+
+	previousName := ns.Namespace.Info.Name
+	ns.Namespace.Info.Name = request.NewName
+
+	nsDataBlob, err := m.serializer.NamespaceDetailToBlob(ns.Namespace, enumspb.ENCODING_TYPE_PROTO3)
+	if err != nil {
+		return err
+	}
+
+	renameRequest := &InternalRenameNamespaceRequest{
+		InternalUpdateNamespaceRequest: &InternalUpdateNamespaceRequest{
+			Id:                  ns.Namespace.Info.Id,
+			Name:                ns.Namespace.Info.Name,
+			Namespace:           nsDataBlob,
+			NotificationVersion: metadata.NotificationVersion,
+			IsGlobal:            ns.IsGlobalNamespace,
+		},
+		PreviousName: previousName,
+	}
+
+	return m.persistence.RenameNamespace(renameRequest)
+}
+
 func (m *metadataManagerImpl) DeleteNamespace(request *DeleteNamespaceRequest) error {
 	return m.persistence.DeleteNamespace(request)
 }
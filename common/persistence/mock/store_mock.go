@@ -446,6 +446,20 @@ func (mr *MockMetadataStoreMockRecorder) ListNamespaces(request interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaces", reflect.TypeOf((*MockMetadataStore)(nil).ListNamespaces), request)
 }
 
+// RenameNamespace mocks base method.
+func (m *MockMetadataStore) RenameNamespace(request *persistence.InternalRenameNamespaceRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameNamespace", request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameNamespace indicates an expected call of RenameNamespace.
+func (mr *MockMetadataStoreMockRecorder) RenameNamespace(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNamespace", reflect.TypeOf((*MockMetadataStore)(nil).RenameNamespace), request)
+}
+
 // UpdateNamespace mocks base method.
 func (m *MockMetadataStore) UpdateNamespace(request *persistence.InternalUpdateNamespaceRequest) error {
 	m.ctrl.T.Helper()
@@ -539,6 +553,21 @@ func (mr *MockClusterMetadataStoreMockRecorder) GetClusterMetadata(request inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterMetadata", reflect.TypeOf((*MockClusterMetadataStore)(nil).GetClusterMetadata), request)
 }
 
+// GetDynamicConfig mocks base method.
+func (m *MockClusterMetadataStore) GetDynamicConfig(request *persistence.InternalGetDynamicConfigRequest) (*persistence.InternalGetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig", request)
+	ret0, _ := ret[0].(*persistence.InternalGetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockClusterMetadataStoreMockRecorder) GetDynamicConfig(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockClusterMetadataStore)(nil).GetDynamicConfig), request)
+}
+
 // GetName mocks base method.
 func (m *MockClusterMetadataStore) GetName() string {
 	m.ctrl.T.Helper()
@@ -568,6 +597,21 @@ func (mr *MockClusterMetadataStoreMockRecorder) ListClusterMetadata(request inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterMetadata", reflect.TypeOf((*MockClusterMetadataStore)(nil).ListClusterMetadata), request)
 }
 
+// ListDynamicConfig mocks base method.
+func (m *MockClusterMetadataStore) ListDynamicConfig(request *persistence.InternalListDynamicConfigRequest) (*persistence.InternalListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfig", request)
+	ret0, _ := ret[0].(*persistence.InternalListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockClusterMetadataStoreMockRecorder) ListDynamicConfig(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockClusterMetadataStore)(nil).ListDynamicConfig), request)
+}
+
 // PruneClusterMembership mocks base method.
 func (m *MockClusterMetadataStore) PruneClusterMembership(request *persistence.PruneClusterMembershipRequest) error {
 	m.ctrl.T.Helper()
@@ -597,6 +641,21 @@ func (mr *MockClusterMetadataStoreMockRecorder) SaveClusterMetadata(request inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveClusterMetadata", reflect.TypeOf((*MockClusterMetadataStore)(nil).SaveClusterMetadata), request)
 }
 
+// SaveDynamicConfig mocks base method.
+func (m *MockClusterMetadataStore) SaveDynamicConfig(request *persistence.InternalSaveDynamicConfigRequest) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDynamicConfig", request)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveDynamicConfig indicates an expected call of SaveDynamicConfig.
+func (mr *MockClusterMetadataStoreMockRecorder) SaveDynamicConfig(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDynamicConfig", reflect.TypeOf((*MockClusterMetadataStore)(nil).SaveDynamicConfig), request)
+}
+
 // UpsertClusterMembership mocks base method.
 func (m *MockClusterMetadataStore) UpsertClusterMembership(request *persistence.UpsertClusterMembershipRequest) error {
 	m.ctrl.T.Helper()
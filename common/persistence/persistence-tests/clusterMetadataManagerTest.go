@@ -453,3 +453,60 @@ func (s *ClusterMetadataManagerSuite) TestInitImmutableMetadataReadWrite() {
 	s.NoError(err)
 	s.Equal(1, len(resp.ClusterMetadata))
 }
+
+// TestDynamicConfigReadWrite test
+func (s *ClusterMetadataManagerSuite) TestDynamicConfigReadWrite() {
+	key := "testing.dynamicconfig." + uuid.New()
+
+	// Case 1 - Get, no data persisted
+	getResp, err := s.ClusterMetadataManager.GetDynamicConfig(&p.GetDynamicConfigRequest{Key: key})
+	s.IsType(&serviceerror.NotFound{}, err)
+	s.Nil(getResp)
+
+	// Case 2 - Save new entry
+	entry := &persistencespb.DynamicConfigEntry{
+		Key: key,
+		Values: []*persistencespb.DynamicConfigValue{
+			{Value: []byte("100"), Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "samples-namespace"}},
+		},
+	}
+	applied, err := s.ClusterMetadataManager.SaveDynamicConfig(&p.SaveDynamicConfigRequest{Entry: entry})
+	s.NoError(err)
+	s.True(applied)
+
+	getResp, err = s.ClusterMetadataManager.GetDynamicConfig(&p.GetDynamicConfigRequest{Key: key})
+	s.NoError(err)
+	s.Equal(int64(1), getResp.Version)
+	s.Equal(entry, getResp.Entry)
+
+	// Case 3 - Save with stale version is not applied
+	entry.Values[0].Value = []byte("200")
+	applied, err = s.ClusterMetadataManager.SaveDynamicConfig(&p.SaveDynamicConfigRequest{Entry: entry})
+	s.NoError(err)
+	s.False(applied)
+
+	// Case 4 - Save with current version
+	applied, err = s.ClusterMetadataManager.SaveDynamicConfig(&p.SaveDynamicConfigRequest{Entry: entry, Version: getResp.Version})
+	s.NoError(err)
+	s.True(applied)
+
+	getResp, err = s.ClusterMetadataManager.GetDynamicConfig(&p.GetDynamicConfigRequest{Key: key})
+	s.NoError(err)
+	s.Equal(int64(2), getResp.Version)
+	s.Equal([]byte("200"), getResp.Entry.Values[0].Value)
+
+	// Case 5 - List doesn't mix dynamic config and cluster metadata
+	listResp, err := s.ClusterMetadataManager.ListDynamicConfig(&p.ListDynamicConfigRequest{PageSize: 100})
+	s.NoError(err)
+	found := false
+	for _, e := range listResp.Entries {
+		found = found || e.Entry.Key == key
+	}
+	s.True(found)
+
+	clusterResp, err := s.ClusterMetadataManager.ListClusterMetadata(&p.ListClusterMetadataRequest{PageSize: 100})
+	s.NoError(err)
+	for _, c := range clusterResp.ClusterMetadata {
+		s.NotEqual(key, c.ClusterName)
+	}
+}
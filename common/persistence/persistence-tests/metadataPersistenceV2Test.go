@@ -893,6 +893,101 @@ func (m *MetadataPersistenceSuiteV2) TestDeleteNamespace() {
 	m.Nil(resp9)
 }
 
+// TestRenameNamespace test
+func (m *MetadataPersistenceSuiteV2) TestRenameNamespace() {
+	id := uuid.New()
+	name := "rename-namespace-test-name"
+	newName := "rename-namespace-test-new-name"
+	newNewName := "rename-namespace-test-new-new-name"
+	state := enumspb.NAMESPACE_STATE_REGISTERED
+	description := "rename-namespace-test-description"
+	owner := "rename-namespace-test-owner"
+	data := map[string]string{"k1": "v1"}
+	retention := timestamp.DurationFromDays(10)
+	historyArchivalState := enumspb.ARCHIVAL_STATE_ENABLED
+	historyArchivalURI := "test://history/uri"
+	visibilityArchivalState := enumspb.ARCHIVAL_STATE_ENABLED
+	visibilityArchivalURI := "test://visibility/uri"
+
+	clusterActive := "some random active cluster name"
+	clusterStandby := "some random standby cluster name"
+	configVersion := int64(10)
+	failoverVersion := int64(59)
+	isGlobalNamespace := true
+	clusters := []string{clusterActive, clusterStandby}
+
+	resp1, err1 := m.CreateNamespace(
+		&persistencespb.NamespaceInfo{
+			Id:          id,
+			Name:        name,
+			State:       state,
+			Description: description,
+			Owner:       owner,
+			Data:        data,
+		},
+		&persistencespb.NamespaceConfig{
+			Retention:               retention,
+			HistoryArchivalState:    historyArchivalState,
+			HistoryArchivalUri:      historyArchivalURI,
+			VisibilityArchivalState: visibilityArchivalState,
+			VisibilityArchivalUri:   visibilityArchivalURI,
+		},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: clusterActive,
+			Clusters:          clusters,
+		},
+		isGlobalNamespace,
+		configVersion,
+		failoverVersion,
+	)
+	m.NoError(err1)
+	m.EqualValues(id, resp1.ID)
+
+	_, err2 := m.GetNamespace(id, "")
+	m.NoError(err2)
+
+	err3 := m.MetadataManager.RenameNamespace(&p.RenameNamespaceRequest{
+		PreviousName: name,
+		NewName:      newName,
+	})
+	m.NoError(err3)
+
+	resp4, err4 := m.GetNamespace("", newName)
+	m.NoError(err4)
+	m.NotNil(resp4)
+	m.EqualValues(newName, resp4.Namespace.Info.Name)
+	m.EqualValues(id, resp4.Namespace.Info.Id)
+
+	resp5, err5 := m.GetNamespace(id, "")
+	m.NoError(err5)
+	m.NotNil(resp5)
+	m.EqualValues(newName, resp5.Namespace.Info.Name)
+	m.EqualValues(id, resp5.Namespace.Info.Id)
+
+	_, err6 := m.GetNamespace("", name)
+	m.Error(err6)
+	m.IsType(&serviceerror.NotFound{}, err6)
+
+	err7 := m.MetadataManager.RenameNamespace(&p.RenameNamespaceRequest{
+		PreviousName: newName,
+		NewName:      newNewName,
+	})
+	m.NoError(err7)
+
+	resp8, err8 := m.GetNamespace("", newNewName)
+	m.NoError(err8)
+	m.NotNil(resp8)
+	m.EqualValues(newNewName, resp8.Namespace.Info.Name)
+	m.EqualValues(id, resp8.Namespace.Info.Id)
+
+	err9 := m.MetadataManager.RenameNamespace(&p.RenameNamespaceRequest{
+		PreviousName: name,
+		NewName:      newName,
+	})
+	m.Error(err9)
+	m.IsType(&serviceerror.NotFound{}, err9)
+}
+
 // TestListNamespaces test
 func (m *MetadataPersistenceSuiteV2) TestListNamespaces() {
 	clusterActive1 := "some random active cluster name"
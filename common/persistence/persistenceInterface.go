@@ -81,6 +81,7 @@ type (
 		CreateNamespace(request *InternalCreateNamespaceRequest) (*CreateNamespaceResponse, error)
 		GetNamespace(request *GetNamespaceRequest) (*InternalGetNamespaceResponse, error)
 		UpdateNamespace(request *InternalUpdateNamespaceRequest) error
+		RenameNamespace(request *InternalRenameNamespaceRequest) error
 		DeleteNamespace(request *DeleteNamespaceRequest) error
 		DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error
 		ListNamespaces(request *ListNamespacesRequest) (*InternalListNamespacesResponse, error)
@@ -97,6 +98,10 @@ type (
 		GetClusterMetadata(request *InternalGetClusterMetadataRequest) (*InternalGetClusterMetadataResponse, error)
 		SaveClusterMetadata(request *InternalSaveClusterMetadataRequest) (bool, error)
 		DeleteClusterMetadata(request *InternalDeleteClusterMetadataRequest) error
+		// Dynamic config APIs
+		ListDynamicConfig(request *InternalListDynamicConfigRequest) (*InternalListDynamicConfigResponse, error)
+		GetDynamicConfig(request *InternalGetDynamicConfigRequest) (*InternalGetDynamicConfigResponse, error)
+		SaveDynamicConfig(request *InternalSaveDynamicConfigRequest) (bool, error)
 		// Membership APIs
 		GetClusterMembers(request *GetClusterMembersRequest) (*GetClusterMembersResponse, error)
 		UpsertClusterMembership(request *UpsertClusterMembershipRequest) error
@@ -675,6 +680,12 @@ type (
 		IsGlobal            bool
 	}
 
+	// InternalRenameNamespaceRequest is used to rename namespace
+	InternalRenameNamespaceRequest struct {
+		*InternalUpdateNamespaceRequest
+		PreviousName string
+	}
+
 	// InternalListNamespacesResponse is the response for GetNamespace
 	InternalListNamespacesResponse struct {
 		Namespaces    []*InternalGetNamespaceResponse
@@ -718,6 +729,38 @@ type (
 		ClusterName string
 	}
 
+	// InternalListDynamicConfigRequest is the request for ListDynamicConfig
+	InternalListDynamicConfigRequest struct {
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// InternalListDynamicConfigResponse is the response for ListDynamicConfig
+	InternalListDynamicConfigResponse struct {
+		Entries       []*InternalGetDynamicConfigResponse
+		NextPageToken []byte
+	}
+
+	// InternalGetDynamicConfigRequest is the request for GetDynamicConfig
+	InternalGetDynamicConfigRequest struct {
+		Key string
+	}
+
+	// InternalGetDynamicConfigResponse is the response for GetDynamicConfig
+	InternalGetDynamicConfigResponse struct {
+		// Serialized DynamicConfigEntry.
+		Entry   *commonpb.DataBlob
+		Version int64
+	}
+
+	// InternalSaveDynamicConfigRequest is the request for SaveDynamicConfig
+	InternalSaveDynamicConfigRequest struct {
+		Key string
+		// Serialized DynamicConfigEntry.
+		Entry   *commonpb.DataBlob
+		Version int64
+	}
+
 	// InternalUpsertClusterMembershipRequest is the request to UpsertClusterMembership
 	InternalUpsertClusterMembershipRequest struct {
 		ClusterMember
@@ -800,6 +800,20 @@ func (p *metadataPersistenceClient) DeleteNamespace(request *DeleteNamespaceRequ
 	return err
 }
 
+func (p *metadataPersistenceClient) RenameNamespace(request *RenameNamespaceRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRenameNamespaceScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRenameNamespaceScope, metrics.PersistenceLatency)
+	err := p.persistence.RenameNamespace(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRenameNamespaceScope, err)
+	}
+
+	return err
+}
+
 func (p *metadataPersistenceClient) DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteNamespaceByNameScope, metrics.PersistenceRequests)
 
@@ -1205,6 +1219,48 @@ func (c *clusterMetadataPersistenceClient) DeleteClusterMetadata(request *Delete
 	return err
 }
 
+func (c *clusterMetadataPersistenceClient) ListDynamicConfig(request *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error) {
+	c.metricClient.IncCounter(metrics.PersistenceListDynamicConfigScope, metrics.PersistenceRequests)
+
+	sw := c.metricClient.StartTimer(metrics.PersistenceListDynamicConfigScope, metrics.PersistenceLatency)
+	result, err := c.persistence.ListDynamicConfig(request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricClient.IncCounter(metrics.PersistenceListDynamicConfigScope, metrics.PersistenceFailures)
+	}
+
+	return result, err
+}
+
+func (c *clusterMetadataPersistenceClient) GetDynamicConfig(request *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	c.metricClient.IncCounter(metrics.PersistenceGetDynamicConfigScope, metrics.PersistenceRequests)
+
+	sw := c.metricClient.StartTimer(metrics.PersistenceGetDynamicConfigScope, metrics.PersistenceLatency)
+	result, err := c.persistence.GetDynamicConfig(request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricClient.IncCounter(metrics.PersistenceGetDynamicConfigScope, metrics.PersistenceFailures)
+	}
+
+	return result, err
+}
+
+func (c *clusterMetadataPersistenceClient) SaveDynamicConfig(request *SaveDynamicConfigRequest) (bool, error) {
+	c.metricClient.IncCounter(metrics.PersistenceSaveDynamicConfigScope, metrics.PersistenceRequests)
+
+	sw := c.metricClient.StartTimer(metrics.PersistenceSaveDynamicConfigScope, metrics.PersistenceLatency)
+	applied, err := c.persistence.SaveDynamicConfig(request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricClient.IncCounter(metrics.PersistenceSaveDynamicConfigScope, metrics.PersistenceFailures)
+	}
+
+	return applied, err
+}
+
 func (c *clusterMetadataPersistenceClient) GetName() string {
 	return c.persistence.GetName()
 }
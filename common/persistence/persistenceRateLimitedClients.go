@@ -562,6 +562,15 @@ func (p *metadataRateLimitedPersistenceClient) DeleteNamespace(request *DeleteNa
 	return err
 }
 
+func (p *metadataRateLimitedPersistenceClient) RenameNamespace(request *RenameNamespaceRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.RenameNamespace(request)
+	return err
+}
+
 func (p *metadataRateLimitedPersistenceClient) DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return ErrPersistenceLimitExceeded
@@ -840,6 +849,27 @@ func (c *clusterMetadataRateLimitedPersistenceClient) DeleteClusterMetadata(requ
 	return c.persistence.DeleteClusterMetadata(request)
 }
 
+func (c *clusterMetadataRateLimitedPersistenceClient) ListDynamicConfig(request *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return c.persistence.ListDynamicConfig(request)
+}
+
+func (c *clusterMetadataRateLimitedPersistenceClient) GetDynamicConfig(request *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return c.persistence.GetDynamicConfig(request)
+}
+
+func (c *clusterMetadataRateLimitedPersistenceClient) SaveDynamicConfig(request *SaveDynamicConfigRequest) (bool, error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		return false, ErrPersistenceLimitExceeded
+	}
+	return c.persistence.SaveDynamicConfig(request)
+}
+
 func (c *metadataRateLimitedPersistenceClient) InitializeSystemNamespaces(currentClusterName string) error {
 	if ok := c.rateLimiter.Allow(); !ok {
 		return ErrPersistenceLimitExceeded
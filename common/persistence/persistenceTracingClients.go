@@ -0,0 +1,753 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/tracing"
+)
+
+type (
+	shardTracingPersistenceClient struct {
+		persistence ShardManager
+	}
+
+	executionTracingPersistenceClient struct {
+		persistence ExecutionManager
+	}
+
+	taskTracingPersistenceClient struct {
+		persistence TaskManager
+	}
+
+	metadataTracingPersistenceClient struct {
+		persistence MetadataManager
+	}
+
+	clusterMetadataTracingPersistenceClient struct {
+		persistence ClusterMetadataManager
+	}
+
+	queueTracingPersistenceClient struct {
+		persistence Queue
+	}
+)
+
+var _ ShardManager = (*shardTracingPersistenceClient)(nil)
+var _ ExecutionManager = (*executionTracingPersistenceClient)(nil)
+var _ TaskManager = (*taskTracingPersistenceClient)(nil)
+var _ MetadataManager = (*metadataTracingPersistenceClient)(nil)
+var _ ClusterMetadataManager = (*clusterMetadataTracingPersistenceClient)(nil)
+var _ Queue = (*queueTracingPersistenceClient)(nil)
+
+// NewShardPersistenceTracingClient creates a client to manage shards which records a span for every call
+func NewShardPersistenceTracingClient(persistence ShardManager) ShardManager {
+	return &shardTracingPersistenceClient{
+		persistence: persistence,
+	}
+}
+
+// NewExecutionPersistenceTracingClient creates a client to manage executions which records a span for every call
+func NewExecutionPersistenceTracingClient(persistence ExecutionManager) ExecutionManager {
+	return &executionTracingPersistenceClient{
+		persistence: persistence,
+	}
+}
+
+// NewTaskPersistenceTracingClient creates a client to manage tasks which records a span for every call
+func NewTaskPersistenceTracingClient(persistence TaskManager) TaskManager {
+	return &taskTracingPersistenceClient{
+		persistence: persistence,
+	}
+}
+
+// NewMetadataPersistenceTracingClient creates a MetadataManager client to manage metadata which records a span for every call
+func NewMetadataPersistenceTracingClient(persistence MetadataManager) MetadataManager {
+	return &metadataTracingPersistenceClient{
+		persistence: persistence,
+	}
+}
+
+// NewClusterMetadataPersistenceTracingClient creates a ClusterMetadataManager client to manage cluster metadata which records a span for every call
+func NewClusterMetadataPersistenceTracingClient(persistence ClusterMetadataManager) ClusterMetadataManager {
+	return &clusterMetadataTracingPersistenceClient{
+		persistence: persistence,
+	}
+}
+
+// NewQueuePersistenceTracingClient creates a client to manage queue which records a span for every call
+func NewQueuePersistenceTracingClient(persistence Queue) Queue {
+	return &queueTracingPersistenceClient{
+		persistence: persistence,
+	}
+}
+
+func (p *shardTracingPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardTracingPersistenceClient) GetOrCreateShard(request *GetOrCreateShardRequest) (*GetOrCreateShardResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetOrCreateShard", p.persistence.GetName())
+	response, err := p.persistence.GetOrCreateShard(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *shardTracingPersistenceClient) UpdateShard(request *UpdateShardRequest) error {
+	span := startPersistenceSpan(context.TODO(), "UpdateShard", p.persistence.GetName())
+	err := p.persistence.UpdateShard(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *shardTracingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *executionTracingPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *executionTracingPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "CreateWorkflowExecution", p.persistence.GetName())
+	response, err := p.persistence.CreateWorkflowExecution(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetWorkflowExecution", p.persistence.GetName())
+	response, err := p.persistence.GetWorkflowExecution(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) SetWorkflowExecution(request *SetWorkflowExecutionRequest) (*SetWorkflowExecutionResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "SetWorkflowExecution", p.persistence.GetName())
+	response, err := p.persistence.SetWorkflowExecution(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "UpdateWorkflowExecution", p.persistence.GetName())
+	response, err := p.persistence.UpdateWorkflowExecution(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) (*ConflictResolveWorkflowExecutionResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "ConflictResolveWorkflowExecution", p.persistence.GetName())
+	response, err := p.persistence.ConflictResolveWorkflowExecution(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	span := startPersistenceSpan(context.TODO(), "DeleteWorkflowExecution", p.persistence.GetName())
+	err := p.persistence.DeleteWorkflowExecution(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	span := startPersistenceSpan(context.TODO(), "DeleteCurrentWorkflowExecution", p.persistence.GetName())
+	err := p.persistence.DeleteCurrentWorkflowExecution(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetCurrentExecution", p.persistence.GetName())
+	response, err := p.persistence.GetCurrentExecution(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "ListConcreteExecutions", p.persistence.GetName())
+	response, err := p.persistence.ListConcreteExecutions(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) AddTasks(request *AddTasksRequest) error {
+	span := startPersistenceSpan(context.TODO(), "AddTasks", p.persistence.GetName())
+	err := p.persistence.AddTasks(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) GetTransferTask(request *GetTransferTaskRequest) (*GetTransferTaskResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetTransferTask", p.persistence.GetName())
+	response, err := p.persistence.GetTransferTask(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetTransferTasks", p.persistence.GetName())
+	response, err := p.persistence.GetTransferTasks(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) GetVisibilityTask(request *GetVisibilityTaskRequest) (*GetVisibilityTaskResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetVisibilityTask", p.persistence.GetName())
+	response, err := p.persistence.GetVisibilityTask(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) GetVisibilityTasks(request *GetVisibilityTasksRequest) (*GetVisibilityTasksResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetVisibilityTasks", p.persistence.GetName())
+	response, err := p.persistence.GetVisibilityTasks(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) GetReplicationTask(request *GetReplicationTaskRequest) (*GetReplicationTaskResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetReplicationTask", p.persistence.GetName())
+	response, err := p.persistence.GetReplicationTask(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetReplicationTasks", p.persistence.GetName())
+	response, err := p.persistence.GetReplicationTasks(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	span := startPersistenceSpan(context.TODO(), "CompleteTransferTask", p.persistence.GetName())
+	err := p.persistence.CompleteTransferTask(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	span := startPersistenceSpan(context.TODO(), "RangeCompleteTransferTask", p.persistence.GetName())
+	err := p.persistence.RangeCompleteTransferTask(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) CompleteVisibilityTask(request *CompleteVisibilityTaskRequest) error {
+	span := startPersistenceSpan(context.TODO(), "CompleteVisibilityTask", p.persistence.GetName())
+	err := p.persistence.CompleteVisibilityTask(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) RangeCompleteVisibilityTask(request *RangeCompleteVisibilityTaskRequest) error {
+	span := startPersistenceSpan(context.TODO(), "RangeCompleteVisibilityTask", p.persistence.GetName())
+	err := p.persistence.RangeCompleteVisibilityTask(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	span := startPersistenceSpan(context.TODO(), "CompleteReplicationTask", p.persistence.GetName())
+	err := p.persistence.CompleteReplicationTask(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) RangeCompleteReplicationTask(request *RangeCompleteReplicationTaskRequest) error {
+	span := startPersistenceSpan(context.TODO(), "RangeCompleteReplicationTask", p.persistence.GetName())
+	err := p.persistence.RangeCompleteReplicationTask(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) PutReplicationTaskToDLQ(request *PutReplicationTaskToDLQRequest) error {
+	span := startPersistenceSpan(context.TODO(), "PutReplicationTaskToDLQ", p.persistence.GetName())
+	err := p.persistence.PutReplicationTaskToDLQ(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) GetReplicationTasksFromDLQ(request *GetReplicationTasksFromDLQRequest) (*GetReplicationTasksFromDLQResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetReplicationTasksFromDLQ", p.persistence.GetName())
+	response, err := p.persistence.GetReplicationTasksFromDLQ(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) DeleteReplicationTaskFromDLQ(request *DeleteReplicationTaskFromDLQRequest) error {
+	span := startPersistenceSpan(context.TODO(), "DeleteReplicationTaskFromDLQ", p.persistence.GetName())
+	err := p.persistence.DeleteReplicationTaskFromDLQ(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) RangeDeleteReplicationTaskFromDLQ(request *RangeDeleteReplicationTaskFromDLQRequest) error {
+	span := startPersistenceSpan(context.TODO(), "RangeDeleteReplicationTaskFromDLQ", p.persistence.GetName())
+	err := p.persistence.RangeDeleteReplicationTaskFromDLQ(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) GetTimerTask(request *GetTimerTaskRequest) (*GetTimerTaskResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetTimerTask", p.persistence.GetName())
+	response, err := p.persistence.GetTimerTask(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) GetTimerTasks(request *GetTimerTasksRequest) (*GetTimerTasksResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetTimerTasks", p.persistence.GetName())
+	response, err := p.persistence.GetTimerTasks(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	span := startPersistenceSpan(context.TODO(), "CompleteTimerTask", p.persistence.GetName())
+	err := p.persistence.CompleteTimerTask(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	span := startPersistenceSpan(context.TODO(), "RangeCompleteTimerTask", p.persistence.GetName())
+	err := p.persistence.RangeCompleteTimerTask(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "AppendHistoryNodes", p.persistence.GetName())
+	response, err := p.persistence.AppendHistoryNodes(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "ReadHistoryBranch", p.persistence.GetName())
+	response, err := p.persistence.ReadHistoryBranch(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) ReadHistoryBranchReverse(request *ReadHistoryBranchReverseRequest) (
+	*ReadHistoryBranchReverseResponse,
+	error,
+) {
+	span := startPersistenceSpan(context.TODO(), "ReadHistoryBranchReverse", p.persistence.GetName())
+	response, err := p.persistence.ReadHistoryBranchReverse(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "ReadHistoryBranchByBatch", p.persistence.GetName())
+	response, err := p.persistence.ReadHistoryBranchByBatch(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "ReadRawHistoryBranch", p.persistence.GetName())
+	response, err := p.persistence.ReadRawHistoryBranch(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "ForkHistoryBranch", p.persistence.GetName())
+	response, err := p.persistence.ForkHistoryBranch(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	span := startPersistenceSpan(context.TODO(), "DeleteHistoryBranch", p.persistence.GetName())
+	err := p.persistence.DeleteHistoryBranch(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *executionTracingPersistenceClient) TrimHistoryBranch(request *TrimHistoryBranchRequest) (*TrimHistoryBranchResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "TrimHistoryBranch", p.persistence.GetName())
+	response, err := p.persistence.TrimHistoryBranch(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetAllHistoryTreeBranches", p.persistence.GetName())
+	response, err := p.persistence.GetAllHistoryTreeBranches(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetHistoryTree", p.persistence.GetName())
+	response, err := p.persistence.GetHistoryTree(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *executionTracingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskTracingPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskTracingPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "CreateTasks", p.persistence.GetName())
+	response, err := p.persistence.CreateTasks(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *taskTracingPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetTasks", p.persistence.GetName())
+	response, err := p.persistence.GetTasks(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *taskTracingPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
+	span := startPersistenceSpan(context.TODO(), "CompleteTask", p.persistence.GetName())
+	err := p.persistence.CompleteTask(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *taskTracingPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
+	span := startPersistenceSpan(context.TODO(), "CompleteTasksLessThan", p.persistence.GetName())
+	response, err := p.persistence.CompleteTasksLessThan(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *taskTracingPersistenceClient) CreateTaskQueue(request *CreateTaskQueueRequest) (*CreateTaskQueueResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "CreateTaskQueue", p.persistence.GetName())
+	response, err := p.persistence.CreateTaskQueue(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *taskTracingPersistenceClient) UpdateTaskQueue(request *UpdateTaskQueueRequest) (*UpdateTaskQueueResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "UpdateTaskQueue", p.persistence.GetName())
+	response, err := p.persistence.UpdateTaskQueue(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *taskTracingPersistenceClient) GetTaskQueue(request *GetTaskQueueRequest) (*GetTaskQueueResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetTaskQueue", p.persistence.GetName())
+	response, err := p.persistence.GetTaskQueue(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *taskTracingPersistenceClient) ListTaskQueue(request *ListTaskQueueRequest) (*ListTaskQueueResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "ListTaskQueue", p.persistence.GetName())
+	response, err := p.persistence.ListTaskQueue(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *taskTracingPersistenceClient) DeleteTaskQueue(request *DeleteTaskQueueRequest) error {
+	span := startPersistenceSpan(context.TODO(), "DeleteTaskQueue", p.persistence.GetName())
+	err := p.persistence.DeleteTaskQueue(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *taskTracingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *metadataTracingPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *metadataTracingPersistenceClient) CreateNamespace(request *CreateNamespaceRequest) (*CreateNamespaceResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "CreateNamespace", p.persistence.GetName())
+	response, err := p.persistence.CreateNamespace(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *metadataTracingPersistenceClient) GetNamespace(request *GetNamespaceRequest) (*GetNamespaceResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetNamespace", p.persistence.GetName())
+	response, err := p.persistence.GetNamespace(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *metadataTracingPersistenceClient) UpdateNamespace(request *UpdateNamespaceRequest) error {
+	span := startPersistenceSpan(context.TODO(), "UpdateNamespace", p.persistence.GetName())
+	err := p.persistence.UpdateNamespace(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *metadataTracingPersistenceClient) DeleteNamespace(request *DeleteNamespaceRequest) error {
+	span := startPersistenceSpan(context.TODO(), "DeleteNamespace", p.persistence.GetName())
+	err := p.persistence.DeleteNamespace(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *metadataTracingPersistenceClient) RenameNamespace(request *RenameNamespaceRequest) error {
+	span := startPersistenceSpan(context.TODO(), "RenameNamespace", p.persistence.GetName())
+	err := p.persistence.RenameNamespace(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *metadataTracingPersistenceClient) DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error {
+	span := startPersistenceSpan(context.TODO(), "DeleteNamespaceByName", p.persistence.GetName())
+	err := p.persistence.DeleteNamespaceByName(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *metadataTracingPersistenceClient) ListNamespaces(request *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "ListNamespaces", p.persistence.GetName())
+	response, err := p.persistence.ListNamespaces(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *metadataTracingPersistenceClient) GetMetadata() (*GetMetadataResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetMetadata", p.persistence.GetName())
+	response, err := p.persistence.GetMetadata()
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *metadataTracingPersistenceClient) InitializeSystemNamespaces(currentClusterName string) error {
+	span := startPersistenceSpan(context.TODO(), "InitializeSystemNamespaces", p.persistence.GetName())
+	err := p.persistence.InitializeSystemNamespaces(currentClusterName)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *metadataTracingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *clusterMetadataTracingPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *clusterMetadataTracingPersistenceClient) ListClusterMetadata(request *ListClusterMetadataRequest) (*ListClusterMetadataResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "ListClusterMetadata", p.persistence.GetName())
+	response, err := p.persistence.ListClusterMetadata(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *clusterMetadataTracingPersistenceClient) GetCurrentClusterMetadata() (*GetClusterMetadataResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetCurrentClusterMetadata", p.persistence.GetName())
+	response, err := p.persistence.GetCurrentClusterMetadata()
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *clusterMetadataTracingPersistenceClient) GetClusterMetadata(request *GetClusterMetadataRequest) (*GetClusterMetadataResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetClusterMetadata", p.persistence.GetName())
+	response, err := p.persistence.GetClusterMetadata(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *clusterMetadataTracingPersistenceClient) SaveClusterMetadata(request *SaveClusterMetadataRequest) (bool, error) {
+	span := startPersistenceSpan(context.TODO(), "SaveClusterMetadata", p.persistence.GetName())
+	response, err := p.persistence.SaveClusterMetadata(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *clusterMetadataTracingPersistenceClient) DeleteClusterMetadata(request *DeleteClusterMetadataRequest) error {
+	span := startPersistenceSpan(context.TODO(), "DeleteClusterMetadata", p.persistence.GetName())
+	err := p.persistence.DeleteClusterMetadata(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *clusterMetadataTracingPersistenceClient) ListDynamicConfig(request *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "ListDynamicConfig", p.persistence.GetName())
+	response, err := p.persistence.ListDynamicConfig(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *clusterMetadataTracingPersistenceClient) GetDynamicConfig(request *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetDynamicConfig", p.persistence.GetName())
+	response, err := p.persistence.GetDynamicConfig(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *clusterMetadataTracingPersistenceClient) SaveDynamicConfig(request *SaveDynamicConfigRequest) (bool, error) {
+	span := startPersistenceSpan(context.TODO(), "SaveDynamicConfig", p.persistence.GetName())
+	response, err := p.persistence.SaveDynamicConfig(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *clusterMetadataTracingPersistenceClient) GetClusterMembers(request *GetClusterMembersRequest) (*GetClusterMembersResponse, error) {
+	span := startPersistenceSpan(context.TODO(), "GetClusterMembers", p.persistence.GetName())
+	response, err := p.persistence.GetClusterMembers(request)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *clusterMetadataTracingPersistenceClient) UpsertClusterMembership(request *UpsertClusterMembershipRequest) error {
+	span := startPersistenceSpan(context.TODO(), "UpsertClusterMembership", p.persistence.GetName())
+	err := p.persistence.UpsertClusterMembership(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *clusterMetadataTracingPersistenceClient) PruneClusterMembership(request *PruneClusterMembershipRequest) error {
+	span := startPersistenceSpan(context.TODO(), "PruneClusterMembership", p.persistence.GetName())
+	err := p.persistence.PruneClusterMembership(request)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *clusterMetadataTracingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *queueTracingPersistenceClient) Init(blob *commonpb.DataBlob) error {
+	span := startPersistenceSpan(context.TODO(), "Init", "")
+	err := p.persistence.Init(blob)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *queueTracingPersistenceClient) EnqueueMessage(blob commonpb.DataBlob) error {
+	span := startPersistenceSpan(context.TODO(), "EnqueueMessage", "")
+	err := p.persistence.EnqueueMessage(blob)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *queueTracingPersistenceClient) ReadMessages(lastMessageID int64, maxCount int) ([]*QueueMessage, error) {
+	span := startPersistenceSpan(context.TODO(), "ReadMessages", "")
+	response, err := p.persistence.ReadMessages(lastMessageID, maxCount)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *queueTracingPersistenceClient) UpdateAckLevel(metadata *InternalQueueMetadata) error {
+	span := startPersistenceSpan(context.TODO(), "UpdateAckLevel", "")
+	err := p.persistence.UpdateAckLevel(metadata)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *queueTracingPersistenceClient) GetAckLevels() (*InternalQueueMetadata, error) {
+	span := startPersistenceSpan(context.TODO(), "GetAckLevels", "")
+	response, err := p.persistence.GetAckLevels()
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *queueTracingPersistenceClient) DeleteMessagesBefore(messageID int64) error {
+	span := startPersistenceSpan(context.TODO(), "DeleteMessagesBefore", "")
+	err := p.persistence.DeleteMessagesBefore(messageID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *queueTracingPersistenceClient) EnqueueMessageToDLQ(blob commonpb.DataBlob) (int64, error) {
+	span := startPersistenceSpan(context.TODO(), "EnqueueMessageToDLQ", "")
+	response, err := p.persistence.EnqueueMessageToDLQ(blob)
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *queueTracingPersistenceClient) ReadMessagesFromDLQ(firstMessageID int64, lastMessageID int64, pageSize int, pageToken []byte) ([]*QueueMessage, []byte, error) {
+	span := startPersistenceSpan(context.TODO(), "ReadMessagesFromDLQ", "")
+	messages, pageToken, err := p.persistence.ReadMessagesFromDLQ(firstMessageID, lastMessageID, pageSize, pageToken)
+	tracing.EndSpan(span, err)
+	return messages, pageToken, err
+}
+
+func (p *queueTracingPersistenceClient) DeleteMessageFromDLQ(messageID int64) error {
+	span := startPersistenceSpan(context.TODO(), "DeleteMessageFromDLQ", "")
+	err := p.persistence.DeleteMessageFromDLQ(messageID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *queueTracingPersistenceClient) RangeDeleteMessagesFromDLQ(firstMessageID int64, lastMessageID int64) error {
+	span := startPersistenceSpan(context.TODO(), "RangeDeleteMessagesFromDLQ", "")
+	err := p.persistence.RangeDeleteMessagesFromDLQ(firstMessageID, lastMessageID)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *queueTracingPersistenceClient) UpdateDLQAckLevel(metadata *InternalQueueMetadata) error {
+	span := startPersistenceSpan(context.TODO(), "UpdateDLQAckLevel", "")
+	err := p.persistence.UpdateDLQAckLevel(metadata)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (p *queueTracingPersistenceClient) GetDLQAckLevels() (*InternalQueueMetadata, error) {
+	span := startPersistenceSpan(context.TODO(), "GetDLQAckLevels", "")
+	response, err := p.persistence.GetDLQAckLevels()
+	tracing.EndSpan(span, err)
+	return response, err
+}
+
+func (p *queueTracingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+// startPersistenceSpan starts a span for persistence call as a child of the span in ctx. No span is
+// recorded if ctx has no span: persistence calls are only traced as part of the caller trace, they
+// never start a trace of their own. Persistence API doesn't accept context yet, calls pass
+// context.TODO() until it does.
+func startPersistenceSpan(ctx context.Context, operation string, store string) trace.Span {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return trace.SpanFromContext(context.Background())
+	}
+	opts := []trace.SpanStartOption{trace.WithSpanKind(trace.SpanKindClient)}
+	if store != "" {
+		opts = append(opts, trace.WithAttributes(tracing.StoreKey.String(store)))
+	}
+	_, span := tracing.Tracer().Start(ctx, "persistence."+operation, opts...)
+	return span
+}
@@ -99,6 +99,9 @@ type (
 		QueueMetadataToBlob(metadata *persistencespb.QueueMetadata, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error)
 		QueueMetadataFromBlob(data *commonpb.DataBlob) (*persistencespb.QueueMetadata, error)
 
+		DynamicConfigEntryToBlob(entry *persistencespb.DynamicConfigEntry, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error)
+		DynamicConfigEntryFromBlob(data *commonpb.DataBlob) (*persistencespb.DynamicConfigEntry, error)
+
 		ReplicationTaskToBlob(replicationTask *replicationspb.ReplicationTask, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error)
 		ReplicationTaskFromBlob(data *commonpb.DataBlob) (*replicationspb.ReplicationTask, error)
 
@@ -451,6 +454,15 @@ func (t *serializerImpl) QueueMetadataFromBlob(data *commonpb.DataBlob) (*persis
 	return result, decodeBlob(data, result)
 }
 
+func (t *serializerImpl) DynamicConfigEntryToBlob(entry *persistencespb.DynamicConfigEntry, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	return proto3EncodeBlob(entry, encodingType)
+}
+
+func (t *serializerImpl) DynamicConfigEntryFromBlob(data *commonpb.DataBlob) (*persistencespb.DynamicConfigEntry, error) {
+	result := &persistencespb.DynamicConfigEntry{}
+	return result, proto3DecodeBlob(data, result)
+}
+
 func (t *serializerImpl) ReplicationTaskToBlob(replicationTask *replicationspb.ReplicationTask, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
 	return proto3EncodeBlob(replicationTask, encodingType)
 }
@@ -37,6 +37,10 @@ import (
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
+// dynamicConfigMetadataPartition is the metadata partition of cluster_metadata_info
+// which stores dynamic config entries, keyed by dynamic config key instead of cluster name.
+const dynamicConfigMetadataPartition = 1
+
 type sqlClusterMetadataManager struct {
 	SqlStore
 }
@@ -154,6 +158,110 @@ func (s *sqlClusterMetadataManager) DeleteClusterMetadata(
 	return nil
 }
 
+func (s *sqlClusterMetadataManager) ListDynamicConfig(
+	request *p.InternalListDynamicConfigRequest,
+) (*p.InternalListDynamicConfigResponse, error) {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	var lastKey string
+	if request.NextPageToken != nil {
+		err := gobDeserialize(request.NextPageToken, &lastKey)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("error deserializing page token: %v", err))
+		}
+	}
+
+	rows, err := s.Db.ListClusterMetadata(ctx, &sqlplugin.ClusterMetadataFilter{
+		MetadataPartition: dynamicConfigMetadataPartition,
+		ClusterName:       lastKey,
+		PageSize:          &request.PageSize,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &p.InternalListDynamicConfigResponse{}, nil
+		}
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("ListDynamicConfig operation failed. Failed to get dynamic config rows. Error: %v", err))
+	}
+
+	resp := &p.InternalListDynamicConfigResponse{}
+	for _, row := range rows {
+		resp.Entries = append(resp.Entries, &p.InternalGetDynamicConfigResponse{
+			Entry:   p.NewDataBlob(row.Data, row.DataEncoding),
+			Version: row.Version,
+		})
+	}
+	if len(rows) >= request.PageSize {
+		nextPageToken, err := gobSerialize(rows[len(rows)-1].ClusterName)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("error serializing page token: %v", err))
+		}
+		resp.NextPageToken = nextPageToken
+	}
+	return resp, nil
+}
+
+func (s *sqlClusterMetadataManager) GetDynamicConfig(
+	request *p.InternalGetDynamicConfigRequest,
+) (*p.InternalGetDynamicConfigResponse, error) {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	row, err := s.Db.GetClusterMetadata(ctx, &sqlplugin.ClusterMetadataFilter{
+		MetadataPartition: dynamicConfigMetadataPartition,
+		ClusterName:       request.Key,
+	})
+	if err != nil {
+		return nil, convertCommonErrors("GetDynamicConfig", err)
+	}
+
+	return &p.InternalGetDynamicConfigResponse{
+		Entry:   p.NewDataBlob(row.Data, row.DataEncoding),
+		Version: row.Version,
+	}, nil
+}
+
+func (s *sqlClusterMetadataManager) SaveDynamicConfig(request *p.InternalSaveDynamicConfigRequest) (bool, error) {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	err := s.txExecute(ctx, "SaveDynamicConfig", func(tx sqlplugin.Tx) error {
+		old, err := tx.WriteLockGetClusterMetadata(ctx, &sqlplugin.ClusterMetadataFilter{
+			MetadataPartition: dynamicConfigMetadataPartition,
+			ClusterName:       request.Key,
+		})
+		var lastVersion int64
+		if err != nil {
+			if err != sql.ErrNoRows {
+				return serviceerror.NewUnavailable(fmt.Sprintf("SaveDynamicConfig operation failed. Error %v", err))
+			}
+		} else {
+			lastVersion = old.Version
+		}
+		if request.Version != lastVersion {
+			return &p.ConditionFailedError{
+				Msg: fmt.Sprintf("SaveDynamicConfig encountered version mismatch, expected %v but got %v.", request.Version, lastVersion),
+			}
+		}
+		_, err = tx.SaveClusterMetadata(ctx, &sqlplugin.ClusterMetadataRow{
+			MetadataPartition: dynamicConfigMetadataPartition,
+			ClusterName:       request.Key,
+			Data:              request.Entry.Data,
+			DataEncoding:      request.Entry.EncodingType.String(),
+			Version:           request.Version,
+		})
+		if err != nil {
+			return convertCommonErrors("SaveDynamicConfig", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		if _, ok := err.(*p.ConditionFailedError); ok {
+			return false, nil
+		}
+		return false, serviceerror.NewUnavailable(err.Error())
+	}
+	return true, nil
+}
+
 func (s *sqlClusterMetadataManager) GetClusterMembers(request *p.GetClusterMembersRequest) (*p.GetClusterMembersResponse, error) {
 	ctx, cancel := newExecutionContext()
 	defer cancel()
@@ -35,10 +35,11 @@ import (
 type (
 	// ClusterMetadataRow represents a row in the cluster_metadata table
 	ClusterMetadataRow struct {
-		ClusterName  string
-		Data         []byte
-		DataEncoding string
-		Version      int64
+		MetadataPartition int32
+		ClusterName       string
+		Data              []byte
+		DataEncoding      string
+		Version           int64
 	}
 
 	// ClusterMembershipRow represents a row in the cluster_membership table
@@ -54,8 +55,9 @@ type (
 	}
 
 	ClusterMetadataFilter struct {
-		ClusterName string
-		PageSize    *int
+		MetadataPartition int32
+		ClusterName       string
+		PageSize          *int
 	}
 
 	// ClusterMembershipFilter is used for GetClusterMembership queries
@@ -40,6 +40,7 @@ const (
 	DbKindUnknown DbKind = iota
 	DbKindMain
 	DbKindVisibility
+	DbKindAdvancedVisibility
 )
 
 type (
@@ -54,6 +55,7 @@ type (
 		ClusterMetadata
 		Namespace
 		Visibility
+		AdvancedVisibility
 		QueueMessage
 		QueueMetadata
 
@@ -118,6 +118,8 @@ func (mdb *db) ExpectedVersion() string {
 		return mysqlschema.Version
 	case sqlplugin.DbKindVisibility:
 		return mysqlschema.VisibilityVersion
+	case sqlplugin.DbKindAdvancedVisibility:
+		return mysqlschema.AdvancedVisibilityVersion
 	default:
 		panic(fmt.Sprintf("unknown db kind %v", mdb.dbKind))
 	}
@@ -0,0 +1,188 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+const (
+	templateUpsertAdvancedVisibility = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, ` +
+		`task_queue, state_transition_count, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (namespace_id, run_id) DO UPDATE
+		  SET workflow_id = excluded.workflow_id,
+		      start_time = excluded.start_time,
+		      execution_time = excluded.execution_time,
+		      workflow_type_name = excluded.workflow_type_name,
+		      close_time = excluded.close_time,
+		      status = excluded.status,
+		      history_length = excluded.history_length,
+		      memo = excluded.memo,
+		      encoding = excluded.encoding,
+		      task_queue = excluded.task_queue,
+		      state_transition_count = excluded.state_transition_count,
+		      search_attributes = excluded.search_attributes`
+
+	// Select queries are built dynamically with ? placeholders and rebound to $N before execution.
+
+	// Running workflows don't have close time and go first.
+	// Expression must match by_coalesce_close_time index.
+	advancedVisibilityCloseTime = `COALESCE(close_time, '9999-12-31 23:59:59')`
+
+	templateAdvancedVisibilitySelect = `SELECT workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, ` +
+		`close_time, history_length, task_queue, state_transition_count, search_attributes ` +
+		`FROM executions_visibility WHERE namespace_id = ?`
+
+	templateAdvancedVisibilityCount = `SELECT COUNT(1) FROM executions_visibility WHERE namespace_id = ?`
+
+	// RunID condition is needed for correct pagination
+	templateAdvancedVisibilityPageConditions = ` AND (` + advancedVisibilityCloseTime + ` < ?` +
+		` OR (` + advancedVisibilityCloseTime + ` = ? AND start_time < ?)` +
+		` OR (` + advancedVisibilityCloseTime + ` = ? AND start_time = ? AND run_id > ?))`
+
+	templateAdvancedVisibilityOrderBy = ` ORDER BY ` + advancedVisibilityCloseTime + ` DESC, start_time DESC, run_id LIMIT ?`
+)
+
+var (
+	maxAdvancedVisibilityCloseTime = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+)
+
+// UpsertIntoAdvancedVisibility inserts a row into visibility table or replaces the existing one
+func (pdb *db) UpsertIntoAdvancedVisibility(
+	ctx context.Context,
+	row *sqlplugin.VisibilityRow,
+) (sql.Result, error) {
+	var closeTime *time.Time
+	if row.CloseTime != nil {
+		t := pdb.converter.ToPostgreSQLDateTime(*row.CloseTime)
+		closeTime = &t
+	}
+	// JSONB value must be passed as text, not as bytea.
+	var searchAttributes *string
+	if row.SearchAttributes != nil {
+		s := string(row.SearchAttributes)
+		searchAttributes = &s
+	}
+	return pdb.conn.ExecContext(ctx,
+		templateUpsertAdvancedVisibility,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
+		pdb.converter.ToPostgreSQLDateTime(row.StartTime),
+		pdb.converter.ToPostgreSQLDateTime(row.ExecutionTime),
+		row.WorkflowTypeName,
+		closeTime,
+		row.Status,
+		row.HistoryLength,
+		row.Memo,
+		row.Encoding,
+		row.TaskQueue,
+		row.StateTransitionCount,
+		searchAttributes,
+	)
+}
+
+// SelectFromAdvancedVisibility reads a page of rows matching the filter from visibility table
+func (pdb *db) SelectFromAdvancedVisibility(
+	ctx context.Context,
+	filter sqlplugin.AdvancedVisibilitySelectFilter,
+) ([]sqlplugin.VisibilityRow, error) {
+	var query strings.Builder
+	query.WriteString(templateAdvancedVisibilitySelect)
+	args := pdb.advancedVisibilityQueryArgs(&query, filter)
+
+	if filter.StartTime != nil && filter.RunID != nil {
+		closeTime := maxAdvancedVisibilityCloseTime
+		if filter.CloseTime != nil {
+			closeTime = pdb.converter.ToPostgreSQLDateTime(*filter.CloseTime)
+		}
+		startTime := pdb.converter.ToPostgreSQLDateTime(*filter.StartTime)
+		query.WriteString(templateAdvancedVisibilityPageConditions)
+		args = append(args, closeTime, closeTime, startTime, closeTime, startTime, *filter.RunID)
+	}
+	query.WriteString(templateAdvancedVisibilityOrderBy)
+	args = append(args, filter.PageSize)
+
+	var rows []sqlplugin.VisibilityRow
+	if err := pdb.conn.SelectContext(ctx, &rows, pdb.conn.Rebind(query.String()), args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = pdb.converter.FromPostgreSQLDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = pdb.converter.FromPostgreSQLDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := pdb.converter.FromPostgreSQLDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+		// need to trim the run ID, or otherwise the returned value will
+		//  come with lots of trailing spaces, probably due to the CHAR(64) type
+		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
+	}
+	return rows, nil
+}
+
+// CountFromAdvancedVisibility returns the number of rows matching the filter
+func (pdb *db) CountFromAdvancedVisibility(
+	ctx context.Context,
+	filter sqlplugin.AdvancedVisibilitySelectFilter,
+) (int64, error) {
+	var query strings.Builder
+	query.WriteString(templateAdvancedVisibilityCount)
+	args := pdb.advancedVisibilityQueryArgs(&query, filter)
+
+	var count int64
+	if err := pdb.conn.GetContext(ctx, &count, pdb.conn.Rebind(query.String()), args...); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (pdb *db) advancedVisibilityQueryArgs(
+	query *strings.Builder,
+	filter sqlplugin.AdvancedVisibilitySelectFilter,
+) []interface{} {
+	args := make([]interface{}, 0, len(filter.QueryArgs)+8)
+	args = append(args, filter.NamespaceID)
+	if filter.QueryString == "" {
+		return args
+	}
+
+	query.WriteString(fmt.Sprintf(" AND (%s)", filter.QueryString))
+	for _, arg := range filter.QueryArgs {
+		if t, ok := arg.(time.Time); ok {
+			arg = pdb.converter.ToPostgreSQLDateTime(t)
+		}
+		args = append(args, arg)
+	}
+	return args
+}
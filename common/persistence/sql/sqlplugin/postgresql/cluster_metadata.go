@@ -35,7 +35,6 @@ import (
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
-const constMetadataPartition = 0
 const constMembershipPartition = 0
 const (
 	// ****** CLUSTER_METADATA_INFO TABLE ******
@@ -43,7 +42,7 @@ const (
 
 	updateClusterMetadataQry = `UPDATE cluster_metadata_info SET data = $1, data_encoding = $2, version = $3 WHERE metadata_partition = $4 AND cluster_name = $5`
 
-	getClusterMetadataBase      = `SELECT data, data_encoding, version FROM cluster_metadata_info `
+	getClusterMetadataBase      = `SELECT cluster_name, data, data_encoding, version FROM cluster_metadata_info `
 	getClusterMetadataQry       = getClusterMetadataBase + `WHERE metadata_partition = $1 AND cluster_name = $2`
 	listClusterMetadataQry      = getClusterMetadataBase + `WHERE metadata_partition = $1 ORDER BY cluster_name LIMIT $2`
 	listClusterMetadataRangeQry = getClusterMetadataBase + `WHERE metadata_partition = $1 AND cluster_name > $2 ORDER BY cluster_name LIMIT $3`
@@ -89,7 +88,7 @@ func (pdb *db) SaveClusterMetadata(
 	if row.Version == 0 {
 		return pdb.conn.ExecContext(ctx,
 			insertClusterMetadataQry,
-			constMetadataPartition,
+			row.MetadataPartition,
 			row.ClusterName,
 			row.Data,
 			row.DataEncoding,
@@ -101,7 +100,7 @@ func (pdb *db) SaveClusterMetadata(
 		row.Data,
 		row.DataEncoding,
 		row.Version+1,
-		constMetadataPartition,
+		row.MetadataPartition,
 		row.ClusterName,
 	)
 }
@@ -117,7 +116,7 @@ func (pdb *db) ListClusterMetadata(
 		err = pdb.conn.SelectContext(ctx,
 			&rows,
 			listClusterMetadataRangeQry,
-			constMetadataPartition,
+			filter.MetadataPartition,
 			filter.ClusterName,
 			filter.PageSize,
 		)
@@ -125,7 +124,7 @@ func (pdb *db) ListClusterMetadata(
 		err = pdb.conn.SelectContext(ctx,
 			&rows,
 			listClusterMetadataQry,
-			constMetadataPartition,
+			filter.MetadataPartition,
 			filter.PageSize,
 		)
 	}
@@ -140,7 +139,7 @@ func (pdb *db) GetClusterMetadata(
 	err := pdb.conn.GetContext(ctx,
 		&row,
 		getClusterMetadataQry,
-		constMetadataPartition,
+		filter.MetadataPartition,
 		filter.ClusterName,
 	)
 	if err != nil {
@@ -157,7 +156,7 @@ func (pdb *db) WriteLockGetClusterMetadata(
 	err := pdb.conn.GetContext(ctx,
 		&row,
 		writeLockGetClusterMetadataQry,
-		constMetadataPartition,
+		filter.MetadataPartition,
 		filter.ClusterName,
 	)
 	if err != nil {
@@ -173,7 +172,7 @@ func (pdb *db) DeleteClusterMetadata(
 
 	return pdb.conn.ExecContext(ctx,
 		deleteClusterMetadataQry,
-		constMetadataPartition,
+		filter.MetadataPartition,
 		filter.ClusterName,
 	)
 }
@@ -117,6 +117,8 @@ func (pdb *db) ExpectedVersion() string {
 		return postgresqlschema.Version
 	case sqlplugin.DbKindVisibility:
 		return postgresqlschema.VisibilityVersion
+	case sqlplugin.DbKindAdvancedVisibility:
+		return postgresqlschema.AdvancedVisibilityVersion
 	default:
 		panic(fmt.Sprintf("unknown db kind %v", pdb.dbKind))
 	}
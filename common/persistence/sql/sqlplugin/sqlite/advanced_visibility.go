@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build cgo
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+const (
+	templateUpsertAdvancedVisibility = `REPLACE INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, ` +
+		`task_queue, state_transition_count, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	// Running workflows don't have close time and go first.
+	// Timestamps are stored as text, literal uses the same format.
+	advancedVisibilityCloseTime = `COALESCE(close_time, '9999-12-31 23:59:59+00:00')`
+
+	templateAdvancedVisibilitySelect = `SELECT workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, ` +
+		`close_time, history_length, task_queue, state_transition_count, search_attributes ` +
+		`FROM executions_visibility WHERE namespace_id = ?`
+
+	templateAdvancedVisibilityCount = `SELECT COUNT(1) FROM executions_visibility WHERE namespace_id = ?`
+
+	// RunID condition is needed for correct pagination
+	templateAdvancedVisibilityPageConditions = ` AND (` + advancedVisibilityCloseTime + ` < ?` +
+		` OR (` + advancedVisibilityCloseTime + ` = ? AND start_time < ?)` +
+		` OR (` + advancedVisibilityCloseTime + ` = ? AND start_time = ? AND run_id > ?))`
+
+	templateAdvancedVisibilityOrderBy = ` ORDER BY ` + advancedVisibilityCloseTime + ` DESC, start_time DESC, run_id LIMIT ?`
+)
+
+var (
+	maxAdvancedVisibilityCloseTime = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+)
+
+// UpsertIntoAdvancedVisibility inserts a row into visibility table or replaces the existing one
+func (mdb *db) UpsertIntoAdvancedVisibility(
+	ctx context.Context,
+	row *sqlplugin.VisibilityRow,
+) (sql.Result, error) {
+	var closeTime *time.Time
+	if row.CloseTime != nil {
+		t := mdb.converter.ToSQLiteDateTime(*row.CloseTime)
+		closeTime = &t
+	}
+	// JSON functions don't accept BLOB values.
+	var searchAttributes *string
+	if row.SearchAttributes != nil {
+		s := string(row.SearchAttributes)
+		searchAttributes = &s
+	}
+	return mdb.conn.ExecContext(ctx,
+		templateUpsertAdvancedVisibility,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
+		mdb.converter.ToSQLiteDateTime(row.StartTime),
+		mdb.converter.ToSQLiteDateTime(row.ExecutionTime),
+		row.WorkflowTypeName,
+		closeTime,
+		row.Status,
+		row.HistoryLength,
+		row.Memo,
+		row.Encoding,
+		row.TaskQueue,
+		row.StateTransitionCount,
+		searchAttributes,
+	)
+}
+
+// SelectFromAdvancedVisibility reads a page of rows matching the filter from visibility table
+func (mdb *db) SelectFromAdvancedVisibility(
+	ctx context.Context,
+	filter sqlplugin.AdvancedVisibilitySelectFilter,
+) ([]sqlplugin.VisibilityRow, error) {
+	var query strings.Builder
+	query.WriteString(templateAdvancedVisibilitySelect)
+	args := mdb.advancedVisibilityQueryArgs(&query, filter)
+
+	if filter.StartTime != nil && filter.RunID != nil {
+		closeTime := maxAdvancedVisibilityCloseTime
+		if filter.CloseTime != nil {
+			closeTime = mdb.converter.ToSQLiteDateTime(*filter.CloseTime)
+		}
+		startTime := mdb.converter.ToSQLiteDateTime(*filter.StartTime)
+		query.WriteString(templateAdvancedVisibilityPageConditions)
+		args = append(args, closeTime, closeTime, startTime, closeTime, startTime, *filter.RunID)
+	}
+	query.WriteString(templateAdvancedVisibilityOrderBy)
+	args = append(args, filter.PageSize)
+
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.conn.SelectContext(ctx, &rows, query.String(), args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromSQLiteDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = mdb.converter.FromSQLiteDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := mdb.converter.FromSQLiteDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromAdvancedVisibility returns the number of rows matching the filter
+func (mdb *db) CountFromAdvancedVisibility(
+	ctx context.Context,
+	filter sqlplugin.AdvancedVisibilitySelectFilter,
+) (int64, error) {
+	var query strings.Builder
+	query.WriteString(templateAdvancedVisibilityCount)
+	args := mdb.advancedVisibilityQueryArgs(&query, filter)
+
+	var count int64
+	if err := mdb.conn.GetContext(ctx, &count, query.String(), args...); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (mdb *db) advancedVisibilityQueryArgs(
+	query *strings.Builder,
+	filter sqlplugin.AdvancedVisibilitySelectFilter,
+) []interface{} {
+	args := make([]interface{}, 0, len(filter.QueryArgs)+8)
+	args = append(args, filter.NamespaceID)
+	if filter.QueryString == "" {
+		return args
+	}
+
+	query.WriteString(fmt.Sprintf(" AND (%s)", filter.QueryString))
+	for _, arg := range filter.QueryArgs {
+		if t, ok := arg.(time.Time); ok {
+			arg = mdb.converter.ToSQLiteDateTime(t)
+		}
+		args = append(args, arg)
+	}
+	return args
+}
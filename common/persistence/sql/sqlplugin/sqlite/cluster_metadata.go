@@ -38,7 +38,6 @@ import (
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
-const constMetadataPartition = 0
 const constMembershipPartition = 0
 const (
 	// ****** CLUSTER_METADATA_INFO TABLE ******
@@ -46,7 +45,7 @@ const (
 
 	updateClusterMetadataQry = `UPDATE cluster_metadata_info SET data = ?, data_encoding = ?, version = ? WHERE metadata_partition = ? AND cluster_name = ?`
 
-	getClusterMetadataBase         = `SELECT data, data_encoding, version FROM cluster_metadata_info `
+	getClusterMetadataBase         = `SELECT cluster_name, data, data_encoding, version FROM cluster_metadata_info `
 	getClusterMetadataQry          = getClusterMetadataBase + `WHERE metadata_partition = ? AND cluster_name = ?`
 	listClusterMetadataQry         = getClusterMetadataBase + `WHERE metadata_partition = ? ORDER BY cluster_name LIMIT ?`
 	listClusterMetadataRangeQry    = getClusterMetadataBase + `WHERE metadata_partition = ? AND cluster_name > ? ORDER BY cluster_name LIMIT ?`
@@ -87,7 +86,7 @@ func (mdb *db) SaveClusterMetadata(
 	if row.Version == 0 {
 		return mdb.conn.ExecContext(ctx,
 			insertClusterMetadataQry,
-			constMetadataPartition,
+			row.MetadataPartition,
 			row.ClusterName,
 			row.Data,
 			row.DataEncoding,
@@ -99,7 +98,7 @@ func (mdb *db) SaveClusterMetadata(
 		row.Data,
 		row.DataEncoding,
 		row.Version+1,
-		constMetadataPartition,
+		row.MetadataPartition,
 		row.ClusterName,
 	)
 }
@@ -115,7 +114,7 @@ func (mdb *db) ListClusterMetadata(
 		err = mdb.conn.SelectContext(ctx,
 			&rows,
 			listClusterMetadataRangeQry,
-			constMetadataPartition,
+			filter.MetadataPartition,
 			filter.ClusterName,
 			filter.PageSize,
 		)
@@ -123,7 +122,7 @@ func (mdb *db) ListClusterMetadata(
 		err = mdb.conn.SelectContext(ctx,
 			&rows,
 			listClusterMetadataQry,
-			constMetadataPartition,
+			filter.MetadataPartition,
 			filter.PageSize,
 		)
 	}
@@ -138,7 +137,7 @@ func (mdb *db) GetClusterMetadata(
 	err := mdb.conn.GetContext(ctx,
 		&row,
 		getClusterMetadataQry,
-		constMetadataPartition,
+		filter.MetadataPartition,
 		filter.ClusterName,
 	)
 	if err != nil {
@@ -155,7 +154,7 @@ func (mdb *db) WriteLockGetClusterMetadata(
 	err := mdb.conn.GetContext(ctx,
 		&row,
 		writeLockGetClusterMetadataQry,
-		constMetadataPartition,
+		filter.MetadataPartition,
 		filter.ClusterName,
 	)
 	if err != nil {
@@ -171,7 +170,7 @@ func (mdb *db) DeleteClusterMetadata(
 
 	return mdb.conn.ExecContext(ctx,
 		deleteClusterMetadataQry,
-		constMetadataPartition,
+		filter.MetadataPartition,
 		filter.ClusterName,
 	)
 }
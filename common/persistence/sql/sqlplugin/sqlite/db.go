@@ -129,7 +129,7 @@ func (mdb *db) ExpectedVersion() string {
 	switch mdb.dbKind {
 	case sqlplugin.DbKindMain:
 		return sqliteschema.Version
-	case sqlplugin.DbKindVisibility:
+	case sqlplugin.DbKindVisibility, sqlplugin.DbKindAdvancedVisibility:
 		return sqliteschema.VisibilityVersion
 	default:
 		panic(fmt.Sprintf("unknown db kind %v", mdb.dbKind))
@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/shuffle"
+)
+
+type (
+	advancedVisibilitySuite struct {
+		suite.Suite
+		*require.Assertions
+
+		store sqlplugin.AdvancedVisibility
+	}
+)
+
+func newAdvancedVisibilitySuite(
+	t *testing.T,
+	store sqlplugin.AdvancedVisibility,
+) *advancedVisibilitySuite {
+	return &advancedVisibilitySuite{
+		Assertions: require.New(t),
+		store:      store,
+	}
+}
+
+func (s *advancedVisibilitySuite) SetupSuite() {
+
+}
+
+func (s *advancedVisibilitySuite) TearDownSuite() {
+
+}
+
+func (s *advancedVisibilitySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *advancedVisibilitySuite) TearDownTest() {
+
+}
+
+func (s *advancedVisibilitySuite) TestUpsertSelect() {
+	namespaceID := primitives.NewUUID()
+	startTime := s.now()
+	row := s.newRandomAdvancedVisibilityRow(namespaceID, startTime, nil, `{"CustomKeywordField":["a","b"],"CustomIntField":7}`)
+	_, err := s.store.UpsertIntoAdvancedVisibility(newVisibilityContext(), &row)
+	s.NoError(err)
+
+	closeTime := startTime.Add(time.Minute)
+	row.Status = 2
+	row.CloseTime = &closeTime
+	row.HistoryLength = convert.Int64Ptr(10)
+	_, err = s.store.UpsertIntoAdvancedVisibility(newVisibilityContext(), &row)
+	s.NoError(err)
+
+	rows, err := s.store.SelectFromAdvancedVisibility(newVisibilityContext(), sqlplugin.AdvancedVisibilitySelectFilter{
+		NamespaceID: namespaceID.String(),
+		QueryString: "workflow_id = ?",
+		QueryArgs:   []interface{}{row.WorkflowID},
+		PageSize:    10,
+	})
+	s.NoError(err)
+	s.Len(rows, 1)
+	s.Equal(row.RunID, rows[0].RunID)
+	s.Equal(int32(2), rows[0].Status)
+	s.Equal(closeTime, *rows[0].CloseTime)
+	s.Equal(int64(10), *rows[0].HistoryLength)
+	s.Equal(row.TaskQueue, rows[0].TaskQueue)
+	s.JSONEq(string(row.SearchAttributes), string(rows[0].SearchAttributes))
+}
+
+func (s *advancedVisibilitySuite) TestSelect_Pagination() {
+	namespaceID := primitives.NewUUID()
+	startTime := s.now()
+	closeTime := startTime.Add(time.Hour)
+
+	var runIDs []string
+	// Running workflows go first, then closed workflows ordered by close time.
+	for i := 0; i < 3; i++ {
+		row := s.newRandomAdvancedVisibilityRow(namespaceID, startTime.Add(time.Duration(-i)*time.Minute), nil, "")
+		_, err := s.store.UpsertIntoAdvancedVisibility(newVisibilityContext(), &row)
+		s.NoError(err)
+		runIDs = append(runIDs, row.RunID)
+	}
+	for i := 0; i < 3; i++ {
+		rowCloseTime := closeTime.Add(time.Duration(-i) * time.Minute)
+		row := s.newRandomAdvancedVisibilityRow(namespaceID, startTime, &rowCloseTime, "")
+		_, err := s.store.UpsertIntoAdvancedVisibility(newVisibilityContext(), &row)
+		s.NoError(err)
+		runIDs = append(runIDs, row.RunID)
+	}
+
+	filter := sqlplugin.AdvancedVisibilitySelectFilter{
+		NamespaceID: namespaceID.String(),
+		PageSize:    2,
+	}
+	var selectedRunIDs []string
+	for {
+		rows, err := s.store.SelectFromAdvancedVisibility(newVisibilityContext(), filter)
+		s.NoError(err)
+		for _, row := range rows {
+			selectedRunIDs = append(selectedRunIDs, row.RunID)
+		}
+		if len(rows) < filter.PageSize {
+			break
+		}
+		lastRow := rows[len(rows)-1]
+		filter.CloseTime = lastRow.CloseTime
+		filter.StartTime = &lastRow.StartTime
+		filter.RunID = &lastRow.RunID
+	}
+	s.Equal(runIDs, selectedRunIDs)
+
+	count, err := s.store.CountFromAdvancedVisibility(newVisibilityContext(), sqlplugin.AdvancedVisibilitySelectFilter{
+		NamespaceID: namespaceID.String(),
+		QueryString: "status = ?",
+		QueryArgs:   []interface{}{int32(1)},
+	})
+	s.NoError(err)
+	s.Equal(int64(3), count)
+}
+
+func (s *advancedVisibilitySuite) now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+func (s *advancedVisibilitySuite) newRandomAdvancedVisibilityRow(
+	namespaceID primitives.UUID,
+	startTime time.Time,
+	closeTime *time.Time,
+	searchAttributes string,
+) sqlplugin.VisibilityRow {
+	status := int32(1)
+	if closeTime != nil {
+		status = 2
+	}
+	var searchAttributesBytes []byte
+	if searchAttributes != "" {
+		searchAttributesBytes = []byte(searchAttributes)
+	}
+	return sqlplugin.VisibilityRow{
+		NamespaceID:          namespaceID.String(),
+		RunID:                primitives.NewUUID().String(),
+		WorkflowTypeName:     shuffle.String(testVisibilityWorkflowTypeName),
+		WorkflowID:           shuffle.String(testVisibilityWorkflowID),
+		StartTime:            startTime,
+		ExecutionTime:        startTime,
+		Status:               status,
+		CloseTime:            closeTime,
+		Memo:                 shuffle.Bytes(testVisibilityData),
+		Encoding:             testVisibilityEncoding,
+		TaskQueue:            "random task queue",
+		StateTransitionCount: convert.Int64Ptr(3),
+		SearchAttributes:     searchAttributesBytes,
+	}
+}
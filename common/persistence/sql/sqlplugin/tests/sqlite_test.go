@@ -415,6 +415,22 @@ func TestSQLiteVisibilitySuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestSQLiteAdvancedVisibilitySuite(t *testing.T) {
+	cfg := newSQLiteConfig()
+	setupSQLiteDatabase(cfg, t)
+	store, err := sql.NewSQLDB(sqlplugin.DbKindAdvancedVisibility, cfg, resolver.NewNoopResolver())
+	if err != nil {
+		t.Fatalf("unable to create SQLite DB: %v", err)
+	}
+	defer func() {
+		_ = store.Close()
+		tearDownSQLiteDatabase(cfg, t)
+	}()
+
+	s := newAdvancedVisibilitySuite(t, store)
+	suite.Run(t, s)
+}
+
 // newSQLiteConfig returns a new SQLite config for test
 func newSQLiteConfig() *config.SQL {
 	return &config.SQL{
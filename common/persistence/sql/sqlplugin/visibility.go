@@ -44,6 +44,12 @@ type (
 		HistoryLength    *int64
 		Memo             []byte
 		Encoding         string
+
+		// Fields below are used by advanced visibility only.
+		TaskQueue            string
+		StateTransitionCount *int64
+		// SearchAttributes is a JSON object of search attribute names to their values.
+		SearchAttributes []byte
 	}
 
 	// VisibilitySelectFilter contains the column names within executions_visibility table that
@@ -59,6 +65,23 @@ type (
 		PageSize         *int
 	}
 
+	// AdvancedVisibilitySelectFilter contains a filter built from a visibility query
+	// and the position of the previous page. Rows are returned running workflows first,
+	// then ordered by close time, start time (both descending) and run ID.
+	AdvancedVisibilitySelectFilter struct {
+		NamespaceID string
+		// QueryString is a boolean SQL expression in the dialect of the plugin which
+		// uses ? placeholders for QueryArgs. Empty QueryString matches all rows.
+		QueryString string
+		QueryArgs   []interface{}
+		// CloseTime, StartTime and RunID identify the last row of the previous page.
+		// CloseTime is nil if that row is a running workflow.
+		CloseTime *time.Time
+		StartTime *time.Time
+		RunID     *string
+		PageSize  int
+	}
+
 	VisibilityDeleteFilter struct {
 		NamespaceID string
 		RunID       string
@@ -81,4 +104,15 @@ type (
 		SelectFromVisibility(ctx context.Context, filter VisibilitySelectFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(ctx context.Context, filter VisibilityDeleteFilter) (sql.Result, error)
 	}
+
+	AdvancedVisibility interface {
+		// UpsertIntoAdvancedVisibility inserts a row into visibility table or replaces
+		// all fields of the existing row, including search attributes
+		UpsertIntoAdvancedVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// SelectFromAdvancedVisibility returns a page of rows matching the filter
+		SelectFromAdvancedVisibility(ctx context.Context, filter AdvancedVisibilitySelectFilter) ([]VisibilityRow, error)
+		// CountFromAdvancedVisibility returns the number of rows matching the filter,
+		// pagination fields of the filter are ignored
+		CountFromAdvancedVisibility(ctx context.Context, filter AdvancedVisibilitySelectFilter) (int64, error)
+	}
 )
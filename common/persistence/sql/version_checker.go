@@ -41,7 +41,12 @@ func VerifyCompatibleVersion(
 		return err
 	}
 	if cfg.StandardVisibilityConfigExist() {
-		return checkVisibilityDatabase(cfg, r)
+		if err := checkVisibilityDatabase(cfg, r); err != nil {
+			return err
+		}
+	}
+	if cfg.AdvancedVisibilityConfigExist() {
+		return checkAdvancedVisibilityDatabase(cfg, r)
 	}
 	return nil
 }
@@ -68,6 +73,17 @@ func checkVisibilityDatabase(
 	return nil
 }
 
+func checkAdvancedVisibilityDatabase(
+	cfg config.Persistence,
+	r resolver.ServiceResolver,
+) error {
+	ds, ok := cfg.DataStores[cfg.AdvancedVisibilityStore]
+	if ok && ds.SQL != nil {
+		return checkCompatibleVersion(ds.SQL, r, sqlplugin.DbKindAdvancedVisibility)
+	}
+	return nil
+}
+
 func checkCompatibleVersion(
 	cfg *config.SQL,
 	r resolver.ServiceResolver,
@@ -33,6 +33,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/store"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	sqladvanced "go.temporal.io/server/common/persistence/visibility/store/sql"
 	"go.temporal.io/server/common/persistence/visibility/store/standard"
 	"go.temporal.io/server/common/persistence/visibility/store/standard/cassandra"
 	"go.temporal.io/server/common/persistence/visibility/store/standard/sql"
@@ -75,17 +76,32 @@ func NewManager(
 		return nil, err
 	}
 
-	advVisibilityManager, err := NewAdvancedManager(
-		defaultIndexName,
-		esClient,
-		esProcessorConfig,
-		searchAttributesProvider,
-		searchAttributesMapper,
-		advancedVisibilityPersistenceMaxReadQPS,
-		advancedVisibilityPersistenceMaxWriteQPS,
-		metricsClient,
-		logger,
-	)
+	var advVisibilityManager manager.VisibilityManager
+	if persistenceCfg.AdvancedVisibilitySQLConfigExist() {
+		advVisibilityManager, err = NewSQLAdvancedManager(
+			persistenceCfg,
+			persistenceResolver,
+			defaultIndexName,
+			searchAttributesProvider,
+			searchAttributesMapper,
+			advancedVisibilityPersistenceMaxReadQPS,
+			advancedVisibilityPersistenceMaxWriteQPS,
+			metricsClient,
+			logger,
+		)
+	} else {
+		advVisibilityManager, err = NewAdvancedManager(
+			defaultIndexName,
+			esClient,
+			esProcessorConfig,
+			searchAttributesProvider,
+			searchAttributesMapper,
+			advancedVisibilityPersistenceMaxReadQPS,
+			advancedVisibilityPersistenceMaxWriteQPS,
+			metricsClient,
+			logger,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +231,48 @@ func NewAdvancedManager(
 	), nil
 }
 
+// NewSQLAdvancedManager creates advanced visibility manager backed by SQL database.
+// Index name is only used to get search attribute types and can be empty.
+func NewSQLAdvancedManager(
+	persistenceCfg config.Persistence,
+	persistenceResolver resolver.ServiceResolver,
+	defaultIndexName string,
+	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapper searchattribute.Mapper,
+
+	advancedVisibilityPersistenceMaxReadQPS dynamicconfig.IntPropertyFn,
+	advancedVisibilityPersistenceMaxWriteQPS dynamicconfig.IntPropertyFn,
+
+	metricsClient metrics.Client,
+	logger log.Logger,
+) (manager.VisibilityManager, error) {
+	if !persistenceCfg.AdvancedVisibilitySQLConfigExist() {
+		return nil, nil
+	}
+
+	advVisibilityStoreCfg := persistenceCfg.DataStores[persistenceCfg.AdvancedVisibilityStore]
+	advVisibilityStore, err := sqladvanced.NewSQLVisibilityStore(
+		*advVisibilityStoreCfg.SQL,
+		persistenceResolver,
+		defaultIndexName,
+		searchAttributesProvider,
+		searchAttributesMapper,
+		logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return newVisibilityManager(
+		advVisibilityStore,
+		advancedVisibilityPersistenceMaxReadQPS,
+		advancedVisibilityPersistenceMaxWriteQPS,
+		metricsClient,
+		metrics.AdvancedVisibilityTypeTag(),
+		logger,
+	), nil
+}
+
 func newVisibilityManager(
 	store store.VisibilityStore,
 	maxReadQPS dynamicconfig.IntPropertyFn,
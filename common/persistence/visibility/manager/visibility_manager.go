@@ -72,6 +72,7 @@ type (
 		Status               enumspb.WorkflowExecutionStatus
 		ExecutionTime        time.Time
 		StateTransitionCount int64
+		HistoryLength        int64
 		TaskID               int64 // not persisted, used as condition update version for ES
 		ShardID              int32 // not persisted
 		Memo                 *commonpb.Memo
@@ -87,8 +88,7 @@ type (
 	// RecordWorkflowExecutionClosedRequest is used to add a record of a closed execution
 	RecordWorkflowExecutionClosedRequest struct {
 		*VisibilityRequestBase
-		CloseTime     time.Time
-		HistoryLength int64
+		CloseTime time.Time
 	}
 
 	// UpsertWorkflowExecutionRequest is used to upsert workflow execution
@@ -473,9 +473,9 @@ func (s *VisibilityPersistenceSuite) TestFilteringByStatus() {
 			WorkflowTypeName: "visibility-workflow",
 			StartTime:        startTime,
 			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			HistoryLength:    3,
 		},
-		CloseTime:     time.Now(),
-		HistoryLength: 3,
+		CloseTime: time.Now(),
 	}
 	err3 := s.VisibilityMgr.RecordWorkflowExecutionClosed(closeReq)
 	s.Nil(err3)
@@ -531,9 +531,9 @@ func (s *VisibilityPersistenceSuite) TestDelete() {
 				WorkflowTypeName: "visibility-workflow",
 				StartTime:        startTime,
 				Status:           enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+				HistoryLength:    3,
 			},
-			CloseTime:     closeTime,
-			HistoryLength: 3,
+			CloseTime: closeTime,
 		}
 		err1 := s.VisibilityMgr.RecordWorkflowExecutionClosed(closeReq)
 		s.Nil(err1)
@@ -687,9 +687,9 @@ func (s *VisibilityPersistenceSuite) createClosedWorkflowRecord(
 			Execution:        startReq.Execution,
 			WorkflowTypeName: startReq.WorkflowTypeName,
 			StartTime:        startReq.StartTime,
+			HistoryLength:    5,
 		},
-		CloseTime:     closeTime,
-		HistoryLength: 5,
+		CloseTime: closeTime,
 	}
 	err := s.VisibilityMgr.RecordWorkflowExecutionClosed(closeReq)
 	s.Nil(err)
@@ -79,6 +79,30 @@ type (
 		IndexGetSettings(ctx context.Context, indexName string) (map[string]*elastic.IndicesGetSettingsResponse, error)
 	}
 
+	// SchemaClient is used by temporal-elasticsearch-tool to manage index templates, indices and aliases.
+	SchemaClient interface {
+		IntegrationTestsClient
+		Ping(ctx context.Context) (*ServerInfo, error)
+		ClusterPutSettings(ctx context.Context, bodyString string) (bool, error)
+		IndexGetMapping(ctx context.Context, indexName string) (map[string]interface{}, error)
+		IndexPutMapping(ctx context.Context, indexName string, bodyString string) (bool, error)
+		Reindex(ctx context.Context, sourceIndex string, destIndex string, script *elastic.Script) (string, error)
+		GetTask(ctx context.Context, taskID string) (*elastic.TasksGetTaskResponse, error)
+		GetAliasIndices(ctx context.Context, aliasName string) ([]string, error)
+		SwapAlias(ctx context.Context, aliasName string, oldIndices []string, newIndex string) (bool, error)
+	}
+
+	// ServerInfo is returned by the root endpoint of Elasticsearch and OpenSearch servers.
+	ServerInfo struct {
+		Name        string `json:"name"`
+		ClusterName string `json:"cluster_name"`
+		Version     struct {
+			Number string `json:"number"`
+			// Distribution is only set by OpenSearch, to "opensearch".
+			Distribution string `json:"distribution"`
+		} `json:"version"`
+	}
+
 	// SearchParameters holds all required and optional parameters for executing a search.
 	SearchParameters struct {
 		Index    string
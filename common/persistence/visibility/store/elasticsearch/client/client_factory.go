@@ -63,3 +63,12 @@ func NewIntegrationTestsClient(config *Config, logger log.Logger) (IntegrationTe
 		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
 	}
 }
+
+func NewSchemaClient(config *Config, logger log.Logger) (SchemaClient, error) {
+	switch config.Version {
+	case "v7", "":
+		return newClientV7(config, nil, logger)
+	default:
+		return nil, fmt.Errorf("not supported Elasticsearch version: %v", config.Version)
+	}
+}
@@ -678,3 +678,236 @@ func (mr *MockIntegrationTestsClientMockRecorder) IndexPutTemplate(ctx, template
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexPutTemplate", reflect.TypeOf((*MockIntegrationTestsClient)(nil).IndexPutTemplate), ctx, templateName, bodyString)
 }
+
+// MockSchemaClient is a mock of SchemaClient interface.
+type MockSchemaClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchemaClientMockRecorder
+}
+
+// MockSchemaClientMockRecorder is the mock recorder for MockSchemaClient.
+type MockSchemaClientMockRecorder struct {
+	mock *MockSchemaClient
+}
+
+// NewMockSchemaClient creates a new mock instance.
+func NewMockSchemaClient(ctrl *gomock.Controller) *MockSchemaClient {
+	mock := &MockSchemaClient{ctrl: ctrl}
+	mock.recorder = &MockSchemaClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchemaClient) EXPECT() *MockSchemaClientMockRecorder {
+	return m.recorder
+}
+
+// ClusterPutSettings mocks base method.
+func (m *MockSchemaClient) ClusterPutSettings(ctx context.Context, bodyString string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterPutSettings", ctx, bodyString)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterPutSettings indicates an expected call of ClusterPutSettings.
+func (mr *MockSchemaClientMockRecorder) ClusterPutSettings(ctx, bodyString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterPutSettings", reflect.TypeOf((*MockSchemaClient)(nil).ClusterPutSettings), ctx, bodyString)
+}
+
+// CreateIndex mocks base method.
+func (m *MockSchemaClient) CreateIndex(ctx context.Context, index string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIndex", ctx, index)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIndex indicates an expected call of CreateIndex.
+func (mr *MockSchemaClientMockRecorder) CreateIndex(ctx, index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIndex", reflect.TypeOf((*MockSchemaClient)(nil).CreateIndex), ctx, index)
+}
+
+// DeleteIndex mocks base method.
+func (m *MockSchemaClient) DeleteIndex(ctx context.Context, indexName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIndex", ctx, indexName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIndex indicates an expected call of DeleteIndex.
+func (mr *MockSchemaClientMockRecorder) DeleteIndex(ctx, indexName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIndex", reflect.TypeOf((*MockSchemaClient)(nil).DeleteIndex), ctx, indexName)
+}
+
+// GetAliasIndices mocks base method.
+func (m *MockSchemaClient) GetAliasIndices(ctx context.Context, aliasName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAliasIndices", ctx, aliasName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAliasIndices indicates an expected call of GetAliasIndices.
+func (mr *MockSchemaClientMockRecorder) GetAliasIndices(ctx, aliasName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAliasIndices", reflect.TypeOf((*MockSchemaClient)(nil).GetAliasIndices), ctx, aliasName)
+}
+
+// GetTask mocks base method.
+func (m *MockSchemaClient) GetTask(ctx context.Context, taskID string) (*v7.TasksGetTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTask", ctx, taskID)
+	ret0, _ := ret[0].(*v7.TasksGetTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTask indicates an expected call of GetTask.
+func (mr *MockSchemaClientMockRecorder) GetTask(ctx, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockSchemaClient)(nil).GetTask), ctx, taskID)
+}
+
+// IndexExists mocks base method.
+func (m *MockSchemaClient) IndexExists(ctx context.Context, indexName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexExists", ctx, indexName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexExists indicates an expected call of IndexExists.
+func (mr *MockSchemaClientMockRecorder) IndexExists(ctx, indexName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexExists", reflect.TypeOf((*MockSchemaClient)(nil).IndexExists), ctx, indexName)
+}
+
+// IndexGetMapping mocks base method.
+func (m *MockSchemaClient) IndexGetMapping(ctx context.Context, indexName string) (map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexGetMapping", ctx, indexName)
+	ret0, _ := ret[0].(map[string]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexGetMapping indicates an expected call of IndexGetMapping.
+func (mr *MockSchemaClientMockRecorder) IndexGetMapping(ctx, indexName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexGetMapping", reflect.TypeOf((*MockSchemaClient)(nil).IndexGetMapping), ctx, indexName)
+}
+
+// IndexGetSettings mocks base method.
+func (m *MockSchemaClient) IndexGetSettings(ctx context.Context, indexName string) (map[string]*v7.IndicesGetSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexGetSettings", ctx, indexName)
+	ret0, _ := ret[0].(map[string]*v7.IndicesGetSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexGetSettings indicates an expected call of IndexGetSettings.
+func (mr *MockSchemaClientMockRecorder) IndexGetSettings(ctx, indexName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexGetSettings", reflect.TypeOf((*MockSchemaClient)(nil).IndexGetSettings), ctx, indexName)
+}
+
+// IndexPutMapping mocks base method.
+func (m *MockSchemaClient) IndexPutMapping(ctx context.Context, indexName, bodyString string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexPutMapping", ctx, indexName, bodyString)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexPutMapping indicates an expected call of IndexPutMapping.
+func (mr *MockSchemaClientMockRecorder) IndexPutMapping(ctx, indexName, bodyString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexPutMapping", reflect.TypeOf((*MockSchemaClient)(nil).IndexPutMapping), ctx, indexName, bodyString)
+}
+
+// IndexPutSettings mocks base method.
+func (m *MockSchemaClient) IndexPutSettings(ctx context.Context, indexName, bodyString string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexPutSettings", ctx, indexName, bodyString)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexPutSettings indicates an expected call of IndexPutSettings.
+func (mr *MockSchemaClientMockRecorder) IndexPutSettings(ctx, indexName, bodyString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexPutSettings", reflect.TypeOf((*MockSchemaClient)(nil).IndexPutSettings), ctx, indexName, bodyString)
+}
+
+// IndexPutTemplate mocks base method.
+func (m *MockSchemaClient) IndexPutTemplate(ctx context.Context, templateName, bodyString string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexPutTemplate", ctx, templateName, bodyString)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexPutTemplate indicates an expected call of IndexPutTemplate.
+func (mr *MockSchemaClientMockRecorder) IndexPutTemplate(ctx, templateName, bodyString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexPutTemplate", reflect.TypeOf((*MockSchemaClient)(nil).IndexPutTemplate), ctx, templateName, bodyString)
+}
+
+// Ping mocks base method.
+func (m *MockSchemaClient) Ping(ctx context.Context) (*ServerInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(*ServerInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockSchemaClientMockRecorder) Ping(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockSchemaClient)(nil).Ping), ctx)
+}
+
+// Reindex mocks base method.
+func (m *MockSchemaClient) Reindex(ctx context.Context, sourceIndex, destIndex string, script *v7.Script) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reindex", ctx, sourceIndex, destIndex, script)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reindex indicates an expected call of Reindex.
+func (mr *MockSchemaClientMockRecorder) Reindex(ctx, sourceIndex, destIndex, script interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reindex", reflect.TypeOf((*MockSchemaClient)(nil).Reindex), ctx, sourceIndex, destIndex, script)
+}
+
+// SwapAlias mocks base method.
+func (m *MockSchemaClient) SwapAlias(ctx context.Context, aliasName string, oldIndices []string, newIndex string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapAlias", ctx, aliasName, oldIndices, newIndex)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SwapAlias indicates an expected call of SwapAlias.
+func (mr *MockSchemaClientMockRecorder) SwapAlias(ctx, aliasName, oldIndices, newIndex interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapAlias", reflect.TypeOf((*MockSchemaClient)(nil).SwapAlias), ctx, aliasName, oldIndices, newIndex)
+}
@@ -26,6 +26,8 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
@@ -59,6 +61,7 @@ var (
 )
 
 var _ ClientV7 = (*clientV7)(nil)
+var _ SchemaClient = (*clientV7)(nil)
 
 // newClientV7 create a ES client
 func newClientV7(cfg *Config, httpClient *http.Client, logger log.Logger) (*clientV7, error) {
@@ -308,6 +311,109 @@ func (c *clientV7) IndexGetSettings(ctx context.Context, indexName string) (map[
 	return c.esClient.IndexGetSettings(indexName).Do(ctx)
 }
 
+func (c *clientV7) Ping(ctx context.Context) (*ServerInfo, error) {
+	// PingService doesn't decode version.distribution which is needed to tell OpenSearch from Elasticsearch.
+	resp, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: http.MethodGet,
+		Path:   "/",
+	})
+	if err != nil {
+		return nil, err
+	}
+	var info ServerInfo
+	if err := json.Unmarshal(resp.Body, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (c *clientV7) ClusterPutSettings(ctx context.Context, bodyString string) (bool, error) {
+	resp, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: http.MethodPut,
+		Path:   "/_cluster/settings",
+		Body:   bodyString,
+	})
+	if err != nil {
+		return false, err
+	}
+	var ack elastic.AcknowledgedResponse
+	if err := json.Unmarshal(resp.Body, &ack); err != nil {
+		return false, err
+	}
+	return ack.Acknowledged, nil
+}
+
+func (c *clientV7) IndexGetMapping(ctx context.Context, indexName string) (map[string]interface{}, error) {
+	resp, err := c.esClient.GetMapping().Index(indexName).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Response is keyed by the concrete index name which differs from indexName if it is an alias.
+	if len(resp) != 1 {
+		return nil, fmt.Errorf("expected mapping of one index, got %d", len(resp))
+	}
+	for _, indexMapping := range resp {
+		if indexMappingMap, ok := indexMapping.(map[string]interface{}); ok {
+			if mappings, ok := indexMappingMap["mappings"].(map[string]interface{}); ok {
+				return mappings, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("unexpected mapping format for index %s", indexName)
+}
+
+func (c *clientV7) IndexPutMapping(ctx context.Context, indexName string, bodyString string) (bool, error) {
+	resp, err := c.esClient.PutMapping().Index(indexName).BodyString(bodyString).Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
+func (c *clientV7) Reindex(ctx context.Context, sourceIndex string, destIndex string, script *elastic.Script) (string, error) {
+	reindexService := c.esClient.Reindex().
+		SourceIndex(sourceIndex).
+		Destination(elastic.NewReindexDestination().Index(destIndex).VersionType(versionTypeExternal)).
+		ProceedOnVersionConflict().
+		Slices("auto")
+	if script != nil {
+		reindexService = reindexService.Script(script)
+	}
+	resp, err := reindexService.DoAsync(ctx)
+	if err != nil {
+		return "", err
+	}
+	return resp.TaskId, nil
+}
+
+func (c *clientV7) GetTask(ctx context.Context, taskID string) (*elastic.TasksGetTaskResponse, error) {
+	return c.esClient.TasksGetTask().TaskId(taskID).Do(ctx)
+}
+
+func (c *clientV7) GetAliasIndices(ctx context.Context, aliasName string) ([]string, error) {
+	resp, err := c.esClient.Aliases().Alias(aliasName).Do(ctx)
+	if err != nil {
+		if elastic.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return resp.IndicesByAlias(aliasName), nil
+}
+
+func (c *clientV7) SwapAlias(ctx context.Context, aliasName string, oldIndices []string, newIndex string) (bool, error) {
+	// All actions are applied atomically.
+	aliasService := c.esClient.Alias()
+	for _, oldIndex := range oldIndices {
+		aliasService = aliasService.Remove(oldIndex, aliasName)
+	}
+	resp, err := aliasService.Add(newIndex, aliasName).Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
 func (c *clientV7) Delete(ctx context.Context, indexName string, docID string, version int64) error {
 	_, err := c.esClient.Delete().
 		Index(indexName).
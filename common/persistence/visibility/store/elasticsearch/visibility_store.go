@@ -153,7 +153,6 @@ func (s *visibilityStore) RecordWorkflowExecutionClosed(request *store.InternalR
 
 	doc[searchattribute.CloseTime] = request.CloseTime
 	doc[searchattribute.ExecutionDuration] = request.CloseTime.Sub(request.ExecutionTime).Nanoseconds()
-	doc[searchattribute.HistoryLength] = request.HistoryLength
 	doc[searchattribute.StateTransitionCount] = request.StateTransitionCount
 
 	return s.addBulkIndexRequestAndWait(request.InternalVisibilityRequestBase, doc, visibilityTaskKey)
@@ -762,6 +761,10 @@ func (s *visibilityStore) generateESDoc(request *store.InternalVisibilityRequest
 		searchattribute.TaskQueue:         request.TaskQueue,
 	}
 
+	if request.HistoryLength > 0 {
+		doc[searchattribute.HistoryLength] = request.HistoryLength
+	}
+
 	if len(request.Memo.GetData()) > 0 {
 		doc[searchattribute.Memo] = request.Memo.GetData()
 		doc[searchattribute.MemoEncoding] = request.Memo.GetEncodingType().String()
@@ -153,9 +153,9 @@ func (s *ESVisibilitySuite) TestRecordWorkflowExecutionClosed() {
 					"CustomTextField": payload.EncodeString("alex"),
 				},
 			},
+			HistoryLength: int64(20),
 		},
-		CloseTime:     time.Unix(0, 1978).UTC(),
-		HistoryLength: int64(20),
+		CloseTime: time.Unix(0, 1978).UTC(),
 	}
 
 	s.mockProcessor.EXPECT().Add(gomock.Any(), gomock.Any()).
@@ -0,0 +1,129 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+)
+
+// sqlitePluginName is the name of SQLite plugin. Plugin package itself requires cgo and can't be imported here.
+const sqlitePluginName = "sqlite"
+
+type (
+	// mysqlDialect relies on JSON functions of MySQL 8.0.17+.
+	mysqlDialect struct{}
+	// postgresqlDialect relies on JSONB operators of PostgreSQL 12+.
+	postgresqlDialect struct{}
+	// sqliteDialect relies on JSON1 extension of SQLite. Bundled SQLite has it only when built with "sqlite_json" tag.
+	sqliteDialect struct{}
+)
+
+var _ sqlDialect = (*mysqlDialect)(nil)
+var _ sqlDialect = (*postgresqlDialect)(nil)
+var _ sqlDialect = (*sqliteDialect)(nil)
+
+func newSQLDialect(pluginName string) (sqlDialect, error) {
+	switch pluginName {
+	case mysql.PluginName:
+		return &mysqlDialect{}, nil
+	case postgresql.PluginName:
+		return &postgresqlDialect{}, nil
+	case sqlitePluginName:
+		return &sqliteDialect{}, nil
+	default:
+		return nil, fmt.Errorf("advanced visibility is not supported by %q SQL plugin", pluginName)
+	}
+}
+
+func (d *mysqlDialect) jsonValue(name string, saType enumspb.IndexedValueType) string {
+	// JSON values don't support BETWEEN and IN, so numbers are cast to SQL types.
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		return fmt.Sprintf("CAST(search_attributes->>'$.%s' AS SIGNED)", name)
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		return fmt.Sprintf("CAST(search_attributes->>'$.%s' AS DOUBLE)", name)
+	default:
+		return fmt.Sprintf("search_attributes->>'$.%s'", name)
+	}
+}
+
+func (d *mysqlDialect) jsonContains(name string) string {
+	// Expression must match multi-valued indexes on predefined search attributes.
+	return fmt.Sprintf("JSON_CONTAINS(search_attributes->'$.%s', JSON_QUOTE(?))", name)
+}
+
+func (d *mysqlDialect) boolValue(v bool) interface{} {
+	// Unquoted JSON booleans are "true" and "false" strings.
+	if v {
+		return "true"
+	}
+	return "false"
+}
+
+func (d *postgresqlDialect) jsonValue(name string, saType enumspb.IndexedValueType) string {
+	switch saType {
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		return fmt.Sprintf("(search_attributes->>'%s')::bigint", name)
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		return fmt.Sprintf("(search_attributes->>'%s')::double precision", name)
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		return fmt.Sprintf("(search_attributes->>'%s')::boolean", name)
+	default:
+		return fmt.Sprintf("search_attributes->>'%s'", name)
+	}
+}
+
+func (d *postgresqlDialect) jsonContains(name string) string {
+	// Scalar on the right side of @> is contained by an array or equal scalar on the left side.
+	// Expression must match GIN indexes on predefined search attributes.
+	return fmt.Sprintf("(search_attributes->'%s') @> to_jsonb(?::text)", name)
+}
+
+func (d *postgresqlDialect) boolValue(v bool) interface{} {
+	return v
+}
+
+func (d *sqliteDialect) jsonValue(name string, _ enumspb.IndexedValueType) string {
+	// json_extract returns values of native SQL types.
+	return fmt.Sprintf("json_extract(search_attributes, '$.%s')", name)
+}
+
+func (d *sqliteDialect) jsonContains(name string) string {
+	// json_each iterates over array elements or returns scalar value as a single row.
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(search_attributes, '$.%s') WHERE json_each.value = ?)", name)
+}
+
+func (d *sqliteDialect) boolValue(v bool) interface{} {
+	// json_extract returns JSON booleans as 1 and 0.
+	if v {
+		return int64(1)
+	}
+	return int64(0)
+}
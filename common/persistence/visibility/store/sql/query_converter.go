@@ -0,0 +1,409 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+// jsonDatetimeLayout is used to store datetime search attributes in JSON.
+// Fixed number of fractional digits makes string comparison match time comparison.
+const jsonDatetimeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+var (
+	// systemColumns maps system search attributes to the columns of executions_visibility table.
+	// All other search attributes are stored in search_attributes JSON column.
+	systemColumns = map[string]string{
+		searchattribute.WorkflowID:           "workflow_id",
+		searchattribute.RunID:                "run_id",
+		searchattribute.WorkflowType:         "workflow_type_name",
+		searchattribute.StartTime:            "start_time",
+		searchattribute.ExecutionTime:        "execution_time",
+		searchattribute.CloseTime:            "close_time",
+		searchattribute.ExecutionStatus:      "status",
+		searchattribute.TaskQueue:            "task_queue",
+		searchattribute.HistoryLength:        "history_length",
+		searchattribute.StateTransitionCount: "state_transition_count",
+	}
+
+	// Search attribute names are embedded into JSON path expressions and must be safe to use there.
+	jsonFieldNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+type (
+	// sqlDialect builds vendor specific expressions over search attributes stored in JSON column.
+	sqlDialect interface {
+		// jsonValue returns an expression which extracts scalar value of the search attribute.
+		jsonValue(name string, saType enumspb.IndexedValueType) string
+		// jsonContains returns an expression with one placeholder which is true if the keyword
+		// search attribute is equal to the placeholder value or, if it is a list, contains it.
+		jsonContains(name string) string
+		// boolValue converts bool to the value comparable with jsonValue result.
+		boolValue(v bool) interface{}
+	}
+
+	queryConverter struct {
+		dialect                sqlDialect
+		namespace              namespace.Name
+		searchAttributesTypes  searchattribute.NameTypeMap
+		searchAttributesMapper searchattribute.Mapper
+
+		args []interface{}
+	}
+
+	queryColumn struct {
+		name   string
+		saType enumspb.IndexedValueType
+		// expr is either a column name or JSON value extraction expression.
+		expr   string
+		isJSON bool
+	}
+)
+
+func newQueryConverter(
+	dialect sqlDialect,
+	namespace namespace.Name,
+	saTypeMap searchattribute.NameTypeMap,
+	saMapper searchattribute.Mapper,
+) *queryConverter {
+	return &queryConverter{
+		dialect:                dialect,
+		namespace:              namespace,
+		searchAttributesTypes:  saTypeMap,
+		searchAttributesMapper: saMapper,
+	}
+}
+
+// convertWhere transforms visibility query to SQL boolean expression with ? placeholders and its arguments.
+// Empty query is converted to empty expression. ORDER BY clause is not supported.
+func (c *queryConverter) convertWhere(queryStr string) (string, []interface{}, error) {
+	queryStr = strings.TrimSpace(queryStr)
+	if queryStr == "" {
+		return "", nil, nil
+	}
+
+	if strings.HasPrefix(strings.ToLower(queryStr), "order by ") {
+		return "", nil, query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage)
+	}
+	// sqlparser can't parse just WHERE clause but instead accepts only valid SQL statement.
+	stmt, err := sqlparser.Parse(fmt.Sprintf("select * from table1 where %s", queryStr))
+	if err != nil {
+		return "", nil, query.NewConverterError("%s: %v", query.MalformedSqlQueryErrMessage, err)
+	}
+
+	sel, isSelect := stmt.(*sqlparser.Select)
+	if !isSelect {
+		return "", nil, query.NewConverterError("%s: statement must be 'select' not %T", query.NotSupportedErrMessage, stmt)
+	}
+	if sel.OrderBy != nil {
+		return "", nil, query.NewConverterError("%s: 'order by' clause", query.NotSupportedErrMessage)
+	}
+	if sel.GroupBy != nil {
+		return "", nil, query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage)
+	}
+	if sel.Limit != nil {
+		return "", nil, query.NewConverterError("%s: 'limit' clause", query.NotSupportedErrMessage)
+	}
+
+	c.args = nil
+	where, err := c.convertExpr(sel.Where.Expr)
+	if err != nil {
+		return "", nil, err
+	}
+	return where, c.args, nil
+}
+
+func (c *queryConverter) convertExpr(expr sqlparser.Expr) (string, error) {
+	switch e := expr.(type) {
+	case *sqlparser.AndExpr:
+		return c.convertBinary(e.Left, e.Right, "AND")
+	case *sqlparser.OrExpr:
+		return c.convertBinary(e.Left, e.Right, "OR")
+	case *sqlparser.ParenExpr:
+		return c.convertExpr(e.Expr)
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparison(e)
+	case *sqlparser.RangeCond:
+		return c.convertRange(e)
+	case *sqlparser.IsExpr:
+		return c.convertIs(e)
+	case *sqlparser.NotExpr:
+		return "", query.NewConverterError("%s: 'not' expression", query.NotSupportedErrMessage)
+	case *sqlparser.FuncExpr:
+		return "", query.NewConverterError("%s: function expression", query.NotSupportedErrMessage)
+	case *sqlparser.ColName:
+		return "", query.NewConverterError("incomplete expression")
+	default:
+		return "", query.NewConverterError("%s: expression of type %T", query.NotSupportedErrMessage, expr)
+	}
+}
+
+func (c *queryConverter) convertBinary(left sqlparser.Expr, right sqlparser.Expr, operator string) (string, error) {
+	leftStr, err := c.convertExpr(left)
+	if err != nil {
+		return "", err
+	}
+	rightStr, err := c.convertExpr(right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s %s)", leftStr, operator, rightStr), nil
+}
+
+func (c *queryConverter) convertComparison(expr *sqlparser.ComparisonExpr) (string, error) {
+	col, err := c.convertColName(expr.Left)
+	if err != nil {
+		return "", err
+	}
+
+	var values []interface{}
+	switch operator := expr.Operator; operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, isTuple := expr.Right.(sqlparser.ValTuple)
+		if !isTuple {
+			return "", query.NewConverterError("%s: '%s' operator requires a list of values", query.InvalidExpressionErrMessage, operator)
+		}
+		for _, valueExpr := range tuple {
+			value, err := c.convertValue(col, valueExpr)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, value)
+		}
+	case sqlparser.EqualStr, sqlparser.NotEqualStr,
+		sqlparser.LessThanStr, sqlparser.LessEqualStr,
+		sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr,
+		sqlparser.LikeStr, sqlparser.NotLikeStr:
+		value, err := c.convertValue(col, expr.Right)
+		if err != nil {
+			return "", err
+		}
+		values = append(values, value)
+	default:
+		return "", query.NewConverterError("operator '%v' not allowed in comparison expression", operator)
+	}
+
+	if expr.Operator == sqlparser.LikeStr || expr.Operator == sqlparser.NotLikeStr {
+		if (col.saType != enumspb.INDEXED_VALUE_TYPE_KEYWORD && col.saType != enumspb.INDEXED_VALUE_TYPE_TEXT) || col.name == searchattribute.ExecutionStatus {
+			return "", query.NewConverterError("%s: 'like' operator can't be used with %s", query.InvalidExpressionErrMessage, col.name)
+		}
+	}
+
+	// Keyword search attributes might be lists, equality means that list contains the value.
+	if col.isJSON && col.saType == enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+		switch expr.Operator {
+		case sqlparser.EqualStr, sqlparser.InStr, sqlparser.NotEqualStr, sqlparser.NotInStr:
+			conditions := make([]string, len(values))
+			for i, value := range values {
+				conditions[i] = c.dialect.jsonContains(col.name)
+				c.args = append(c.args, value)
+			}
+			result := strings.Join(conditions, " OR ")
+			if expr.Operator == sqlparser.NotEqualStr || expr.Operator == sqlparser.NotInStr {
+				return fmt.Sprintf("NOT (%s)", result), nil
+			}
+			return fmt.Sprintf("(%s)", result), nil
+		}
+	}
+
+	c.args = append(c.args, values...)
+	switch expr.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", ")
+		return fmt.Sprintf("%s %s (%s)", col.expr, strings.ToUpper(expr.Operator), placeholders), nil
+	case sqlparser.NotEqualStr:
+		return fmt.Sprintf("%s <> ?", col.expr), nil
+	default:
+		return fmt.Sprintf("%s %s ?", col.expr, strings.ToUpper(expr.Operator)), nil
+	}
+}
+
+func (c *queryConverter) convertRange(expr *sqlparser.RangeCond) (string, error) {
+	col, err := c.convertColName(expr.Left)
+	if err != nil {
+		return "", err
+	}
+	if expr.Operator != sqlparser.BetweenStr && expr.Operator != sqlparser.NotBetweenStr {
+		return "", query.NewConverterError("%s: range condition operator must be 'between' or 'not between'", query.InvalidExpressionErrMessage)
+	}
+
+	from, err := c.convertValue(col, expr.From)
+	if err != nil {
+		return "", err
+	}
+	to, err := c.convertValue(col, expr.To)
+	if err != nil {
+		return "", err
+	}
+	c.args = append(c.args, from, to)
+	return fmt.Sprintf("%s %s ? AND ?", col.expr, strings.ToUpper(expr.Operator)), nil
+}
+
+func (c *queryConverter) convertIs(expr *sqlparser.IsExpr) (string, error) {
+	col, err := c.convertColName(expr.Expr)
+	if err != nil {
+		return "", err
+	}
+	switch expr.Operator {
+	case sqlparser.IsNullStr, sqlparser.IsNotNullStr:
+		return fmt.Sprintf("%s %s", col.expr, strings.ToUpper(expr.Operator)), nil
+	default:
+		return "", query.NewConverterError("%s: 'is' operator can be used with 'null' and 'not null' only", query.InvalidExpressionErrMessage)
+	}
+}
+
+func (c *queryConverter) convertColName(expr sqlparser.Expr) (*queryColumn, error) {
+	colName, isColName := expr.(*sqlparser.ColName)
+	if !isColName {
+		return nil, query.NewConverterError("%s: must be a column name but was %T", query.InvalidExpressionErrMessage, expr)
+	}
+	name := strings.ReplaceAll(sqlparser.String(colName), "`", "")
+
+	fieldName := name
+	if searchattribute.IsMappable(name) && c.searchAttributesMapper != nil {
+		var err error
+		fieldName, err = c.searchAttributesMapper.GetFieldName(name, c.namespace.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	saType, err := c.searchAttributesTypes.GetType(fieldName)
+	if err != nil {
+		return nil, query.NewConverterError("invalid search attribute: %s", name)
+	}
+
+	if column, isSystem := systemColumns[fieldName]; isSystem {
+		return &queryColumn{name: fieldName, saType: saType, expr: column}, nil
+	}
+	if fieldName == searchattribute.ExecutionDuration {
+		return nil, query.NewConverterError("%s: filter by %s", query.NotSupportedErrMessage, name)
+	}
+	if !jsonFieldNameRegexp.MatchString(fieldName) {
+		return nil, query.NewConverterError("%s: filter by search attribute with name %q", query.NotSupportedErrMessage, name)
+	}
+	return &queryColumn{
+		name:   fieldName,
+		saType: saType,
+		expr:   c.dialect.jsonValue(fieldName, saType),
+		isJSON: true,
+	}, nil
+}
+
+func (c *queryConverter) convertValue(col *queryColumn, expr sqlparser.Expr) (interface{}, error) {
+	value, err := parseSqlValue(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	invalidValueErr := query.NewConverterError("%s: invalid value %v for search attribute %s of type %s", query.InvalidExpressionErrMessage, value, col.name, col.saType)
+	switch col.saType {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT:
+		if col.name == searchattribute.ExecutionStatus {
+			switch v := value.(type) {
+			case string:
+				status, ok := enumspb.WorkflowExecutionStatus_value[v]
+				if !ok {
+					return nil, invalidValueErr
+				}
+				return status, nil
+			case int64:
+				return int32(v), nil
+			}
+			return nil, invalidValueErr
+		}
+		if v, isString := value.(string); isString {
+			return v, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		if v, isInt := value.(int64); isInt {
+			return v, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		switch v := value.(type) {
+		case int64:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		if v, isBool := value.(bool); isBool {
+			return c.dialect.boolValue(v), nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		var t time.Time
+		switch v := value.(type) {
+		case int64:
+			t = time.Unix(0, v).UTC()
+		case string:
+			if t, err = time.Parse(time.RFC3339Nano, v); err != nil {
+				return nil, query.NewConverterError("%s: invalid %s format: %v", query.InvalidExpressionErrMessage, col.name, v)
+			}
+		default:
+			return nil, invalidValueErr
+		}
+		if col.isJSON {
+			return t.UTC().Format(jsonDatetimeLayout), nil
+		}
+		return t, nil
+	}
+	return nil, invalidValueErr
+}
+
+func parseSqlValue(expr sqlparser.Expr) (interface{}, error) {
+	switch e := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch e.Type {
+		case sqlparser.StrVal:
+			return string(e.Val), nil
+		case sqlparser.IntVal:
+			if v, err := strconv.ParseInt(string(e.Val), 10, 64); err == nil {
+				return v, nil
+			}
+		case sqlparser.FloatVal:
+			if v, err := strconv.ParseFloat(string(e.Val), 64); err == nil {
+				return v, nil
+			}
+		}
+		return nil, query.NewConverterError("%s: unable to parse %s", query.InvalidExpressionErrMessage, sqlparser.String(e))
+	case sqlparser.BoolVal:
+		return bool(e), nil
+	case *sqlparser.ColName:
+		return nil, query.NewConverterError("%s: column name on the right side of comparison expression", query.NotSupportedErrMessage)
+	default:
+		return nil, query.NewConverterError("%s: unexpected value type %T", query.InvalidExpressionErrMessage, expr)
+	}
+}
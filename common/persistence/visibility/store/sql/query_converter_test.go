@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+func TestQueryConverter_Supported(t *testing.T) {
+	datetime := time.Date(2021, 10, 5, 13, 14, 15, 0, time.UTC)
+	cases := []struct {
+		query        string
+		mysql        string
+		postgresql   string
+		sqlite       string
+		expectedArgs []interface{}
+	}{
+		{
+			query:        "",
+			mysql:        "",
+			postgresql:   "",
+			sqlite:       "",
+			expectedArgs: nil,
+		},
+		{
+			query:        "WorkflowId = 'wid'",
+			mysql:        "workflow_id = ?",
+			postgresql:   "workflow_id = ?",
+			sqlite:       "workflow_id = ?",
+			expectedArgs: []interface{}{"wid"},
+		},
+		{
+			query:        "ExecutionStatus = 'Running' and WorkflowType like 'type%'",
+			mysql:        "(status = ? AND workflow_type_name LIKE ?)",
+			postgresql:   "(status = ? AND workflow_type_name LIKE ?)",
+			sqlite:       "(status = ? AND workflow_type_name LIKE ?)",
+			expectedArgs: []interface{}{int32(1), "type%"},
+		},
+		{
+			query:        "StartTime >= '2021-10-05T13:14:15Z'",
+			mysql:        "start_time >= ?",
+			postgresql:   "start_time >= ?",
+			sqlite:       "start_time >= ?",
+			expectedArgs: []interface{}{datetime},
+		},
+		{
+			query:        "CustomIntField between 1 and 5 or CustomDoubleField < 2",
+			mysql:        "(CAST(search_attributes->>'$.CustomIntField' AS SIGNED) BETWEEN ? AND ? OR CAST(search_attributes->>'$.CustomDoubleField' AS DOUBLE) < ?)",
+			postgresql:   "((search_attributes->>'CustomIntField')::bigint BETWEEN ? AND ? OR (search_attributes->>'CustomDoubleField')::double precision < ?)",
+			sqlite:       "(json_extract(search_attributes, '$.CustomIntField') BETWEEN ? AND ? OR json_extract(search_attributes, '$.CustomDoubleField') < ?)",
+			expectedArgs: []interface{}{int64(1), int64(5), float64(2)},
+		},
+		{
+			query:        "CustomKeywordField in ('a', 'b')",
+			mysql:        "(JSON_CONTAINS(search_attributes->'$.CustomKeywordField', JSON_QUOTE(?)) OR JSON_CONTAINS(search_attributes->'$.CustomKeywordField', JSON_QUOTE(?)))",
+			postgresql:   "((search_attributes->'CustomKeywordField') @> to_jsonb(?::text) OR (search_attributes->'CustomKeywordField') @> to_jsonb(?::text))",
+			sqlite:       "(EXISTS (SELECT 1 FROM json_each(search_attributes, '$.CustomKeywordField') WHERE json_each.value = ?) OR EXISTS (SELECT 1 FROM json_each(search_attributes, '$.CustomKeywordField') WHERE json_each.value = ?))",
+			expectedArgs: []interface{}{"a", "b"},
+		},
+		{
+			query:        "CustomKeywordField != 'a'",
+			mysql:        "NOT (JSON_CONTAINS(search_attributes->'$.CustomKeywordField', JSON_QUOTE(?)))",
+			postgresql:   "NOT ((search_attributes->'CustomKeywordField') @> to_jsonb(?::text))",
+			sqlite:       "NOT (EXISTS (SELECT 1 FROM json_each(search_attributes, '$.CustomKeywordField') WHERE json_each.value = ?))",
+			expectedArgs: []interface{}{"a"},
+		},
+		{
+			query:        "CustomDatetimeField > '2021-10-05T13:14:15Z' and CustomTextField is not null",
+			mysql:        "(search_attributes->>'$.CustomDatetimeField' > ? AND search_attributes->>'$.CustomTextField' IS NOT NULL)",
+			postgresql:   "(search_attributes->>'CustomDatetimeField' > ? AND search_attributes->>'CustomTextField' IS NOT NULL)",
+			sqlite:       "(json_extract(search_attributes, '$.CustomDatetimeField') > ? AND json_extract(search_attributes, '$.CustomTextField') IS NOT NULL)",
+			expectedArgs: []interface{}{"2021-10-05T13:14:15.000000000Z"},
+		},
+	}
+
+	for _, tc := range cases {
+		for pluginName, expectedQuery := range map[string]string{
+			"mysql":          tc.mysql,
+			"postgres":       tc.postgresql,
+			sqlitePluginName: tc.sqlite,
+		} {
+			dialect, err := newSQLDialect(pluginName)
+			require.NoError(t, err)
+			converter := newQueryConverter(dialect, "test-namespace", searchattribute.TestNameTypeMap, nil)
+			queryString, args, err := converter.convertWhere(tc.query)
+			assert.NoError(t, err, tc.query)
+			assert.Equal(t, expectedQuery, queryString, "%s: %s", pluginName, tc.query)
+			assert.Equal(t, tc.expectedArgs, args, "%s: %s", pluginName, tc.query)
+		}
+	}
+}
+
+func TestQueryConverter_BoolValue(t *testing.T) {
+	expectedArgs := map[string]interface{}{
+		"mysql":          "true",
+		"postgres":       true,
+		sqlitePluginName: int64(1),
+	}
+	for pluginName, expectedArg := range expectedArgs {
+		dialect, err := newSQLDialect(pluginName)
+		require.NoError(t, err)
+		converter := newQueryConverter(dialect, "test-namespace", searchattribute.TestNameTypeMap, nil)
+		_, args, err := converter.convertWhere("CustomBoolField = true")
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{expectedArg}, args, pluginName)
+	}
+}
+
+func TestQueryConverter_Errors(t *testing.T) {
+	cases := map[string]string{
+		"order by StartTime":                    query.NotSupportedErrMessage,
+		"WorkflowId = 'wid' order by StartTime": query.NotSupportedErrMessage,
+		"WorkflowId = 'wid' limit 10":           query.NotSupportedErrMessage,
+		"not (WorkflowId = 'wid')":              query.NotSupportedErrMessage,
+		"ExecutionDuration > 10":                query.NotSupportedErrMessage,
+		"WorkflowId = ":                         query.MalformedSqlQueryErrMessage,
+		"1 = WorkflowId":                        query.InvalidExpressionErrMessage,
+		"CustomIntField = 'abc'":                query.InvalidExpressionErrMessage,
+		"CustomIntField like '1%'":              query.InvalidExpressionErrMessage,
+		"ExecutionStatus like 'Run%'":           query.InvalidExpressionErrMessage,
+		"ExecutionStatus = 'Unknown'":           query.InvalidExpressionErrMessage,
+		"UnknownField = 1":                      "invalid search attribute",
+	}
+
+	dialect, err := newSQLDialect("mysql")
+	require.NoError(t, err)
+	for queryStr, expectedErr := range cases {
+		converter := newQueryConverter(dialect, "test-namespace", searchattribute.TestNameTypeMap, nil)
+		_, _, err := converter.convertWhere(queryStr)
+		var converterErr *query.ConverterError
+		assert.True(t, errors.As(err, &converterErr), queryStr)
+		assert.Contains(t, err.Error(), expectedErr, queryStr)
+	}
+}
+
+func TestNewSQLDialect_UnknownPlugin(t *testing.T) {
+	_, err := newSQLDialect("cassandra")
+	assert.Error(t, err)
+}
@@ -0,0 +1,541 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	persistencesql "go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	visibilityTimeout = 16 * time.Second
+)
+
+type (
+	// visibilityStore is an advanced visibility store on top of SQL database.
+	// Search attributes are stored in JSON column and queried with vendor specific JSON functions.
+	visibilityStore struct {
+		sqlStore                 persistencesql.SqlStore
+		dialect                  sqlDialect
+		index                    string
+		searchAttributesProvider searchattribute.Provider
+		searchAttributesMapper   searchattribute.Mapper
+	}
+
+	visibilityPageToken struct {
+		CloseTime *time.Time
+		StartTime time.Time
+		RunID     string
+	}
+)
+
+var _ store.VisibilityStore = (*visibilityStore)(nil)
+
+// TODO remove this function when NoSQL & SQL layer all support context timeout
+func newVisibilityContext() (context.Context, context.CancelFunc) {
+	ctx := context.Background()
+	return context.WithTimeout(ctx, visibilityTimeout)
+}
+
+// NewSQLVisibilityStore creates an instance of advanced VisibilityStore backed by SQL database
+func NewSQLVisibilityStore(
+	cfg config.SQL,
+	r resolver.ServiceResolver,
+	index string,
+	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapper searchattribute.Mapper,
+	logger log.Logger,
+) (*visibilityStore, error) {
+	dialect, err := newSQLDialect(cfg.PluginName)
+	if err != nil {
+		return nil, err
+	}
+	refDbConn := persistencesql.NewRefCountedDBConn(sqlplugin.DbKindAdvancedVisibility, &cfg, r)
+	db, err := refDbConn.Get()
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityStore{
+		sqlStore:                 persistencesql.NewSqlStore(db, logger),
+		dialect:                  dialect,
+		index:                    index,
+		searchAttributesProvider: searchAttributesProvider,
+		searchAttributesMapper:   searchAttributesMapper,
+	}, nil
+}
+
+func (s *visibilityStore) Close() {
+	s.sqlStore.Close()
+}
+
+func (s *visibilityStore) GetName() string {
+	return s.sqlStore.GetName()
+}
+
+func (s *visibilityStore) RecordWorkflowExecutionStarted(
+	request *store.InternalRecordWorkflowExecutionStartedRequest,
+) error {
+	row, err := s.generateRow(request.InternalVisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	return s.upsertRow("RecordWorkflowExecutionStarted", row)
+}
+
+func (s *visibilityStore) RecordWorkflowExecutionClosed(
+	request *store.InternalRecordWorkflowExecutionClosedRequest,
+) error {
+	row, err := s.generateRow(request.InternalVisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	row.CloseTime = &request.CloseTime
+	return s.upsertRow("RecordWorkflowExecutionClosed", row)
+}
+
+func (s *visibilityStore) UpsertWorkflowExecution(
+	request *store.InternalUpsertWorkflowExecutionRequest,
+) error {
+	row, err := s.generateRow(request.InternalVisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	return s.upsertRow("UpsertWorkflowExecution", row)
+}
+
+func (s *visibilityStore) DeleteWorkflowExecution(
+	request *manager.VisibilityDeleteWorkflowExecutionRequest,
+) error {
+	ctx, cancel := newVisibilityContext()
+	defer cancel()
+	_, err := s.sqlStore.Db.DeleteFromVisibility(ctx, sqlplugin.VisibilityDeleteFilter{
+		NamespaceID: request.NamespaceID.String(),
+		RunID:       request.RunID,
+	})
+	if err != nil {
+		return serviceerror.NewUnavailable(err.Error())
+	}
+	return nil
+}
+
+func (s *visibilityStore) ListOpenWorkflowExecutions(
+	request *manager.ListWorkflowExecutionsRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWithFilter("ListOpenWorkflowExecutions", request, false, "", nil)
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutions(
+	request *manager.ListWorkflowExecutionsRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWithFilter("ListClosedWorkflowExecutions", request, true, "", nil)
+}
+
+func (s *visibilityStore) ListOpenWorkflowExecutionsByType(
+	request *manager.ListWorkflowExecutionsByTypeRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWithFilter("ListOpenWorkflowExecutionsByType", request.ListWorkflowExecutionsRequest, false,
+		"workflow_type_name = ?", request.WorkflowTypeName)
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutionsByType(
+	request *manager.ListWorkflowExecutionsByTypeRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWithFilter("ListClosedWorkflowExecutionsByType", request.ListWorkflowExecutionsRequest, true,
+		"workflow_type_name = ?", request.WorkflowTypeName)
+}
+
+func (s *visibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
+	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWithFilter("ListOpenWorkflowExecutionsByWorkflowID", request.ListWorkflowExecutionsRequest, false,
+		"workflow_id = ?", request.WorkflowID)
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
+	request *manager.ListWorkflowExecutionsByWorkflowIDRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWithFilter("ListClosedWorkflowExecutionsByWorkflowID", request.ListWorkflowExecutionsRequest, true,
+		"workflow_id = ?", request.WorkflowID)
+}
+
+func (s *visibilityStore) ListClosedWorkflowExecutionsByStatus(
+	request *manager.ListClosedWorkflowExecutionsByStatusRequest,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWithFilter("ListClosedWorkflowExecutionsByStatus", request.ListWorkflowExecutionsRequest, true,
+		"status = ?", int32(request.Status))
+}
+
+func (s *visibilityStore) ListWorkflowExecutions(
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	queryString, queryArgs, err := s.convertQuery(request.Namespace, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	return s.listWorkflowExecutions("ListWorkflowExecutions", request.NamespaceID, request.Namespace, queryString, queryArgs, request.PageSize, request.NextPageToken)
+}
+
+// ScanWorkflowExecutions is the same as ListWorkflowExecutions because keyset pagination
+// doesn't skip or duplicate workflows when new rows are added.
+func (s *visibilityStore) ScanWorkflowExecutions(
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	queryString, queryArgs, err := s.convertQuery(request.Namespace, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	return s.listWorkflowExecutions("ScanWorkflowExecutions", request.NamespaceID, request.Namespace, queryString, queryArgs, request.PageSize, request.NextPageToken)
+}
+
+func (s *visibilityStore) CountWorkflowExecutions(
+	request *manager.CountWorkflowExecutionsRequest,
+) (*manager.CountWorkflowExecutionsResponse, error) {
+	queryString, queryArgs, err := s.convertQuery(request.Namespace, request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := newVisibilityContext()
+	defer cancel()
+	count, err := s.sqlStore.Db.CountFromAdvancedVisibility(ctx, sqlplugin.AdvancedVisibilitySelectFilter{
+		NamespaceID: request.NamespaceID.String(),
+		QueryString: queryString,
+		QueryArgs:   queryArgs,
+	})
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("CountWorkflowExecutions operation failed. Select failed: %v", err))
+	}
+	return &manager.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+func (s *visibilityStore) convertQuery(
+	namespace namespace.Name,
+	requestQueryStr string,
+) (string, []interface{}, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return "", nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+	}
+
+	converter := newQueryConverter(s.dialect, namespace, saTypeMap, s.searchAttributesMapper)
+	queryString, queryArgs, err := converter.convertWhere(requestQueryStr)
+	if err != nil {
+		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return "", nil, converterErr.ToInvalidArgument()
+		}
+		return "", nil, err
+	}
+	return queryString, queryArgs, nil
+}
+
+// listWithFilter serves list APIs of standard visibility: filters by start time for open
+// workflows or by close time for closed workflows plus one optional extra condition.
+func (s *visibilityStore) listWithFilter(
+	opName string,
+	request *manager.ListWorkflowExecutionsRequest,
+	closed bool,
+	condition string,
+	conditionArg interface{},
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	timeColumn := "start_time"
+	queryString := "status = ?"
+	if closed {
+		timeColumn = "close_time"
+		queryString = "status <> ?"
+	}
+	queryArgs := []interface{}{int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)}
+
+	if !request.EarliestStartTime.IsZero() {
+		queryString += fmt.Sprintf(" AND %s >= ?", timeColumn)
+		queryArgs = append(queryArgs, request.EarliestStartTime)
+	}
+	if !request.LatestStartTime.IsZero() {
+		queryString += fmt.Sprintf(" AND %s <= ?", timeColumn)
+		queryArgs = append(queryArgs, request.LatestStartTime)
+	}
+	if condition != "" {
+		queryString += " AND " + condition
+		queryArgs = append(queryArgs, conditionArg)
+	}
+
+	return s.listWorkflowExecutions(opName, request.NamespaceID, request.Namespace, queryString, queryArgs, request.PageSize, request.NextPageToken)
+}
+
+func (s *visibilityStore) listWorkflowExecutions(
+	opName string,
+	namespaceID namespace.ID,
+	namespace namespace.Name,
+	queryString string,
+	queryArgs []interface{},
+	pageSize int,
+	pageToken []byte,
+) (*store.InternalListWorkflowExecutionsResponse, error) {
+	token, err := s.deserializePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := sqlplugin.AdvancedVisibilitySelectFilter{
+		NamespaceID: namespaceID.String(),
+		QueryString: queryString,
+		QueryArgs:   queryArgs,
+		PageSize:    pageSize,
+	}
+	if token != nil {
+		filter.CloseTime = token.CloseTime
+		filter.StartTime = &token.StartTime
+		filter.RunID = &token.RunID
+	}
+
+	ctx, cancel := newVisibilityContext()
+	defer cancel()
+	rows, err := s.sqlStore.Db.SelectFromAdvancedVisibility(ctx, filter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("%v operation failed. Select failed: %v", opName, err))
+	}
+	if len(rows) == 0 {
+		return &store.InternalListWorkflowExecutionsResponse{}, nil
+	}
+
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+	}
+
+	infos := make([]*store.InternalWorkflowExecutionInfo, len(rows))
+	for i := range rows {
+		infos[i], err = s.rowToInfo(&rows[i], saTypeMap, namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var nextPageToken []byte
+	if len(rows) == pageSize {
+		lastRow := rows[len(rows)-1]
+		nextPageToken, err = s.serializePageToken(&visibilityPageToken{
+			CloseTime: lastRow.CloseTime,
+			StartTime: lastRow.StartTime,
+			RunID:     lastRow.RunID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &store.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *visibilityStore) upsertRow(opName string, row *sqlplugin.VisibilityRow) error {
+	ctx, cancel := newVisibilityContext()
+	defer cancel()
+	if _, err := s.sqlStore.Db.UpsertIntoAdvancedVisibility(ctx, row); err != nil {
+		return serviceerror.NewUnavailable(fmt.Sprintf("%v operation failed. Upsert failed: %v", opName, err))
+	}
+	return nil
+}
+
+func (s *visibilityStore) generateRow(
+	request *store.InternalVisibilityRequestBase,
+) (*sqlplugin.VisibilityRow, error) {
+	searchAttributes, err := s.encodeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return nil, err
+	}
+	stateTransitionCount := request.StateTransitionCount
+	var historyLength *int64
+	if request.HistoryLength > 0 {
+		historyLength = &request.HistoryLength
+	}
+	return &sqlplugin.VisibilityRow{
+		NamespaceID:          request.NamespaceID,
+		WorkflowID:           request.WorkflowID,
+		RunID:                request.RunID,
+		StartTime:            request.StartTime,
+		ExecutionTime:        request.ExecutionTime,
+		WorkflowTypeName:     request.WorkflowTypeName,
+		Status:               int32(request.Status),
+		Memo:                 request.Memo.GetData(),
+		Encoding:             request.Memo.GetEncodingType().String(),
+		TaskQueue:            request.TaskQueue,
+		StateTransitionCount: &stateTransitionCount,
+		HistoryLength:        historyLength,
+		SearchAttributes:     searchAttributes,
+	}, nil
+}
+
+// encodeSearchAttributes converts search attributes to JSON object of their values.
+func (s *visibilityStore) encodeSearchAttributes(
+	searchAttributes *commonpb.SearchAttributes,
+) ([]byte, error) {
+	typeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read search attribute types: %v", err))
+	}
+
+	values, err := searchattribute.Decode(searchAttributes, &typeMap)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to decode search attributes: %v", err))
+	}
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	for name, value := range values {
+		switch v := value.(type) {
+		case time.Time:
+			values[name] = v.UTC().Format(jsonDatetimeLayout)
+		case []time.Time:
+			formatted := make([]string, len(v))
+			for i, t := range v {
+				formatted[i] = t.UTC().Format(jsonDatetimeLayout)
+			}
+			values[name] = formatted
+		}
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to encode search attributes: %v", err))
+	}
+	return data, nil
+}
+
+// decodeSearchAttributes converts JSON object of search attribute values back to search attributes.
+// Values of search attributes which are not in type map anymore are ignored.
+func (s *visibilityStore) decodeSearchAttributes(
+	data []byte,
+	saTypeMap searchattribute.NameTypeMap,
+	namespace namespace.Name,
+) (*commonpb.SearchAttributes, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var rawValues map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawValues); err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to unmarshal search attributes: %v", err))
+	}
+
+	values := make(map[string]interface{}, len(rawValues))
+	for name, rawValue := range rawValues {
+		if !saTypeMap.IsDefined(name) {
+			continue
+		}
+		// Search attribute values are JSON encoded payloads already.
+		values[name] = rawValue
+	}
+
+	searchAttributes, err := searchattribute.Encode(values, &saTypeMap)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to encode search attributes: %v", err))
+	}
+	if err := searchattribute.ApplyAliases(s.searchAttributesMapper, searchAttributes, namespace.String()); err != nil {
+		return nil, err
+	}
+	return searchAttributes, nil
+}
+
+func (s *visibilityStore) rowToInfo(
+	row *sqlplugin.VisibilityRow,
+	saTypeMap searchattribute.NameTypeMap,
+	namespace namespace.Name,
+) (*store.InternalWorkflowExecutionInfo, error) {
+	if row.ExecutionTime.UnixNano() == 0 {
+		row.ExecutionTime = row.StartTime
+	}
+	info := &store.InternalWorkflowExecutionInfo{
+		WorkflowID:    row.WorkflowID,
+		RunID:         row.RunID,
+		TypeName:      row.WorkflowTypeName,
+		StartTime:     row.StartTime,
+		ExecutionTime: row.ExecutionTime,
+		Memo:          persistence.NewDataBlob(row.Memo, row.Encoding),
+		Status:        enumspb.WorkflowExecutionStatus(row.Status),
+		TaskQueue:     row.TaskQueue,
+	}
+	if row.CloseTime != nil {
+		info.CloseTime = *row.CloseTime
+	}
+	if row.HistoryLength != nil {
+		info.HistoryLength = *row.HistoryLength
+	}
+	if row.StateTransitionCount != nil {
+		info.StateTransitionCount = *row.StateTransitionCount
+	}
+
+	var err error
+	info.SearchAttributes, err = s.decodeSearchAttributes(row.SearchAttributes, saTypeMap, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (s *visibilityStore) deserializePageToken(
+	data []byte,
+) (*visibilityPageToken, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var token visibilityPageToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unable to deserialize page token: %v", err))
+	}
+	return &token, nil
+}
+
+func (s *visibilityStore) serializePageToken(
+	token *visibilityPageToken,
+) ([]byte, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("unable to serialize page token: %v", err))
+	}
+	return data, nil
+}
@@ -0,0 +1,70 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/searchattribute"
+)
+
+func TestVisibilityStore_SearchAttributesRoundTrip(t *testing.T) {
+	s := &visibilityStore{
+		searchAttributesProvider: searchattribute.NewTestProvider(),
+	}
+
+	datetime := time.Date(2021, 10, 5, 13, 14, 15, 123, time.UTC)
+	searchAttributes, err := searchattribute.Encode(map[string]interface{}{
+		"CustomIntField":      int64(42),
+		"CustomKeywordField":  []string{"a", "b"},
+		"CustomDatetimeField": datetime,
+		"CustomBoolField":     true,
+	}, &searchattribute.TestNameTypeMap)
+	require.NoError(t, err)
+
+	data, err := s.encodeSearchAttributes(searchAttributes)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"CustomIntField": 42,
+		"CustomKeywordField": ["a", "b"],
+		"CustomDatetimeField": "2021-10-05T13:14:15.000000123Z",
+		"CustomBoolField": true
+	}`, string(data))
+
+	decoded, err := s.decodeSearchAttributes(append(data[:len(data)-1], []byte(`,"RemovedField":"x"}`)...), searchattribute.TestNameTypeMap, "test-namespace")
+	require.NoError(t, err)
+	values, err := searchattribute.Decode(decoded, &searchattribute.TestNameTypeMap)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"CustomIntField":      int64(42),
+		"CustomKeywordField":  []string{"a", "b"},
+		"CustomDatetimeField": datetime,
+		"CustomBoolField":     true,
+	}, values)
+}
@@ -96,6 +96,7 @@ type (
 		Status               enumspb.WorkflowExecutionStatus
 		ExecutionTime        time.Time
 		StateTransitionCount int64
+		HistoryLength        int64
 		TaskID               int64
 		ShardID              int32
 		Memo                 *commonpb.DataBlob
@@ -111,8 +112,7 @@ type (
 	// InternalRecordWorkflowExecutionClosedRequest is request to RecordWorkflowExecutionClosed
 	InternalRecordWorkflowExecutionClosedRequest struct {
 		*InternalVisibilityRequestBase
-		CloseTime     time.Time
-		HistoryLength int64
+		CloseTime time.Time
 	}
 
 	// InternalUpsertWorkflowExecutionRequest is request to UpsertWorkflowExecution
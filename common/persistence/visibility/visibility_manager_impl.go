@@ -94,7 +94,6 @@ func (p *visibilityManagerImpl) RecordWorkflowExecutionClosed(request *manager.R
 	req := &store.InternalRecordWorkflowExecutionClosedRequest{
 		InternalVisibilityRequestBase: requestBase,
 		CloseTime:                     request.CloseTime,
-		HistoryLength:                 request.HistoryLength,
 	}
 	return p.store.RecordWorkflowExecutionClosed(req)
 }
@@ -221,6 +220,7 @@ func (p *visibilityManagerImpl) newInternalVisibilityRequestBase(request *manage
 		Status:               request.Status,
 		ExecutionTime:        request.ExecutionTime,
 		StateTransitionCount: request.StateTransitionCount,
+		HistoryLength:        request.HistoryLength,
 		TaskID:               request.TaskID,
 		ShardID:              request.ShardID,
 		TaskQueue:            request.TaskQueue,
@@ -272,12 +272,12 @@ func (p *visibilityManagerImpl) convertInternalWorkflowExecutionInfo(internalExe
 		TaskQueue:            internalExecution.TaskQueue,
 		Status:               internalExecution.Status,
 		StateTransitionCount: internalExecution.StateTransitionCount,
+		HistoryLength:        internalExecution.HistoryLength,
 	}
 
 	// for close records
 	if internalExecution.Status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
 		executionInfo.CloseTime = &internalExecution.CloseTime
-		executionInfo.HistoryLength = internalExecution.HistoryLength
 	}
 
 	// Workflows created before 1.11 have ExecutionTime set to Unix epoch zero time (1/1/1970) for non-cron/non-retry case.
@@ -0,0 +1,98 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package priorities defines the priority of workflow and activity tasks. The public API has no
+// priority field, so clients set it with an integer in the HeaderKey field of the Temporal
+// header passed with start workflow options and with ScheduleActivityTask, StartChildWorkflowExecution
+// and ContinueAsNewWorkflowExecution commands. The header is read only where such a request or
+// command enters the server, from there on the priority is carried in the typed priority fields
+// of the internal requests, mutable state and matching tasks.
+package priorities
+
+import (
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	// HeaderKey is the Temporal header key which holds the task priority
+	HeaderKey = "_priority"
+
+	// Highest is the highest task priority
+	Highest int32 = 1
+	// Default is the priority of tasks which do not set a priority
+	Default int32 = 3
+	// Lowest is the lowest task priority
+	Lowest int32 = 5
+)
+
+// FromHeader returns the priority set in the header, or 0 if the header does not set a priority.
+func FromHeader(header *commonpb.Header) (int32, error) {
+	value, ok := header.GetFields()[HeaderKey]
+	if !ok {
+		return 0, nil
+	}
+	var priority int32
+	if err := payload.Decode(value, &priority); err != nil {
+		return 0, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid %v header: %v.", HeaderKey, err))
+	}
+	if priority < Highest || priority > Lowest {
+		return 0, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid %v header: priority must be between %v and %v.", HeaderKey, Highest, Lowest))
+	}
+	return priority, nil
+}
+
+// Validate returns an error if the priority is neither 0 nor between Highest and Lowest.
+func Validate(priority int32) error {
+	if priority != 0 && (priority < Highest || priority > Lowest) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid priority %v: priority must be between %v and %v.", priority, Highest, Lowest))
+	}
+	return nil
+}
+
+// Effective returns the priority used to order tasks, tasks without priority use Default.
+func Effective(priority int32) int32 {
+	if priority < Highest || priority > Lowest {
+		return Default
+	}
+	return priority
+}
+
+// Inherit returns the priority set in the header, or the given priority of the workflow execution
+// if the header does not set a priority, so activities, child workflows and continued-as-new runs
+// keep the priority of the workflow execution.
+func Inherit(header *commonpb.Header, priority int32) (int32, error) {
+	headerPriority, err := FromHeader(header)
+	if err != nil {
+		return 0, err
+	}
+	if headerPriority != 0 {
+		return headerPriority, nil
+	}
+	return priority, nil
+}
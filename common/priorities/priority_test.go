@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package priorities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/payload"
+)
+
+type (
+	prioritySuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestPrioritySuite(t *testing.T) {
+	suite.Run(t, &prioritySuite{})
+}
+
+func (s *prioritySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *prioritySuite) TestFromHeader() {
+	priority, err := FromHeader(nil)
+	s.NoError(err)
+	s.Equal(int32(0), priority)
+
+	priority, err = FromHeader(s.header(2))
+	s.NoError(err)
+	s.Equal(int32(2), priority)
+
+	_, err = FromHeader(s.header(Lowest + 1))
+	s.Error(err)
+
+	_, err = FromHeader(&commonpb.Header{Fields: map[string]*commonpb.Payload{
+		HeaderKey: payload.EncodeString("high"),
+	}})
+	s.Error(err)
+}
+
+func (s *prioritySuite) TestEffective() {
+	s.Equal(Default, Effective(0))
+	s.Equal(Highest, Effective(Highest))
+	s.Equal(Lowest, Effective(Lowest))
+}
+
+func (s *prioritySuite) TestValidate() {
+	s.NoError(Validate(0))
+	s.NoError(Validate(Highest))
+	s.NoError(Validate(Lowest))
+	s.Error(Validate(Lowest + 1))
+	s.Error(Validate(-1))
+}
+
+func (s *prioritySuite) TestInherit() {
+	priority, err := Inherit(nil, 0)
+	s.NoError(err)
+	s.Equal(int32(0), priority)
+
+	priority, err = Inherit(&commonpb.Header{Fields: map[string]*commonpb.Payload{
+		"other": payload.EncodeString("value"),
+	}}, 4)
+	s.NoError(err)
+	s.Equal(int32(4), priority)
+
+	priority, err = Inherit(s.header(1), 4)
+	s.NoError(err)
+	s.Equal(int32(1), priority)
+
+	_, err = Inherit(s.header(Lowest+1), 4)
+	s.Error(err)
+}
+
+func (s *prioritySuite) header(priority int32) *commonpb.Header {
+	value, err := payload.Encode(priority)
+	s.NoError(err)
+	return &commonpb.Header{Fields: map[string]*commonpb.Payload{HeaderKey: value}}
+}
@@ -54,6 +54,7 @@ var (
 
 	matchingAPIExcluded = map[string]struct{}{
 		"ListTaskQueuePartitions": {},
+		"RefreshDynamicConfig":    {},
 	}
 
 	historyAPIExcluded = map[string]struct{}{
@@ -67,6 +68,9 @@ var (
 		"RemoveTask":                {},
 		"SyncShardStatus":           {},
 		"GetReplicationStatus":      {},
+		"GetOpenExecutionCounts":    {},
+		"GetShardLoad":              {},
+		"RefreshDynamicConfig":      {},
 	}
 )
 
@@ -48,6 +48,7 @@ const (
 	BinaryChecksums       = "BinaryChecksums"
 	BatcherNamespace      = "BatcherNamespace"
 	BatcherUser           = "BatcherUser"
+	HistorySizeBytes      = "HistorySizeBytes"
 
 	MemoEncoding      = "MemoEncoding"
 	Memo              = "Memo"
@@ -78,6 +79,7 @@ var (
 		BinaryChecksums:       enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 		BatcherNamespace:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 		BatcherUser:           enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		HistorySizeBytes:      enumspb.INDEXED_VALUE_TYPE_INT,
 	}
 
 	// reserved are internal field names that can't be used as search attribute names.
@@ -0,0 +1,102 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	defaultServiceName  = "temporal"
+	defaultOTLPEndpoint = "localhost:4317"
+	defaultOTLPTimeout  = 10 * time.Second
+)
+
+type (
+	// Config contains the config items for tracing subsystem
+	Config struct {
+		// ServiceName is reported as service.name resource attribute of every span. Default is "temporal".
+		ServiceName string `yaml:"serviceName"`
+		// SamplingRatio is the fraction of traces started by Temporal server which are sampled.
+		// Traces which were started by a caller follow the caller sampling decision. Default is 1.
+		SamplingRatio *float64 `yaml:"samplingRatio"`
+		// OTLP is the configuration for OTLP gRPC exporter
+		OTLP *OTLPConfig `yaml:"otlp"`
+	}
+
+	// OTLPConfig contains the config items for OTLP gRPC exporter
+	OTLPConfig struct {
+		// Endpoint is host:port of OpenTelemetry collector. Default is "localhost:4317".
+		Endpoint string `yaml:"endpoint"`
+		// Insecure disables client transport security for the exporter connection.
+		Insecure bool `yaml:"insecure"`
+		// Headers are sent with every export request.
+		Headers map[string]string `yaml:"headers"`
+		// Timeout is the max time allowed for one export request. Default is 10s.
+		Timeout time.Duration `yaml:"timeout"`
+	}
+)
+
+var errNoExporter = errors.New("tracing config: no exporter is configured")
+
+// Validate validates tracing config
+func (c *Config) Validate() error {
+	if c.OTLP == nil {
+		return errNoExporter
+	}
+	if c.SamplingRatio != nil && (*c.SamplingRatio < 0 || *c.SamplingRatio > 1) {
+		return errors.New("tracing config: samplingRatio must be between 0 and 1")
+	}
+	return nil
+}
+
+func (c *Config) serviceName() string {
+	if c.ServiceName == "" {
+		return defaultServiceName
+	}
+	return c.ServiceName
+}
+
+func (c *Config) samplingRatio() float64 {
+	if c.SamplingRatio == nil {
+		return 1
+	}
+	return *c.SamplingRatio
+}
+
+func (c *OTLPConfig) endpoint() string {
+	if c.Endpoint == "" {
+		return defaultOTLPEndpoint
+	}
+	return c.Endpoint
+}
+
+func (c *OTLPConfig) timeout() time.Duration {
+	if c.Timeout <= 0 {
+		return defaultOTLPTimeout
+	}
+	return c.Timeout
+}
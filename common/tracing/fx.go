@@ -0,0 +1,66 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/fx"
+
+	"go.temporal.io/server/common/log"
+)
+
+// Module initializes tracing if it is configured.
+// Requires *Config (can be nil) available in container.
+var Module = fx.Options(
+	fx.Provide(TracerProviderProvider),
+	fx.Invoke(LifetimeHooks),
+)
+
+// TracerProviderProvider returns nil if tracing is not configured.
+func TracerProviderProvider(cfg *Config, logger log.Logger) (*sdktrace.TracerProvider, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	return NewTracerProvider(cfg, logger)
+}
+
+func LifetimeHooks(
+	lc fx.Lifecycle,
+	provider *sdktrace.TracerProvider,
+) {
+	if provider == nil {
+		return
+	}
+	lc.Append(
+		fx.Hook{
+			OnStop: func(ctx context.Context) error {
+				// Flushes spans which are not exported yet.
+				return provider.Shutdown(ctx)
+			},
+		},
+	)
+}
@@ -0,0 +1,216 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	// sdkTracerHeaderKey is the Temporal header key which is used by SDK tracing interceptors
+	// to pass trace context of the caller.
+	sdkTracerHeaderKey = "_tracer-data"
+)
+
+type (
+	// metadataCarrier adapts gRPC metadata to OpenTelemetry propagation carrier.
+	metadataCarrier metadata.MD
+
+	namespaceGetter interface {
+		GetNamespace() string
+	}
+
+	namespaceIDGetter interface {
+		GetNamespaceId() string
+	}
+
+	workflowIDGetter interface {
+		GetWorkflowId() string
+	}
+
+	workflowExecutionGetter interface {
+		GetWorkflowExecution() *commonpb.WorkflowExecution
+	}
+
+	headerGetter interface {
+		GetHeader() *commonpb.Header
+	}
+)
+
+var _ propagation.TextMapCarrier = metadataCarrier(nil)
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// UnaryServerInterceptor starts server span for every request. The span continues the trace
+// propagated by the caller in gRPC metadata. If request has Temporal header with trace context
+// set by SDK tracing interceptor, it is used as parent when gRPC metadata has no trace context,
+// or linked to the span otherwise.
+func UnaryServerInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(rpcAttributes(info.FullMethod)...),
+		trace.WithAttributes(requestAttributes(req)...),
+	}
+	if sdkSpanContext := sdkSpanContextFromRequest(req); sdkSpanContext.IsValid() {
+		if trace.SpanContextFromContext(ctx).IsValid() {
+			opts = append(opts, trace.WithLinks(trace.Link{SpanContext: sdkSpanContext}))
+		} else {
+			ctx = trace.ContextWithRemoteSpanContext(ctx, sdkSpanContext)
+		}
+	}
+
+	ctx, span := Tracer().Start(ctx, spanName(info.FullMethod), opts...)
+	resp, err := handler(ctx, req)
+	if err != nil {
+		span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int64(int64(serviceerror.ToStatus(err).Code())))
+	}
+	EndSpan(span, err)
+	return resp, err
+}
+
+// UnaryClientInterceptor starts client span for every outgoing request
+// and propagates trace context to the server in gRPC metadata.
+func UnaryClientInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	ctx, span := Tracer().Start(
+		ctx,
+		spanName(method),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(rpcAttributes(method)...),
+	)
+	// Span context is not valid if tracing is not enabled.
+	if span.SpanContext().IsValid() {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if ok {
+			md = md.Copy()
+		} else {
+			md = metadata.MD{}
+		}
+		otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	EndSpan(span, err)
+	return err
+}
+
+func sdkSpanContextFromRequest(req interface{}) trace.SpanContext {
+	request, ok := req.(headerGetter)
+	if !ok {
+		return trace.SpanContext{}
+	}
+	tracerData, ok := request.GetHeader().GetFields()[sdkTracerHeaderKey]
+	if !ok {
+		return trace.SpanContext{}
+	}
+	var carrier map[string]string
+	if err := payload.Decode(tracerData, &carrier); err != nil {
+		return trace.SpanContext{}
+	}
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(carrier))
+	return trace.SpanContextFromContext(ctx)
+}
+
+func requestAttributes(req interface{}) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if r, ok := req.(namespaceGetter); ok && r.GetNamespace() != "" {
+		attrs = append(attrs, NamespaceKey.String(r.GetNamespace()))
+	}
+	if r, ok := req.(namespaceIDGetter); ok && r.GetNamespaceId() != "" {
+		attrs = append(attrs, NamespaceIDKey.String(r.GetNamespaceId()))
+	}
+	if r, ok := req.(workflowIDGetter); ok && r.GetWorkflowId() != "" {
+		attrs = append(attrs, WorkflowIDKey.String(r.GetWorkflowId()))
+	} else if r, ok := req.(workflowExecutionGetter); ok && r.GetWorkflowExecution() != nil {
+		attrs = append(attrs, WorkflowIDKey.String(r.GetWorkflowExecution().GetWorkflowId()))
+		if runID := r.GetWorkflowExecution().GetRunId(); runID != "" {
+			attrs = append(attrs, RunIDKey.String(runID))
+		}
+	}
+	return attrs
+}
+
+// spanName returns full gRPC method name without leading slash, i.e. "package.Service/Method".
+func spanName(fullMethod string) string {
+	return strings.TrimPrefix(fullMethod, "/")
+}
+
+func rpcAttributes(fullMethod string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{semconv.RPCSystemKey.String("grpc")}
+	name := spanName(fullMethod)
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		attrs = append(attrs,
+			semconv.RPCServiceKey.String(name[:i]),
+			semconv.RPCMethodKey.String(name[i+1:]),
+		)
+	}
+	return attrs
+}
@@ -0,0 +1,165 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/payload"
+)
+
+type (
+	grpcSuite struct {
+		suite.Suite
+
+		recorder *tracetest.SpanRecorder
+	}
+)
+
+const testMethod = "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"
+
+func TestGRPCSuite(t *testing.T) {
+	suite.Run(t, new(grpcSuite))
+}
+
+func (s *grpcSuite) SetupTest() {
+	s.recorder = tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(s.recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+func (s *grpcSuite) TearDownTest() {
+	otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+}
+
+func (s *grpcSuite) TestClientToServerPropagation() {
+	var outgoingMD metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoingMD, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	err := UnaryClientInterceptor(context.Background(), testMethod, nil, nil, nil, invoker)
+	s.NoError(err)
+	s.NotEmpty(outgoingMD.Get("traceparent"))
+
+	var handlerSpanContext trace.SpanContext
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerSpanContext = trace.SpanContextFromContext(ctx)
+		return nil, nil
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), outgoingMD)
+	_, err = UnaryServerInterceptor(ctx, &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:  "test-namespace",
+		WorkflowId: "test-workflow-id",
+	}, &grpc.UnaryServerInfo{FullMethod: testMethod}, handler)
+	s.NoError(err)
+
+	spans := s.recorder.Ended()
+	s.Len(spans, 2)
+	clientSpan, serverSpan := spans[0], spans[1]
+	s.Equal(trace.SpanKindClient, clientSpan.SpanKind())
+	s.Equal(trace.SpanKindServer, serverSpan.SpanKind())
+	s.Equal("temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution", serverSpan.Name())
+	s.Equal(clientSpan.SpanContext().TraceID(), serverSpan.SpanContext().TraceID())
+	s.Equal(clientSpan.SpanContext().SpanID(), serverSpan.Parent().SpanID())
+	s.Equal(serverSpan.SpanContext(), handlerSpanContext)
+	s.Contains(serverSpan.Attributes(), NamespaceKey.String("test-namespace"))
+	s.Contains(serverSpan.Attributes(), WorkflowIDKey.String("test-workflow-id"))
+}
+
+func (s *grpcSuite) TestServerUsesSDKHeaderAsParent() {
+	sdkSpanContext, header := s.newSDKHeader()
+
+	_, err := UnaryServerInterceptor(context.Background(), &workflowservice.StartWorkflowExecutionRequest{
+		Header: header,
+	}, &grpc.UnaryServerInfo{FullMethod: testMethod}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	s.NoError(err)
+
+	spans := s.recorder.Ended()
+	s.Len(spans, 1)
+	s.Equal(sdkSpanContext.TraceID(), spans[0].SpanContext().TraceID())
+	s.Equal(sdkSpanContext.SpanID(), spans[0].Parent().SpanID())
+	s.Empty(spans[0].Links())
+}
+
+func (s *grpcSuite) TestServerLinksSDKHeader() {
+	sdkSpanContext, header := s.newSDKHeader()
+
+	ctx, parent := Tracer().Start(context.Background(), "parent")
+	md := metadata.MD{}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+
+	_, err := UnaryServerInterceptor(ctx, &workflowservice.StartWorkflowExecutionRequest{
+		Header: header,
+	}, &grpc.UnaryServerInfo{FullMethod: testMethod}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	s.NoError(err)
+
+	spans := s.recorder.Ended()
+	s.Len(spans, 1)
+	s.Equal(parent.SpanContext().TraceID(), spans[0].SpanContext().TraceID())
+	s.Len(spans[0].Links(), 1)
+	s.Equal(sdkSpanContext.TraceID(), spans[0].Links()[0].SpanContext.TraceID())
+}
+
+func (s *grpcSuite) TestServerRecordsError() {
+	_, err := UnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testMethod}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, context.DeadlineExceeded
+	})
+	s.Error(err)
+
+	spans := s.recorder.Ended()
+	s.Len(spans, 1)
+	s.Equal("Error", spans[0].Status().Code.String())
+}
+
+func (s *grpcSuite) newSDKHeader() (trace.SpanContext, *commonpb.Header) {
+	ctx, sdkSpan := Tracer().Start(context.Background(), "sdk")
+	sdkSpan.End()
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	tracerData, err := payload.Encode(map[string]string(carrier))
+	s.NoError(err)
+	s.recorder = tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(s.recorder)))
+	return sdkSpan.SpanContext(), &commonpb.Header{Fields: map[string]*commonpb.Payload{sdkTracerHeaderKey: tracerData}}
+}
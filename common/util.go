@@ -249,12 +249,14 @@ func IsContextCanceledErr(err error) bool {
 
 // IsWhitelistServiceTransientError checks if the error is a transient error.
 func IsWhitelistServiceTransientError(err error) bool {
-	switch err.(type) {
+	switch err := err.(type) {
 	case *serviceerror.Internal,
-		*serviceerror.ResourceExhausted,
 		*serviceerrors.ShardOwnershipLost,
 		*serviceerror.Unavailable:
 		return true
+	case *serviceerror.ResourceExhausted:
+		// concurrency limits are not expected to be released within the retry interval
+		return err.Cause != enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT
 	}
 
 	return false
@@ -285,6 +287,39 @@ func WorkflowIDToHistoryShard(
 	return int32(hash%uint32(numberOfShards)) + 1 // ShardID starts with 1
 }
 
+// VerifyShardCountCompatibility returns an error unless the larger of the two history shard counts is a multiple
+// of the smaller one. Only then all workflows of a shard in the cluster with more shards belong to a single shard
+// in the cluster with fewer shards, which is required to replicate workflows between the clusters.
+func VerifyShardCountCompatibility(thisShardCount int32, remoteShardCount int32) error {
+	if thisShardCount <= 0 || remoteShardCount <= 0 {
+		return fmt.Errorf("history shard count must be positive, got %d and %d", thisShardCount, remoteShardCount)
+	}
+	large, small := thisShardCount, remoteShardCount
+	if large < small {
+		large, small = small, large
+	}
+	if large%small != 0 {
+		return fmt.Errorf("history shard count %d is not a multiple of %d", large, small)
+	}
+	return nil
+}
+
+// SourceHistoryShardID returns the shard of a cluster with sourceShardCount history shards which owns all workflows
+// of targetShardID in a cluster with a multiple of sourceShardCount shards.
+func SourceHistoryShardID(targetShardID int32, sourceShardCount int32) int32 {
+	return (targetShardID-1)%sourceShardCount + 1
+}
+
+// TargetHistoryShardIDs returns the shards of a cluster with targetShardCount history shards which own the workflows
+// of sourceShardID in a cluster with sourceShardCount shards. targetShardCount must be a multiple of sourceShardCount.
+func TargetHistoryShardIDs(sourceShardID int32, sourceShardCount int32, targetShardCount int32) []int32 {
+	shardIDs := make([]int32, 0, targetShardCount/sourceShardCount)
+	for shardID := sourceShardID; shardID <= targetShardCount; shardID += sourceShardCount {
+		shardIDs = append(shardIDs, shardID)
+	}
+	return shardIDs
+}
+
 // PrettyPrintHistory prints history in human readable format
 func PrettyPrintHistory(history *historypb.History, logger log.Logger) {
 	fmt.Println("************** History *******************")
@@ -340,6 +375,8 @@ func CreateMatchingPollWorkflowTaskQueueResponse(historyResponse *historyservice
 		ScheduledTime:              historyResponse.ScheduledTime,
 		StartedTime:                historyResponse.StartedTime,
 		Queries:                    historyResponse.Queries,
+		HistorySizeBytes:           historyResponse.HistorySizeBytes,
+		SuggestContinueAsNew:       historyResponse.SuggestContinueAsNew,
 	}
 
 	return matchingResp
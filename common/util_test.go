@@ -30,9 +30,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/dynamicconfig"
@@ -254,6 +256,13 @@ func TestIsContextCanceledErr(t *testing.T) {
 	require.True(t, IsContextCanceledErr(ctx.Err()))
 }
 
+func TestIsWhitelistServiceTransientError(t *testing.T) {
+	require.True(t, IsWhitelistServiceTransientError(serviceerror.NewUnavailable("something")))
+	require.True(t, IsWhitelistServiceTransientError(serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT, "something")))
+	require.False(t, IsWhitelistServiceTransientError(serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, "something")))
+	require.False(t, IsWhitelistServiceTransientError(serviceerror.NewInvalidArgument("something")))
+}
+
 func TestOverrideWorkflowRunTimeout_InfiniteRunTimeout_InfiniteExecutionTimeout(t *testing.T) {
 	runTimeout := time.Duration(0)
 	executionTimeout := time.Duration(0)
@@ -333,3 +342,24 @@ func TestOverrideWorkflowTaskTimeout_Finite(t *testing.T) {
 	defaultTimeoutFn = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(defaultTimeout)
 	require.Equal(t, MaxWorkflowTaskStartToCloseTimeout, OverrideWorkflowTaskTimeout("random domain", taskTimeout, runTimeout, defaultTimeoutFn))
 }
+
+func TestVerifyShardCountCompatibility(t *testing.T) {
+	require.NoError(t, VerifyShardCountCompatibility(4, 4))
+	require.NoError(t, VerifyShardCountCompatibility(4, 16))
+	require.NoError(t, VerifyShardCountCompatibility(16, 4))
+	require.Error(t, VerifyShardCountCompatibility(4, 6))
+	require.Error(t, VerifyShardCountCompatibility(0, 4))
+}
+
+func TestHistoryShardIDMapping(t *testing.T) {
+	require.Equal(t, []int32{3, 7, 11, 15}, TargetHistoryShardIDs(3, 4, 16))
+	require.Equal(t, []int32{2}, TargetHistoryShardIDs(2, 4, 4))
+
+	for i := 0; i < 1000; i++ {
+		workflowID := uuid.New()
+		sourceShardID := WorkflowIDToHistoryShard("namespace", workflowID, 4)
+		targetShardID := WorkflowIDToHistoryShard("namespace", workflowID, 16)
+		require.Equal(t, sourceShardID, SourceHistoryShardID(targetShardID, 4))
+		require.Contains(t, TargetHistoryShardIDs(sourceShardID, 4, 16), targetShardID)
+	}
+}
@@ -0,0 +1,39 @@
+#!/bin/bash
+
+set -eu -o pipefail
+
+# Adds fields introduced in v2 to the mapping of existing visibility index and updates index template.
+# No reindex is required because fields are only added.
+
+# Prerequisites:
+#   - jq
+#   - curl
+
+# Input parameters.
+ES_SCHEME="${ES_SCHEME:-http}"
+ES_SERVER="${ES_SERVER:-127.0.0.1}"
+ES_PORT="${ES_PORT:-9200}"
+ES_USER="${ES_USER:-}"
+ES_PWD="${ES_PWD:-}"
+ES_VERSION="${ES_VERSION:-v7}"
+ES_VIS_INDEX="${ES_VIS_INDEX:-temporal_visibility_v1_dev}"
+
+ES_ENDPOINT="${ES_SCHEME}://${ES_SERVER}:${ES_PORT}"
+DIR_NAME="$(dirname "$(realpath "${BASH_SOURCE[0]}")")"
+
+echo "=== Step 0. Sanity check if Elasticsearch index is accessible. ==="
+if ! curl --silent --fail --user "${ES_USER}":"${ES_PWD}" "${ES_ENDPOINT}/${ES_VIS_INDEX}/_stats/docs" --write-out "\n"; then
+    echo "Elasticsearch index ${ES_VIS_INDEX} is not accessible at ${ES_ENDPOINT}."
+    exit 1
+fi
+
+echo "=== Step 1. Update index template. ==="
+curl --silent --fail --user "${ES_USER}":"${ES_PWD}" -X PUT "${ES_ENDPOINT}/_template/temporal_visibility_v1_template" -H "Content-Type: application/json" --data-binary "@${DIR_NAME}/index_template_${ES_VERSION}.json" | jq
+
+echo "=== Step 2. Add new fields to the index mapping. ==="
+DOC_TYPE=""
+if [ "${ES_VERSION}" != "v7" ]; then
+    DOC_TYPE="/_doc"
+fi
+NEW_FIELDS_MAPPING='{"properties":{"HistorySizeBytes":{"type":"long"}}}'
+curl --silent --fail --user "${ES_USER}":"${ES_PWD}" -X PUT "${ES_ENDPOINT}/${ES_VIS_INDEX}${DOC_TYPE}/_mapping" -H "Content-Type: application/json" --data-binary "${NEW_FIELDS_MAPPING}" | jq
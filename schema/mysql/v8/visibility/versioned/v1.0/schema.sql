@@ -0,0 +1,35 @@
+CREATE TABLE executions_visibility (
+  namespace_id            CHAR(64) NOT NULL,
+  run_id                  CHAR(64) NOT NULL,
+  start_time              DATETIME(6) NOT NULL,
+  execution_time          DATETIME(6) NOT NULL,
+  workflow_id             VARCHAR(255) NOT NULL,
+  workflow_type_name      VARCHAR(255) NOT NULL,
+  status                  INT NOT NULL,  -- enum WorkflowExecutionStatus {RUNNING, COMPLETED, FAILED, CANCELED, TERMINATED, CONTINUED_AS_NEW, TIMED_OUT}
+  close_time              DATETIME(6) NULL,
+  history_length          BIGINT,
+  memo                    BLOB,
+  encoding                VARCHAR(64) NOT NULL,
+  task_queue              VARCHAR(255) DEFAULT '' NOT NULL,
+  state_transition_count  BIGINT,
+  search_attributes       JSON,
+
+  PRIMARY KEY  (namespace_id, run_id)
+);
+
+-- Default sort order of advanced visibility queries: running workflows first, then closed workflows by close time.
+CREATE INDEX by_coalesce_close_time ON executions_visibility (namespace_id, (COALESCE(close_time, CAST('9999-12-31 23:59:59' AS DATETIME))) DESC, start_time DESC, run_id);
+CREATE INDEX by_type_start_time ON executions_visibility (namespace_id, workflow_type_name, status, start_time DESC, run_id);
+CREATE INDEX by_workflow_id_start_time ON executions_visibility (namespace_id, workflow_id, status, start_time DESC, run_id);
+CREATE INDEX by_status_by_start_time ON executions_visibility (namespace_id, status, start_time DESC, run_id);
+CREATE INDEX by_type_close_time ON executions_visibility (namespace_id, workflow_type_name, status, close_time DESC, run_id);
+CREATE INDEX by_workflow_id_close_time ON executions_visibility (namespace_id, workflow_id, status, close_time DESC, run_id);
+CREATE INDEX by_status_by_close_time ON executions_visibility (namespace_id, status, close_time DESC, run_id);
+CREATE INDEX by_close_time_by_status ON executions_visibility (namespace_id, close_time DESC, run_id, status);
+CREATE INDEX by_task_queue ON executions_visibility (namespace_id, task_queue);
+
+-- Multi-valued indexes on predefined search attributes. Both single values and lists are indexed.
+CREATE INDEX by_temporal_change_version ON executions_visibility (namespace_id, (CAST(search_attributes->'$.TemporalChangeVersion' AS CHAR(255) ARRAY)));
+CREATE INDEX by_binary_checksums ON executions_visibility (namespace_id, (CAST(search_attributes->'$.BinaryChecksums' AS CHAR(255) ARRAY)));
+CREATE INDEX by_batcher_namespace ON executions_visibility (namespace_id, (CAST(search_attributes->'$.BatcherNamespace' AS CHAR(255) ARRAY)));
+CREATE INDEX by_batcher_user ON executions_visibility (namespace_id, (CAST(search_attributes->'$.BatcherUser' AS CHAR(255) ARRAY)));
@@ -31,3 +31,6 @@ const Version = "1.7"
 
 // VisibilityVersion is the MySQL visibility database release version
 const VisibilityVersion = "1.1"
+
+// AdvancedVisibilityVersion is the MySQL 8 advanced visibility database release version
+const AdvancedVisibilityVersion = "1.0"
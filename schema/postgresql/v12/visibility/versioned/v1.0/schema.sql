@@ -0,0 +1,35 @@
+CREATE TABLE executions_visibility (
+  namespace_id            CHAR(64) NOT NULL,
+  run_id                  CHAR(64) NOT NULL,
+  start_time              TIMESTAMP NOT NULL,
+  execution_time          TIMESTAMP NOT NULL,
+  workflow_id             VARCHAR(255) NOT NULL,
+  workflow_type_name      VARCHAR(255) NOT NULL,
+  status                  INTEGER NOT NULL,  -- enum WorkflowExecutionStatus {RUNNING, COMPLETED, FAILED, CANCELED, TERMINATED, CONTINUED_AS_NEW, TIMED_OUT}
+  close_time              TIMESTAMP NULL,
+  history_length          BIGINT,
+  memo                    BYTEA,
+  encoding                VARCHAR(64) NOT NULL,
+  task_queue              VARCHAR(255) DEFAULT '' NOT NULL,
+  state_transition_count  BIGINT,
+  search_attributes       JSONB,
+
+  PRIMARY KEY  (namespace_id, run_id)
+);
+
+-- Default sort order of advanced visibility queries: running workflows first, then closed workflows by close time.
+CREATE INDEX by_coalesce_close_time ON executions_visibility (namespace_id, (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_type_start_time ON executions_visibility (namespace_id, workflow_type_name, status, start_time DESC, run_id);
+CREATE INDEX by_workflow_id_start_time ON executions_visibility (namespace_id, workflow_id, status, start_time DESC, run_id);
+CREATE INDEX by_status_by_start_time ON executions_visibility (namespace_id, status, start_time DESC, run_id);
+CREATE INDEX by_type_close_time ON executions_visibility (namespace_id, workflow_type_name, status, close_time DESC, run_id);
+CREATE INDEX by_workflow_id_close_time ON executions_visibility (namespace_id, workflow_id, status, close_time DESC, run_id);
+CREATE INDEX by_status_by_close_time ON executions_visibility (namespace_id, status, close_time DESC, run_id);
+CREATE INDEX by_close_time_by_status ON executions_visibility (namespace_id, close_time DESC, run_id, status);
+CREATE INDEX by_task_queue ON executions_visibility (namespace_id, task_queue);
+
+-- GIN indexes on predefined search attributes. Both single values and lists are indexed.
+CREATE INDEX by_temporal_change_version ON executions_visibility USING GIN ((search_attributes->'TemporalChangeVersion') jsonb_path_ops);
+CREATE INDEX by_binary_checksums ON executions_visibility USING GIN ((search_attributes->'BinaryChecksums') jsonb_path_ops);
+CREATE INDEX by_batcher_namespace ON executions_visibility USING GIN ((search_attributes->'BatcherNamespace') jsonb_path_ops);
+CREATE INDEX by_batcher_user ON executions_visibility USING GIN ((search_attributes->'BatcherUser') jsonb_path_ops);
@@ -11,6 +11,8 @@ CREATE TABLE executions_visibility (
 	memo BLOB,
 	encoding VARCHAR(64) NOT NULL,
 	task_queue VARCHAR(255) DEFAULT '' NOT NULL,
+	state_transition_count BIGINT,
+	search_attributes TEXT,
 
 	PRIMARY KEY (namespace_id, run_id)
 );
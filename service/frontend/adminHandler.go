@@ -26,27 +26,38 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
+	"sort"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	filterpb "go.temporal.io/api/filter/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	batchspb "go.temporal.io/server/api/batch/v1"
 	clusterspb "go.temporal.io/server/api/cluster/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
+	"go.temporal.io/server/api/workerservice/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/common"
@@ -55,17 +66,21 @@ import (
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
@@ -73,12 +88,24 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/deletenamespace"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	listDynamicConfigPageSize               = 100
+	// dynamicConfigHistorySize is the number of changes kept in the history of a dynamic config key
+	dynamicConfigHistorySize = 20
+	// dynamicConfigRefreshTimeout bounds the time spent asking a single host to reload dynamic config
+	dynamicConfigRefreshTimeout = 5 * time.Second
+	// shardLoadTimeout bounds the time spent asking a single history host for the load on its shards
+	shardLoadTimeout = 5 * time.Second
+	// defaultShardLoadTopWorkflows is the number of most requested workflows returned for each shard by default
+	defaultShardLoadTopWorkflows = 10
 )
 
 type (
@@ -103,6 +130,7 @@ type (
 		clientFactory               serverClient.Factory
 		clientBean                  serverClient.Bean
 		historyClient               historyservice.HistoryServiceClient
+		matchingClient              matchingservice.MatchingServiceClient
 		sdkClient                   sdkclient.Client
 		membershipMonitor           membership.Monitor
 		metricsClient               metrics.Client
@@ -110,6 +138,11 @@ type (
 		saProvider                  searchattribute.Provider
 		saManager                   searchattribute.Manager
 		clusterMetadata             cluster.Metadata
+		dynamicConfigClient         dynamicconfig.Client
+
+		hostClientsLock      sync.Mutex
+		frontendAdminClients map[string]adminservice.AdminServiceClient
+		workerClients        map[string]workerservice.WorkerServiceClient
 	}
 
 	NewAdminHandlerArgs struct {
@@ -128,6 +161,7 @@ type (
 		ClientFactory                       serverClient.Factory
 		ClientBean                          serverClient.Bean
 		HistoryClient                       historyservice.HistoryServiceClient
+		MatchingClient                      matchingservice.MatchingServiceClient
 		SdkSystemClient                     sdkclient.Client
 		MembershipMonitor                   membership.Monitor
 		ArchiverProvider                    provider.ArchiverProvider
@@ -137,6 +171,7 @@ type (
 		SaManager                           searchattribute.Manager
 		ClusterMetadata                     cluster.Metadata
 		ArchivalMetadata                    archiver.ArchivalMetadata
+		DynamicConfigClient                 dynamicconfig.Client
 	}
 )
 
@@ -188,6 +223,7 @@ func NewAdminHandler(
 		clientFactory:               args.ClientFactory,
 		clientBean:                  args.ClientBean,
 		historyClient:               args.HistoryClient,
+		matchingClient:              args.MatchingClient,
 		sdkClient:                   args.SdkSystemClient,
 		membershipMonitor:           args.MembershipMonitor,
 		metricsClient:               args.MetricsClient,
@@ -195,6 +231,9 @@ func NewAdminHandler(
 		saProvider:                  args.SaProvider,
 		saManager:                   args.SaManager,
 		clusterMetadata:             args.ClusterMetadata,
+		dynamicConfigClient:         args.DynamicConfigClient,
+		frontendAdminClients:        make(map[string]adminservice.AdminServiceClient),
+		workerClients:               make(map[string]workerservice.WorkerServiceClient),
 	}
 }
 
@@ -1132,9 +1171,29 @@ func (adh *AdminHandler) GetReplicationMessages(ctx context.Context, request *ad
 		return nil, adh.error(errClusterNameNotSet, scope)
 	}
 
+	// A polling cluster with more shards than this one is being migrated to: each of its shards polls the shard
+	// of this cluster which owns its workflows. Replication to a cluster with fewer shards is not supported,
+	// this is the old cluster of a migration polling the new one, so it gets no tasks.
+	clusterShardCount := adh.config.NumHistoryShards
+	if clusterInfo, ok := adh.clusterMetadata.GetAllClusterInfo()[request.GetClusterName()]; ok && clusterInfo.ShardCount != 0 {
+		clusterShardCount = clusterInfo.ShardCount
+	}
+	if clusterShardCount < adh.config.NumHistoryShards {
+		return &adminservice.GetReplicationMessagesResponse{}, nil
+	}
+	if clusterShardCount%adh.config.NumHistoryShards != 0 {
+		return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"Cannot replicate to cluster %s with %d history shards from cluster with %d history shards.",
+			request.GetClusterName(),
+			clusterShardCount,
+			adh.config.NumHistoryShards,
+		)), scope)
+	}
+
 	resp, err := adh.historyClient.GetReplicationMessages(ctx, &historyservice.GetReplicationMessagesRequest{
-		Tokens:      request.GetTokens(),
-		ClusterName: request.GetClusterName(),
+		Tokens:            request.GetTokens(),
+		ClusterName:       request.GetClusterName(),
+		ClusterShardCount: clusterShardCount,
 	})
 	if err != nil {
 		return nil, adh.error(err, scope)
@@ -1550,181 +1609,1512 @@ func (adh *AdminHandler) GetTaskQueueTasks(
 	}, nil
 }
 
-func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
-	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
-) error {
+// DeleteWorkflowExecution deletes a closed workflow execution asynchronously (workflow must be not running).
+// If force is set, a running workflow execution is terminated first.
+func (adh *AdminHandler) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DeleteWorkflowExecutionRequest,
+) (_ *adminservice.DeleteWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteWorkflowExecutionScope)
+	defer sw.Stop()
 
-	execution := request.Execution
-	if execution.GetWorkflowId() == "" {
-		return errWorkflowIDNotSet
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
 	}
-	// TODO currently, this API is only going to be used by re-send history events
-	// to remote cluster if kafka is lossy again, in the future, this API can be used
-	// by CLI and client, then empty runID (meaning the current workflow) should be allowed
-	if execution.GetRunId() == "" || uuid.Parse(execution.GetRunId()) == nil {
-		return errInvalidRunID
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
 	}
 
-	pageSize := int(request.GetMaximumPageSize())
-	if pageSize <= 0 {
-		return errInvalidPageSize
+	if request.GetForce() {
+		_, err = adh.historyClient.TerminateWorkflowExecution(ctx, &historyservice.TerminateWorkflowExecutionRequest{
+			NamespaceId: namespaceID.String(),
+			TerminateRequest: &workflowservice.TerminateWorkflowExecutionRequest{
+				Namespace:         request.GetNamespace(),
+				WorkflowExecution: request.Execution,
+				Reason:            request.GetReason(),
+				Identity:          request.GetIdentity(),
+			},
+		})
+		switch err.(type) {
+		case nil, *serviceerror.NotFound:
+			// NotFound means the workflow execution is already closed
+		default:
+			return nil, adh.error(err, scope)
+		}
 	}
 
-	if request.GetStartEventId() == common.EmptyEventID &&
-		request.GetStartEventVersion() == common.EmptyVersion &&
-		request.GetEndEventId() == common.EmptyEventID &&
-		request.GetEndEventVersion() == common.EmptyVersion {
-		return errInvalidEventQueryRange
+	_, err = adh.historyClient.DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId:       namespaceID.String(),
+		WorkflowExecution: request.Execution,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
 	}
+	return &adminservice.DeleteWorkflowExecutionResponse{}, nil
+}
 
-	if (request.GetStartEventId() != common.EmptyEventID && request.GetStartEventVersion() == common.EmptyVersion) ||
-		(request.GetStartEventId() == common.EmptyEventID && request.GetStartEventVersion() != common.EmptyVersion) {
-		return errInvalidStartEventCombination
+// StartBatchOperation starts a batch operation job in the system namespace.
+func (adh *AdminHandler) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+) (_ *adminservice.StartBatchOperationResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminStartBatchOperationScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetReason() == "" {
+		return nil, adh.error(errReasonNotSet, scope)
+	}
+	if (request.GetVisibilityQuery() == "") == (len(request.GetExecutions()) == 0) {
+		return nil, adh.error(errBatchTargetNotSet, scope)
+	}
+	batchType, ok := batchTypes[request.GetOperationType()]
+	if !ok {
+		return nil, adh.error(errBatchOperationTypeNotSupported, scope)
+	}
+	if _, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace())); err != nil {
+		return nil, adh.error(err, scope)
 	}
 
-	return nil
+	params := batcher.BatchParams{
+		Namespace: request.GetNamespace(),
+		Query:     request.GetVisibilityQuery(),
+		Reason:    request.GetReason(),
+		BatchType: batchType,
+		SignalParams: batcher.SignalParams{
+			SignalName: request.GetSignalName(),
+			Input:      request.GetSignalInput(),
+		},
+		RPS:         int(request.GetRps()),
+		Concurrency: int(request.GetConcurrency()),
+	}
+	for _, execution := range request.GetExecutions() {
+		if err := validateExecution(execution); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		params.Executions = append(params.Executions, *execution)
+	}
+	switch batchType {
+	case batcher.BatchTypeSignal:
+		if request.GetSignalName() == "" {
+			return nil, adh.error(errSignalNameNotSet, scope)
+		}
+	case batcher.BatchTypeReset:
+		resetType, ok := batchResetTypes[request.GetResetType()]
+		if !ok {
+			return nil, adh.error(errBatchResetTypeNotSupported, scope)
+		}
+		if resetType == batcher.ResetTypeBadBinary && request.GetResetBadBinaryChecksum() == "" {
+			return nil, adh.error(errBadBinaryChecksumNotSet, scope)
+		}
+		params.ResetParams = batcher.ResetParams{
+			ResetType:         resetType,
+			ResetReapplyType:  request.GetResetReapplyType(),
+			BadBinaryChecksum: request.GetResetBadBinaryChecksum(),
+		}
+	}
+
+	jobID := request.GetJobId()
+	if jobID == "" {
+		jobID = uuid.New()
+	}
+	_, err = adh.sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			ID:        jobID,
+			TaskQueue: batcher.BatcherTaskQueueName,
+			Memo: map[string]interface{}{
+				batcher.MemoReason:    request.GetReason(),
+				batcher.MemoBatchType: batchType,
+			},
+			SearchAttributes: map[string]interface{}{
+				searchattribute.BatcherNamespace: request.GetNamespace(),
+				searchattribute.BatcherUser:      request.GetIdentity(),
+			},
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
+		},
+		batcher.BatchWFTypeName,
+		params,
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.StartBatchOperationResponse{JobId: jobID}, nil
 }
 
-func (adh *AdminHandler) validateRemoteClusterMetadata(metadata *adminservice.DescribeClusterResponse) error {
-	// Verify remote cluster config
-	currentClusterInfo := adh.clusterMetadata
-	if metadata.GetClusterName() == currentClusterInfo.GetCurrentClusterName() {
-		// cluster name conflict
-		return serviceerror.NewInvalidArgument("Cannot update current cluster metadata from rpc calls")
+// StopBatchOperation terminates a running batch operation job.
+func (adh *AdminHandler) StopBatchOperation(
+	ctx context.Context,
+	request *adminservice.StopBatchOperationRequest,
+) (_ *adminservice.StopBatchOperationResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminStopBatchOperationScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
 	}
-	if metadata.GetFailoverVersionIncrement() != currentClusterInfo.GetFailoverVersionIncrement() {
-		// failover version increment is mismatch with current cluster config
-		return serviceerror.NewInvalidArgument("Cannot add remote cluster due to failover version increment mismatch")
+	if request.GetJobId() == "" {
+		return nil, adh.error(errJobIDNotSet, scope)
 	}
-	if metadata.GetHistoryShardCount() != adh.config.NumHistoryShards {
-		// cluster shard number not equal
-		// TODO: remove this check once we support different shard numbers
-		return serviceerror.NewInvalidArgument("Cannot add remote cluster due to history shard number mismatch")
+	if request.GetReason() == "" {
+		return nil, adh.error(errReasonNotSet, scope)
 	}
-	if !metadata.IsGlobalNamespaceEnabled {
-		// remote cluster doesn't support global namespace
-		return serviceerror.NewInvalidArgument("Cannot add remote cluster as global namespace is not supported")
+	// make sure the job belongs to the namespace of the request
+	if _, err := adh.describeBatchOperation(ctx, request.GetNamespace(), request.GetJobId()); err != nil {
+		return nil, adh.error(err, scope)
 	}
-	for clusterName, cluster := range currentClusterInfo.GetAllClusterInfo() {
-		if clusterName != metadata.ClusterName && cluster.InitialFailoverVersion == metadata.GetInitialFailoverVersion() {
-			// initial failover version conflict
-			// best effort: race condition if a concurrent write to db with the same version.
-			return serviceerror.NewInvalidArgument("Cannot add remote cluster due to initial failover version conflict")
-		}
+
+	if err := adh.sdkClient.TerminateWorkflow(ctx, request.GetJobId(), "", request.GetReason(), request.GetIdentity()); err != nil {
+		return nil, adh.error(err, scope)
 	}
-	return nil
+	return &adminservice.StopBatchOperationResponse{}, nil
 }
 
-func (adh *AdminHandler) setRequestDefaultValueAndGetTargetVersionHistory(
-	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
-	versionHistories *historyspb.VersionHistories,
-) (*historyspb.VersionHistory, error) {
+// DescribeBatchOperation returns the state and the progress of a batch operation job.
+func (adh *AdminHandler) DescribeBatchOperation(
+	ctx context.Context,
+	request *adminservice.DescribeBatchOperationRequest,
+) (_ *adminservice.DescribeBatchOperationResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeBatchOperationScope)
+	defer sw.Stop()
 
-	targetBranch, err := versionhistory.GetCurrentVersionHistory(versionHistories)
-	if err != nil {
-		return nil, err
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
 	}
-	firstItem, err := versionhistory.GetFirstVersionHistoryItem(targetBranch)
+	if request.GetJobId() == "" {
+		return nil, adh.error(errJobIDNotSet, scope)
+	}
+
+	resp, err := adh.describeBatchOperation(ctx, request.GetNamespace(), request.GetJobId())
 	if err != nil {
-		return nil, err
+		return nil, adh.error(err, scope)
 	}
-	lastItem, err := versionhistory.GetLastVersionHistoryItem(targetBranch)
+	info, err := batchOperationInfo(resp.GetWorkflowExecutionInfo())
 	if err != nil {
-		return nil, err
+		return nil, adh.error(err, scope)
 	}
 
-	if request.GetStartEventId() == common.EmptyVersion || request.GetStartEventVersion() == common.EmptyVersion {
-		// If start event is not set, get the events from the first event
-		// As the API is exclusive-exclusive, use first event id - 1 here
-		request.StartEventId = common.FirstEventID - 1
-		request.StartEventVersion = firstItem.GetVersion()
-	}
-	if request.GetEndEventId() == common.EmptyEventID || request.GetEndEventVersion() == common.EmptyVersion {
-		// If end event is not set, get the events until the end event
-		// As the API is exclusive-exclusive, use end event id + 1 here
-		request.EndEventId = lastItem.GetEventId() + 1
-		request.EndEventVersion = lastItem.GetVersion()
+	// Progress is reported through the heartbeat details of the batch activity while the job
+	// is running, and is the result of the batch workflow once the job has completed.
+	var hbd batcher.HeartBeatDetails
+	switch info.GetState() {
+	case enumsspb.BATCH_OPERATION_STATE_RUNNING:
+		for _, activity := range resp.GetPendingActivities() {
+			if activity.GetHeartbeatDetails() != nil {
+				if err := payloads.Decode(activity.GetHeartbeatDetails(), &hbd); err != nil {
+					return nil, adh.error(err, scope)
+				}
+			}
+		}
+	case enumsspb.BATCH_OPERATION_STATE_COMPLETED:
+		execution := resp.GetWorkflowExecutionInfo().GetExecution()
+		if err := adh.sdkClient.GetWorkflow(ctx, execution.GetWorkflowId(), execution.GetRunId()).Get(ctx, &hbd); err != nil {
+			return nil, adh.error(err, scope)
+		}
 	}
 
-	if request.GetStartEventId() < 0 {
-		return nil, errInvalidFirstNextEventCombination
+	return &adminservice.DescribeBatchOperationResponse{
+		Info: info,
+		Progress: &batchspb.BatchOperationProgress{
+			TotalOperationCount:    hbd.TotalEstimate,
+			CompleteOperationCount: int64(hbd.SuccessCount),
+			FailureOperationCount:  int64(hbd.ErrorCount),
+			FailedWorkflowIds:      hbd.FailedWorkflowIDs,
+		},
+	}, nil
+}
+
+// ListBatchOperations lists the batch operation jobs of a namespace.
+func (adh *AdminHandler) ListBatchOperations(
+	ctx context.Context,
+	request *adminservice.ListBatchOperationsRequest,
+) (_ *adminservice.ListBatchOperationsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListBatchOperationsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	namespaceEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
 	}
 
-	// get branch based on the end event if end event is defined in the request
-	if request.GetEndEventId() == lastItem.GetEventId()+1 &&
-		request.GetEndEventVersion() == lastItem.GetVersion() {
-		// this is a special case, target branch remains the same
-	} else {
-		endItem := versionhistory.NewVersionHistoryItem(request.GetEndEventId(), request.GetEndEventVersion())
-		idx, err := versionhistory.FindFirstVersionHistoryIndexByVersionHistoryItem(versionHistories, endItem)
-		if err != nil {
-			return nil, err
-		}
+	resp, err := adh.sdkClient.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace:     common.SystemLocalNamespace,
+		PageSize:      request.GetPageSize(),
+		NextPageToken: request.GetNextPageToken(),
+		Query:         fmt.Sprintf("%s = %s", searchattribute.BatcherNamespace, quoteQueryValue(namespaceEntry.Name().String())),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
 
-		targetBranch, err = versionhistory.GetVersionHistory(versionHistories, idx)
+	operations := make([]*batchspb.BatchOperationInfo, 0, len(resp.GetExecutions()))
+	for _, execution := range resp.GetExecutions() {
+		info, err := batchOperationInfo(execution)
 		if err != nil {
-			return nil, err
+			return nil, adh.error(err, scope)
 		}
+		operations = append(operations, info)
 	}
+	return &adminservice.ListBatchOperationsResponse{
+		Operations:    operations,
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
 
-	startItem := versionhistory.NewVersionHistoryItem(request.GetStartEventId(), request.GetStartEventVersion())
-	// If the request start event is defined. The start event may be on a different branch as current branch.
-	// We need to find the LCA of the start event and the current branch.
-	if request.GetStartEventId() == common.FirstEventID-1 &&
-		request.GetStartEventVersion() == firstItem.GetVersion() {
-		// this is a special case, start event is on the same branch as target branch
-	} else {
-		if !versionhistory.ContainsVersionHistoryItem(targetBranch, startItem) {
-			idx, err := versionhistory.FindFirstVersionHistoryIndexByVersionHistoryItem(versionHistories, startItem)
-			if err != nil {
-				return nil, err
-			}
-			startBranch, err := versionhistory.GetVersionHistory(versionHistories, idx)
-			if err != nil {
-				return nil, err
-			}
-			startItem, err = versionhistory.FindLCAVersionHistoryItem(targetBranch, startBranch)
-			if err != nil {
-				return nil, err
-			}
-			request.StartEventId = startItem.GetEventId()
-			request.StartEventVersion = startItem.GetVersion()
-		}
+// CreateSchedule starts the scheduler workflow of a new schedule in the system namespace.
+func (adh *AdminHandler) CreateSchedule(
+	ctx context.Context,
+	request *adminservice.CreateScheduleRequest,
+) (_ *adminservice.CreateScheduleResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminCreateScheduleScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateScheduleRequest(request.GetNamespace(), request.GetScheduleId()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	var schedule scheduler.Schedule
+	if err := decodeSchedulePayload(request.GetSchedule(), &schedule); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if err := scheduler.ValidateSchedule(&schedule); err != nil {
+		return nil, adh.error(serviceerror.NewInvalidArgument(err.Error()), scope)
 	}
 
-	return targetBranch, nil
+	_, err = adh.sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			ID:                                       scheduler.WorkflowID(request.GetNamespace(), request.GetScheduleId()),
+			TaskQueue:                                scheduler.TaskQueueName,
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
+		},
+		scheduler.WorkflowType,
+		&scheduler.StartScheduleArgs{
+			Namespace:  request.GetNamespace(),
+			ScheduleID: request.GetScheduleId(),
+			Schedule:   schedule,
+		},
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.CreateScheduleResponse{}, nil
 }
 
-// startRequestProfile initiates recording of request metrics
-func (adh *AdminHandler) startRequestProfile(scope int) (metrics.Scope, metrics.Stopwatch) {
-	metricsScope := adh.metricsClient.Scope(scope)
-	sw := metricsScope.StartTimer(metrics.ServiceLatency)
-	metricsScope.IncCounter(metrics.ServiceRequests)
-	return metricsScope, sw
-}
+// DescribeSchedule returns the definition and the state of a schedule.
+func (adh *AdminHandler) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+) (_ *adminservice.DescribeScheduleResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeScheduleScope)
+	defer sw.Stop()
 
-func (adh *AdminHandler) error(err error, scope metrics.Scope) error {
-	switch err := err.(type) {
-	case *serviceerror.Unavailable:
-		adh.logger.Error("unavailable error", tag.Error(err))
-		scope.IncCounter(metrics.ServiceFailures)
-		return err
-	case *serviceerror.InvalidArgument:
-		scope.IncCounter(metrics.ServiceErrInvalidArgumentCounter)
-		return err
-	case *serviceerror.ResourceExhausted:
-		scope.Tagged(metrics.ResourceExhaustedCauseTag(err.Cause)).IncCounter(metrics.ServiceErrResourceExhaustedCounter)
-		return err
-	case *serviceerror.NotFound:
-		return err
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateScheduleRequest(request.GetNamespace(), request.GetScheduleId()); err != nil {
+		return nil, adh.error(err, scope)
 	}
 
-	adh.logger.Error("Unknown error", tag.Error(err))
-	scope.IncCounter(metrics.ServiceFailures)
-
+	value, err := adh.sdkClient.QueryWorkflow(
+		ctx,
+		scheduler.WorkflowID(request.GetNamespace(), request.GetScheduleId()),
+		"",
+		scheduler.QueryNameDescribe,
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	var description scheduler.DescribeResponse
+	if err := value.Get(&description); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	schedulePayload, err := payload.Encode(description.Schedule)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	infoPayload, err := payload.Encode(description.Info)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.DescribeScheduleResponse{
+		Schedule:      schedulePayload,
+		Info:          infoPayload,
+		ConflictToken: description.ConflictToken,
+	}, nil
+}
+
+// UpdateSchedule replaces the definition of a schedule.
+func (adh *AdminHandler) UpdateSchedule(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleRequest,
+) (_ *adminservice.UpdateScheduleResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateScheduleScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateScheduleRequest(request.GetNamespace(), request.GetScheduleId()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	var schedule scheduler.Schedule
+	if err := decodeSchedulePayload(request.GetSchedule(), &schedule); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if err := scheduler.ValidateSchedule(&schedule); err != nil {
+		return nil, adh.error(serviceerror.NewInvalidArgument(err.Error()), scope)
+	}
+
+	if err := adh.sdkClient.SignalWorkflow(
+		ctx,
+		scheduler.WorkflowID(request.GetNamespace(), request.GetScheduleId()),
+		"",
+		scheduler.SignalNameUpdate,
+		&scheduler.UpdateRequest{
+			Schedule:      schedule,
+			ConflictToken: request.GetConflictToken(),
+		},
+	); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UpdateScheduleResponse{}, nil
+}
+
+// PatchSchedule pauses, unpauses, triggers or backfills a schedule.
+func (adh *AdminHandler) PatchSchedule(
+	ctx context.Context,
+	request *adminservice.PatchScheduleRequest,
+) (_ *adminservice.PatchScheduleResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminPatchScheduleScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateScheduleRequest(request.GetNamespace(), request.GetScheduleId()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	var patch scheduler.SchedulePatch
+	if err := decodeSchedulePayload(request.GetPatch(), &patch); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if err := scheduler.ValidatePatch(&patch); err != nil {
+		return nil, adh.error(serviceerror.NewInvalidArgument(err.Error()), scope)
+	}
+
+	if err := adh.sdkClient.SignalWorkflow(
+		ctx,
+		scheduler.WorkflowID(request.GetNamespace(), request.GetScheduleId()),
+		"",
+		scheduler.SignalNamePatch,
+		&patch,
+	); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.PatchScheduleResponse{}, nil
+}
+
+// DeleteSchedule terminates the scheduler workflow of a schedule. Workflows started by the schedule are not affected.
+func (adh *AdminHandler) DeleteSchedule(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleRequest,
+) (_ *adminservice.DeleteScheduleResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteScheduleScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateScheduleRequest(request.GetNamespace(), request.GetScheduleId()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetReason() == "" {
+		return nil, adh.error(errReasonNotSet, scope)
+	}
+
+	if err := adh.sdkClient.TerminateWorkflow(
+		ctx,
+		scheduler.WorkflowID(request.GetNamespace(), request.GetScheduleId()),
+		"",
+		request.GetReason(),
+		request.GetIdentity(),
+	); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.DeleteScheduleResponse{}, nil
+}
+
+// ListSchedules lists the schedules of a namespace.
+func (adh *AdminHandler) ListSchedules(
+	ctx context.Context,
+	request *adminservice.ListSchedulesRequest,
+) (_ *adminservice.ListSchedulesResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListSchedulesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if _, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace())); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// Scheduler workflows of all namespaces run in the system namespace,
+	// the ones of the requested namespace are found by their workflow id prefix.
+	resp, err := adh.sdkClient.ListOpenWorkflow(ctx, &workflowservice.ListOpenWorkflowExecutionsRequest{
+		Namespace:       common.SystemLocalNamespace,
+		MaximumPageSize: request.GetPageSize(),
+		NextPageToken:   request.GetNextPageToken(),
+		Filters: &workflowservice.ListOpenWorkflowExecutionsRequest_TypeFilter{
+			TypeFilter: &filterpb.WorkflowTypeFilter{Name: scheduler.WorkflowType},
+		},
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	prefix := scheduler.WorkflowID(request.GetNamespace(), "")
+	var scheduleIDs []string
+	for _, execution := range resp.GetExecutions() {
+		if workflowID := execution.GetExecution().GetWorkflowId(); strings.HasPrefix(workflowID, prefix) {
+			scheduleIDs = append(scheduleIDs, strings.TrimPrefix(workflowID, prefix))
+		}
+	}
+	return &adminservice.ListSchedulesResponse{
+		ScheduleIds:   scheduleIDs,
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// quoteQueryValue returns value as a string literal of the visibility query language
+func quoteQueryValue(value string) string {
+	return "'" + queryValueEscaper.Replace(value) + "'"
+}
+
+func (adh *AdminHandler) validateScheduleRequest(namespaceName string, scheduleID string) error {
+	if namespaceName == "" {
+		return errNamespaceNotSet
+	}
+	if scheduleID == "" {
+		return errScheduleIDNotSet
+	}
+	// schedules can only be managed in namespaces the caller is authorized for
+	// and which exist, the scheduler starts workflows in this namespace
+	_, err := adh.namespaceRegistry.GetNamespace(namespace.Name(namespaceName))
+	return err
+}
+
+func decodeSchedulePayload(p *commonpb.Payload, valuePtr interface{}) error {
+	if p == nil {
+		return errScheduleNotSet
+	}
+	if err := payload.Decode(p, valuePtr); err != nil {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid schedule: %v.", err))
+	}
+	return nil
+}
+
+// UpdateWorkerBuildIdOrdering adds a worker build ID to the compatible-version graph of a workflow task queue.
+func (adh *AdminHandler) UpdateWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerBuildIdOrderingRequest,
+) (_ *adminservice.UpdateWorkerBuildIdOrderingResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateWorkerBuildIdOrderingScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, adh.error(errTaskQueueNotSet, scope)
+	}
+	if request.GetBuildId() == "" {
+		return nil, adh.error(errBuildIDNotSet, scope)
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.matchingClient.UpdateWorkerBuildIdOrdering(ctx, &matchingservice.UpdateWorkerBuildIdOrderingRequest{
+		NamespaceId:       namespaceID.String(),
+		TaskQueue:         request.GetTaskQueue(),
+		BuildId:           request.GetBuildId(),
+		CompatibleBuildId: request.GetCompatibleBuildId(),
+		BecomeDefault:     request.GetBecomeDefault(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UpdateWorkerBuildIdOrderingResponse{VersioningData: resp.GetVersioningData()}, nil
+}
+
+// GetWorkerBuildIdOrdering returns the compatible-version graph of a workflow task queue.
+func (adh *AdminHandler) GetWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *adminservice.GetWorkerBuildIdOrderingRequest,
+) (_ *adminservice.GetWorkerBuildIdOrderingResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetWorkerBuildIdOrderingScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, adh.error(errTaskQueueNotSet, scope)
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.matchingClient.GetWorkerBuildIdOrdering(ctx, &matchingservice.GetWorkerBuildIdOrderingRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue:   request.GetTaskQueue(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.GetWorkerBuildIdOrderingResponse{VersioningData: resp.GetVersioningData()}, nil
+}
+
+// DescribeTaskQueueStats returns backlog and throughput stats of a task queue aggregated across all its partitions.
+func (adh *AdminHandler) DescribeTaskQueueStats(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueStatsRequest,
+) (_ *adminservice.DescribeTaskQueueStatsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeTaskQueueStatsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, adh.error(errTaskQueueNotSet, scope)
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	taskQueueType := request.GetTaskQueueType()
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		taskQueueType = enumspb.TASK_QUEUE_TYPE_WORKFLOW
+	}
+
+	partitionsResp, err := adh.matchingClient.ListTaskQueuePartitions(ctx, &matchingservice.ListTaskQueuePartitionsRequest{
+		Namespace: request.GetNamespace(),
+		TaskQueue: &taskqueuepb.TaskQueue{
+			Name: request.GetTaskQueue(),
+			Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+		},
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	partitions := partitionsResp.GetWorkflowTaskQueuePartitions()
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		partitions = partitionsResp.GetActivityTaskQueuePartitions()
+	}
+
+	partitionStats := make([]*taskqueuespb.TaskQueuePartitionStats, len(partitions))
+	errs := make([]error, len(partitions))
+	var wg sync.WaitGroup
+	for i, partition := range partitions {
+		wg.Add(1)
+		go func(i int, partition *taskqueuepb.TaskQueuePartitionMetadata) {
+			defer wg.Done()
+			resp, err := adh.matchingClient.DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+				NamespaceId: namespaceID.String(),
+				DescRequest: &workflowservice.DescribeTaskQueueRequest{
+					Namespace: request.GetNamespace(),
+					TaskQueue: &taskqueuepb.TaskQueue{
+						Name: partition.GetKey(),
+						Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+					},
+					TaskQueueType:          taskQueueType,
+					IncludeTaskQueueStatus: true,
+				},
+			})
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			partitionStats[i] = &taskqueuespb.TaskQueuePartitionStats{
+				Partition:     partition.GetKey(),
+				OwnerHostName: partition.GetOwnerHostName(),
+				Stats:         resp.GetStats(),
+			}
+		}(i, partition)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+
+	stats := make([]*taskqueuespb.TaskQueueStats, len(partitionStats))
+	for i, partition := range partitionStats {
+		stats[i] = partition.GetStats()
+	}
+	return &adminservice.DescribeTaskQueueStatsResponse{
+		Stats:      mergeTaskQueueStats(stats),
+		Partitions: partitionStats,
+	}, nil
+}
+
+// mergeTaskQueueStats aggregates the stats of the partitions of a task queue. Backlog counts and rates
+// are summed, the backlog age is the one of the oldest partition backlog and the sync match ratio is
+// weighted by the dispatch rate of each partition.
+func mergeTaskQueueStats(partitions []*taskqueuespb.TaskQueueStats) *taskqueuespb.TaskQueueStats {
+	var backlogAge time.Duration
+	var syncMatchRate float32
+	merged := &taskqueuespb.TaskQueueStats{}
+	for _, stats := range partitions {
+		merged.ApproximateBacklogCount += stats.GetApproximateBacklogCount()
+		merged.TasksAddRate += stats.GetTasksAddRate()
+		merged.TasksDispatchRate += stats.GetTasksDispatchRate()
+		syncMatchRate += stats.GetSyncMatchRatio() * stats.GetTasksDispatchRate()
+		if age := timestamp.DurationValue(stats.GetApproximateBacklogAge()); age > backlogAge {
+			backlogAge = age
+		}
+	}
+	merged.ApproximateBacklogAge = timestamp.DurationPtr(backlogAge)
+	if merged.TasksDispatchRate > 0 {
+		merged.SyncMatchRatio = syncMatchRate / merged.TasksDispatchRate
+	}
+	return merged
+}
+
+// UpdateWorkflowExecution sends the update to the workflow and waits for it to be completed.
+// Update is validated by the workflow first and rejected update is not recorded in the history.
+func (adh *AdminHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionRequest,
+) (_ *adminservice.UpdateWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.GetExecution()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetName() == "" {
+		return nil, adh.error(errUpdateNameNotSet, scope)
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetUpdateId() == "" {
+		request.UpdateId = uuid.New()
+	}
+
+	resp, err := adh.historyClient.UpdateWorkflowExecution(ctx, &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp.GetResponse(), nil
+}
+
+// DeleteNamespace marks the namespace as deleted and renames it, so the name can be reused right away.
+// Namespace data and metadata are deleted asynchronously by the system workflow.
+func (adh *AdminHandler) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+) (_ *adminservice.DeleteNamespaceResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteNamespaceScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetNamespace() == common.SystemLocalNamespace {
+		return nil, adh.error(errUnableToDeleteSystemNamespace, scope)
+	}
+	nsEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if nsEntry.IsGlobalNamespace() {
+		return nil, adh.error(errUnableToDeleteGlobalNamespace, scope)
+	}
+
+	// Execute workflow.
+	wfParams := deletenamespace.DeleteNamespaceWorkflowParams{
+		Namespace: request.GetNamespace(),
+	}
+
+	run, err := adh.sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: worker.DefaultWorkerTaskQueue,
+			ID:        fmt.Sprintf("%s/%s", deletenamespace.WorkflowName, request.GetNamespace()),
+		},
+		deletenamespace.WorkflowName,
+		wfParams,
+	)
+	if err != nil {
+		return nil, adh.error(serviceerror.NewUnavailable(fmt.Sprintf(errUnableToStartWorkflowMessage, deletenamespace.WorkflowName, err)), scope)
+	}
+
+	// Wait for workflow to complete. Namespace data is deleted by the child workflow which keeps running.
+	var wfResult deletenamespace.DeleteNamespaceWorkflowResult
+	err = run.Get(ctx, &wfResult)
+	if err != nil {
+		scope.IncCounter(metrics.DeleteNamespaceWorkflowFailuresCount)
+		return nil, adh.error(serviceerror.NewUnavailable(fmt.Sprintf(errWorkflowReturnedErrorMessage, deletenamespace.WorkflowName, err)), scope)
+	}
+	scope.IncCounter(metrics.DeleteNamespaceWorkflowSuccessCount)
+
+	return &adminservice.DeleteNamespaceResponse{
+		DeletedNamespace:           wfResult.DeletedNamespace,
+		ReclaimResourcesWorkflowId: wfResult.ReclaimResourcesWorkflowID,
+	}, nil
+}
+
+// GetDynamicConfig returns the values and the change history of a dynamic config key stored in persistence.
+func (adh *AdminHandler) GetDynamicConfig(
+	_ context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+) (_ *adminservice.GetDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetKey() == "" {
+		return nil, adh.error(errDynamicConfigKeyNotSet, scope)
+	}
+
+	resp, err := adh.clusterMetadataManager.GetDynamicConfig(&persistence.GetDynamicConfigRequest{
+		Key: strings.ToLower(request.GetKey()),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.GetDynamicConfigResponse{
+		Entry:   resp.Entry,
+		Version: resp.Version,
+	}, nil
+}
+
+// ListDynamicConfig lists dynamic config keys stored in persistence.
+func (adh *AdminHandler) ListDynamicConfig(
+	_ context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+) (_ *adminservice.ListDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminListDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = listDynamicConfigPageSize
+	}
+
+	resp, err := adh.clusterMetadataManager.ListDynamicConfig(&persistence.ListDynamicConfigRequest{
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	entries := make([]*persistencespb.DynamicConfigEntry, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		// Keys whose values were all deleted are kept to preserve their change history.
+		if len(e.Entry.GetValues()) > 0 {
+			entries = append(entries, e.Entry)
+		}
+	}
+	return &adminservice.ListDynamicConfigResponse{
+		Entries:       entries,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// SetDynamicConfig sets the value of a dynamic config key for the given constraints.
+func (adh *AdminHandler) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+) (_ *adminservice.SetDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminSetDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetKey() == "" {
+		return nil, adh.error(errDynamicConfigKeyNotSet, scope)
+	}
+	if !json.Valid(request.GetValue()) {
+		return nil, adh.error(errInvalidDynamicConfigValue, scope)
+	}
+
+	constraints := request.GetConstraints()
+	if constraints == nil {
+		constraints = &persistencespb.DynamicConfigConstraints{}
+	}
+	newValue := &persistencespb.DynamicConfigValue{Value: request.GetValue(), Constraints: constraints}
+	if err := dynamicconfig.ValidatePersistenceValue(dynamicconfig.Key(request.GetKey()), newValue); err != nil {
+		return nil, adh.error(serviceerror.NewInvalidArgument(err.Error()), scope)
+	}
+
+	version, err := adh.updateDynamicConfig(
+		request.GetKey(),
+		request.GetIdentity(),
+		request.GetReason(),
+		func(values []*persistencespb.DynamicConfigValue) ([]*persistencespb.DynamicConfigValue, error) {
+			for i, v := range values {
+				if constraints.Equal(v.GetConstraints()) {
+					values[i] = newValue
+					return values, nil
+				}
+			}
+			return append(values, newValue), nil
+		},
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	adh.refreshDynamicConfig(ctx)
+	return &adminservice.SetDynamicConfigResponse{Version: version}, nil
+}
+
+// DeleteDynamicConfig deletes values of a dynamic config key. All values are deleted if no constraints are given.
+// The change history of the key is kept.
+func (adh *AdminHandler) DeleteDynamicConfig(
+	ctx context.Context,
+	request *adminservice.DeleteDynamicConfigRequest,
+) (_ *adminservice.DeleteDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetKey() == "" {
+		return nil, adh.error(errDynamicConfigKeyNotSet, scope)
+	}
+
+	version, err := adh.updateDynamicConfig(
+		request.GetKey(),
+		request.GetIdentity(),
+		request.GetReason(),
+		func(values []*persistencespb.DynamicConfigValue) ([]*persistencespb.DynamicConfigValue, error) {
+			var remaining []*persistencespb.DynamicConfigValue
+			if request.GetConstraints() != nil {
+				for _, v := range values {
+					if !request.GetConstraints().Equal(v.GetConstraints()) {
+						remaining = append(remaining, v)
+					}
+				}
+			}
+			if len(remaining) == len(values) {
+				return nil, errDynamicConfigValueNotFound
+			}
+			return remaining, nil
+		},
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	adh.refreshDynamicConfig(ctx)
+	return &adminservice.DeleteDynamicConfigResponse{Version: version}, nil
+}
+
+// RefreshDynamicConfig reloads dynamic config stored in persistence on this host.
+func (adh *AdminHandler) RefreshDynamicConfig(
+	_ context.Context,
+	request *adminservice.RefreshDynamicConfigRequest,
+) (_ *adminservice.RefreshDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminRefreshDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if refresher, ok := adh.dynamicConfigClient.(dynamicconfig.Refresher); ok {
+		if err := refresher.Refresh(); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	return &adminservice.RefreshDynamicConfigResponse{}, nil
+}
+
+// GetEffectiveDynamicConfig returns the values of dynamic config keys seen by this host for the given
+// namespace, task queue, shard and workflow type.
+func (adh *AdminHandler) GetEffectiveDynamicConfig(
+	_ context.Context,
+	request *adminservice.GetEffectiveDynamicConfigRequest,
+) (_ *adminservice.GetEffectiveDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminGetEffectiveDynamicConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	definitions := dynamicconfig.ListKeyDefinitions()
+	if request.GetKey() != "" {
+		definition, ok := dynamicconfig.GetKeyDefinition(dynamicconfig.Key(request.GetKey()))
+		if !ok {
+			return nil, adh.error(serviceerror.NewNotFound(fmt.Sprintf("Dynamic config key %s is not registered.", request.GetKey())), scope)
+		}
+		definitions = []*dynamicconfig.KeyDefinition{definition}
+	}
+
+	filters := make(map[dynamicconfig.Filter]interface{})
+	if request.GetNamespace() != "" {
+		namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		dynamicconfig.NamespaceFilter(request.GetNamespace())(filters)
+		dynamicconfig.NamespaceIDFilter(namespaceID.String())(filters)
+	}
+	if request.GetTaskQueue() != "" {
+		dynamicconfig.TaskQueueFilter(request.GetTaskQueue())(filters)
+	}
+	if request.GetTaskQueueType() != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		dynamicconfig.TaskTypeFilter(request.GetTaskQueueType())(filters)
+	}
+	if request.GetShardId() != 0 {
+		dynamicconfig.ShardIDFilter(request.GetShardId())(filters)
+	}
+	if request.GetWorkflowType() != "" {
+		dynamicconfig.WorkflowTypeFilter(request.GetWorkflowType())(filters)
+	}
+
+	values := make([]*adminservice.EffectiveDynamicConfigValue, 0, len(definitions))
+	for _, definition := range definitions {
+		value, isDefault := definition.EffectiveValue(adh.dynamicConfigClient, filters)
+		if duration, ok := value.(time.Duration); ok {
+			value = duration.String()
+		}
+		var data []byte
+		if value != nil {
+			var err error
+			if data, err = json.Marshal(value); err != nil {
+				return nil, adh.error(err, scope)
+			}
+		}
+		values = append(values, &adminservice.EffectiveDynamicConfigValue{
+			Key:         definition.Key.String(),
+			Type:        definition.Type.String(),
+			Precedence:  definition.Precedence.String(),
+			Description: definition.Description,
+			Value:       data,
+			IsDefault:   isDefault,
+		})
+	}
+	return &adminservice.GetEffectiveDynamicConfigResponse{Values: values}, nil
+}
+
+// ListShardLoad returns the load on history shards reported by all history hosts known to membership,
+// most loaded shards first.
+func (adh *AdminHandler) ListShardLoad(
+	ctx context.Context,
+	request *adminservice.ListShardLoadRequest,
+) (_ *adminservice.ListShardLoadResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+	scope, sw := adh.startRequestProfile(metrics.AdminListShardLoadScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	maxWorkflows := request.GetMaxWorkflowsPerShard()
+	if maxWorkflows <= 0 {
+		maxWorkflows = defaultShardLoadTopWorkflows
+	}
+
+	resolver, err := adh.membershipMonitor.GetResolver(common.HistoryServiceName)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	var (
+		wg          sync.WaitGroup
+		lock        sync.Mutex
+		shards      []*historyspb.ShardLoad
+		failedHosts []string
+	)
+	for _, host := range resolver.Members() {
+		address := host.GetAddress()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hostCtx, cancel := context.WithTimeout(ctx, shardLoadTimeout)
+			defer cancel()
+			resp, err := adh.historyClient.GetShardLoad(hostCtx, &historyservice.GetShardLoadRequest{
+				HostAddress:          address,
+				MaxWorkflowsPerShard: maxWorkflows,
+			})
+
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				adh.logger.Warn("Failed to get shard load from history host.", tag.Address(address), tag.Error(err))
+				failedHosts = append(failedHosts, address)
+				return
+			}
+			shards = append(shards, resp.GetShards()...)
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(shards, func(i, j int) bool {
+		if shards[i].GetRequestsPerSecond() != shards[j].GetRequestsPerSecond() {
+			return shards[i].GetRequestsPerSecond() > shards[j].GetRequestsPerSecond()
+		}
+		return shards[i].GetShardId() < shards[j].GetShardId()
+	})
+	if maxShards := int(request.GetMaxShards()); maxShards > 0 && maxShards < len(shards) {
+		shards = shards[:maxShards]
+	}
+	sort.Strings(failedHosts)
+	return &adminservice.ListShardLoadResponse{
+		Shards:      shards,
+		FailedHosts: failedHosts,
+	}, nil
+}
+
+// updateDynamicConfig applies the update to the values of a dynamic config key, records the change in
+// the history of the key and saves it. It returns the version of the saved entry.
+func (adh *AdminHandler) updateDynamicConfig(
+	key string,
+	identity string,
+	reason string,
+	update func([]*persistencespb.DynamicConfigValue) ([]*persistencespb.DynamicConfigValue, error),
+) (int64, error) {
+	key = strings.ToLower(key)
+	entry := &persistencespb.DynamicConfigEntry{Key: key}
+	var version int64
+	resp, err := adh.clusterMetadataManager.GetDynamicConfig(&persistence.GetDynamicConfigRequest{Key: key})
+	switch err.(type) {
+	case nil:
+		entry = resp.Entry
+		entry.Key = key
+		version = resp.Version
+	case *serviceerror.NotFound:
+	default:
+		return 0, err
+	}
+
+	values, err := update(entry.GetValues())
+	if err != nil {
+		return 0, err
+	}
+	entry.Values = values
+	entry.History = append([]*persistencespb.DynamicConfigChange{{
+		Version:    version + 1,
+		ChangeTime: timestamp.TimePtr(time.Now().UTC()),
+		Identity:   identity,
+		Reason:     reason,
+		Values:     values,
+	}}, entry.GetHistory()...)
+	if len(entry.History) > dynamicConfigHistorySize {
+		entry.History = entry.History[:dynamicConfigHistorySize]
+	}
+
+	applied, err := adh.clusterMetadataManager.SaveDynamicConfig(&persistence.SaveDynamicConfigRequest{
+		Entry:   entry,
+		Version: version,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !applied {
+		return 0, errDynamicConfigConcurrentUpdate
+	}
+	return version + 1, nil
+}
+
+// refreshDynamicConfig asks frontend, history, matching and worker hosts known to membership to reload dynamic config
+// stored in persistence. Hosts are called concurrently. Hosts which can't be reached pick up the change on their next poll.
+func (adh *AdminHandler) refreshDynamicConfig(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, role := range []string{
+		common.FrontendServiceName,
+		common.HistoryServiceName,
+		common.MatchingServiceName,
+		common.WorkerServiceName,
+	} {
+		resolver, err := adh.membershipMonitor.GetResolver(role)
+		if err != nil {
+			adh.logger.Warn("Failed to resolve hosts to refresh dynamic config.", tag.Service(role), tag.Error(err))
+			continue
+		}
+		for _, host := range resolver.Members() {
+			role, address := role, host.GetAddress()
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := adh.refreshHostDynamicConfig(ctx, role, address); err != nil {
+					adh.logger.Warn("Failed to refresh dynamic config on host.", tag.Service(role), tag.Address(address), tag.Error(err))
+				}
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+func (adh *AdminHandler) refreshHostDynamicConfig(ctx context.Context, role string, address string) error {
+	ctx, cancel := context.WithTimeout(ctx, dynamicConfigRefreshTimeout)
+	defer cancel()
+
+	var err error
+	switch role {
+	case common.FrontendServiceName:
+		_, err = adh.frontendAdminClient(address).RefreshDynamicConfig(ctx, &adminservice.RefreshDynamicConfigRequest{})
+	case common.HistoryServiceName:
+		_, err = adh.historyClient.RefreshDynamicConfig(ctx, &historyservice.RefreshDynamicConfigRequest{HostAddress: address})
+	case common.MatchingServiceName:
+		_, err = adh.matchingClient.RefreshDynamicConfig(ctx, &matchingservice.RefreshDynamicConfigRequest{HostAddress: address})
+	case common.WorkerServiceName:
+		_, err = adh.workerClient(address).RefreshDynamicConfig(ctx, &workerservice.RefreshDynamicConfigRequest{})
+	}
+	return err
+}
+
+func (adh *AdminHandler) frontendAdminClient(address string) adminservice.AdminServiceClient {
+	adh.hostClientsLock.Lock()
+	defer adh.hostClientsLock.Unlock()
+
+	client, ok := adh.frontendAdminClients[address]
+	if !ok {
+		client = adh.clientFactory.NewAdminClientWithTimeout(address, admin.DefaultTimeout, admin.DefaultLargeTimeout)
+		adh.frontendAdminClients[address] = client
+	}
+	return client
+}
+
+func (adh *AdminHandler) workerClient(address string) workerservice.WorkerServiceClient {
+	adh.hostClientsLock.Lock()
+	defer adh.hostClientsLock.Unlock()
+
+	client, ok := adh.workerClients[address]
+	if !ok {
+		client = adh.clientFactory.NewWorkerClient(address)
+		adh.workerClients[address] = client
+	}
+	return client
+}
+
+// describeBatchOperation describes the batch workflow of the job and returns NotFound
+// if the job does not belong to the namespace.
+func (adh *AdminHandler) describeBatchOperation(
+	ctx context.Context,
+	namespaceName string,
+	jobID string,
+) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+	if namespaceName == "" {
+		return nil, errNamespaceNotSet
+	}
+	resp, err := adh.sdkClient.DescribeWorkflowExecution(ctx, jobID, "")
+	if err != nil {
+		return nil, err
+	}
+	var jobNamespace string
+	indexedFields := resp.GetWorkflowExecutionInfo().GetSearchAttributes().GetIndexedFields()
+	if err := payload.Decode(indexedFields[searchattribute.BatcherNamespace], &jobNamespace); err != nil || jobNamespace != namespaceName {
+		return nil, errBatchOperationNotFound
+	}
+	return resp, nil
+}
+
+func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
+	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
+) error {
+
+	execution := request.Execution
+	if execution.GetWorkflowId() == "" {
+		return errWorkflowIDNotSet
+	}
+	// TODO currently, this API is only going to be used by re-send history events
+	// to remote cluster if kafka is lossy again, in the future, this API can be used
+	// by CLI and client, then empty runID (meaning the current workflow) should be allowed
+	if execution.GetRunId() == "" || uuid.Parse(execution.GetRunId()) == nil {
+		return errInvalidRunID
+	}
+
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 {
+		return errInvalidPageSize
+	}
+
+	if request.GetStartEventId() == common.EmptyEventID &&
+		request.GetStartEventVersion() == common.EmptyVersion &&
+		request.GetEndEventId() == common.EmptyEventID &&
+		request.GetEndEventVersion() == common.EmptyVersion {
+		return errInvalidEventQueryRange
+	}
+
+	if (request.GetStartEventId() != common.EmptyEventID && request.GetStartEventVersion() == common.EmptyVersion) ||
+		(request.GetStartEventId() == common.EmptyEventID && request.GetStartEventVersion() != common.EmptyVersion) {
+		return errInvalidStartEventCombination
+	}
+
+	return nil
+}
+
+func (adh *AdminHandler) validateRemoteClusterMetadata(metadata *adminservice.DescribeClusterResponse) error {
+	// Verify remote cluster config
+	currentClusterInfo := adh.clusterMetadata
+	if metadata.GetClusterName() == currentClusterInfo.GetCurrentClusterName() {
+		// cluster name conflict
+		return serviceerror.NewInvalidArgument("Cannot update current cluster metadata from rpc calls")
+	}
+	if metadata.GetFailoverVersionIncrement() != currentClusterInfo.GetFailoverVersionIncrement() {
+		// failover version increment is mismatch with current cluster config
+		return serviceerror.NewInvalidArgument("Cannot add remote cluster due to failover version increment mismatch")
+	}
+	if adh.config.NumHistoryShards != metadata.GetHistoryShardCount() {
+		// workflows can only be replicated between clusters if the shards of one cluster map to shards of the other
+		if err := common.VerifyShardCountCompatibility(adh.config.NumHistoryShards, metadata.GetHistoryShardCount()); err != nil {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("Cannot add remote cluster due to history shard number mismatch: %v", err))
+		}
+	}
+	if !metadata.IsGlobalNamespaceEnabled {
+		// remote cluster doesn't support global namespace
+		return serviceerror.NewInvalidArgument("Cannot add remote cluster as global namespace is not supported")
+	}
+	for clusterName, cluster := range currentClusterInfo.GetAllClusterInfo() {
+		if clusterName != metadata.ClusterName && cluster.InitialFailoverVersion == metadata.GetInitialFailoverVersion() {
+			// initial failover version conflict
+			// best effort: race condition if a concurrent write to db with the same version.
+			return serviceerror.NewInvalidArgument("Cannot add remote cluster due to initial failover version conflict")
+		}
+	}
+	return nil
+}
+
+func (adh *AdminHandler) setRequestDefaultValueAndGetTargetVersionHistory(
+	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
+	versionHistories *historyspb.VersionHistories,
+) (*historyspb.VersionHistory, error) {
+
+	targetBranch, err := versionhistory.GetCurrentVersionHistory(versionHistories)
+	if err != nil {
+		return nil, err
+	}
+	firstItem, err := versionhistory.GetFirstVersionHistoryItem(targetBranch)
+	if err != nil {
+		return nil, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(targetBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetStartEventId() == common.EmptyVersion || request.GetStartEventVersion() == common.EmptyVersion {
+		// If start event is not set, get the events from the first event
+		// As the API is exclusive-exclusive, use first event id - 1 here
+		request.StartEventId = common.FirstEventID - 1
+		request.StartEventVersion = firstItem.GetVersion()
+	}
+	if request.GetEndEventId() == common.EmptyEventID || request.GetEndEventVersion() == common.EmptyVersion {
+		// If end event is not set, get the events until the end event
+		// As the API is exclusive-exclusive, use end event id + 1 here
+		request.EndEventId = lastItem.GetEventId() + 1
+		request.EndEventVersion = lastItem.GetVersion()
+	}
+
+	if request.GetStartEventId() < 0 {
+		return nil, errInvalidFirstNextEventCombination
+	}
+
+	// get branch based on the end event if end event is defined in the request
+	if request.GetEndEventId() == lastItem.GetEventId()+1 &&
+		request.GetEndEventVersion() == lastItem.GetVersion() {
+		// this is a special case, target branch remains the same
+	} else {
+		endItem := versionhistory.NewVersionHistoryItem(request.GetEndEventId(), request.GetEndEventVersion())
+		idx, err := versionhistory.FindFirstVersionHistoryIndexByVersionHistoryItem(versionHistories, endItem)
+		if err != nil {
+			return nil, err
+		}
+
+		targetBranch, err = versionhistory.GetVersionHistory(versionHistories, idx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	startItem := versionhistory.NewVersionHistoryItem(request.GetStartEventId(), request.GetStartEventVersion())
+	// If the request start event is defined. The start event may be on a different branch as current branch.
+	// We need to find the LCA of the start event and the current branch.
+	if request.GetStartEventId() == common.FirstEventID-1 &&
+		request.GetStartEventVersion() == firstItem.GetVersion() {
+		// this is a special case, start event is on the same branch as target branch
+	} else {
+		if !versionhistory.ContainsVersionHistoryItem(targetBranch, startItem) {
+			idx, err := versionhistory.FindFirstVersionHistoryIndexByVersionHistoryItem(versionHistories, startItem)
+			if err != nil {
+				return nil, err
+			}
+			startBranch, err := versionhistory.GetVersionHistory(versionHistories, idx)
+			if err != nil {
+				return nil, err
+			}
+			startItem, err = versionhistory.FindLCAVersionHistoryItem(targetBranch, startBranch)
+			if err != nil {
+				return nil, err
+			}
+			request.StartEventId = startItem.GetEventId()
+			request.StartEventVersion = startItem.GetVersion()
+		}
+	}
+
+	return targetBranch, nil
+}
+
+// startRequestProfile initiates recording of request metrics
+func (adh *AdminHandler) startRequestProfile(scope int) (metrics.Scope, metrics.Stopwatch) {
+	metricsScope := adh.metricsClient.Scope(scope)
+	sw := metricsScope.StartTimer(metrics.ServiceLatency)
+	metricsScope.IncCounter(metrics.ServiceRequests)
+	return metricsScope, sw
+}
+
+func (adh *AdminHandler) error(err error, scope metrics.Scope) error {
+	switch err := err.(type) {
+	case *serviceerror.Unavailable:
+		adh.logger.Error("unavailable error", tag.Error(err))
+		scope.IncCounter(metrics.ServiceFailures)
+		return err
+	case *serviceerror.InvalidArgument:
+		scope.IncCounter(metrics.ServiceErrInvalidArgumentCounter)
+		return err
+	case *serviceerror.ResourceExhausted:
+		scope.Tagged(metrics.ResourceExhaustedCauseTag(err.Cause)).IncCounter(metrics.ServiceErrResourceExhaustedCounter)
+		return err
+	case *serviceerror.NotFound:
+		return err
+	}
+
+	adh.logger.Error("Unknown error", tag.Error(err))
+	scope.IncCounter(metrics.ServiceFailures)
+
 	return err
 }
 
+var (
+	queryValueEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+	batchTypes = map[enumsspb.BatchOperationType]string{
+		enumsspb.BATCH_OPERATION_TYPE_TERMINATE: batcher.BatchTypeTerminate,
+		enumsspb.BATCH_OPERATION_TYPE_CANCEL:    batcher.BatchTypeCancel,
+		enumsspb.BATCH_OPERATION_TYPE_SIGNAL:    batcher.BatchTypeSignal,
+		enumsspb.BATCH_OPERATION_TYPE_DELETE:    batcher.BatchTypeDelete,
+		enumsspb.BATCH_OPERATION_TYPE_RESET:     batcher.BatchTypeReset,
+	}
+
+	batchResetTypes = map[enumsspb.BatchResetType]string{
+		enumsspb.BATCH_RESET_TYPE_FIRST_WORKFLOW_TASK:   batcher.ResetTypeFirstWorkflowTask,
+		enumsspb.BATCH_RESET_TYPE_LAST_WORKFLOW_TASK:    batcher.ResetTypeLastWorkflowTask,
+		enumsspb.BATCH_RESET_TYPE_LAST_CONTINUED_AS_NEW: batcher.ResetTypeLastContinuedAsNew,
+		enumsspb.BATCH_RESET_TYPE_BAD_BINARY:            batcher.ResetTypeBadBinary,
+	}
+)
+
+func batchOperationInfo(execution *workflowpb.WorkflowExecutionInfo) (*batchspb.BatchOperationInfo, error) {
+	info := &batchspb.BatchOperationInfo{
+		JobId:     execution.GetExecution().GetWorkflowId(),
+		StartTime: execution.GetStartTime(),
+		CloseTime: execution.GetCloseTime(),
+	}
+	switch execution.GetStatus() {
+	case enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING:
+		info.State = enumsspb.BATCH_OPERATION_STATE_RUNNING
+	case enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+		info.State = enumsspb.BATCH_OPERATION_STATE_COMPLETED
+	default:
+		info.State = enumsspb.BATCH_OPERATION_STATE_FAILED
+	}
+
+	memo := execution.GetMemo().GetFields()
+	if err := payload.Decode(memo[batcher.MemoReason], &info.Reason); err != nil {
+		return nil, err
+	}
+	// jobs started by older versions of tctl don't have the batch type memo
+	if p, ok := memo[batcher.MemoBatchType]; ok {
+		var batchType string
+		if err := payload.Decode(p, &batchType); err != nil {
+			return nil, err
+		}
+		for operationType, t := range batchTypes {
+			if t == batchType {
+				info.OperationType = operationType
+			}
+		}
+	}
+	if p, ok := execution.GetSearchAttributes().GetIndexedFields()[searchattribute.BatcherUser]; ok {
+		if err := payload.Decode(p, &info.Identity); err != nil {
+			return nil, err
+		}
+	}
+	return info, nil
+}
+
 func getTaskType(task tasks.Task) (enumsspb.TaskType, error) {
 	var taskType enumsspb.TaskType
 	switch task := task.(type) {
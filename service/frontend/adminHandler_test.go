@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"go.temporal.io/server/api/adminservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -43,24 +44,39 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
 	sdkmocks "go.temporal.io/sdk/mocks"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/api/workerservice/v1"
+	"go.temporal.io/server/api/workerservicemock/v1"
 	clientmocks "go.temporal.io/server/client"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/worker/deletenamespace"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 type (
@@ -71,6 +87,7 @@ type (
 		controller          *gomock.Controller
 		mockResource        *resource.Test
 		mockHistoryClient   *historyservicemock.MockHistoryServiceClient
+		mockMatchingClient  *matchingservicemock.MockMatchingServiceClient
 		mockSdkSystemClient *sdkmocks.Client
 		mockNamespaceCache  *namespace.MockRegistry
 
@@ -104,6 +121,7 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockResource = resource.NewTest(s.controller, metrics.Frontend)
 	s.mockNamespaceCache = s.mockResource.NamespaceCache
 	s.mockHistoryClient = s.mockResource.HistoryClient
+	s.mockMatchingClient = s.mockResource.MatchingClient
 	s.mockExecutionMgr = s.mockResource.ExecutionMgr
 	s.mockClusterMetadataManager = s.mockResource.ClusterMetadataMgr
 	s.mockClientFactory = s.mockResource.ClientFactory
@@ -136,6 +154,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetClientFactory(),
 		s.mockResource.GetClientBean(),
 		s.mockResource.GetHistoryClient(),
+		s.mockResource.GetMatchingClient(),
 		s.mockSdkSystemClient,
 		s.mockResource.GetMembershipMonitor(),
 		s.mockResource.GetArchiverProvider(),
@@ -145,6 +164,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetSearchAttributesManager(),
 		s.mockMetadata,
 		s.mockResource.GetArchivalMetadata(),
+		dynamicconfig.NewNoopClient(),
 	}
 	s.handler = NewAdminHandler(args)
 	s.handler.Start()
@@ -573,6 +593,92 @@ func (s *adminHandlerSuite) Test_AddSearchAttributes() {
 	s.mockSdkSystemClient.AssertExpectations(s.T())
 }
 
+func (s *adminHandlerSuite) Test_DeleteNamespace() {
+	handler := s.handler
+	ctx := context.Background()
+
+	type test struct {
+		Name     string
+		Request  *adminservice.DeleteNamespaceRequest
+		Expected error
+	}
+	// request validation tests
+	testCases := []test{
+		{
+			Name:     "nil request",
+			Request:  nil,
+			Expected: &serviceerror.InvalidArgument{Message: "Request is nil."},
+		},
+		{
+			Name:     "empty namespace",
+			Request:  &adminservice.DeleteNamespaceRequest{},
+			Expected: &serviceerror.InvalidArgument{Message: "Namespace is not set on request."},
+		},
+		{
+			Name: "system namespace",
+			Request: &adminservice.DeleteNamespaceRequest{
+				Namespace: common.SystemLocalNamespace,
+			},
+			Expected: &serviceerror.InvalidArgument{Message: "System namespace can't be deleted."},
+		},
+	}
+	for _, testCase := range testCases {
+		s.T().Run(testCase.Name, func(t *testing.T) {
+			resp, err := handler.DeleteNamespace(ctx, testCase.Request)
+			s.Equal(testCase.Expected, err)
+			s.Nil(resp)
+		})
+	}
+
+	// Global namespace.
+	s.mockNamespaceCache.EXPECT().GetNamespace(namespace.Name("global-namespace")).Return(
+		namespace.NewGlobalNamespaceForTest(
+			&persistencespb.NamespaceInfo{Id: uuid.New(), Name: "global-namespace"},
+			nil,
+			&persistencespb.NamespaceReplicationConfig{ActiveClusterName: "active"},
+			1,
+		), nil)
+	resp, err := handler.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{Namespace: "global-namespace"})
+	s.Equal(&serviceerror.InvalidArgument{Message: "Global namespace can't be deleted."}, err)
+	s.Nil(resp)
+
+	localNamespace := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+		nil,
+		"active",
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(localNamespace, nil).AnyTimes()
+
+	// Workflow failed to start.
+	s.mockSdkSystemClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, "temporal-sys-delete-namespace-workflow", mock.Anything).Return(nil, errors.New("start failed")).Once()
+	resp, err = handler.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{Namespace: s.namespace.String()})
+	s.Error(err)
+	s.Equal("Unable to start temporal-sys-delete-namespace-workflow workflow: start failed.", err.Error())
+	s.Nil(resp)
+
+	// Workflow failed.
+	mockRun := &sdkmocks.WorkflowRun{}
+	mockRun.On("Get", mock.Anything, mock.Anything).Return(errors.New("workflow failed")).Once()
+	s.mockSdkSystemClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, "temporal-sys-delete-namespace-workflow", mock.Anything).Return(mockRun, nil)
+	resp, err = handler.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{Namespace: s.namespace.String()})
+	s.Error(err)
+	s.Equal("Workflow temporal-sys-delete-namespace-workflow returned an error: workflow failed.", err.Error())
+	s.Nil(resp)
+
+	// Success case.
+	mockRun.On("Get", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		result := args.Get(1).(*deletenamespace.DeleteNamespaceWorkflowResult)
+		result.DeletedNamespace = "deleted-namespace"
+		result.ReclaimResourcesWorkflowID = "reclaim-workflow-id"
+	})
+	resp, err = handler.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{Namespace: s.namespace.String()})
+	s.NoError(err)
+	s.Equal("deleted-namespace", resp.GetDeletedNamespace())
+	s.Equal("reclaim-workflow-id", resp.GetReclaimResourcesWorkflowId())
+	mockRun.AssertExpectations(s.T())
+	s.mockSdkSystemClient.AssertExpectations(s.T())
+}
+
 func (s *adminHandlerSuite) Test_GetSearchAttributes() {
 	handler := s.handler
 	ctx := context.Background()
@@ -732,6 +838,204 @@ func (s *adminHandlerSuite) Test_RemoveSearchAttributes() {
 	s.NotNil(resp)
 }
 
+func (s *adminHandlerSuite) Test_DeleteWorkflowExecution_FailedOnInvalidWorkflowID() {
+	_, err := s.handler.DeleteWorkflowExecution(context.Background(), &adminservice.DeleteWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "",
+			RunId:      uuid.New(),
+		},
+	})
+	s.Error(err)
+}
+
+func (s *adminHandlerSuite) Test_DeleteWorkflowExecution() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "workflowID",
+		RunId:      uuid.New(),
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId:       s.namespaceID.String(),
+		WorkflowExecution: execution,
+	}).Return(&historyservice.DeleteWorkflowExecutionResponse{}, nil)
+
+	_, err := s.handler.DeleteWorkflowExecution(context.Background(), &adminservice.DeleteWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_DeleteWorkflowExecution_Force() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "workflowID",
+		RunId:      uuid.New(),
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	gomock.InOrder(
+		s.mockHistoryClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(nil, serviceerror.NewNotFound("workflow execution already completed")),
+		s.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).
+			Return(&historyservice.DeleteWorkflowExecutionResponse{}, nil),
+	)
+
+	_, err := s.handler.DeleteWorkflowExecution(context.Background(), &adminservice.DeleteWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+		Force:     true,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_DeleteWorkflowExecution_Running() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewNotFound("workflow execution is not completed"))
+
+	_, err := s.handler.DeleteWorkflowExecution(context.Background(), &adminservice.DeleteWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID",
+		},
+	})
+	s.Error(err)
+}
+
+func (s *adminHandlerSuite) Test_StartBatchOperation_FailedOnInvalidTarget() {
+	ctx := context.Background()
+	_, err := s.handler.StartBatchOperation(ctx, &adminservice.StartBatchOperationRequest{
+		Namespace:     s.namespace.String(),
+		Reason:        "test",
+		OperationType: enumsspb.BATCH_OPERATION_TYPE_TERMINATE,
+	})
+	s.Equal(errBatchTargetNotSet, err)
+
+	_, err = s.handler.StartBatchOperation(ctx, &adminservice.StartBatchOperationRequest{
+		Namespace:       s.namespace.String(),
+		Reason:          "test",
+		OperationType:   enumsspb.BATCH_OPERATION_TYPE_TERMINATE,
+		VisibilityQuery: "WorkflowType='foo'",
+		Executions:      []*commonpb.WorkflowExecution{{WorkflowId: "workflowID"}},
+	})
+	s.Equal(errBatchTargetNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_StartBatchOperation_FailedOnUnsupportedType() {
+	_, err := s.handler.StartBatchOperation(context.Background(), &adminservice.StartBatchOperationRequest{
+		Namespace:       s.namespace.String(),
+		Reason:          "test",
+		VisibilityQuery: "WorkflowType='foo'",
+	})
+	s.Equal(errBatchOperationTypeNotSupported, err)
+}
+
+func (s *adminHandlerSuite) Test_ListBatchOperations_FailedOnUnknownNamespace() {
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(nil, serviceerror.NewNotFound("namespace not found"))
+
+	_, err := s.handler.ListBatchOperations(context.Background(), &adminservice.ListBatchOperationsRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+	s.mockSdkSystemClient.AssertNotCalled(s.T(), "ListWorkflow")
+}
+
+func (s *adminHandlerSuite) Test_ListBatchOperations_EscapesNamespace() {
+	namespaceName := namespace.Name(`ns' or BatcherNamespace != '\`)
+	s.mockNamespaceCache.EXPECT().GetNamespace(namespaceName).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: namespaceName.String()},
+		nil,
+		"active",
+	), nil)
+	s.mockSdkSystemClient.On("ListWorkflow", mock.Anything, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: common.SystemLocalNamespace,
+		PageSize:  10,
+		Query:     `BatcherNamespace = 'ns\' or BatcherNamespace != \'\\'`,
+	}).Return(&workflowservice.ListWorkflowExecutionsResponse{}, nil)
+
+	resp, err := s.handler.ListBatchOperations(context.Background(), &adminservice.ListBatchOperationsRequest{
+		Namespace: namespaceName.String(),
+		PageSize:  10,
+	})
+	s.NoError(err)
+	s.Empty(resp.GetOperations())
+	s.mockSdkSystemClient.AssertExpectations(s.T())
+}
+
+func (s *adminHandlerSuite) Test_CreateSchedule_FailedOnUnknownNamespace() {
+	schedulePayload, err := payload.Encode(scheduler.Schedule{})
+	s.NoError(err)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(nil, serviceerror.NewNotFound("namespace not found"))
+
+	_, err = s.handler.CreateSchedule(context.Background(), &adminservice.CreateScheduleRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "schedule",
+		Schedule:   schedulePayload,
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+	s.mockSdkSystemClient.AssertNotCalled(s.T(), "ExecuteWorkflow")
+}
+
+func (s *adminHandlerSuite) Test_CreateSchedule_Success() {
+	schedule := scheduler.Schedule{
+		Spec: scheduler.ScheduleSpec{
+			Interval: []scheduler.IntervalSpec{{Interval: time.Hour}},
+		},
+		Action: scheduler.StartWorkflowAction{
+			WorkflowID:   "workflow",
+			WorkflowType: "workflow-type",
+			TaskQueue:    "task-queue",
+		},
+	}
+	schedulePayload, err := payload.Encode(schedule)
+	s.NoError(err)
+	localNamespace := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+		nil,
+		"active",
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(localNamespace, nil)
+	s.mockSdkSystemClient.On(
+		"ExecuteWorkflow",
+		mock.Anything,
+		mock.MatchedBy(func(options sdkclient.StartWorkflowOptions) bool {
+			return options.ID == scheduler.WorkflowID(s.namespace.String(), "schedule") && options.TaskQueue == scheduler.TaskQueueName
+		}),
+		scheduler.WorkflowType,
+		mock.MatchedBy(func(args *scheduler.StartScheduleArgs) bool {
+			return args.Namespace == s.namespace.String() && args.ScheduleID == "schedule" && args.Schedule.Action.WorkflowID == "workflow"
+		}),
+	).Return(&sdkmocks.WorkflowRun{}, nil).Once()
+
+	_, err = s.handler.CreateSchedule(context.Background(), &adminservice.CreateScheduleRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "schedule",
+		Schedule:   schedulePayload,
+	})
+	s.NoError(err)
+	s.mockSdkSystemClient.AssertExpectations(s.T())
+}
+
+func (s *adminHandlerSuite) Test_PatchSchedule_FailedOnInvalidPatch() {
+	patchPayload, err := payload.Encode(scheduler.SchedulePatch{
+		TriggerImmediately: &scheduler.TriggerImmediatelyRequest{OverlapPolicy: "unknown"},
+	})
+	s.NoError(err)
+	localNamespace := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+		nil,
+		"active",
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(localNamespace, nil)
+
+	_, err = s.handler.PatchSchedule(context.Background(), &adminservice.PatchScheduleRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "schedule",
+		Patch:      patchPayload,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
 func (s *adminHandlerSuite) Test_RemoveRemoteCluster_Success() {
 	var clusterName = "cluster"
 	s.mockClusterMetadataManager.EXPECT().DeleteClusterMetadata(
@@ -885,6 +1189,8 @@ func (s *adminHandlerSuite) Test_AddOrUpdateRemoteCluster_ValidationError_ShardC
 	var clusterName = uuid.New()
 	var clusterId = uuid.New()
 
+	// shard counts are allowed to differ only if one is a multiple of the other
+	s.handler.config.NumHistoryShards = 4
 	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(uuid.New())
 	s.mockMetadata.EXPECT().GetFailoverVersionIncrement().Return(int64(0))
 	s.mockClientFactory.EXPECT().NewAdminClientWithTimeout(rpcAddress, gomock.Any(), gomock.Any()).Return(
@@ -894,7 +1200,7 @@ func (s *adminHandlerSuite) Test_AddOrUpdateRemoteCluster_ValidationError_ShardC
 		&adminservice.DescribeClusterResponse{
 			ClusterId:                clusterId,
 			ClusterName:              clusterName,
-			HistoryShardCount:        1000,
+			HistoryShardCount:        6,
 			FailoverVersionIncrement: 0,
 			InitialFailoverVersion:   0,
 			IsGlobalNamespaceEnabled: true,
@@ -904,6 +1210,48 @@ func (s *adminHandlerSuite) Test_AddOrUpdateRemoteCluster_ValidationError_ShardC
 	s.IsType(&serviceerror.InvalidArgument{}, err)
 }
 
+func (s *adminHandlerSuite) Test_GetReplicationMessages_ShardCountMigration() {
+	s.handler.config.NumHistoryShards = 4
+	s.mockMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		"new-cluster": {Enabled: true, ShardCount: 8},
+		"old-cluster": {Enabled: true, ShardCount: 2},
+	}).Times(2)
+	tokens := []*replicationspb.ReplicationToken{{ShardId: 7}}
+
+	// cluster with more shards polls with its own shard ids
+	s.mockHistoryClient.EXPECT().GetReplicationMessages(gomock.Any(), &historyservice.GetReplicationMessagesRequest{
+		Tokens:            tokens,
+		ClusterName:       "new-cluster",
+		ClusterShardCount: 8,
+	}).Return(&historyservice.GetReplicationMessagesResponse{}, nil)
+	_, err := s.handler.GetReplicationMessages(context.Background(), &adminservice.GetReplicationMessagesRequest{
+		Tokens:      tokens,
+		ClusterName: "new-cluster",
+	})
+	s.NoError(err)
+
+	// cluster with fewer shards does not replicate from this cluster
+	resp, err := s.handler.GetReplicationMessages(context.Background(), &adminservice.GetReplicationMessagesRequest{
+		Tokens:      tokens,
+		ClusterName: "old-cluster",
+	})
+	s.NoError(err)
+	s.Empty(resp.GetShardMessages())
+}
+
+func (s *adminHandlerSuite) Test_GetReplicationMessages_ShardCountMismatch() {
+	s.handler.config.NumHistoryShards = 4
+	s.mockMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		"other-cluster": {Enabled: true, ShardCount: 6},
+	})
+
+	_, err := s.handler.GetReplicationMessages(context.Background(), &adminservice.GetReplicationMessagesRequest{
+		Tokens:      []*replicationspb.ReplicationToken{{ShardId: 5}},
+		ClusterName: "other-cluster",
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
 func (s *adminHandlerSuite) Test_AddOrUpdateRemoteCluster_ValidationError_GlobalNamespaceDisabled() {
 	var rpcAddress = uuid.New()
 	var clusterName = uuid.New()
@@ -1177,3 +1525,328 @@ func (s *adminHandlerSuite) Test_ListClusters_Success() {
 	s.Equal(1, len(resp.Clusters))
 	s.Equal(0, len(resp.GetNextPageToken()))
 }
+
+func (s *adminHandlerSuite) Test_UpdateWorkerBuildIdOrdering_FailedOnMissingBuildID() {
+	_, err := s.handler.UpdateWorkerBuildIdOrdering(context.Background(), &adminservice.UpdateWorkerBuildIdOrderingRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "taskQueue",
+	})
+	s.Equal(errBuildIDNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_UpdateWorkerBuildIdOrdering() {
+	versioningData := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{{BuildIds: []string{"1.0", "1.1"}}},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockMatchingClient.EXPECT().UpdateWorkerBuildIdOrdering(gomock.Any(), &matchingservice.UpdateWorkerBuildIdOrderingRequest{
+		NamespaceId:       s.namespaceID.String(),
+		TaskQueue:         "taskQueue",
+		BuildId:           "1.1",
+		CompatibleBuildId: "1.0",
+		BecomeDefault:     true,
+	}).Return(&matchingservice.UpdateWorkerBuildIdOrderingResponse{VersioningData: versioningData}, nil)
+
+	resp, err := s.handler.UpdateWorkerBuildIdOrdering(context.Background(), &adminservice.UpdateWorkerBuildIdOrderingRequest{
+		Namespace:         s.namespace.String(),
+		TaskQueue:         "taskQueue",
+		BuildId:           "1.1",
+		CompatibleBuildId: "1.0",
+		BecomeDefault:     true,
+	})
+	s.NoError(err)
+	s.Equal(versioningData, resp.GetVersioningData())
+}
+
+func (s *adminHandlerSuite) Test_DescribeTaskQueueStats() {
+	partitions := []*taskqueuepb.TaskQueuePartitionMetadata{
+		{Key: "taskQueue", OwnerHostName: "host1"},
+		{Key: "/_sys/taskQueue/1", OwnerHostName: "host2"},
+	}
+	partitionStats := map[string]*taskqueuespb.TaskQueueStats{
+		"taskQueue": {
+			ApproximateBacklogCount: 10,
+			ApproximateBacklogAge:   timestamp.DurationPtr(time.Minute),
+			TasksAddRate:            2,
+			TasksDispatchRate:       3,
+			SyncMatchRatio:          1,
+		},
+		"/_sys/taskQueue/1": {
+			ApproximateBacklogCount: 5,
+			ApproximateBacklogAge:   timestamp.DurationPtr(time.Hour),
+			TasksAddRate:            2,
+			TasksDispatchRate:       1,
+			SyncMatchRatio:          0,
+		},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockMatchingClient.EXPECT().ListTaskQueuePartitions(gomock.Any(), &matchingservice.ListTaskQueuePartitionsRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: &taskqueuepb.TaskQueue{Name: "taskQueue", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+	}).Return(&matchingservice.ListTaskQueuePartitionsResponse{ActivityTaskQueuePartitions: partitions}, nil)
+	s.mockMatchingClient.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.DescribeTaskQueueRequest, _ ...interface{}) (*matchingservice.DescribeTaskQueueResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.GetDescRequest().GetTaskQueueType())
+			s.True(request.GetDescRequest().GetIncludeTaskQueueStatus())
+			return &matchingservice.DescribeTaskQueueResponse{
+				Stats: partitionStats[request.GetDescRequest().GetTaskQueue().GetName()],
+			}, nil
+		}).Times(2)
+
+	resp, err := s.handler.DescribeTaskQueueStats(context.Background(), &adminservice.DescribeTaskQueueStatsRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "taskQueue",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+	s.NoError(err)
+	s.Len(resp.GetPartitions(), 2)
+	s.Equal("host2", resp.GetPartitions()[1].GetOwnerHostName())
+	s.Equal(partitionStats["/_sys/taskQueue/1"], resp.GetPartitions()[1].GetStats())
+	s.Equal(&taskqueuespb.TaskQueueStats{
+		ApproximateBacklogCount: 15,
+		ApproximateBacklogAge:   timestamp.DurationPtr(time.Hour),
+		TasksAddRate:            4,
+		TasksDispatchRate:       4,
+		SyncMatchRatio:          0.75,
+	}, resp.GetStats())
+}
+
+func (s *adminHandlerSuite) Test_UpdateWorkflowExecution_FailedOnMissingName() {
+	_, err := s.handler.UpdateWorkflowExecution(context.Background(), &adminservice.UpdateWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "workflowID"},
+	})
+	s.Equal(errUpdateNameNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_UpdateWorkflowExecution() {
+	result := payloads.EncodeString("result")
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.UpdateWorkflowExecutionRequest, _ ...interface{}) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal("update", request.GetRequest().GetName())
+			// update id is generated if not set, so that history can deduplicate retries
+			s.NotEmpty(request.GetRequest().GetUpdateId())
+			return &historyservice.UpdateWorkflowExecutionResponse{
+				Response: &adminservice.UpdateWorkflowExecutionResponse{
+					UpdateId: request.GetRequest().GetUpdateId(),
+					Result:   result,
+				},
+			}, nil
+		})
+
+	resp, err := s.handler.UpdateWorkflowExecution(context.Background(), &adminservice.UpdateWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "workflowID"},
+		Name:      "update",
+	})
+	s.NoError(err)
+	s.NotEmpty(resp.GetUpdateId())
+	s.False(resp.GetRejected())
+	s.Equal(result, resp.GetResult())
+}
+
+func (s *adminHandlerSuite) Test_SetDynamicConfig_FailedOnInvalidValue() {
+	_, err := s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Key:   "frontend.namespacerps",
+		Value: []byte("{not json"),
+	})
+	s.Equal(errInvalidDynamicConfigValue, err)
+}
+
+func (s *adminHandlerSuite) Test_SetDynamicConfig_FailedOnValidation() {
+	_, err := s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Key:   "frontend.unknownKey",
+		Value: []byte("100"),
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Key:   "frontend.namespacerps",
+		Value: []byte(`"100"`),
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Key:         "frontend.namespacerps",
+		Value:       []byte("100"),
+		Constraints: &persistencespb.DynamicConfigConstraints{TaskQueueName: "tq"},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *adminHandlerSuite) Test_SetDynamicConfig() {
+	// entries saved before keys were normalized may be in mixed case
+	existing := &persistencespb.DynamicConfigEntry{
+		Key: "Frontend.NamespaceRPS",
+		Values: []*persistencespb.DynamicConfigValue{
+			{Value: []byte("100"), Constraints: &persistencespb.DynamicConfigConstraints{}},
+			{Value: []byte("10"), Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "ns"}},
+		},
+	}
+	s.mockClusterMetadataManager.EXPECT().GetDynamicConfig(&persistence.GetDynamicConfigRequest{Key: "frontend.namespacerps"}).Return(
+		&persistence.GetDynamicConfigResponse{Entry: existing, Version: 3}, nil)
+	s.mockClusterMetadataManager.EXPECT().SaveDynamicConfig(gomock.Any()).DoAndReturn(
+		func(request *persistence.SaveDynamicConfigRequest) (bool, error) {
+			s.Equal(int64(3), request.Version)
+			s.Equal("frontend.namespacerps", request.Entry.Key)
+			s.Len(request.Entry.Values, 2)
+			s.Equal([]byte("100"), request.Entry.Values[0].Value)
+			s.Equal([]byte("20"), request.Entry.Values[1].Value)
+			s.Len(request.Entry.History, 1)
+			s.Equal(int64(4), request.Entry.History[0].Version)
+			s.Equal("operator", request.Entry.History[0].Identity)
+			s.Equal("load test", request.Entry.History[0].Reason)
+			return true, nil
+		})
+	s.mockResource.FrontendServiceResolver.EXPECT().Members().Return(nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{membership.NewHostInfo("history:7234", nil)})
+	s.mockResource.MatchingServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{membership.NewHostInfo("matching:7235", nil)})
+	s.mockResource.WorkerServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{membership.NewHostInfo("worker:7239", nil)})
+	s.mockHistoryClient.EXPECT().RefreshDynamicConfig(gomock.Any(), &historyservice.RefreshDynamicConfigRequest{HostAddress: "history:7234"}).
+		Return(&historyservice.RefreshDynamicConfigResponse{}, nil)
+	s.mockMatchingClient.EXPECT().RefreshDynamicConfig(gomock.Any(), &matchingservice.RefreshDynamicConfigRequest{HostAddress: "matching:7235"}).
+		Return(nil, errors.New("unavailable"))
+	mockWorkerClient := workerservicemock.NewMockWorkerServiceClient(s.controller)
+	s.mockClientFactory.EXPECT().NewWorkerClient("worker:7239").Return(mockWorkerClient)
+	mockWorkerClient.EXPECT().RefreshDynamicConfig(gomock.Any(), &workerservice.RefreshDynamicConfigRequest{}).
+		Return(&workerservice.RefreshDynamicConfigResponse{}, nil)
+
+	resp, err := s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Key:         "Frontend.NamespaceRPS",
+		Value:       []byte("20"),
+		Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "ns"},
+		Identity:    "operator",
+		Reason:      "load test",
+	})
+	s.NoError(err)
+	s.Equal(int64(4), resp.GetVersion())
+}
+
+func (s *adminHandlerSuite) Test_SetDynamicConfig_ConcurrentUpdate() {
+	s.mockClusterMetadataManager.EXPECT().GetDynamicConfig(&persistence.GetDynamicConfigRequest{Key: "frontend.namespacerps"}).Return(
+		nil, serviceerror.NewNotFound("not found"))
+	s.mockClusterMetadataManager.EXPECT().SaveDynamicConfig(gomock.Any()).DoAndReturn(
+		func(request *persistence.SaveDynamicConfigRequest) (bool, error) {
+			s.Equal(int64(0), request.Version)
+			s.Equal("frontend.namespacerps", request.Entry.Key)
+			return false, nil
+		})
+
+	_, err := s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{
+		Key:   "frontend.namespacerps",
+		Value: []byte("20"),
+	})
+	s.Equal(errDynamicConfigConcurrentUpdate, err)
+}
+
+func (s *adminHandlerSuite) Test_DeleteDynamicConfig_NotFound() {
+	s.mockClusterMetadataManager.EXPECT().GetDynamicConfig(&persistence.GetDynamicConfigRequest{Key: "frontend.namespacerps"}).Return(
+		&persistence.GetDynamicConfigResponse{
+			Entry: &persistencespb.DynamicConfigEntry{
+				Key: "frontend.namespacerps",
+				Values: []*persistencespb.DynamicConfigValue{
+					{Value: []byte("100"), Constraints: &persistencespb.DynamicConfigConstraints{}},
+				},
+			},
+			Version: 1,
+		}, nil)
+
+	_, err := s.handler.DeleteDynamicConfig(context.Background(), &adminservice.DeleteDynamicConfigRequest{
+		Key:         "frontend.namespacerps",
+		Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "ns"},
+	})
+	s.Equal(errDynamicConfigValueNotFound, err)
+}
+
+func (s *adminHandlerSuite) Test_ListDynamicConfig_SkipsDeletedKeys() {
+	s.mockClusterMetadataManager.EXPECT().ListDynamicConfig(&persistence.ListDynamicConfigRequest{
+		PageSize: listDynamicConfigPageSize,
+	}).Return(&persistence.ListDynamicConfigResponse{
+		Entries: []*persistence.GetDynamicConfigResponse{
+			{Entry: &persistencespb.DynamicConfigEntry{Key: "deleted"}},
+			{Entry: &persistencespb.DynamicConfigEntry{
+				Key:    "frontend.namespacerps",
+				Values: []*persistencespb.DynamicConfigValue{{Value: []byte("100")}},
+			}},
+		},
+	}, nil)
+
+	resp, err := s.handler.ListDynamicConfig(context.Background(), &adminservice.ListDynamicConfigRequest{})
+	s.NoError(err)
+	s.Len(resp.GetEntries(), 1)
+	s.Equal("frontend.namespacerps", resp.GetEntries()[0].GetKey())
+}
+
+func (s *adminHandlerSuite) Test_GetEffectiveDynamicConfig() {
+	s.handler.dynamicConfigClient = dynamicconfig.NewMutableEphemeralClient(
+		dynamicconfig.Set(dynamicconfig.FrontendMaxNamespaceRPSPerInstance, 100, dynamicconfig.ForNamespace(s.namespace.String())),
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).Times(2)
+
+	resp, err := s.handler.GetEffectiveDynamicConfig(context.Background(), &adminservice.GetEffectiveDynamicConfigRequest{
+		Key:       "Frontend.NamespaceRPS",
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.Len(resp.GetValues(), 1)
+	s.Equal(&adminservice.EffectiveDynamicConfigValue{
+		Key:         dynamicconfig.FrontendMaxNamespaceRPSPerInstance,
+		Type:        "int",
+		Precedence:  "namespace",
+		Description: resp.GetValues()[0].GetDescription(),
+		Value:       []byte("100"),
+		IsDefault:   false,
+	}, resp.GetValues()[0])
+
+	resp, err = s.handler.GetEffectiveDynamicConfig(context.Background(), &adminservice.GetEffectiveDynamicConfigRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.Len(resp.GetValues(), len(dynamicconfig.ListKeyDefinitions()))
+	for _, value := range resp.GetValues() {
+		if value.GetKey() == dynamicconfig.AcquireShardInterval {
+			s.True(value.GetIsDefault())
+			s.Equal(`"1m0s"`, string(value.GetValue()))
+		}
+	}
+
+	_, err = s.handler.GetEffectiveDynamicConfig(context.Background(), &adminservice.GetEffectiveDynamicConfigRequest{
+		Key: "frontend.unknownKey",
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *adminHandlerSuite) Test_ListShardLoad() {
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{
+		membership.NewHostInfo("history1:7234", nil),
+		membership.NewHostInfo("history2:7234", nil),
+		membership.NewHostInfo("history3:7234", nil),
+	})
+	s.mockHistoryClient.EXPECT().GetShardLoad(gomock.Any(), &historyservice.GetShardLoadRequest{
+		HostAddress:          "history1:7234",
+		MaxWorkflowsPerShard: 10,
+	}).Return(&historyservice.GetShardLoadResponse{Shards: []*historyspb.ShardLoad{
+		{ShardId: 1, HostAddress: "history1:7234", RequestsPerSecond: 5},
+		{ShardId: 2, HostAddress: "history1:7234", RequestsPerSecond: 50},
+	}}, nil)
+	s.mockHistoryClient.EXPECT().GetShardLoad(gomock.Any(), &historyservice.GetShardLoadRequest{
+		HostAddress:          "history2:7234",
+		MaxWorkflowsPerShard: 10,
+	}).Return(&historyservice.GetShardLoadResponse{Shards: []*historyspb.ShardLoad{
+		{ShardId: 3, HostAddress: "history2:7234", RequestsPerSecond: 20},
+	}}, nil)
+	s.mockHistoryClient.EXPECT().GetShardLoad(gomock.Any(), &historyservice.GetShardLoadRequest{
+		HostAddress:          "history3:7234",
+		MaxWorkflowsPerShard: 10,
+	}).Return(nil, errors.New("unavailable"))
+
+	resp, err := s.handler.ListShardLoad(context.Background(), &adminservice.ListShardLoadRequest{MaxShards: 2})
+	s.NoError(err)
+	s.Len(resp.GetShards(), 2)
+	s.Equal(int32(2), resp.GetShards()[0].GetShardId())
+	s.Equal(int32(3), resp.GetShards()[1].GetShardId())
+	s.Equal([]string{"history3:7234"}, resp.GetFailedHosts())
+}
@@ -71,6 +71,23 @@ var (
 	errDLQTypeIsNotSupported                              = serviceerror.NewInvalidArgument("The DLQ type is not supported.")
 	errFailureMustHaveApplicationFailureInfo              = serviceerror.NewInvalidArgument("Failure must have ApplicationFailureInfo.")
 	errStatusFilterMustBeNotRunning                       = serviceerror.NewInvalidArgument("StatusFilter must be specified and must be not Running.")
+	errReasonNotSet                                       = serviceerror.NewInvalidArgument("Reason is not set on request.")
+	errJobIDNotSet                                        = serviceerror.NewInvalidArgument("JobId is not set on request.")
+	errBatchTargetNotSet                                  = serviceerror.NewInvalidArgument("Exactly one of VisibilityQuery and Executions must be set on request.")
+	errBatchOperationTypeNotSupported                     = serviceerror.NewInvalidArgument("The batch operation type is not supported.")
+	errBatchResetTypeNotSupported                         = serviceerror.NewInvalidArgument("The batch reset type is not supported.")
+	errBadBinaryChecksumNotSet                            = serviceerror.NewInvalidArgument("Bad binary checksum is not set on request.")
+	errBatchOperationNotFound                             = serviceerror.NewNotFound("Batch operation not found.")
+	errBuildIDNotSet                                      = serviceerror.NewInvalidArgument("BuildId is not set on request.")
+	errScheduleIDNotSet                                   = serviceerror.NewInvalidArgument("ScheduleId is not set on request.")
+	errScheduleNotSet                                     = serviceerror.NewInvalidArgument("Schedule is not set on request.")
+	errUpdateNameNotSet                                   = serviceerror.NewInvalidArgument("Update name is not set on request.")
+	errUnableToDeleteSystemNamespace                      = serviceerror.NewInvalidArgument("System namespace can't be deleted.")
+	errUnableToDeleteGlobalNamespace                      = serviceerror.NewInvalidArgument("Global namespace can't be deleted.")
+	errDynamicConfigKeyNotSet                             = serviceerror.NewInvalidArgument("Dynamic config key is not set on request.")
+	errInvalidDynamicConfigValue                          = serviceerror.NewInvalidArgument("Dynamic config value is not valid JSON.")
+	errDynamicConfigValueNotFound                         = serviceerror.NewNotFound("Dynamic config value not found.")
+	errDynamicConfigConcurrentUpdate                      = serviceerror.NewUnavailable("Dynamic config key was updated concurrently, please retry.")
 	errShuttingDown                                       = serviceerror.NewUnavailable("Shutting down")
 
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
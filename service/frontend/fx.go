@@ -60,6 +60,7 @@ import (
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service"
 	"go.temporal.io/server/service/frontend/configs"
 )
@@ -136,7 +137,10 @@ func GrpcServerOptionsProvider(
 	authorizer authorization.Authorizer,
 	claimMapper authorization.ClaimMapper,
 	audienceGetter authorization.JWTAudienceMapper,
+	auditSink authorization.AuditSink,
 	customInterceptors []grpc.UnaryServerInterceptor,
+	namespaceRegistry namespace.Registry,
+	historyClient historyservice.HistoryServiceClient,
 	metricsClient metrics.Client,
 ) []grpc.ServerOption {
 	kep := keepalive.EnforcementPolicy{
@@ -157,6 +161,7 @@ func GrpcServerOptionsProvider(
 	interceptors := []grpc.UnaryServerInterceptor{
 		namespaceLogInterceptor.Intercept,
 		rpc.ServiceErrorInterceptor,
+		tracing.UnaryServerInterceptor,
 		metrics.NewServerMetricsContextInjectorInterceptor(),
 		telemetryInterceptor.Intercept,
 		namespaceValidatorInterceptor.Intercept,
@@ -169,6 +174,9 @@ func GrpcServerOptionsProvider(
 			metricsClient,
 			logger,
 			audienceGetter,
+			newWorkflowTypeResolver(namespaceRegistry, historyClient),
+			auditSink,
+			func() float64 { return serviceConfig.AuthorizationAuditReadSampleRate() },
 		),
 		sdkVersionInterceptor.Intercept,
 	}
@@ -359,6 +367,7 @@ func AdminHandlerProvider(
 	clientFactory client.Factory,
 	clientBean client.Bean,
 	historyClient historyservice.HistoryServiceClient,
+	matchingClient resource.MatchingClient,
 	sdkSystemClient sdkclient.Client,
 	membershipMonitor membership.Monitor,
 	archiverProvider provider.ArchiverProvider,
@@ -368,6 +377,7 @@ func AdminHandlerProvider(
 	saManager searchattribute.Manager,
 	clusterMetadata cluster.Metadata,
 	archivalMetadata archiver.ArchivalMetadata,
+	dynamicConfigClient dynamicconfig.Client,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
 		params,
@@ -385,6 +395,7 @@ func AdminHandlerProvider(
 		clientFactory,
 		clientBean,
 		historyClient,
+		matchingClient,
 		sdkSystemClient,
 		membershipMonitor,
 		archiverProvider,
@@ -394,6 +405,7 @@ func AdminHandlerProvider(
 		saManager,
 		clusterMetadata,
 		archivalMetadata,
+		dynamicConfigClient,
 	}
 	return NewAdminHandler(args)
 }
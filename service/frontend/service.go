@@ -127,6 +127,9 @@ type Config struct {
 	KeepAliveTime dynamicconfig.DurationPropertyFn
 	// Wait for the ping ack before assuming the connection is dead.
 	KeepAliveTimeout dynamicconfig.DurationPropertyFn
+
+	// Fraction of allowed read-only and worker API calls recorded in the authorization audit log
+	AuthorizationAuditReadSampleRate dynamicconfig.FloatPropertyFn
 }
 
 // NewConfig returns new service config with default values
@@ -178,6 +181,8 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, esIndexName
 		KeepAliveMaxConnectionAgeGrace:         dc.GetDurationProperty(dynamicconfig.KeepAliveMaxConnectionAgeGrace, 70*time.Second),
 		KeepAliveTime:                          dc.GetDurationProperty(dynamicconfig.KeepAliveTime, 1*time.Minute),
 		KeepAliveTimeout:                       dc.GetDurationProperty(dynamicconfig.KeepAliveTimeout, 10*time.Second),
+
+		AuthorizationAuditReadSampleRate: dc.GetFloat64Property(dynamicconfig.FrontendAuthorizationAuditReadSampleRate, 0),
 	}
 }
 
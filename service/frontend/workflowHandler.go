@@ -27,6 +27,7 @@ package frontend
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync/atomic"
 	"time"
 	"unicode/utf8"
@@ -39,7 +40,9 @@ import (
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -62,6 +65,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/priorities"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
 )
@@ -446,7 +450,14 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 		return nil, err
 	}
 
-	resp, err := wh.historyClient.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID.String(), request, nil, time.Now().UTC()))
+	priority, err := priorities.FromHeader(request.GetHeader())
+	if err != nil {
+		return nil, err
+	}
+
+	histRequest := common.CreateHistoryStartWorkflowRequest(namespaceID.String(), request, nil, time.Now().UTC())
+	histRequest.Priority = priority
+	resp, err := wh.historyClient.StartWorkflowExecution(ctx, histRequest)
 
 	if err != nil {
 		return nil, err
@@ -2092,9 +2103,15 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, err
 	}
 
+	priority, err := priorities.FromHeader(request.GetHeader())
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := wh.historyClient.SignalWithStartWorkflowExecution(ctx, &historyservice.SignalWithStartWorkflowExecutionRequest{
 		NamespaceId:            namespaceID.String(),
 		SignalWithStartRequest: request,
+		Priority:               priority,
 	})
 
 	if err != nil {
@@ -3369,10 +3386,25 @@ func (wh *WorkflowHandler) createPollWorkflowTaskQueueResponse(
 		StartedTime:                matchingResp.StartedTime,
 		Queries:                    matchingResp.Queries,
 	}
+	wh.setWorkflowTaskHeaders(ctx, matchingResp)
 
 	return resp, nil
 }
 
+// setWorkflowTaskHeaders sends history size and continue-as-new suggestion of the workflow execution
+// to the worker as response headers, the public poll response has no fields for them yet.
+func (wh *WorkflowHandler) setWorkflowTaskHeaders(
+	ctx context.Context,
+	matchingResp *matchingservice.PollWorkflowTaskQueueResponse,
+) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(
+		headers.HistorySizeBytesHeaderName, strconv.FormatInt(matchingResp.GetHistorySizeBytes(), 10),
+		headers.SuggestContinueAsNewHeaderName, strconv.FormatBool(matchingResp.GetSuggestContinueAsNew()),
+	)); err != nil {
+		wh.logger.Warn("Unable to set workflow task response headers.", tag.Error(err))
+	}
+}
+
 func (wh *WorkflowHandler) verifyHistoryIsComplete(
 	events []*historypb.HistoryEvent,
 	expectedFirstEventID int64,
@@ -45,6 +45,8 @@ import (
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
@@ -56,6 +58,7 @@ import (
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
@@ -1836,6 +1839,19 @@ func registerNamespaceRequest(
 	}
 }
 
+func (s *workflowHandlerSuite) TestSetWorkflowTaskHeaders() {
+	wh := s.getWorkflowHandler(s.newConfig())
+	stream := &headerRecordingStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+	wh.setWorkflowTaskHeaders(ctx, &matchingservice.PollWorkflowTaskQueueResponse{
+		HistorySizeBytes:     1024,
+		SuggestContinueAsNew: true,
+	})
+	s.Equal([]string{"1024"}, stream.header.Get(headers.HistorySizeBytesHeaderName))
+	s.Equal([]string{"true"}, stream.header.Get(headers.SuggestContinueAsNewHeaderName))
+}
+
 func getHistoryRequest(nextPageToken []byte) *workflowservice.GetWorkflowExecutionHistoryRequest {
 	return &workflowservice.GetWorkflowExecutionHistoryRequest{
 		Execution: &commonpb.WorkflowExecution{
@@ -1863,3 +1879,24 @@ func TestContextNearDeadline(t *testing.T) {
 
 	assert.False(t, common.IsServiceTransientError(errContextNearDeadline))
 }
+
+type headerRecordingStream struct {
+	header metadata.MD
+}
+
+func (s *headerRecordingStream) Method() string {
+	return "headerRecordingStream"
+}
+
+func (s *headerRecordingStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerRecordingStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *headerRecordingStream) SetTrailer(metadata.MD) error {
+	return nil
+}
@@ -0,0 +1,79 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	// workflowTypeResolver looks up workflow types of executions for authorization of calls
+	// which target existing workflows
+	workflowTypeResolver struct {
+		namespaceRegistry namespace.Registry
+		historyClient     historyservice.HistoryServiceClient
+	}
+)
+
+var _ authorization.WorkflowTypeResolver = (*workflowTypeResolver)(nil)
+
+func newWorkflowTypeResolver(
+	namespaceRegistry namespace.Registry,
+	historyClient historyservice.HistoryServiceClient,
+) *workflowTypeResolver {
+	return &workflowTypeResolver{
+		namespaceRegistry: namespaceRegistry,
+		historyClient:     historyClient,
+	}
+}
+
+func (r *workflowTypeResolver) GetWorkflowType(
+	ctx context.Context,
+	namespaceName string,
+	execution *commonpb.WorkflowExecution,
+) (string, error) {
+	namespaceID, err := r.namespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+	if err != nil {
+		return "", err
+	}
+	response, err := r.historyClient.DescribeWorkflowExecution(ctx, &historyservice.DescribeWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: namespaceName,
+			Execution: execution,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return response.GetWorkflowExecutionInfo().GetType().GetName(), nil
+}
@@ -35,6 +35,7 @@ import (
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/common/rpc/interceptor"
+	"go.temporal.io/server/common/tracing"
 )
 
 func PersistenceMaxQpsFn(
@@ -72,6 +73,7 @@ func GrpcServerOptionsProvider(
 		grpcServerOptions,
 		grpc.ChainUnaryInterceptor(
 			rpc.ServiceErrorInterceptor,
+			tracing.UnaryServerInterceptor,
 			metrics.NewServerMetricsContextInjectorInterceptor(),
 			metrics.NewServerMetricsTrailerPropagatorInterceptor(logger),
 			telemetryInterceptor.Intercept,
@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/priorities"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/workflow"
@@ -266,6 +267,10 @@ func (v *commandAttrValidator) validateActivityScheduleAttributes(
 		return err
 	}
 
+	if _, err := priorities.FromHeader(attributes.GetHeader()); err != nil {
+		return err
+	}
+
 	if len(attributes.GetActivityId()) > v.maxIDLengthLimit {
 		return serviceerror.NewInvalidArgument("ActivityID exceeds length limit.")
 	}
@@ -392,6 +397,9 @@ func (v *commandAttrValidator) validateRecordMarkerAttributes(
 	if len(attributes.GetMarkerName()) > v.maxIDLengthLimit {
 		return serviceerror.NewInvalidArgument("MarkerName exceeds length limit.")
 	}
+	if attributes.GetMarkerName() == workflow.UpdateCompletedMarkerName && workflow.GetUpdateID(attributes.GetHeader()) == "" {
+		return serviceerror.NewInvalidArgument("Update id is not set on update completed marker.")
+	}
 
 	return nil
 }
@@ -502,6 +510,9 @@ func (v *commandAttrValidator) validateSignalExternalWorkflowExecutionAttributes
 	if attributes.GetSignalName() == "" {
 		return serviceerror.NewInvalidArgument("SignalName is not set on command.")
 	}
+	if workflow.IsUpdateSignalName(attributes.GetSignalName()) {
+		return serviceerror.NewInvalidArgument("SignalName is reserved for workflow updates.")
+	}
 
 	return nil
 }
@@ -574,6 +585,10 @@ func (v *commandAttrValidator) validateContinueAsNewWorkflowExecutionAttributes(
 		attributes.WorkflowTaskTimeout = timestamp.DurationPtr(timestamp.DurationValue(executionInfo.DefaultWorkflowTaskTimeout))
 	}
 
+	if _, err := priorities.FromHeader(attributes.GetHeader()); err != nil {
+		return err
+	}
+
 	return v.searchAttributesValidator.Validate(attributes.GetSearchAttributes(), namespace.String(), visibilityIndexName)
 }
 
@@ -649,6 +664,10 @@ func (v *commandAttrValidator) validateStartChildExecutionAttributes(
 	}
 	attributes.TaskQueue = taskQueue
 
+	if _, err := priorities.FromHeader(attributes.GetHeader()); err != nil {
+		return err
+	}
+
 	// workflow execution timeout is left as is
 	//  if workflow execution timeout == 0 -> infinity
 
@@ -75,6 +75,10 @@ type Config struct {
 	AcquireShardInterval    dynamicconfig.DurationPropertyFn
 	AcquireShardConcurrency dynamicconfig.IntPropertyFn
 
+	// shard load reporting
+	ShardLoadWindow              dynamicconfig.DurationPropertyFn
+	ShardLoadMaxTrackedWorkflows dynamicconfig.IntPropertyFn
+
 	// the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay                  dynamicconfig.DurationPropertyFn
 	StandbyTaskMissingEventsResendDelay  dynamicconfig.DurationPropertyFn
@@ -137,6 +141,15 @@ type Config struct {
 	MaximumBufferedEventsBatch dynamicconfig.IntPropertyFn
 	MaximumSignalsPerExecution dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// Open workflow execution limits
+	MaxOpenWorkflowExecutionsPerNamespace    dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxOpenWorkflowExecutionsPerWorkflowType dynamicconfig.IntPropertyFnWithWorkflowTypeFilter
+	OpenExecutionCountRefreshInterval        dynamicconfig.DurationPropertyFn
+
+	// History task scheduling
+	TaskSchedulerNamespaceWeight         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	TaskSchedulerNamespaceMaxWorkerShare dynamicconfig.FloatPropertyFnWithNamespaceFilter
+
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
 	// ShardSyncMinInterval the minimal time interval which the shard info should be sync to remote
@@ -173,6 +186,10 @@ type Config struct {
 	HistoryCountLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
 	HistoryCountLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// Soft limits after which workflow task started response suggests continue-as-new
+	HistorySizeSuggestContinueAsNew  dynamicconfig.IntPropertyFnWithWorkflowTypeFilter
+	HistoryCountSuggestContinueAsNew dynamicconfig.IntPropertyFnWithWorkflowTypeFilter
+
 	// DefaultActivityRetryOptions specifies the out-of-box retry policy if
 	// none is configured on the Activity by the user.
 	DefaultActivityRetryPolicy dynamicconfig.MapPropertyFnWithNamespaceFilter
@@ -211,6 +228,10 @@ type Config struct {
 	// The following are used by consistent query
 	MaxBufferedQueryCount dynamicconfig.IntPropertyFn
 
+	// The following are used by workflow update
+	MaxInFlightUpdates  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxCompletedUpdates dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	// Data integrity check related config knobs
 	MutableStateChecksumGenProbability    dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MutableStateChecksumVerifyProbability dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -288,6 +309,8 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		RangeSizeBits:                        20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                 dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, time.Minute),
 		AcquireShardConcurrency:              dc.GetIntProperty(dynamicconfig.AcquireShardConcurrency, 10),
+		ShardLoadWindow:                      dc.GetDurationProperty(dynamicconfig.ShardLoadWindow, time.Minute),
+		ShardLoadMaxTrackedWorkflows:         dc.GetIntProperty(dynamicconfig.ShardLoadMaxTrackedWorkflows, 1000),
 		StandbyClusterDelay:                  dc.GetDurationProperty(dynamicconfig.StandbyClusterDelay, 5*time.Minute),
 		StandbyTaskMissingEventsResendDelay:  dc.GetDurationProperty(dynamicconfig.StandbyTaskMissingEventsResendDelay, 10*time.Minute),
 		StandbyTaskMissingEventsDiscardDelay: dc.GetDurationProperty(dynamicconfig.StandbyTaskMissingEventsDiscardDelay, 15*time.Minute),
@@ -350,6 +373,12 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		ShardSyncMinInterval:            dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval, 5*time.Minute),
 		ShardSyncTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient, 0.15),
 
+		MaxOpenWorkflowExecutionsPerNamespace:    dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MaxOpenWorkflowExecutionsPerNamespace, 0),
+		MaxOpenWorkflowExecutionsPerWorkflowType: dc.GetIntPropertyFilteredByWorkflowType(dynamicconfig.MaxOpenWorkflowExecutionsPerWorkflowType, 0),
+		OpenExecutionCountRefreshInterval:        dc.GetDurationProperty(dynamicconfig.OpenExecutionCountRefreshInterval, 10*time.Second),
+		TaskSchedulerNamespaceWeight:             dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskSchedulerNamespaceWeight, 1),
+		TaskSchedulerNamespaceMaxWorkerShare:     dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.TaskSchedulerNamespaceMaxWorkerShare, 1),
+
 		// history client: client/history/client.go set the client timeout 30s
 		// TODO: Return this value to the client: go.temporal.io/server/issues/294
 		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20),
@@ -371,6 +400,9 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		HistoryCountLimitError: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCountLimitError, 50*1024),
 		HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCountLimitWarn, 10*1024),
 
+		HistorySizeSuggestContinueAsNew:  dc.GetIntPropertyFilteredByWorkflowType(dynamicconfig.HistorySizeSuggestContinueAsNew, 10*1024*1024),
+		HistoryCountSuggestContinueAsNew: dc.GetIntPropertyFilteredByWorkflowType(dynamicconfig.HistoryCountSuggestContinueAsNew, 10*1024),
+
 		ThrottledLogRPS:   dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 4),
 		EnableStickyQuery: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableStickyQuery, true),
 
@@ -395,6 +427,8 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		ReplicationTaskProcessorCleanupJitterCoefficient:     dc.GetFloat64PropertyFilteredByShardID(dynamicconfig.ReplicationTaskProcessorCleanupJitterCoefficient, 0.15),
 
 		MaxBufferedQueryCount:                 dc.GetIntProperty(dynamicconfig.MaxBufferedQueryCount, 1),
+		MaxInFlightUpdates:                    dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MaxInFlightUpdates, 10),
+		MaxCompletedUpdates:                   dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MaxCompletedUpdates, 100),
 		MutableStateChecksumGenProbability:    dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MutableStateChecksumGenProbability, 0),
 		MutableStateChecksumVerifyProbability: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MutableStateChecksumVerifyProbability, 0),
 		MutableStateChecksumInvalidateBefore:  dc.GetFloat64Property(dynamicconfig.MutableStateChecksumInvalidateBefore, 0),
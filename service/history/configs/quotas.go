@@ -68,8 +68,12 @@ var (
 		"SyncActivity":                        0,
 		"SyncShardStatus":                     0,
 		"TerminateWorkflowExecution":          0,
+		"UpdateWorkflowExecution":             0,
 		"GenerateLastHistoryReplicationTasks": 0,
 		"GetReplicationStatus":                0,
+		"RefreshDynamicConfig":                0,
+		"GetOpenExecutionCounts":              0,
+		"GetShardLoad":                        0,
 	}
 
 	APIPriorities = map[int]struct{}{
@@ -78,6 +78,16 @@ var (
 	ErrUnknownCluster = serviceerror.NewInvalidArgument("unknown cluster")
 	// ErrBufferedQueryCleared is error indicating mutable state is cleared while buffered query is pending
 	ErrBufferedQueryCleared = serviceerror.NewUnavailable("buffered query cleared, please retry")
+	// ErrInFlightUpdatesCleared is error indicating mutable state is cleared while update is waiting for its outcome
+	ErrInFlightUpdatesCleared = serviceerror.NewUnavailable("in-flight updates cleared, please retry")
+	// ErrInFlightUpdatesExceeded is error indicating workflow has too many updates waiting for their outcome
+	ErrInFlightUpdatesExceeded = serviceerror.NewUnavailable("exceeded workflow execution limit for in-flight updates")
+	// ErrSignalNameReserved is error indicating signal name is reserved for accepted updates
+	ErrSignalNameReserved = serviceerror.NewInvalidArgument("signal name is reserved for workflow updates")
+	// ErrNamespaceOpenExecutionsLimitExceeded is error indicating namespace has reached the limit of open workflow executions
+	ErrNamespaceOpenExecutionsLimitExceeded = serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, "exceeded namespace limit for open workflow executions")
+	// ErrWorkflowTypeOpenExecutionsLimitExceeded is error indicating workflow type has reached the limit of open workflow executions
+	ErrWorkflowTypeOpenExecutionsLimitExceeded = serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, "exceeded workflow type limit for open workflow executions")
 
 	// FailedWorkflowStatuses is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
@@ -80,6 +80,8 @@ var Module = fx.Options(
 	fx.Provide(ServiceResolverProvider),
 	fx.Provide(EventNotifierProvider),
 	fx.Provide(ReplicationTaskFetchersProvider),
+	fx.Provide(OpenExecutionCounterProvider),
+	fx.Provide(TaskWorkerLimiterProvider),
 	fx.Provide(HistoryEngineFactoryProvider),
 	fx.Provide(HandlerProvider),
 	fx.Provide(ServiceProvider),
@@ -133,6 +135,8 @@ func HandlerProvider(
 	shardController *shard.ControllerImpl,
 	eventNotifier events.Notifier,
 	replicationTaskFetchers ReplicationTaskFetchers,
+	dynamicConfigClient dynamicconfig.Client,
+	openExecutionCounter OpenExecutionCounter,
 ) *Handler {
 	args := NewHandlerArgs{
 		config,
@@ -153,6 +157,8 @@ func HandlerProvider(
 		shardController,
 		eventNotifier,
 		replicationTaskFetchers,
+		dynamicConfigClient,
+		openExecutionCounter,
 	}
 	return NewHandler(args)
 }
@@ -170,6 +176,8 @@ func HistoryEngineFactoryProvider(
 	clientBean client.Bean,
 	archiverProvider provider.ArchiverProvider,
 	registry namespace.Registry,
+	openExecutionCounter OpenExecutionCounter,
+	taskWorkerLimiter TaskWorkerLimiter,
 ) shard.EngineFactory {
 	return NewEngineFactory(
 		visibilityMgr,
@@ -184,6 +192,8 @@ func HistoryEngineFactoryProvider(
 		clientBean,
 		archiverProvider,
 		registry,
+		openExecutionCounter,
+		taskWorkerLimiter,
 	)
 }
 
@@ -306,6 +316,27 @@ func ReplicationTaskFetchersProvider(
 	)
 }
 
+func OpenExecutionCounterProvider(
+	config *configs.Config,
+	historyClient historyservice.HistoryServiceClient,
+	historyServiceResolver membership.ServiceResolver,
+	logger log.Logger,
+) OpenExecutionCounter {
+	return NewOpenExecutionCounter(
+		config,
+		historyClient,
+		historyServiceResolver,
+		logger,
+	)
+}
+
+func TaskWorkerLimiterProvider(
+	config *configs.Config,
+	metricsClient metrics.Client,
+) TaskWorkerLimiter {
+	return NewTaskWorkerLimiter(config, metricsClient)
+}
+
 func ServiceLifetimeHooks(
 	lc fx.Lifecycle,
 	svcStoppedCh chan struct{},
@@ -47,6 +47,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -90,6 +91,8 @@ type (
 		archivalMetadata            archiver.ArchivalMetadata
 		hostInfoProvider            resource.HostInfoProvider
 		controller                  *shard.ControllerImpl
+		dynamicConfigClient         dynamicconfig.Client
+		openExecutionCounter        OpenExecutionCounter
 	}
 
 	NewHandlerArgs struct {
@@ -111,6 +114,8 @@ type (
 		ShardController             *shard.ControllerImpl
 		EventNotifier               events.Notifier
 		ReplicationTaskFetchers     ReplicationTaskFetchers
+		DynamicConfigClient         dynamicconfig.Client
+		OpenExecutionCounter        OpenExecutionCounter
 	}
 )
 
@@ -159,6 +164,8 @@ func NewHandler(args NewHandlerArgs) *Handler {
 		controller:                  args.ShardController,
 		eventNotifier:               args.EventNotifier,
 		replicationTaskFetchers:     args.ReplicationTaskFetchers,
+		dynamicConfigClient:         args.DynamicConfigClient,
+		openExecutionCounter:        args.OpenExecutionCounter,
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -181,6 +188,7 @@ func (h *Handler) Start() {
 	// events notifier must starts before controller
 	h.eventNotifier.Start()
 	h.controller.Start()
+	h.openExecutionCounter.Start()
 
 	h.startWG.Done()
 }
@@ -196,6 +204,7 @@ func (h *Handler) Stop() {
 	}
 
 	h.replicationTaskFetchers.Stop()
+	h.openExecutionCounter.Stop()
 	h.controller.Stop()
 	h.eventNotifier.Stop()
 }
@@ -985,6 +994,82 @@ func (h *Handler) QueryWorkflow(ctx context.Context, request *historyservice.Que
 	return resp, nil
 }
 
+// UpdateWorkflowExecution validates the update and, once it is accepted, waits for its outcome.
+func (h *Handler) UpdateWorkflowExecution(ctx context.Context, request *historyservice.UpdateWorkflowExecutionRequest) (_ *historyservice.UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(ctx, namespaceID, workflowID)
+	if err1 != nil {
+		return nil, h.convertError(err1)
+	}
+
+	var resp *historyservice.UpdateWorkflowExecutionResponse
+	err2 := backoff.RetryContext(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = engine.UpdateWorkflowExecution(ctx, request)
+		return err
+	}, common.CreateHistoryServiceRetryPolicy(), func(err error) bool {
+		return err == consts.ErrInFlightUpdatesCleared
+	})
+
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
+// RefreshDynamicConfig reloads dynamic config stored in persistence on this host
+func (h *Handler) RefreshDynamicConfig(_ context.Context, _ *historyservice.RefreshDynamicConfigRequest) (_ *historyservice.RefreshDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	if refresher, ok := h.dynamicConfigClient.(dynamicconfig.Refresher); ok {
+		if err := refresher.Refresh(); err != nil {
+			return nil, h.convertError(err)
+		}
+	}
+	return &historyservice.RefreshDynamicConfigResponse{}, nil
+}
+
+// GetOpenExecutionCounts returns the approximate number of open workflow executions on shards owned by this host.
+func (h *Handler) GetOpenExecutionCounts(_ context.Context, _ *historyservice.GetOpenExecutionCountsRequest) (_ *historyservice.GetOpenExecutionCountsResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	return &historyservice.GetOpenExecutionCountsResponse{
+		NamespaceCounts: h.controller.OpenExecutionCounts(),
+	}, nil
+}
+
+// GetShardLoad returns the load on each shard owned by this host.
+func (h *Handler) GetShardLoad(_ context.Context, request *historyservice.GetShardLoadRequest) (_ *historyservice.GetShardLoadResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	return &historyservice.GetShardLoadResponse{
+		Shards: h.controller.ShardLoad(int(request.GetMaxWorkflowsPerShard())),
+	}, nil
+}
+
 // ScheduleWorkflowTask is used for creating a workflow task for already started workflow execution.  This is mainly
 // used by transfer queue processor during the processing of StartChildWorkflowExecution task, where it first starts
 // child execution without creating the workflow task and then calls this API after updating the mutable state of
@@ -1204,7 +1289,10 @@ func (h *Handler) GetReplicationMessages(ctx context.Context, request *historyse
 		go func(token *replicationspb.ReplicationToken) {
 			defer wg.Done()
 
-			engine, err := h.controller.GetEngineForShard(ctx, token.GetShardId())
+			// the polling cluster may have more history shards than this cluster,
+			// in which case the token refers to a shard of the polling cluster
+			shardID := common.SourceHistoryShardID(token.GetShardId(), h.config.NumberOfShards)
+			engine, err := h.controller.GetEngineForShard(ctx, shardID)
 			if err != nil {
 				h.logger.Warn("History engine not found for shard", tag.Error(err))
 				return
@@ -1212,6 +1300,8 @@ func (h *Handler) GetReplicationMessages(ctx context.Context, request *historyse
 			tasks, err := engine.GetReplicationMessages(
 				ctx,
 				request.GetClusterName(),
+				token.GetShardId(),
+				request.GetClusterShardCount(),
 				token.GetLastProcessedMessageId(),
 				timestamp.TimeValue(token.LastProcessedVisibilityTime),
 				token.GetLastRetrievedMessageId(),
@@ -1511,9 +1601,9 @@ func (h *Handler) convertError(err error) error {
 	case *persistence.ShardOwnershipLostError:
 		hostInfo := h.hostInfoProvider.HostInfo()
 		if info, err := h.historyServiceResolver.Lookup(convert.Int32ToString(err.ShardID)); err == nil {
-			return serviceerrors.NewShardOwnershipLost(hostInfo.GetAddress(), info.GetAddress())
+			return serviceerrors.NewShardOwnershipLost(info.GetAddress(), hostInfo.GetAddress())
 		}
-		return serviceerrors.NewShardOwnershipLost(hostInfo.GetAddress(), "<unknown>")
+		return serviceerrors.NewShardOwnershipLost("<unknown>", hostInfo.GetAddress())
 	case *persistence.WorkflowConditionFailedError:
 		return serviceerror.NewUnavailable(err.Msg)
 	case *persistence.CurrentWorkflowConditionFailedError:
@@ -28,6 +28,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -35,6 +36,7 @@ import (
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
 	historypb "go.temporal.io/api/history/v1"
 	querypb "go.temporal.io/api/query/v1"
 	"go.temporal.io/api/serviceerror"
@@ -49,6 +51,7 @@ import (
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/tasks"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -69,6 +72,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/priorities"
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
@@ -119,6 +123,8 @@ type (
 		replicationDLQHandler         replicationDLQHandler
 		searchAttributesValidator     *searchattribute.Validator
 		workflowDeleteManager         workflow.DeleteManager
+		openExecutionCounter          OpenExecutionCounter
+		taskWorkerLimiter             TaskWorkerLimiter
 	}
 )
 
@@ -137,6 +143,8 @@ func NewEngineWithShardContext(
 	clientBean client.Bean,
 	archiverProvider provider.ArchiverProvider,
 	registry namespace.Registry,
+	openExecutionCounter OpenExecutionCounter,
+	taskWorkerLimiter TaskWorkerLimiter,
 ) *historyEngineImpl {
 	currentClusterName := shard.GetClusterMetadata().GetCurrentClusterName()
 
@@ -182,6 +190,8 @@ func NewEngineWithShardContext(
 		replicationTaskProcessors: make(map[string]ReplicationTaskProcessor),
 		replicationTaskFetchers:   replicationTaskFetchers,
 		workflowDeleteManager:     workflowDeleteManager,
+		openExecutionCounter:      openExecutionCounter,
+		taskWorkerLimiter:         taskWorkerLimiter,
 	}
 
 	txProcessor := newTransferQueueProcessor(shard, historyEngImpl,
@@ -525,6 +535,9 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	if err := priorities.Validate(startRequest.GetPriority()); err != nil {
+		return nil, err
+	}
 
 	workflowID := request.GetWorkflowId()
 	// grab the current context as a Lock, nothing more
@@ -542,6 +555,15 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 		WorkflowId: workflowID,
 		RunId:      uuid.New(),
 	}
+	dedupRunID, err := e.checkOpenExecutionLimit(namespaceEntry, request, execution)
+	if err != nil {
+		return nil, err
+	}
+	if dedupRunID != "" {
+		return &historyservice.StartWorkflowExecutionResponse{
+			RunId: dedupRunID,
+		}, nil
+	}
 	clusterMetadata := e.shard.GetClusterMetadata()
 	mutableState, err := createMutableState(e.shard, namespaceEntry, execution.GetRunId())
 	if err != nil {
@@ -1177,6 +1199,11 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 	}
 	executionInfo := mutableState.GetExecutionInfo()
 	executionState := mutableState.GetExecutionState()
+	// WorkflowExecutionInfo has no field for history size, it is returned as search attribute.
+	searchAttributes, err := getSearchAttributesWithHistorySize(copySearchAttributes(executionInfo.SearchAttributes), context.GetHistorySize())
+	if err != nil {
+		return nil, err
+	}
 	result := &historyservice.DescribeWorkflowExecutionResponse{
 		ExecutionConfig: &workflowpb.WorkflowExecutionConfig{
 			TaskQueue: &taskqueuepb.TaskQueue{
@@ -1198,7 +1225,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			HistoryLength:        mutableState.GetNextEventID() - common.FirstEventID,
 			ExecutionTime:        executionInfo.ExecutionTime,
 			Memo:                 &commonpb.Memo{Fields: executionInfo.Memo},
-			SearchAttributes:     &commonpb.SearchAttributes{IndexedFields: executionInfo.SearchAttributes},
+			SearchAttributes:     searchAttributes,
 			AutoResetPoints:      executionInfo.AutoResetPoints,
 			TaskQueue:            executionInfo.TaskQueue,
 			StateTransitionCount: executionInfo.StateTransitionCount,
@@ -1861,6 +1888,9 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	namespaceID := namespaceEntry.ID()
 
 	request := signalRequest.SignalRequest
+	if workflow.IsUpdateSignalName(request.GetSignalName()) {
+		return consts.ErrSignalNameReserved
+	}
 	parentExecution := signalRequest.ExternalWorkflowExecution
 	childWorkflowOnly := signalRequest.GetChildWorkflowOnly()
 	execution := commonpb.WorkflowExecution{
@@ -1927,6 +1957,205 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 		})
 }
 
+func (e *historyEngineImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	updateRequest *historyservice.UpdateWorkflowExecutionRequest,
+) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(namespace.ID(updateRequest.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	namespaceID := namespaceEntry.ID()
+
+	request := updateRequest.GetRequest()
+	updateID := request.GetUpdateId()
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: request.GetExecution().GetWorkflowId(),
+		RunId:      request.GetExecution().GetRunId(),
+	}
+
+	// Update which is already accepted is not validated again, caller only needs its outcome.
+	wfContext, err := e.loadWorkflow(ctx, namespaceID, execution.GetWorkflowId(), execution.GetRunId())
+	if err != nil {
+		return nil, err
+	}
+	inFlight, outcome, err := getAcceptedUpdate(wfContext.getMutableState(), updateID)
+	wfContext.getReleaseFn()(err)
+	if err != nil {
+		return nil, err
+	}
+
+	if inFlight == nil && outcome == nil {
+		rejection, err := e.validateUpdate(ctx, namespaceEntry, execution, request)
+		if err != nil {
+			return nil, err
+		}
+		if rejection != nil {
+			return &historyservice.UpdateWorkflowExecutionResponse{
+				Response: &adminservice.UpdateWorkflowExecutionResponse{
+					UpdateId: updateID,
+					Rejected: true,
+					Failure:  rejection,
+				},
+			}, nil
+		}
+
+		err = e.updateWorkflow(
+			ctx,
+			namespaceID,
+			execution,
+			func(context workflow.Context, mutableState workflow.MutableState) (*updateWorkflowAction, error) {
+				if !mutableState.IsWorkflowExecutionRunning() {
+					return nil, consts.ErrWorkflowCompleted
+				}
+
+				// Same update could be accepted by concurrent request after validation.
+				var err error
+				inFlight, outcome, err = getAcceptedUpdate(mutableState, updateID)
+				if err != nil {
+					return nil, err
+				}
+				if inFlight != nil || outcome != nil {
+					return &updateWorkflowAction{
+						noop:               true,
+						createWorkflowTask: false,
+					}, nil
+				}
+
+				updateRegistry := mutableState.GetUpdateRegistry()
+				if updateRegistry.Len() >= e.config.MaxInFlightUpdates(namespaceEntry.Name().String()) {
+					return nil, consts.ErrInFlightUpdatesExceeded
+				}
+
+				executionInfo := mutableState.GetExecutionInfo()
+				createWorkflowTask := true
+				// Do not create workflow task when the workflow is cron and the cron has not been started yet
+				if executionInfo.CronSchedule != "" && !mutableState.HasProcessedOrPendingWorkflowTask() {
+					createWorkflowTask = false
+				}
+
+				// Accepted update is recorded as signal and counts towards signal limit.
+				maxAllowedSignals := e.config.MaximumSignalsPerExecution(namespaceEntry.Name().String())
+				if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
+					e.logger.Info("Execution limit reached for maximum signals", tag.WorkflowSignalCount(executionInfo.SignalCount),
+						tag.WorkflowID(execution.GetWorkflowId()),
+						tag.WorkflowRunID(execution.GetRunId()),
+						tag.WorkflowNamespaceID(namespaceID.String()))
+					return nil, consts.ErrSignalsLimitExceeded
+				}
+
+				if _, err := mutableState.AddWorkflowExecutionUpdateAcceptedEvent(
+					updateID,
+					request.GetName(),
+					request.GetInput(),
+					request.GetIdentity(),
+				); err != nil {
+					return nil, err
+				}
+				inFlight = updateRegistry.Add(updateID)
+
+				return &updateWorkflowAction{
+					noop:               false,
+					createWorkflowTask: createWorkflowTask,
+				}, nil
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if outcome == nil {
+		outcome, err = inFlight.Wait(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &historyservice.UpdateWorkflowExecutionResponse{
+		Response: &adminservice.UpdateWorkflowExecutionResponse{
+			UpdateId: updateID,
+			Result:   outcome.Result,
+			Failure:  outcome.Failure,
+		},
+	}, nil
+}
+
+// validateUpdate runs update validator on the worker and returns rejection failure if update is rejected.
+func (e *historyEngineImpl) validateUpdate(
+	ctx context.Context,
+	namespaceEntry *namespace.Namespace,
+	execution commonpb.WorkflowExecution,
+	request *adminservice.UpdateWorkflowExecutionRequest,
+) (*failurepb.Failure, error) {
+
+	resp, err := e.QueryWorkflow(ctx, &historyservice.QueryWorkflowRequest{
+		NamespaceId: namespaceEntry.ID().String(),
+		Request: &workflowservice.QueryWorkflowRequest{
+			Namespace: namespaceEntry.Name().String(),
+			Execution: &execution,
+			Query: &querypb.WorkflowQuery{
+				QueryType: workflow.UpdateValidatorQueryType,
+				QueryArgs: request.GetInput(),
+				Header:    workflow.NewUpdateHeader(request.GetUpdateId(), request.GetName()),
+			},
+			QueryRejectCondition: enumspb.QUERY_REJECT_CONDITION_NOT_OPEN,
+		},
+	})
+	return getUpdateRejection(resp, err)
+}
+
+// getUpdateRejection converts the outcome of update validator query into rejection failure.
+// Workflow without update validator accepts every update.
+func getUpdateRejection(
+	resp *historyservice.QueryWorkflowResponse,
+	err error,
+) (*failurepb.Failure, error) {
+
+	switch err := err.(type) {
+	case nil:
+		if resp.GetResponse().GetQueryRejected() != nil {
+			return nil, consts.ErrWorkflowCompleted
+		}
+		return nil, nil
+	case *serviceerror.QueryFailed:
+		if isUnknownQueryTypeError(err, workflow.UpdateValidatorQueryType) {
+			return nil, nil
+		}
+		return failure.NewServerFailure(err.Error(), true), nil
+	default:
+		return nil, err
+	}
+}
+
+// isUnknownQueryTypeError returns true if the worker failed the query because workflow has no
+// handler for the query type. SDKs report it as e.g. "unknown queryType <type>" or
+// "Unknown query type: <type>".
+func isUnknownQueryTypeError(err *serviceerror.QueryFailed, queryType string) bool {
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "unknown") && strings.Contains(message, strings.ToLower(queryType))
+}
+
+// getAcceptedUpdate returns outcome of completed update or in-flight update to wait for.
+// Both are nil if update with given id was not accepted.
+func getAcceptedUpdate(
+	mutableState workflow.MutableState,
+	updateID string,
+) (*workflow.InFlightUpdate, *workflow.UpdateOutcome, error) {
+
+	updateInfo, ok := mutableState.GetUpdateInfo(updateID)
+	if !ok {
+		return nil, nil, nil
+	}
+	if updateInfo.GetCompletedEventId() != common.EmptyEventID {
+		outcome, err := mutableState.GetUpdateOutcome(updateID)
+		return nil, outcome, err
+	}
+	if !mutableState.IsWorkflowExecutionRunning() {
+		return nil, nil, consts.ErrWorkflowCompleted
+	}
+	return mutableState.GetUpdateRegistry().Add(updateID), nil, nil
+}
+
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	signalWithStartRequest *historyservice.SignalWithStartWorkflowExecutionRequest,
@@ -1940,6 +2169,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	namespace := namespaceEntry.Name()
 
 	sRequest := signalWithStartRequest.SignalWithStartRequest
+	if workflow.IsUpdateSignalName(sRequest.GetSignalName()) {
+		return nil, consts.ErrSignalNameReserved
+	}
 	execution := commonpb.WorkflowExecution{
 		WorkflowId: sRequest.WorkflowId,
 	}
@@ -2027,12 +2259,16 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 	// Start workflow and signal
 	startRequest := e.getStartRequest(namespaceID, sRequest)
+	startRequest.Priority = signalWithStartRequest.GetPriority()
 	request := startRequest.StartRequest
 	e.overrideStartWorkflowExecutionRequest(request, metrics.HistorySignalWithStartWorkflowExecutionScope)
 	err = e.validateStartWorkflowExecutionRequest(ctx, request, namespace, "SignalWithStartWorkflowExecution")
 	if err != nil {
 		return nil, err
 	}
+	if err := priorities.Validate(startRequest.GetPriority()); err != nil {
+		return nil, err
+	}
 
 	if err := common.CheckEventBlobSizeLimit(
 		sRequest.GetSignalInput().Size(),
@@ -2089,6 +2325,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 			return nil, err
 		}
 	}
+	if err := e.openExecutionCounter.CheckLimit(namespaceEntry, request.WorkflowType.GetName()); err != nil {
+		return nil, err
+	}
 
 	// Add WF start event
 	startEvent, err := mutableState.AddWorkflowExecutionStartedEvent(
@@ -2278,13 +2517,15 @@ func (e *historyEngineImpl) DeleteWorkflowExecution(
 	}
 	defer func() { wfCtx.getReleaseFn()(retError) }()
 
+	// RunId is optional in the request, use the run that was actually loaded.
+	mutableState := wfCtx.getMutableState()
 	return e.workflowDeleteManager.AddDeleteWorkflowExecutionTask(
 		nsID,
 		commonpb.WorkflowExecution{
 			WorkflowId: request.GetWorkflowExecution().GetWorkflowId(),
-			RunId:      request.GetWorkflowExecution().GetRunId(),
+			RunId:      mutableState.GetExecutionState().GetRunId(),
 		},
-		wfCtx.getMutableState())
+		mutableState)
 }
 
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
@@ -2425,12 +2666,25 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		return nil, serviceerror.NewInvalidArgument("Workflow task finish ID must be > 1 && <= workflow last event ID.")
 	}
 
-	switch request.GetResetReapplyType() {
-	case enumspb.RESET_REAPPLY_TYPE_UNSPECIFIED:
-		return nil, serviceerror.NewInvalidArgument("reset type not set")
-	case enumspb.RESET_REAPPLY_TYPE_SIGNAL:
+	resetReapplyType := resetRequest.GetResetReapplyType()
+	if resetReapplyType == enumsspb.RESET_REAPPLY_TYPE_UNSPECIFIED {
+		switch request.GetResetReapplyType() {
+		case enumspb.RESET_REAPPLY_TYPE_UNSPECIFIED:
+			return nil, serviceerror.NewInvalidArgument("reset type not set")
+		case enumspb.RESET_REAPPLY_TYPE_SIGNAL:
+			resetReapplyType = enumsspb.RESET_REAPPLY_TYPE_SIGNAL
+		case enumspb.RESET_REAPPLY_TYPE_NONE:
+			resetReapplyType = enumsspb.RESET_REAPPLY_TYPE_NONE
+		default:
+			return nil, serviceerror.NewInternal("unknown reset type")
+		}
+	}
+	switch resetReapplyType {
+	case enumsspb.RESET_REAPPLY_TYPE_SIGNAL:
 		// noop
-	case enumspb.RESET_REAPPLY_TYPE_NONE:
+	case enumsspb.RESET_REAPPLY_TYPE_SIGNAL_AND_UPDATE:
+		// noop
+	case enumsspb.RESET_REAPPLY_TYPE_NONE:
 		// noop
 	default:
 		return nil, serviceerror.NewInternal("unknown reset type")
@@ -2524,7 +2778,7 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		),
 		request.GetReason(),
 		nil,
-		request.GetResetReapplyType(),
+		resetReapplyType,
 	); err != nil {
 		return nil, err
 	}
@@ -2947,6 +3201,47 @@ func (e *historyEngineImpl) applyWorkflowIDReusePolicyHelper(
 	return nil
 }
 
+// checkOpenExecutionLimit checks the open workflow executions limit for starting the given execution.
+// The limit only applies to starts which would create a new run: if the current run was created by the
+// same request its run ID is returned, and if the ID reuse policy rejects the start its error is returned.
+func (e *historyEngineImpl) checkOpenExecutionLimit(
+	namespaceEntry *namespace.Namespace,
+	request *workflowservice.StartWorkflowExecutionRequest,
+	execution commonpb.WorkflowExecution,
+) (string, error) {
+
+	limitErr := e.openExecutionCounter.CheckLimit(namespaceEntry, request.WorkflowType.GetName())
+	if limitErr == nil {
+		return "", nil
+	}
+
+	resp, err := e.shard.GetExecutionManager().GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		ShardID:     e.shard.GetShardID(),
+		NamespaceID: namespaceEntry.ID().String(),
+		WorkflowID:  execution.GetWorkflowId(),
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return "", limitErr
+		}
+		return "", err
+	}
+	if resp.StartRequestID == request.GetRequestId() {
+		return resp.RunID, nil
+	}
+	if err := e.applyWorkflowIDReusePolicyHelper(
+		resp.StartRequestID,
+		resp.RunID,
+		resp.State,
+		resp.Status,
+		execution,
+		request.GetWorkflowIdReusePolicy(),
+	); err != nil {
+		return "", err
+	}
+	return "", limitErr
+}
+
 func getWorkflowAlreadyStartedError(errMsg string, createRequestID string, workflowID string, runID string) error {
 	return serviceerror.NewWorkflowExecutionAlreadyStarted(
 		fmt.Sprintf(errMsg, workflowID, runID),
@@ -2958,25 +3253,51 @@ func getWorkflowAlreadyStartedError(errMsg string, createRequestID string, workf
 func (e *historyEngineImpl) GetReplicationMessages(
 	ctx context.Context,
 	pollingCluster string,
+	pollingShardID int32,
+	pollingShardCount int32,
 	ackMessageID int64,
 	ackTimestampe time.Time,
 	queryMessageID int64,
 ) (*replicationspb.ReplicationMessages, error) {
 
+	// polling cluster has a different number of history shards, e.g. during shard count migration,
+	// each of its shards only receives the tasks of workflows it owns and acks independently
+	remoteShardCountMismatch := pollingShardCount != 0 && pollingShardCount != e.config.NumberOfShards
+
 	if ackMessageID != persistence.EmptyQueueMessageID {
-		if err := e.shard.UpdateClusterReplicationLevel(
-			pollingCluster,
-			ackMessageID,
-			ackTimestampe,
-		); err != nil {
+		var err error
+		if remoteShardCountMismatch {
+			err = e.shard.UpdateRemoteShardReplicationLevel(
+				pollingCluster,
+				pollingShardID,
+				pollingShardCount,
+				ackMessageID,
+				ackTimestampe,
+			)
+		} else {
+			err = e.shard.UpdateClusterReplicationLevel(
+				pollingCluster,
+				ackMessageID,
+				ackTimestampe,
+			)
+		}
+		if err != nil {
 			e.logger.Error("error updating replication level for shard", tag.Error(err), tag.OperationFailed)
 		}
 	}
 
+	var taskFilter replicationTaskFilter
+	if remoteShardCountMismatch {
+		taskFilter = func(task tasks.Task) bool {
+			return common.WorkflowIDToHistoryShard(task.GetNamespaceID(), task.GetWorkflowID(), pollingShardCount) == pollingShardID
+		}
+	}
+
 	replicationMessages, err := e.replicatorProcessor.paginateTasks(
 		ctx,
 		pollingCluster,
 		queryMessageID,
+		taskFilter,
 	)
 	if err != nil {
 		e.logger.Error("Failed to retrieve replication messages.", tag.Error(err))
@@ -3111,7 +3432,7 @@ func (e *historyEngineImpl) ReapplyEvents(
 					),
 					eventsReapplicationResetWorkflowReason,
 					toReapplyEvents,
-					enumspb.RESET_REAPPLY_TYPE_SIGNAL,
+					enumsspb.RESET_REAPPLY_TYPE_SIGNAL_AND_UPDATE,
 				); err != nil {
 					return nil, err
 				}
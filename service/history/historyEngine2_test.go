@@ -65,6 +65,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -151,18 +152,19 @@ func (s *engine2Suite) SetupTest() {
 
 	historyCache := workflow.NewCache(s.mockShard)
 	h := &historyEngineImpl{
-		currentClusterName: s.mockShard.GetClusterMetadata().GetCurrentClusterName(),
-		shard:              s.mockShard,
-		clusterMetadata:    s.mockClusterMetadata,
-		executionManager:   s.mockExecutionMgr,
-		historyCache:       historyCache,
-		logger:             s.logger,
-		throttledLogger:    s.logger,
-		metricsClient:      metrics.NewNoopMetricsClient(),
-		tokenSerializer:    common.NewProtoTaskTokenSerializer(),
-		config:             s.config,
-		timeSource:         s.mockShard.GetTimeSource(),
-		eventNotifier:      events.NewNotifier(clock.NewRealTimeSource(), metrics.NewNoopMetricsClient(), func(namespace.ID, string) int32 { return 1 }),
+		currentClusterName:   s.mockShard.GetClusterMetadata().GetCurrentClusterName(),
+		shard:                s.mockShard,
+		clusterMetadata:      s.mockClusterMetadata,
+		executionManager:     s.mockExecutionMgr,
+		historyCache:         historyCache,
+		logger:               s.logger,
+		throttledLogger:      s.logger,
+		metricsClient:        metrics.NewNoopMetricsClient(),
+		tokenSerializer:      common.NewProtoTaskTokenSerializer(),
+		config:               s.config,
+		timeSource:           s.mockShard.GetTimeSource(),
+		openExecutionCounter: NewOpenExecutionCounter(s.config, nil, nil, s.logger),
+		eventNotifier:        events.NewNotifier(clock.NewRealTimeSource(), metrics.NewNoopMetricsClient(), func(namespace.ID, string) int32 { return 1 }),
 		queueProcessors: map[tasks.Category]queues.Processor{
 			s.mockTxProcessor.Category():         s.mockTxProcessor,
 			s.mockTimerProcessor.Category():      s.mockTimerProcessor,
@@ -1070,6 +1072,102 @@ func (s *engine2Suite) TestStartWorkflowExecution_BrandNew() {
 	s.NotNil(resp.RunId)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_OpenExecutionsLimitExceeded() {
+	namespaceID := tests.NamespaceID
+	workflowType := "workflowType"
+
+	s.config.MaxOpenWorkflowExecutionsPerNamespace = dynamicconfig.GetIntPropertyFilteredByNamespace(2)
+	s.config.MaxOpenWorkflowExecutionsPerWorkflowType = dynamicconfig.GetIntPropertyFilteredByWorkflowType(1)
+	counter := s.historyEngine.openExecutionCounter.(*openExecutionCounterImpl)
+
+	newRequest := func() *historyservice.StartWorkflowExecutionRequest {
+		return &historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				Namespace:                namespaceID.String(),
+				WorkflowId:               "workflowID",
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: "testTaskQueue"},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(20 * time.Second),
+				WorkflowRunTimeout:       timestamp.DurationPtr(1 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(2 * time.Second),
+				Identity:                 "testIdentity",
+				RequestId:                uuid.New(),
+			},
+		}
+	}
+
+	s.mockExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any()).Return(nil, serviceerror.NewNotFound("")).Times(2)
+
+	counter.counts = map[string]*persistencespb.OpenExecutionCounts{
+		namespaceID.String(): {Total: 1, WorkflowTypes: map[string]int64{workflowType: 1}},
+	}
+	_, err := s.historyEngine.StartWorkflowExecution(metrics.AddMetricsContext(context.Background()), newRequest())
+	s.Equal(consts.ErrWorkflowTypeOpenExecutionsLimitExceeded, err)
+
+	counter.counts = map[string]*persistencespb.OpenExecutionCounts{
+		namespaceID.String(): {Total: 2, WorkflowTypes: map[string]int64{"otherWorkflowType": 2}},
+	}
+	_, err = s.historyEngine.StartWorkflowExecution(metrics.AddMetricsContext(context.Background()), newRequest())
+	s.Equal(consts.ErrNamespaceOpenExecutionsLimitExceeded, err)
+
+	counter.counts = map[string]*persistencespb.OpenExecutionCounts{
+		namespaceID.String(): {Total: 1, WorkflowTypes: map[string]int64{"otherWorkflowType": 1}},
+	}
+	s.mockExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any()).Return(tests.CreateWorkflowExecutionResponse, nil)
+	resp, err := s.historyEngine.StartWorkflowExecution(metrics.AddMetricsContext(context.Background()), newRequest())
+	s.NoError(err)
+	s.NotEmpty(resp.RunId)
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_OpenExecutionsLimitExceeded_CurrentRun() {
+	namespaceID := tests.NamespaceID
+	workflowType := "workflowType"
+	requestID := uuid.New()
+	runID := uuid.New()
+
+	s.config.MaxOpenWorkflowExecutionsPerNamespace = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+	counter := s.historyEngine.openExecutionCounter.(*openExecutionCounterImpl)
+	counter.counts = map[string]*persistencespb.OpenExecutionCounts{
+		namespaceID.String(): {Total: 1, WorkflowTypes: map[string]int64{workflowType: 1}},
+	}
+
+	newRequest := func(requestID string) *historyservice.StartWorkflowExecutionRequest {
+		return &historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				Namespace:                namespaceID.String(),
+				WorkflowId:               "workflowID",
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: "testTaskQueue"},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(20 * time.Second),
+				WorkflowRunTimeout:       timestamp.DurationPtr(1 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(2 * time.Second),
+				Identity:                 "testIdentity",
+				RequestId:                requestID,
+			},
+		}
+	}
+
+	s.mockExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any()).Return(&persistence.GetCurrentExecutionResponse{
+		StartRequestID: requestID,
+		RunID:          runID,
+		State:          enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		Status:         enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}, nil).Times(2)
+
+	// retry of the request which started the current run
+	resp, err := s.historyEngine.StartWorkflowExecution(metrics.AddMetricsContext(context.Background()), newRequest(requestID))
+	s.NoError(err)
+	s.Equal(runID, resp.RunId)
+
+	// other request for the running workflow
+	_, err = s.historyEngine.StartWorkflowExecution(metrics.AddMetricsContext(context.Background()), newRequest(uuid.New()))
+	s.IsType(&serviceerror.WorkflowExecutionAlreadyStarted{}, err)
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_BrandNew_SearchAttributes() {
 	namespaceID := tests.NamespaceID
 	workflowID := "workflowID"
@@ -52,6 +52,8 @@ type (
 		clientBean              client.Bean
 		archiverProvider        provider.ArchiverProvider
 		registry                namespace.Registry
+		openExecutionCounter    OpenExecutionCounter
+		taskWorkerLimiter       TaskWorkerLimiter
 	}
 )
 
@@ -68,6 +70,8 @@ func NewEngineFactory(
 	clientBean client.Bean,
 	archiverProvider provider.ArchiverProvider,
 	registry namespace.Registry,
+	openExecutionCounter OpenExecutionCounter,
+	taskWorkerLimiter TaskWorkerLimiter,
 ) shard.EngineFactory {
 	return &historyEngineFactory{
 		visibilityMgr:           visibilityMgr,
@@ -82,6 +86,8 @@ func NewEngineFactory(
 		clientBean:              clientBean,
 		archiverProvider:        archiverProvider,
 		registry:                registry,
+		openExecutionCounter:    openExecutionCounter,
+		taskWorkerLimiter:       taskWorkerLimiter,
 	}
 }
 
@@ -102,5 +108,7 @@ func (f *historyEngineFactory) CreateEngine(
 		f.clientBean,
 		f.archiverProvider,
 		f.registry,
+		f.openExecutionCounter,
+		f.taskWorkerLimiter,
 	)
 }
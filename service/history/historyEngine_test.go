@@ -48,6 +48,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -4567,6 +4568,99 @@ func (s *engineSuite) TestSignalWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestSignalWorkflowExecution_ReservedSignalName() {
+	signalRequest := &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace: tests.NamespaceID.String(),
+			WorkflowExecution: &commonpb.WorkflowExecution{
+				WorkflowId: tests.WorkflowID,
+				RunId:      tests.RunID,
+			},
+			Identity:   "testIdentity",
+			SignalName: workflow.UpdateSignalNamePrefix + "update",
+			Header:     workflow.NewUpdateHeader("update-id", "update"),
+		},
+	}
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Equal(consts.ErrSignalNameReserved, err)
+}
+
+func (s *engineSuite) TestGetUpdateRejection() {
+	rejection, err := getUpdateRejection(&historyservice.QueryWorkflowResponse{
+		Response: &workflowservice.QueryWorkflowResponse{},
+	}, nil)
+	s.NoError(err)
+	s.Nil(rejection)
+
+	rejection, err = getUpdateRejection(nil, serviceerror.NewQueryFailed("invalid update input"))
+	s.NoError(err)
+	s.Equal("invalid update input", rejection.GetMessage())
+
+	// workflow without update validator accepts the update
+	rejection, err = getUpdateRejection(nil, serviceerror.NewQueryFailed(
+		"unknown queryType "+workflow.UpdateValidatorQueryType+". KnownQueryTypes=[__stack_trace]",
+	))
+	s.NoError(err)
+	s.Nil(rejection)
+	rejection, err = getUpdateRejection(nil, serviceerror.NewQueryFailed(
+		"Unknown query type: "+workflow.UpdateValidatorQueryType+", knownTypes=[]",
+	))
+	s.NoError(err)
+	s.Nil(rejection)
+
+	_, err = getUpdateRejection(nil, serviceerror.NewUnavailable("unavailable"))
+	s.IsType(&serviceerror.Unavailable{}, err)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_DuplicateCompletedUpdate() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+	updateID := "update-id"
+	result := payloads.EncodeString("update result")
+
+	msBuilder := workflow.TestLocalMutableState(s.mockHistoryEngine.shard, s.eventsCache,
+		tests.LocalNamespaceEntry, log.NewTestLogger(), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	_, err := msBuilder.AddWorkflowExecutionUpdateAcceptedEvent(updateID, "update", payloads.EncodeString("update input"), identity)
+	s.NoError(err)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	startedEvent := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	completedEvent := addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.GetEventId(), identity)
+	_, err = msBuilder.AddRecordMarkerEvent(completedEvent.GetEventId(), &commandpb.RecordMarkerCommandAttributes{
+		MarkerName: workflow.UpdateCompletedMarkerName,
+		Header:     workflow.NewUpdateHeader(updateID, "update"),
+		Details:    map[string]*commonpb.Payloads{workflow.UpdateResultMarkerDetailsKey: result},
+	})
+	s.NoError(err)
+	ms := workflow.TestCloneToProto(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	// retry of completed update gets its outcome without validating and accepting the update again
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any()).Return(gwmsResponse, nil)
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Request: &adminservice.UpdateWorkflowExecutionRequest{
+			Namespace: tests.Namespace.String(),
+			Execution: &we,
+			UpdateId:  updateID,
+			Name:      "update",
+			Input:     payloads.EncodeString("update input"),
+			Identity:  identity,
+		},
+	})
+	s.NoError(err)
+	s.Equal(updateID, resp.GetResponse().GetUpdateId())
+	s.False(resp.GetResponse().GetRejected())
+	s.Equal(result, resp.GetResponse().GetResult())
+}
+
 // Test signal workflow task by adding request ID
 func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest() {
 	signalRequest := &historyservice.SignalWorkflowExecutionRequest{}
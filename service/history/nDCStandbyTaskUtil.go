@@ -100,11 +100,14 @@ type (
 
 	pushActivityTaskToMatchingInfo struct {
 		activityTaskScheduleToStartTimeout time.Duration
+		priority                           int32
 	}
 
 	pushWorkflowTaskToMatchingInfo struct {
 		workflowTaskScheduleToStartTimeout int64
 		taskqueue                          taskqueuepb.TaskQueue
+		workerBuildID                      string
+		priority                           int32
 	}
 )
 
@@ -120,21 +123,27 @@ func newHistoryResendInfo(
 
 func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout time.Duration,
+	priority int32,
 ) *pushActivityTaskToMatchingInfo {
 
 	return &pushActivityTaskToMatchingInfo{
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
+		priority:                           priority,
 	}
 }
 
 func newPushWorkflowTaskToMatchingInfo(
 	workflowTaskScheduleToStartTimeout int64,
 	taskqueue taskqueuepb.TaskQueue,
+	workerBuildID string,
+	priority int32,
 ) *pushWorkflowTaskToMatchingInfo {
 
 	return &pushWorkflowTaskToMatchingInfo{
 		workflowTaskScheduleToStartTimeout: workflowTaskScheduleToStartTimeout,
 		taskqueue:                          taskqueue,
+		workerBuildID:                      workerBuildID,
+		priority:                           priority,
 	}
 }
 
@@ -34,9 +34,9 @@ import (
 
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
-	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
@@ -358,7 +358,7 @@ func (r *nDCTransactionMgrImpl) backfillWorkflowEventsReapply(
 			targetWorkflow,
 			eventsReapplicationResetWorkflowReason,
 			targetWorkflowEvents.Events,
-			enumspb.RESET_REAPPLY_TYPE_SIGNAL,
+			enumsspb.RESET_REAPPLY_TYPE_SIGNAL_AND_UPDATE,
 		); err != nil {
 			return 0, workflow.TransactionPolicyActive, err
 		}
@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sort"
+	"sync"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	// namespaceTaskScheduler buffers the tasks loaded by a queue processor and hands them to the task
+	// workers in interleaved weighted round robin order across namespaces, so a burst of tasks from one
+	// namespace cannot starve the other namespaces on the same shard.
+	// ref: https://en.wikipedia.org/wiki/Weighted_round_robin#Interleaved_WRR
+	namespaceTaskScheduler struct {
+		weightFn dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+		sync.Mutex
+		queues map[namespace.ID]*namespaceTaskQueue
+		// precalculated / flattened namespace queues according to weight, rebuilt once exhausted
+		// e.g. if weights are {A: 3, B: 2, C: 1}, then schedule will contain [A, A, B, A, B, C]
+		schedule []*namespaceTaskQueue
+		cursor   int
+	}
+
+	namespaceTaskQueue struct {
+		namespaceID   namespace.ID
+		namespaceName namespace.Name
+		weight        int
+		tasks         []*taskInfo
+	}
+)
+
+func newNamespaceTaskScheduler(
+	weightFn dynamicconfig.IntPropertyFnWithNamespaceFilter,
+) *namespaceTaskScheduler {
+	return &namespaceTaskScheduler{
+		weightFn: weightFn,
+		queues:   make(map[namespace.ID]*namespaceTaskQueue),
+	}
+}
+
+func (s *namespaceTaskScheduler) add(
+	task *taskInfo,
+	namespaceName namespace.Name,
+) {
+	s.Lock()
+	defer s.Unlock()
+
+	namespaceID := namespace.ID(task.GetNamespaceID())
+	queue, ok := s.queues[namespaceID]
+	if !ok {
+		queue = &namespaceTaskQueue{
+			namespaceID:   namespaceID,
+			namespaceName: namespaceName,
+		}
+		s.queues[namespaceID] = queue
+	}
+	queue.tasks = append(queue.tasks, task)
+}
+
+// next returns the next task to execute, skipping namespaces for which tryAcquire returns false,
+// or nil if there is no task which can be executed now.
+func (s *namespaceTaskScheduler) next(
+	tryAcquire func(namespaceID namespace.ID, namespaceName namespace.Name) bool,
+) *taskInfo {
+	s.Lock()
+	defer s.Unlock()
+
+	// the second pass goes through a freshly built schedule which covers all buffered tasks
+	for pass := 0; pass < 2; pass++ {
+		for s.cursor < len(s.schedule) {
+			queue := s.schedule[s.cursor]
+			s.cursor++
+			if len(queue.tasks) == 0 || !tryAcquire(queue.namespaceID, queue.namespaceName) {
+				continue
+			}
+
+			task := queue.tasks[0]
+			queue.tasks[0] = nil
+			queue.tasks = queue.tasks[1:]
+			return task
+		}
+		s.rebuildScheduleLocked()
+	}
+	return nil
+}
+
+func (s *namespaceTaskScheduler) len() int {
+	s.Lock()
+	defer s.Unlock()
+
+	size := 0
+	for _, queue := range s.queues {
+		size += len(queue.tasks)
+	}
+	return size
+}
+
+func (s *namespaceTaskScheduler) rebuildScheduleLocked() {
+	queues := make([]*namespaceTaskQueue, 0, len(s.queues))
+	for namespaceID, queue := range s.queues {
+		if len(queue.tasks) == 0 {
+			delete(s.queues, namespaceID)
+			continue
+		}
+		queue.weight = s.weightFn(queue.namespaceName.String())
+		if queue.weight < 1 {
+			queue.weight = 1
+		}
+		queues = append(queues, queue)
+	}
+	sort.Slice(queues, func(i, j int) bool {
+		if queues[i].weight != queues[j].weight {
+			return queues[i].weight > queues[j].weight
+		}
+		return queues[i].namespaceID < queues[j].namespaceID
+	})
+
+	var schedule []*namespaceTaskQueue
+	if len(queues) > 0 {
+		for round := queues[0].weight - 1; round > -1; round-- {
+			for index := 0; index < len(queues) && queues[index].weight > round; index++ {
+				schedule = append(schedule, queues[index])
+			}
+		}
+	}
+	s.schedule = schedule
+	s.cursor = 0
+}
@@ -0,0 +1,140 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	namespaceTaskSchedulerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		weights   map[string]int
+		scheduler *namespaceTaskScheduler
+	}
+)
+
+func TestNamespaceTaskSchedulerSuite(t *testing.T) {
+	s := new(namespaceTaskSchedulerSuite)
+	suite.Run(t, s)
+}
+
+func (s *namespaceTaskSchedulerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.weights = make(map[string]int)
+	s.scheduler = newNamespaceTaskScheduler(func(namespaceName string) int {
+		if weight, ok := s.weights[namespaceName]; ok {
+			return weight
+		}
+		return 1
+	})
+}
+
+func (s *namespaceTaskSchedulerSuite) TestNext_Empty() {
+	s.Nil(s.scheduler.next(allowAllNamespaces))
+}
+
+func (s *namespaceTaskSchedulerSuite) TestNext_RoundRobin() {
+	for i := 0; i < 10; i++ {
+		s.addTask("busy-namespace", int64(i))
+	}
+	s.addTask("quiet-namespace", 100)
+
+	// the single task of the quiet namespace must not wait for the burst of the busy namespace
+	var order []string
+	for i := 0; i < 3; i++ {
+		order = append(order, s.scheduler.next(allowAllNamespaces).GetNamespaceID())
+	}
+	s.Contains(order, "quiet-namespace")
+	s.Equal(8, s.scheduler.len())
+}
+
+func (s *namespaceTaskSchedulerSuite) TestNext_Weighted() {
+	s.weights["namespace-a"] = 3
+	s.weights["namespace-b"] = 1
+	for i := 0; i < 8; i++ {
+		s.addTask("namespace-a", int64(i))
+		s.addTask("namespace-b", int64(i))
+	}
+
+	counts := make(map[string]int)
+	for i := 0; i < 8; i++ {
+		counts[s.scheduler.next(allowAllNamespaces).GetNamespaceID()]++
+	}
+	s.Equal(map[string]int{"namespace-a": 6, "namespace-b": 2}, counts)
+}
+
+func (s *namespaceTaskSchedulerSuite) TestNext_FIFOWithinNamespace() {
+	for i := 0; i < 5; i++ {
+		s.addTask("namespace", int64(i))
+	}
+
+	for i := 0; i < 5; i++ {
+		s.Equal(int64(i), s.scheduler.next(allowAllNamespaces).GetTaskID())
+	}
+	s.Nil(s.scheduler.next(allowAllNamespaces))
+}
+
+func (s *namespaceTaskSchedulerSuite) TestNext_SkipThrottledNamespace() {
+	s.addTask("throttled-namespace", 1)
+	s.addTask("throttled-namespace", 2)
+	s.addTask("other-namespace", 3)
+
+	tryAcquire := func(namespaceID namespace.ID, namespaceName namespace.Name) bool {
+		return namespaceID != "throttled-namespace"
+	}
+	s.Equal(int64(3), s.scheduler.next(tryAcquire).GetTaskID())
+	s.Nil(s.scheduler.next(tryAcquire))
+
+	s.Equal(int64(1), s.scheduler.next(allowAllNamespaces).GetTaskID())
+	s.Equal(int64(2), s.scheduler.next(allowAllNamespaces).GetTaskID())
+	s.Nil(s.scheduler.next(allowAllNamespaces))
+}
+
+func (s *namespaceTaskSchedulerSuite) addTask(
+	namespaceID string,
+	taskID int64,
+) {
+	task := &tasks.ActivityTask{
+		WorkflowKey: definition.NewWorkflowKey(namespaceID, "workflow-id", "run-id"),
+		TaskID:      taskID,
+	}
+	s.scheduler.add(newTaskInfo(nil, task, log.NewNoopLogger()), namespace.Name(namespaceID))
+}
+
+func allowAllNamespaces(_ namespace.ID, _ namespace.Name) bool {
+	return true
+}
@@ -0,0 +1,211 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/shard"
+)
+
+const (
+	openExecutionCountRefreshTimeout           = 5 * time.Second
+	openExecutionCountRefreshJitterCoefficient = 0.2
+)
+
+type (
+	// OpenExecutionCounter keeps approximate cluster wide counts of open workflow executions, collected
+	// periodically from the counters maintained by history shards on every history host, and enforces
+	// open workflow execution limits.
+	OpenExecutionCounter interface {
+		common.Daemon
+
+		// CheckLimit returns a ResourceExhausted error if the namespace or the workflow type already reached
+		// the configured limit of open workflow executions.
+		CheckLimit(namespaceEntry *namespace.Namespace, workflowType string) error
+	}
+
+	openExecutionCounterImpl struct {
+		status                 int32
+		config                 *configs.Config
+		historyClient          historyservice.HistoryServiceClient
+		historyServiceResolver membership.ServiceResolver
+		logger                 log.Logger
+		shutdownCh             chan struct{}
+
+		sync.RWMutex
+		// counts reported by each history host keyed by host address
+		hostCounts map[string]map[string]*persistencespb.OpenExecutionCounts
+		// sum of hostCounts keyed by namespace ID
+		counts map[string]*persistencespb.OpenExecutionCounts
+	}
+)
+
+var _ OpenExecutionCounter = (*openExecutionCounterImpl)(nil)
+
+// NewOpenExecutionCounter creates a new open workflow execution counter
+func NewOpenExecutionCounter(
+	config *configs.Config,
+	historyClient historyservice.HistoryServiceClient,
+	historyServiceResolver membership.ServiceResolver,
+	logger log.Logger,
+) OpenExecutionCounter {
+	return &openExecutionCounterImpl{
+		status:                 common.DaemonStatusInitialized,
+		config:                 config,
+		historyClient:          historyClient,
+		historyServiceResolver: historyServiceResolver,
+		logger:                 logger,
+		shutdownCh:             make(chan struct{}),
+		hostCounts:             make(map[string]map[string]*persistencespb.OpenExecutionCounts),
+		counts:                 make(map[string]*persistencespb.OpenExecutionCounts),
+	}
+}
+
+// Start starts the periodic collection of open workflow execution counts
+func (c *openExecutionCounterImpl) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&c.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	go c.refreshLoop()
+	c.logger.Info("Open execution counter started.")
+}
+
+// Stop stops the periodic collection of open workflow execution counts
+func (c *openExecutionCounterImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&c.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	close(c.shutdownCh)
+	c.logger.Info("Open execution counter stopped.")
+}
+
+func (c *openExecutionCounterImpl) CheckLimit(
+	namespaceEntry *namespace.Namespace,
+	workflowType string,
+) error {
+	namespaceName := namespaceEntry.Name().String()
+	namespaceLimit := c.config.MaxOpenWorkflowExecutionsPerNamespace(namespaceName)
+	workflowTypeLimit := c.config.MaxOpenWorkflowExecutionsPerWorkflowType(namespaceName, workflowType)
+	if namespaceLimit <= 0 && workflowTypeLimit <= 0 {
+		return nil
+	}
+
+	c.RLock()
+	counts := c.counts[namespaceEntry.ID().String()]
+	c.RUnlock()
+
+	if namespaceLimit > 0 && counts.GetTotal() >= int64(namespaceLimit) {
+		return consts.ErrNamespaceOpenExecutionsLimitExceeded
+	}
+	if workflowTypeLimit > 0 && counts.GetWorkflowTypes()[workflowType] >= int64(workflowTypeLimit) {
+		return consts.ErrWorkflowTypeOpenExecutionsLimitExceeded
+	}
+	return nil
+}
+
+func (c *openExecutionCounterImpl) refreshLoop() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-timer.C:
+			c.refresh()
+			timer.Reset(backoff.JitDuration(
+				c.config.OpenExecutionCountRefreshInterval(),
+				openExecutionCountRefreshJitterCoefficient,
+			))
+		}
+	}
+}
+
+// refresh collects open workflow execution counts from all history hosts. Counts of hosts which can't be reached
+// are kept from the previous refresh, counts of hosts which left the cluster are dropped.
+func (c *openExecutionCounterImpl) refresh() {
+	members := c.historyServiceResolver.Members()
+	hostCounts := make(map[string]map[string]*persistencespb.OpenExecutionCounts, len(members))
+	for _, host := range members {
+		address := host.GetAddress()
+		counts, err := c.getHostCounts(address)
+		if err != nil {
+			c.logger.Warn("Failed to get open execution counts from host.", tag.Address(address), tag.Error(err))
+			c.RLock()
+			counts = c.hostCounts[address]
+			c.RUnlock()
+		}
+		hostCounts[address] = counts
+	}
+
+	counts := make(map[string]*persistencespb.OpenExecutionCounts)
+	for _, hostCount := range hostCounts {
+		shard.MergeOpenExecutionCounts(counts, hostCount)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	c.hostCounts = hostCounts
+	c.counts = counts
+}
+
+func (c *openExecutionCounterImpl) getHostCounts(
+	address string,
+) (map[string]*persistencespb.OpenExecutionCounts, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), openExecutionCountRefreshTimeout)
+	defer cancel()
+
+	resp, err := c.historyClient.GetOpenExecutionCounts(ctx, &historyservice.GetOpenExecutionCountsRequest{
+		HostAddress: address,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetNamespaceCounts(), nil
+}
@@ -0,0 +1,146 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/tests"
+)
+
+type (
+	openExecutionCounterSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller           *gomock.Controller
+		mockHistoryClient    *historyservicemock.MockHistoryServiceClient
+		mockHistoryResolver  *membership.MockServiceResolver
+		config               *configs.Config
+		openExecutionCounter *openExecutionCounterImpl
+		namespaceEntry       *namespace.Namespace
+		otherNamespaceEntry  *namespace.Namespace
+	}
+)
+
+func TestOpenExecutionCounterSuite(t *testing.T) {
+	s := new(openExecutionCounterSuite)
+	suite.Run(t, s)
+}
+
+func (s *openExecutionCounterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.mockHistoryResolver = membership.NewMockServiceResolver(s.controller)
+
+	s.config = tests.NewDynamicConfig()
+	s.openExecutionCounter = NewOpenExecutionCounter(
+		s.config,
+		s.mockHistoryClient,
+		s.mockHistoryResolver,
+		log.NewTestLogger(),
+	).(*openExecutionCounterImpl)
+
+	s.namespaceEntry = tests.GlobalNamespaceEntry
+	s.otherNamespaceEntry = tests.GlobalTargetNamespaceEntry
+}
+
+func (s *openExecutionCounterSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *openExecutionCounterSuite) TestRefresh() {
+	s.mockHistoryResolver.EXPECT().Members().Return([]*membership.HostInfo{
+		membership.NewHostInfo("host1", nil),
+		membership.NewHostInfo("host2", nil),
+	})
+	s.mockHistoryClient.EXPECT().GetOpenExecutionCounts(gomock.Any(), &historyservice.GetOpenExecutionCountsRequest{HostAddress: "host1"}).
+		Return(&historyservice.GetOpenExecutionCountsResponse{
+			NamespaceCounts: map[string]*persistencespb.OpenExecutionCounts{
+				s.namespaceEntry.ID().String(): {Total: 3, WorkflowTypes: map[string]int64{"type1": 1, "type2": 2}},
+			},
+		}, nil)
+	s.mockHistoryClient.EXPECT().GetOpenExecutionCounts(gomock.Any(), &historyservice.GetOpenExecutionCountsRequest{HostAddress: "host2"}).
+		Return(&historyservice.GetOpenExecutionCountsResponse{
+			NamespaceCounts: map[string]*persistencespb.OpenExecutionCounts{
+				s.namespaceEntry.ID().String():      {Total: 1, WorkflowTypes: map[string]int64{"type1": 1}},
+				s.otherNamespaceEntry.ID().String(): {Total: 5, WorkflowTypes: map[string]int64{"type1": 5}},
+			},
+		}, nil)
+	s.openExecutionCounter.refresh()
+	s.Equal(map[string]*persistencespb.OpenExecutionCounts{
+		s.namespaceEntry.ID().String():      {Total: 4, WorkflowTypes: map[string]int64{"type1": 2, "type2": 2}},
+		s.otherNamespaceEntry.ID().String(): {Total: 5, WorkflowTypes: map[string]int64{"type1": 5}},
+	}, s.openExecutionCounter.counts)
+
+	// host1 is unavailable and its previous counts are used, host2 left the cluster
+	s.mockHistoryResolver.EXPECT().Members().Return([]*membership.HostInfo{
+		membership.NewHostInfo("host1", nil),
+	})
+	s.mockHistoryClient.EXPECT().GetOpenExecutionCounts(gomock.Any(), &historyservice.GetOpenExecutionCountsRequest{HostAddress: "host1"}).
+		Return(nil, serviceerror.NewUnavailable("host unavailable"))
+	s.openExecutionCounter.refresh()
+	s.Equal(map[string]*persistencespb.OpenExecutionCounts{
+		s.namespaceEntry.ID().String(): {Total: 3, WorkflowTypes: map[string]int64{"type1": 1, "type2": 2}},
+	}, s.openExecutionCounter.counts)
+}
+
+func (s *openExecutionCounterSuite) TestCheckLimit() {
+	s.openExecutionCounter.counts = map[string]*persistencespb.OpenExecutionCounts{
+		s.namespaceEntry.ID().String(): {Total: 4, WorkflowTypes: map[string]int64{"type1": 1, "type2": 3}},
+	}
+
+	// no limits configured
+	s.NoError(s.openExecutionCounter.CheckLimit(s.namespaceEntry, "type2"))
+
+	s.config.MaxOpenWorkflowExecutionsPerWorkflowType = func(namespace string, workflowType string) int {
+		if workflowType == "type2" {
+			return 3
+		}
+		return 0
+	}
+	s.NoError(s.openExecutionCounter.CheckLimit(s.namespaceEntry, "type1"))
+	s.Equal(consts.ErrWorkflowTypeOpenExecutionsLimitExceeded, s.openExecutionCounter.CheckLimit(s.namespaceEntry, "type2"))
+
+	s.config.MaxOpenWorkflowExecutionsPerNamespace = dynamicconfig.GetIntPropertyFilteredByNamespace(4)
+	s.Equal(consts.ErrNamespaceOpenExecutionsLimitExceeded, s.openExecutionCounter.CheckLimit(s.namespaceEntry, "type1"))
+	s.NoError(s.openExecutionCounter.CheckLimit(s.otherNamespaceEntry, "type1"))
+}
@@ -97,6 +97,7 @@ func newQueueProcessorBase(
 	processor processor,
 	queueAckMgr queueAckMgr,
 	historyCache workflow.Cache,
+	taskWorkerLimiter TaskWorkerLimiter,
 	logger log.Logger,
 	metricsScope metrics.Scope,
 ) *queueProcessorBase {
@@ -107,7 +108,7 @@ func newQueueProcessorBase(
 			queueSize:   options.BatchSize(),
 			workerCount: options.WorkerCount(),
 		}
-		taskProcessor = newTaskProcessor(taskProcessorOptions, shard, historyCache, logger)
+		taskProcessor = newTaskProcessor(taskProcessorOptions, shard, historyCache, taskWorkerLimiter, logger)
 	}
 
 	p := &queueProcessorBase{
@@ -72,6 +72,9 @@ type (
 		maxTaskID       *int64
 		sanityCheckTime time.Time
 	}
+
+	// replicationTaskFilter returns false for tasks that should not be sent to the polling shard
+	replicationTaskFilter func(task tasks.Task) bool
 )
 
 var (
@@ -148,6 +151,7 @@ func (p *replicatorQueueProcessorImpl) paginateTasks(
 	ctx context.Context,
 	pollingCluster string,
 	queryMessageID int64,
+	taskFilter replicationTaskFilter,
 ) (*replicationspb.ReplicationMessages, error) {
 
 	minTaskID, maxTaskID := p.taskIDsRange(queryMessageID)
@@ -156,6 +160,7 @@ func (p *replicatorQueueProcessorImpl) paginateTasks(
 		minTaskID,
 		maxTaskID,
 		p.pageSize,
+		taskFilter,
 	)
 	if err != nil {
 		return nil, err
@@ -197,6 +202,7 @@ func (p *replicatorQueueProcessorImpl) getTasks(
 	minTaskID int64,
 	maxTaskID int64,
 	batchSize int,
+	taskFilter replicationTaskFilter,
 ) ([]*replicationspb.ReplicationTask, int64, error) {
 
 	if minTaskID == maxTaskID {
@@ -219,6 +225,9 @@ func (p *replicatorQueueProcessorImpl) getTasks(
 
 		token = response.NextPageToken
 		for _, task := range response.Tasks {
+			if taskFilter != nil && !taskFilter(task) {
+				continue
+			}
 			if replicationTask, err := p.taskInfoToTask(
 				ctx,
 				task,
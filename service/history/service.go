@@ -25,6 +25,7 @@
 package history
 
 import (
+	"context"
 	"math/rand"
 	"net"
 	"sync/atomic"
@@ -117,16 +118,20 @@ func (s *Service) Stop() {
 		return
 	}
 
-	// initiate graceful shutdown :
-	// 1. remove self from the membership ring
-	// 2. wait for other members to discover we are going down
-	// 3. stop acquiring new shards (periodically or based on other membership changes)
-	// 4. wait for shard ownership to transfer (and inflight requests to drain) while still accepting new requests
-	// 5. Reject all requests arriving at rpc handler to avoid taking on more work except for RespondXXXCompleted and
+	// initiate graceful shutdown, it is opt-in: every step is bounded by the shutdown drain duration,
+	// which defaults to 0, so by default the host stops right away and other hosts steal its shards:
+	// 1. flush the queue ack levels of the shards one at a time, the other members acquire all shards
+	//    at once after the next step and start processing their queues from these ack levels
+	// 2. remove self from the membership ring
+	// 3. wait for other members to discover we are going down
+	// 4. stop acquiring new shards (periodically or based on other membership changes)
+	// 5. hand off shards to their new owners: redirect new requests, stop queue processing and close the shard
+	// 6. wait for shard ownership to transfer (and inflight requests to drain) while still accepting new requests
+	// 7. Reject all requests arriving at rpc handler to avoid taking on more work except for RespondXXXCompleted and
 	//    RecordXXStarted APIs - for these APIs, most of the work is already one and rejecting at last stage is
 	//    probably not that desirable. If the shard is closed, these requests will fail anyways.
-	// 6. wait for grace period
-	// 7. force stop the whole world and return
+	// 8. wait for grace period
+	// 9. force stop the whole world and return
 
 	const gossipPropagationDelay = 400 * time.Millisecond
 	const shardOwnershipTransferDelay = 5 * time.Second
@@ -134,12 +139,18 @@ func (s *Service) Stop() {
 
 	remainingTime := s.config.ShutdownDrainDuration()
 
+	logger.Info("ShutdownHandler: Flushing shards")
+	remainingTime = s.flushShards(remainingTime)
+
 	logger.Info("ShutdownHandler: Evicting self from membership ring")
 	_ = s.membershipMonitor.EvictSelf()
 
 	logger.Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
 	remainingTime = s.sleep(gossipPropagationDelay, remainingTime)
 
+	logger.Info("ShutdownHandler: Handing off shards")
+	remainingTime = s.drainShards(remainingTime)
+
 	logger.Info("ShutdownHandler: Initiating shardController shutdown")
 	s.handler.controller.Stop()
 	logger.Info("ShutdownHandler: Waiting for traffic to drain")
@@ -157,6 +168,32 @@ func (s *Service) Stop() {
 	logger.Info("history stopped")
 }
 
+// flushShards flushes shards until they are all flushed or the available duration elapses
+// returns the remaining available time duration
+func (s *Service) flushShards(available time.Duration) time.Duration {
+	if available <= 0 {
+		return available
+	}
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), available)
+	defer cancel()
+	s.handler.controller.FlushShards(ctx)
+	return available - time.Since(start)
+}
+
+// drainShards hands off shards until they are all gone or the available duration elapses
+// returns the remaining available time duration
+func (s *Service) drainShards(available time.Duration) time.Duration {
+	if available <= 0 {
+		return available
+	}
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), available)
+	defer cancel()
+	s.handler.controller.DrainShards(ctx)
+	return available - time.Since(start)
+}
+
 // sleep sleeps for the minimum of desired and available duration
 // returns the remaining available time duration
 func (s *Service) sleep(desired time.Duration, available time.Duration) time.Duration {
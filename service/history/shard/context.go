@@ -93,6 +93,7 @@ type (
 
 		GetClusterReplicationLevel(cluster string) int64
 		UpdateClusterReplicationLevel(cluster string, ackTaskID int64, ackTimestamp time.Time) error
+		UpdateRemoteShardReplicationLevel(cluster string, remoteShardID int32, remoteShardCount int32, ackTaskID int64, ackTimestamp time.Time) error
 
 		GetTimerAckLevel() time.Time
 		UpdateTimerAckLevel(ackLevel time.Time) error
@@ -128,6 +129,8 @@ type (
 		GetSearchAttributesMapper() searchattribute.Mapper
 		GetArchivalMetadata() archiver.ArchivalMetadata
 
+		RecordMutableStateCacheLookup(hit bool)
+
 		Unload()
 	}
 )
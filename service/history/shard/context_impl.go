@@ -27,6 +27,7 @@ package shard
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
@@ -55,6 +56,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/tasks"
@@ -102,6 +104,7 @@ type (
 		clusterMetadata         cluster.Metadata
 		archivalMetadata        archiver.ArchivalMetadata
 		hostInfoProvider        resource.HostInfoProvider
+		loadTracker             *loadTracker
 
 		// Context that lives for the lifetime of the shard context
 		lifecycleCtx    context.Context
@@ -117,6 +120,8 @@ type (
 		maxTransferSequenceNumber int64
 		transferMaxReadLevel      int64
 		timerMaxReadLevelMap      map[string]time.Time // cluster -> timerMaxReadLevel
+		handingOff                bool
+		handoffOwner              string // address of the new owner while handingOff, if known
 
 		// exist only in memory
 		remoteClusterInfos map[string]*remoteClusterInfo
@@ -127,6 +132,9 @@ type (
 		CurrentTime               time.Time
 		AckedReplicationTaskID    int64
 		AckedReplicationTimestamp time.Time
+		// ack timestamps keyed by remote shard id, only used when the remote
+		// cluster has a different number of history shards
+		AckedShardReplicationTimestamps map[int32]time.Time
 	}
 
 	namespaceHandOverInfo struct {
@@ -348,6 +356,63 @@ func (s *ContextImpl) UpdateClusterReplicationLevel(cluster string, ackTaskID in
 	return s.updateShardInfoLocked()
 }
 
+// UpdateRemoteShardReplicationLevel records the replication ack level of a single shard of a
+// remote cluster which has a different number of history shards than this cluster.
+// The cluster replication level is only advanced, to the minimum of all remote shard levels,
+// once every remote shard reading from this shard has reported.
+func (s *ContextImpl) UpdateRemoteShardReplicationLevel(
+	cluster string,
+	remoteShardID int32,
+	remoteShardCount int32,
+	ackTaskID int64,
+	ackTimestamp time.Time,
+) error {
+	s.wLock()
+	defer s.wUnlock()
+
+	if s.shardInfo.ClusterShardReplicationLevel == nil {
+		s.shardInfo.ClusterShardReplicationLevel = make(map[string]*persistencespb.RemoteShardReplicationLevels)
+	}
+	levels, ok := s.shardInfo.ClusterShardReplicationLevel[cluster]
+	if !ok {
+		levels = &persistencespb.RemoteShardReplicationLevels{}
+		s.shardInfo.ClusterShardReplicationLevel[cluster] = levels
+	}
+	if levels.ShardLevels == nil {
+		levels.ShardLevels = make(map[int32]int64)
+	}
+	levels.ShardLevels[remoteShardID] = ackTaskID
+
+	info := s.getRemoteClusterInfoLocked(cluster)
+	if info.AckedShardReplicationTimestamps == nil {
+		info.AckedShardReplicationTimestamps = make(map[int32]time.Time)
+	}
+	info.AckedShardReplicationTimestamps[remoteShardID] = ackTimestamp
+
+	minAckTaskID := int64(math.MaxInt64)
+	minAckTimestamp := ackTimestamp
+	for _, shardID := range common.TargetHistoryShardIDs(s.shardID, s.config.NumberOfShards, remoteShardCount) {
+		level, ok := levels.ShardLevels[shardID]
+		if !ok {
+			// not every remote shard has acked yet
+			s.shardInfo.StolenSinceRenew = 0
+			return s.updateShardInfoLocked()
+		}
+		if level < minAckTaskID {
+			minAckTaskID = level
+		}
+		if ackTime, ok := info.AckedShardReplicationTimestamps[shardID]; ok && ackTime.Before(minAckTimestamp) {
+			minAckTimestamp = ackTime
+		}
+	}
+
+	s.shardInfo.ClusterReplicationLevel[cluster] = minAckTaskID
+	s.shardInfo.StolenSinceRenew = 0
+	info.AckedReplicationTaskID = minAckTaskID
+	info.AckedReplicationTimestamp = minAckTimestamp
+	return s.updateShardInfoLocked()
+}
+
 func (s *ContextImpl) GetTimerAckLevel() time.Time {
 	s.rLock()
 	defer s.rUnlock()
@@ -554,6 +619,7 @@ func (s *ContextImpl) CreateWorkflowExecution(
 	if err = s.handleErrorAndUpdateMaxReadLevelLocked(err, transferMaxReadLevel); err != nil {
 		return nil, err
 	}
+	s.updateOpenExecutionCountsLocked(request.NewWorkflowSnapshot.ExecutionInfo, request.NewWorkflowSnapshot.Tasks)
 	return resp, nil
 }
 
@@ -602,6 +668,10 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 	if err = s.handleErrorAndUpdateMaxReadLevelLocked(err, transferMaxReadLevel); err != nil {
 		return nil, err
 	}
+	s.updateOpenExecutionCountsLocked(request.UpdateWorkflowMutation.ExecutionInfo, request.UpdateWorkflowMutation.Tasks)
+	if request.NewWorkflowSnapshot != nil {
+		s.updateOpenExecutionCountsLocked(request.NewWorkflowSnapshot.ExecutionInfo, request.NewWorkflowSnapshot.Tasks)
+	}
 	return resp, nil
 }
 
@@ -660,6 +730,13 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 	if err = s.handleErrorAndUpdateMaxReadLevelLocked(err, transferMaxReadLevel); err != nil {
 		return nil, err
 	}
+	if request.CurrentWorkflowMutation != nil {
+		s.updateOpenExecutionCountsLocked(request.CurrentWorkflowMutation.ExecutionInfo, request.CurrentWorkflowMutation.Tasks)
+	}
+	s.updateOpenExecutionCountsLocked(request.ResetWorkflowSnapshot.ExecutionInfo, request.ResetWorkflowSnapshot.Tasks)
+	if request.NewWorkflowSnapshot != nil {
+		s.updateOpenExecutionCountsLocked(request.NewWorkflowSnapshot.ExecutionInfo, request.NewWorkflowSnapshot.Tasks)
+	}
 	return resp, nil
 }
 
@@ -961,15 +1038,18 @@ func (s *ContextImpl) updateShardInfoLocked() error {
 		return err
 	}
 
-	var err error
 	now := clock.NewRealTimeSource().Now()
 	if s.lastUpdated.Add(s.config.ShardUpdateMinInterval()).After(now) {
 		return nil
 	}
+	return s.persistShardInfoLocked(now)
+}
+
+func (s *ContextImpl) persistShardInfoLocked(now time.Time) error {
 	updatedShardInfo := copyShardInfo(s.shardInfo)
 	s.emitShardInfoMetricsLogsLocked()
 
-	err = s.persistenceShardManager.UpdateShard(&persistence.UpdateShardRequest{
+	err := s.persistenceShardManager.UpdateShard(&persistence.UpdateShardRequest{
 		ShardInfo:       updatedShardInfo.ShardInfo,
 		PreviousRangeID: s.shardInfo.GetRangeId(),
 	})
@@ -1195,6 +1275,9 @@ func (s *ContextImpl) getOrCreateEngine(ctx context.Context) (engine Engine, ret
 	op := func(context.Context) error {
 		s.rLock()
 		defer s.rUnlock()
+		if s.handingOff {
+			return s.handoffErrorLocked()
+		}
 		err := s.errorByStateLocked()
 		if err == nil {
 			engine = s.engine
@@ -1240,6 +1323,44 @@ func (s *ContextImpl) stop() {
 	s.lifecycleCancel()
 }
 
+// flush persists the latest queue ack levels of the shard regardless of ShardUpdateMinInterval,
+// so that the next owner of the shard does not reprocess tasks already completed on this host.
+func (s *ContextImpl) flush() error {
+	s.wLock()
+	defer s.wUnlock()
+	if err := s.errorByStateLocked(); err != nil {
+		return err
+	}
+	return s.persistShardInfoLocked(clock.NewRealTimeSource().Now())
+}
+
+// handoff prepares the shard to be taken over by newOwner during a graceful shutdown: new requests
+// are redirected to newOwner (or rejected if it is not known yet), queue processing is stopped, and
+// the queue ack levels are flushed again if the new owner has not acquired the shard yet.
+// handoff should only be called by the controller, which then stops the shard.
+func (s *ContextImpl) handoff(newOwner string) error {
+	s.wLock()
+	s.handingOff = true
+	s.handoffOwner = newOwner
+	engine := s.engine
+	s.wUnlock()
+
+	if engine != nil {
+		s.contextTaggedLogger.Info("", tag.LifeCycleStopping, tag.ComponentShardEngine)
+		engine.Stop()
+		s.contextTaggedLogger.Info("", tag.LifeCycleStopped, tag.ComponentShardEngine)
+	}
+
+	return s.flush()
+}
+
+func (s *ContextImpl) handoffErrorLocked() error {
+	if s.handoffOwner == "" {
+		return ErrShardClosed
+	}
+	return serviceerrors.NewShardOwnershipLost(s.handoffOwner, s.hostInfoProvider.HostInfo().GetAddress())
+}
+
 func (s *ContextImpl) isValid() bool {
 	s.rLock()
 	defer s.rUnlock()
@@ -1618,11 +1739,12 @@ func newContext(
 	hostIdentity := hostInfoProvider.HostInfo().Identity()
 
 	lifecycleCtx, lifecycleCancel := context.WithCancel(context.Background())
+	loadTracker := newLoadTracker(timeSource, config.ShardLoadWindow, config.ShardLoadMaxTrackedWorkflows)
 
 	shardContext := &ContextImpl{
 		state:                   contextStateInitialized,
 		shardID:                 shardID,
-		executionManager:        persistenceExecutionManager,
+		executionManager:        newLoadTrackingExecutionManager(persistenceExecutionManager, loadTracker),
 		metricsClient:           metricsClient,
 		closeCallback:           closeCallback,
 		config:                  config,
@@ -1640,6 +1762,7 @@ func newContext(
 		clusterMetadata:         clusterMetadata,
 		archivalMetadata:        archivalMetadata,
 		hostInfoProvider:        hostInfoProvider,
+		loadTracker:             loadTracker,
 		handoverNamespaces:      make(map[string]*namespaceHandOverInfo),
 		lifecycleCtx:            lifecycleCtx,
 		lifecycleCancel:         lifecycleCancel,
@@ -1682,6 +1805,18 @@ func copyShardInfo(shardInfo *persistence.ShardInfoWithFailover) *persistence.Sh
 	for k, v := range shardInfo.ClusterReplicationLevel {
 		clusterReplicationLevel[k] = v
 	}
+	// left nil when there are no remote shard levels, they only exist for clusters with a different shard count
+	var clusterShardReplicationLevel map[string]*persistencespb.RemoteShardReplicationLevels
+	if len(shardInfo.ClusterShardReplicationLevel) > 0 {
+		clusterShardReplicationLevel = make(map[string]*persistencespb.RemoteShardReplicationLevels, len(shardInfo.ClusterShardReplicationLevel))
+	}
+	for k, v := range shardInfo.ClusterShardReplicationLevel {
+		shardLevels := make(map[int32]int64, len(v.GetShardLevels()))
+		for shardID, level := range v.GetShardLevels() {
+			shardLevels[shardID] = level
+		}
+		clusterShardReplicationLevel[k] = &persistencespb.RemoteShardReplicationLevels{ShardLevels: shardLevels}
+	}
 	clusterReplicationDLQLevel := make(map[string]int64)
 	for k, v := range shardInfo.ReplicationDlqAckLevel {
 		clusterReplicationDLQLevel[k] = v
@@ -1702,9 +1837,11 @@ func copyShardInfo(shardInfo *persistence.ShardInfoWithFailover) *persistence.Sh
 			ClusterTimerAckLevel:         clusterTimerAckLevel,
 			NamespaceNotificationVersion: shardInfo.NamespaceNotificationVersion,
 			ClusterReplicationLevel:      clusterReplicationLevel,
+			ClusterShardReplicationLevel: clusterShardReplicationLevel,
 			ReplicationDlqAckLevel:       clusterReplicationDLQLevel,
 			UpdateTime:                   shardInfo.UpdateTime,
 			VisibilityAckLevel:           shardInfo.VisibilityAckLevel,
+			OpenExecutionCounts:          copyOpenExecutionCounts(shardInfo.OpenExecutionCounts),
 		},
 		TransferFailoverLevels: transferFailoverLevels,
 		TimerFailoverLevels:    timerFailoverLevels,
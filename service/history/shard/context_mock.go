@@ -683,6 +683,18 @@ func (mr *MockContextMockRecorder) GetVisibilityAckLevel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibilityAckLevel", reflect.TypeOf((*MockContext)(nil).GetVisibilityAckLevel))
 }
 
+// RecordMutableStateCacheLookup mocks base method.
+func (m *MockContext) RecordMutableStateCacheLookup(hit bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordMutableStateCacheLookup", hit)
+}
+
+// RecordMutableStateCacheLookup indicates an expected call of RecordMutableStateCacheLookup.
+func (mr *MockContextMockRecorder) RecordMutableStateCacheLookup(hit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordMutableStateCacheLookup", reflect.TypeOf((*MockContext)(nil).RecordMutableStateCacheLookup), hit)
+}
+
 // SetCurrentTime mocks base method.
 func (m *MockContext) SetCurrentTime(cluster string, currentTime time.Time) {
 	m.ctrl.T.Helper()
@@ -747,6 +759,20 @@ func (mr *MockContextMockRecorder) UpdateNamespaceNotificationVersion(namespaceN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceNotificationVersion", reflect.TypeOf((*MockContext)(nil).UpdateNamespaceNotificationVersion), namespaceNotificationVersion)
 }
 
+// UpdateRemoteShardReplicationLevel mocks base method.
+func (m *MockContext) UpdateRemoteShardReplicationLevel(cluster string, remoteShardID, remoteShardCount int32, ackTaskID int64, ackTimestamp time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRemoteShardReplicationLevel", cluster, remoteShardID, remoteShardCount, ackTaskID, ackTimestamp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRemoteShardReplicationLevel indicates an expected call of UpdateRemoteShardReplicationLevel.
+func (mr *MockContextMockRecorder) UpdateRemoteShardReplicationLevel(cluster, remoteShardID, remoteShardCount, ackTaskID, ackTimestamp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemoteShardReplicationLevel", reflect.TypeOf((*MockContext)(nil).UpdateRemoteShardReplicationLevel), cluster, remoteShardID, remoteShardCount, ackTaskID, ackTimestamp)
+}
+
 // UpdateReplicatorAckLevel mocks base method.
 func (m *MockContext) UpdateReplicatorAckLevel(ackLevel int64) error {
 	m.ctrl.T.Helper()
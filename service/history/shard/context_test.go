@@ -25,6 +25,7 @@
 package shard
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -41,6 +42,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
 )
@@ -206,3 +208,111 @@ func (s *contextSuite) TestTimerMaxReadLevelUpdate() {
 	newMaxReadLevel = s.shardContext.GetTimerMaxReadLevel(clusterName)
 	s.True(newMaxReadLevel.After(maxReadLevel))
 }
+
+func (s *contextSuite) TestOpenExecutionCounts() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.namespaceID).Return(s.namespaceEntry, nil).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	executionInfo := &persistencespb.WorkflowExecutionInfo{
+		NamespaceId:      s.namespaceID.String(),
+		WorkflowId:       "workflow-id",
+		WorkflowTypeName: "workflow-type",
+	}
+	startTasks := func() map[tasks.Category][]tasks.Task {
+		return map[tasks.Category][]tasks.Task{
+			tasks.CategoryTransfer:   {&tasks.WorkflowTask{}},
+			tasks.CategoryVisibility: {&tasks.StartExecutionVisibilityTask{}},
+		}
+	}
+	closeTasks := func() map[tasks.Category][]tasks.Task {
+		return map[tasks.Category][]tasks.Task{
+			tasks.CategoryTransfer:   {&tasks.CloseExecutionTask{}},
+			tasks.CategoryVisibility: {&tasks.CloseExecutionVisibilityTask{}},
+		}
+	}
+
+	s.mockExecutionManager.EXPECT().CreateWorkflowExecution(gomock.Any()).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err := s.shardContext.CreateWorkflowExecution(&persistence.CreateWorkflowExecutionRequest{
+			NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+				ExecutionInfo: executionInfo,
+				Tasks:         startTasks(),
+			},
+		})
+		s.NoError(err)
+	}
+	s.Equal(map[string]*persistencespb.OpenExecutionCounts{
+		s.namespaceID.String(): {Total: 2, WorkflowTypes: map[string]int64{"workflow-type": 2}},
+	}, s.shardContext.(*ContextTest).getOpenExecutionCounts())
+
+	// continue-as-new closes the current run and starts a new one
+	s.mockExecutionManager.EXPECT().UpdateWorkflowExecution(gomock.Any()).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil)
+	_, err := s.shardContext.UpdateWorkflowExecution(&persistence.UpdateWorkflowExecutionRequest{
+		UpdateWorkflowMutation: persistence.WorkflowMutation{
+			ExecutionInfo: executionInfo,
+			Tasks:         closeTasks(),
+		},
+		NewWorkflowSnapshot: &persistence.WorkflowSnapshot{
+			ExecutionInfo: executionInfo,
+			Tasks:         startTasks(),
+		},
+	})
+	s.NoError(err)
+	s.Equal(int64(2), s.shardContext.(*ContextTest).getOpenExecutionCounts()[s.namespaceID.String()].GetTotal())
+
+	s.mockExecutionManager.EXPECT().UpdateWorkflowExecution(gomock.Any()).Return(&persistence.UpdateWorkflowExecutionResponse{}, nil).Times(3)
+	for i := 0; i < 3; i++ {
+		_, err := s.shardContext.UpdateWorkflowExecution(&persistence.UpdateWorkflowExecutionRequest{
+			UpdateWorkflowMutation: persistence.WorkflowMutation{
+				ExecutionInfo: executionInfo,
+				Tasks:         closeTasks(),
+			},
+		})
+		s.NoError(err)
+	}
+	s.Empty(s.shardContext.(*ContextTest).getOpenExecutionCounts())
+}
+
+func (s *contextSuite) TestHandoff() {
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	shardContext := s.shardContext.(*ContextTest)
+	shardContext.lastUpdated = time.Now().UTC()
+
+	s.mockHistoryEngine.EXPECT().Stop()
+	s.mockResource.ShardMgr.EXPECT().UpdateShard(gomock.Any()).Return(nil)
+	s.NoError(shardContext.handoff("newhost:7234"))
+
+	// in-flight requests can still complete
+	s.NoError(shardContext.errorByState())
+
+	_, err := shardContext.getOrCreateEngine(context.Background())
+	s.IsType(&serviceerrors.ShardOwnershipLost{}, err)
+	s.Equal("newhost:7234", err.(*serviceerrors.ShardOwnershipLost).OwnerHost)
+}
+
+func (s *contextSuite) TestUpdateRemoteShardReplicationLevel() {
+	shardContext := s.shardContext.(*ContextTest)
+	shardContext.shardID = 1
+	shardContext.config.NumberOfShards = 1
+	shardContext.shardInfo.ClusterReplicationLevel = make(map[string]int64)
+	shardContext.shardInfo.ClusterShardReplicationLevel = make(map[string]*persistencespb.RemoteShardReplicationLevels)
+	shardContext.lastUpdated = time.Now().UTC()
+
+	now := time.Now().UTC()
+	// remote cluster has 2 shards which both read from this shard
+	s.NoError(shardContext.UpdateRemoteShardReplicationLevel(cluster.TestAlternativeClusterName, 2, 2, 20, now))
+	s.Equal(persistence.EmptyQueueMessageID, shardContext.GetClusterReplicationLevel(cluster.TestAlternativeClusterName))
+
+	s.NoError(shardContext.UpdateRemoteShardReplicationLevel(cluster.TestAlternativeClusterName, 1, 2, 10, now.Add(-time.Minute)))
+	s.Equal(int64(10), shardContext.GetClusterReplicationLevel(cluster.TestAlternativeClusterName))
+
+	s.NoError(shardContext.UpdateRemoteShardReplicationLevel(cluster.TestAlternativeClusterName, 1, 2, 30, now))
+	s.Equal(int64(20), shardContext.GetClusterReplicationLevel(cluster.TestAlternativeClusterName))
+	s.Equal(map[int32]int64{1: 30, 2: 20}, shardContext.shardInfo.ClusterShardReplicationLevel[cluster.TestAlternativeClusterName].GetShardLevels())
+
+	status, _, err := shardContext.GetReplicationStatus([]string{cluster.TestAlternativeClusterName})
+	s.NoError(err)
+	s.Equal(int64(20), status[cluster.TestAlternativeClusterName].GetAckedTaskId())
+	s.Equal(now, *status[cluster.TestAlternativeClusterName].GetAckedTaskVisibilityTime())
+}
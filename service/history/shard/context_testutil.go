@@ -31,6 +31,7 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
@@ -100,6 +101,11 @@ func NewTestContext(
 		historyClient:           resourceTest.GetHistoryClient(),
 		archivalMetadata:        resourceTest.GetArchivalMetadata(),
 		hostInfoProvider:        hostInfoProvider,
+		loadTracker: newLoadTracker(
+			resourceTest.TimeSource,
+			dynamicconfig.GetDurationPropertyFn(time.Minute),
+			dynamicconfig.GetIntPropertyFn(1000),
+		),
 	}
 	return &ContextTest{
 		Resource:             resourceTest,
@@ -27,11 +27,17 @@ package shard
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"google.golang.org/grpc"
+
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/clock"
@@ -61,6 +67,7 @@ type (
 		membershipUpdateCh  chan *membership.ChangedEvent
 		engineFactory       EngineFactory
 		status              int32
+		draining            int32
 		shutdownWG          sync.WaitGroup
 		shutdownCh          chan struct{}
 		contextTaggedLogger log.Logger
@@ -149,16 +156,30 @@ func (c *ControllerImpl) Status() int32 {
 
 func (c *ControllerImpl) GetEngine(ctx context.Context, namespaceID namespace.ID, workflowID string) (Engine, error) {
 	shardID := c.config.GetShardID(namespaceID, workflowID)
-	return c.GetEngineForShard(ctx, shardID)
+	return c.getEngineForShard(ctx, shardID, namespaceID, workflowID)
 }
 
 func (c *ControllerImpl) GetEngineForShard(ctx context.Context, shardID int32) (Engine, error) {
+	return c.getEngineForShard(ctx, shardID, "", "")
+}
+
+func (c *ControllerImpl) getEngineForShard(
+	ctx context.Context,
+	shardID int32,
+	namespaceID namespace.ID,
+	workflowID string,
+) (Engine, error) {
 	sw := c.metricsScope.StartTimer(metrics.GetEngineForShardLatency)
 	defer sw.Stop()
 	shard, err := c.getOrCreateShardContext(shardID)
 	if err != nil {
 		return nil, err
 	}
+	// Only requests served by the history service API are counted towards shard load,
+	// engines requested by background processing have no gRPC method in their context.
+	if method, ok := grpc.Method(ctx); ok {
+		shard.loadTracker.recordRequest(method[strings.LastIndex(method, "/")+1:], namespaceID, workflowID)
+	}
 	return shard.getOrCreateEngine(ctx)
 }
 
@@ -209,7 +230,7 @@ func (c *ControllerImpl) getOrCreateShardContext(shardID int32) (*ContextImpl, e
 
 	hostInfo := c.hostInfoProvider.HostInfo()
 	if info.Identity() != hostInfo.Identity() {
-		return nil, serviceerrors.NewShardOwnershipLost(hostInfo.Identity(), info.GetAddress())
+		return nil, serviceerrors.NewShardOwnershipLost(info.GetAddress(), hostInfo.GetAddress())
 	}
 
 	c.Lock()
@@ -225,6 +246,10 @@ func (c *ControllerImpl) getOrCreateShardContext(shardID int32) (*ContextImpl, e
 	if atomic.LoadInt32(&c.status) == common.DaemonStatusStopped {
 		return nil, fmt.Errorf("ControllerImpl for host '%v' shutting down", hostInfo.Identity())
 	}
+	if atomic.LoadInt32(&c.draining) == 1 {
+		// shards are being handed off, do not take ownership of new ones
+		return nil, ErrShardClosed
+	}
 
 	shard, err := newContext(
 		shardID,
@@ -369,6 +394,93 @@ LoopSubmit:
 	c.metricsScope.UpdateGauge(metrics.NumShardsGauge, float64(c.NumShards()))
 }
 
+// FlushShards persists the queue ack levels of all shards owned by this host, one at a time, until
+// ctx is done. It must be called before this host is evicted from the membership ring: once it is,
+// the other hosts acquire all of its shards at once, and the ack levels flushed here are the ones
+// they start processing from.
+func (c *ControllerImpl) FlushShards(ctx context.Context) {
+	shardIDs := c.ShardIDs()
+	sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+	c.contextTaggedLogger.Info("Flushing shards", tag.Number(int64(len(shardIDs))))
+
+	for i, shardID := range shardIDs {
+		if ctx.Err() != nil {
+			c.contextTaggedLogger.Warn("Shard flush deadline exceeded", tag.Number(int64(len(shardIDs)-i)))
+			return
+		}
+		c.RLock()
+		shard, ok := c.historyShards[shardID]
+		c.RUnlock()
+		if !ok {
+			continue
+		}
+		if err := shard.flush(); err != nil {
+			c.contextTaggedLogger.Warn("Unable to flush shard", tag.Error(err), tag.ShardID(shardID))
+		}
+	}
+}
+
+// DrainShards gracefully hands off all shards owned by this host until ctx is done. It must be
+// called after this host is evicted from the membership ring, so that lookups return the new owner
+// of each shard. For each shard, new requests are redirected to the new owner, queue processing is
+// stopped, the queue ack levels are flushed unless the new owner already acquired the shard, and
+// the shard is closed. Shards which could not be handed off before ctx is done are closed when the
+// controller is stopped.
+func (c *ControllerImpl) DrainShards(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&c.draining, 0, 1) {
+		return
+	}
+
+	shardIDs := c.ShardIDs()
+	sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+	c.contextTaggedLogger.Info("Handing off shards", tag.Number(int64(len(shardIDs))))
+
+	for i, shardID := range shardIDs {
+		if ctx.Err() != nil {
+			c.contextTaggedLogger.Warn("Shard handoff deadline exceeded", tag.Number(int64(len(shardIDs)-i)))
+			return
+		}
+		c.handoffShard(shardID)
+	}
+}
+
+func (c *ControllerImpl) handoffShard(shardID int32) {
+	c.RLock()
+	shard, ok := c.historyShards[shardID]
+	c.RUnlock()
+	if !ok {
+		return
+	}
+
+	c.metricsScope.IncCounter(metrics.ShardHandoffCounter)
+	sw := c.metricsScope.StartTimer(metrics.ShardHandoffLatency)
+	defer sw.Stop()
+
+	newOwner := ""
+	if info, err := c.historyServiceResolver.Lookup(convert.Int32ToString(shardID)); err != nil {
+		c.contextTaggedLogger.Warn("Error looking up new owner for shardID", tag.Error(err), tag.ShardID(shardID))
+	} else if info.Identity() != c.hostInfoProvider.HostInfo().Identity() {
+		newOwner = info.GetAddress()
+	}
+
+	if err := shard.handoff(newOwner); err != nil {
+		if _, ok := err.(*persistence.ShardOwnershipLostError); ok {
+			// the new owner acquired the shard already, it starts from the ack levels flushed by FlushShards
+			c.contextTaggedLogger.Info("Shard acquired by new owner before handoff", tag.ShardID(shardID))
+		} else {
+			c.metricsScope.IncCounter(metrics.ShardHandoffFailedCounter)
+			c.contextTaggedLogger.Warn("Unable to flush shard before handoff", tag.Error(err), tag.ShardID(shardID))
+		}
+	}
+
+	_, newNumShards := c.removeShard(shardID, shard)
+	// Whether shard was in the shards map or not, in both cases we should stop it.
+	shard.contextTaggedLogger.Info("", tag.LifeCycleStopping, tag.ComponentShardContext, tag.ShardID(shardID))
+	shard.stop()
+	c.metricsScope.IncCounter(metrics.ShardContextRemovedCounter)
+	shard.contextTaggedLogger.Info("", tag.LifeCycleStopped, tag.ComponentShardContext, tag.Number(newNumShards))
+}
+
 func (c *ControllerImpl) doShutdown() {
 	c.contextTaggedLogger.Info("", tag.LifeCycleStopping)
 	c.Lock()
@@ -396,6 +508,46 @@ func (c *ControllerImpl) ShardIDs() []int32 {
 	return ids
 }
 
+// OpenExecutionCounts returns the approximate number of open workflow executions, keyed by namespace ID,
+// on all shards owned by this host.
+func (c *ControllerImpl) OpenExecutionCounts() map[string]*persistencespb.OpenExecutionCounts {
+	c.RLock()
+	shards := make([]*ContextImpl, 0, len(c.historyShards))
+	for _, shard := range c.historyShards {
+		shards = append(shards, shard)
+	}
+	c.RUnlock()
+
+	counts := make(map[string]*persistencespb.OpenExecutionCounts)
+	for _, shard := range shards {
+		MergeOpenExecutionCounts(counts, shard.getOpenExecutionCounts())
+	}
+	return counts
+}
+
+// ShardLoad returns the load on all shards owned by this host over the last sampling window.
+func (c *ControllerImpl) ShardLoad(maxWorkflowsPerShard int) []*historyspb.ShardLoad {
+	c.RLock()
+	shards := make([]*ContextImpl, 0, len(c.historyShards))
+	for _, shard := range c.historyShards {
+		shards = append(shards, shard)
+	}
+	c.RUnlock()
+
+	hostAddress := c.hostInfoProvider.HostInfo().GetAddress()
+	loads := make([]*historyspb.ShardLoad, 0, len(shards))
+	for _, shard := range shards {
+		if load := shard.getShardLoad(maxWorkflowsPerShard); load != nil {
+			load.HostAddress = hostAddress
+			loads = append(loads, load)
+		}
+	}
+	sort.Slice(loads, func(i, j int) bool {
+		return loads[i].GetShardId() < loads[j].GetShardId()
+	})
+	return loads
+}
+
 func IsShardOwnershipLostError(err error) bool {
 	switch err.(type) {
 	case *persistence.ShardOwnershipLostError:
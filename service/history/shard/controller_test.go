@@ -53,6 +53,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
 
 type (
@@ -648,6 +649,100 @@ func (s *controllerSuite) TestShardControllerClosed() {
 	workerWG.Wait()
 }
 
+func (s *controllerSuite) TestFlushShards() {
+	numShards := int32(2)
+	s.config.NumberOfShards = numShards
+	s.shardController = NewTestController(
+		s.mockEngineFactory,
+		s.config,
+		s.mockResource,
+		s.mockHostInfoProvider,
+	)
+
+	historyEngines := make(map[int32]*MockEngine)
+	for shardID := int32(1); shardID <= numShards; shardID++ {
+		mockEngine := NewMockEngine(s.controller)
+		historyEngines[shardID] = mockEngine
+		s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+	}
+
+	s.mockServiceResolver.EXPECT().AddListener(shardControllerMembershipUpdateListenerName, gomock.Any()).Return(nil).AnyTimes()
+	s.mockServiceResolver.EXPECT().RemoveListener(shardControllerMembershipUpdateListenerName).Return(nil).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.shardController.Start()
+	s.Equal(int(numShards), s.shardController.NumShards())
+
+	// ack levels are flushed regardless of ShardUpdateMinInterval, and the shards stay loaded
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any()).DoAndReturn(
+		func(request *persistence.UpdateShardRequest) error {
+			s.Equal(int64(6), request.PreviousRangeID)
+			return nil
+		},
+	).Times(int(numShards))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	s.shardController.FlushShards(ctx)
+	s.Equal(int(numShards), s.shardController.NumShards())
+
+	for shardID := int32(1); shardID <= numShards; shardID++ {
+		s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(shardID)).Return(s.hostInfo, nil).AnyTimes()
+		historyEngines[shardID].EXPECT().Stop()
+	}
+	s.shardController.Stop()
+}
+
+func (s *controllerSuite) TestDrainShards() {
+	numShards := int32(2)
+	s.config.NumberOfShards = numShards
+	s.shardController = NewTestController(
+		s.mockEngineFactory,
+		s.config,
+		s.mockResource,
+		s.mockHostInfoProvider,
+	)
+
+	historyEngines := make(map[int32]*MockEngine)
+	for shardID := int32(1); shardID <= numShards; shardID++ {
+		mockEngine := NewMockEngine(s.controller)
+		historyEngines[shardID] = mockEngine
+		s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+	}
+
+	s.mockServiceResolver.EXPECT().AddListener(shardControllerMembershipUpdateListenerName, gomock.Any()).Return(nil).AnyTimes()
+	s.mockServiceResolver.EXPECT().RemoveListener(shardControllerMembershipUpdateListenerName).Return(nil).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.shardController.Start()
+	defer s.shardController.Stop()
+	s.Equal(int(numShards), s.shardController.NumShards())
+
+	// this host evicted itself from the ring, the shards are now owned by another host
+	newOwner := membership.NewHostInfo("newhost:7234", nil)
+	for shardID := int32(1); shardID <= numShards; shardID++ {
+		s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(shardID)).Return(newOwner, nil).AnyTimes()
+		historyEngines[shardID].EXPECT().Stop().MinTimes(1)
+	}
+	// ack levels are flushed regardless of ShardUpdateMinInterval
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any()).DoAndReturn(
+		func(request *persistence.UpdateShardRequest) error {
+			s.Equal(int64(6), request.PreviousRangeID)
+			return nil
+		},
+	).Times(int(numShards))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	s.shardController.DrainShards(ctx)
+	s.Equal(0, s.shardController.NumShards())
+
+	_, err := s.shardController.GetEngineForShard(ctx, 1)
+	s.Error(err)
+	s.IsType(&serviceerrors.ShardOwnershipLost{}, err)
+	s.Equal(newOwner.GetAddress(), err.(*serviceerrors.ShardOwnershipLost).OwnerHost)
+}
+
 func (s *controllerSuite) setupMocksForAcquireShard(shardID int32, mockEngine *MockEngine, currentRangeID,
 	newRangeID int64) {
 
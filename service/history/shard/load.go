@@ -0,0 +1,370 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// loadTracker accumulates the load on a shard over fixed length sampling windows. Load is reported
+	// for the last complete window, or for the current one until the first window completes.
+	loadTracker struct {
+		timeSource          clock.TimeSource
+		window              dynamicconfig.DurationPropertyFn
+		maxTrackedWorkflows dynamicconfig.IntPropertyFn
+
+		sync.Mutex
+		current  *loadWindow
+		previous *loadWindow
+	}
+
+	loadWindow struct {
+		start time.Time
+		end   time.Time
+
+		apiRequests      map[string]int64
+		workflowRequests map[workflowLoadKey]int64
+
+		cacheHits   int64
+		cacheMisses int64
+
+		persistenceRequests     int64
+		persistenceLatencyTotal time.Duration
+		persistenceLatencyMax   time.Duration
+	}
+
+	workflowLoadKey struct {
+		namespaceID string
+		workflowID  string
+	}
+
+	// loadTrackingExecutionManager records the latency of the execution manager calls made on the
+	// critical path of workflow requests.
+	loadTrackingExecutionManager struct {
+		persistence.ExecutionManager
+		tracker *loadTracker
+	}
+)
+
+// RecordMutableStateCacheLookup records whether a workflow context was found in the mutable state cache of the shard.
+func (s *ContextImpl) RecordMutableStateCacheLookup(hit bool) {
+	s.loadTracker.recordCacheLookup(hit)
+}
+
+// getShardLoad returns the load on the shard over the last sampling window, or nil if the shard is not acquired.
+func (s *ContextImpl) getShardLoad(maxWorkflows int) *historyspb.ShardLoad {
+	load := s.loadTracker.snapshot(maxWorkflows)
+	load.ShardId = s.shardID
+
+	s.rLock()
+	defer s.rUnlock()
+
+	if err := s.errorByStateLocked(); err != nil {
+		return nil
+	}
+	// transfer, visibility and replication tasks share the shard task ID sequence, so all of them
+	// are measured against the same max read level.
+	load.TaskIdLag = map[string]int64{
+		tasks.CategoryNameTransfer:    taskIDLag(s.transferMaxReadLevel, s.shardInfo.TransferAckLevel),
+		tasks.CategoryNameVisibility:  taskIDLag(s.transferMaxReadLevel, s.shardInfo.VisibilityAckLevel),
+		tasks.CategoryNameReplication: taskIDLag(s.transferMaxReadLevel, s.shardInfo.ReplicationAckLevel),
+	}
+	timerAckLag := s.timeSource.Now().Sub(timestamp.TimeValue(s.shardInfo.TimerAckLevelTime))
+	if timerAckLag < 0 {
+		timerAckLag = 0
+	}
+	load.TimerAckLag = timestamp.DurationPtr(timerAckLag)
+	return load
+}
+
+func taskIDLag(maxReadLevel int64, ackLevel int64) int64 {
+	if maxReadLevel < ackLevel {
+		return 0
+	}
+	return maxReadLevel - ackLevel
+}
+
+func newLoadTracker(
+	timeSource clock.TimeSource,
+	window dynamicconfig.DurationPropertyFn,
+	maxTrackedWorkflows dynamicconfig.IntPropertyFn,
+) *loadTracker {
+	return &loadTracker{
+		timeSource:          timeSource,
+		window:              window,
+		maxTrackedWorkflows: maxTrackedWorkflows,
+		current:             newLoadWindow(timeSource.Now()),
+	}
+}
+
+func newLoadWindow(start time.Time) *loadWindow {
+	return &loadWindow{
+		start:            start,
+		apiRequests:      make(map[string]int64),
+		workflowRequests: make(map[workflowLoadKey]int64),
+	}
+}
+
+// recordRequest records a request to the given history API. workflowID is empty for requests which are not
+// made on behalf of a single workflow.
+func (t *loadTracker) recordRequest(api string, namespaceID namespace.ID, workflowID string) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.rotateLocked()
+	t.current.apiRequests[api]++
+	if workflowID != "" {
+		t.current.recordWorkflowRequest(
+			workflowLoadKey{namespaceID: namespaceID.String(), workflowID: workflowID},
+			t.maxTrackedWorkflows(),
+		)
+	}
+}
+
+func (t *loadTracker) recordCacheLookup(hit bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.rotateLocked()
+	if hit {
+		t.current.cacheHits++
+	} else {
+		t.current.cacheMisses++
+	}
+}
+
+func (t *loadTracker) recordPersistenceLatency(latency time.Duration) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.rotateLocked()
+	t.current.persistenceRequests++
+	t.current.persistenceLatencyTotal += latency
+	if latency > t.current.persistenceLatencyMax {
+		t.current.persistenceLatencyMax = latency
+	}
+}
+
+// snapshot returns the request load of the reported window. Task ID lag is not known to the tracker
+// and is left unset.
+func (t *loadTracker) snapshot(maxWorkflows int) *historyspb.ShardLoad {
+	t.Lock()
+	defer t.Unlock()
+
+	t.rotateLocked()
+	w := t.previous
+	if w == nil {
+		w = t.current
+		w.end = t.timeSource.Now()
+	}
+	return w.toShardLoad(maxWorkflows)
+}
+
+func (t *loadTracker) rotateLocked() {
+	now := t.timeSource.Now()
+	window := t.window()
+	elapsed := now.Sub(t.current.start)
+	if elapsed < window {
+		return
+	}
+
+	if elapsed < 2*window {
+		t.current.end = t.current.start.Add(window)
+		t.previous = t.current
+	} else {
+		// nothing was recorded during the last complete window
+		t.previous = newLoadWindow(now.Add(-window))
+		t.previous.end = now
+	}
+	t.current = newLoadWindow(t.previous.end)
+}
+
+// recordWorkflowRequest counts requests per workflow using the Space-Saving algorithm: once capacity
+// workflows are tracked, the least requested one is replaced and its count is inherited by the new
+// workflow. Counts of the most requested workflows may be overestimated, but they are never missed.
+func (w *loadWindow) recordWorkflowRequest(key workflowLoadKey, capacity int) {
+	if _, ok := w.workflowRequests[key]; ok || len(w.workflowRequests) < capacity {
+		w.workflowRequests[key]++
+		return
+	}
+	if capacity <= 0 {
+		return
+	}
+
+	var minKey workflowLoadKey
+	minCount := int64(math.MaxInt64)
+	for k, count := range w.workflowRequests {
+		if count < minCount {
+			minKey, minCount = k, count
+		}
+	}
+	delete(w.workflowRequests, minKey)
+	w.workflowRequests[key] = minCount + 1
+}
+
+func (w *loadWindow) toShardLoad(maxWorkflows int) *historyspb.ShardLoad {
+	duration := w.end.Sub(w.start)
+	load := &historyspb.ShardLoad{
+		Window:                timestamp.DurationPtr(duration),
+		ApiRequestsPerSecond:  make(map[string]float64, len(w.apiRequests)),
+		PersistenceRequests:   w.persistenceRequests,
+		PersistenceLatencyMax: timestamp.DurationPtr(w.persistenceLatencyMax),
+	}
+
+	var requests int64
+	for api, count := range w.apiRequests {
+		requests += count
+		load.ApiRequestsPerSecond[api] = perSecond(count, duration)
+	}
+	load.RequestsPerSecond = perSecond(requests, duration)
+
+	if lookups := w.cacheHits + w.cacheMisses; lookups > 0 {
+		load.MutableStateCacheHitRate = float64(w.cacheHits) / float64(lookups)
+	}
+	if w.persistenceRequests > 0 {
+		load.PersistenceLatencyAvg = timestamp.DurationPtr(w.persistenceLatencyTotal / time.Duration(w.persistenceRequests))
+	} else {
+		load.PersistenceLatencyAvg = timestamp.DurationPtr(0)
+	}
+
+	load.TopWorkflows = make([]*historyspb.WorkflowLoad, 0, len(w.workflowRequests))
+	for key, count := range w.workflowRequests {
+		load.TopWorkflows = append(load.TopWorkflows, &historyspb.WorkflowLoad{
+			NamespaceId: key.namespaceID,
+			WorkflowId:  key.workflowID,
+			Requests:    count,
+		})
+	}
+	sort.Slice(load.TopWorkflows, func(i, j int) bool {
+		a, b := load.TopWorkflows[i], load.TopWorkflows[j]
+		if a.Requests != b.Requests {
+			return a.Requests > b.Requests
+		}
+		if a.NamespaceId != b.NamespaceId {
+			return a.NamespaceId < b.NamespaceId
+		}
+		return a.WorkflowId < b.WorkflowId
+	})
+	if maxWorkflows < len(load.TopWorkflows) {
+		load.TopWorkflows = load.TopWorkflows[:maxWorkflows]
+	}
+	return load
+}
+
+func perSecond(count int64, duration time.Duration) float64 {
+	if duration <= 0 {
+		return 0
+	}
+	return float64(count) / duration.Seconds()
+}
+
+func newLoadTrackingExecutionManager(
+	executionManager persistence.ExecutionManager,
+	tracker *loadTracker,
+) persistence.ExecutionManager {
+	return &loadTrackingExecutionManager{
+		ExecutionManager: executionManager,
+		tracker:          tracker,
+	}
+}
+
+func (m *loadTrackingExecutionManager) CreateWorkflowExecution(
+	request *persistence.CreateWorkflowExecutionRequest,
+) (*persistence.CreateWorkflowExecutionResponse, error) {
+	defer m.record(time.Now())
+	return m.ExecutionManager.CreateWorkflowExecution(request)
+}
+
+func (m *loadTrackingExecutionManager) UpdateWorkflowExecution(
+	request *persistence.UpdateWorkflowExecutionRequest,
+) (*persistence.UpdateWorkflowExecutionResponse, error) {
+	defer m.record(time.Now())
+	return m.ExecutionManager.UpdateWorkflowExecution(request)
+}
+
+func (m *loadTrackingExecutionManager) ConflictResolveWorkflowExecution(
+	request *persistence.ConflictResolveWorkflowExecutionRequest,
+) (*persistence.ConflictResolveWorkflowExecutionResponse, error) {
+	defer m.record(time.Now())
+	return m.ExecutionManager.ConflictResolveWorkflowExecution(request)
+}
+
+func (m *loadTrackingExecutionManager) GetCurrentExecution(
+	request *persistence.GetCurrentExecutionRequest,
+) (*persistence.GetCurrentExecutionResponse, error) {
+	defer m.record(time.Now())
+	return m.ExecutionManager.GetCurrentExecution(request)
+}
+
+func (m *loadTrackingExecutionManager) GetWorkflowExecution(
+	request *persistence.GetWorkflowExecutionRequest,
+) (*persistence.GetWorkflowExecutionResponse, error) {
+	defer m.record(time.Now())
+	return m.ExecutionManager.GetWorkflowExecution(request)
+}
+
+func (m *loadTrackingExecutionManager) AddTasks(
+	request *persistence.AddTasksRequest,
+) error {
+	defer m.record(time.Now())
+	return m.ExecutionManager.AddTasks(request)
+}
+
+func (m *loadTrackingExecutionManager) AppendHistoryNodes(
+	request *persistence.AppendHistoryNodesRequest,
+) (*persistence.AppendHistoryNodesResponse, error) {
+	defer m.record(time.Now())
+	return m.ExecutionManager.AppendHistoryNodes(request)
+}
+
+func (m *loadTrackingExecutionManager) ReadHistoryBranch(
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadHistoryBranchResponse, error) {
+	defer m.record(time.Now())
+	return m.ExecutionManager.ReadHistoryBranch(request)
+}
+
+func (m *loadTrackingExecutionManager) ReadHistoryBranchByBatch(
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+	defer m.record(time.Now())
+	return m.ExecutionManager.ReadHistoryBranchByBatch(request)
+}
+
+func (m *loadTrackingExecutionManager) record(start time.Time) {
+	m.tracker.recordPersistenceLatency(time.Since(start))
+}
@@ -0,0 +1,176 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/tests"
+)
+
+type (
+	loadTrackerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		timeSource *clock.EventTimeSource
+		now        time.Time
+		tracker    *loadTracker
+	}
+)
+
+func TestLoadTrackerSuite(t *testing.T) {
+	s := &loadTrackerSuite{}
+	suite.Run(t, s)
+}
+
+func (s *loadTrackerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.now = time.Unix(1000, 0)
+	s.timeSource = clock.NewEventTimeSource().Update(s.now)
+	s.tracker = newLoadTracker(
+		s.timeSource,
+		dynamicconfig.GetDurationPropertyFn(10*time.Second),
+		dynamicconfig.GetIntPropertyFn(2),
+	)
+}
+
+func (s *loadTrackerSuite) advance(d time.Duration) {
+	s.now = s.now.Add(d)
+	s.timeSource.Update(s.now)
+}
+
+func (s *loadTrackerSuite) TestSnapshot_CurrentWindowUntilFirstWindowCompletes() {
+	for i := 0; i < 10; i++ {
+		s.tracker.recordRequest("StartWorkflowExecution", "ns", "wf")
+	}
+	s.advance(5 * time.Second)
+
+	load := s.tracker.snapshot(10)
+	s.Equal(5*time.Second, timestamp.DurationValue(load.GetWindow()))
+	s.Equal(2.0, load.GetRequestsPerSecond())
+	s.Equal(2.0, load.GetApiRequestsPerSecond()["StartWorkflowExecution"])
+}
+
+func (s *loadTrackerSuite) TestSnapshot_LastCompleteWindow() {
+	for i := 0; i < 20; i++ {
+		s.tracker.recordRequest("SignalWorkflowExecution", "ns", "wf")
+	}
+	s.advance(12 * time.Second)
+	s.tracker.recordRequest("SignalWorkflowExecution", "ns", "wf")
+
+	load := s.tracker.snapshot(10)
+	s.Equal(10*time.Second, timestamp.DurationValue(load.GetWindow()))
+	s.Equal(2.0, load.GetRequestsPerSecond())
+	s.Len(load.GetTopWorkflows(), 1)
+	s.Equal(int64(20), load.GetTopWorkflows()[0].GetRequests())
+
+	// no requests during the last complete window
+	s.advance(25 * time.Second)
+	load = s.tracker.snapshot(10)
+	s.Equal(10*time.Second, timestamp.DurationValue(load.GetWindow()))
+	s.Zero(load.GetRequestsPerSecond())
+	s.Empty(load.GetTopWorkflows())
+}
+
+func (s *loadTrackerSuite) TestTopWorkflows() {
+	for i := 0; i < 5; i++ {
+		s.tracker.recordRequest("SignalWorkflowExecution", "ns", "hot")
+	}
+	s.tracker.recordRequest("SignalWorkflowExecution", "ns", "cold1")
+	s.tracker.recordRequest("SignalWorkflowExecution", "ns", "cold2")
+	s.tracker.recordRequest("SignalWorkflowExecution", "ns", "cold3")
+	// requests which are not made on behalf of a workflow are not tracked per workflow
+	s.tracker.recordRequest("GetReplicationMessages", "", "")
+	s.advance(time.Second)
+
+	load := s.tracker.snapshot(1)
+	s.Equal(9.0, load.GetRequestsPerSecond())
+	s.Len(load.GetTopWorkflows(), 1)
+	s.Equal("ns", load.GetTopWorkflows()[0].GetNamespaceId())
+	s.Equal("hot", load.GetTopWorkflows()[0].GetWorkflowId())
+	s.Equal(int64(5), load.GetTopWorkflows()[0].GetRequests())
+
+	load = s.tracker.snapshot(10)
+	s.Len(load.GetTopWorkflows(), 2)
+	s.Equal("cold3", load.GetTopWorkflows()[1].GetWorkflowId())
+}
+
+func (s *loadTrackerSuite) TestCacheAndPersistence() {
+	s.tracker.recordCacheLookup(true)
+	s.tracker.recordCacheLookup(true)
+	s.tracker.recordCacheLookup(true)
+	s.tracker.recordCacheLookup(false)
+	s.tracker.recordPersistenceLatency(10 * time.Millisecond)
+	s.tracker.recordPersistenceLatency(30 * time.Millisecond)
+	s.advance(time.Second)
+
+	load := s.tracker.snapshot(10)
+	s.Equal(0.75, load.GetMutableStateCacheHitRate())
+	s.Equal(int64(2), load.GetPersistenceRequests())
+	s.Equal(20*time.Millisecond, timestamp.DurationValue(load.GetPersistenceLatencyAvg()))
+	s.Equal(30*time.Millisecond, timestamp.DurationValue(load.GetPersistenceLatencyMax()))
+}
+
+func (s *loadTrackerSuite) TestGetShardLoad() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+
+	shardContext := NewTestContext(
+		controller,
+		&persistence.ShardInfoWithFailover{
+			ShardInfo: &persistencespb.ShardInfo{
+				ShardId:             1,
+				TransferAckLevel:    90,
+				VisibilityAckLevel:  100,
+				ReplicationAckLevel: 120,
+			},
+		},
+		tests.NewDynamicConfig(),
+	)
+	shardContext.transferMaxReadLevel = 110
+	shardContext.RecordMutableStateCacheLookup(true)
+
+	load := shardContext.getShardLoad(10)
+	s.Equal(int32(1), load.GetShardId())
+	s.Equal(1.0, load.GetMutableStateCacheHitRate())
+	s.Equal(map[string]int64{
+		tasks.CategoryNameTransfer:    20,
+		tasks.CategoryNameVisibility:  10,
+		tasks.CategoryNameReplication: 0,
+	}, load.GetTaskIdLag())
+}
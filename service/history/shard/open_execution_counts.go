@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// updateOpenExecutionCountsLocked adjusts the open workflow execution counters of the shard based on the tasks
+// persisted for a workflow execution. A start visibility task is generated exactly when a new run starts and a
+// close execution task exactly when a run closes, so they are used as markers for the state transition.
+// Counters are stored in the shard info and persisted together with it, which makes them approximate: changes
+// made after the last shard info update are lost if the shard moves, and executions opened before counting was
+// introduced are not accounted for.
+func (s *ContextImpl) updateOpenExecutionCountsLocked(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	newTasks map[tasks.Category][]tasks.Task,
+) {
+	delta := int64(0)
+	for _, task := range newTasks[tasks.CategoryVisibility] {
+		if _, ok := task.(*tasks.StartExecutionVisibilityTask); ok {
+			delta++
+		}
+	}
+	for _, task := range newTasks[tasks.CategoryTransfer] {
+		if _, ok := task.(*tasks.CloseExecutionTask); ok {
+			delta--
+		}
+	}
+	if delta == 0 {
+		return
+	}
+
+	if s.shardInfo.OpenExecutionCounts == nil {
+		s.shardInfo.OpenExecutionCounts = make(map[string]*persistencespb.OpenExecutionCounts)
+	}
+	addOpenExecutionCount(
+		s.shardInfo.OpenExecutionCounts,
+		executionInfo.GetNamespaceId(),
+		executionInfo.GetWorkflowTypeName(),
+		delta,
+	)
+}
+
+func (s *ContextImpl) getOpenExecutionCounts() map[string]*persistencespb.OpenExecutionCounts {
+	s.rLock()
+	defer s.rUnlock()
+
+	if err := s.errorByStateLocked(); err != nil {
+		return nil
+	}
+	return copyOpenExecutionCounts(s.shardInfo.OpenExecutionCounts)
+}
+
+// MergeOpenExecutionCounts adds open workflow execution counts keyed by namespace ID from src to dst.
+func MergeOpenExecutionCounts(
+	dst map[string]*persistencespb.OpenExecutionCounts,
+	src map[string]*persistencespb.OpenExecutionCounts,
+) {
+	for namespaceID, counts := range src {
+		merged, ok := dst[namespaceID]
+		if !ok {
+			merged = &persistencespb.OpenExecutionCounts{WorkflowTypes: make(map[string]int64)}
+			dst[namespaceID] = merged
+		}
+		merged.Total += counts.GetTotal()
+		for workflowType, count := range counts.GetWorkflowTypes() {
+			merged.WorkflowTypes[workflowType] += count
+		}
+	}
+}
+
+func addOpenExecutionCount(
+	counts map[string]*persistencespb.OpenExecutionCounts,
+	namespaceID string,
+	workflowType string,
+	delta int64,
+) {
+	namespaceCounts, ok := counts[namespaceID]
+	if !ok {
+		namespaceCounts = &persistencespb.OpenExecutionCounts{}
+		counts[namespaceID] = namespaceCounts
+	}
+	if namespaceCounts.WorkflowTypes == nil {
+		namespaceCounts.WorkflowTypes = make(map[string]int64)
+	}
+
+	// Counters never go below zero: executions which were opened before they were counted may still be closed.
+	namespaceCounts.Total += delta
+	if namespaceCounts.Total < 0 {
+		namespaceCounts.Total = 0
+	}
+	if count := namespaceCounts.WorkflowTypes[workflowType] + delta; count > 0 {
+		namespaceCounts.WorkflowTypes[workflowType] = count
+	} else {
+		delete(namespaceCounts.WorkflowTypes, workflowType)
+	}
+
+	if namespaceCounts.Total == 0 && len(namespaceCounts.WorkflowTypes) == 0 {
+		delete(counts, namespaceID)
+	}
+}
+
+func copyOpenExecutionCounts(
+	counts map[string]*persistencespb.OpenExecutionCounts,
+) map[string]*persistencespb.OpenExecutionCounts {
+	if len(counts) == 0 {
+		return nil
+	}
+	countsCopy := make(map[string]*persistencespb.OpenExecutionCounts, len(counts))
+	MergeOpenExecutionCounts(countsCopy, counts)
+	return countsCopy
+}
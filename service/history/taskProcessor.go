@@ -26,9 +26,11 @@ package history
 
 import (
 	"context"
+	"reflect"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common"
@@ -39,6 +41,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/shard"
@@ -62,8 +65,9 @@ type (
 		// TODO: change to queueTaskExecutor
 		processor taskExecutor
 
-		attempt   int
-		startTime time.Time
+		attempt       int
+		startTime     time.Time
+		scheduledTime time.Time
 
 		userLatency time.Duration
 		logger      log.Logger
@@ -78,6 +82,8 @@ type (
 		cache         workflow.Cache
 		shutdownCh    chan struct{}
 		tasksCh       chan *taskInfo
+		scheduler     *namespaceTaskScheduler
+		workerLimiter TaskWorkerLimiter
 		config        *configs.Config
 		logger        log.Logger
 		metricsClient metrics.Client
@@ -85,6 +91,10 @@ type (
 		retryPolicy   backoff.RetryPolicy
 		workerWG      sync.WaitGroup
 
+		// bounds the number of tasks buffered by the scheduler
+		schedulerSlotsCh  chan struct{}
+		schedulerNotifyCh chan struct{}
+
 		// worker coroutines notification
 		workerNotificationChans []chan struct{}
 		// duplicate numOfWorker from config.TimerTaskWorkerCount for dynamic config works correctly
@@ -113,6 +123,7 @@ func newTaskProcessor(
 	options taskProcessorOptions,
 	shard shard.Context,
 	historyCache workflow.Cache,
+	workerLimiter TaskWorkerLimiter,
 	logger log.Logger,
 ) *taskProcessor {
 
@@ -125,7 +136,11 @@ func newTaskProcessor(
 		shard:                   shard,
 		cache:                   historyCache,
 		shutdownCh:              make(chan struct{}),
-		tasksCh:                 make(chan *taskInfo, options.queueSize),
+		tasksCh:                 make(chan *taskInfo),
+		scheduler:               newNamespaceTaskScheduler(shard.GetConfig().TaskSchedulerNamespaceWeight),
+		workerLimiter:           workerLimiter,
+		schedulerSlotsCh:        make(chan struct{}, options.queueSize),
+		schedulerNotifyCh:       make(chan struct{}, 1),
 		config:                  shard.GetConfig(),
 		logger:                  logger,
 		metricsClient:           shard.GetMetricsClient(),
@@ -139,6 +154,9 @@ func newTaskProcessor(
 }
 
 func (t *taskProcessor) start() {
+	t.workerLimiter.RegisterWorkers(t.numOfWorker)
+	t.workerWG.Add(1)
+	go t.dispatchLoop()
 	for i := 0; i < t.numOfWorker; i++ {
 		t.workerWG.Add(1)
 		notificationChan := t.workerNotificationChans[i]
@@ -152,6 +170,7 @@ func (t *taskProcessor) stop() {
 	if success := common.AwaitWaitGroup(&t.workerWG, time.Minute); !success {
 		t.logger.Warn("Task processor timed out on shutdown.")
 	}
+	t.workerLimiter.UnregisterWorkers(t.numOfWorker)
 	t.logger.Info("Task processor shutdown.")
 }
 
@@ -168,13 +187,47 @@ func (t *taskProcessor) taskWorker(
 			if !ok {
 				return
 			}
+			namespaceID := namespace.ID(task.GetNamespaceID())
 			if task.GetTaskID() > t.shard.GetMaxTaskIDForCurrentRangeID() {
 				// this could happen if we lost ownership and were not aware of it.
 				// unload shard
+				t.workerLimiter.Release(namespaceID)
 				t.shard.Unload()
 				return
 			}
+			t.metricsClient.Scope(metrics.TaskSchedulerScope, t.getNamespaceTagByID(namespaceID)).
+				RecordTimer(metrics.TaskSchedulerLatency, time.Since(task.scheduledTime))
 			t.processTaskAndAck(notificationChan, task)
+			t.workerLimiter.Release(namespaceID)
+		}
+	}
+}
+
+// dispatchLoop hands buffered tasks to the task workers in the order picked by the scheduler,
+// skipping namespaces which already use their max share of the host's task workers.
+func (t *taskProcessor) dispatchLoop() {
+	defer t.workerWG.Done()
+
+	for {
+		// get the notification channel before picking the task, so a release in between is not missed
+		releasedCh := t.workerLimiter.Released()
+		task := t.scheduler.next(t.workerLimiter.TryAcquire)
+		if task == nil {
+			select {
+			case <-t.schedulerNotifyCh:
+			case <-releasedCh:
+			case <-t.shutdownCh:
+				return
+			}
+			continue
+		}
+
+		<-t.schedulerSlotsCh
+		select {
+		case t.tasksCh <- task:
+		case <-t.shutdownCh:
+			t.workerLimiter.Release(namespace.ID(task.GetNamespaceID()))
+			return
 		}
 	}
 }
@@ -193,10 +246,17 @@ func (t *taskProcessor) addTask(
 ) bool {
 	// We have a timer to fire.
 	select {
-	case t.tasksCh <- task:
+	case t.schedulerSlotsCh <- struct{}{}:
 	case <-t.shutdownCh:
 		return false
 	}
+
+	task.scheduledTime = time.Now()
+	t.scheduler.add(task, t.getNamespaceNameByID(namespace.ID(task.GetNamespaceID())))
+	select {
+	case t.schedulerNotifyCh <- struct{}{}:
+	default:
+	}
 	return true
 }
 
@@ -272,8 +332,10 @@ func (t *taskProcessor) processTaskOnce(
 
 	ctx := context.Background()
 	ctx = metrics.AddMetricsContext(ctx)
+	ctx, span := t.startTaskSpan(ctx, task)
 	startTime := t.timeSource.Now()
 	scopeIdx, err := task.processor.process(ctx, task)
+	tracing.EndSpan(span, err)
 	if duration, ok := metrics.ContextCounterGet(ctx, metrics.HistoryWorkflowExecutionCacheLatency); ok {
 		task.userLatency += time.Duration(duration)
 	}
@@ -288,6 +350,30 @@ func (t *taskProcessor) processTaskOnce(
 	return scope, err
 }
 
+// startTaskSpan starts a span for one processing attempt of the task. RPCs made by the task executor
+// (e.g. to matching service) are part of the trace.
+func (t *taskProcessor) startTaskSpan(
+	ctx context.Context,
+	task *taskInfo,
+) (context.Context, trace.Span) {
+
+	taskType := reflect.Indirect(reflect.ValueOf(task.Task)).Type().Name()
+	return tracing.Tracer().Start(
+		ctx,
+		"history.task."+taskType,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			tracing.ShardIDKey.Int64(int64(t.shard.GetShardID())),
+			tracing.NamespaceIDKey.String(task.GetNamespaceID()),
+			tracing.WorkflowIDKey.String(task.GetWorkflowID()),
+			tracing.RunIDKey.String(task.GetRunID()),
+			tracing.TaskIDKey.Int64(task.GetTaskID()),
+			tracing.TaskTypeKey.String(taskType),
+			tracing.TaskAttemptKey.Int(task.attempt),
+		),
+	)
+}
+
 func (t *taskProcessor) handleTaskError(
 	scope metrics.Scope,
 	task *taskInfo,
@@ -357,10 +443,18 @@ func (t *taskProcessor) ackTaskOnce(
 }
 
 func (t *taskProcessor) getNamespaceTagByID(namespaceID namespace.ID) metrics.Tag {
+	namespaceName := t.getNamespaceNameByID(namespaceID)
+	if namespaceName == "" {
+		return metrics.NamespaceUnknownTag()
+	}
+	return metrics.NamespaceTag(namespaceName.String())
+}
+
+func (t *taskProcessor) getNamespaceNameByID(namespaceID namespace.ID) namespace.Name {
 	namespaceName, err := t.shard.GetNamespaceRegistry().GetNamespaceName(namespaceID)
 	if err != nil {
 		t.logger.Error("Unable to get namespace", tag.Error(err))
-		return metrics.NamespaceUnknownTag()
+		return ""
 	}
-	return metrics.NamespaceTag(namespaceName.String())
+	return namespaceName
 }
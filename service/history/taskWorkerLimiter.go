@@ -0,0 +1,148 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/configs"
+)
+
+type (
+	// TaskWorkerLimiter is shared by the transfer, timer and visibility task processors of all shards
+	// on a history host, and caps the share of the host's task workers which can execute tasks of
+	// a single namespace at the same time.
+	TaskWorkerLimiter interface {
+		// RegisterWorkers adds task workers to the host's worker pool
+		RegisterWorkers(count int)
+		// UnregisterWorkers removes task workers from the host's worker pool
+		UnregisterWorkers(count int)
+		// TryAcquire reserves a task worker for the namespace, returns false if the namespace
+		// already uses its max share of the host's task workers.
+		TryAcquire(namespaceID namespace.ID, namespaceName namespace.Name) bool
+		// Release returns a task worker reserved by TryAcquire
+		Release(namespaceID namespace.ID)
+		// Released returns a channel which is closed the next time a task worker is released
+		Released() <-chan struct{}
+	}
+
+	taskWorkerLimiterImpl struct {
+		maxWorkerShare dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		metricsClient  metrics.Client
+
+		sync.Mutex
+		workerCount int
+		// number of task workers executing tasks keyed by namespace ID
+		acquired   map[namespace.ID]int
+		releasedCh chan struct{}
+	}
+)
+
+var _ TaskWorkerLimiter = (*taskWorkerLimiterImpl)(nil)
+
+// NewTaskWorkerLimiter creates a new task worker limiter
+func NewTaskWorkerLimiter(
+	config *configs.Config,
+	metricsClient metrics.Client,
+) TaskWorkerLimiter {
+	return &taskWorkerLimiterImpl{
+		maxWorkerShare: config.TaskSchedulerNamespaceMaxWorkerShare,
+		metricsClient:  metricsClient,
+		acquired:       make(map[namespace.ID]int),
+		releasedCh:     make(chan struct{}),
+	}
+}
+
+func (l *taskWorkerLimiterImpl) RegisterWorkers(count int) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.workerCount += count
+}
+
+func (l *taskWorkerLimiterImpl) UnregisterWorkers(count int) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.workerCount -= count
+	if l.workerCount < 0 {
+		l.workerCount = 0
+	}
+	l.notifyReleasedLocked()
+}
+
+func (l *taskWorkerLimiterImpl) TryAcquire(
+	namespaceID namespace.ID,
+	namespaceName namespace.Name,
+) bool {
+	share := l.maxWorkerShare(namespaceName.String())
+
+	l.Lock()
+	defer l.Unlock()
+
+	if share < 1 {
+		limit := int(share * float64(l.workerCount))
+		if limit < 1 {
+			// always allow a namespace to make progress
+			limit = 1
+		}
+		if l.acquired[namespaceID] >= limit {
+			l.metricsClient.Scope(metrics.TaskSchedulerScope, metrics.NamespaceTag(namespaceName.String())).
+				IncCounter(metrics.TaskWorkerShareThrottledCounter)
+			return false
+		}
+	}
+	l.acquired[namespaceID]++
+	return true
+}
+
+func (l *taskWorkerLimiterImpl) Release(
+	namespaceID namespace.ID,
+) {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.acquired[namespaceID] <= 1 {
+		delete(l.acquired, namespaceID)
+	} else {
+		l.acquired[namespaceID]--
+	}
+	l.notifyReleasedLocked()
+}
+
+func (l *taskWorkerLimiterImpl) Released() <-chan struct{} {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.releasedCh
+}
+
+func (l *taskWorkerLimiterImpl) notifyReleasedLocked() {
+	close(l.releasedCh)
+	l.releasedCh = make(chan struct{})
+}
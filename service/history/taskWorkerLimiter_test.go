@@ -0,0 +1,94 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/tests"
+)
+
+type (
+	taskWorkerLimiterSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		limiter TaskWorkerLimiter
+	}
+)
+
+func TestTaskWorkerLimiterSuite(t *testing.T) {
+	s := new(taskWorkerLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *taskWorkerLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	config := tests.NewDynamicConfig()
+	config.TaskSchedulerNamespaceMaxWorkerShare = func(namespaceName string) float64 {
+		if namespaceName == "limited-namespace" {
+			return 0.25
+		}
+		return 1
+	}
+	s.limiter = NewTaskWorkerLimiter(config, metrics.NewNoopMetricsClient())
+	s.limiter.RegisterWorkers(10)
+}
+
+func (s *taskWorkerLimiterSuite) TestTryAcquire_MaxWorkerShare() {
+	for i := 0; i < 2; i++ {
+		s.True(s.limiter.TryAcquire("limited-namespace-id", "limited-namespace"))
+	}
+	s.False(s.limiter.TryAcquire("limited-namespace-id", "limited-namespace"))
+
+	for i := 0; i < 10; i++ {
+		s.True(s.limiter.TryAcquire("other-namespace-id", "other-namespace"))
+	}
+
+	released := s.limiter.Released()
+	s.limiter.Release("limited-namespace-id")
+	select {
+	case <-released:
+	default:
+		s.Fail("expected release notification")
+	}
+	s.True(s.limiter.TryAcquire("limited-namespace-id", "limited-namespace"))
+	s.False(s.limiter.TryAcquire("limited-namespace-id", "limited-namespace"))
+}
+
+func (s *taskWorkerLimiterSuite) TestTryAcquire_AtLeastOneWorker() {
+	s.limiter.UnregisterWorkers(8)
+
+	s.True(s.limiter.TryAcquire("limited-namespace-id", "limited-namespace"))
+	s.False(s.limiter.TryAcquire("limited-namespace-id", "limited-namespace"))
+	s.limiter.Release(namespace.ID("limited-namespace-id"))
+	s.True(s.limiter.TryAcquire("limited-namespace-id", "limited-namespace"))
+}
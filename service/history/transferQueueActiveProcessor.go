@@ -137,6 +137,7 @@ func newTransferQueueActiveProcessor(
 		processor,
 		queueAckMgr,
 		historyEngine.historyCache,
+		historyEngine.taskWorkerLimiter,
 		logger,
 		shard.GetMetricsClient().Scope(metrics.TransferActiveQueueProcessorScope),
 	)
@@ -248,6 +249,7 @@ func newTransferQueueFailoverProcessor(
 		processor,
 		queueAckMgr,
 		historyEngine.historyCache,
+		historyEngine.taskWorkerLimiter,
 		logger,
 		shard.GetMetricsClient().Scope(metrics.TransferActiveQueueProcessorScope),
 	)
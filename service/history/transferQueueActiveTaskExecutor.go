@@ -39,6 +39,7 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -170,12 +171,13 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	}
 
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
+	priority := ai.Priority
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(task, &timeout)
+	return t.pushActivity(task, &timeout, priority)
 }
 
 func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
@@ -223,6 +225,8 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 	// task or not.
 	var taskQueue *taskqueuepb.TaskQueue
 	var taskScheduleToStartTimeoutSeconds = int64(0)
+	var workerBuildID string
+	priority := executionInfo.Priority
 	if mutableState.GetExecutionInfo().TaskQueue != task.TaskQueue {
 		// this workflowTask is an sticky workflowTask
 		// there shall already be an timer set
@@ -238,13 +242,15 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		}
 		workflowRunTimeout := timestamp.DurationValue(executionInfo.WorkflowRunTimeout)
 		taskScheduleToStartTimeoutSeconds = int64(workflowRunTimeout.Round(time.Second).Seconds())
+		// sticky task queues are bound to a single worker, only normal ones are routed by build ID
+		workerBuildID = executionInfo.GetWorkerBuildId()
 	}
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushWorkflowTask(task, taskQueue, timestamp.DurationFromSeconds(taskScheduleToStartTimeoutSeconds))
+	return t.pushWorkflowTask(task, taskQueue, timestamp.DurationFromSeconds(taskScheduleToStartTimeoutSeconds), workerBuildID, priority)
 }
 
 func (t *transferQueueActiveTaskExecutor) processCloseExecution(
@@ -660,8 +666,15 @@ func (t *transferQueueActiveTaskExecutor) processStartChildExecution(
 
 		// Check to see if the error is non-transient, in which case add StartChildWorkflowExecutionFailed
 		// event and complete transfer task by setting the err = nil
-		if _, ok := err.(*serviceerror.WorkflowExecutionAlreadyStarted); ok {
-			err = t.recordStartChildExecutionFailed(task, context, attributes)
+		switch err := err.(type) {
+		case *serviceerror.WorkflowExecutionAlreadyStarted:
+			return t.recordStartChildExecutionFailed(task, context, attributes, enumspb.START_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_WORKFLOW_ALREADY_EXISTS)
+		case *serviceerror.ResourceExhausted:
+			// open executions limit of the target namespace is reached, retrying the task would not
+			// start the child until some other executions are closed
+			if err.Cause == enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT {
+				return t.recordStartChildExecutionFailed(task, context, attributes, enumspb.START_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_UNSPECIFIED)
+			}
 		}
 
 		return err
@@ -856,6 +869,7 @@ func (t *transferQueueActiveTaskExecutor) recordStartChildExecutionFailed(
 	task *tasks.StartChildExecutionTask,
 	context workflow.Context,
 	initiatedAttributes *historypb.StartChildWorkflowExecutionInitiatedEventAttributes,
+	cause enumspb.StartChildWorkflowExecutionFailedCause,
 ) error {
 
 	return t.updateWorkflowExecution(context, true,
@@ -871,7 +885,7 @@ func (t *transferQueueActiveTaskExecutor) recordStartChildExecutionFailed(
 
 			_, err := mutableState.AddStartChildWorkflowExecutionFailedEvent(
 				task.InitiatedID,
-				enumspb.START_CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_WORKFLOW_ALREADY_EXISTS,
+				cause,
 				initiatedAttributes,
 			)
 			return err
@@ -1196,6 +1210,7 @@ func (t *transferQueueActiveTaskExecutor) startWorkflowWithRetry(
 		},
 		t.shard.GetTimeSource().Now(),
 	)
+	request.Priority = childInfo.Priority
 
 	ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
 	defer cancel()
@@ -1206,13 +1221,21 @@ func (t *transferQueueActiveTaskExecutor) startWorkflowWithRetry(
 		return err
 	}
 
-	err = backoff.Retry(op, workflow.PersistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	err = backoff.Retry(op, workflow.PersistenceOperationRetryPolicy, isStartChildTransientError)
 	if err != nil {
 		return "", err
 	}
 	return response.GetRunId(), nil
 }
 
+func isStartChildTransientError(err error) bool {
+	// open executions limit is not expected to be released within the retry interval
+	if err, ok := err.(*serviceerror.ResourceExhausted); ok && err.Cause == enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT {
+		return false
+	}
+	return common.IsPersistenceTransientError(err)
+}
+
 func (t *transferQueueActiveTaskExecutor) resetWorkflow(
 	task *tasks.ResetWorkflowTask,
 	reason string,
@@ -1266,7 +1289,7 @@ func (t *transferQueueActiveTaskExecutor) resetWorkflow(
 		),
 		reason,
 		nil,
-		enumspb.RESET_REAPPLY_TYPE_SIGNAL,
+		enumsspb.RESET_REAPPLY_TYPE_SIGNAL_AND_UPDATE,
 	)
 
 	switch err.(type) {
@@ -140,6 +140,7 @@ func newTransferQueueStandbyProcessor(
 		processor,
 		queueAckMgr,
 		historyEngine.historyCache,
+		historyEngine.taskWorkerLimiter,
 		logger,
 		shard.GetMetricsClient().Scope(metrics.TransferStandbyQueueProcessorScope),
 	)
@@ -149,7 +149,7 @@ func (t *transferQueueStandbyTaskExecutor) processActivityTask(
 		}
 
 		if activityInfo.StartedId == common.EmptyEventID {
-			return newPushActivityToMatchingInfo(*activityInfo.ScheduleToStartTimeout), nil
+			return newPushActivityToMatchingInfo(*activityInfo.ScheduleToStartTimeout, activityInfo.Priority), nil
 		}
 
 		return nil, nil
@@ -188,6 +188,7 @@ func (t *transferQueueStandbyTaskExecutor) processWorkflowTask(
 
 		var taskQueue *taskqueuepb.TaskQueue
 		var taskScheduleToStartTimeoutSeconds = int64(0)
+		var workerBuildID string
 		if mutableState.GetExecutionInfo().TaskQueue != transferTask.TaskQueue {
 			// this workflowTask is an sticky workflowTask
 			// there shall already be an timer set
@@ -203,6 +204,7 @@ func (t *transferQueueStandbyTaskExecutor) processWorkflowTask(
 			}
 			workflowRunTimeout := timestamp.DurationValue(executionInfo.WorkflowRunTimeout)
 			taskScheduleToStartTimeoutSeconds = int64(workflowRunTimeout.Round(time.Second).Seconds())
+			workerBuildID = executionInfo.GetWorkerBuildId()
 		}
 
 		ok, err := verifyTaskVersion(t.shard, t.logger, namespace.ID(transferTask.NamespaceID), wtInfo.Version, transferTask.Version, transferTask)
@@ -214,6 +216,8 @@ func (t *transferQueueStandbyTaskExecutor) processWorkflowTask(
 			return newPushWorkflowTaskToMatchingInfo(
 				taskScheduleToStartTimeoutSeconds,
 				*taskQueue,
+				workerBuildID,
+				executionInfo.Priority,
 			), nil
 		}
 
@@ -479,6 +483,7 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 	return t.transferQueueTaskExecutorBase.pushActivity(
 		task.(*tasks.ActivityTask),
 		&timeout,
+		pushActivityInfo.priority,
 	)
 }
 
@@ -498,6 +503,8 @@ func (t *transferQueueStandbyTaskExecutor) pushWorkflowTask(
 		task.(*tasks.WorkflowTask),
 		&pushwtInfo.taskqueue,
 		timestamp.DurationFromSeconds(timeout),
+		pushwtInfo.workerBuildID,
+		pushwtInfo.priority,
 	)
 }
 
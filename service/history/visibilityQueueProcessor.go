@@ -156,6 +156,7 @@ func newVisibilityQueueProcessor(
 		retProcessor,
 		queueAckMgr,
 		historyEngine.historyCache,
+		historyEngine.taskWorkerLimiter,
 		logger,
 		shard.GetMetricsClient().Scope(metrics.VisibilityQueueProcessorScope),
 	)
@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -166,10 +167,14 @@ func (t *visibilityQueueTaskExecutor) processStartExecution(
 	workflowStartTime := timestamp.TimeValue(mutableState.GetExecutionInfo().GetStartTime())
 	workflowExecutionTime := timestamp.TimeValue(mutableState.GetExecutionInfo().GetExecutionTime())
 	visibilityMemo := getWorkflowMemo(copyMemo(executionInfo.Memo))
-	searchAttr := getSearchAttributes(copySearchAttributes(executionInfo.SearchAttributes))
+	searchAttr, err := getSearchAttributesWithHistorySize(copySearchAttributes(executionInfo.SearchAttributes), weContext.GetHistorySize())
+	if err != nil {
+		return err
+	}
 	executionStatus := executionState.GetStatus()
 	taskQueue := executionInfo.TaskQueue
 	stateTransitionCount := executionInfo.GetStateTransitionCount()
+	historyLength := mutableState.GetNextEventID() - 1
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state builder and
@@ -184,6 +189,7 @@ func (t *visibilityQueueTaskExecutor) processStartExecution(
 		workflowStartTime,
 		workflowExecutionTime,
 		stateTransitionCount,
+		historyLength,
 		task.GetTaskID(),
 		executionStatus,
 		taskQueue,
@@ -229,10 +235,14 @@ func (t *visibilityQueueTaskExecutor) processUpsertExecution(
 	workflowStartTime := timestamp.TimeValue(mutableState.GetExecutionInfo().GetStartTime())
 	workflowExecutionTime := timestamp.TimeValue(mutableState.GetExecutionInfo().GetExecutionTime())
 	visibilityMemo := getWorkflowMemo(copyMemo(executionInfo.Memo))
-	searchAttr := getSearchAttributes(copySearchAttributes(executionInfo.SearchAttributes))
+	searchAttr, err := getSearchAttributesWithHistorySize(copySearchAttributes(executionInfo.SearchAttributes), weContext.GetHistorySize())
+	if err != nil {
+		return err
+	}
 	executionStatus := executionState.GetStatus()
 	taskQueue := executionInfo.TaskQueue
 	stateTransitionCount := executionInfo.GetStateTransitionCount()
+	historyLength := mutableState.GetNextEventID() - 1
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state builder and
@@ -247,6 +257,7 @@ func (t *visibilityQueueTaskExecutor) processUpsertExecution(
 		workflowStartTime,
 		workflowExecutionTime,
 		stateTransitionCount,
+		historyLength,
 		task.GetTaskID(),
 		executionStatus,
 		taskQueue,
@@ -263,6 +274,7 @@ func (t *visibilityQueueTaskExecutor) recordStartExecution(
 	startTime time.Time,
 	executionTime time.Time,
 	stateTransitionCount int64,
+	historyLength int64,
 	taskID int64,
 	status enumspb.WorkflowExecutionStatus,
 	taskQueue string,
@@ -286,12 +298,14 @@ func (t *visibilityQueueTaskExecutor) recordStartExecution(
 			WorkflowTypeName:     workflowTypeName,
 			StartTime:            startTime,
 			ExecutionTime:        executionTime,
-			StateTransitionCount: stateTransitionCount, TaskID: taskID,
-			Status:           status,
-			ShardID:          t.shard.GetShardID(),
-			Memo:             visibilityMemo,
-			TaskQueue:        taskQueue,
-			SearchAttributes: searchAttributes,
+			StateTransitionCount: stateTransitionCount,
+			HistoryLength:        historyLength,
+			TaskID:               taskID,
+			Status:               status,
+			ShardID:              t.shard.GetShardID(),
+			Memo:                 visibilityMemo,
+			TaskQueue:            taskQueue,
+			SearchAttributes:     searchAttributes,
 		},
 	}
 	return t.visibilityMgr.RecordWorkflowExecutionStarted(request)
@@ -305,6 +319,7 @@ func (t *visibilityQueueTaskExecutor) upsertExecution(
 	startTime time.Time,
 	executionTime time.Time,
 	stateTransitionCount int64,
+	historyLength int64,
 	taskID int64,
 	status enumspb.WorkflowExecutionStatus,
 	taskQueue string,
@@ -328,12 +343,14 @@ func (t *visibilityQueueTaskExecutor) upsertExecution(
 			WorkflowTypeName:     workflowTypeName,
 			StartTime:            startTime,
 			ExecutionTime:        executionTime,
-			StateTransitionCount: stateTransitionCount, TaskID: taskID,
-			ShardID:          t.shard.GetShardID(),
-			Status:           status,
-			Memo:             visibilityMemo,
-			TaskQueue:        taskQueue,
-			SearchAttributes: searchAttributes,
+			StateTransitionCount: stateTransitionCount,
+			HistoryLength:        historyLength,
+			TaskID:               taskID,
+			ShardID:              t.shard.GetShardID(),
+			Status:               status,
+			Memo:                 visibilityMemo,
+			TaskQueue:            taskQueue,
+			SearchAttributes:     searchAttributes,
 		},
 	}
 
@@ -395,7 +412,10 @@ func (t *visibilityQueueTaskExecutor) processCloseExecution(
 	workflowStartTime := timestamp.TimeValue(mutableState.GetExecutionInfo().GetStartTime())
 	workflowExecutionTime := timestamp.TimeValue(mutableState.GetExecutionInfo().GetExecutionTime())
 	visibilityMemo := getWorkflowMemo(copyMemo(executionInfo.Memo))
-	searchAttr := getSearchAttributes(copySearchAttributes(executionInfo.SearchAttributes))
+	searchAttr, err := getSearchAttributesWithHistorySize(copySearchAttributes(executionInfo.SearchAttributes), weContext.GetHistorySize())
+	if err != nil {
+		return err
+	}
 	taskQueue := executionInfo.TaskQueue
 	stateTransitionCount := executionInfo.GetStateTransitionCount()
 
@@ -454,15 +474,16 @@ func (t *visibilityQueueTaskExecutor) recordCloseExecution(
 			WorkflowTypeName:     workflowTypeName,
 			StartTime:            startTime,
 			ExecutionTime:        executionTime,
-			StateTransitionCount: stateTransitionCount, Status: status,
-			TaskID:           taskID,
-			ShardID:          t.shard.GetShardID(),
-			Memo:             visibilityMemo,
-			TaskQueue:        taskQueue,
-			SearchAttributes: searchAttributes,
+			StateTransitionCount: stateTransitionCount,
+			HistoryLength:        historyLength,
+			Status:               status,
+			TaskID:               taskID,
+			ShardID:              t.shard.GetShardID(),
+			Memo:                 visibilityMemo,
+			TaskQueue:            taskQueue,
+			SearchAttributes:     searchAttributes,
 		},
-		CloseTime:     endTime,
-		HistoryLength: historyLength,
+		CloseTime: endTime,
 	})
 }
 
@@ -520,6 +541,24 @@ func getSearchAttributes(
 	return &commonpb.SearchAttributes{IndexedFields: indexedFields}
 }
 
+// getSearchAttributesWithHistorySize adds history size to search attributes,
+// it is stored in visibility together with custom search attributes.
+func getSearchAttributesWithHistorySize(
+	indexedFields map[string]*commonpb.Payload,
+	historySizeBytes int64,
+) (*commonpb.SearchAttributes, error) {
+
+	historySizePayload, err := searchattribute.EncodeValue(historySizeBytes, enumspb.INDEXED_VALUE_TYPE_INT)
+	if err != nil {
+		return nil, err
+	}
+	if indexedFields == nil {
+		indexedFields = make(map[string]*commonpb.Payload, 1)
+	}
+	indexedFields[searchattribute.HistorySizeBytes] = historySizePayload
+	return &commonpb.SearchAttributes{IndexedFields: indexedFields}, nil
+}
+
 func copySearchAttributes(
 	input map[string]*commonpb.Payload,
 ) map[string]*commonpb.Payload {
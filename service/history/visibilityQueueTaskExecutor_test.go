@@ -53,6 +53,7 @@ import (
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -344,6 +345,20 @@ func (s *visibilityQueueTaskExecutorSuite) TestProcessUpsertWorkflowSearchAttrib
 	s.NoError(err)
 }
 
+func (s *visibilityQueueTaskExecutorSuite) TestGetSearchAttributesWithHistorySize() {
+	customPayload, err := searchattribute.EncodeValue("value", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.NoError(err)
+
+	searchAttributes, err := getSearchAttributesWithHistorySize(map[string]*commonpb.Payload{"CustomKeywordField": customPayload}, 1024)
+	s.NoError(err)
+	s.Len(searchAttributes.GetIndexedFields(), 2)
+	s.Equal(customPayload, searchAttributes.GetIndexedFields()["CustomKeywordField"])
+
+	historySize, err := searchattribute.DecodeValue(searchAttributes.GetIndexedFields()[searchattribute.HistorySizeBytes], enumspb.INDEXED_VALUE_TYPE_INT)
+	s.NoError(err)
+	s.Equal(int64(1024), historySize)
+}
+
 func (s *visibilityQueueTaskExecutorSuite) createRecordWorkflowExecutionStartedRequest(
 	namespaceName namespace.Name,
 	startEvent *historypb.HistoryEvent,
@@ -359,6 +374,8 @@ func (s *visibilityQueueTaskExecutorSuite) createRecordWorkflowExecutionStartedR
 	}
 	executionInfo := mutableState.GetExecutionInfo()
 	executionTimestamp := timestamp.TimeValue(startEvent.GetEventTime()).Add(backoffSeconds)
+	searchAttributes, err := getSearchAttributesWithHistorySize(nil, 0)
+	s.NoError(err)
 
 	return &manager.RecordWorkflowExecutionStartedRequest{
 		VisibilityRequestBase: &manager.VisibilityRequestBase{
@@ -372,6 +389,8 @@ func (s *visibilityQueueTaskExecutorSuite) createRecordWorkflowExecutionStartedR
 			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
 			ShardID:          s.mockShard.GetShardID(),
 			TaskQueue:        taskQueueName,
+			HistoryLength:    mutableState.GetNextEventID() - 1,
+			SearchAttributes: searchAttributes,
 		},
 	}
 }
@@ -388,6 +407,8 @@ func (s *visibilityQueueTaskExecutorSuite) createUpsertWorkflowSearchAttributesR
 		RunId:      task.RunID,
 	}
 	executionInfo := mutableState.GetExecutionInfo()
+	searchAttributes, err := getSearchAttributesWithHistorySize(nil, 0)
+	s.NoError(err)
 
 	return &manager.UpsertWorkflowExecutionRequest{
 		VisibilityRequestBase: &manager.VisibilityRequestBase{
@@ -401,6 +422,8 @@ func (s *visibilityQueueTaskExecutorSuite) createUpsertWorkflowSearchAttributesR
 			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
 			TaskQueue:        taskQueueName,
 			ShardID:          s.mockShard.GetShardID(),
+			HistoryLength:    mutableState.GetNextEventID() - 1,
+			SearchAttributes: searchAttributes,
 		},
 	}
 }
@@ -172,6 +172,7 @@ func (c *CacheImpl) getOrCreateWorkflowExecutionInternal(
 
 	key := definition.NewWorkflowKey(namespaceID.String(), execution.GetWorkflowId(), execution.GetRunId())
 	workflowCtx, cacheHit := c.Get(key).(Context)
+	c.shard.RecordMutableStateCacheLookup(cacheHit)
 	if !cacheHit {
 		c.metricsClient.IncCounter(scope, metrics.CacheMissCounter)
 		// Let's create the workflow execution workflowCtx
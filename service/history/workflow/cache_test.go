@@ -227,6 +227,7 @@ func (s *historyCacheSuite) TestHistoryCacheClear() {
 
 	s.NotNil(ctx.(*ContextImpl).MutableState)
 	mock.EXPECT().GetQueryRegistry().Return(NewQueryRegistry())
+	mock.EXPECT().GetUpdateRegistry().Return(NewUpdateRegistry())
 	release(errors.New("some random error message"))
 
 	// since last time, the release function receive a non-nil error
@@ -279,6 +280,7 @@ func (s *historyCacheSuite) TestHistoryCacheConcurrentAccess_Release() {
 		// all we need is a fake MutableState
 		mock := NewMockMutableState(s.controller)
 		mock.EXPECT().GetQueryRegistry().Return(NewQueryRegistry())
+		mock.EXPECT().GetUpdateRegistry().Return(NewUpdateRegistry())
 		ctx.(*ContextImpl).MutableState = mock
 		release(errors.New("some random error message"))
 	}
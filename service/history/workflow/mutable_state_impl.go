@@ -27,6 +27,7 @@ package workflow
 import (
 	"fmt"
 	"math/rand"
+	"sort"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -61,6 +62,7 @@ import (
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/priorities"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
@@ -89,6 +91,8 @@ var (
 	ErrMissingRequestCancelInfo = serviceerror.NewInternal("unable to get request cancel info")
 	// ErrMissingSignalInfo indicates missing signal external
 	ErrMissingSignalInfo = serviceerror.NewInternal("unable to get signal info")
+	// ErrMissingUpdateInfo indicates missing info of completed update
+	ErrMissingUpdateInfo = serviceerror.NewInternal("unable to get update info")
 	// ErrMissingWorkflowStartEvent indicates missing workflow start event
 	ErrMissingWorkflowStartEvent = serviceerror.NewInternal("unable to get workflow start event")
 	// ErrMissingWorkflowCompletionEvent indicates missing workflow completion event
@@ -99,6 +103,8 @@ var (
 	ErrMissingChildWorkflowInitiatedEvent = serviceerror.NewInternal("unable to get child workflow initiated event")
 	// ErrMissingSignalInitiatedEvent indicates missing workflow signal initiated event
 	ErrMissingSignalInitiatedEvent = serviceerror.NewInternal("unable to get signal initiated event")
+	// ErrMissingUpdateCompletedEvent indicates missing marker event with update outcome
+	ErrMissingUpdateCompletedEvent = serviceerror.NewInternal("unable to get update completed event")
 )
 
 type (
@@ -167,6 +173,7 @@ type (
 		taskGenerator       TaskGenerator
 		workflowTaskManager *workflowTaskStateMachine
 		QueryRegistry       QueryRegistry
+		UpdateRegistry      UpdateRegistry
 
 		shard           shard.Context
 		clusterMetadata cluster.Metadata
@@ -225,7 +232,8 @@ func NewMutableState(
 		appliedEvents:    make(map[string]struct{}),
 		InsertTasks:      make(map[tasks.Category][]tasks.Task),
 
-		QueryRegistry: NewQueryRegistry(),
+		QueryRegistry:  NewQueryRegistry(),
+		UpdateRegistry: NewUpdateRegistry(),
 
 		shard:           shard,
 		clusterMetadata: shard.GetClusterMetadata(),
@@ -417,6 +425,16 @@ func (e *MutableStateImpl) SetHistoryTree(
 	return e.SetCurrentBranchToken(initialBranchToken)
 }
 
+// SetSuggestContinueAsNew marks the workflow execution as crossed the history soft limit,
+// the first time it happens visibility record is updated to expose current history size and length.
+func (e *MutableStateImpl) SetSuggestContinueAsNew() error {
+	if e.executionInfo.SuggestContinueAsNew {
+		return nil
+	}
+	e.executionInfo.SuggestContinueAsNew = true
+	return e.taskGenerator.GenerateWorkflowSearchAttrTasks(e.timeSource.Now())
+}
+
 func (e *MutableStateImpl) SetCurrentBranchToken(
 	branchToken []byte,
 ) error {
@@ -581,6 +599,51 @@ func (e *MutableStateImpl) GetQueryRegistry() QueryRegistry {
 	return e.QueryRegistry
 }
 
+func (e *MutableStateImpl) GetUpdateRegistry() UpdateRegistry {
+	return e.UpdateRegistry
+}
+
+// GetUpdateInfo returns info about accepted update
+func (e *MutableStateImpl) GetUpdateInfo(
+	updateID string,
+) (*persistencespb.UpdateInfo, bool) {
+
+	updateInfo, ok := e.executionInfo.UpdateInfos[updateID]
+	return updateInfo, ok
+}
+
+// GetUpdateOutcome returns outcome of completed update from the marker event which recorded it.
+func (e *MutableStateImpl) GetUpdateOutcome(
+	updateID string,
+) (*UpdateOutcome, error) {
+
+	updateInfo, ok := e.executionInfo.UpdateInfos[updateID]
+	if !ok || updateInfo.CompletedEventId == common.EmptyEventID {
+		return nil, ErrMissingUpdateInfo
+	}
+
+	currentBranchToken, version, err := e.getCurrentBranchTokenAndEventVersion(updateInfo.CompletedEventId)
+	if err != nil {
+		return nil, err
+	}
+	event, err := e.eventsCache.GetEvent(
+		events.EventKey{
+			NamespaceID: namespace.ID(e.executionInfo.NamespaceId),
+			WorkflowID:  e.executionInfo.WorkflowId,
+			RunID:       e.executionState.RunId,
+			EventID:     updateInfo.CompletedEventId,
+			Version:     version,
+		},
+		updateInfo.CompletedEventBatchId,
+		currentBranchToken,
+	)
+	if err != nil {
+		return nil, ErrMissingUpdateCompletedEvent
+	}
+	attributes := event.GetMarkerRecordedEventAttributes()
+	return NewUpdateOutcome(attributes.GetDetails(), attributes.GetFailure()), nil
+}
+
 func (e *MutableStateImpl) GetActivityScheduledEvent(
 	scheduleEventID int64,
 ) (*historypb.HistoryEvent, error) {
@@ -1369,6 +1432,9 @@ func (e *MutableStateImpl) addWorkflowExecutionStartedEventForContinueAsNew(
 	if !workflowTimeoutTime.IsZero() {
 		req.WorkflowExecutionExpirationTime = &workflowTimeoutTime
 	}
+	// priority header is validated by the workflow task command checker,
+	// the new run inherits the priority of the previous run if the command does not set one
+	req.Priority, _ = priorities.Inherit(command.GetHeader(), previousExecutionInfo.Priority)
 
 	event, err := e.AddWorkflowExecutionStartedEventWithOptions(
 		execution,
@@ -1441,6 +1507,9 @@ func (e *MutableStateImpl) AddWorkflowExecutionStartedEventWithOptions(
 	); err != nil {
 		return nil, err
 	}
+	if startRequest.GetPriority() != 0 {
+		e.executionInfo.Priority = startRequest.GetPriority()
+	}
 
 	// TODO merge active & passive task generation
 	if err := e.taskGenerator.GenerateWorkflowStartTasks(
@@ -1476,6 +1545,10 @@ func (e *MutableStateImpl) ReplicateWorkflowExecutionStartedEvent(
 	e.executionInfo.WorkflowRunTimeout = event.GetWorkflowRunTimeout()
 	e.executionInfo.WorkflowExecutionTimeout = event.GetWorkflowExecutionTimeout()
 	e.executionInfo.DefaultWorkflowTaskTimeout = event.GetWorkflowTaskTimeout()
+	// history events have no priority field, so a replicated or rebuilt execution only knows
+	// the priority set in the header of the start request, an inherited priority is set by
+	// AddWorkflowExecutionStartedEventWithOptions from the start request
+	e.executionInfo.Priority, _ = priorities.FromHeader(event.GetHeader())
 
 	if err := e.UpdateWorkflowStateStatus(
 		enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
@@ -1867,6 +1940,9 @@ func (e *MutableStateImpl) ReplicateActivityTaskScheduledEvent(
 		HasRetryPolicy:          attributes.RetryPolicy != nil,
 		Attempt:                 1,
 	}
+	// priority header is validated by the workflow task command checker,
+	// activities without priority inherit the priority of the workflow execution
+	ai.Priority, _ = priorities.Inherit(attributes.GetHeader(), e.executionInfo.Priority)
 	if ai.HasRetryPolicy {
 		ai.RetryInitialInterval = attributes.RetryPolicy.GetInitialInterval()
 		ai.RetryBackoffCoefficient = attributes.RetryPolicy.GetBackoffCoefficient()
@@ -2942,7 +3018,55 @@ func (e *MutableStateImpl) AddRecordMarkerEvent(
 		return nil, err
 	}
 
-	return e.hBuilder.AddMarkerRecordedEvent(workflowTaskCompletedEventID, command), nil
+	event := e.hBuilder.AddMarkerRecordedEvent(workflowTaskCompletedEventID, command)
+	if err := e.ReplicateMarkerRecordedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *MutableStateImpl) ReplicateMarkerRecordedEvent(
+	event *historypb.HistoryEvent,
+) error {
+
+	attributes := event.GetMarkerRecordedEventAttributes()
+	if attributes.GetMarkerName() != UpdateCompletedMarkerName {
+		return nil
+	}
+	updateInfo, ok := e.executionInfo.UpdateInfos[GetUpdateID(attributes.GetHeader())]
+	if !ok || updateInfo.CompletedEventId != common.EmptyEventID {
+		// Worker completed update which was never accepted or is already completed,
+		// there is nobody to deliver the outcome to.
+		return nil
+	}
+	updateInfo.CompletedEventId = event.GetEventId()
+	updateInfo.CompletedEventBatchId = attributes.GetWorkflowTaskCompletedEventId()
+	e.pruneCompletedUpdates()
+	// Retries of the update read its outcome from the marker event.
+	e.writeEventToCache(event)
+	return nil
+}
+
+// pruneCompletedUpdates drops the oldest completed updates above the configured limit.
+// Outcome of completed update is kept so that retries of the update get it instead of applying
+// the update again.
+func (e *MutableStateImpl) pruneCompletedUpdates() {
+	maxCompleted := e.config.MaxCompletedUpdates(e.namespaceEntry.Name().String())
+	var completed []string
+	for updateID, updateInfo := range e.executionInfo.UpdateInfos {
+		if updateInfo.CompletedEventId != common.EmptyEventID {
+			completed = append(completed, updateID)
+		}
+	}
+	if len(completed) <= maxCompleted {
+		return
+	}
+	sort.Slice(completed, func(i, j int) bool {
+		return e.executionInfo.UpdateInfos[completed[i]].CompletedEventId < e.executionInfo.UpdateInfos[completed[j]].CompletedEventId
+	})
+	for _, updateID := range completed[:len(completed)-maxCompleted] {
+		delete(e.executionInfo.UpdateInfos, updateID)
+	}
 }
 
 func (e *MutableStateImpl) AddWorkflowExecutionTerminatedEvent(
@@ -3007,12 +3131,40 @@ func (e *MutableStateImpl) AddWorkflowExecutionSignaled(
 	return event, nil
 }
 
+func (e *MutableStateImpl) AddWorkflowExecutionUpdateAcceptedEvent(
+	updateID string,
+	name string,
+	input *commonpb.Payloads,
+	identity string,
+) (*historypb.HistoryEvent, error) {
+
+	return e.AddWorkflowExecutionSignaled(
+		UpdateSignalNamePrefix+name,
+		input,
+		identity,
+		NewUpdateHeader(updateID, name),
+	)
+}
+
 func (e *MutableStateImpl) ReplicateWorkflowExecutionSignaled(
-	_ *historypb.HistoryEvent,
+	event *historypb.HistoryEvent,
 ) error {
 
 	// Increment signal count in mutable state for this workflow execution
 	e.executionInfo.SignalCount++
+
+	attributes := event.GetWorkflowExecutionSignaledEventAttributes()
+	if !IsUpdateSignalName(attributes.GetSignalName()) {
+		return nil
+	}
+	if updateID := GetUpdateID(attributes.GetHeader()); updateID != "" {
+		if e.executionInfo.UpdateInfos == nil {
+			e.executionInfo.UpdateInfos = make(map[string]*persistencespb.UpdateInfo)
+		}
+		e.executionInfo.UpdateInfos[updateID] = &persistencespb.UpdateInfo{
+			AcceptedEventId: event.GetEventId(),
+		}
+	}
 	return nil
 }
 
@@ -3184,6 +3336,9 @@ func (e *MutableStateImpl) ReplicateStartChildWorkflowExecutionInitiatedEvent(
 		WorkflowTypeName:      attributes.GetWorkflowType().GetName(),
 		ParentClosePolicy:     attributes.GetParentClosePolicy(),
 	}
+	// priority header is validated by the workflow task command checker,
+	// child workflows without priority inherit the priority of the workflow execution
+	ci.Priority, _ = priorities.Inherit(attributes.GetHeader(), e.executionInfo.Priority)
 
 	e.pendingChildExecutionInfoIDs[ci.InitiatedId] = ci
 	e.updateChildExecutionInfos[ci.InitiatedId] = ci
@@ -349,6 +349,100 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestUpdateAcceptedAndCompleted() {
+	signalEvent := &historypb.HistoryEvent{
+		EventId:   5,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+				SignalName: UpdateSignalNamePrefix + "update",
+				Header:     NewUpdateHeader("update-id", "update"),
+			},
+		},
+	}
+	s.NoError(s.mutableState.ReplicateWorkflowExecutionSignaled(signalEvent))
+	s.Equal(int64(1), s.mutableState.GetExecutionInfo().SignalCount)
+	updateInfo, ok := s.mutableState.GetUpdateInfo("update-id")
+	s.True(ok)
+	s.Equal(int64(5), updateInfo.GetAcceptedEventId())
+
+	markerEvent := &historypb.HistoryEvent{
+		EventId:   10,
+		EventType: enumspb.EVENT_TYPE_MARKER_RECORDED,
+		Attributes: &historypb.HistoryEvent_MarkerRecordedEventAttributes{
+			MarkerRecordedEventAttributes: &historypb.MarkerRecordedEventAttributes{
+				MarkerName:                   UpdateCompletedMarkerName,
+				Header:                       NewUpdateHeader("update-id", "update"),
+				WorkflowTaskCompletedEventId: 9,
+			},
+		},
+	}
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), markerEvent)
+	s.NoError(s.mutableState.ReplicateMarkerRecordedEvent(markerEvent))
+	updateInfo, ok = s.mutableState.GetUpdateInfo("update-id")
+	s.True(ok)
+	s.Equal(int64(5), updateInfo.GetAcceptedEventId())
+	s.Equal(int64(10), updateInfo.GetCompletedEventId())
+	s.Equal(int64(9), updateInfo.GetCompletedEventBatchId())
+}
+
+func (s *mutableStateSuite) TestCompletedUpdatesPruned() {
+	s.mockConfig.MaxCompletedUpdates = func(namespace string) int { return 1 }
+
+	eventID := int64(5)
+	for _, updateID := range []string{"update-1", "update-2", "update-3"} {
+		s.NoError(s.mutableState.ReplicateWorkflowExecutionSignaled(&historypb.HistoryEvent{
+			EventId:   eventID,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+					SignalName: UpdateSignalNamePrefix + "update",
+					Header:     NewUpdateHeader(updateID, "update"),
+				},
+			},
+		}))
+		eventID++
+	}
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).Times(2)
+	for _, updateID := range []string{"update-1", "update-2"} {
+		s.NoError(s.mutableState.ReplicateMarkerRecordedEvent(&historypb.HistoryEvent{
+			EventId:   eventID,
+			EventType: enumspb.EVENT_TYPE_MARKER_RECORDED,
+			Attributes: &historypb.HistoryEvent_MarkerRecordedEventAttributes{
+				MarkerRecordedEventAttributes: &historypb.MarkerRecordedEventAttributes{
+					MarkerName: UpdateCompletedMarkerName,
+					Header:     NewUpdateHeader(updateID, "update"),
+				},
+			},
+		}))
+		eventID++
+	}
+
+	// oldest completed update is pruned, in-flight update is kept
+	_, ok := s.mutableState.GetUpdateInfo("update-1")
+	s.False(ok)
+	updateInfo, ok := s.mutableState.GetUpdateInfo("update-2")
+	s.True(ok)
+	s.Equal(int64(9), updateInfo.GetCompletedEventId())
+	updateInfo, ok = s.mutableState.GetUpdateInfo("update-3")
+	s.True(ok)
+	s.Equal(common.EmptyEventID, updateInfo.GetCompletedEventId())
+}
+
+func (s *mutableStateSuite) TestUpdateSignalWithoutUpdateID() {
+	signalEvent := &historypb.HistoryEvent{
+		EventId:   5,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+				SignalName: UpdateSignalNamePrefix + "update",
+			},
+		},
+	}
+	s.NoError(s.mutableState.ReplicateWorkflowExecutionSignaled(signalEvent))
+	s.Empty(s.mutableState.GetExecutionInfo().GetUpdateInfos())
+}
+
 func (s *mutableStateSuite) TestTransientWorkflowTaskSchedule_CurrentVersionChanged() {
 	version := int64(2000)
 	runID := uuid.New()
@@ -496,7 +496,11 @@ func (b *MutableStateRebuilderImpl) ApplyEvents(
 			}
 
 		case enumspb.EVENT_TYPE_MARKER_RECORDED:
-			// No mutable state action is needed
+			if err := b.mutableState.ReplicateMarkerRecordedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
 
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
 			if err := b.mutableState.ReplicateWorkflowExecutionSignaled(
@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+
+	"go.temporal.io/server/common/payload"
+)
+
+// Workflow update is built on top of existing worker protocol:
+//  1. update is validated by the worker with a query of UpdateValidatorQueryType type,
+//     rejected update is not written to history, workflow which doesn't handle the query
+//     accepts every update;
+//  2. accepted update is recorded as WorkflowExecutionSignaled event with UpdateSignalNamePrefix + update name
+//     signal name and update id in the header, worker receives it with the next workflow task;
+//  3. worker completes the update by recording marker with UpdateCompletedMarkerName name,
+//     update id in the header and update result in the details (or failure).
+//
+// Signal names with UpdateSignalNamePrefix are reserved, signals sent by clients and workflows
+// can't use them. Accepted updates are tracked in the execution info. Completed updates keep
+// a reference to the marker with their outcome, so that a retry of the update gets the outcome
+// instead of applying the update again. Only the most recent completed updates are kept.
+const (
+	// UpdateValidatorQueryType is the query type used to validate update before it is accepted.
+	UpdateValidatorQueryType = "__temporal_update_validator"
+	// UpdateSignalNamePrefix is the prefix of the signal name which records accepted update.
+	UpdateSignalNamePrefix = "__temporal_update:"
+	// UpdateCompletedMarkerName is the name of the marker which records update outcome.
+	UpdateCompletedMarkerName = "__temporal_update_completed"
+	// UpdateResultMarkerDetailsKey is the key of update result in the marker details.
+	UpdateResultMarkerDetailsKey = "result"
+
+	// UpdateIDHeaderKey is the header key of update id.
+	UpdateIDHeaderKey = "__temporal_update_id"
+	// UpdateNameHeaderKey is the header key of update name.
+	UpdateNameHeaderKey = "__temporal_update_name"
+)
+
+type (
+	// UpdateOutcome is either result or failure of completed update.
+	UpdateOutcome struct {
+		Result  *commonpb.Payloads
+		Failure *failurepb.Failure
+	}
+)
+
+// NewUpdateHeader returns header which identifies the update.
+func NewUpdateHeader(updateID string, name string) *commonpb.Header {
+	return &commonpb.Header{
+		Fields: map[string]*commonpb.Payload{
+			UpdateIDHeaderKey:   payload.EncodeString(updateID),
+			UpdateNameHeaderKey: payload.EncodeString(name),
+		},
+	}
+}
+
+// IsUpdateSignalName returns true if the signal name is reserved for accepted updates.
+func IsUpdateSignalName(signalName string) bool {
+	return strings.HasPrefix(signalName, UpdateSignalNamePrefix)
+}
+
+// GetUpdateID returns update id from the header, empty string if header doesn't belong to the update.
+func GetUpdateID(header *commonpb.Header) string {
+	p, ok := header.GetFields()[UpdateIDHeaderKey]
+	if !ok {
+		return ""
+	}
+	var updateID string
+	if err := payload.Decode(p, &updateID); err != nil {
+		return ""
+	}
+	return updateID
+}
+
+// NewUpdateOutcome builds update outcome from the details and failure of update completed marker.
+func NewUpdateOutcome(details map[string]*commonpb.Payloads, failure *failurepb.Failure) *UpdateOutcome {
+	if failure != nil {
+		return &UpdateOutcome{Failure: failure}
+	}
+	return &UpdateOutcome{Result: details[UpdateResultMarkerDetailsKey]}
+}
@@ -0,0 +1,122 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"context"
+	"sync"
+
+	"go.temporal.io/server/service/history/consts"
+)
+
+type (
+	// UpdateRegistry keeps track of accepted updates whose callers are waiting for the outcome.
+	// It is in-memory only: if it is cleared callers retry and either wait again or find the update
+	// outcome in mutable state.
+	UpdateRegistry interface {
+		// Add returns in-flight update with given id, registering it first if needed.
+		Add(updateID string) *InFlightUpdate
+		// Complete delivers the outcome to the callers waiting for the update.
+		Complete(updateID string, outcome *UpdateOutcome)
+		// FailAll fails all in-flight updates with err.
+		FailAll(err error)
+		Len() int
+		Clear()
+	}
+
+	// InFlightUpdate is an accepted update waiting for the outcome.
+	InFlightUpdate struct {
+		doneCh  chan struct{}
+		outcome *UpdateOutcome
+		err     error
+	}
+
+	updateRegistryImpl struct {
+		sync.Mutex
+
+		updates map[string]*InFlightUpdate
+	}
+)
+
+func NewUpdateRegistry() UpdateRegistry {
+	return &updateRegistryImpl{
+		updates: make(map[string]*InFlightUpdate),
+	}
+}
+
+func (r *updateRegistryImpl) Add(updateID string) *InFlightUpdate {
+	r.Lock()
+	defer r.Unlock()
+	if u, ok := r.updates[updateID]; ok {
+		return u
+	}
+	u := &InFlightUpdate{
+		doneCh: make(chan struct{}),
+	}
+	r.updates[updateID] = u
+	return u
+}
+
+func (r *updateRegistryImpl) Complete(updateID string, outcome *UpdateOutcome) {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.updates[updateID]
+	if !ok {
+		return
+	}
+	u.outcome = outcome
+	close(u.doneCh)
+	delete(r.updates, updateID)
+}
+
+func (r *updateRegistryImpl) FailAll(err error) {
+	r.Lock()
+	defer r.Unlock()
+	for _, u := range r.updates {
+		u.err = err
+		close(u.doneCh)
+	}
+	r.updates = make(map[string]*InFlightUpdate)
+}
+
+func (r *updateRegistryImpl) Len() int {
+	r.Lock()
+	defer r.Unlock()
+	return len(r.updates)
+}
+
+func (r *updateRegistryImpl) Clear() {
+	r.FailAll(consts.ErrInFlightUpdatesCleared)
+}
+
+// Wait blocks until the update is completed, failed or ctx is done.
+func (u *InFlightUpdate) Wait(ctx context.Context) (*UpdateOutcome, error) {
+	select {
+	case <-u.doneCh:
+		return u.outcome, u.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/service/history/consts"
+)
+
+type UpdateRegistrySuite struct {
+	suite.Suite
+	*require.Assertions
+}
+
+func TestUpdateRegistrySuite(t *testing.T) {
+	suite.Run(t, new(UpdateRegistrySuite))
+}
+
+func (s *UpdateRegistrySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *UpdateRegistrySuite) TestComplete() {
+	ur := NewUpdateRegistry()
+	u1 := ur.Add("update-1")
+	u2 := ur.Add("update-2")
+	s.Same(u1, ur.Add("update-1"))
+	s.Equal(2, ur.Len())
+
+	outcome := &UpdateOutcome{Result: payloads.EncodeString("result")}
+	ur.Complete("update-1", outcome)
+	s.Equal(1, ur.Len())
+	// completing unknown or already completed update is noop
+	ur.Complete("update-1", outcome)
+	ur.Complete("update-3", outcome)
+
+	result, err := u1.Wait(context.Background())
+	s.NoError(err)
+	s.Equal(outcome, result)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = u2.Wait(ctx)
+	s.Equal(context.DeadlineExceeded, err)
+}
+
+func (s *UpdateRegistrySuite) TestClear() {
+	ur := NewUpdateRegistry()
+	u1 := ur.Add("update-1")
+	u2 := ur.Add("update-2")
+
+	ur.Clear()
+	s.Equal(0, ur.Len())
+	for _, u := range []*InFlightUpdate{u1, u2} {
+		_, err := u.Wait(context.Background())
+		s.Equal(consts.ErrInFlightUpdatesCleared, err)
+	}
+	s.NotSame(u1, ur.Add("update-1"))
+}
@@ -749,6 +749,7 @@ func (m *workflowTaskStateMachine) afterAddWorkflowTaskCompletedEvent(
 	maxResetPoints int,
 ) error {
 	m.ms.executionInfo.LastWorkflowTaskStartId = event.GetWorkflowTaskCompletedEventAttributes().GetStartedEventId()
+	m.ms.executionInfo.WorkerBuildId = event.GetWorkflowTaskCompletedEventAttributes().GetBinaryChecksum()
 	return m.ms.addBinaryCheckSumIfNotExists(event, maxResetPoints)
 }
 
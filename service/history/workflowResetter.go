@@ -36,6 +36,7 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
@@ -71,7 +72,7 @@ type (
 			currentWorkflow nDCWorkflow,
 			resetReason string,
 			additionalReapplyEvents []*historypb.HistoryEvent,
-			resetReapplyType enumspb.ResetReapplyType,
+			resetReapplyType enumsspb.ResetReapplyType,
 		) error
 	}
 
@@ -124,7 +125,7 @@ func (r *workflowResetterImpl) resetWorkflow(
 	currentWorkflow nDCWorkflow,
 	resetReason string,
 	additionalReapplyEvents []*historypb.HistoryEvent,
-	resetReapplyType enumspb.ResetReapplyType,
+	resetReapplyType enumsspb.ResetReapplyType,
 ) (retError error) {
 
 	namespaceEntry, err := r.namespaceRegistry.GetNamespaceByID(namespaceID)
@@ -167,6 +168,7 @@ func (r *workflowResetterImpl) resetWorkflow(
 				baseBranchToken,
 				baseRebuildLastEventID+1,
 				baseNextEventID,
+				resetReapplyType,
 			)
 			if err != nil {
 				return err
@@ -174,7 +176,7 @@ func (r *workflowResetterImpl) resetWorkflow(
 
 			if lastVisitedRunID == currentMutableState.GetExecutionState().RunId {
 				for _, event := range currentWorkflowEventsSeq {
-					if err := r.reapplyEvents(resetMutableState, event.Events); err != nil {
+					if err := r.reapplyEvents(resetMutableState, event.Events, resetReapplyType); err != nil {
 						return err
 					}
 				}
@@ -192,6 +194,7 @@ func (r *workflowResetterImpl) resetWorkflow(
 				baseBranchToken,
 				baseRebuildLastEventID+1,
 				baseNextEventID,
+				resetReapplyType,
 			)
 			return err
 		}
@@ -314,25 +317,26 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 func (r *workflowResetterImpl) reapplyEventsToResetWorkflow(
 	ctx context.Context,
 	resetMutableState workflow.MutableState,
-	resetReapplyType enumspb.ResetReapplyType,
+	resetReapplyType enumsspb.ResetReapplyType,
 	reapplyEventsApplier workflowResetReapplyEventsFn,
 	additionalReapplyEvents []*historypb.HistoryEvent,
 ) error {
 	switch resetReapplyType {
-	case enumspb.RESET_REAPPLY_TYPE_SIGNAL:
+	case enumsspb.RESET_REAPPLY_TYPE_SIGNAL, enumsspb.RESET_REAPPLY_TYPE_SIGNAL_AND_UPDATE:
 		if err := reapplyEventsApplier(
 			ctx,
 			resetMutableState,
 		); err != nil {
 			return err
 		}
-	case enumspb.RESET_REAPPLY_TYPE_NONE:
+	case enumsspb.RESET_REAPPLY_TYPE_NONE:
 		// noop
 	default:
 		panic(fmt.Sprintf("unknown reset type: %v", resetReapplyType))
 	}
 
-	if err := r.reapplyEvents(resetMutableState, additionalReapplyEvents); err != nil {
+	// additional events come from the conflicting branch and are always reapplied
+	if err := r.reapplyEvents(resetMutableState, additionalReapplyEvents, enumsspb.RESET_REAPPLY_TYPE_SIGNAL_AND_UPDATE); err != nil {
 		return err
 	}
 
@@ -589,6 +593,7 @@ func (r *workflowResetterImpl) reapplyContinueAsNewWorkflowEvents(
 	baseBranchToken []byte,
 	baseRebuildNextEventID int64,
 	baseNextEventID int64,
+	resetReapplyType enumsspb.ResetReapplyType,
 ) (string, error) {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
@@ -602,6 +607,7 @@ func (r *workflowResetterImpl) reapplyContinueAsNewWorkflowEvents(
 		baseRebuildNextEventID,
 		baseNextEventID,
 		baseBranchToken,
+		resetReapplyType,
 	)
 	switch err.(type) {
 	case nil:
@@ -656,6 +662,7 @@ func (r *workflowResetterImpl) reapplyContinueAsNewWorkflowEvents(
 			common.FirstEventID,
 			nextWorkflowNextEventID,
 			nextWorkflowBranchToken,
+			resetReapplyType,
 		)
 		switch err.(type) {
 		case nil:
@@ -676,6 +683,7 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 	firstEventID int64,
 	nextEventID int64,
 	branchToken []byte,
+	resetReapplyType enumsspb.ResetReapplyType,
 ) (string, error) {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
@@ -697,7 +705,7 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 			return "", err
 		}
 		lastEvents = batch.(*historypb.History).Events
-		if err := r.reapplyEvents(mutableState, lastEvents); err != nil {
+		if err := r.reapplyEvents(mutableState, lastEvents, resetReapplyType); err != nil {
 			return "", err
 		}
 	}
@@ -714,12 +722,18 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 func (r *workflowResetterImpl) reapplyEvents(
 	mutableState workflow.MutableState,
 	events []*historypb.HistoryEvent,
+	resetReapplyType enumsspb.ResetReapplyType,
 ) error {
 
 	for _, event := range events {
 		switch event.GetEventType() {
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
 			attr := event.GetWorkflowExecutionSignaledEventAttributes()
+			if resetReapplyType != enumsspb.RESET_REAPPLY_TYPE_SIGNAL_AND_UPDATE &&
+				workflow.IsUpdateSignalName(attr.GetSignalName()) {
+				// accepted update is recorded as signal, it is skipped unless updates are reapplied
+				continue
+			}
 			if _, err := mutableState.AddWorkflowExecutionSignaled(
 				attr.GetSignalName(),
 				attr.GetInput(),
@@ -33,8 +33,8 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	enums "go.temporal.io/api/enums/v1"
 	history "go.temporal.io/api/history/v1"
+	enums "go.temporal.io/server/api/enums/v1"
 	namespace "go.temporal.io/server/common/namespace"
 )
 
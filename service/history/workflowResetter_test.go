@@ -38,6 +38,7 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/collection"
@@ -597,6 +598,7 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents_WithOutCo
 		baseBranchToken,
 		baseFirstEventID,
 		baseNextEventID,
+		enumsspb.RESET_REAPPLY_TYPE_SIGNAL,
 	)
 	s.NoError(err)
 	s.Equal(s.baseRunID, lastVisitedRunID)
@@ -710,6 +712,7 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents_WithConti
 		baseBranchToken,
 		baseFirstEventID,
 		baseNextEventID,
+		enumsspb.RESET_REAPPLY_TYPE_SIGNAL,
 	)
 	s.NoError(err)
 	s.Equal(newRunID, lastVisitedRunID)
@@ -769,6 +772,7 @@ func (s *workflowResetterSuite) TestReapplyWorkflowEvents() {
 		firstEventID,
 		nextEventID,
 		branchToken,
+		enumsspb.RESET_REAPPLY_TYPE_SIGNAL,
 	)
 	s.NoError(err)
 	s.Equal(newRunID, nextRunID)
@@ -816,7 +820,41 @@ func (s *workflowResetterSuite) TestReapplyEvents() {
 		}
 	}
 
-	err := s.workflowResetter.reapplyEvents(mutableState, events)
+	err := s.workflowResetter.reapplyEvents(mutableState, events, enumsspb.RESET_REAPPLY_TYPE_SIGNAL)
+	s.NoError(err)
+}
+
+func (s *workflowResetterSuite) TestReapplyEvents_Update() {
+	signalEvent := &historypb.HistoryEvent{
+		EventId:   101,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "some random signal name",
+		}},
+	}
+	updateEvent := &historypb.HistoryEvent{
+		EventId:   102,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+			SignalName: workflow.UpdateSignalNamePrefix + "some random update name",
+			Input:      payloads.EncodeString("some random update input"),
+			Header:     workflow.NewUpdateHeader("some random update id", "some random update name"),
+		}},
+	}
+	events := []*historypb.HistoryEvent{signalEvent, updateEvent}
+
+	mutableState := workflow.NewMockMutableState(s.controller)
+	mutableState.EXPECT().AddWorkflowExecutionSignaled(
+		"some random signal name", gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(&historypb.HistoryEvent{}, nil).Times(2)
+	err := s.workflowResetter.reapplyEvents(mutableState, events, enumsspb.RESET_REAPPLY_TYPE_SIGNAL)
+	s.NoError(err)
+
+	attr := updateEvent.GetWorkflowExecutionSignaledEventAttributes()
+	mutableState.EXPECT().AddWorkflowExecutionSignaled(
+		attr.GetSignalName(), attr.GetInput(), attr.GetIdentity(), attr.GetHeader(),
+	).Return(&historypb.HistoryEvent{}, nil)
+	err = s.workflowResetter.reapplyEvents(mutableState, events, enumsspb.RESET_REAPPLY_TYPE_SIGNAL_AND_UPDATE)
 	s.NoError(err)
 }
 
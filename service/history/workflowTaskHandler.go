@@ -65,7 +65,8 @@ type (
 		newStateBuilder                 workflow.MutableState
 		stopProcessing                  bool // should stop processing any more commands
 		mutableState                    workflow.MutableState
-		initiatedChildExecutionsInBatch map[string]struct{} // Set of initiated child executions in the workflow task
+		initiatedChildExecutionsInBatch map[string]struct{}                // Set of initiated child executions in the workflow task
+		completedUpdates                map[string]*workflow.UpdateOutcome // Updates completed by the workflow task, keyed by update id
 
 		// validation
 		attrValidator          *commandAttrValidator
@@ -111,6 +112,7 @@ func newWorkflowTaskHandler(
 		stopProcessing:                  false,
 		mutableState:                    mutableState,
 		initiatedChildExecutionsInBatch: make(map[string]struct{}),
+		completedUpdates:                make(map[string]*workflow.UpdateOutcome),
 
 		// validation
 		attrValidator:          attrValidator,
@@ -618,8 +620,15 @@ func (handler *workflowTaskHandlerImpl) handleCommandRecordMarker(
 		return err
 	}
 
-	_, err = handler.mutableState.AddRecordMarkerEvent(handler.workflowTaskCompletedID, attr)
-	return err
+	if _, err = handler.mutableState.AddRecordMarkerEvent(handler.workflowTaskCompletedID, attr); err != nil {
+		return err
+	}
+
+	if attr.GetMarkerName() == workflow.UpdateCompletedMarkerName {
+		// Outcome is delivered to the caller after workflow task completion is persisted.
+		handler.completedUpdates[workflow.GetUpdateID(attr.GetHeader())] = workflow.NewUpdateOutcome(attr.GetDetails(), attr.GetFailure())
+	}
+	return nil
 }
 
 func (handler *workflowTaskHandlerImpl) handleCommandContinueAsNewWorkflow(
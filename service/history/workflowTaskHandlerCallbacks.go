@@ -206,7 +206,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 			if workflowTask.StartedID != common.EmptyEventID {
 				// If workflow task is started as part of the current request scope then return a positive response
 				if workflowTask.RequestID == requestID {
-					resp, err = handler.createRecordWorkflowTaskStartedResponse(mutableState, workflowTask, req.PollRequest.GetIdentity())
+					resp, err = handler.createRecordWorkflowTaskStartedResponse(mutableState, context.GetHistorySize(), workflowTask, req.PollRequest.GetIdentity())
 					if err != nil {
 						return nil, err
 					}
@@ -237,7 +237,14 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 				Tagged(metrics.TaskTypeTag("workflow")).
 				RecordTimer(metrics.TaskScheduleToStartLatency, workflowScheduleToStartLatency)
 
-			resp, err = handler.createRecordWorkflowTaskStartedResponse(mutableState, workflowTask, req.PollRequest.GetIdentity())
+			historySizeBytes := context.GetHistorySize()
+			if handler.shouldSuggestContinueAsNew(namespaceName, mutableState, historySizeBytes) {
+				if err := mutableState.SetSuggestContinueAsNew(); err != nil {
+					return nil, err
+				}
+			}
+
+			resp, err = handler.createRecordWorkflowTaskStartedResponse(mutableState, historySizeBytes, workflowTask, req.PollRequest.GetIdentity())
 			if err != nil {
 				return nil, err
 			}
@@ -412,6 +419,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 		wtFailedCause               *workflowTaskFailedCause
 		activityNotStartedCancelled bool
 		newStateBuilder             workflow.MutableState
+		completedUpdates            map[string]*workflow.UpdateOutcome
 
 		hasUnhandledEvents bool
 	)
@@ -480,6 +488,8 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 		newStateBuilder = workflowTaskHandler.newStateBuilder
 
 		hasUnhandledEvents = workflowTaskHandler.hasBufferedEvents
+
+		completedUpdates = workflowTaskHandler.completedUpdates
 	}
 
 	if wtFailedCause != nil {
@@ -495,6 +505,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 		}
 		hasUnhandledEvents = true
 		newStateBuilder = nil
+		completedUpdates = nil
 	}
 
 	createNewWorkflowTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewWorkflowTask() || activityNotStartedCancelled)
@@ -529,6 +540,11 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 			if err != nil {
 				return nil, err
 			}
+			if handler.shouldSuggestContinueAsNew(namespaceEntry.Name(), msBuilder, weContext.GetHistorySize()) {
+				if err := msBuilder.SetSuggestContinueAsNew(); err != nil {
+					return nil, err
+				}
+			}
 		}
 	}
 
@@ -589,6 +605,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 	}
 
 	handler.handleBufferedQueries(msBuilder, req.GetCompleteRequest().GetQueryResults(), createNewWorkflowTask, namespaceEntry, workflowTaskHeartbeating)
+	handler.handleCompletedUpdates(msBuilder, completedUpdates)
 
 	if workflowTaskHeartbeatTimeout {
 		// at this point, update is successful, but we still return an error to client so that the worker will give up this workflow
@@ -602,7 +619,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 	resp = &historyservice.RespondWorkflowTaskCompletedResponse{}
 	if request.GetReturnNewWorkflowTask() && createNewWorkflowTask {
 		workflowTask, _ := msBuilder.GetWorkflowTaskInfo(newWorkflowTaskScheduledID)
-		resp.StartedResponse, err = handler.createRecordWorkflowTaskStartedResponse(msBuilder, workflowTask, request.GetIdentity())
+		resp.StartedResponse, err = handler.createRecordWorkflowTaskStartedResponse(msBuilder, weContext.GetHistorySize(), workflowTask, request.GetIdentity())
 		if err != nil {
 			return nil, err
 		}
@@ -614,8 +631,22 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 
 }
 
+// shouldSuggestContinueAsNew returns true if workflow history crossed the soft limit of size or event count.
+func (handler *workflowTaskHandlerCallbacksImpl) shouldSuggestContinueAsNew(
+	namespaceName namespace.Name,
+	msBuilder workflow.MutableState,
+	historySizeBytes int64,
+) bool {
+	workflowType := msBuilder.GetWorkflowType().GetName()
+	historySizeLimit := handler.config.HistorySizeSuggestContinueAsNew(namespaceName.String(), workflowType)
+	historyCountLimit := handler.config.HistoryCountSuggestContinueAsNew(namespaceName.String(), workflowType)
+	historyCount := msBuilder.GetNextEventID() - 1
+	return historySizeBytes >= int64(historySizeLimit) || historyCount >= int64(historyCountLimit)
+}
+
 func (handler *workflowTaskHandlerCallbacksImpl) createRecordWorkflowTaskStartedResponse(
 	msBuilder workflow.MutableState,
+	historySizeBytes int64,
 	workflowTask *workflow.WorkflowTaskInfo,
 	identity string,
 ) (*historyservice.RecordWorkflowTaskStartedResponse, error) {
@@ -640,6 +671,8 @@ func (handler *workflowTaskHandlerCallbacksImpl) createRecordWorkflowTaskStarted
 	}
 	response.ScheduledTime = workflowTask.ScheduledTime
 	response.StartedTime = workflowTask.StartedTime
+	response.HistorySizeBytes = historySizeBytes
+	response.SuggestContinueAsNew = executionInfo.SuggestContinueAsNew
 
 	if workflowTask.Attempt > 1 {
 		// This workflowTask is retried from mutable state
@@ -669,6 +702,18 @@ func (handler *workflowTaskHandlerCallbacksImpl) createRecordWorkflowTaskStarted
 	return response, nil
 }
 
+func (handler *workflowTaskHandlerCallbacksImpl) handleCompletedUpdates(msBuilder workflow.MutableState, completedUpdates map[string]*workflow.UpdateOutcome) {
+	updateRegistry := msBuilder.GetUpdateRegistry()
+	for updateID, outcome := range completedUpdates {
+		updateRegistry.Complete(updateID, outcome)
+	}
+
+	// Updates which are still in-flight will never be completed if workflow is closed.
+	if !msBuilder.IsWorkflowExecutionRunning() {
+		updateRegistry.FailAll(consts.ErrWorkflowCompleted)
+	}
+}
+
 func (handler *workflowTaskHandlerCallbacksImpl) handleBufferedQueries(msBuilder workflow.MutableState, queryResults map[string]*querypb.WorkflowQueryResult, createNewWorkflowTask bool, namespaceEntry *namespace.Namespace, workflowTaskHeartbeating bool) {
 	queryRegistry := msBuilder.GetQueryRegistry()
 	if !queryRegistry.HasBufferedQuery() {
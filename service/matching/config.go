@@ -68,6 +68,13 @@ type (
 
 		AdminNamespaceToPartitionDispatchRate          dynamicconfig.FloatPropertyFnWithNamespaceFilter
 		AdminNamespaceTaskqueueToPartitionDispatchRate dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+
+		// versioning configuration
+		VersionBuildIDLimitPerQueue   dynamicconfig.IntPropertyFnWithNamespaceFilter
+		VersioningDataRefreshInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+
+		// task priority configuration
+		BacklogPriorityAgingInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 	}
 
 	forwarderConfig struct {
@@ -99,6 +106,13 @@ type (
 		AdminNamespaceToPartitionDispatchRate func() float64
 		// partition qps = AdminNamespaceTaskQueueToPartitionDispatchRate(namespace, task_queue)
 		AdminNamespaceTaskQueueToPartitionDispatchRate func() float64
+
+		// versioning configuration
+		VersionBuildIDLimit           func() int
+		VersioningDataRefreshInterval func() time.Duration
+
+		// task priority configuration
+		BacklogPriorityAgingInterval func() time.Duration
 	}
 )
 
@@ -130,6 +144,11 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 
 		AdminNamespaceToPartitionDispatchRate:          dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.AdminMatchingNamespaceToPartitionDispatchRate, 10000),
 		AdminNamespaceTaskqueueToPartitionDispatchRate: dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate, 1000),
+
+		VersionBuildIDLimitPerQueue:   dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MatchingVersionBuildIDLimitPerQueue, 100),
+		VersioningDataRefreshInterval: dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingVersioningDataRefreshInterval, time.Minute),
+
+		BacklogPriorityAgingInterval: dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingBacklogPriorityAgingInterval, time.Minute),
 	}
 }
 
@@ -184,6 +203,15 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Nam
 		AdminNamespaceTaskQueueToPartitionDispatchRate: func() float64 {
 			return config.AdminNamespaceTaskqueueToPartitionDispatchRate(namespace.String(), taskQueueName, taskType)
 		},
+		VersionBuildIDLimit: func() int {
+			return config.VersionBuildIDLimitPerQueue(namespace.String())
+		},
+		VersioningDataRefreshInterval: func() time.Duration {
+			return config.VersioningDataRefreshInterval(namespace.String(), taskQueueName, taskType)
+		},
+		BacklogPriorityAgingInterval: func() time.Duration {
+			return config.BacklogPriorityAgingInterval(namespace.String(), taskQueueName, taskType)
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(namespace.String(), taskQueueName, taskType)
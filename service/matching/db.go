@@ -54,8 +54,10 @@ type (
 		taskType      enumspb.TaskQueueType
 		rangeID       int64
 		ackLevel      int64
-		store         persistence.TaskManager
-		logger        log.Logger
+		// versioningData is only maintained for the root partition of a workflow task queue
+		versioningData *persistencespb.VersioningData
+		store          persistence.TaskManager
+		logger         log.Logger
 	}
 	taskQueueState struct {
 		rangeID  int64
@@ -129,6 +131,7 @@ func (db *taskQueueDB) takeOverTaskQueueLocked() error {
 			return err
 		}
 		db.ackLevel = response.TaskQueueInfo.AckLevel
+		db.versioningData = response.TaskQueueInfo.VersioningData
 		db.rangeID = response.RangeID + 1
 		return nil
 
@@ -168,6 +171,7 @@ func (db *taskQueueDB) renewTaskQueueLocked(
 			AckLevel:       db.ackLevel,
 			ExpiryTime:     db.expiryTime(),
 			LastUpdateTime: timestamp.TimeNowPtrUtc(),
+			VersioningData: db.versioningData,
 		},
 		PrevRangeID: db.rangeID,
 	}); err != nil {
@@ -192,6 +196,7 @@ func (db *taskQueueDB) UpdateState(ackLevel int64) error {
 			AckLevel:       ackLevel,
 			ExpiryTime:     db.expiryTime(),
 			LastUpdateTime: timestamp.TimeNowPtrUtc(),
+			VersioningData: db.versioningData,
 		},
 		PrevRangeID: db.rangeID,
 	})
@@ -201,6 +206,38 @@ func (db *taskQueueDB) UpdateState(ackLevel int64) error {
 	return err
 }
 
+// GetVersioningData returns the versioning data of the task queue as of the last lease renewal
+// or update. Callers must not mutate the returned value.
+func (db *taskQueueDB) GetVersioningData() *persistencespb.VersioningData {
+	db.Lock()
+	defer db.Unlock()
+	return db.versioningData
+}
+
+// UpdateVersioningData persists new versioning data for the task queue
+func (db *taskQueueDB) UpdateVersioningData(data *persistencespb.VersioningData) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskQueue(&persistence.UpdateTaskQueueRequest{
+		RangeID: db.rangeID,
+		TaskQueueInfo: &persistencespb.TaskQueueInfo{
+			NamespaceId:    db.namespaceID.String(),
+			Name:           db.taskQueueName,
+			TaskType:       db.taskType,
+			Kind:           db.taskQueueKind,
+			AckLevel:       db.ackLevel,
+			ExpiryTime:     db.expiryTime(),
+			LastUpdateTime: timestamp.TimeNowPtrUtc(),
+			VersioningData: data,
+		},
+		PrevRangeID: db.rangeID,
+	})
+	if err == nil {
+		db.versioningData = data
+	}
+	return err
+}
+
 // CreateTasks creates a batch of given tasks for this task queue
 func (db *taskQueueDB) CreateTasks(tasks []*persistencespb.AllocatedTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
@@ -209,11 +246,12 @@ func (db *taskQueueDB) CreateTasks(tasks []*persistencespb.AllocatedTaskInfo) (*
 		&persistence.CreateTasksRequest{
 			TaskQueueInfo: &persistence.PersistedTaskQueueInfo{
 				Data: &persistencespb.TaskQueueInfo{
-					NamespaceId: db.namespaceID.String(),
-					Name:        db.taskQueueName,
-					TaskType:    db.taskType,
-					AckLevel:    db.ackLevel,
-					Kind:        db.taskQueueKind,
+					NamespaceId:    db.namespaceID.String(),
+					Name:           db.taskQueueName,
+					TaskType:       db.taskType,
+					AckLevel:       db.ackLevel,
+					Kind:           db.taskQueueKind,
+					VersioningData: db.versioningData,
 				},
 				RangeID: db.rangeID,
 			},
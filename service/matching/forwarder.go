@@ -155,6 +155,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Source:                 task.source,
 			ScheduleToStartTimeout: &expirationDuration,
 			ForwardedSource:        fwdr.taskQueueID.name,
+			WorkerBuildId:          task.event.Data.GetWorkerBuildId(),
+			Priority:               task.event.Data.GetPriority(),
 		})
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
 		_, err = fwdr.client.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
@@ -169,6 +171,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Source:                 task.source,
 			ScheduleToStartTimeout: &expirationDuration,
 			ForwardedSource:        fwdr.taskQueueID.name,
+			Priority:               task.event.Data.GetPriority(),
 		})
 	default:
 		return errInvalidTaskQueueType
@@ -218,6 +221,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 
 	pollerID, _ := ctx.Value(pollerIDKey).(string)
 	identity, _ := ctx.Value(identityKey).(string)
+	workerBuildID, _ := ctx.Value(workerBuildIDKey).(string)
 
 	switch fwdr.taskQueueID.taskType {
 	case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
@@ -229,7 +233,8 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 					Name: name,
 					Kind: fwdr.taskQueueKind,
 				},
-				Identity: identity,
+				Identity:       identity,
+				BinaryChecksum: workerBuildID,
 			},
 			ForwardedSource: fwdr.taskQueueID.name,
 		})
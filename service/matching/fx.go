@@ -114,6 +114,7 @@ func HandlerProvider(
 	metricsClient metrics.Client,
 	namespaceRegistry namespace.Registry,
 	clusterMetadata cluster.Metadata,
+	dynamicConfigClient dynamicconfig.Client,
 ) *Handler {
 	return NewHandler(
 		config,
@@ -126,6 +127,7 @@ func HandlerProvider(
 		metricsClient,
 		namespaceRegistry,
 		clusterMetadata,
+		dynamicConfigClient,
 	)
 }
 
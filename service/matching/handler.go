@@ -37,6 +37,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
@@ -55,6 +56,7 @@ type (
 		throttledLogger         log.Logger
 		matchingServiceResolver membership.ServiceResolver
 		namespaceRegistry       namespace.Registry
+		dynamicConfigClient     dynamicconfig.Client
 	}
 )
 
@@ -78,6 +80,7 @@ func NewHandler(
 	metricsClient metrics.Client,
 	namespaceRegistry namespace.Registry,
 	clusterMetadata cluster.Metadata,
+	dynamicConfigClient dynamicconfig.Client,
 ) *Handler {
 	handler := &Handler{
 		config:          config,
@@ -95,7 +98,8 @@ func NewHandler(
 			matchingServiceResolver,
 			clusterMetadata,
 		),
-		namespaceRegistry: namespaceRegistry,
+		namespaceRegistry:   namespaceRegistry,
+		dynamicConfigClient: dynamicConfigClient,
 	}
 
 	// prevent from serving requests before matching engine is started and ready
@@ -351,6 +355,55 @@ func (h *Handler) ListTaskQueuePartitions(
 	return response, err
 }
 
+// UpdateWorkerBuildIdOrdering adds a worker build ID to the compatible-version graph of a task queue
+func (h *Handler) UpdateWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *matchingservice.UpdateWorkerBuildIdOrderingRequest,
+) (_ *matchingservice.UpdateWorkerBuildIdOrderingResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		namespace.ID(request.GetNamespaceId()),
+		&taskqueuepb.TaskQueue{Name: request.GetTaskQueue()},
+		metrics.MatchingUpdateWorkerBuildIdOrderingScope,
+	)
+
+	response, err := h.engine.UpdateWorkerBuildIdOrdering(hCtx, request)
+	return response, err
+}
+
+// GetWorkerBuildIdOrdering returns the compatible-version graph of a task queue
+func (h *Handler) GetWorkerBuildIdOrdering(
+	ctx context.Context,
+	request *matchingservice.GetWorkerBuildIdOrderingRequest,
+) (_ *matchingservice.GetWorkerBuildIdOrderingResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		namespace.ID(request.GetNamespaceId()),
+		&taskqueuepb.TaskQueue{Name: request.GetTaskQueue()},
+		metrics.MatchingGetWorkerBuildIdOrderingScope,
+	)
+
+	response, err := h.engine.GetWorkerBuildIdOrdering(hCtx, request)
+	return response, err
+}
+
+// RefreshDynamicConfig reloads dynamic config stored in persistence on this host
+func (h *Handler) RefreshDynamicConfig(
+	_ context.Context,
+	_ *matchingservice.RefreshDynamicConfigRequest,
+) (_ *matchingservice.RefreshDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	if refresher, ok := h.dynamicConfigClient.(dynamicconfig.Refresher); ok {
+		if err := refresher.Refresh(); err != nil {
+			return nil, err
+		}
+	}
+	return &matchingservice.RefreshDynamicConfigResponse{}, nil
+}
+
 func (h *Handler) namespaceName(id namespace.ID) namespace.Name {
 	entry, err := h.namespaceRegistry.GetNamespaceByID(id)
 	if err != nil {
@@ -27,6 +27,7 @@ package matching
 import (
 	"context"
 	"math"
+	"sync"
 	"time"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -43,6 +44,10 @@ type TaskMatcher struct {
 
 	// synchronous task channel to match producer/consumer
 	taskC chan *internalTask
+	// synchronous task channels per compatible version set, for workflow task queues
+	// with versioning data. Unversioned tasks and pollers use taskC
+	versionedTaskCLock sync.Mutex
+	versionedTaskC     map[string]chan *internalTask
 	// synchronous task channel to match query task - the reason to have
 	// separate channel for this is because there are cases when consumers
 	// are interested in queryTasks but not others. Example is when namespace is
@@ -91,6 +96,7 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, scope metrics.Scop
 		scope:            scope,
 		fwdr:             fwdr,
 		taskC:            make(chan *internalTask),
+		versionedTaskC:   make(map[string]chan *internalTask),
 		queryTaskC:       make(chan *internalTask),
 		numPartitions:    config.NumReadPartitions,
 	}
@@ -134,7 +140,7 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 	}
 
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case tm.taskChan(task.versionSet) <- task: // poller picked up the task
 		if task.responseC != nil {
 			// if there is a response channel, block until resp is received
 			// and return error if the response contains error
@@ -169,7 +175,7 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 
 func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *internalTask) (bool, error) {
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case tm.taskChan(task.versionSet) <- task: // poller picked up the task
 		if task.responseC != nil {
 			select {
 			case err := <-task.responseC:
@@ -229,10 +235,12 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask) error
 		return err
 	}
 
+	taskC := tm.taskChan(task.versionSet)
+
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
 	select {
-	case tm.taskC <- task:
+	case taskC <- task:
 		return nil
 	case <-ctx.Done():
 		return ctx.Err()
@@ -242,7 +250,7 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask) error
 forLoop:
 	for {
 		select {
-		case tm.taskC <- task:
+		case taskC <- task:
 			return nil
 		case token := <-tm.fwdrAddReqTokenC():
 			childCtx, cancel := context.WithTimeout(ctx, time.Second*2)
@@ -255,7 +263,7 @@ forLoop:
 				// the next forwarded call after this childCtx expires. Till then, we block
 				// hoping for a local poller match
 				select {
-				case tm.taskC <- task:
+				case taskC <- task:
 					cancel()
 					return nil
 				case <-childCtx.Done():
@@ -280,15 +288,16 @@ forLoop:
 
 // Poll blocks until a task is found or context deadline is exceeded
 // On success, the returned task could be a query task or a regular task
+// from the given compatible version set
 // Returns ErrNoTasks when context deadline is exceeded
-func (tm *TaskMatcher) Poll(ctx context.Context) (*internalTask, error) {
-	return tm.poll(ctx, false)
+func (tm *TaskMatcher) Poll(ctx context.Context, versionSet string) (*internalTask, error) {
+	return tm.poll(ctx, versionSet, false)
 }
 
 // PollForQuery blocks until a *query* task is found or context deadline is exceeded
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) PollForQuery(ctx context.Context) (*internalTask, error) {
-	return tm.poll(ctx, true)
+	return tm.poll(ctx, unversionedSet, true)
 }
 
 // UpdateRatelimit updates the task dispatch rate
@@ -319,8 +328,8 @@ func (tm *TaskMatcher) Rate() float64 {
 	return tm.rateLimiter.Rate()
 }
 
-func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask, error) {
-	taskC, queryTaskC := tm.taskC, tm.queryTaskC
+func (tm *TaskMatcher) poll(ctx context.Context, versionSet string, queryOnly bool) (*internalTask, error) {
+	taskC, queryTaskC := tm.taskChan(versionSet), tm.queryTaskC
 	if queryOnly {
 		taskC = nil
 	}
@@ -400,6 +409,21 @@ func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask,
 	}
 }
 
+// taskChan returns the task channel matching tasks and pollers of the given compatible version set
+func (tm *TaskMatcher) taskChan(versionSet string) chan *internalTask {
+	if versionSet == unversionedSet {
+		return tm.taskC
+	}
+	tm.versionedTaskCLock.Lock()
+	defer tm.versionedTaskCLock.Unlock()
+	taskC, ok := tm.versionedTaskC[versionSet]
+	if !ok {
+		taskC = make(chan *internalTask)
+		tm.versionedTaskC[versionSet] = taskC
+	}
+	return taskC
+}
+
 func (tm *TaskMatcher) fwdrPollReqTokenC() <-chan *ForwarderReqToken {
 	if tm.fwdr == nil {
 		return nil
@@ -103,7 +103,7 @@ func (t *MatcherTestSuite) TestLocalSyncMatch() {
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		close(pollStarted)
-		task, err := t.matcher.Poll(ctx)
+		task, err := t.matcher.Poll(ctx, unversionedSet)
 		cancel()
 		if err == nil {
 			task.finish(nil)
@@ -139,7 +139,7 @@ func (t *MatcherTestSuite) testRemoteSyncMatch(taskSource enumsspb.TaskSource) {
 			// so lets delay polling by a bit to verify that
 			time.Sleep(time.Millisecond * 10)
 		}
-		task, err := t.matcher.Poll(ctx)
+		task, err := t.matcher.Poll(ctx, unversionedSet)
 		cancel()
 		if err == nil && !task.isStarted() {
 			task.finish(nil)
@@ -150,7 +150,7 @@ func (t *MatcherTestSuite) testRemoteSyncMatch(taskSource enumsspb.TaskSource) {
 	var remotePollResp matchingservice.PollWorkflowTaskQueueResponse
 	t.client.EXPECT().PollWorkflowTaskQueue(gomock.Any(), gomock.Any(), gomock.Any()).Do(
 		func(arg0 context.Context, arg1 *matchingservice.PollWorkflowTaskQueueRequest, arg2 ...interface{}) {
-			task, err := t.rootMatcher.Poll(arg0)
+			task, err := t.rootMatcher.Poll(arg0, unversionedSet)
 			if err != nil {
 				remotePollErr = err
 			} else {
@@ -346,7 +346,7 @@ func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		close(pollStarted)
-		task, err := t.matcher.Poll(ctx)
+		task, err := t.matcher.Poll(ctx, unversionedSet)
 		cancel()
 		if err == nil {
 			task.finish(nil)
@@ -374,7 +374,7 @@ func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 			wg.Done()
 			<-pollSigC
 			time.Sleep(time.Millisecond * 500) // delay poll to verify that offer blocks on parent
-			task, err := t.rootMatcher.Poll(arg0)
+			task, err := t.rootMatcher.Poll(arg0, unversionedSet)
 			if err != nil {
 				remotePollErr = err
 			} else {
@@ -388,7 +388,7 @@ func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 4*time.Second)
-		t.matcher.Poll(ctx)
+		t.matcher.Poll(ctx, unversionedSet)
 		cancel()
 	}()
 
@@ -445,7 +445,7 @@ func (t *MatcherTestSuite) TestRemotePoll() {
 	}()
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	task, err := t.matcher.Poll(ctx)
+	task, err := t.matcher.Poll(ctx, unversionedSet)
 	cancel()
 	t.NoError(err)
 	t.NotNil(req)
@@ -503,3 +503,41 @@ func randomTaskInfo() *persistencespb.AllocatedTaskInfo {
 		TaskId: rand.Int63(),
 	}
 }
+
+func (t *MatcherTestSuite) TestVersionedSyncMatch() {
+	pollStarted := make(chan struct{})
+	polled := make(chan *internalTask, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		close(pollStarted)
+		task, err := t.rootMatcher.Poll(ctx, "2.0")
+		if err == nil {
+			task.finish(nil)
+			polled <- task
+		}
+	}()
+
+	<-pollStarted
+	time.Sleep(10 * time.Millisecond)
+
+	// tasks of other version sets are not matched with the poller
+	for _, versionSet := range []string{unversionedSet, "1.0"} {
+		task := newInternalTask(randomTaskInfo(), nil, enumsspb.TASK_SOURCE_HISTORY, "", true)
+		task.versionSet = versionSet
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		syncMatch, err := t.rootMatcher.Offer(ctx, task)
+		cancel()
+		t.NoError(err)
+		t.False(syncMatch)
+	}
+
+	task := newInternalTask(randomTaskInfo(), nil, enumsspb.TASK_SOURCE_HISTORY, "", true)
+	task.versionSet = "2.0"
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := t.rootMatcher.Offer(ctx, task)
+	cancel()
+	t.NoError(err)
+	t.True(syncMatch)
+	t.Equal(task, <-polled)
+}
@@ -61,8 +61,9 @@ import (
 // TODO: Switch implementation from lock/channel based to a partitioned agent
 // to simplify code and reduce possibility of synchronization errors.
 type (
-	pollerIDCtxKey string
-	identityCtxKey string
+	pollerIDCtxKey      string
+	identityCtxKey      string
+	workerBuildIDCtxKey string
 
 	// lockableQueryTaskMap maps query TaskID (which is a UUID generated in QueryWorkflow() call) to a channel
 	// that QueryWorkflow() will block on. The channel is unblocked either by worker sending response through
@@ -110,8 +111,9 @@ var (
 	ErrNoTasks    = errors.New("No tasks")
 	errPumpClosed = errors.New("Task queue pump closed its channel")
 
-	pollerIDKey pollerIDCtxKey = "pollerID"
-	identityKey identityCtxKey = "identity"
+	pollerIDKey      pollerIDCtxKey      = "pollerID"
+	identityKey      identityCtxKey      = "identity"
+	workerBuildIDKey workerBuildIDCtxKey = "workerBuildID"
 )
 
 var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed implemented
@@ -269,12 +271,14 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		expirationTime = timestamp.TimePtr(now.Add(expirationDuration))
 	}
 	taskInfo := &persistencespb.TaskInfo{
-		NamespaceId: namespaceID.String(),
-		RunId:       addRequest.Execution.GetRunId(),
-		WorkflowId:  addRequest.Execution.GetWorkflowId(),
-		ScheduleId:  addRequest.GetScheduleId(),
-		ExpiryTime:  expirationTime,
-		CreateTime:  now,
+		NamespaceId:   namespaceID.String(),
+		RunId:         addRequest.Execution.GetRunId(),
+		WorkflowId:    addRequest.Execution.GetWorkflowId(),
+		ScheduleId:    addRequest.GetScheduleId(),
+		ExpiryTime:    expirationTime,
+		CreateTime:    now,
+		WorkerBuildId: addRequest.GetWorkerBuildId(),
+		Priority:      addRequest.GetPriority(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -327,6 +331,7 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleId:  addRequest.GetScheduleId(),
 		CreateTime:  now,
 		ExpiryTime:  expirationTime,
+		Priority:    addRequest.GetPriority(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -357,6 +362,7 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(hCtx.Context, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, workerBuildIDKey, request.GetBinaryChecksum())
 		taskQueue, err := newTaskQueueID(namespaceID, taskQueueName, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
 		if err != nil {
 			return nil, err
@@ -615,6 +621,52 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 	return tlMgr.DescribeTaskQueue(request.DescRequest.GetIncludeTaskQueueStatus()), nil
 }
 
+func (e *matchingEngineImpl) UpdateWorkerBuildIdOrdering(
+	hCtx *handlerContext,
+	request *matchingservice.UpdateWorkerBuildIdOrderingRequest,
+) (*matchingservice.UpdateWorkerBuildIdOrderingResponse, error) {
+	taskQueue, err := newTaskQueueID(namespace.ID(request.GetNamespaceId()), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	if !taskQueue.IsRoot() {
+		return nil, serviceerror.NewInvalidArgument("Versioning data can only be updated on the root partition of a task queue.")
+	}
+	tlMgr, err := e.getTaskQueueManager(taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL)
+	if err != nil {
+		return nil, err
+	}
+	data, err := tlMgr.UpdateVersioningData(
+		hCtx.Context,
+		request.GetBuildId(),
+		request.GetCompatibleBuildId(),
+		request.GetBecomeDefault(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateWorkerBuildIdOrderingResponse{VersioningData: data}, nil
+}
+
+func (e *matchingEngineImpl) GetWorkerBuildIdOrdering(
+	hCtx *handlerContext,
+	request *matchingservice.GetWorkerBuildIdOrderingRequest,
+) (*matchingservice.GetWorkerBuildIdOrderingResponse, error) {
+	taskQueue, err := newTaskQueueID(namespace.ID(request.GetNamespaceId()), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	tlMgr, err := e.getTaskQueueManager(taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL)
+	if err != nil {
+		return nil, err
+	}
+	data, err := tlMgr.GetVersioningData(hCtx.Context)
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.GetWorkerBuildIdOrderingResponse{VersioningData: data}, nil
+}
+
 func (e *matchingEngineImpl) ListTaskQueuePartitions(
 	hCtx *handlerContext,
 	request *matchingservice.ListTaskQueuePartitionsRequest,
@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -63,6 +64,7 @@ import (
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/priorities"
 	"go.temporal.io/server/common/quotas"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
@@ -301,6 +303,123 @@ func (s *matchingEngineSuite) TestOnlyUnloadMatchingInstance() {
 		"Unload call with matching incarnation should have caused unload")
 }
 
+func (s *matchingEngineSuite) TestWorkerBuildIdOrdering() {
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+
+	for _, buildID := range []string{"1.0", "2.0"} {
+		_, err := s.matchingEngine.UpdateWorkerBuildIdOrdering(s.handlerContext, &matchingservice.UpdateWorkerBuildIdOrderingRequest{
+			NamespaceId:   namespaceID.String(),
+			TaskQueue:     tl,
+			BuildId:       buildID,
+			BecomeDefault: true,
+		})
+		s.NoError(err)
+	}
+	_, err := s.matchingEngine.UpdateWorkerBuildIdOrdering(s.handlerContext, &matchingservice.UpdateWorkerBuildIdOrderingRequest{
+		NamespaceId:       namespaceID.String(),
+		TaskQueue:         tl,
+		BuildId:           "1.1",
+		CompatibleBuildId: "1.0",
+	})
+	s.NoError(err)
+
+	_, err = s.matchingEngine.UpdateWorkerBuildIdOrdering(s.handlerContext, &matchingservice.UpdateWorkerBuildIdOrderingRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue:   "/_sys/makeToast/1",
+		BuildId:     "3.0",
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	expected := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{
+			{BuildIds: []string{"1.0", "1.1"}},
+			{BuildIds: []string{"2.0"}},
+		},
+	}
+	resp, err := s.matchingEngine.GetWorkerBuildIdOrdering(s.handlerContext, &matchingservice.GetWorkerBuildIdOrderingRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue:   tl,
+	})
+	s.NoError(err)
+	s.Equal(expected, resp.GetVersioningData())
+
+	// versioning data survives a reload of the task queue
+	tqm, err := s.matchingEngine.getTaskQueueManager(
+		newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_WORKFLOW),
+		enumspb.TASK_QUEUE_KIND_NORMAL,
+	)
+	s.NoError(err)
+	s.matchingEngine.unloadTaskQueue(tqm)
+	resp, err = s.matchingEngine.GetWorkerBuildIdOrdering(s.handlerContext, &matchingservice.GetWorkerBuildIdOrderingRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue:   tl,
+	})
+	s.NoError(err)
+	s.Equal(expected, resp.GetVersioningData())
+}
+
+func (s *matchingEngineSuite) TestPollWorkflowTaskQueuesVersioned() {
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+	taskQueue := &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(100 * time.Millisecond)
+
+	for _, buildID := range []string{"1.0", "2.0"} {
+		_, err := s.matchingEngine.UpdateWorkerBuildIdOrdering(s.handlerContext, &matchingservice.UpdateWorkerBuildIdOrderingRequest{
+			NamespaceId:   namespaceID.String(),
+			TaskQueue:     tl,
+			BuildId:       buildID,
+			BecomeDefault: true,
+		})
+		s.NoError(err)
+	}
+
+	execution := &commonpb.WorkflowExecution{RunId: uuid.New(), WorkflowId: "workflow1"}
+	s.mockHistoryClient.EXPECT().RecordWorkflowTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&historyservice.RecordWorkflowTaskStartedResponse{
+			WorkflowType:               &commonpb.WorkflowType{Name: "workflow"},
+			ScheduledEventId:           1,
+			Attempt:                    1,
+			WorkflowExecutionTaskQueue: taskQueue,
+		}, nil).Times(1)
+
+	// the workflow was last processed by a worker of the old, incompatible build
+	_, err := s.matchingEngine.AddWorkflowTask(s.handlerContext, &matchingservice.AddWorkflowTaskRequest{
+		NamespaceId:            namespaceID.String(),
+		Execution:              execution,
+		ScheduleId:             1,
+		TaskQueue:              taskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		WorkerBuildId:          "1.0",
+	})
+	s.NoError(err)
+
+	for _, buildID := range []string{"", "2.0"} {
+		resp, err := s.matchingEngine.PollWorkflowTaskQueue(s.handlerContext, &matchingservice.PollWorkflowTaskQueueRequest{
+			NamespaceId: namespaceID.String(),
+			PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+				TaskQueue:      taskQueue,
+				Identity:       "worker-" + buildID,
+				BinaryChecksum: buildID,
+			},
+		})
+		s.NoError(err)
+		s.Equal(emptyPollWorkflowTaskQueueResponse, resp)
+	}
+
+	resp, err := s.matchingEngine.PollWorkflowTaskQueue(s.handlerContext, &matchingservice.PollWorkflowTaskQueueRequest{
+		NamespaceId: namespaceID.String(),
+		PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+			TaskQueue:      taskQueue,
+			Identity:       "worker-1.0",
+			BinaryChecksum: "1.0",
+		},
+	})
+	s.NoError(err)
+	s.Equal(execution, resp.GetWorkflowExecution())
+}
+
 func (s *matchingEngineSuite) TestPollWorkflowTaskQueues() {
 	namespaceID := namespace.ID(uuid.New())
 	tl := "makeToast"
@@ -1568,6 +1687,69 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
 }
 
+func (s *matchingEngineSuite) TestAddTaskWithPriority() {
+	runID := uuid.NewRandom().String()
+	workflowID := "workflow1"
+	workflowExecution := &commonpb.WorkflowExecution{RunId: runID, WorkflowId: workflowID}
+
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+	tlID := newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	priorityTlID := newTestTaskQueueID(namespaceID, tlID.priorityBacklogName(priorities.Highest), enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	tlKind := enumspb.TASK_QUEUE_KIND_NORMAL
+
+	taskQueue := &taskqueuepb.TaskQueue{
+		Name: tl,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+
+	const taskCount = 5
+	const priorityScheduleID = int64(100)
+	addTask := func(scheduleID int64, priority int32) {
+		addRequest := matchingservice.AddActivityTaskRequest{
+			SourceNamespaceId:      namespaceID.String(),
+			NamespaceId:            namespaceID.String(),
+			Execution:              workflowExecution,
+			ScheduleId:             scheduleID,
+			TaskQueue:              taskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+			Priority:               priority,
+		}
+		_, err := s.matchingEngine.AddActivityTask(s.handlerContext, &addRequest)
+		s.NoError(err)
+	}
+
+	for i := int64(0); i < taskCount; i++ {
+		addTask(i, 0)
+	}
+	addTask(priorityScheduleID, priorities.Highest)
+
+	// the high priority task is persisted in the backlog of its priority level
+	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
+	s.EqualValues(1, s.taskManager.getTaskCount(priorityTlID))
+
+	// wait until the high priority task is read into the buffer, next to the default priority tasks
+	// not yet handed to the matcher
+	tlMgr, ok := s.matchingEngine.taskQueues[*tlID].(*taskQueueManagerImpl)
+	s.True(ok, "taskQueueManger doesn't implement taskQueueManager interface")
+	priorityBacklog := tlMgr.backlogFor(priorities.Highest)
+	s.True(s.awaitCondition(func() bool {
+		return priorityBacklog.taskAckManager.getReadLevel() > 0 && tlMgr.taskBuffer.len() == taskCount
+	}, time.Second))
+
+	// the high priority task goes right after the default priority task already handed to the matcher
+	var scheduleIDs []int64
+	for i := 0; i <= taskCount; i++ {
+		ctx, err := s.matchingEngine.getTask(context.Background(), tlID, nil, tlKind)
+		s.NoError(err)
+		scheduleIDs = append(scheduleIDs, ctx.event.Data.GetScheduleId())
+		ctx.finish(nil)
+	}
+	s.Equal(priorityScheduleID, scheduleIDs[1])
+	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+	s.EqualValues(0, s.taskManager.getTaskCount(priorityTlID))
+}
+
 func (s *matchingEngineSuite) TestTaskQueueManagerGetTaskBatch() {
 	runID := uuid.NewRandom().String()
 	workflowID := "workflow1"
@@ -1608,8 +1790,8 @@ func (s *matchingEngineSuite) TestTaskQueueManagerGetTaskBatch() {
 
 	// wait until all tasks are read by the task pump and enqeued into the in-memory buffer
 	// at the end of this step, ackManager readLevel will also be equal to the buffer size
-	expectedBufSize := common.MinInt(cap(tlMgr.taskReader.taskBuffer), taskCount)
-	s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) == expectedBufSize }, time.Second))
+	expectedBufSize := common.MinInt(tlMgr.taskReader.taskBuffer.capacity, taskCount)
+	s.True(s.awaitCondition(func() bool { return tlMgr.taskReader.taskBuffer.len() == expectedBufSize }, time.Second))
 
 	// stop all goroutines that read / write tasks in the background
 	// remainder of this test works with the in-memory buffer
@@ -1775,7 +1957,7 @@ func (s *matchingEngineSuite) TestTaskExpiryAndCompletion() {
 
 		// wait until all tasks are loaded by into in-memory buffers by task queue manager
 		// the buffer size should be one less than expected because dispatcher will dequeue the head
-		s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) >= (taskCount/2 - 1) }, time.Second))
+		s.True(s.awaitCondition(func() bool { return tlMgr.taskReader.taskBuffer.len() >= (taskCount/2 - 1) }, time.Second))
 
 		maxTimeBetweenTaskDeletes = tc.maxTimeBtwnDeletes
 
@@ -1909,6 +2091,7 @@ type testTaskQueueManager struct {
 	sync.Mutex
 	rangeID         int64
 	ackLevel        int64
+	versioningData  *persistencespb.VersioningData
 	createTaskCount int
 	getTasksCount   int
 	tasks           *treemap.Map
@@ -1938,6 +2121,14 @@ func newTestTaskQueueManager() *testTaskQueueManager {
 }
 
 func newTestTaskQueueID(namespaceID namespace.ID, name string, taskType enumspb.TaskQueueType) *taskQueueID {
+	if strings.Contains(name, "/priority-") {
+		// persistence task queue of a priority level backlog, it is not a task queue partition
+		return &taskQueueID{
+			qualifiedTaskQueueName: qualifiedTaskQueueName{name: name, baseName: name},
+			namespaceID:            namespaceID,
+			taskType:               taskType,
+		}
+	}
 	result, err := newTaskQueueID(namespaceID, name, taskType)
 	if err != nil {
 		panic(fmt.Sprintf("newTaskQueueID failed with error %v", err))
@@ -1975,6 +2166,7 @@ func (m *testTaskManager) UpdateTaskQueue(request *persistence.UpdateTaskQueueRe
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.versioningData = tli.VersioningData
 	tlm.rangeID = request.RangeID
 	return &persistence.UpdateTaskQueueResponse{}, nil
 }
@@ -1996,6 +2188,7 @@ func (m *testTaskManager) GetTaskQueue(request *persistence.GetTaskQueueRequest)
 			AckLevel:       tlm.ackLevel,
 			ExpiryTime:     nil,
 			LastUpdateTime: timestamp.TimeNowPtrUtc(),
+			VersioningData: tlm.versioningData,
 		},
 		RangeID: tlm.rangeID,
 	}, nil
@@ -0,0 +1,193 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/priorities"
+)
+
+type (
+	// priorityTaskBuffer holds the backlog tasks loaded from persistence until they are dispatched.
+	// Every priority level has its own backlog and room for capacity tasks in the buffer, so a
+	// backlog of low priority tasks does not keep higher priority tasks from being loaded. Tasks
+	// are dispatched by priority with aging: for each priority level below the highest, a task is
+	// ordered as if it was created one aging interval later. So low priority tasks are not starved
+	// by a steady stream of higher priority tasks either.
+	priorityTaskBuffer struct {
+		capacity      int
+		agingInterval func() time.Duration
+
+		sync.Mutex
+		tasks     priorityTaskHeap
+		counts    map[int32]int
+		notEmptyC chan struct{}
+		notFullC  map[int32]chan struct{}
+	}
+
+	priorityTaskHeap []*priorityTask
+
+	priorityTask struct {
+		info    *persistencespb.AllocatedTaskInfo
+		backlog *taskQueueBacklog
+		// create time of the task adjusted by its priority
+		dispatchTime time.Time
+	}
+)
+
+var _ heap.Interface = (*priorityTaskHeap)(nil)
+
+func newPriorityTaskBuffer(
+	capacity int,
+	agingInterval func() time.Duration,
+) *priorityTaskBuffer {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &priorityTaskBuffer{
+		capacity:      capacity,
+		agingInterval: agingInterval,
+		counts:        make(map[int32]int),
+		notEmptyC:     make(chan struct{}, 1),
+		notFullC:      make(map[int32]chan struct{}),
+	}
+}
+
+// put adds a task of the backlog to the buffer, blocking while the buffer is full for its priority
+func (b *priorityTaskBuffer) put(
+	ctx context.Context,
+	backlog *taskQueueBacklog,
+	info *persistencespb.AllocatedTaskInfo,
+) error {
+	task := &priorityTask{
+		info:    info,
+		backlog: backlog,
+		dispatchTime: timestamp.TimeValue(info.Data.GetCreateTime()).Add(
+			time.Duration(backlog.priority-priorities.Highest) * b.agingInterval(),
+		),
+	}
+
+	for {
+		b.Lock()
+		if b.counts[backlog.priority] < b.capacity {
+			heap.Push(&b.tasks, task)
+			b.counts[backlog.priority]++
+			b.Unlock()
+			select {
+			case b.notEmptyC <- struct{}{}:
+			default:
+			}
+			return nil
+		}
+		notFullC := b.notFullLocked(backlog.priority)
+		b.Unlock()
+
+		select {
+		case <-notFullC:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// take removes and returns the next task to dispatch and its backlog, blocking while the buffer is empty
+func (b *priorityTaskBuffer) take(
+	ctx context.Context,
+) (*persistencespb.AllocatedTaskInfo, *taskQueueBacklog, error) {
+	for {
+		b.Lock()
+		if len(b.tasks) > 0 {
+			task := heap.Pop(&b.tasks).(*priorityTask)
+			b.counts[task.backlog.priority]--
+			notFullC := b.notFullLocked(task.backlog.priority)
+			b.Unlock()
+			select {
+			case notFullC <- struct{}{}:
+			default:
+			}
+			return task.info, task.backlog, nil
+		}
+		b.Unlock()
+
+		select {
+		case <-b.notEmptyC:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+}
+
+func (b *priorityTaskBuffer) len() int {
+	b.Lock()
+	defer b.Unlock()
+
+	return len(b.tasks)
+}
+
+func (b *priorityTaskBuffer) notFullLocked(priority int32) chan struct{} {
+	notFullC, ok := b.notFullC[priority]
+	if !ok {
+		notFullC = make(chan struct{}, 1)
+		b.notFullC[priority] = notFullC
+	}
+	return notFullC
+}
+
+func (h priorityTaskHeap) Len() int {
+	return len(h)
+}
+
+func (h priorityTaskHeap) Less(i, j int) bool {
+	if !h[i].dispatchTime.Equal(h[j].dispatchTime) {
+		return h[i].dispatchTime.Before(h[j].dispatchTime)
+	}
+	if h[i].backlog.priority != h[j].backlog.priority {
+		return h[i].backlog.priority < h[j].backlog.priority
+	}
+	return h[i].info.GetTaskId() < h[j].info.GetTaskId()
+}
+
+func (h priorityTaskHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *priorityTaskHeap) Push(x interface{}) {
+	*h = append(*h, x.(*priorityTask))
+}
+
+func (h *priorityTaskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	task := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return task
+}
@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/priorities"
+)
+
+func TestPriorityTaskBuffer_DispatchByPriority(t *testing.T) {
+	buffer := newPriorityTaskBuffer(10, func() time.Duration { return time.Minute })
+	now := time.Now().UTC()
+	lowest := newPriorityTestBacklog(priorities.Lowest)
+
+	// a backlog of low priority tasks followed by a high priority and a default priority task
+	for taskID := int64(1); taskID <= 3; taskID++ {
+		require.NoError(t, buffer.put(context.Background(), lowest, newPriorityTestTask(taskID, now)))
+	}
+	require.NoError(t, buffer.put(context.Background(), newPriorityTestBacklog(priorities.Highest), newPriorityTestTask(1, now.Add(time.Second))))
+	require.NoError(t, buffer.put(context.Background(), newPriorityTestBacklog(priorities.Default), newPriorityTestTask(1, now.Add(time.Second))))
+
+	var dispatched []int32
+	for buffer.len() > 0 {
+		_, backlog, err := buffer.take(context.Background())
+		require.NoError(t, err)
+		dispatched = append(dispatched, backlog.priority)
+	}
+	require.Equal(t, []int32{priorities.Highest, priorities.Default, priorities.Lowest, priorities.Lowest, priorities.Lowest}, dispatched)
+}
+
+func TestPriorityTaskBuffer_Aging(t *testing.T) {
+	buffer := newPriorityTaskBuffer(10, func() time.Duration { return time.Minute })
+	now := time.Now().UTC()
+
+	// low priority task waited longer than 4 aging intervals, so it goes before a new highest priority task
+	require.NoError(t, buffer.put(context.Background(), newPriorityTestBacklog(priorities.Lowest), newPriorityTestTask(1, now.Add(-5*time.Minute))))
+	require.NoError(t, buffer.put(context.Background(), newPriorityTestBacklog(priorities.Highest), newPriorityTestTask(1, now)))
+
+	_, backlog, err := buffer.take(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, priorities.Lowest, backlog.priority)
+}
+
+func TestPriorityTaskBuffer_Blocking(t *testing.T) {
+	buffer := newPriorityTaskBuffer(1, func() time.Duration { return time.Minute })
+	now := time.Now().UTC()
+	backlog := newPriorityTestBacklog(priorities.Default)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err := buffer.take(ctx)
+	require.Equal(t, context.DeadlineExceeded, err)
+
+	require.NoError(t, buffer.put(context.Background(), backlog, newPriorityTestTask(1, now)))
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, buffer.put(ctx, backlog, newPriorityTestTask(2, now)))
+
+	putErrC := make(chan error, 1)
+	go func() {
+		putErrC <- buffer.put(context.Background(), backlog, newPriorityTestTask(3, now))
+	}()
+	task, _, err := buffer.take(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), task.GetTaskId())
+	require.NoError(t, <-putErrC)
+	require.Equal(t, 1, buffer.len())
+}
+
+func TestPriorityTaskBuffer_CapacityPerPriority(t *testing.T) {
+	buffer := newPriorityTaskBuffer(1, func() time.Duration { return time.Minute })
+	now := time.Now().UTC()
+	lowest := newPriorityTestBacklog(priorities.Lowest)
+	highest := newPriorityTestBacklog(priorities.Highest)
+
+	// a full buffer of low priority tasks does not block high priority tasks
+	require.NoError(t, buffer.put(context.Background(), lowest, newPriorityTestTask(1, now)))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, buffer.put(ctx, lowest, newPriorityTestTask(2, now)))
+	require.NoError(t, buffer.put(context.Background(), highest, newPriorityTestTask(1, now)))
+	require.Equal(t, 2, buffer.len())
+
+	_, backlog, err := buffer.take(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, highest, backlog)
+}
+
+func newPriorityTestBacklog(priority int32) *taskQueueBacklog {
+	return &taskQueueBacklog{priority: priority}
+}
+
+func newPriorityTestTask(
+	taskID int64,
+	createTime time.Time,
+) *persistencespb.AllocatedTaskInfo {
+	return &persistencespb.AllocatedTaskInfo{
+		TaskId: taskID,
+		Data: &persistencespb.TaskInfo{
+			CreateTime: timestamp.TimePtr(createTime),
+		},
+	}
+}
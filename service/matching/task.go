@@ -62,6 +62,7 @@ type (
 		forwardedFrom    string     // name of the child partition this task is forwarded from (empty if not forwarded)
 		responseC        chan error // non-nil only where there is a caller waiting for response (sync-match)
 		backlogCountHint int64
+		versionSet       string // compatible version set this task can be dispatched to (empty if unversioned)
 	}
 )
 
@@ -0,0 +1,216 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/priorities"
+)
+
+type (
+	// taskQueueBacklog is the persisted backlog of the tasks of one priority level of a task queue.
+	// Tasks with the default priority are persisted in the task queue itself. Tasks with other
+	// priorities are persisted in a separate persistence task queue per level, so every level has
+	// its own task ID range, read level and ack level, and a backlog of low priority tasks does not
+	// have to be read before the higher priority tasks written after it.
+	taskQueueBacklog struct {
+		priority       int32
+		db             *taskQueueDB
+		taskWriter     *taskWriter
+		taskReader     *taskReader // reads tasks from db into the task buffer of the task queue
+		taskAckManager ackManager  // tracks ackLevel for delivered messages
+		taskGC         *taskGC
+		tlMgr          *taskQueueManagerImpl
+	}
+)
+
+func newTaskQueueBacklog(
+	tlMgr *taskQueueManagerImpl,
+	priority int32,
+	db *taskQueueDB,
+) *taskQueueBacklog {
+	backlog := &taskQueueBacklog{
+		priority:       priority,
+		db:             db,
+		taskAckManager: newAckManager(tlMgr.logger),
+		taskGC:         newTaskGC(db, tlMgr.config),
+		tlMgr:          tlMgr,
+	}
+	backlog.taskWriter = newTaskWriter(tlMgr, backlog)
+	backlog.taskReader = newTaskReader(tlMgr, backlog)
+	return backlog
+}
+
+func (b *taskQueueBacklog) isDefault() bool {
+	return b == b.tlMgr.taskQueueBacklog
+}
+
+func (b *taskQueueBacklog) start() {
+	b.taskWriter.Start()
+	b.taskReader.Start()
+}
+
+func (b *taskQueueBacklog) stop() {
+	_ = b.db.UpdateState(b.taskAckManager.getAckLevel())
+	b.taskGC.RunNow(b.taskAckManager.getAckLevel())
+	b.taskWriter.Stop()
+	b.taskReader.Stop()
+}
+
+// completeTask marks a task as processed. Only tasks created by taskReader (i.e. backlog from db) reach
+// here. As part of completion:
+//   - task is deleted from the database when err is nil
+//   - new task is created and current task is deleted when err is not nil
+func (b *taskQueueBacklog) completeTask(task *persistencespb.AllocatedTaskInfo, err error) {
+	if err != nil {
+		// failed to start the task.
+		// We cannot just remove it from persistence because then it will be lost.
+		// We handle this by writing the task back to persistence with a higher taskID.
+		// This will allow subsequent tasks to make progress, and hopefully by the time this task is picked-up
+		// again the underlying reason for failing to start will be resolved.
+		// Note that RecordTaskStarted only fails after retrying for a long time, so a single task will not be
+		// re-written to persistence frequently.
+		err = executeWithRetry(func() error {
+			wf := &commonpb.WorkflowExecution{WorkflowId: task.Data.GetWorkflowId(), RunId: task.Data.GetRunId()}
+			_, err := b.taskWriter.appendTask(wf, task.Data)
+			return err
+		})
+
+		if err != nil {
+			// OK, we also failed to write to persistence.
+			// This should only happen in very extreme cases where persistence is completely down.
+			// We still can't lose the old task so we just unload the entire task queue
+			b.tlMgr.logger.Error("Persistent store operation failure",
+				tag.StoreOperationStopTaskQueue,
+				tag.Error(err),
+				tag.WorkflowTaskQueueName(b.db.taskQueueName),
+				tag.WorkflowTaskQueueType(b.db.taskType))
+			b.tlMgr.signalFatalProblem(b.tlMgr)
+			return
+		}
+		b.taskReader.Signal()
+	}
+
+	ackLevel := b.taskAckManager.completeTask(task.GetTaskId())
+	b.taskGC.Run(ackLevel)
+}
+
+// backlogFor returns the backlog that persists tasks of the given priority, starting it if needed.
+// Sticky task queues keep all tasks in the default backlog.
+func (c *taskQueueManagerImpl) backlogFor(priority int32) *taskQueueBacklog {
+	priority = priorities.Effective(priority)
+	if priority == priorities.Default || c.taskQueueKind == enumspb.TASK_QUEUE_KIND_STICKY {
+		return c.taskQueueBacklog
+	}
+
+	c.priorityBacklogsLock.Lock()
+	defer c.priorityBacklogsLock.Unlock()
+	if c.priorityBacklogs == nil {
+		// task queue is stopped, the stopped default backlog fails the call
+		return c.taskQueueBacklog
+	}
+	backlog, ok := c.priorityBacklogs[priority]
+	if !ok {
+		db := newTaskQueueDB(
+			c.db.store,
+			c.taskQueueID.namespaceID,
+			c.taskQueueID.priorityBacklogName(priority),
+			c.taskQueueID.taskType,
+			c.taskQueueKind,
+			c.logger,
+		)
+		backlog = newTaskQueueBacklog(c, priority, db)
+		c.priorityBacklogs[priority] = backlog
+		backlog.start()
+	}
+	return backlog
+}
+
+// loadPriorityBacklogs starts the backlogs of the priority levels which have a persistence
+// task queue, so tasks persisted by a previous owner are dispatched even if no new task of
+// their priority is added.
+func (c *taskQueueManagerImpl) loadPriorityBacklogs(ctx context.Context) error {
+	if c.taskQueueKind == enumspb.TASK_QUEUE_KIND_STICKY {
+		return nil
+	}
+
+	retryForever := backoff.NewExponentialRetryPolicy(1 * time.Second)
+	retryForever.SetMaximumInterval(10 * time.Second)
+	retryForever.SetExpirationInterval(backoff.NoInterval)
+
+	for priority := priorities.Highest; priority <= priorities.Lowest; priority++ {
+		if priority == priorities.Default {
+			continue
+		}
+		var exists bool
+		op := func(context.Context) error {
+			_, err := c.db.store.GetTaskQueue(&persistence.GetTaskQueueRequest{
+				NamespaceID: c.taskQueueID.namespaceID.String(),
+				TaskQueue:   c.taskQueueID.priorityBacklogName(priority),
+				TaskType:    c.taskQueueID.taskType,
+			})
+			switch err.(type) {
+			case nil:
+				exists = true
+				return nil
+			case *serviceerror.NotFound:
+				exists = false
+				return nil
+			default:
+				return err
+			}
+		}
+		if err := backoff.RetryContext(ctx, op, retryForever, common.IsPersistenceTransientError); err != nil {
+			c.logger.Error("Failed to load the backlog of a task priority", tag.Error(err))
+			return err
+		}
+		if exists {
+			c.backlogFor(priority)
+		}
+	}
+	return nil
+}
+
+// backlogCountHint returns the approximate number of tasks in the backlogs of all priority levels
+func (c *taskQueueManagerImpl) backlogCountHint() int64 {
+	count := c.taskAckManager.getBacklogCountHint()
+	c.priorityBacklogsLock.Lock()
+	defer c.priorityBacklogsLock.Unlock()
+	for _, backlog := range c.priorityBacklogs {
+		count += backlog.taskAckManager.getBacklogCountHint()
+	}
+	return count
+}
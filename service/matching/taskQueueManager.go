@@ -51,12 +51,17 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/priorities"
+	"go.temporal.io/server/internal/goro"
 )
 
 const (
 	// Time budget for empty task to propagate through the function stack and be returned to
 	// pollForActivityTask or pollForWorkflowTask handler.
 	returnEmptyTaskTimeBudget = time.Second
+	// How long a backlog task of a version set waits for a poller before it is sent to the
+	// end of the backlog.
+	versionedTaskDispatchTimeout = 10 * time.Second
 
 	// Fake Task ID to wrap a task for syncmatch
 	syncMatchTaskId = -137
@@ -98,6 +103,11 @@ type (
 		GetAllPollerInfo() []*taskqueuepb.PollerInfo
 		// DescribeTaskQueue returns information about the target task queue
 		DescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
+		// GetVersioningData returns the compatible-version graph of worker build IDs of this task queue
+		GetVersioningData(ctx context.Context) (*persistencespb.VersioningData, error)
+		// UpdateVersioningData adds a build ID to the compatible-version graph of this task queue.
+		// Only supported on the root partition of a workflow task queue
+		UpdateVersioningData(ctx context.Context, buildID string, compatibleBuildID string, becomeDefault bool) (*persistencespb.VersioningData, error)
 		String() string
 		QueueID() *taskQueueID
 		TaskQueueKind() enumspb.TaskQueueKind
@@ -109,18 +119,22 @@ type (
 		taskQueueID       *taskQueueID
 		taskQueueKind     enumspb.TaskQueueKind // sticky taskQueue has different process in persistence
 		config            *taskQueueConfig
-		db                *taskQueueDB
-		taskWriter        *taskWriter
-		taskReader        *taskReader // reads tasks from db and async matches it with poller
 		liveness          *liveness
-		taskGC            *taskGC
-		taskAckManager    ackManager   // tracks ackLevel for delivered messages
 		matcher           *TaskMatcher // for matching a task producer with a poller
 		namespaceRegistry namespace.Registry
 		logger            log.Logger
 		metricsClient     metrics.Client
 		namespace         namespace.Name
 		metricScope       metrics.Scope // namespace/taskqueue tagged metric scope
+		gorogrp           goro.Group
+		// backlog of the tasks with the default priority, it is persisted in the task queue itself
+		*taskQueueBacklog
+		// backlogs of the other priority levels by priority, a level is started when its first
+		// task is added or when its persisted backlog is found on start
+		priorityBacklogsLock sync.Mutex
+		priorityBacklogs     map[int32]*taskQueueBacklog
+		// taskBuffer holds the tasks read from the backlogs of all priority levels
+		taskBuffer *priorityTaskBuffer
 		// pollerHistory stores poller which poll from this taskqueue in last few minutes
 		pollerHistory *pollerHistory
 		// outstandingPollsMap is needed to keep track of all outstanding pollers for a
@@ -132,13 +146,28 @@ type (
 		outstandingPollsMap  map[string]context.CancelFunc
 		signalFatalProblem   func(taskQueueManager)
 		clusterMeta          cluster.Metadata
+		// initializedC is closed once the task queue lease is acquired and its metadata is loaded
+		initializedC   chan struct{}
+		matchingClient matchingservice.MatchingServiceClient
+		// versioningDataLock serializes updates of the versioning data on the root partition
+		// and guards the cached copy on other partitions
+		versioningDataLock      sync.Mutex
+		versioningData          *persistencespb.VersioningData
+		versioningDataFetchTime time.Time
+		versioningDataFetching  bool
+		// stats tracks backlog and throughput of this partition for DescribeTaskQueue
+		stats *taskQueueStats
 	}
 )
 
 var _ taskQueueManager = (*taskQueueManagerImpl)(nil)
 
-var errRemoteSyncMatchFailed = serviceerror.NewCanceled("remote sync match failed")
+var (
+	errRemoteSyncMatchFailed = serviceerror.NewCanceled("remote sync match failed")
+	errVersionSetNotPolled   = errors.New("no poller of the version set picked up the task")
+)
 
+// withIDBlockAllocator sets the ID block allocator of the default priority backlog
 func withIDBlockAllocator(ibl idBlockAllocator) taskQueueManagerOpt {
 	return func(tqm *taskQueueManagerImpl) {
 		tqm.taskWriter.idAlloc = ibl
@@ -182,9 +211,7 @@ func newTaskQueueManager(
 		taskQueueID:         taskQueue,
 		taskQueueKind:       taskQueueKind,
 		logger:              logger,
-		db:                  db,
-		taskAckManager:      newAckManager(e.logger),
-		taskGC:              newTaskGC(db, taskQueueConfig),
+		priorityBacklogs:    make(map[int32]*taskQueueBacklog),
 		config:              taskQueueConfig,
 		pollerHistory:       newPollerHistory(),
 		outstandingPollsMap: make(map[string]context.CancelFunc),
@@ -192,6 +219,9 @@ func newTaskQueueManager(
 		clusterMeta:         clusterMeta,
 		namespace:           nsName,
 		metricScope:         metricsScope,
+		initializedC:        make(chan struct{}),
+		matchingClient:      e.matchingClient,
+		stats:               newTaskQueueStats(clock.NewRealTimeSource()),
 	}
 
 	tlMgr.liveness = newLiveness(
@@ -199,8 +229,10 @@ func newTaskQueueManager(
 		taskQueueConfig.IdleTaskqueueCheckInterval(),
 		func() { tlMgr.signalFatalProblem(tlMgr) },
 	)
-	tlMgr.taskWriter = newTaskWriter(tlMgr)
-	tlMgr.taskReader = newTaskReader(tlMgr)
+	// we always dequeue the head of the buffer and try to dispatch it to a poller
+	// so allocate one less than desired target buffer size
+	tlMgr.taskBuffer = newPriorityTaskBuffer(taskQueueConfig.GetTasksBatchSize()-1, taskQueueConfig.BacklogPriorityAgingInterval)
+	tlMgr.taskQueueBacklog = newTaskQueueBacklog(tlMgr, priorities.Default, db)
 
 	var fwdr *Forwarder
 	if tlMgr.isFowardingAllowed(taskQueue, taskQueueKind) {
@@ -241,8 +273,8 @@ func (c *taskQueueManagerImpl) Start() {
 		return
 	}
 	c.liveness.Start()
-	c.taskWriter.Start()
-	c.taskReader.Start()
+	c.taskQueueBacklog.start()
+	c.gorogrp.Go(c.loadPriorityBacklogs)
 	c.logger.Info("", tag.LifeCycleStarted)
 	c.metricScope.IncCounter(metrics.TaskQueueStartedCounter)
 }
@@ -256,11 +288,16 @@ func (c *taskQueueManagerImpl) Stop() {
 	) {
 		return
 	}
-	_ = c.db.UpdateState(c.taskAckManager.getAckLevel())
-	c.taskGC.RunNow(c.taskAckManager.getAckLevel())
+	c.gorogrp.Cancel()
 	c.liveness.Stop()
-	c.taskWriter.Stop()
-	c.taskReader.Stop()
+	c.priorityBacklogsLock.Lock()
+	priorityBacklogs := c.priorityBacklogs
+	c.priorityBacklogs = nil
+	c.priorityBacklogsLock.Unlock()
+	for _, backlog := range priorityBacklogs {
+		backlog.stop()
+	}
+	c.taskQueueBacklog.stop()
 	c.logger.Info("", tag.LifeCycleStopped)
 	c.metricScope.IncCounter(metrics.TaskQueueStoppedCounter)
 }
@@ -278,6 +315,7 @@ func (c *taskQueueManagerImpl) AddTask(
 	}
 
 	var syncMatch bool
+	backlog := c.backlogFor(params.taskInfo.GetPriority())
 	err := executeWithRetry(func() error {
 		taskInfo := params.taskInfo
 
@@ -300,12 +338,19 @@ func (c *taskQueueManagerImpl) AddTask(
 			return errRemoteSyncMatchFailed
 		}
 
-		_, err = c.taskWriter.appendTask(params.execution, taskInfo)
+		_, err = backlog.taskWriter.appendTask(params.execution, taskInfo)
 		c.signalIfFatal(err)
 		return err
 	})
 	if !syncMatch && err == nil {
-		c.taskReader.Signal()
+		backlog.taskReader.Signal()
+	}
+	if err == nil && params.forwardedFrom == "" {
+		// tasks forwarded from a child partition are accounted for by the child partition
+		c.stats.recordTaskAdded()
+		if syncMatch {
+			c.stats.recordTaskDispatched(true)
+		}
 	}
 	return syncMatch, err
 }
@@ -362,24 +407,67 @@ func (c *taskQueueManagerImpl) GetTask(
 		return c.matcher.PollForQuery(childCtx)
 	}
 
-	task, err := c.matcher.Poll(childCtx)
+	workerBuildID, _ := ctx.Value(workerBuildIDKey).(string)
+	versionSet, err := c.versionSetForPoller(childCtx, workerBuildID)
+	if err != nil {
+		if common.IsContextDeadlineExceededErr(err) {
+			return nil, ErrNoTasks
+		}
+		return nil, err
+	}
+
+	task, err := c.matcher.Poll(childCtx, versionSet)
 	if err != nil {
 		return nil, err
 	}
 
 	task.namespace = c.namespace
-	task.backlogCountHint = c.taskAckManager.getBacklogCountHint()
+	task.backlogCountHint = c.backlogCountHint()
 	return task, nil
 }
 
 // DispatchTask dispatches a task to a poller. When there are no pollers to pick
 // up the task or if rate limit is exceeded, this method will return error. Task
-// *will not* be persisted to db
+// *will not* be persisted to db. Returns errVersionSetNotPolled when no poller of
+// the version set of the task picked it up within versionedTaskDispatchTimeout.
 func (c *taskQueueManagerImpl) DispatchTask(
 	ctx context.Context,
 	task *internalTask,
 ) error {
-	return c.matcher.MustOffer(ctx, task)
+	versionSet, err := c.versionSetForTask(ctx, task.event.Data)
+	if err != nil {
+		return err
+	}
+	task.versionSet = versionSet
+	if versionSet == unversionedSet {
+		err = c.matcher.MustOffer(ctx, task)
+		if err == nil {
+			c.stats.recordTaskDispatched(false)
+		}
+		return err
+	}
+	return c.dispatchVersionedTask(ctx, task)
+}
+
+// dispatchVersionedTask dispatches a task to a poller of the version set of the task. A version
+// set may have no pollers for a long time, e.g. when its workers have been retired. Gives up with
+// errVersionSetNotPolled after versionedTaskDispatchTimeout so that the task can be sent to the
+// end of the backlog.
+func (c *taskQueueManagerImpl) dispatchVersionedTask(
+	ctx context.Context,
+	task *internalTask,
+) error {
+	childCtx, cancel := context.WithTimeout(ctx, versionedTaskDispatchTimeout)
+	defer cancel()
+	err := c.matcher.MustOffer(childCtx, task)
+	if err == nil {
+		c.stats.recordTaskDispatched(false)
+		return nil
+	}
+	if childCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+		return errVersionSetNotPolled
+	}
+	return err
 }
 
 // DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
@@ -409,8 +497,10 @@ func (c *taskQueueManagerImpl) CancelPoller(pollerID string) {
 }
 
 // DescribeTaskQueue returns information about the target taskqueue, right now this API returns the
-// pollers which polled this taskqueue in last few minutes and status of taskqueue's ackManager
-// (readLevel, ackLevel, backlogCountHint and taskIDBlock).
+// pollers which polled this taskqueue in last few minutes, status of taskqueue's ackManager
+// (readLevel, ackLevel, backlogCountHint and taskIDBlock) and backlog and throughput stats.
+// Read level, ack level and task ID block are those of the default priority backlog, the backlog
+// count hint covers all priority levels.
 func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse {
 	response := &matchingservice.DescribeTaskQueueResponse{Pollers: c.GetAllPollerInfo()}
 	if !includeTaskQueueStatus {
@@ -418,20 +508,132 @@ func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *m
 	}
 
 	taskIDBlock := rangeIDToTaskIDBlock(c.db.RangeID(), c.config.RangeSize)
+	backlogCountHint := c.backlogCountHint()
 	response.TaskQueueStatus = &taskqueuepb.TaskQueueStatus{
 		ReadLevel:        c.taskAckManager.getReadLevel(),
 		AckLevel:         c.taskAckManager.getAckLevel(),
-		BacklogCountHint: c.taskAckManager.getBacklogCountHint(),
+		BacklogCountHint: backlogCountHint,
 		RatePerSecond:    c.matcher.Rate(),
 		TaskIdBlock: &taskqueuepb.TaskIdBlock{
 			StartId: taskIDBlock.start,
 			EndId:   taskIDBlock.end,
 		},
 	}
+	response.Stats = c.stats.describe(backlogCountHint)
 
 	return response
 }
 
+// GetVersioningData returns the compatible-version graph of worker build IDs of this task queue. The root
+// partition owns the graph; other partitions fetch it from the root partition and cache it for
+// VersioningDataRefreshInterval. Partitions that fail to fetch the graph keep using their cached copy,
+// and treat tasks and polls as unversioned until the first fetch succeeds.
+func (c *taskQueueManagerImpl) GetVersioningData(ctx context.Context) (*persistencespb.VersioningData, error) {
+	if !c.supportsVersioning() {
+		return nil, nil
+	}
+
+	if c.taskQueueID.IsRoot() {
+		if err := c.waitInitialized(ctx); err != nil {
+			return nil, err
+		}
+		return c.db.GetVersioningData(), nil
+	}
+
+	c.versioningDataLock.Lock()
+	data, fetchTime, fetching := c.versioningData, c.versioningDataFetchTime, c.versioningDataFetching
+	stale := fetchTime.IsZero() || time.Since(fetchTime) >= c.config.VersioningDataRefreshInterval()
+	if !stale || (fetching && !fetchTime.IsZero()) {
+		c.versioningDataLock.Unlock()
+		return data, nil
+	}
+	c.versioningDataFetching = true
+	c.versioningDataLock.Unlock()
+
+	// the lock is not held during the call so that a slow root partition does not serialize
+	// every task and poll of this partition
+	resp, err := c.matchingClient.GetWorkerBuildIdOrdering(ctx, &matchingservice.GetWorkerBuildIdOrderingRequest{
+		NamespaceId: c.taskQueueID.namespaceID.String(),
+		TaskQueue:   c.taskQueueID.GetRoot(),
+	})
+
+	c.versioningDataLock.Lock()
+	defer c.versioningDataLock.Unlock()
+	c.versioningDataFetching = false
+	c.versioningDataFetchTime = time.Now()
+	if err != nil {
+		// keep using the stale copy, or no versioning data at all, rather than failing tasks
+		// and polls, and retry on the next refresh
+		c.logger.Warn("Failed to refresh versioning data from root partition", tag.Error(err))
+		return c.versioningData, nil
+	}
+	c.versioningData = resp.GetVersioningData()
+	return c.versioningData, nil
+}
+
+// UpdateVersioningData adds a build ID to the compatible-version graph of this task queue
+func (c *taskQueueManagerImpl) UpdateVersioningData(
+	ctx context.Context,
+	buildID string,
+	compatibleBuildID string,
+	becomeDefault bool,
+) (*persistencespb.VersioningData, error) {
+	if !c.supportsVersioning() || !c.taskQueueID.IsRoot() {
+		return nil, serviceerror.NewInvalidArgument("Versioning data can only be updated on the root partition of a normal workflow task queue.")
+	}
+	if err := c.waitInitialized(ctx); err != nil {
+		return nil, err
+	}
+
+	c.versioningDataLock.Lock()
+	defer c.versioningDataLock.Unlock()
+	data, err := updateVersioningData(c.db.GetVersioningData(), buildID, compatibleBuildID, becomeDefault, c.config.VersionBuildIDLimit())
+	if err != nil {
+		return nil, err
+	}
+	err = c.db.UpdateVersioningData(data)
+	c.signalIfFatal(err)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (c *taskQueueManagerImpl) versionSetForTask(ctx context.Context, taskInfo *persistencespb.TaskInfo) (string, error) {
+	data, err := c.GetVersioningData(ctx)
+	if err != nil {
+		return unversionedSet, err
+	}
+	return versionSetForTask(data, taskInfo.GetWorkerBuildId()), nil
+}
+
+func (c *taskQueueManagerImpl) versionSetForPoller(ctx context.Context, workerBuildID string) (string, error) {
+	data, err := c.GetVersioningData(ctx)
+	if err != nil {
+		return unversionedSet, err
+	}
+	return versionSetForPoller(data, workerBuildID), nil
+}
+
+// supportsVersioning returns true for normal workflow task queues. Activity tasks and sticky
+// task queues are not routed by worker build ID.
+func (c *taskQueueManagerImpl) supportsVersioning() bool {
+	return c.taskQueueID.taskType == enumspb.TASK_QUEUE_TYPE_WORKFLOW && c.taskQueueKind == enumspb.TASK_QUEUE_KIND_NORMAL
+}
+
+func (c *taskQueueManagerImpl) markInitialized() {
+	close(c.initializedC)
+}
+
+func (c *taskQueueManagerImpl) waitInitialized(ctx context.Context) error {
+	select {
+	case <-c.initializedC:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (c *taskQueueManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskQueueID.taskType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
@@ -449,44 +651,6 @@ func (c *taskQueueManagerImpl) String() string {
 	return buf.String()
 }
 
-// completeTask marks a task as processed. Only tasks created by taskReader (i.e. backlog from db) reach
-// here. As part of completion:
-//   - task is deleted from the database when err is nil
-//   - new task is created and current task is deleted when err is not nil
-func (c *taskQueueManagerImpl) completeTask(task *persistencespb.AllocatedTaskInfo, err error) {
-	if err != nil {
-		// failed to start the task.
-		// We cannot just remove it from persistence because then it will be lost.
-		// We handle this by writing the task back to persistence with a higher taskID.
-		// This will allow subsequent tasks to make progress, and hopefully by the time this task is picked-up
-		// again the underlying reason for failing to start will be resolved.
-		// Note that RecordTaskStarted only fails after retrying for a long time, so a single task will not be
-		// re-written to persistence frequently.
-		err = executeWithRetry(func() error {
-			wf := &commonpb.WorkflowExecution{WorkflowId: task.Data.GetWorkflowId(), RunId: task.Data.GetRunId()}
-			_, err := c.taskWriter.appendTask(wf, task.Data)
-			return err
-		})
-
-		if err != nil {
-			// OK, we also failed to write to persistence.
-			// This should only happen in very extreme cases where persistence is completely down.
-			// We still can't lose the old task so we just unload the entire task queue
-			c.logger.Error("Persistent store operation failure",
-				tag.StoreOperationStopTaskQueue,
-				tag.Error(err),
-				tag.WorkflowTaskQueueName(c.taskQueueID.name),
-				tag.WorkflowTaskQueueType(c.taskQueueID.taskType))
-			c.signalFatalProblem(c)
-			return
-		}
-		c.taskReader.Signal()
-	}
-
-	ackLevel := c.taskAckManager.completeTask(task.GetTaskId())
-	c.taskGC.Run(ackLevel)
-}
-
 func rangeIDToTaskIDBlock(rangeID int64, rangeSize int64) taskIDBlock {
 	return taskIDBlock{
 		start: (rangeID-1)*rangeSize + 1,
@@ -520,6 +684,12 @@ func (c *taskQueueManagerImpl) trySyncMatch(ctx context.Context, params addTaskP
 	}
 
 	task := newInternalTask(fakeTaskIdWrapper, nil, params.source, params.forwardedFrom, true)
+	versionSet, err := c.versionSetForTask(childCtx, params.taskInfo)
+	if err != nil {
+		cancel()
+		return false, err
+	}
+	task.versionSet = versionSet
 	matched, err := c.matcher.Offer(childCtx, task)
 	cancel()
 	return matched, err
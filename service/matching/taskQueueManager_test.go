@@ -39,6 +39,8 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
@@ -57,13 +59,13 @@ func TestDeliverBufferTasks(t *testing.T) {
 	defer controller.Finish()
 
 	tests := []func(tlm *taskQueueManagerImpl){
-		func(tlm *taskQueueManagerImpl) { close(tlm.taskReader.taskBuffer) },
 		func(tlm *taskQueueManagerImpl) { tlm.taskReader.gorogrp.Cancel() },
 		func(tlm *taskQueueManagerImpl) {
 			rps := 0.1
 			tlm.matcher.UpdateRatelimit(&rps)
-			tlm.taskReader.taskBuffer <- &persistencespb.AllocatedTaskInfo{}
-			err := tlm.matcher.rateLimiter.Wait(context.Background()) // consume the token
+			err := tlm.taskReader.taskBuffer.put(context.Background(), tlm.taskQueueBacklog, &persistencespb.AllocatedTaskInfo{})
+			assert.NoError(t, err)
+			err = tlm.matcher.rateLimiter.Wait(context.Background()) // consume the token
 			assert.NoError(t, err)
 			tlm.taskReader.gorogrp.Cancel()
 		},
@@ -82,13 +84,52 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	defer controller.Finish()
 
 	tlm := mustCreateTestTaskQueueManager(t, controller)
-	tlm.taskReader.taskBuffer <- &persistencespb.AllocatedTaskInfo{}
+	err := tlm.taskReader.taskBuffer.put(context.Background(), tlm.taskQueueBacklog, &persistencespb.AllocatedTaskInfo{})
+	assert.NoError(t, err)
 	tlm.taskReader.gorogrp.Go(tlm.taskReader.dispatchBufferedTasks)
 	time.Sleep(100 * time.Millisecond) // let go routine run first and block on tasksForPoll
 	tlm.taskReader.gorogrp.Cancel()
 	tlm.taskReader.gorogrp.Wait()
 }
 
+func TestDeliverBufferTasks_VersionSetWithoutPollers(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	tlm.taskQueueID = newTestTaskQueueID(tlm.taskQueueID.namespaceID, "/_sys/tq/1", enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	mockMatchingClient := matchingservicemock.NewMockMatchingServiceClient(controller)
+	mockMatchingClient.EXPECT().GetWorkerBuildIdOrdering(gomock.Any(), gomock.Any()).
+		Return(&matchingservice.GetWorkerBuildIdOrderingResponse{VersioningData: mkVersioningData([]string{"1.0"}, []string{"2.0"})}, nil).
+		AnyTimes()
+	tlm.matchingClient = mockMatchingClient
+
+	// task of the version set without pollers is ahead of the task of the polled version set
+	now := time.Now().UTC()
+	for i, buildID := range []string{"1.0", "2.0"} {
+		err := tlm.taskReader.taskBuffer.put(context.Background(), tlm.taskQueueBacklog, &persistencespb.AllocatedTaskInfo{
+			TaskId: int64(i + 1),
+			Data: &persistencespb.TaskInfo{
+				WorkerBuildId: buildID,
+				CreateTime:    timestamp.TimePtr(now.Add(time.Duration(i) * time.Second)),
+			},
+		})
+		require.NoError(t, err)
+	}
+	tlm.taskReader.gorogrp.Go(tlm.taskReader.dispatchBufferedTasks)
+	defer func() {
+		tlm.taskReader.gorogrp.Cancel()
+		tlm.taskReader.gorogrp.Wait()
+	}()
+
+	// poller of the second version set gets its task without waiting for the first version set
+	ctx, cancel := context.WithTimeout(context.Background(), versionedTaskDispatchTimeout/2)
+	defer cancel()
+	task, err := tlm.matcher.Poll(ctx, "2.0")
+	require.NoError(t, err)
+	require.Equal(t, "2.0", task.event.Data.GetWorkerBuildId())
+}
+
 func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -473,3 +514,31 @@ func TestAddTaskStandby(t *testing.T) {
 	require.Equal(t, errRemoteSyncMatchFailed, err) // should not persist the task
 	require.False(t, syncMatch)
 }
+
+func TestGetVersioningData_FetchFailureIsUnversioned(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	tlm.taskQueueID = newTestTaskQueueID(tlm.taskQueueID.namespaceID, "/_sys/tq/1", enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	mockMatchingClient := matchingservicemock.NewMockMatchingServiceClient(controller)
+	tlm.matchingClient = mockMatchingClient
+
+	mockMatchingClient.EXPECT().GetWorkerBuildIdOrdering(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("root partition unavailable"))
+	versionSet, err := tlm.versionSetForTask(context.Background(), &persistencespb.TaskInfo{WorkerBuildId: "1.0"})
+	require.NoError(t, err)
+	require.Equal(t, unversionedSet, versionSet)
+
+	// the failed fetch is not retried before the refresh interval elapses
+	versionSet, err = tlm.versionSetForPoller(context.Background(), "1.0")
+	require.NoError(t, err)
+	require.Equal(t, unversionedSet, versionSet)
+
+	tlm.versioningDataFetchTime = time.Time{}
+	mockMatchingClient.EXPECT().GetWorkerBuildIdOrdering(gomock.Any(), gomock.Any()).
+		Return(&matchingservice.GetWorkerBuildIdOrderingResponse{VersioningData: mkVersioningData([]string{"1.0"})}, nil)
+	versionSet, err = tlm.versionSetForTask(context.Background(), &persistencespb.TaskInfo{WorkerBuildId: "1.0"})
+	require.NoError(t, err)
+	require.Equal(t, "1.0", versionSet)
+}
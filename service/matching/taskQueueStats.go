@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// taskQueueStatsWindow is the window over which add and dispatch rates are averaged
+	taskQueueStatsWindow = time.Minute
+)
+
+type (
+	// rateCounter counts events in one second buckets over a sliding window
+	rateCounter struct {
+		timeSource clock.TimeSource
+
+		sync.Mutex
+		buckets    []int64
+		lastSecond int64 // unix second of the most recently updated bucket
+	}
+
+	// taskQueueStats tracks the backlog and throughput of a task queue partition
+	taskQueueStats struct {
+		timeSource  clock.TimeSource
+		added       *rateCounter
+		dispatched  *rateCounter
+		syncMatched *rateCounter
+
+		sync.Mutex
+		backlogHeadCreateTime time.Time // create time of the backlog task being dispatched, zero if none
+	}
+)
+
+func newRateCounter(timeSource clock.TimeSource, window time.Duration) *rateCounter {
+	size := int(window / time.Second)
+	if size < 1 {
+		size = 1
+	}
+	return &rateCounter{
+		timeSource: timeSource,
+		buckets:    make([]int64, size),
+		lastSecond: timeSource.Now().Unix(),
+	}
+}
+
+func (r *rateCounter) add(count int64) {
+	r.Lock()
+	defer r.Unlock()
+	now := r.advance()
+	r.buckets[now%int64(len(r.buckets))] += count
+}
+
+// rate returns the average number of events per second over the window
+func (r *rateCounter) rate() float64 {
+	r.Lock()
+	defer r.Unlock()
+	r.advance()
+	var total int64
+	for _, count := range r.buckets {
+		total += count
+	}
+	return float64(total) / float64(len(r.buckets))
+}
+
+// advance resets the buckets which fell out of the window since the last update
+// and returns the current unix second. Must be called with the lock held.
+func (r *rateCounter) advance() int64 {
+	now := r.timeSource.Now().Unix()
+	size := int64(len(r.buckets))
+	if now-r.lastSecond >= size {
+		for i := range r.buckets {
+			r.buckets[i] = 0
+		}
+	} else {
+		for second := r.lastSecond + 1; second <= now; second++ {
+			r.buckets[second%size] = 0
+		}
+	}
+	if now > r.lastSecond {
+		r.lastSecond = now
+	}
+	return now
+}
+
+func newTaskQueueStats(timeSource clock.TimeSource) *taskQueueStats {
+	return &taskQueueStats{
+		timeSource:  timeSource,
+		added:       newRateCounter(timeSource, taskQueueStatsWindow),
+		dispatched:  newRateCounter(timeSource, taskQueueStatsWindow),
+		syncMatched: newRateCounter(timeSource, taskQueueStatsWindow),
+	}
+}
+
+func (s *taskQueueStats) recordTaskAdded() {
+	s.added.add(1)
+}
+
+func (s *taskQueueStats) recordTaskDispatched(syncMatch bool) {
+	s.dispatched.add(1)
+	if syncMatch {
+		s.syncMatched.add(1)
+	}
+}
+
+// setBacklogHead records the create time of the backlog task being dispatched. The backlog
+// is dispatched in task ID order, so this is approximately the oldest task of the backlog.
+func (s *taskQueueStats) setBacklogHead(createTime *time.Time) {
+	s.Lock()
+	defer s.Unlock()
+	s.backlogHeadCreateTime = timestamp.TimeValue(createTime)
+}
+
+func (s *taskQueueStats) clearBacklogHead() {
+	s.Lock()
+	defer s.Unlock()
+	s.backlogHeadCreateTime = time.Time{}
+}
+
+func (s *taskQueueStats) describe(backlogCount int64) *taskqueuespb.TaskQueueStats {
+	dispatchRate := s.dispatched.rate()
+	stats := &taskqueuespb.TaskQueueStats{
+		ApproximateBacklogCount: backlogCount,
+		ApproximateBacklogAge:   timestamp.DurationPtr(0),
+		TasksAddRate:            float32(s.added.rate()),
+		TasksDispatchRate:       float32(dispatchRate),
+	}
+	if dispatchRate > 0 {
+		stats.SyncMatchRatio = float32(s.syncMatched.rate() / dispatchRate)
+	}
+
+	s.Lock()
+	headCreateTime := s.backlogHeadCreateTime
+	s.Unlock()
+	if backlogCount > 0 && !headCreateTime.IsZero() {
+		if age := s.timeSource.Now().Sub(headCreateTime); age > 0 {
+			stats.ApproximateBacklogAge = timestamp.DurationPtr(age)
+		}
+	}
+	return stats
+}
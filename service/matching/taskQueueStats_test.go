@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func TestRateCounter(t *testing.T) {
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Unix(1000, 0))
+	counter := newRateCounter(timeSource, 10*time.Second)
+
+	counter.add(5)
+	timeSource.Update(time.Unix(1004, 0))
+	counter.add(15)
+	require.Equal(t, 2.0, counter.rate())
+
+	// the first bucket falls out of the window
+	timeSource.Update(time.Unix(1010, 0))
+	require.Equal(t, 1.5, counter.rate())
+
+	timeSource.Update(time.Unix(1100, 0))
+	require.Equal(t, 0.0, counter.rate())
+	counter.add(10)
+	require.Equal(t, 1.0, counter.rate())
+}
+
+func TestTaskQueueStats(t *testing.T) {
+	timeSource := clock.NewEventTimeSource()
+	now := time.Unix(1000, 0)
+	timeSource.Update(now)
+	stats := newTaskQueueStats(timeSource)
+
+	for i := 0; i < 60; i++ {
+		stats.recordTaskAdded()
+	}
+	for i := 0; i < 15; i++ {
+		stats.recordTaskDispatched(true)
+	}
+	for i := 0; i < 45; i++ {
+		stats.recordTaskDispatched(false)
+	}
+	stats.setBacklogHead(timestamp.TimePtr(now.Add(-time.Minute)))
+
+	described := stats.describe(10)
+	require.Equal(t, int64(10), described.GetApproximateBacklogCount())
+	require.Equal(t, time.Minute, timestamp.DurationValue(described.GetApproximateBacklogAge()))
+	require.Equal(t, float32(1), described.GetTasksAddRate())
+	require.Equal(t, float32(1), described.GetTasksDispatchRate())
+	require.Equal(t, float32(0.25), described.GetSyncMatchRatio())
+
+	// no backlog age is reported once the backlog is drained
+	described = stats.describe(0)
+	require.Equal(t, time.Duration(0), timestamp.DurationValue(described.GetApproximateBacklogAge()))
+
+	stats.clearBacklogHead()
+	described = stats.describe(10)
+	require.Equal(t, time.Duration(0), timestamp.DurationValue(described.GetApproximateBacklogAge()))
+}
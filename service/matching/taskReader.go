@@ -41,27 +41,44 @@ import (
 )
 
 const (
-	taskReaderOfferThrottleWait = time.Second
+	taskReaderOfferThrottleWait  = time.Second
+	taskReaderMaxRequeueThrottle = time.Minute
 )
 
 type (
+	// taskReader reads the tasks of a backlog from persistence into the task buffer of the task queue,
+	// which is shared by the backlogs of all priority levels. The reader of the default backlog also
+	// dispatches the buffered tasks.
 	taskReader struct {
 		status     int32
-		taskBuffer chan *persistencespb.AllocatedTaskInfo // tasks loaded from persistence
-		notifyC    chan struct{}                          // Used as signal to notify pump of new tasks
+		taskBuffer *priorityTaskBuffer // tasks loaded from persistence
+		notifyC    chan struct{}       // Used as signal to notify pump of new tasks
 		tlMgr      *taskQueueManagerImpl
+		backlog    *taskQueueBacklog
 		gorogrp    goro.Group
+		// dispatchers of versioned tasks keyed by version set, only used by dispatchBufferedTasks
+		versionSetDispatchers map[string]*versionSetDispatcher
+	}
+
+	// versionSetDispatcher dispatches the backlog tasks of one compatible version set. Its tasks wait
+	// in their own buffer, so a version set without pollers only holds up its own tasks and the task
+	// reader keeps dispatching the tasks of other version sets.
+	versionSetDispatcher struct {
+		versionSet string
+		tasksC     chan *internalTask
+		tlMgr      *taskQueueManagerImpl
 	}
 )
 
-func newTaskReader(tlMgr *taskQueueManagerImpl) *taskReader {
+func newTaskReader(tlMgr *taskQueueManagerImpl, backlog *taskQueueBacklog) *taskReader {
 	return &taskReader{
-		status:  common.DaemonStatusInitialized,
-		tlMgr:   tlMgr,
-		notifyC: make(chan struct{}, 1),
-		// we always dequeue the head of the buffer and try to dispatch it to a poller
-		// so allocate one less than desired target buffer size
-		taskBuffer: make(chan *persistencespb.AllocatedTaskInfo, tlMgr.config.GetTasksBatchSize()-1),
+		status:     common.DaemonStatusInitialized,
+		tlMgr:      tlMgr,
+		backlog:    backlog,
+		notifyC:    make(chan struct{}, 1),
+		taskBuffer: tlMgr.taskBuffer,
+
+		versionSetDispatchers: make(map[string]*versionSetDispatcher),
 	}
 }
 
@@ -74,7 +91,9 @@ func (tr *taskReader) Start() {
 		return
 	}
 
-	tr.gorogrp.Go(tr.dispatchBufferedTasks)
+	if tr.backlog.isDefault() {
+		tr.gorogrp.Go(tr.dispatchBufferedTasks)
+	}
 	tr.gorogrp.Go(tr.getTasksPump)
 
 	// Do not signal getTasksPump to start here, let it wait until taskWriter
@@ -102,34 +121,101 @@ func (tr *taskReader) Signal() {
 }
 
 func (tr *taskReader) dispatchBufferedTasks(ctx context.Context) error {
-dispatchLoop:
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffer:
-			if !ok { // Task queue getTasks pump is shutdown
-				break dispatchLoop
-			}
-			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
-			for {
-				err := tr.tlMgr.DispatchTask(ctx, task)
-				if err == nil {
-					break
-				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
-					return err
+		taskInfo, backlog, err := tr.taskBuffer.take(ctx)
+		if err != nil {
+			return nil
+		}
+		task := newInternalTask(taskInfo, backlog.completeTask, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+		tr.tlMgr.stats.setBacklogHead(taskInfo.Data.GetCreateTime())
+		if tr.dispatchVersionedTask(ctx, task) {
+			continue
+		}
+		for {
+			err := tr.tlMgr.DispatchTask(ctx, task)
+			if err == nil {
+				if tr.taskBuffer.len() == 0 {
+					tr.tlMgr.stats.clearBacklogHead()
 				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.scope().IncCounter(metrics.BufferThrottlePerTaskQueueCounter)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				time.Sleep(taskReaderOfferThrottleWait)
+				break
+			}
+			if err == errVersionSetNotPolled {
+				// completing the task with an error writes it back to the end of the backlog
+				task.finish(err)
+				break
 			}
+			if err == context.Canceled {
+				tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
+				return err
+			}
+			// this should never happen unless there is a bug - don't drop the task
+			tr.scope().IncCounter(metrics.BufferThrottlePerTaskQueueCounter)
+			tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+			time.Sleep(taskReaderOfferThrottleWait)
+		}
+	}
+}
 
+// dispatchVersionedTask hands the task over to the dispatcher of its version set. Returns false if
+// the task is unversioned or its version set is unknown, and the caller has to dispatch it. A task
+// is written back to the end of the backlog if the buffer of its version set is full.
+func (tr *taskReader) dispatchVersionedTask(ctx context.Context, task *internalTask) bool {
+	versionSet, err := tr.tlMgr.versionSetForTask(ctx, task.event.Data)
+	if err != nil || versionSet == unversionedSet {
+		return false
+	}
+	dispatcher, ok := tr.versionSetDispatchers[versionSet]
+	if !ok {
+		dispatcher = &versionSetDispatcher{
+			versionSet: versionSet,
+			tasksC:     make(chan *internalTask, tr.taskBuffer.capacity),
+			tlMgr:      tr.tlMgr,
+		}
+		tr.versionSetDispatchers[versionSet] = dispatcher
+		tr.gorogrp.Go(dispatcher.dispatchTasks)
+	}
+	task.versionSet = versionSet
+	select {
+	case dispatcher.tasksC <- task:
+	default:
+		task.finish(errVersionSetNotPolled)
+	}
+	return true
+}
+
+func (d *versionSetDispatcher) dispatchTasks(ctx context.Context) error {
+	// grows while the version set has no pollers, so that a backlog of tasks nobody polls for
+	// is not rewritten in a tight loop
+	requeueThrottle := time.Duration(0)
+	for {
+		var task *internalTask
+		select {
+		case task = <-d.tasksC:
+		case <-ctx.Done():
+			return nil
+		}
+		err := d.tlMgr.dispatchVersionedTask(ctx, task)
+		if err == nil {
+			requeueThrottle = 0
+			continue
+		}
+		if err != errVersionSetNotPolled {
+			// task queue is shutting down, buffered tasks are read again by the next owner
+			return nil
+		}
+		// completing the task with an error writes it back to the end of the backlog
+		task.finish(err)
+		if requeueThrottle == 0 {
+			requeueThrottle = taskReaderOfferThrottleWait
+		} else {
+			requeueThrottle = common.MinDuration(2*requeueThrottle, taskReaderMaxRequeueThrottle)
+		}
+		select {
+		case <-time.After(requeueThrottle):
 		case <-ctx.Done():
 			return nil
 		}
 	}
-	return nil
 }
 
 func (tr *taskReader) getTasksPump(ctx context.Context) error {
@@ -167,7 +253,7 @@ Loop:
 			}
 
 			if len(tasks) == 0 {
-				tr.tlMgr.taskAckManager.setReadLevelAfterGap(readLevel)
+				tr.backlog.taskAckManager.setReadLevelAfterGap(readLevel)
 				if !isReadBatchDone {
 					tr.Signal()
 				}
@@ -199,7 +285,7 @@ func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64)
 	var response *persistence.GetTasksResponse
 	var err error
 	err = executeWithRetry(func() error {
-		response, err = tr.tlMgr.db.GetTasks(readLevel, maxReadLevel, tr.tlMgr.config.GetTasksBatchSize())
+		response, err = tr.backlog.db.GetTasks(readLevel, maxReadLevel, tr.tlMgr.config.GetTasksBatchSize())
 		return err
 	})
 	if err != nil {
@@ -213,8 +299,8 @@ func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64)
 // Also return a bool to indicate whether read is finished
 func (tr *taskReader) getTaskBatch() ([]*persistencespb.AllocatedTaskInfo, int64, bool, error) {
 	var tasks []*persistencespb.AllocatedTaskInfo
-	readLevel := tr.tlMgr.taskAckManager.getReadLevel()
-	maxReadLevel := tr.tlMgr.taskWriter.GetMaxReadLevel()
+	readLevel := tr.backlog.taskAckManager.getReadLevel()
+	maxReadLevel := tr.backlog.taskWriter.GetMaxReadLevel()
 
 	// counter i is used to break and let caller check whether taskqueue is still alive and need resume read.
 	for i := 0; i < 10 && readLevel < maxReadLevel; i++ {
@@ -244,7 +330,7 @@ func (tr *taskReader) addTasksToBuffer(
 			tr.scope().IncCounter(metrics.ExpiredTasksPerTaskQueueCounter)
 			// Also increment readLevel for expired tasks otherwise it could result in
 			// looping over the same tasks if all tasks read in the batch are expired
-			tr.tlMgr.taskAckManager.setReadLevel(t.GetTaskId())
+			tr.backlog.taskAckManager.setReadLevel(t.GetTaskId())
 			continue
 		}
 		if err := tr.addSingleTaskToBuffer(ctx, t); err != nil {
@@ -258,17 +344,12 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	ctx context.Context,
 	task *persistencespb.AllocatedTaskInfo,
 ) error {
-	tr.tlMgr.taskAckManager.addTask(task.GetTaskId())
-	select {
-	case tr.taskBuffer <- task:
-		return nil
-	case <-ctx.Done():
-		return ctx.Err()
-	}
+	tr.backlog.taskAckManager.addTask(task.GetTaskId())
+	return tr.taskBuffer.put(ctx, tr.backlog, task)
 }
 
 func (tr *taskReader) persistAckLevel() error {
-	return tr.tlMgr.db.UpdateState(tr.tlMgr.taskAckManager.getAckLevel())
+	return tr.backlog.db.UpdateState(tr.backlog.taskAckManager.getAckLevel())
 }
 
 func (tr *taskReader) isTaskAddedRecently(lastAddTime time.Time) bool {
@@ -65,6 +65,7 @@ type (
 	taskWriter struct {
 		status       int32
 		tlMgr        *taskQueueManagerImpl
+		backlog      *taskQueueBacklog
 		config       *taskQueueConfig
 		taskQueueID  *taskQueueID
 		appendCh     chan *writeTaskRequest
@@ -83,17 +84,19 @@ var noTaskIDs = taskIDBlock{start: 1, end: 0}
 
 func newTaskWriter(
 	tlMgr *taskQueueManagerImpl,
+	backlog *taskQueueBacklog,
 ) *taskWriter {
 	return &taskWriter{
 		status:       common.DaemonStatusInitialized,
 		tlMgr:        tlMgr,
+		backlog:      backlog,
 		config:       tlMgr.config,
 		taskQueueID:  tlMgr.taskQueueID,
 		appendCh:     make(chan *writeTaskRequest, tlMgr.config.OutstandingTaskAppendsThreshold()),
 		taskIDBlock:  noTaskIDs,
 		maxReadLevel: noTaskIDs.start - 1,
 		logger:       tlMgr.logger,
-		idAlloc:      tlMgr.db,
+		idAlloc:      backlog.db,
 	}
 }
 
@@ -132,8 +135,11 @@ func (w *taskWriter) initReadWriteState(ctx context.Context) error {
 	}
 	w.taskIDBlock = rangeIDToTaskIDBlock(state.rangeID, w.config.RangeSize)
 	atomic.StoreInt64(&w.maxReadLevel, w.taskIDBlock.start-1)
-	w.tlMgr.taskAckManager.setAckLevel(state.ackLevel)
-	w.tlMgr.taskReader.Signal()
+	w.backlog.taskAckManager.setAckLevel(state.ackLevel)
+	if w.backlog.isDefault() {
+		w.tlMgr.markInitialized()
+	}
+	w.backlog.taskReader.Signal()
 	return nil
 }
 
@@ -201,13 +207,13 @@ func (w *taskWriter) appendTasks(
 	tasks []*persistencespb.AllocatedTaskInfo,
 ) (*persistence.CreateTasksResponse, error) {
 
-	resp, err := w.tlMgr.db.CreateTasks(tasks)
+	resp, err := w.backlog.db.CreateTasks(tasks)
 	if err != nil {
 		w.tlMgr.signalIfFatal(err)
 		w.logger.Error("Persistent store operation failure",
 			tag.StoreOperationCreateTask,
 			tag.Error(err),
-			tag.WorkflowTaskQueueName(w.taskQueueID.name),
+			tag.WorkflowTaskQueueName(w.backlog.db.taskQueueName),
 			tag.WorkflowTaskQueueType(w.taskQueueID.taskType))
 		return nil, err
 	}
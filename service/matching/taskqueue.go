@@ -112,6 +112,13 @@ func (tn *qualifiedTaskQueueName) mkName(partition int) string {
 	return fmt.Sprintf("%v%v/%v", taskQueuePartitionPrefix, tn.baseName, partition)
 }
 
+// priorityBacklogName returns the name of the persistence task queue that holds the backlog of
+// the given priority level of this partition, i.e. /_sys/<baseName>/<partition>/priority-<priority>.
+// These names never parse as a partition of a task queue.
+func (tn *qualifiedTaskQueueName) priorityBacklogName(priority int32) string {
+	return fmt.Sprintf("%v%v/%v/priority-%v", taskQueuePartitionPrefix, tn.baseName, tn.partition, priority)
+}
+
 func (tn *qualifiedTaskQueueName) init() error {
 	if !strings.HasPrefix(tn.name, taskQueuePartitionPrefix) {
 		return nil
@@ -119,3 +119,24 @@ func TestInvalidTaskqueueNames(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskQueuePriorityBacklogName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		priority int32
+		output   string
+	}{
+		{"list0", 1, "/_sys/list0/0/priority-1"},
+		{"/_sys/list0/3", 5, "/_sys/list0/3/priority-5"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.output, func(t *testing.T) {
+			tn, err := newTaskQueueName(tc.name)
+			require.NoError(t, err)
+			require.Equal(t, tc.output, tn.priorityBacklogName(tc.priority))
+			_, err = newTaskQueueName(tc.output)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// unversionedSet is the key of the task channel shared by tasks and pollers that are not
+// covered by the versioning data of a task queue, i.e. all of them when versioning is not used.
+const unversionedSet = ""
+
+// versionSetForTask returns the key of the compatible set that a workflow task last processed
+// by the given build ID must be dispatched to. Tasks without a build ID belong to the default set.
+func versionSetForTask(data *persistencespb.VersioningData, buildID string) string {
+	sets := data.GetVersionSets()
+	if len(sets) == 0 {
+		return unversionedSet
+	}
+	if buildID == "" {
+		return versionSetKey(sets[len(sets)-1])
+	}
+	return versionSetForBuildID(data, buildID)
+}
+
+// versionSetForPoller returns the key of the compatible set that a poller with the given
+// build ID can receive workflow tasks from.
+func versionSetForPoller(data *persistencespb.VersioningData, buildID string) string {
+	if buildID == "" {
+		return unversionedSet
+	}
+	return versionSetForBuildID(data, buildID)
+}
+
+func versionSetForBuildID(data *persistencespb.VersioningData, buildID string) string {
+	if set := findVersionSet(data, buildID); set >= 0 {
+		return versionSetKey(data.VersionSets[set])
+	}
+	return unversionedSet
+}
+
+// versionSetKey identifies a compatible set by its first build ID. Build IDs are unique within
+// a task queue and never removed from their set, so the key is stable across updates.
+func versionSetKey(set *persistencespb.CompatibleVersionSet) string {
+	return set.BuildIds[0]
+}
+
+func findVersionSet(data *persistencespb.VersioningData, buildID string) int {
+	for i, set := range data.GetVersionSets() {
+		for _, id := range set.GetBuildIds() {
+			if id == buildID {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func countBuildIDs(data *persistencespb.VersioningData) int {
+	count := 0
+	for _, set := range data.GetVersionSets() {
+		count += len(set.GetBuildIds())
+	}
+	return count
+}
+
+// updateVersioningData returns a copy of the given versioning data with buildID added to it.
+// buildID joins the set containing compatibleBuildID when that is given, otherwise it starts a
+// new set. A new set becomes the default one unless becomeDefault is false and another set
+// exists already. Adding an existing build ID is only allowed to promote its set to default.
+func updateVersioningData(
+	data *persistencespb.VersioningData,
+	buildID string,
+	compatibleBuildID string,
+	becomeDefault bool,
+	maxBuildIDs int,
+) (*persistencespb.VersioningData, error) {
+	if buildID == "" {
+		return nil, serviceerror.NewInvalidArgument("BuildId is not set on request.")
+	}
+
+	sets := make([]*persistencespb.CompatibleVersionSet, 0, len(data.GetVersionSets())+1)
+	for _, set := range data.GetVersionSets() {
+		sets = append(sets, &persistencespb.CompatibleVersionSet{
+			BuildIds: append([]string(nil), set.GetBuildIds()...),
+		})
+	}
+	result := &persistencespb.VersioningData{VersionSets: sets}
+
+	if existing := findVersionSet(result, buildID); existing >= 0 {
+		if compatibleBuildID != "" && findVersionSet(result, compatibleBuildID) != existing {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Build ID %v already exists and is not compatible with %v.", buildID, compatibleBuildID))
+		}
+		if becomeDefault {
+			result.VersionSets = moveToEnd(result.VersionSets, existing)
+		}
+		return result, nil
+	}
+
+	if maxBuildIDs > 0 && countBuildIDs(result) >= maxBuildIDs {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Task queue has reached the limit of %v build IDs.", maxBuildIDs))
+	}
+
+	if compatibleBuildID != "" {
+		compatible := findVersionSet(result, compatibleBuildID)
+		if compatible < 0 {
+			return nil, serviceerror.NewNotFound(fmt.Sprintf("Build ID %v not found.", compatibleBuildID))
+		}
+		result.VersionSets[compatible].BuildIds = append(result.VersionSets[compatible].BuildIds, buildID)
+		if becomeDefault {
+			result.VersionSets = moveToEnd(result.VersionSets, compatible)
+		}
+		return result, nil
+	}
+
+	set := &persistencespb.CompatibleVersionSet{BuildIds: []string{buildID}}
+	if becomeDefault || len(result.VersionSets) == 0 {
+		result.VersionSets = append(result.VersionSets, set)
+	} else {
+		last := len(result.VersionSets) - 1
+		result.VersionSets = append(result.VersionSets[:last], set, result.VersionSets[last])
+	}
+	return result, nil
+}
+
+func moveToEnd(sets []*persistencespb.CompatibleVersionSet, index int) []*persistencespb.CompatibleVersionSet {
+	set := sets[index]
+	sets = append(sets[:index], sets[index+1:]...)
+	return append(sets, set)
+}
@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+func mkVersioningData(sets ...[]string) *persistencespb.VersioningData {
+	data := &persistencespb.VersioningData{}
+	for _, ids := range sets {
+		data.VersionSets = append(data.VersionSets, &persistencespb.CompatibleVersionSet{BuildIds: ids})
+	}
+	return data
+}
+
+func TestVersionSetForTask(t *testing.T) {
+	data := mkVersioningData([]string{"1.0", "1.1"}, []string{"2.0"})
+
+	require.Equal(t, unversionedSet, versionSetForTask(nil, "1.0"))
+	require.Equal(t, unversionedSet, versionSetForTask(nil, ""))
+	require.Equal(t, "2.0", versionSetForTask(data, ""))
+	require.Equal(t, "1.0", versionSetForTask(data, "1.1"))
+	require.Equal(t, "2.0", versionSetForTask(data, "2.0"))
+	require.Equal(t, unversionedSet, versionSetForTask(data, "0.9"))
+}
+
+func TestVersionSetForPoller(t *testing.T) {
+	data := mkVersioningData([]string{"1.0", "1.1"}, []string{"2.0"})
+
+	require.Equal(t, unversionedSet, versionSetForPoller(nil, "1.0"))
+	require.Equal(t, unversionedSet, versionSetForPoller(data, ""))
+	require.Equal(t, "1.0", versionSetForPoller(data, "1.1"))
+	require.Equal(t, "2.0", versionSetForPoller(data, "2.0"))
+	require.Equal(t, unversionedSet, versionSetForPoller(data, "0.9"))
+}
+
+func TestUpdateVersioningData(t *testing.T) {
+	testCases := []struct {
+		name          string
+		data          *persistencespb.VersioningData
+		buildID       string
+		compatible    string
+		becomeDefault bool
+		expected      *persistencespb.VersioningData
+		expectErr     bool
+	}{
+		{
+			name:          "first build ID",
+			buildID:       "1.0",
+			becomeDefault: true,
+			expected:      mkVersioningData([]string{"1.0"}),
+		},
+		{
+			name:          "first build ID is default",
+			buildID:       "1.0",
+			becomeDefault: false,
+			expected:      mkVersioningData([]string{"1.0"}),
+		},
+		{
+			name:          "new default set",
+			data:          mkVersioningData([]string{"1.0"}),
+			buildID:       "2.0",
+			becomeDefault: true,
+			expected:      mkVersioningData([]string{"1.0"}, []string{"2.0"}),
+		},
+		{
+			name:     "new non-default set",
+			data:     mkVersioningData([]string{"1.0"}, []string{"2.0"}),
+			buildID:  "1.5",
+			expected: mkVersioningData([]string{"1.0"}, []string{"1.5"}, []string{"2.0"}),
+		},
+		{
+			name:       "compatible build ID",
+			data:       mkVersioningData([]string{"1.0"}, []string{"2.0"}),
+			buildID:    "1.1",
+			compatible: "1.0",
+			expected:   mkVersioningData([]string{"1.0", "1.1"}, []string{"2.0"}),
+		},
+		{
+			name:          "compatible build ID becomes default",
+			data:          mkVersioningData([]string{"1.0"}, []string{"2.0"}),
+			buildID:       "1.1",
+			compatible:    "1.0",
+			becomeDefault: true,
+			expected:      mkVersioningData([]string{"2.0"}, []string{"1.0", "1.1"}),
+		},
+		{
+			name:          "promote existing build ID",
+			data:          mkVersioningData([]string{"1.0"}, []string{"2.0"}),
+			buildID:       "1.0",
+			becomeDefault: true,
+			expected:      mkVersioningData([]string{"2.0"}, []string{"1.0"}),
+		},
+		{
+			name:     "existing build ID is a no-op",
+			data:     mkVersioningData([]string{"1.0"}, []string{"2.0"}),
+			buildID:  "2.0",
+			expected: mkVersioningData([]string{"1.0"}, []string{"2.0"}),
+		},
+		{
+			name:       "existing build ID in another set",
+			data:       mkVersioningData([]string{"1.0"}, []string{"2.0"}),
+			buildID:    "2.0",
+			compatible: "1.0",
+			expectErr:  true,
+		},
+		{
+			name:       "unknown compatible build ID",
+			data:       mkVersioningData([]string{"1.0"}),
+			buildID:    "1.1",
+			compatible: "0.9",
+			expectErr:  true,
+		},
+		{
+			name:      "empty build ID",
+			data:      mkVersioningData([]string{"1.0"}),
+			expectErr: true,
+		},
+		{
+			name:      "limit reached",
+			data:      mkVersioningData([]string{"1.0", "1.1"}, []string{"2.0"}),
+			buildID:   "3.0",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var original *persistencespb.VersioningData
+			if tc.data != nil {
+				original = proto.Clone(tc.data).(*persistencespb.VersioningData)
+			}
+			result, err := updateVersioningData(tc.data, tc.buildID, tc.compatible, tc.becomeDefault, 3)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, result)
+			// the input must not be mutated since it is shared with concurrent readers
+			require.Equal(t, original, tc.data)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	// minRPS is the lowest rate the adaptive rate limiter backs off to
+	minRPS = 1
+	// backoffCoefficient is applied to the current rate on overload errors
+	backoffCoefficient = 0.5
+	// backoffInterval is the minimum time between two backoffs, so that a burst of
+	// errors from concurrent task processors only cuts the rate once
+	backoffInterval = time.Second
+	// rampUpInterval is the time without overload errors after which the rate is increased
+	rampUpInterval = 10 * time.Second
+	// rampUpSteps is the number of increases needed to go from minRPS back to the max rate
+	rampUpSteps = 10
+)
+
+type (
+	// adaptiveRateLimiter limits the rate of batch operations to at most maxRPS. The rate is
+	// cut multiplicatively when the backend reports that it is overloaded (persistence or
+	// namespace rate limits, busy or unavailable history shards) and ramps back up additively
+	// while requests succeed.
+	adaptiveRateLimiter struct {
+		sync.Mutex
+		limiter    *quotas.RateLimiterImpl
+		timeSource clock.TimeSource
+		maxRPS     float64
+		rps        float64
+		lastAdjust time.Time
+	}
+)
+
+func newAdaptiveRateLimiter(maxRPS int, timeSource clock.TimeSource) *adaptiveRateLimiter {
+	rps := math.Max(float64(maxRPS), minRPS)
+	return &adaptiveRateLimiter{
+		limiter:    quotas.NewRateLimiter(rps, burst(rps)),
+		timeSource: timeSource,
+		maxRPS:     rps,
+		rps:        rps,
+		lastAdjust: timeSource.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed by the current rate
+func (l *adaptiveRateLimiter) Wait(ctx context.Context) error {
+	return l.limiter.Wait(ctx)
+}
+
+// Rate returns the current rate
+func (l *adaptiveRateLimiter) Rate() float64 {
+	l.Lock()
+	defer l.Unlock()
+	return l.rps
+}
+
+// Record adjusts the rate based on the result of a request. It returns true if the
+// rate was cut because of the error.
+func (l *adaptiveRateLimiter) Record(err error) bool {
+	l.Lock()
+	defer l.Unlock()
+
+	now := l.timeSource.Now()
+	if isOverloadError(err) {
+		if now.Sub(l.lastAdjust) < backoffInterval || l.rps <= minRPS {
+			return false
+		}
+		l.setRate(math.Max(l.rps*backoffCoefficient, minRPS), now)
+		return true
+	}
+	if err == nil && l.rps < l.maxRPS && now.Sub(l.lastAdjust) >= rampUpInterval {
+		l.setRate(math.Min(l.rps+math.Max(l.maxRPS/rampUpSteps, 1), l.maxRPS), now)
+	}
+	return false
+}
+
+func (l *adaptiveRateLimiter) setRate(rps float64, now time.Time) {
+	l.rps = rps
+	l.lastAdjust = now
+	l.limiter.SetRateBurst(rps, burst(rps))
+}
+
+func burst(rps float64) int {
+	return int(math.Ceil(rps))
+}
+
+// isOverloadError returns true if the error indicates that the backend is overloaded
+// and the batch operation should slow down.
+func isOverloadError(err error) bool {
+	switch err.(type) {
+	case *serviceerror.ResourceExhausted, *serviceerror.Unavailable:
+		return true
+	default:
+		return false
+	}
+}
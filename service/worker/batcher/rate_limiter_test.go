@@ -0,0 +1,77 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/clock"
+)
+
+func TestAdaptiveRateLimiter(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	limiter := newAdaptiveRateLimiter(100, timeSource)
+	overloaded := serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_SYSTEM_OVERLOADED, "overloaded")
+
+	// back off on overload errors, at most once per backoff interval
+	timeSource.Update(timeSource.Now().Add(backoffInterval))
+	require.True(t, limiter.Record(overloaded))
+	require.Equal(t, float64(50), limiter.Rate())
+	require.False(t, limiter.Record(serviceerror.NewUnavailable("shard busy")))
+	require.Equal(t, float64(50), limiter.Rate())
+
+	// other errors don't change the rate
+	timeSource.Update(timeSource.Now().Add(backoffInterval))
+	require.False(t, limiter.Record(errors.New("some error")))
+	require.False(t, limiter.Record(serviceerror.NewNotFound("not found")))
+	require.Equal(t, float64(50), limiter.Rate())
+
+	// ramp up after a period without overload errors
+	timeSource.Update(timeSource.Now().Add(rampUpInterval))
+	require.False(t, limiter.Record(nil))
+	require.Equal(t, float64(60), limiter.Rate())
+	require.False(t, limiter.Record(nil))
+	require.Equal(t, float64(60), limiter.Rate())
+
+	// never above max rate
+	for i := 0; i < rampUpSteps; i++ {
+		timeSource.Update(timeSource.Now().Add(rampUpInterval))
+		limiter.Record(nil)
+	}
+	require.Equal(t, float64(100), limiter.Rate())
+
+	// never below min rate
+	for i := 0; i < 20; i++ {
+		timeSource.Update(timeSource.Now().Add(backoffInterval))
+		limiter.Record(overloaded)
+	}
+	require.Equal(t, float64(minRPS), limiter.Rate())
+}
@@ -29,19 +29,24 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
-	"golang.org/x/time/rate"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 )
 
 const (
@@ -63,6 +68,13 @@ const (
 	DefaultAttemptsOnRetryableError = 50
 	// DefaultActivityHeartBeatTimeout is the default value for ActivityHeartBeatTimeout
 	DefaultActivityHeartBeatTimeout = time.Second * 10
+	// maxFailedWorkflowIDs bounds the number of failed workflow ids kept in heartbeat details
+	maxFailedWorkflowIDs = 100
+
+	// MemoReason is the memo key of the batch job reason
+	MemoReason = "Reason"
+	// MemoBatchType is the memo key of the batch job type
+	MemoBatchType = "BatchType"
 )
 
 const (
@@ -72,10 +84,26 @@ const (
 	BatchTypeCancel = "cancel"
 	// BatchTypeSignal is batch type for signaling workflows
 	BatchTypeSignal = "signal"
+	// BatchTypeDelete is batch type for deleting workflows
+	BatchTypeDelete = "delete"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+)
+
+const (
+	// ResetTypeFirstWorkflowTask resets workflows to the first completed workflow task
+	ResetTypeFirstWorkflowTask = "FirstWorkflowTask"
+	// ResetTypeLastWorkflowTask resets workflows to the last completed workflow task
+	ResetTypeLastWorkflowTask = "LastWorkflowTask"
+	// ResetTypeLastContinuedAsNew resets workflows to the last completed workflow task of the run
+	// which continued as new into the current run
+	ResetTypeLastContinuedAsNew = "LastContinuedAsNew"
+	// ResetTypeBadBinary resets workflows to the first workflow task completed by the bad binary
+	ResetTypeBadBinary = "BadBinary"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeDelete, BatchTypeReset}
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -100,15 +128,27 @@ type (
 		Input      *commonpb.Payloads
 	}
 
+	// ResetParams is the parameters for resetting workflow
+	ResetParams struct {
+		// Supporting: FirstWorkflowTask,LastWorkflowTask,LastContinuedAsNew,BadBinary
+		ResetType string
+		// Default to RESET_REAPPLY_TYPE_SIGNAL
+		ResetReapplyType enumsspb.ResetReapplyType
+		// Binary checksum of the bad binary, required by BadBinary reset type
+		BadBinaryChecksum string
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target namespace to execute batch operation
 		Namespace string
 		// To get the target workflows for processing
 		Query string
+		// Explicit list of target workflows, alternative to Query
+		Executions []commonpb.WorkflowExecution
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate
+		// Supporting: signal,cancel,terminate,delete,reset
 		BatchType string
 
 		// Below are all optional
@@ -118,8 +158,10 @@ type (
 		CancelParams CancelParams
 		// SignalParams is params only for BatchTypeSignal
 		SignalParams SignalParams
-		// RPS of processing. Default to DefaultRPS
-		// TODO we will implement smarter way than this static rate limiter: https://go.temporal.io/server/issues/2138
+		// ResetParams is params only for BatchTypeReset
+		ResetParams ResetParams
+		// Max RPS of processing. The actual rate backs off when the backend is overloaded
+		// and ramps back up to RPS afterwards. Default to DefaultRPS
 		RPS int
 		// Number of goroutines running in parallel to process
 		Concurrency int
@@ -143,6 +185,8 @@ type (
 		SuccessCount int
 		// Number of workflows that give up due to errors.
 		ErrorCount int
+		// Ids of the first workflows that give up due to errors.
+		FailedWorkflowIDs []string
 	}
 
 	taskDetail struct {
@@ -151,6 +195,11 @@ type (
 		// passing along the current heartbeat details to make heartbeat within a task so that it won't timeout
 		hbd HeartBeatDetails
 	}
+
+	taskResponse struct {
+		workflowID string
+		err        error
+	}
 )
 
 var (
@@ -185,8 +234,11 @@ func validateParams(params BatchParams) error {
 	if params.BatchType == "" ||
 		params.Reason == "" ||
 		params.Namespace == "" ||
-		params.Query == "" {
-		return fmt.Errorf("must provide required parameters: BatchType/Reason/Namespace/Query")
+		(params.Query == "" && len(params.Executions) == 0) {
+		return fmt.Errorf("must provide required parameters: BatchType/Reason/Namespace/Query or Executions")
+	}
+	if params.Query != "" && len(params.Executions) > 0 {
+		return fmt.Errorf("must provide either Query or Executions, not both")
 	}
 	switch params.BatchType {
 	case BatchTypeSignal:
@@ -194,7 +246,19 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
-	case BatchTypeCancel, BatchTypeTerminate:
+	case BatchTypeReset:
+		switch params.ResetParams.ResetType {
+		case ResetTypeFirstWorkflowTask, ResetTypeLastWorkflowTask, ResetTypeLastContinuedAsNew:
+			return nil
+		case ResetTypeBadBinary:
+			if params.ResetParams.BadBinaryChecksum == "" {
+				return fmt.Errorf("must provide bad binary checksum")
+			}
+			return nil
+		default:
+			return fmt.Errorf("not supported reset type: %v", params.ResetParams.ResetType)
+		}
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -223,6 +287,9 @@ func setDefaultParams(params BatchParams) BatchParams {
 	if params.TerminateParams.TerminateChildren == nil {
 		params.TerminateParams.TerminateChildren = convert.BoolPtr(true)
 	}
+	if params.ResetParams.ResetReapplyType == enumsspb.RESET_REAPPLY_TYPE_UNSPECIFIED {
+		params.ResetParams.ResetReapplyType = enumsspb.RESET_REAPPLY_TYPE_SIGNAL
+	}
 	return params
 }
 
@@ -250,42 +317,45 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	}
 
 	if startOver {
-		resp, err := sdkClient.CountWorkflow(ctx, &workflowservice.CountWorkflowExecutionsRequest{
-			Query: batchParams.Query,
-		})
-		if err != nil {
-			return HeartBeatDetails{}, err
+		if len(batchParams.Executions) > 0 {
+			hbd.TotalEstimate = int64(len(batchParams.Executions))
+		} else {
+			resp, err := sdkClient.CountWorkflow(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+				Query: batchParams.Query,
+			})
+			if err != nil {
+				return HeartBeatDetails{}, err
+			}
+			hbd.TotalEstimate = resp.GetCount()
 		}
-		hbd.TotalEstimate = resp.GetCount()
 	}
-	rateLimiter := rate.NewLimiter(rate.Limit(batchParams.RPS), batchParams.RPS)
+
+	namespaceID, err := getNamespaceID(batcher.namespaceRegistry, batchParams)
+	if err != nil {
+		return HeartBeatDetails{}, err
+	}
+
+	rateLimiter := newAdaptiveRateLimiter(batchParams.RPS, clock.NewRealTimeSource())
 	taskCh := make(chan taskDetail, pageSize)
-	respCh := make(chan error, pageSize)
+	respCh := make(chan taskResponse, pageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, sdkClient, logger)
+		go startTaskProcessor(ctx, batchParams, namespaceID, taskCh, respCh, rateLimiter, sdkClient, logger)
 	}
 
 	for {
-		// TODO https://github.com/uber/cadence/issues/2154
-		//  Need to improve scan concurrency because it will hold an ES resource until the workflow finishes.
-		//  And we can't use list API because terminate / reset will mutate the result.
-		resp, err := sdkClient.ScanWorkflow(ctx, &workflowservice.ScanWorkflowExecutionsRequest{
-			PageSize:      int32(pageSize),
-			NextPageToken: hbd.PageToken,
-			Query:         batchParams.Query,
-		})
+		executions, nextPageToken, err := getNextPage(ctx, batchParams, hbd, sdkClient)
 		if err != nil {
 			return HeartBeatDetails{}, err
 		}
-		batchCount := len(resp.Executions)
+		batchCount := len(executions)
 		if batchCount <= 0 {
 			break
 		}
 
 		// send all tasks
-		for _, wf := range resp.Executions {
+		for _, execution := range executions {
 			taskCh <- taskDetail{
-				execution: *wf.Execution,
+				execution: execution,
 				attempts:  1,
 				hbd:       hbd,
 			}
@@ -297,11 +367,14 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	Loop:
 		for {
 			select {
-			case err := <-respCh:
-				if err == nil {
+			case resp := <-respCh:
+				if resp.err == nil {
 					succCount++
 				} else {
 					errCount++
+					if len(hbd.FailedWorkflowIDs) < maxFailedWorkflowIDs {
+						hbd.FailedWorkflowIDs = append(hbd.FailedWorkflowIDs, resp.workflowID)
+					}
 				}
 				if succCount+errCount == batchCount {
 					break Loop
@@ -312,12 +385,12 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		}
 
 		hbd.CurrentPage++
-		hbd.PageToken = resp.NextPageToken
+		hbd.PageToken = nextPageToken
 		hbd.SuccessCount += succCount
 		hbd.ErrorCount += errCount
 		activity.RecordHeartbeat(ctx, hbd)
 
-		if len(hbd.PageToken) == 0 {
+		if !hasMorePages(batchParams, hbd) {
 			break
 		}
 	}
@@ -325,12 +398,58 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	return hbd, nil
 }
 
+// getNextPage returns the next page of workflows to process, either from the explicit
+// list of executions or from visibility.
+func getNextPage(
+	ctx context.Context,
+	batchParams BatchParams,
+	hbd HeartBeatDetails,
+	sdkClient sdkclient.Client,
+) ([]commonpb.WorkflowExecution, []byte, error) {
+	if len(batchParams.Executions) > 0 {
+		start := hbd.CurrentPage * pageSize
+		if start >= len(batchParams.Executions) {
+			return nil, nil, nil
+		}
+		end := start + pageSize
+		if end > len(batchParams.Executions) {
+			end = len(batchParams.Executions)
+		}
+		return batchParams.Executions[start:end], nil, nil
+	}
+
+	// TODO https://github.com/uber/cadence/issues/2154
+	//  Need to improve scan concurrency because it will hold an ES resource until the workflow finishes.
+	//  And we can't use list API because terminate / reset will mutate the result.
+	resp, err := sdkClient.ScanWorkflow(ctx, &workflowservice.ScanWorkflowExecutionsRequest{
+		PageSize:      int32(pageSize),
+		NextPageToken: hbd.PageToken,
+		Query:         batchParams.Query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]commonpb.WorkflowExecution, 0, len(resp.Executions))
+	for _, wf := range resp.Executions {
+		executions = append(executions, *wf.Execution)
+	}
+	return executions, resp.NextPageToken, nil
+}
+
+func hasMorePages(batchParams BatchParams, hbd HeartBeatDetails) bool {
+	if len(batchParams.Executions) > 0 {
+		return hbd.CurrentPage*pageSize < len(batchParams.Executions)
+	}
+	return len(hbd.PageToken) > 0
+}
+
 func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
+	namespaceID string,
 	taskCh chan taskDetail,
-	respCh chan error,
-	limiter *rate.Limiter,
+	respCh chan taskResponse,
+	limiter *adaptiveRateLimiter,
 	sdkClient sdkclient.Client,
 	logger log.Logger,
 ) {
@@ -347,22 +466,32 @@ func startTaskProcessor(
 
 			switch batchParams.BatchType {
 			case BatchTypeTerminate:
-				err = processTask(ctx, limiter, task, sdkClient, logger,
+				err = processTask(ctx, limiter, task, sdkClient, batcher.metricsClient, logger,
 					batchParams.TerminateParams.TerminateChildren,
 					func(workflowID, runID string) error {
 						return sdkClient.TerminateWorkflow(ctx, workflowID, runID, batchParams.Reason)
 					})
 			case BatchTypeCancel:
-				err = processTask(ctx, limiter, task, sdkClient, logger,
+				err = processTask(ctx, limiter, task, sdkClient, batcher.metricsClient, logger,
 					batchParams.CancelParams.CancelChildren,
 					func(workflowID, runID string) error {
 						return sdkClient.CancelWorkflow(ctx, workflowID, runID)
 					})
 			case BatchTypeSignal:
-				err = processTask(ctx, limiter, task, sdkClient, logger, convert.BoolPtr(false),
+				err = processTask(ctx, limiter, task, sdkClient, batcher.metricsClient, logger, convert.BoolPtr(false),
 					func(workflowID, runID string) error {
 						return sdkClient.SignalWorkflow(ctx, workflowID, runID, batchParams.SignalParams.SignalName, batchParams.SignalParams.Input)
 					})
+			case BatchTypeDelete:
+				err = processTask(ctx, limiter, task, sdkClient, batcher.metricsClient, logger, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return deleteWorkflow(ctx, batcher.historyClient, sdkClient, namespaceID, workflowID, runID, batchParams.Reason)
+					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task, sdkClient, batcher.metricsClient, logger, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, batcher.historyClient, sdkClient, namespaceID, batchParams, workflowID, runID)
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
@@ -370,7 +499,7 @@ func startTaskProcessor(
 
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
 				if ok || task.attempts > batchParams.AttemptsOnRetryableError {
-					respCh <- err
+					respCh <- taskResponse{workflowID: task.execution.GetWorkflowId(), err: err}
 				} else {
 					// put back to the channel if less than attemptsOnError
 					task.attempts++
@@ -378,7 +507,7 @@ func startTaskProcessor(
 				}
 			} else {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorSuccess)
-				respCh <- nil
+				respCh <- taskResponse{workflowID: task.execution.GetWorkflowId()}
 			}
 		}
 	}
@@ -386,9 +515,10 @@ func startTaskProcessor(
 
 func processTask(
 	ctx context.Context,
-	limiter *rate.Limiter,
+	limiter *adaptiveRateLimiter,
 	task taskDetail,
 	sdkClient sdkclient.Client,
+	metricsClient metrics.Client,
 	logger log.Logger,
 	applyOnChild *bool,
 	procFn func(string, string) error,
@@ -404,6 +534,11 @@ func processTask(
 		activity.RecordHeartbeat(ctx, task.hbd)
 
 		err = procFn(wf.GetWorkflowId(), wf.GetRunId())
+		if limiter.Record(err) {
+			metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorThrottled)
+			logger.Warn("Backend is overloaded, reduced batch operation rate",
+				tag.Number(int64(limiter.Rate())), tag.Error(err))
+		}
 		if err != nil {
 			// NotFound means wf is not running or deleted
 			if _, ok := err.(*serviceerror.NotFound); !ok {
@@ -436,6 +571,155 @@ func processTask(
 	return nil
 }
 
+// getNamespaceID returns the id of the batch namespace for the batch types which call history
+// service directly, and an empty string for the others.
+func getNamespaceID(
+	namespaceRegistry namespace.Registry,
+	batchParams BatchParams,
+) (string, error) {
+	switch batchParams.BatchType {
+	case BatchTypeDelete, BatchTypeReset:
+		id, err := namespaceRegistry.GetNamespaceID(namespace.Name(batchParams.Namespace))
+		if err != nil {
+			return "", err
+		}
+		return id.String(), nil
+	default:
+		return "", nil
+	}
+}
+
+// deleteWorkflow terminates the workflow if it is still running and then deletes it.
+func deleteWorkflow(
+	ctx context.Context,
+	historyClient historyservice.HistoryServiceClient,
+	sdkClient sdkclient.Client,
+	namespaceID string,
+	workflowID string,
+	runID string,
+	reason string,
+) error {
+	err := sdkClient.TerminateWorkflow(ctx, workflowID, runID, reason)
+	if _, ok := err.(*serviceerror.NotFound); err != nil && !ok {
+		return err
+	}
+	_, err = historyClient.DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId: namespaceID,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+	})
+	return err
+}
+
+// resetWorkflow resets the workflow through history service directly,
+// as the public API can't carry reapply types other than signal and none.
+func resetWorkflow(
+	ctx context.Context,
+	historyClient historyservice.HistoryServiceClient,
+	sdkClient sdkclient.Client,
+	namespaceID string,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+) error {
+	baseRunID, eventID, err := getResetPoint(ctx, sdkClient, batchParams.ResetParams, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	_, err = historyClient.ResetWorkflowExecution(ctx, &historyservice.ResetWorkflowExecutionRequest{
+		NamespaceId: namespaceID,
+		ResetRequest: &workflowservice.ResetWorkflowExecutionRequest{
+			Namespace: batchParams.Namespace,
+			WorkflowExecution: &commonpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      baseRunID,
+			},
+			Reason:                    batchParams.Reason,
+			WorkflowTaskFinishEventId: eventID,
+			RequestId:                 uuid.New(),
+		},
+		ResetReapplyType: batchParams.ResetParams.ResetReapplyType,
+	})
+	return err
+}
+
+// getResetPoint returns the run to reset and the id of the workflow task finish event to reset to.
+func getResetPoint(
+	ctx context.Context,
+	sdkClient sdkclient.Client,
+	resetParams ResetParams,
+	workflowID string,
+	runID string,
+) (string, int64, error) {
+	switch resetParams.ResetType {
+	case ResetTypeLastContinuedAsNew:
+		iter := sdkClient.GetWorkflowHistory(ctx, workflowID, runID, false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+		if !iter.HasNext() {
+			return "", 0, serviceerror.NewInvalidArgument("workflow history is empty")
+		}
+		event, err := iter.Next()
+		if err != nil {
+			return "", 0, err
+		}
+		baseRunID := event.GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunId()
+		if baseRunID == "" {
+			return "", 0, serviceerror.NewInvalidArgument("workflow run is not continued as new")
+		}
+		eventID, err := getResetEventID(ctx, sdkClient, ResetTypeLastWorkflowTask, workflowID, baseRunID)
+		return baseRunID, eventID, err
+	case ResetTypeBadBinary:
+		resp, err := sdkClient.DescribeWorkflowExecution(ctx, workflowID, runID)
+		if err != nil {
+			return "", 0, err
+		}
+		for _, point := range resp.GetWorkflowExecutionInfo().GetAutoResetPoints().GetPoints() {
+			if point.GetBinaryChecksum() == resetParams.BadBinaryChecksum && point.GetResettable() {
+				return point.GetRunId(), point.GetFirstWorkflowTaskCompletedId(), nil
+			}
+		}
+		return "", 0, serviceerror.NewInvalidArgument("unable to find any workflow task completed by the bad binary")
+	default:
+		eventID, err := getResetEventID(ctx, sdkClient, resetParams.ResetType, workflowID, runID)
+		return runID, eventID, err
+	}
+}
+
+// getResetEventID returns the id of the first or last completed workflow task, or the id of the
+// event following a scheduled workflow task if none was completed yet.
+func getResetEventID(
+	ctx context.Context,
+	sdkClient sdkclient.Client,
+	resetType string,
+	workflowID string,
+	runID string,
+) (int64, error) {
+	var eventID int64
+	iter := sdkClient.GetWorkflowHistory(ctx, workflowID, runID, false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			return 0, err
+		}
+		switch event.GetEventType() {
+		case enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED:
+			eventID = event.GetEventId()
+			if resetType == ResetTypeFirstWorkflowTask {
+				return eventID, nil
+			}
+		case enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED:
+			if eventID == 0 || resetType == ResetTypeLastWorkflowTask {
+				eventID = event.GetEventId() + 1
+			}
+		}
+	}
+	if eventID == 0 {
+		return 0, serviceerror.NewInvalidArgument("unable to find any scheduled or completed workflow task")
+	}
+	return eventID, nil
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -0,0 +1,195 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	sdkmocks "go.temporal.io/sdk/mocks"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/namespace"
+)
+
+func TestValidateParams(t *testing.T) {
+	executions := []commonpb.WorkflowExecution{{WorkflowId: "wid"}}
+	testCases := []struct {
+		name   string
+		params BatchParams
+		valid  bool
+	}{
+		{
+			name:   "query",
+			params: BatchParams{Namespace: "ns", Reason: "test", BatchType: BatchTypeTerminate, Query: "WorkflowType='foo'"},
+			valid:  true,
+		},
+		{
+			name:   "executions",
+			params: BatchParams{Namespace: "ns", Reason: "test", BatchType: BatchTypeDelete, Executions: executions},
+			valid:  true,
+		},
+		{
+			name:   "no target",
+			params: BatchParams{Namespace: "ns", Reason: "test", BatchType: BatchTypeCancel},
+			valid:  false,
+		},
+		{
+			name:   "query and executions",
+			params: BatchParams{Namespace: "ns", Reason: "test", BatchType: BatchTypeCancel, Query: "WorkflowType='foo'", Executions: executions},
+			valid:  false,
+		},
+		{
+			name:   "signal without name",
+			params: BatchParams{Namespace: "ns", Reason: "test", BatchType: BatchTypeSignal, Executions: executions},
+			valid:  false,
+		},
+		{
+			name: "reset",
+			params: BatchParams{Namespace: "ns", Reason: "test", BatchType: BatchTypeReset, Executions: executions,
+				ResetParams: ResetParams{ResetType: ResetTypeLastWorkflowTask}},
+			valid: true,
+		},
+		{
+			name:   "reset without type",
+			params: BatchParams{Namespace: "ns", Reason: "test", BatchType: BatchTypeReset, Executions: executions},
+			valid:  false,
+		},
+		{
+			name: "reset bad binary",
+			params: BatchParams{Namespace: "ns", Reason: "test", BatchType: BatchTypeReset, Executions: executions,
+				ResetParams: ResetParams{ResetType: ResetTypeBadBinary, BadBinaryChecksum: "checksum"}},
+			valid: true,
+		},
+		{
+			name: "reset bad binary without checksum",
+			params: BatchParams{Namespace: "ns", Reason: "test", BatchType: BatchTypeReset, Executions: executions,
+				ResetParams: ResetParams{ResetType: ResetTypeBadBinary}},
+			valid: false,
+		},
+		{
+			name:   "unknown type",
+			params: BatchParams{Namespace: "ns", Reason: "test", BatchType: "unknown", Executions: executions},
+			valid:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateParams(setDefaultParams(tc.params))
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGetNextPage_Executions(t *testing.T) {
+	params := BatchParams{}
+	for i := 0; i < pageSize+1; i++ {
+		params.Executions = append(params.Executions, commonpb.WorkflowExecution{WorkflowId: fmt.Sprintf("wid-%d", i)})
+	}
+
+	hbd := HeartBeatDetails{}
+	executions, _, err := getNextPage(context.Background(), params, hbd, nil)
+	require.NoError(t, err)
+	require.Len(t, executions, pageSize)
+	hbd.CurrentPage++
+	require.True(t, hasMorePages(params, hbd))
+
+	executions, _, err = getNextPage(context.Background(), params, hbd, nil)
+	require.NoError(t, err)
+	require.Equal(t, []commonpb.WorkflowExecution{{WorkflowId: fmt.Sprintf("wid-%d", pageSize)}}, executions)
+	hbd.CurrentPage++
+	require.False(t, hasMorePages(params, hbd))
+}
+
+func TestGetNamespaceID(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	namespaceRegistry := namespace.NewMockRegistry(controller)
+	namespaceRegistry.EXPECT().GetNamespaceID(namespace.Name("test-namespace")).Return(namespace.ID("test-namespace-id"), nil).Times(2)
+
+	for _, batchType := range []string{BatchTypeDelete, BatchTypeReset} {
+		namespaceID, err := getNamespaceID(namespaceRegistry, BatchParams{Namespace: "test-namespace", BatchType: batchType})
+		require.NoError(t, err)
+		require.Equal(t, "test-namespace-id", namespaceID)
+	}
+
+	namespaceID, err := getNamespaceID(namespaceRegistry, BatchParams{Namespace: "test-namespace", BatchType: BatchTypeTerminate})
+	require.NoError(t, err)
+	require.Empty(t, namespaceID)
+}
+
+func TestResetWorkflow_NamespaceID(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	historyClient := historyservicemock.NewMockHistoryServiceClient(controller)
+	sdkClient := &sdkmocks.Client{}
+	defer sdkClient.AssertExpectations(t)
+
+	sdkClient.On("DescribeWorkflowExecution", mock.Anything, "wid", "rid").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			AutoResetPoints: &workflowpb.ResetPoints{
+				Points: []*workflowpb.ResetPointInfo{{
+					BinaryChecksum:               "bad-binary",
+					RunId:                        "rid",
+					FirstWorkflowTaskCompletedId: 4,
+					Resettable:                   true,
+				}},
+			},
+		},
+	}, nil)
+	historyClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ResetWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.ResetWorkflowExecutionResponse, error) {
+			require.Equal(t, "test-namespace-id", request.GetNamespaceId())
+			require.Equal(t, "test-namespace", request.GetResetRequest().GetNamespace())
+			require.Equal(t, int64(4), request.GetResetRequest().GetWorkflowTaskFinishEventId())
+			return &historyservice.ResetWorkflowExecutionResponse{}, nil
+		})
+
+	batchParams := setDefaultParams(BatchParams{
+		Namespace: "test-namespace",
+		BatchType: BatchTypeReset,
+		Reason:    "test",
+		ResetParams: ResetParams{
+			ResetType:         ResetTypeBadBinary,
+			BadBinaryChecksum: "bad-binary",
+		},
+	})
+	err := resetWorkflow(context.Background(), historyClient, sdkClient, "test-namespace-id", batchParams, "wid", "rid")
+	require.NoError(t, err)
+}
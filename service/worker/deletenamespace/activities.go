@@ -0,0 +1,366 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	deletedNamespaceSuffix = "-deleted-"
+	// min length of namespace ID prefix in deleted namespace name.
+	minDeletedNamespaceIDLength = 5
+
+	taskQueuesPageSize = 1000
+
+	terminateReason = "Namespace is deleted"
+)
+
+type (
+	activities struct {
+		historyShardCount int32
+		storeType         string
+		metadataManager   persistence.MetadataManager
+		executionManager  persistence.ExecutionManager
+		taskManager       persistence.TaskManager
+		historyClient     historyservice.HistoryServiceClient
+		metricsClient     metrics.Client
+		logger            log.Logger
+	}
+
+	// DeleteExecutionsParams is the parameters for DeleteExecutionsActivity.
+	DeleteExecutionsParams struct {
+		NamespaceID string
+		Namespace   string
+		ShardID     int32
+		RPS         int
+		PageSize    int
+	}
+
+	// DeleteExecutionsResult is the result of DeleteExecutionsActivity.
+	DeleteExecutionsResult struct {
+		SuccessCount int
+		ErrorCount   int
+	}
+
+	deleteExecutionsHeartbeatDetails struct {
+		PageToken []byte
+		Result    DeleteExecutionsResult
+	}
+)
+
+// GetNamespaceIDActivity returns ID of the namespace which can be deleted.
+func (a *activities) GetNamespaceIDActivity(_ context.Context, nsName string) (string, error) {
+	if nsName == common.SystemLocalNamespace {
+		return "", temporal.NewNonRetryableApplicationError(fmt.Sprintf("namespace %s can't be deleted", nsName), "", nil)
+	}
+
+	resp, err := a.metadataManager.GetNamespace(&persistence.GetNamespaceRequest{Name: nsName})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return "", temporal.NewNonRetryableApplicationError(err.Error(), "", nil)
+		}
+		return "", err
+	}
+	return resp.Namespace.Info.Id, nil
+}
+
+// MarkNamespaceDeletedActivity updates namespace state to deleted. No new executions can be started after that.
+func (a *activities) MarkNamespaceDeletedActivity(_ context.Context, nsID string) error {
+	resp, err := a.metadataManager.GetNamespace(&persistence.GetNamespaceRequest{ID: nsID})
+	if err != nil {
+		return err
+	}
+	if resp.Namespace.Info.State == enumspb.NAMESPACE_STATE_DELETED {
+		return nil
+	}
+
+	metadata, err := a.metadataManager.GetMetadata()
+	if err != nil {
+		return err
+	}
+
+	resp.Namespace.Info.State = enumspb.NAMESPACE_STATE_DELETED
+	resp.Namespace.ConfigVersion++
+	err = a.metadataManager.UpdateNamespace(&persistence.UpdateNamespaceRequest{
+		Namespace:           resp.Namespace,
+		IsGlobalNamespace:   resp.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	if err != nil {
+		a.logger.Error("Unable to mark namespace as deleted.", tag.WorkflowNamespaceID(nsID), tag.Error(err))
+		return err
+	}
+	a.logger.Info("Namespace is marked as deleted.", tag.WorkflowNamespaceID(nsID), tag.WorkflowNamespace(resp.Namespace.Info.Name))
+	return nil
+}
+
+// RenameNamespaceActivity renames deleted namespace to free the name for the new namespace.
+// It returns new namespace name.
+func (a *activities) RenameNamespaceActivity(_ context.Context, nsID string) (string, error) {
+	resp, err := a.metadataManager.GetNamespace(&persistence.GetNamespaceRequest{ID: nsID})
+	if err != nil {
+		return "", err
+	}
+	nsName := resp.Namespace.Info.Name
+	if isDeletedNamespaceName(nsName, nsID) {
+		// namespace is already renamed by previous attempt
+		return nsName, nil
+	}
+
+	var deletedNsName string
+	for idLength := minDeletedNamespaceIDLength; idLength <= len(nsID); idLength++ {
+		candidate := nsName + deletedNamespaceSuffix + nsID[:idLength]
+		_, err := a.metadataManager.GetNamespace(&persistence.GetNamespaceRequest{Name: candidate})
+		if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+			deletedNsName = candidate
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	if deletedNsName == "" {
+		return "", temporal.NewNonRetryableApplicationError(fmt.Sprintf("unable to generate new name for namespace %s", nsName), "", nil)
+	}
+
+	err = a.metadataManager.RenameNamespace(&persistence.RenameNamespaceRequest{
+		PreviousName: nsName,
+		NewName:      deletedNsName,
+	})
+	if err != nil {
+		a.logger.Error("Unable to rename namespace.", tag.WorkflowNamespace(nsName), tag.Error(err))
+		return "", err
+	}
+	a.logger.Info("Namespace is renamed.", tag.WorkflowNamespace(nsName), tag.NewStringTag("deleted-namespace", deletedNsName))
+	return deletedNsName, nil
+}
+
+// GetShardCountActivity returns number of history shards.
+func (a *activities) GetShardCountActivity(_ context.Context) (int32, error) {
+	return a.historyShardCount, nil
+}
+
+// DeleteExecutionsActivity deletes all workflow executions of the namespace from one history shard.
+// Running executions are terminated first. Executions are deleted asynchronously by history service.
+func (a *activities) DeleteExecutionsActivity(ctx context.Context, params DeleteExecutionsParams) (DeleteExecutionsResult, error) {
+	rateLimiter := quotas.NewRateLimiter(float64(params.RPS), params.RPS)
+
+	var details deleteExecutionsHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			details = deleteExecutionsHeartbeatDetails{}
+		}
+	}
+
+	for {
+		resp, err := a.executionManager.ListConcreteExecutions(&persistence.ListConcreteExecutionsRequest{
+			ShardID:   params.ShardID,
+			PageSize:  params.PageSize,
+			PageToken: details.PageToken,
+		})
+		if err != nil {
+			a.logger.Error("Unable to list workflow executions.", tag.ShardID(params.ShardID), tag.Error(err))
+			return details.Result, err
+		}
+
+		for _, ms := range resp.States {
+			if ms.GetExecutionInfo().GetNamespaceId() != params.NamespaceID {
+				continue
+			}
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return details.Result, err
+			}
+
+			execution := &commonpb.WorkflowExecution{
+				WorkflowId: ms.GetExecutionInfo().GetWorkflowId(),
+				RunId:      ms.GetExecutionState().GetRunId(),
+			}
+			if err := a.deleteExecution(ctx, params, execution, ms.GetExecutionState().GetState()); err != nil {
+				a.logger.Error("Unable to delete workflow execution.",
+					tag.WorkflowNamespaceID(params.NamespaceID),
+					tag.WorkflowID(execution.GetWorkflowId()),
+					tag.WorkflowRunID(execution.GetRunId()),
+					tag.Error(err))
+				a.metricsClient.IncCounter(metrics.DeleteNamespaceWorkflowScope, metrics.DeleteExecutionsFailureCount)
+				details.Result.ErrorCount++
+				continue
+			}
+			a.metricsClient.IncCounter(metrics.DeleteNamespaceWorkflowScope, metrics.DeleteExecutionsSuccessCount)
+			details.Result.SuccessCount++
+		}
+
+		details.PageToken = resp.PageToken
+		activity.RecordHeartbeat(ctx, details)
+		if len(details.PageToken) == 0 {
+			break
+		}
+	}
+
+	return details.Result, nil
+}
+
+func (a *activities) deleteExecution(
+	ctx context.Context,
+	params DeleteExecutionsParams,
+	execution *commonpb.WorkflowExecution,
+	state enumsspb.WorkflowExecutionState,
+) error {
+	if state == enumsspb.WORKFLOW_EXECUTION_STATE_CREATED || state == enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING {
+		_, err := a.historyClient.TerminateWorkflowExecution(ctx, &historyservice.TerminateWorkflowExecutionRequest{
+			NamespaceId: params.NamespaceID,
+			TerminateRequest: &workflowservice.TerminateWorkflowExecutionRequest{
+				Namespace:         params.Namespace,
+				WorkflowExecution: execution,
+				Reason:            terminateReason,
+				Identity:          WorkflowName,
+			},
+		})
+		switch err.(type) {
+		case nil, *serviceerror.NotFound:
+			// NotFound means the workflow execution is already closed
+		default:
+			return err
+		}
+	}
+
+	_, err := a.historyClient.DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId:       params.NamespaceID,
+		WorkflowExecution: execution,
+	})
+	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+		// workflow execution is already deleted
+		return nil
+	}
+	return err
+}
+
+// DeleteTaskQueuesActivity deletes all task queues of the namespace together with their tasks.
+// It returns number of deleted task queues.
+func (a *activities) DeleteTaskQueuesActivity(ctx context.Context, nsID string) (int, error) {
+	if a.storeType != config.StoreTypeSQL {
+		// NoSQL stores can't list task queues, tasks there expire with TTL.
+		a.logger.Info("Persistence store doesn't support task queue listing. Skipping task queues deletion.", tag.WorkflowNamespaceID(nsID))
+		return 0, nil
+	}
+
+	deletedCount := 0
+	var pageToken []byte
+	for {
+		resp, err := a.taskManager.ListTaskQueue(&persistence.ListTaskQueueRequest{
+			PageSize:  taskQueuesPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return deletedCount, err
+		}
+
+		for _, item := range resp.Items {
+			if item.Data.GetNamespaceId() != nsID {
+				continue
+			}
+			key := &persistence.TaskQueueKey{
+				NamespaceID:   nsID,
+				TaskQueueName: item.Data.GetName(),
+				TaskQueueType: item.Data.GetTaskType(),
+			}
+			if err := a.deleteTaskQueue(key, item.RangeID); err != nil {
+				a.logger.Error("Unable to delete task queue.", tag.WorkflowNamespaceID(nsID), tag.WorkflowTaskQueueName(key.TaskQueueName), tag.Error(err))
+				return deletedCount, err
+			}
+			deletedCount++
+		}
+
+		pageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, deletedCount)
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	return deletedCount, nil
+}
+
+func (a *activities) deleteTaskQueue(key *persistence.TaskQueueKey, rangeID int64) error {
+	for {
+		n, err := a.taskManager.CompleteTasksLessThan(&persistence.CompleteTasksLessThanRequest{
+			NamespaceID:   key.NamespaceID,
+			TaskQueueName: key.TaskQueueName,
+			TaskType:      key.TaskQueueType,
+			TaskID:        math.MaxInt64,
+			Limit:         taskQueuesPageSize,
+		})
+		if err != nil {
+			return err
+		}
+		if n < taskQueuesPageSize {
+			break
+		}
+	}
+	return a.taskManager.DeleteTaskQueue(&persistence.DeleteTaskQueueRequest{
+		TaskQueue: key,
+		RangeID:   rangeID,
+	})
+}
+
+// DeleteNamespaceActivity deletes namespace metadata.
+func (a *activities) DeleteNamespaceActivity(_ context.Context, nsID string) error {
+	err := a.metadataManager.DeleteNamespace(&persistence.DeleteNamespaceRequest{ID: nsID})
+	if err != nil {
+		a.logger.Error("Unable to delete namespace from persistence.", tag.WorkflowNamespaceID(nsID), tag.Error(err))
+		return err
+	}
+	a.metricsClient.IncCounter(metrics.DeleteNamespaceWorkflowScope, metrics.DeleteNamespaceSuccessCount)
+	a.logger.Info("Namespace is deleted.", tag.WorkflowNamespaceID(nsID))
+	return nil
+}
+
+func isDeletedNamespaceName(nsName string, nsID string) bool {
+	idx := strings.LastIndex(nsName, deletedNamespaceSuffix)
+	if idx < 0 {
+		return false
+	}
+	idPrefix := nsName[idx+len(deletedNamespaceSuffix):]
+	return len(idPrefix) >= minDeletedNamespaceIDLength && strings.HasPrefix(nsID, idPrefix)
+}
@@ -0,0 +1,96 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	initParams struct {
+		fx.In
+		PersistenceConfig *config.Persistence
+		MetadataManager   persistence.MetadataManager
+		ExecutionManager  persistence.ExecutionManager
+		TaskManager       persistence.TaskManager
+		HistoryClient     historyservice.HistoryServiceClient
+		MetricsClient     metrics.Client
+		Logger            log.Logger
+	}
+
+	fxResult struct {
+		fx.Out
+		Component workercommon.WorkerComponent `group:"workerComponent"`
+	}
+
+	deleteNamespaceComponent struct {
+		initParams
+	}
+)
+
+var Module = fx.Options(
+	fx.Provide(NewResult),
+)
+
+func NewResult(params initParams) fxResult {
+	component := &deleteNamespaceComponent{
+		initParams: params,
+	}
+	return fxResult{
+		Component: component,
+	}
+}
+
+func (wc *deleteNamespaceComponent) Register(worker sdkworker.Worker) {
+	worker.RegisterWorkflowWithOptions(DeleteNamespaceWorkflow, workflow.RegisterOptions{Name: WorkflowName})
+	worker.RegisterWorkflowWithOptions(ReclaimResourcesWorkflow, workflow.RegisterOptions{Name: ReclaimResourcesWorkflowName})
+	worker.RegisterActivity(wc.activities())
+}
+
+func (wc *deleteNamespaceComponent) DedicatedWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (wc *deleteNamespaceComponent) activities() *activities {
+	return &activities{
+		historyShardCount: wc.PersistenceConfig.NumHistoryShards,
+		storeType:         wc.PersistenceConfig.DefaultStoreType(),
+		metadataManager:   wc.MetadataManager,
+		executionManager:  wc.ExecutionManager,
+		taskManager:       wc.TaskManager,
+		historyClient:     wc.HistoryClient,
+		metricsClient:     wc.MetricsClient,
+		logger:            wc.Logger,
+	}
+}
@@ -0,0 +1,266 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	// WorkflowName is the name of the workflow which marks namespace as deleted and starts ReclaimResourcesWorkflow.
+	WorkflowName = "temporal-sys-delete-namespace-workflow"
+	// ReclaimResourcesWorkflowName is the name of the workflow which deletes all namespace data and namespace metadata.
+	ReclaimResourcesWorkflowName = "temporal-sys-reclaim-namespace-resources-workflow"
+	// ProgressQueryType is the query type which returns ReclaimResourcesProgress of ReclaimResourcesWorkflow.
+	ProgressQueryType = "progress"
+
+	defaultDeleteExecutionsRPS    = 100
+	defaultListExecutionsPageSize = 1000
+	// number of shards to be processed before continue as new.
+	defaultShardsPerExecution = 256
+	// max number of passes over all shards before workflow gives up.
+	maxPassCount = 10
+	// executions are deleted asynchronously by history service, next pass will check that they are gone.
+	passInterval = time.Minute
+	// wait for namespace registry to pick up the deleted state everywhere before looking for executions.
+	namespaceCacheRefreshDelay = namespace.CacheRefreshInterval + 5*time.Second
+)
+
+type (
+	// DeleteNamespaceWorkflowParams is the parameters for delete namespace workflow.
+	DeleteNamespaceWorkflowParams struct {
+		Namespace string
+		// Max RPS of delete requests to history service. Default is used if 0.
+		DeleteExecutionsRPS int
+	}
+
+	// DeleteNamespaceWorkflowResult is the result of delete namespace workflow.
+	DeleteNamespaceWorkflowResult struct {
+		DeletedNamespaceID         string
+		DeletedNamespace           string
+		ReclaimResourcesWorkflowID string
+	}
+
+	// ReclaimResourcesParams is the parameters for reclaim resources workflow.
+	ReclaimResourcesParams struct {
+		NamespaceID            string
+		Namespace              string
+		DeleteExecutionsRPS    int
+		ListExecutionsPageSize int
+		ShardsPerExecution     int
+
+		// used by continue as new
+		NextShardID int32
+		Progress    ReclaimResourcesProgress
+	}
+
+	// ReclaimResourcesProgress is returned by ProgressQueryType query.
+	ReclaimResourcesProgress struct {
+		TotalShards int32
+		// Pass over all shards, executions which are not deleted yet are picked up by the next pass.
+		Pass int
+		// Shards processed in the current pass.
+		ProcessedShards int32
+		// Executions found in the current pass.
+		PassExecutionCount int
+		// Totals across all passes.
+		DeletedExecutionCount int
+		FailedExecutionCount  int
+		DeletedTaskQueueCount int
+		Completed             bool
+	}
+)
+
+var (
+	localActivityOptions = workflow.LocalActivityOptions{
+		StartToCloseTimeout: 10 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 1 * time.Second,
+			MaximumInterval: 10 * time.Second,
+		},
+	}
+
+	deleteExecutionsActivityOptions = workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 1 * time.Second,
+			MaximumInterval: 10 * time.Second,
+		},
+	}
+
+	ErrUnableToExecuteActivity  = errors.New("unable to execute activity")
+	ErrUnableToDeleteExecutions = errors.New("unable to delete all workflow executions")
+)
+
+// DeleteNamespaceWorkflow marks namespace as deleted, renames it, and starts ReclaimResourcesWorkflow
+// which deletes namespace data asynchronously.
+func DeleteNamespaceWorkflow(ctx workflow.Context, params DeleteNamespaceWorkflowParams) (DeleteNamespaceWorkflowResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Workflow started.", "wf-type", WorkflowName, "namespace", params.Namespace)
+
+	var a *activities
+	var result DeleteNamespaceWorkflowResult
+	ctx1 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+
+	var nsID string
+	err := workflow.ExecuteLocalActivity(ctx1, a.GetNamespaceIDActivity, params.Namespace).Get(ctx, &nsID)
+	if err != nil {
+		return result, fmt.Errorf("%w: GetNamespaceIDActivity: %v", ErrUnableToExecuteActivity, err)
+	}
+
+	err = workflow.ExecuteLocalActivity(ctx1, a.MarkNamespaceDeletedActivity, nsID).Get(ctx, nil)
+	if err != nil {
+		return result, fmt.Errorf("%w: MarkNamespaceDeletedActivity: %v", ErrUnableToExecuteActivity, err)
+	}
+
+	var deletedNamespace string
+	err = workflow.ExecuteLocalActivity(ctx1, a.RenameNamespaceActivity, nsID).Get(ctx, &deletedNamespace)
+	if err != nil {
+		return result, fmt.Errorf("%w: RenameNamespaceActivity: %v", ErrUnableToExecuteActivity, err)
+	}
+
+	reclaimResourcesWorkflowID := fmt.Sprintf("%s/%s", ReclaimResourcesWorkflowName, nsID)
+	ctx2 := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		WorkflowID:        reclaimResourcesWorkflowID,
+		ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_ABANDON,
+	})
+	reclaimResourcesFuture := workflow.ExecuteChildWorkflow(ctx2, ReclaimResourcesWorkflowName, ReclaimResourcesParams{
+		NamespaceID:         nsID,
+		Namespace:           deletedNamespace,
+		DeleteExecutionsRPS: params.DeleteExecutionsRPS,
+	})
+	err = reclaimResourcesFuture.GetChildWorkflowExecution().Get(ctx, nil)
+	var alreadyStartedErr *temporal.ChildWorkflowExecutionAlreadyStartedError
+	if err != nil && !errors.As(err, &alreadyStartedErr) {
+		return result, fmt.Errorf("unable to start %s workflow: %w", ReclaimResourcesWorkflowName, err)
+	}
+
+	result.DeletedNamespaceID = nsID
+	result.DeletedNamespace = deletedNamespace
+	result.ReclaimResourcesWorkflowID = reclaimResourcesWorkflowID
+	logger.Info("Workflow finished successfully.", "wf-type", WorkflowName, "namespace", params.Namespace, "deleted-namespace", deletedNamespace)
+	return result, nil
+}
+
+// ReclaimResourcesWorkflow deletes all workflow executions and task queues of the deleted namespace
+// and then namespace metadata itself. Executions are found by scanning all history shards.
+// Because executions are deleted asynchronously by history service, shards are scanned
+// again until a pass finds no executions.
+func ReclaimResourcesWorkflow(ctx workflow.Context, params ReclaimResourcesParams) (ReclaimResourcesProgress, error) {
+	logger := workflow.GetLogger(ctx)
+	if params.DeleteExecutionsRPS <= 0 {
+		params.DeleteExecutionsRPS = defaultDeleteExecutionsRPS
+	}
+	if params.ListExecutionsPageSize <= 0 {
+		params.ListExecutionsPageSize = defaultListExecutionsPageSize
+	}
+	if params.ShardsPerExecution <= 0 {
+		params.ShardsPerExecution = defaultShardsPerExecution
+	}
+
+	progress := params.Progress
+	err := workflow.SetQueryHandler(ctx, ProgressQueryType, func() (ReclaimResourcesProgress, error) {
+		return progress, nil
+	})
+	if err != nil {
+		return progress, err
+	}
+
+	var a *activities
+	ctx1 := workflow.WithLocalActivityOptions(ctx, localActivityOptions)
+	ctx2 := workflow.WithActivityOptions(ctx, deleteExecutionsActivityOptions)
+
+	if progress.Pass == 0 {
+		logger.Info("Workflow started.", "wf-type", ReclaimResourcesWorkflowName, "namespace", params.Namespace)
+		err = workflow.ExecuteLocalActivity(ctx1, a.GetShardCountActivity).Get(ctx, &progress.TotalShards)
+		if err != nil {
+			return progress, fmt.Errorf("%w: GetShardCountActivity: %v", ErrUnableToExecuteActivity, err)
+		}
+		progress.Pass = 1
+		params.NextShardID = 1
+		_ = workflow.Sleep(ctx, namespaceCacheRefreshDelay)
+	}
+
+	for processed := 0; processed < params.ShardsPerExecution; processed++ {
+		if params.NextShardID > progress.TotalShards {
+			if progress.PassExecutionCount == 0 {
+				break
+			}
+			if progress.Pass >= maxPassCount {
+				return progress, fmt.Errorf("%w: %d executions are still found after %d passes", ErrUnableToDeleteExecutions, progress.PassExecutionCount, progress.Pass)
+			}
+			_ = workflow.Sleep(ctx, passInterval)
+			progress.Pass++
+			progress.ProcessedShards = 0
+			progress.PassExecutionCount = 0
+			params.NextShardID = 1
+		}
+
+		var result DeleteExecutionsResult
+		err = workflow.ExecuteActivity(ctx2, a.DeleteExecutionsActivity, DeleteExecutionsParams{
+			NamespaceID: params.NamespaceID,
+			Namespace:   params.Namespace,
+			ShardID:     params.NextShardID,
+			RPS:         params.DeleteExecutionsRPS,
+			PageSize:    params.ListExecutionsPageSize,
+		}).Get(ctx, &result)
+		if err != nil {
+			return progress, fmt.Errorf("%w: DeleteExecutionsActivity: %v", ErrUnableToExecuteActivity, err)
+		}
+		progress.PassExecutionCount += result.SuccessCount + result.ErrorCount
+		progress.DeletedExecutionCount += result.SuccessCount
+		progress.FailedExecutionCount += result.ErrorCount
+		progress.ProcessedShards++
+		params.NextShardID++
+	}
+
+	if params.NextShardID <= progress.TotalShards || progress.PassExecutionCount > 0 {
+		params.Progress = progress
+		return progress, workflow.NewContinueAsNewError(ctx, ReclaimResourcesWorkflow, params)
+	}
+
+	err = workflow.ExecuteActivity(ctx2, a.DeleteTaskQueuesActivity, params.NamespaceID).Get(ctx, &progress.DeletedTaskQueueCount)
+	if err != nil {
+		return progress, fmt.Errorf("%w: DeleteTaskQueuesActivity: %v", ErrUnableToExecuteActivity, err)
+	}
+
+	err = workflow.ExecuteLocalActivity(ctx1, a.DeleteNamespaceActivity, params.NamespaceID).Get(ctx, nil)
+	if err != nil {
+		return progress, fmt.Errorf("%w: DeleteNamespaceActivity: %v", ErrUnableToExecuteActivity, err)
+	}
+
+	progress.Completed = true
+	logger.Info("Workflow finished successfully.", "wf-type", ReclaimResourcesWorkflowName, "namespace", params.Namespace, "deleted-executions", progress.DeletedExecutionCount)
+	return progress, nil
+}
@@ -0,0 +1,146 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func Test_DeleteNamespaceWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.RegisterWorkflowWithOptions(ReclaimResourcesWorkflow, workflow.RegisterOptions{Name: ReclaimResourcesWorkflowName})
+
+	namespaceID := uuid.New()
+
+	var a *activities
+	env.OnActivity(a.GetNamespaceIDActivity, mock.Anything, "namespace").Return(namespaceID, nil).Once()
+	env.OnActivity(a.MarkNamespaceDeletedActivity, mock.Anything, namespaceID).Return(nil).Once()
+	env.OnActivity(a.RenameNamespaceActivity, mock.Anything, namespaceID).Return("namespace-deleted-"+namespaceID[:5], nil).Once()
+	env.OnWorkflow(ReclaimResourcesWorkflowName, mock.Anything, ReclaimResourcesParams{
+		NamespaceID: namespaceID,
+		Namespace:   "namespace-deleted-" + namespaceID[:5],
+	}).Return(ReclaimResourcesProgress{Completed: true}, nil).Once()
+
+	env.ExecuteWorkflow(DeleteNamespaceWorkflow, DeleteNamespaceWorkflowParams{
+		Namespace: "namespace",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result DeleteNamespaceWorkflowResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, namespaceID, result.DeletedNamespaceID)
+	require.Equal(t, "namespace-deleted-"+namespaceID[:5], result.DeletedNamespace)
+	require.Equal(t, ReclaimResourcesWorkflowName+"/"+namespaceID, result.ReclaimResourcesWorkflowID)
+	env.AssertExpectations(t)
+}
+
+func Test_DeleteNamespaceWorkflow_NamespaceNotFound(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetNamespaceIDActivity, mock.Anything, "namespace").Return("", temporal.NewNonRetryableApplicationError("namespace not found", "", nil)).Once()
+
+	env.ExecuteWorkflow(DeleteNamespaceWorkflow, DeleteNamespaceWorkflowParams{
+		Namespace: "namespace",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "namespace not found")
+	env.AssertExpectations(t)
+}
+
+func Test_ReclaimResourcesWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	namespaceID := uuid.New()
+
+	var a *activities
+	env.OnActivity(a.GetShardCountActivity, mock.Anything).Return(int32(2), nil).Once()
+	// First pass finds executions on shard 1, second pass finds nothing.
+	env.OnActivity(a.DeleteExecutionsActivity, mock.Anything, DeleteExecutionsParams{
+		NamespaceID: namespaceID,
+		Namespace:   "namespace",
+		ShardID:     1,
+		RPS:         defaultDeleteExecutionsRPS,
+		PageSize:    defaultListExecutionsPageSize,
+	}).Return(DeleteExecutionsResult{SuccessCount: 10}, nil).Once()
+	env.OnActivity(a.DeleteExecutionsActivity, mock.Anything, mock.Anything).Return(DeleteExecutionsResult{}, nil).Times(3)
+	env.OnActivity(a.DeleteTaskQueuesActivity, mock.Anything, namespaceID).Return(3, nil).Once()
+	env.OnActivity(a.DeleteNamespaceActivity, mock.Anything, namespaceID).Return(nil).Once()
+
+	env.ExecuteWorkflow(ReclaimResourcesWorkflow, ReclaimResourcesParams{
+		NamespaceID: namespaceID,
+		Namespace:   "namespace",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var progress ReclaimResourcesProgress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	require.Equal(t, ReclaimResourcesProgress{
+		TotalShards:           2,
+		Pass:                  2,
+		ProcessedShards:       2,
+		DeletedExecutionCount: 10,
+		DeletedTaskQueueCount: 3,
+		Completed:             true,
+	}, progress)
+	env.AssertExpectations(t)
+}
+
+func Test_ReclaimResourcesWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetShardCountActivity, mock.Anything).Return(int32(4), nil).Once()
+	env.OnActivity(a.DeleteExecutionsActivity, mock.Anything, mock.Anything).Return(DeleteExecutionsResult{}, nil).Times(2)
+
+	env.ExecuteWorkflow(ReclaimResourcesWorkflow, ReclaimResourcesParams{
+		NamespaceID:        uuid.New(),
+		Namespace:          "namespace",
+		ShardsPerExecution: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	require.True(t, workflow.IsContinueAsNewError(err))
+	env.AssertExpectations(t)
+}
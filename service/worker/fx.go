@@ -28,25 +28,35 @@ import (
 	"context"
 
 	"go.uber.org/fx"
+	"google.golang.org/grpc"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/rpc"
 	"go.temporal.io/server/service"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/deletenamespace"
 	"go.temporal.io/server/service/worker/migration"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 var Module = fx.Options(
 	migration.Module,
 	addsearchattributes.Module,
+	scheduler.Module,
+	deletenamespace.Module,
 	resource.Module,
 	fx.Provide(ParamsExpandProvider),
 	fx.Provide(dynamicconfig.NewCollection),
 	fx.Provide(ThrottledLoggerRpsFnProvider),
 	fx.Provide(NewConfig),
 	fx.Provide(PersistenceMaxQpsProvider),
+	fx.Provide(GrpcServerOptionsProvider),
+	fx.Provide(NewHandler),
 	fx.Provide(NewService),
 	fx.Provide(NewWorkerManager),
 	fx.Invoke(ServiceLifetimeHooks),
@@ -66,6 +76,23 @@ func PersistenceMaxQpsProvider(
 	return service.PersistenceMaxQpsFn(serviceConfig.PersistenceMaxQPS, serviceConfig.PersistenceGlobalMaxQPS)
 }
 
+// GrpcServerOptionsProvider returns the options of the internode gRPC server
+// worker hosts expose for cluster administration.
+func GrpcServerOptionsProvider(
+	logger log.Logger,
+	rpcFactory common.RPCFactory,
+) []grpc.ServerOption {
+	grpcServerOptions, err := rpcFactory.GetInternodeGRPCServerOptions()
+	if err != nil {
+		logger.Fatal("creating gRPC server options failed", tag.Error(err))
+	}
+
+	return append(
+		grpcServerOptions,
+		grpc.ChainUnaryInterceptor(rpc.ServiceErrorInterceptor),
+	)
+}
+
 func ServiceLifetimeHooks(
 	lc fx.Lifecycle,
 	svcStoppedCh chan struct{},
@@ -0,0 +1,72 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"context"
+
+	"go.temporal.io/server/api/workerservice/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/resource"
+)
+
+type (
+	// Handler - gRPC handler interface for workerservice
+	Handler struct {
+		logger              log.Logger
+		dynamicConfigClient dynamicconfig.Client
+	}
+)
+
+var (
+	_ workerservice.WorkerServiceServer = (*Handler)(nil)
+)
+
+// NewHandler creates a gRPC handler for the workerservice
+func NewHandler(
+	logger resource.SnTaggedLogger,
+	dynamicConfigClient dynamicconfig.Client,
+) *Handler {
+	return &Handler{
+		logger:              logger,
+		dynamicConfigClient: dynamicConfigClient,
+	}
+}
+
+// RefreshDynamicConfig reloads dynamic config stored in persistence on this host
+func (h *Handler) RefreshDynamicConfig(
+	_ context.Context,
+	_ *workerservice.RefreshDynamicConfigRequest,
+) (_ *workerservice.RefreshDynamicConfigResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	if refresher, ok := h.dynamicConfigClient.(dynamicconfig.Refresher); ok {
+		if err := refresher.Refresh(); err != nil {
+			return nil, err
+		}
+	}
+	return &workerservice.RefreshDynamicConfigResponse{}, nil
+}
@@ -29,6 +29,7 @@ import (
 	sdkworker "go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -42,6 +43,7 @@ type (
 	initParams struct {
 		fx.In
 		PersistenceConfig *config.Persistence
+		ClusterMetadata   cluster.Metadata
 		ExecutionManager  persistence.ExecutionManager
 		NamespaceRegistry namespace.Registry
 		HistoryClient     historyservice.HistoryServiceClient
@@ -76,6 +78,7 @@ func NewResult(params initParams) fxResult {
 func (wc *replicationWorkerComponent) Register(worker sdkworker.Worker) {
 	worker.RegisterWorkflowWithOptions(ForceReplicationWorkflow, workflow.RegisterOptions{Name: forceReplicationWorkflowName})
 	worker.RegisterWorkflowWithOptions(NamespaceHandoverWorkflow, workflow.RegisterOptions{Name: namespaceHandoverWorkflowName})
+	worker.RegisterWorkflowWithOptions(ShardCountMigrationWorkflow, workflow.RegisterOptions{Name: ShardCountMigrationWorkflowName})
 	worker.RegisterActivity(wc.activities())
 }
 
@@ -87,6 +90,7 @@ func (wc *replicationWorkerComponent) DedicatedWorkerOptions() *workercommon.Ded
 func (wc *replicationWorkerComponent) activities() *activities {
 	return &activities{
 		historyShardCount: wc.PersistenceConfig.NumHistoryShards,
+		clusterMetadata:   wc.ClusterMetadata,
 		executionManager:  wc.ExecutionManager,
 		namespaceRegistry: wc.NamespaceRegistry,
 		historyClient:     wc.HistoryClient,
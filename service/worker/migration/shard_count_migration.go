@@ -0,0 +1,237 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	// ShardCountMigrationWorkflowName is the registered name of ShardCountMigrationWorkflow
+	ShardCountMigrationWorkflowName = "shard-count-migration"
+	// ShardCountMigrationWorkflowID is the workflow id used for shard count migration in the system namespace
+	ShardCountMigrationWorkflowID = "temporal-sys-shard-count-migration"
+	// ShardCountMigrationProgressQuery returns the ShardCountMigrationProgress of a shard count migration
+	ShardCountMigrationProgressQuery = "progress"
+
+	NamespaceMigrationStatePending     = "Pending"
+	NamespaceMigrationStateReplicating = "Replicating"
+	NamespaceMigrationStateHandingOver = "HandingOver"
+	NamespaceMigrationStateCompleted   = "Completed"
+	NamespaceMigrationStateFailed      = "Failed"
+)
+
+type (
+	// ShardCountMigrationParams describes a migration of namespaces from this cluster
+	// to a remote cluster which has a different number of history shards.
+	ShardCountMigrationParams struct {
+		Namespaces    []string
+		RemoteCluster string
+
+		// passed through to ForceReplicationWorkflow
+		ConcurrentActivityCount int
+		RpsPerActivity          int
+
+		// passed through to NamespaceHandoverWorkflow
+		AllowedLaggingSeconds  int
+		HandoverTimeoutSeconds int
+	}
+
+	// ShardCountMigrationProgress is the result of ShardCountMigrationProgressQuery
+	ShardCountMigrationProgress struct {
+		SourceShardCount int32
+		TargetShardCount int32
+		Namespaces       []NamespaceMigrationProgress
+		Error            string
+	}
+
+	NamespaceMigrationProgress struct {
+		Namespace string
+		State     string
+	}
+
+	verifyShardCountMigrationRequest struct {
+		Namespaces    []string
+		RemoteCluster string
+	}
+
+	verifyShardCountMigrationResponse struct {
+		SourceShardCount int32
+		TargetShardCount int32
+	}
+)
+
+// ShardCountMigrationWorkflow moves namespaces to a remote cluster which has a different number of
+// history shards. Namespaces are migrated one at a time: existing workflows are force replicated
+// and then the namespace is handed over to the remote cluster.
+func ShardCountMigrationWorkflow(ctx workflow.Context, params ShardCountMigrationParams) error {
+	if len(params.Namespaces) == 0 {
+		return errors.New("InvalidArgument: Namespaces is required")
+	}
+	if len(params.RemoteCluster) == 0 {
+		return errors.New("InvalidArgument: RemoteCluster is required")
+	}
+
+	progress := ShardCountMigrationProgress{
+		Namespaces: make([]NamespaceMigrationProgress, len(params.Namespaces)),
+	}
+	for i, ns := range params.Namespaces {
+		progress.Namespaces[i] = NamespaceMigrationProgress{
+			Namespace: ns,
+			State:     NamespaceMigrationStatePending,
+		}
+	}
+	if err := workflow.SetQueryHandler(ctx, ShardCountMigrationProgressQuery, func() (ShardCountMigrationProgress, error) {
+		return progress, nil
+	}); err != nil {
+		return err
+	}
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Second * 10,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: time.Second,
+			MaximumInterval: time.Second * 10,
+		},
+	}
+	ctx1 := workflow.WithActivityOptions(ctx, ao)
+
+	// ** Step 1, make sure the remote cluster can take over the namespaces **
+	var a *activities
+	var verifyResp verifyShardCountMigrationResponse
+	err := workflow.ExecuteActivity(ctx1, a.VerifyShardCountMigration, verifyShardCountMigrationRequest{
+		Namespaces:    params.Namespaces,
+		RemoteCluster: params.RemoteCluster,
+	}).Get(ctx1, &verifyResp)
+	if err != nil {
+		progress.Error = err.Error()
+		return err
+	}
+	progress.SourceShardCount = verifyResp.SourceShardCount
+	progress.TargetShardCount = verifyResp.TargetShardCount
+
+	// ** Step 2, replicate and hand over namespaces one by one **
+	for i, ns := range params.Namespaces {
+		if err := migrateNamespace(ctx, params, ns, func(state string) {
+			progress.Namespaces[i].State = state
+		}); err != nil {
+			progress.Namespaces[i].State = NamespaceMigrationStateFailed
+			progress.Error = err.Error()
+			return err
+		}
+		progress.Namespaces[i].State = NamespaceMigrationStateCompleted
+	}
+
+	return nil
+}
+
+func migrateNamespace(
+	ctx workflow.Context,
+	params ShardCountMigrationParams,
+	ns string,
+	updateState func(state string),
+) error {
+	parentID := workflow.GetInfo(ctx).WorkflowExecution.ID
+
+	updateState(NamespaceMigrationStateReplicating)
+	ctx1 := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		WorkflowID: fmt.Sprintf("%s-%s-%s", parentID, forceReplicationWorkflowName, ns),
+	})
+	err := workflow.ExecuteChildWorkflow(ctx1, forceReplicationWorkflowName, ForceReplicationParams{
+		Namespace:               ns,
+		ConcurrentActivityCount: params.ConcurrentActivityCount,
+		RpsPerActivity:          params.RpsPerActivity,
+	}).Get(ctx1, nil)
+	if err != nil {
+		return err
+	}
+
+	updateState(NamespaceMigrationStateHandingOver)
+	ctx2 := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		WorkflowID: fmt.Sprintf("%s-%s-%s", parentID, namespaceHandoverWorkflowName, ns),
+	})
+	return workflow.ExecuteChildWorkflow(ctx2, namespaceHandoverWorkflowName, NamespaceHandoverParams{
+		Namespace:              ns,
+		RemoteCluster:          params.RemoteCluster,
+		AllowedLaggingSeconds:  params.AllowedLaggingSeconds,
+		HandoverTimeoutSeconds: params.HandoverTimeoutSeconds,
+	}).Get(ctx2, nil)
+}
+
+// VerifyShardCountMigration checks that the remote cluster is connected and has a different but
+// compatible number of history shards, and that every namespace can be handed over to it.
+func (a *activities) VerifyShardCountMigration(ctx context.Context, request verifyShardCountMigrationRequest) (*verifyShardCountMigrationResponse, error) {
+	clusterInfo, ok := a.clusterMetadata.GetAllClusterInfo()[request.RemoteCluster]
+	if !ok || !clusterInfo.Enabled {
+		return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("remote cluster %s is not connected", request.RemoteCluster), "", nil)
+	}
+	if clusterInfo.ShardCount == 0 {
+		// remote cluster metadata has not been refreshed yet
+		return nil, fmt.Errorf("history shard count of remote cluster %s is unknown", request.RemoteCluster)
+	}
+	if clusterInfo.ShardCount == a.historyShardCount {
+		return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("remote cluster %s has the same number of history shards: %d", request.RemoteCluster, a.historyShardCount), "", nil)
+	}
+	if clusterInfo.ShardCount < a.historyShardCount {
+		return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("remote cluster %s has fewer history shards: %d < %d", request.RemoteCluster, clusterInfo.ShardCount, a.historyShardCount), "", nil)
+	}
+	if err := common.VerifyShardCountCompatibility(a.historyShardCount, clusterInfo.ShardCount); err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "", nil)
+	}
+
+	currentCluster := a.clusterMetadata.GetCurrentClusterName()
+	for _, ns := range request.Namespaces {
+		nsEntry, err := a.namespaceRegistry.GetNamespace(namespace.Name(ns))
+		if err != nil {
+			return nil, err
+		}
+		if !nsEntry.IsGlobalNamespace() {
+			return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("namespace %s is not a global namespace", ns), "", nil)
+		}
+		if nsEntry.ActiveClusterName() != currentCluster {
+			return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("namespace %s is not active in current cluster %s", ns, currentCluster), "", nil)
+		}
+		replicated := false
+		for _, clusterName := range nsEntry.ClusterNames() {
+			replicated = replicated || clusterName == request.RemoteCluster
+		}
+		if !replicated {
+			return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("namespace %s is not replicated to remote cluster %s", ns, request.RemoteCluster), "", nil)
+		}
+	}
+
+	return &verifyShardCountMigrationResponse{
+		SourceShardCount: a.historyShardCount,
+		TargetShardCount: clusterInfo.ShardCount,
+	}, nil
+}
@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/namespace"
+)
+
+func newShardCountMigrationTestEnv() *testsuite.TestWorkflowEnvironment {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(ForceReplicationWorkflow, workflow.RegisterOptions{Name: forceReplicationWorkflowName})
+	env.RegisterWorkflowWithOptions(NamespaceHandoverWorkflow, workflow.RegisterOptions{Name: namespaceHandoverWorkflowName})
+	return env
+}
+
+func TestShardCountMigrationWorkflow(t *testing.T) {
+	env := newShardCountMigrationTestEnv()
+
+	var a *activities
+	env.OnActivity(a.VerifyShardCountMigration, mock.Anything, verifyShardCountMigrationRequest{
+		Namespaces:    []string{"ns-1", "ns-2"},
+		RemoteCluster: "new-cluster",
+	}).Return(&verifyShardCountMigrationResponse{SourceShardCount: 4, TargetShardCount: 8}, nil).Once()
+	env.OnWorkflow(forceReplicationWorkflowName, mock.Anything, mock.Anything).Return(nil).Times(2)
+	env.OnWorkflow(namespaceHandoverWorkflowName, mock.Anything, mock.MatchedBy(func(params NamespaceHandoverParams) bool {
+		return params.RemoteCluster == "new-cluster"
+	})).Return(nil).Times(2)
+
+	env.ExecuteWorkflow(ShardCountMigrationWorkflow, ShardCountMigrationParams{
+		Namespaces:    []string{"ns-1", "ns-2"},
+		RemoteCluster: "new-cluster",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	value, err := env.QueryWorkflow(ShardCountMigrationProgressQuery)
+	require.NoError(t, err)
+	var progress ShardCountMigrationProgress
+	require.NoError(t, value.Get(&progress))
+	require.Equal(t, ShardCountMigrationProgress{
+		SourceShardCount: 4,
+		TargetShardCount: 8,
+		Namespaces: []NamespaceMigrationProgress{
+			{Namespace: "ns-1", State: NamespaceMigrationStateCompleted},
+			{Namespace: "ns-2", State: NamespaceMigrationStateCompleted},
+		},
+	}, progress)
+}
+
+func TestShardCountMigrationWorkflow_HandoverFailure(t *testing.T) {
+	env := newShardCountMigrationTestEnv()
+
+	var a *activities
+	env.OnActivity(a.VerifyShardCountMigration, mock.Anything, mock.Anything).Return(&verifyShardCountMigrationResponse{SourceShardCount: 4, TargetShardCount: 8}, nil).Once()
+	env.OnWorkflow(forceReplicationWorkflowName, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnWorkflow(namespaceHandoverWorkflowName, mock.Anything, mock.Anything).Return(errors.New("handover timed out")).Once()
+
+	env.ExecuteWorkflow(ShardCountMigrationWorkflow, ShardCountMigrationParams{
+		Namespaces:    []string{"ns-1", "ns-2"},
+		RemoteCluster: "new-cluster",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	value, err := env.QueryWorkflow(ShardCountMigrationProgressQuery)
+	require.NoError(t, err)
+	var progress ShardCountMigrationProgress
+	require.NoError(t, value.Get(&progress))
+	require.Equal(t, NamespaceMigrationStateFailed, progress.Namespaces[0].State)
+	require.Equal(t, NamespaceMigrationStatePending, progress.Namespaces[1].State)
+	require.Contains(t, progress.Error, "handover timed out")
+}
+
+func TestVerifyShardCountMigration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clusterMetadata := cluster.NewMockMetadata(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	a := &activities{
+		historyShardCount: 4,
+		clusterMetadata:   clusterMetadata,
+		namespaceRegistry: namespaceRegistry,
+	}
+
+	clusterMetadata.EXPECT().GetCurrentClusterName().Return("old-cluster").AnyTimes()
+	clusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		"old-cluster":   {Enabled: true, ShardCount: 4},
+		"same-cluster":  {Enabled: true, ShardCount: 4},
+		"small-cluster": {Enabled: true, ShardCount: 2},
+		"odd-cluster":   {Enabled: true, ShardCount: 6},
+		"new-cluster":   {Enabled: true, ShardCount: 8},
+	}).AnyTimes()
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name("ns")).Return(namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: "ns"},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: "old-cluster",
+			Clusters:          []string{"old-cluster", "new-cluster"},
+		},
+		1,
+	), nil).AnyTimes()
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name("local-ns")).Return(namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: "local-ns"},
+		nil,
+		"old-cluster",
+	), nil).AnyTimes()
+
+	for _, remoteCluster := range []string{"unknown-cluster", "same-cluster", "small-cluster", "odd-cluster"} {
+		_, err := a.VerifyShardCountMigration(context.Background(), verifyShardCountMigrationRequest{
+			Namespaces:    []string{"ns"},
+			RemoteCluster: remoteCluster,
+		})
+		require.Error(t, err, remoteCluster)
+	}
+
+	_, err := a.VerifyShardCountMigration(context.Background(), verifyShardCountMigrationRequest{
+		Namespaces:    []string{"ns", "local-ns"},
+		RemoteCluster: "new-cluster",
+	})
+	require.Error(t, err)
+
+	resp, err := a.VerifyShardCountMigration(context.Background(), verifyShardCountMigrationRequest{
+		Namespaces:    []string{"ns"},
+		RemoteCluster: "new-cluster",
+	})
+	require.NoError(t, err)
+	require.Equal(t, &verifyShardCountMigrationResponse{SourceShardCount: 4, TargetShardCount: 8}, resp)
+}
@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -85,6 +86,7 @@ type (
 
 	activities struct {
 		historyShardCount int32
+		clusterMetadata   cluster.Metadata
 		executionManager  persistence.ExecutionManager
 		namespaceRegistry namespace.Registry
 		historyClient     historyservice.HistoryServiceClient
@@ -0,0 +1,211 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	identity = "temporal-scheduler"
+
+	watchPollTimeout = time.Minute
+)
+
+type (
+	activities struct {
+		frontendClient workflowservice.WorkflowServiceClient
+		logger         log.Logger
+		metricsClient  metrics.Client
+	}
+
+	startWorkflowRequest struct {
+		Namespace   string
+		RequestID   string
+		WorkflowID  string
+		Action      StartWorkflowAction
+		NominalTime time.Time
+		ScheduleID  string
+	}
+
+	startWorkflowResponse struct {
+		RunID     string
+		StartTime time.Time
+	}
+
+	cancelWorkflowRequest struct {
+		Namespace  string
+		Execution  commonpb.WorkflowExecution
+		ScheduleID string
+	}
+
+	watchWorkflowRequest struct {
+		Namespace string
+		Execution commonpb.WorkflowExecution
+	}
+
+	watchWorkflowResponse struct {
+		Status enumspb.WorkflowExecutionStatus
+	}
+)
+
+func (a *activities) StartWorkflow(ctx context.Context, request *startWorkflowRequest) (*startWorkflowResponse, error) {
+	action := request.Action
+	response, err := a.frontendClient.StartWorkflowExecution(ctx, &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                request.Namespace,
+		WorkflowId:               request.WorkflowID,
+		WorkflowType:             &commonpb.WorkflowType{Name: action.WorkflowType},
+		TaskQueue:                &taskqueuepb.TaskQueue{Name: action.TaskQueue},
+		Input:                    action.Input,
+		WorkflowExecutionTimeout: timestamp.DurationPtr(action.WorkflowExecutionTimeout),
+		WorkflowRunTimeout:       timestamp.DurationPtr(action.WorkflowRunTimeout),
+		WorkflowTaskTimeout:      timestamp.DurationPtr(action.WorkflowTaskTimeout),
+		Identity:                 identity,
+		RequestId:                request.RequestID,
+		WorkflowIdReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		RetryPolicy:              action.RetryPolicy,
+		Memo:                     action.Memo,
+		SearchAttributes:         action.SearchAttributes,
+	})
+	if err != nil {
+		a.metricsClient.IncCounter(metrics.SchedulerScope, metrics.SchedulerStartWorkflowFailures)
+		a.logger.Error("Failed to start scheduled workflow",
+			tag.WorkflowNamespace(request.Namespace),
+			tag.WorkflowID(request.WorkflowID),
+			tag.Error(err))
+		return nil, err
+	}
+	a.metricsClient.IncCounter(metrics.SchedulerScope, metrics.SchedulerStartWorkflowSuccess)
+	return &startWorkflowResponse{
+		RunID:     response.GetRunId(),
+		StartTime: time.Now().UTC(),
+	}, nil
+}
+
+func (a *activities) CancelWorkflow(ctx context.Context, request *cancelWorkflowRequest) error {
+	_, err := a.frontendClient.RequestCancelWorkflowExecution(ctx, &workflowservice.RequestCancelWorkflowExecutionRequest{
+		Namespace:         request.Namespace,
+		WorkflowExecution: &request.Execution,
+		Identity:          identity,
+		RequestId:         fmt.Sprintf("%s-%s", request.ScheduleID, request.Execution.GetRunId()),
+	})
+	switch err.(type) {
+	case nil, *serviceerror.NotFound:
+		// NotFound means the workflow is already closed or deleted
+		return nil
+	default:
+		return err
+	}
+}
+
+// WatchWorkflow long polls the given workflow until it closes. Runs that continued
+// as new, or are retried, are followed until the chain closes.
+func (a *activities) WatchWorkflow(ctx context.Context, request *watchWorkflowRequest) (*watchWorkflowResponse, error) {
+	execution := request.Execution
+	var nextPageToken []byte
+	for {
+		activity.RecordHeartbeat(ctx)
+
+		pollCtx, cancel := context.WithTimeout(ctx, watchPollTimeout)
+		response, err := a.frontendClient.GetWorkflowExecutionHistory(pollCtx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace:              request.Namespace,
+			Execution:              &execution,
+			NextPageToken:          nextPageToken,
+			WaitNewEvent:           true,
+			HistoryEventFilterType: enumspb.HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT,
+		})
+		cancel()
+		if err != nil {
+			switch err.(type) {
+			case *serviceerror.NotFound:
+				// the workflow was deleted, there is nothing to watch anymore
+				return &watchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED}, nil
+			}
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if pollCtx.Err() != nil {
+				// long poll timed out, poll again
+				continue
+			}
+			return nil, err
+		}
+
+		events := response.GetHistory().GetEvents()
+		if len(events) == 0 {
+			nextPageToken = response.GetNextPageToken()
+			continue
+		}
+		status, newRunID := closeEventStatus(events[len(events)-1])
+		if newRunID == "" {
+			return &watchWorkflowResponse{Status: status}, nil
+		}
+		execution = commonpb.WorkflowExecution{
+			WorkflowId: execution.GetWorkflowId(),
+			RunId:      newRunID,
+		}
+		nextPageToken = nil
+	}
+}
+
+// closeEventStatus returns the status of a closed run and the id of the run that
+// continues the workflow, if any.
+func closeEventStatus(event *historypb.HistoryEvent) (enumspb.WorkflowExecutionStatus, string) {
+	switch event.GetEventType() {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			event.GetWorkflowExecutionCompletedEventAttributes().GetNewExecutionRunId()
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			event.GetWorkflowExecutionFailedEventAttributes().GetNewExecutionRunId()
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT,
+			event.GetWorkflowExecutionTimedOutEventAttributes().GetNewExecutionRunId()
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
+			event.GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunId()
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED, ""
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED, ""
+	default:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED, ""
+	}
+}
@@ -0,0 +1,322 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// maxYearSearch bounds how many years are skipped while looking for a
+	// calendar match in an allowed year.
+	maxYearSearch = 100
+)
+
+var (
+	calendarParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+	errEmptySpec       = errors.New("schedule spec must contain at least one cron string, calendar or interval")
+	errInvalidInterval = errors.New("interval must be positive and phase must be non-negative")
+	errNegativeJitter  = errors.New("jitter must be non-negative")
+	errInvalidRange    = errors.New("end time must be after start time")
+)
+
+type (
+	// ScheduleSpec describes the times at which a schedule takes its action. The
+	// union of all cron strings, calendars and intervals is used, restricted to
+	// [StartTime, EndTime] when those are set.
+	ScheduleSpec struct {
+		// Standard five field cron strings, e.g. "0 12 * * MON-FRI". Descriptors
+		// such as "@hourly" and "@every 10m" are accepted as well.
+		CronString []string
+		Calendar   []CalendarSpec
+		Interval   []IntervalSpec
+		// StartTime and EndTime are optional bounds of the schedule.
+		StartTime time.Time
+		EndTime   time.Time
+		// Jitter delays each action by a random, but deterministic, amount in
+		// [0, Jitter) so that many schedules on the same spec do not fire at once.
+		Jitter time.Duration
+		// TimezoneName is an IANA time zone name used to interpret cron strings and
+		// calendars. Defaults to UTC.
+		TimezoneName string
+	}
+
+	// CalendarSpec matches calendar times. Each field is a cron style expression
+	// (e.g. "*", "5", "1-5", "*/15", "MON,WED"). Empty fields default to "0" for
+	// Second, Minute and Hour and "*" for the rest.
+	CalendarSpec struct {
+		Second     string
+		Minute     string
+		Hour       string
+		DayOfMonth string
+		Month      string
+		DayOfWeek  string
+		Year       string
+	}
+
+	// IntervalSpec matches times t where (t - epoch - Phase) is a multiple of Interval.
+	IntervalSpec struct {
+		Interval time.Duration
+		Phase    time.Duration
+	}
+
+	// compiledSpec is a validated ScheduleSpec that can compute next times.
+	compiledSpec struct {
+		spec      ScheduleSpec
+		location  *time.Location
+		calendars []compiledCalendar
+		intervals []IntervalSpec
+	}
+
+	compiledCalendar struct {
+		schedule cron.Schedule
+		years    *yearMatcher
+	}
+
+	yearMatcher struct {
+		ranges [][3]int // [from, to, step]
+	}
+
+	// nextTime is the result of getNextTime. Nominal is the time computed from the
+	// spec and Next is the nominal time with jitter applied.
+	nextTime struct {
+		Nominal time.Time
+		Next    time.Time
+	}
+)
+
+func newCompiledSpec(spec ScheduleSpec) (*compiledSpec, error) {
+	if len(spec.CronString) == 0 && len(spec.Calendar) == 0 && len(spec.Interval) == 0 {
+		return nil, errEmptySpec
+	}
+	if spec.Jitter < 0 {
+		return nil, errNegativeJitter
+	}
+	if !spec.StartTime.IsZero() && !spec.EndTime.IsZero() && !spec.EndTime.After(spec.StartTime) {
+		return nil, errInvalidRange
+	}
+
+	location := time.UTC
+	if spec.TimezoneName != "" {
+		loc, err := time.LoadLocation(spec.TimezoneName)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", spec.TimezoneName, err)
+		}
+		location = loc
+	}
+
+	cspec := &compiledSpec{
+		spec:     spec,
+		location: location,
+	}
+	for _, cronString := range spec.CronString {
+		schedule, err := cron.ParseStandard(cronString)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron string %q: %w", cronString, err)
+		}
+		cspec.calendars = append(cspec.calendars, compiledCalendar{schedule: schedule})
+	}
+	for _, calendar := range spec.Calendar {
+		compiled, err := compileCalendar(calendar)
+		if err != nil {
+			return nil, err
+		}
+		cspec.calendars = append(cspec.calendars, compiled)
+	}
+	for _, interval := range spec.Interval {
+		if interval.Interval <= 0 || interval.Phase < 0 {
+			return nil, errInvalidInterval
+		}
+		cspec.intervals = append(cspec.intervals, interval)
+	}
+	return cspec, nil
+}
+
+func compileCalendar(calendar CalendarSpec) (compiledCalendar, error) {
+	fields := []string{
+		defaultString(calendar.Second, "0"),
+		defaultString(calendar.Minute, "0"),
+		defaultString(calendar.Hour, "0"),
+		defaultString(calendar.DayOfMonth, "*"),
+		defaultString(calendar.Month, "*"),
+		defaultString(calendar.DayOfWeek, "*"),
+	}
+	schedule, err := calendarParser.Parse(strings.Join(fields, " "))
+	if err != nil {
+		return compiledCalendar{}, fmt.Errorf("invalid calendar spec %+v: %w", calendar, err)
+	}
+	years, err := parseYears(calendar.Year)
+	if err != nil {
+		return compiledCalendar{}, err
+	}
+	return compiledCalendar{schedule: schedule, years: years}, nil
+}
+
+// getNextTime returns the first time strictly after "after" that matches the spec.
+// A zero nominal time is returned if there are no more matching times.
+func (cs *compiledSpec) getNextTime(after time.Time) nextTime {
+	if !cs.spec.StartTime.IsZero() && after.Before(cs.spec.StartTime) {
+		// times equal to StartTime are included
+		after = cs.spec.StartTime.Add(-time.Nanosecond)
+	}
+
+	var nominal time.Time
+	for _, calendar := range cs.calendars {
+		if next := calendar.next(after.In(cs.location)); !next.IsZero() && (nominal.IsZero() || next.Before(nominal)) {
+			nominal = next
+		}
+	}
+	for _, interval := range cs.intervals {
+		if next := nextInterval(interval, after); nominal.IsZero() || next.Before(nominal) {
+			nominal = next
+		}
+	}
+
+	if nominal.IsZero() || (!cs.spec.EndTime.IsZero() && nominal.After(cs.spec.EndTime)) {
+		return nextTime{}
+	}
+	nominal = nominal.UTC()
+	return nextTime{
+		Nominal: nominal,
+		Next:    nominal.Add(cs.jitter(nominal)),
+	}
+}
+
+// jitter returns a deterministic delay in [0, Jitter) derived from the nominal time,
+// so that replays of the scheduler workflow compute the same value.
+func (cs *compiledSpec) jitter(nominal time.Time) time.Duration {
+	if cs.spec.Jitter <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(nominal.Format(time.RFC3339Nano)))
+	return time.Duration(h.Sum64() % uint64(cs.spec.Jitter))
+}
+
+func (cc compiledCalendar) next(after time.Time) time.Time {
+	for i := 0; i < maxYearSearch; i++ {
+		next := cc.schedule.Next(after)
+		if next.IsZero() || cc.years == nil || cc.years.matches(next.Year()) {
+			return next
+		}
+		year, ok := cc.years.nextYear(next.Year())
+		if !ok {
+			return time.Time{}
+		}
+		after = time.Date(year, time.January, 1, 0, 0, 0, 0, next.Location()).Add(-time.Nanosecond)
+	}
+	return time.Time{}
+}
+
+func nextInterval(interval IntervalSpec, after time.Time) time.Time {
+	ts := after.UnixNano() - int64(interval.Phase)
+	n := int64(interval.Interval)
+	// floor division that works for negative values too
+	periods := ts / n
+	if ts%n < 0 {
+		periods--
+	}
+	return time.Unix(0, (periods+1)*n+int64(interval.Phase)).UTC()
+}
+
+// parseYears parses a cron style year expression. It returns nil if all years match.
+func parseYears(expr string) (*yearMatcher, error) {
+	if expr == "" || expr == "*" {
+		return nil, nil
+	}
+	matcher := &yearMatcher{}
+	for _, part := range strings.Split(expr, ",") {
+		from, to, step := 0, 0, 1
+		rangePart := part
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s <= 0 {
+				return nil, fmt.Errorf("invalid year step in %q", part)
+			}
+			step = s
+			rangePart = part[:i]
+		}
+		bounds := strings.SplitN(rangePart, "-", 2)
+		var err error
+		if from, err = strconv.Atoi(bounds[0]); err != nil {
+			return nil, fmt.Errorf("invalid year %q", part)
+		}
+		to = from
+		if len(bounds) == 2 {
+			if to, err = strconv.Atoi(bounds[1]); err != nil || to < from {
+				return nil, fmt.Errorf("invalid year range %q", part)
+			}
+		} else if step > 1 {
+			// "2022/2" means every other year starting at 2022
+			to = maxYear
+		}
+		matcher.ranges = append(matcher.ranges, [3]int{from, to, step})
+	}
+	return matcher, nil
+}
+
+const maxYear = 9999
+
+func (m *yearMatcher) matches(year int) bool {
+	for _, r := range m.ranges {
+		if year >= r[0] && year <= r[1] && (year-r[0])%r[2] == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// nextYear returns the first matching year after the given one.
+func (m *yearMatcher) nextYear(year int) (int, bool) {
+	result, found := 0, false
+	for _, r := range m.ranges {
+		candidate := year + 1
+		if candidate < r[0] {
+			candidate = r[0]
+		}
+		if rem := (candidate - r[0]) % r[2]; rem != 0 {
+			candidate += r[2] - rem
+		}
+		if candidate <= r[1] && (!found || candidate < result) {
+			result, found = candidate, true
+		}
+	}
+	return result, found
+}
+
+func defaultString(s string, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
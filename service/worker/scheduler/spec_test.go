@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecCronString(t *testing.T) {
+	cspec, err := newCompiledSpec(ScheduleSpec{
+		CronString: []string{"30 12 * * MON-FRI"},
+	})
+	require.NoError(t, err)
+
+	// 2022-03-04 is a Friday
+	next := cspec.getNextTime(time.Date(2022, 3, 4, 12, 30, 0, 0, time.UTC))
+	require.Equal(t, time.Date(2022, 3, 7, 12, 30, 0, 0, time.UTC), next.Nominal)
+	require.Equal(t, next.Nominal, next.Next)
+}
+
+func TestSpecCalendar(t *testing.T) {
+	cspec, err := newCompiledSpec(ScheduleSpec{
+		Calendar: []CalendarSpec{
+			{Hour: "9,17", DayOfMonth: "1"},
+		},
+	})
+	require.NoError(t, err)
+
+	next := cspec.getNextTime(time.Date(2022, 3, 1, 9, 0, 0, 0, time.UTC))
+	require.Equal(t, time.Date(2022, 3, 1, 17, 0, 0, 0, time.UTC), next.Nominal)
+	next = cspec.getNextTime(next.Nominal)
+	require.Equal(t, time.Date(2022, 4, 1, 9, 0, 0, 0, time.UTC), next.Nominal)
+}
+
+func TestSpecCalendarYears(t *testing.T) {
+	cspec, err := newCompiledSpec(ScheduleSpec{
+		Calendar: []CalendarSpec{
+			{Month: "2", DayOfMonth: "29", Year: "2024-2032/4"},
+		},
+	})
+	require.NoError(t, err)
+
+	next := cspec.getNextTime(time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC))
+	require.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), next.Nominal)
+	next = cspec.getNextTime(next.Nominal)
+	require.Equal(t, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC), next.Nominal)
+	next = cspec.getNextTime(time.Date(2032, 3, 1, 0, 0, 0, 0, time.UTC))
+	require.True(t, next.Nominal.IsZero())
+}
+
+func TestSpecTimezone(t *testing.T) {
+	cspec, err := newCompiledSpec(ScheduleSpec{
+		CronString:   []string{"0 9 * * *"},
+		TimezoneName: "America/New_York",
+	})
+	require.NoError(t, err)
+
+	next := cspec.getNextTime(time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC))
+	require.Equal(t, time.Date(2022, 3, 1, 14, 0, 0, 0, time.UTC), next.Nominal)
+}
+
+func TestSpecInterval(t *testing.T) {
+	cspec, err := newCompiledSpec(ScheduleSpec{
+		Interval: []IntervalSpec{
+			{Interval: 90 * time.Minute, Phase: 5 * time.Minute},
+		},
+	})
+	require.NoError(t, err)
+
+	next := cspec.getNextTime(time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC))
+	require.Equal(t, time.Date(2022, 3, 1, 0, 5, 0, 0, time.UTC), next.Nominal)
+	next = cspec.getNextTime(next.Nominal)
+	require.Equal(t, time.Date(2022, 3, 1, 1, 35, 0, 0, time.UTC), next.Nominal)
+}
+
+func TestSpecUnion(t *testing.T) {
+	cspec, err := newCompiledSpec(ScheduleSpec{
+		CronString: []string{"0 * * * *"},
+		Interval: []IntervalSpec{
+			{Interval: 45 * time.Minute},
+		},
+	})
+	require.NoError(t, err)
+
+	next := cspec.getNextTime(time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC))
+	require.Equal(t, time.Date(2022, 3, 1, 0, 45, 0, 0, time.UTC), next.Nominal)
+	next = cspec.getNextTime(next.Nominal)
+	require.Equal(t, time.Date(2022, 3, 1, 1, 0, 0, 0, time.UTC), next.Nominal)
+}
+
+func TestSpecBounds(t *testing.T) {
+	start := time.Date(2022, 3, 1, 5, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 3, 1, 7, 0, 0, 0, time.UTC)
+	cspec, err := newCompiledSpec(ScheduleSpec{
+		CronString: []string{"0 * * * *"},
+		StartTime:  start,
+		EndTime:    end,
+	})
+	require.NoError(t, err)
+
+	next := cspec.getNextTime(time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC))
+	require.Equal(t, start, next.Nominal)
+	next = cspec.getNextTime(end)
+	require.True(t, next.Nominal.IsZero())
+}
+
+func TestSpecJitter(t *testing.T) {
+	cspec, err := newCompiledSpec(ScheduleSpec{
+		CronString: []string{"0 * * * *"},
+		Jitter:     time.Minute,
+	})
+	require.NoError(t, err)
+
+	after := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	next := cspec.getNextTime(after)
+	require.Equal(t, time.Date(2022, 3, 1, 1, 0, 0, 0, time.UTC), next.Nominal)
+	require.False(t, next.Next.Before(next.Nominal))
+	require.True(t, next.Next.Before(next.Nominal.Add(time.Minute)))
+	// jitter must be deterministic
+	require.Equal(t, next, cspec.getNextTime(after))
+}
+
+func TestSpecInvalid(t *testing.T) {
+	testCases := []ScheduleSpec{
+		{},
+		{CronString: []string{"not a cron"}},
+		{Calendar: []CalendarSpec{{Hour: "25"}}},
+		{Calendar: []CalendarSpec{{Year: "2022-2020"}}},
+		{Interval: []IntervalSpec{{Interval: 0}}},
+		{CronString: []string{"@hourly"}, Jitter: -time.Second},
+		{CronString: []string{"@hourly"}, TimezoneName: "Nowhere/Nothing"},
+		{
+			CronString: []string{"@hourly"},
+			StartTime:  time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+			EndTime:    time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, spec := range testCases {
+		_, err := newCompiledSpec(spec)
+		require.Error(t, err, "%+v", spec)
+	}
+}
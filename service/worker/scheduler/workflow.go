@@ -0,0 +1,665 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// TaskQueueName is the task queue of the scheduler workflows
+	TaskQueueName = "temporal-sys-scheduler-taskqueue"
+	// WorkflowType is the workflow type of the scheduler workflows
+	WorkflowType = "temporal-sys-scheduler-workflow"
+	// WorkflowIDPrefix is the prefix of the scheduler workflow ids. The full id also
+	// contains the target namespace and the schedule id, see WorkflowID.
+	WorkflowIDPrefix = "temporal-sys-scheduler:"
+
+	// SignalNamePatch is the signal used to pause, unpause, trigger or backfill a schedule
+	SignalNamePatch = "patch"
+	// SignalNameUpdate is the signal used to replace the schedule definition
+	SignalNameUpdate = "update"
+	// QueryNameDescribe is the query that returns a DescribeResponse
+	QueryNameDescribe = "describe"
+
+	// OverlapPolicySkip drops an action if the previous one is still running
+	OverlapPolicySkip OverlapPolicy = "skip"
+	// OverlapPolicyBufferOne runs an action after the previous one completes, but
+	// buffers at most one action
+	OverlapPolicyBufferOne OverlapPolicy = "buffer-one"
+	// OverlapPolicyCancelOther cancels the running workflow and starts the new one
+	// once it has closed
+	OverlapPolicyCancelOther OverlapPolicy = "cancel-other"
+	// OverlapPolicyAllowAll starts every action regardless of running workflows
+	OverlapPolicyAllowAll OverlapPolicy = "allow-all"
+
+	defaultCatchupWindow = time.Minute
+	minCatchupWindow     = 10 * time.Second
+
+	maxRecentActions      = 10
+	maxFutureActionTimes  = 10
+	maxBufferedStarts     = 1000
+	iterationsBeforeReset = 500
+)
+
+// AllOverlapPolicies is the overlap policies we support
+var AllOverlapPolicies = []OverlapPolicy{
+	OverlapPolicySkip,
+	OverlapPolicyBufferOne,
+	OverlapPolicyCancelOther,
+	OverlapPolicyAllowAll,
+}
+
+type (
+	// OverlapPolicy controls what happens when an action is due while a workflow
+	// started by a previous action is still running.
+	OverlapPolicy string
+
+	// Schedule is the full user provided definition of a schedule
+	Schedule struct {
+		Spec     ScheduleSpec
+		Action   StartWorkflowAction
+		Policies SchedulePolicies
+		State    ScheduleState
+	}
+
+	// StartWorkflowAction describes the workflow started by each action of a schedule.
+	// The workflow id of each run is WorkflowID suffixed with the nominal action time.
+	StartWorkflowAction struct {
+		WorkflowID               string
+		WorkflowType             string
+		TaskQueue                string
+		Input                    *commonpb.Payloads
+		WorkflowExecutionTimeout time.Duration
+		WorkflowRunTimeout       time.Duration
+		WorkflowTaskTimeout      time.Duration
+		RetryPolicy              *commonpb.RetryPolicy
+		Memo                     *commonpb.Memo
+		SearchAttributes         *commonpb.SearchAttributes
+	}
+
+	// SchedulePolicies contains the behavioral knobs of a schedule
+	SchedulePolicies struct {
+		// Default to OverlapPolicySkip
+		OverlapPolicy OverlapPolicy
+		// Actions missed by more than CatchupWindow (e.g. because the scheduler was
+		// unavailable) are skipped. Default to 1 minute.
+		CatchupWindow time.Duration
+	}
+
+	// ScheduleState is the user visible and modifiable state of a schedule
+	ScheduleState struct {
+		Notes  string
+		Paused bool
+	}
+
+	// ScheduleInfo is the informational, scheduler maintained, part of a schedule
+	ScheduleInfo struct {
+		ActionCount         int64
+		MissedCatchupWindow int64
+		OverlapSkipped      int64
+		RunningWorkflows    []commonpb.WorkflowExecution
+		RecentActions       []ScheduleActionResult
+		FutureActionTimes   []time.Time
+		CreateTime          time.Time
+		UpdateTime          time.Time
+		// Set when the current schedule definition can not be used
+		InvalidScheduleError string
+	}
+
+	// ScheduleActionResult is a record of a single action taken by the schedule
+	ScheduleActionResult struct {
+		ScheduleTime time.Time
+		ActualTime   time.Time
+		Execution    commonpb.WorkflowExecution
+	}
+
+	// SchedulePatch is a one-off modification of a running schedule. All fields are optional.
+	SchedulePatch struct {
+		// Take the action now, subject to OverlapPolicy
+		TriggerImmediately *TriggerImmediatelyRequest
+		// Take the actions that the spec would have taken in the given time ranges
+		BackfillRequest []BackfillRequest
+		// If set, pause the schedule with this note
+		Pause string
+		// If set, unpause the schedule with this note
+		Unpause string
+	}
+
+	// TriggerImmediatelyRequest asks the schedule to take one action right away
+	TriggerImmediatelyRequest struct {
+		// Default to the schedule's OverlapPolicy
+		OverlapPolicy OverlapPolicy
+	}
+
+	// BackfillRequest asks the schedule to take all actions in [StartTime, EndTime]
+	BackfillRequest struct {
+		StartTime time.Time
+		EndTime   time.Time
+		// Default to the schedule's OverlapPolicy
+		OverlapPolicy OverlapPolicy
+	}
+
+	// UpdateRequest replaces the schedule definition. If ConflictToken is non-zero
+	// and does not match the current token, the update is dropped.
+	UpdateRequest struct {
+		Schedule      Schedule
+		ConflictToken int64
+	}
+
+	// DescribeResponse is the result of QueryNameDescribe
+	DescribeResponse struct {
+		Schedule      Schedule
+		Info          ScheduleInfo
+		ConflictToken int64
+	}
+
+	// StartScheduleArgs is the input of the scheduler workflow. It is also used to
+	// carry the state across continue-as-new.
+	StartScheduleArgs struct {
+		Namespace    string
+		ScheduleID   string
+		Schedule     Schedule
+		Info         ScheduleInfo
+		InitialPatch *SchedulePatch
+		State        InternalState
+	}
+
+	// InternalState is scheduler state that is not exposed to users
+	InternalState struct {
+		LastProcessedTime time.Time
+		BufferedStarts    []BufferedStart
+		ConflictToken     int64
+	}
+
+	// BufferedStart is an action that is due but has not been taken yet
+	BufferedStart struct {
+		NominalTime   time.Time
+		ActualTime    time.Time
+		OverlapPolicy OverlapPolicy
+		Manual        bool
+	}
+
+	scheduler struct {
+		StartScheduleArgs
+
+		ctx    workflow.Context
+		a      *activities
+		logger log.Logger
+		cspec  *compiledSpec
+
+		// watcher for the oldest running workflow, nil if none
+		watcher workflow.Future
+		// set while a cancel request for the running workflows is outstanding
+		cancelRequested bool
+	}
+)
+
+var (
+	errInvalidOverlapPolicy = errors.New("invalid overlap policy")
+
+	defaultActivityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    time.Minute,
+	}
+)
+
+// WorkflowID returns the id of the scheduler workflow for the given schedule
+func WorkflowID(namespace string, scheduleID string) string {
+	return fmt.Sprintf("%s%s:%s", WorkflowIDPrefix, namespace, scheduleID)
+}
+
+// ValidateSchedule validates a schedule definition
+func ValidateSchedule(schedule *Schedule) error {
+	if _, err := newCompiledSpec(schedule.Spec); err != nil {
+		return err
+	}
+	if schedule.Action.WorkflowID == "" || schedule.Action.WorkflowType == "" || schedule.Action.TaskQueue == "" {
+		return errors.New("action must provide required parameters: WorkflowID/WorkflowType/TaskQueue")
+	}
+	if schedule.Policies.OverlapPolicy != "" && !isValidOverlapPolicy(schedule.Policies.OverlapPolicy) {
+		return errInvalidOverlapPolicy
+	}
+	if schedule.Policies.CatchupWindow < 0 {
+		return errors.New("catchup window must be non-negative")
+	}
+	return nil
+}
+
+// ValidatePatch validates a schedule patch
+func ValidatePatch(patch *SchedulePatch) error {
+	if patch.TriggerImmediately != nil && patch.TriggerImmediately.OverlapPolicy != "" &&
+		!isValidOverlapPolicy(patch.TriggerImmediately.OverlapPolicy) {
+		return errInvalidOverlapPolicy
+	}
+	for _, backfill := range patch.BackfillRequest {
+		if !backfill.EndTime.After(backfill.StartTime) {
+			return errInvalidRange
+		}
+		if backfill.OverlapPolicy != "" && !isValidOverlapPolicy(backfill.OverlapPolicy) {
+			return errInvalidOverlapPolicy
+		}
+	}
+	if patch.Pause != "" && patch.Unpause != "" {
+		return errors.New("can not pause and unpause at the same time")
+	}
+	return nil
+}
+
+func isValidOverlapPolicy(policy OverlapPolicy) bool {
+	for _, p := range AllOverlapPolicies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+// SchedulerWorkflow is the workflow that drives a single schedule
+func SchedulerWorkflow(ctx workflow.Context, args *StartScheduleArgs) error {
+	s := &scheduler{
+		StartScheduleArgs: *args,
+		ctx:               ctx,
+		logger:            workflow.GetLogger(ctx),
+	}
+	return s.run()
+}
+
+func (s *scheduler) run() error {
+	s.ensureFields()
+	s.compileSpec()
+
+	if err := workflow.SetQueryHandler(s.ctx, QueryNameDescribe, s.describe); err != nil {
+		return err
+	}
+
+	if s.State.LastProcessedTime.IsZero() {
+		// first run of this schedule
+		now := workflow.Now(s.ctx)
+		s.State.LastProcessedTime = now
+		s.Info.CreateTime = now
+	}
+	if s.InitialPatch != nil {
+		s.processPatch(s.InitialPatch)
+		s.InitialPatch = nil
+	}
+	s.startWatcher()
+
+	for iters := iterationsBeforeReset; iters > 0; iters-- {
+		now := workflow.Now(s.ctx)
+		nextSleep := s.processTimeRange(
+			s.State.LastProcessedTime,
+			now,
+			"",
+			false,
+		)
+		s.State.LastProcessedTime = now
+		s.processBuffer()
+		s.updateFutureActionTimes(now)
+		s.sleep(nextSleep)
+	}
+
+	// drain signals before continuing as new so that none are lost
+	s.processSignals()
+	args := s.StartScheduleArgs
+	return workflow.NewContinueAsNewError(s.ctx, WorkflowType, &args)
+}
+
+func (s *scheduler) ensureFields() {
+	if s.Schedule.Policies.OverlapPolicy == "" {
+		s.Schedule.Policies.OverlapPolicy = OverlapPolicySkip
+	}
+	if s.Schedule.Policies.CatchupWindow == 0 {
+		s.Schedule.Policies.CatchupWindow = defaultCatchupWindow
+	} else if s.Schedule.Policies.CatchupWindow < minCatchupWindow {
+		s.Schedule.Policies.CatchupWindow = minCatchupWindow
+	}
+}
+
+func (s *scheduler) compileSpec() {
+	cspec, err := newCompiledSpec(s.Schedule.Spec)
+	if err != nil {
+		s.logger.Error("Invalid schedule", "error", err)
+		s.Info.InvalidScheduleError = err.Error()
+		s.cspec = nil
+		return
+	}
+	s.Info.InvalidScheduleError = ""
+	s.cspec = cspec
+}
+
+// processTimeRange buffers all actions that are due in (start, end] and returns how
+// long to sleep until the next one. Manual ranges (backfills) ignore the paused state
+// and the catchup window.
+func (s *scheduler) processTimeRange(
+	start, end time.Time,
+	overlapPolicy OverlapPolicy,
+	manual bool,
+) time.Duration {
+	if s.cspec == nil {
+		return 0
+	}
+	for {
+		next := s.cspec.getNextTime(start)
+		if next.Nominal.IsZero() {
+			// the spec has no more times
+			return 0
+		}
+		if next.Next.After(end) {
+			return next.Next.Sub(end)
+		}
+		start = next.Nominal
+		if !manual && s.Schedule.State.Paused {
+			continue
+		}
+		if !manual && end.Sub(next.Next) > s.Schedule.Policies.CatchupWindow {
+			s.logger.Warn("Schedule missed catchup window", "now", end, "time", next.Next)
+			s.Info.MissedCatchupWindow++
+			continue
+		}
+		s.addStart(next.Nominal, next.Next, overlapPolicy, manual)
+	}
+}
+
+func (s *scheduler) addStart(nominalTime, actualTime time.Time, overlapPolicy OverlapPolicy, manual bool) {
+	if len(s.State.BufferedStarts) >= maxBufferedStarts {
+		s.logger.Warn("Too many buffered starts, dropping action", "time", nominalTime)
+		s.Info.OverlapSkipped++
+		return
+	}
+	s.State.BufferedStarts = append(s.State.BufferedStarts, BufferedStart{
+		NominalTime:   nominalTime,
+		ActualTime:    actualTime,
+		OverlapPolicy: overlapPolicy,
+		Manual:        manual,
+	})
+}
+
+// processBuffer takes the buffered actions that the overlap policies allow
+func (s *scheduler) processBuffer() {
+	var remaining []BufferedStart
+	for _, start := range s.State.BufferedStarts {
+		isRunning := len(s.Info.RunningWorkflows) > 0
+		switch s.resolveOverlapPolicy(start.OverlapPolicy) {
+		case OverlapPolicySkip:
+			if isRunning {
+				s.Info.OverlapSkipped++
+				continue
+			}
+		case OverlapPolicyBufferOne:
+			if isRunning {
+				if len(remaining) > 0 {
+					s.Info.OverlapSkipped++
+				} else {
+					remaining = append(remaining, start)
+				}
+				continue
+			}
+		case OverlapPolicyCancelOther:
+			if isRunning {
+				s.cancelRunning()
+				// only the latest start survives the cancellation
+				if len(remaining) > 0 {
+					s.Info.OverlapSkipped++
+				}
+				remaining = []BufferedStart{start}
+				continue
+			}
+		case OverlapPolicyAllowAll:
+		}
+		s.startWorkflow(start)
+	}
+	s.State.BufferedStarts = remaining
+}
+
+func (s *scheduler) resolveOverlapPolicy(policy OverlapPolicy) OverlapPolicy {
+	if policy == "" {
+		return s.Schedule.Policies.OverlapPolicy
+	}
+	return policy
+}
+
+func (s *scheduler) startWorkflow(start BufferedStart) {
+	// give up on starting the workflow once it would be outside the catchup window
+	ctx := workflow.WithActivityOptions(s.ctx, workflow.ActivityOptions{
+		ScheduleToCloseTimeout: s.Schedule.Policies.CatchupWindow,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            defaultActivityRetryPolicy,
+	})
+	request := &startWorkflowRequest{
+		Namespace:   s.Namespace,
+		RequestID:   fmt.Sprintf("%s-%d", workflow.GetInfo(s.ctx).WorkflowExecution.RunID, start.NominalTime.UnixNano()),
+		WorkflowID:  fmt.Sprintf("%s-%s", s.Schedule.Action.WorkflowID, start.NominalTime.UTC().Format(time.RFC3339)),
+		Action:      s.Schedule.Action,
+		NominalTime: start.NominalTime,
+		ScheduleID:  s.ScheduleID,
+	}
+	var response startWorkflowResponse
+	if err := workflow.ExecuteActivity(ctx, s.a.StartWorkflow, request).Get(s.ctx, &response); err != nil {
+		s.logger.Error("Failed to start workflow", "workflow-id", request.WorkflowID, "error", err)
+		return
+	}
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: request.WorkflowID,
+		RunId:      response.RunID,
+	}
+	s.Info.ActionCount++
+	s.Info.RunningWorkflows = append(s.Info.RunningWorkflows, execution)
+	s.Info.RecentActions = append(s.Info.RecentActions, ScheduleActionResult{
+		ScheduleTime: start.ActualTime,
+		ActualTime:   response.StartTime,
+		Execution:    execution,
+	})
+	if extra := len(s.Info.RecentActions) - maxRecentActions; extra > 0 {
+		s.Info.RecentActions = s.Info.RecentActions[extra:]
+	}
+	s.startWatcher()
+}
+
+func (s *scheduler) cancelRunning() {
+	if s.cancelRequested {
+		return
+	}
+	ctx := workflow.WithActivityOptions(s.ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy:         defaultActivityRetryPolicy,
+	})
+	for _, execution := range s.Info.RunningWorkflows {
+		request := &cancelWorkflowRequest{
+			Namespace:  s.Namespace,
+			Execution:  execution,
+			ScheduleID: s.ScheduleID,
+		}
+		if err := workflow.ExecuteActivity(ctx, s.a.CancelWorkflow, request).Get(s.ctx, nil); err != nil {
+			s.logger.Error("Failed to cancel workflow", "workflow-id", execution.WorkflowId, "error", err)
+		}
+	}
+	s.cancelRequested = true
+}
+
+// startWatcher starts watching the oldest running workflow, if it is not watched already
+func (s *scheduler) startWatcher() {
+	if s.watcher != nil || len(s.Info.RunningWorkflows) == 0 {
+		return
+	}
+	ctx := workflow.WithActivityOptions(s.ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 365 * 24 * time.Hour,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy:         defaultActivityRetryPolicy,
+	})
+	request := &watchWorkflowRequest{
+		Namespace: s.Namespace,
+		Execution: s.Info.RunningWorkflows[0],
+	}
+	s.watcher = workflow.ExecuteActivity(ctx, s.a.WatchWorkflow, request)
+}
+
+func (s *scheduler) processWatcherResult() {
+	var response watchWorkflowResponse
+	err := s.watcher.Get(s.ctx, &response)
+	s.watcher = nil
+	if err != nil {
+		s.logger.Error("Failed to watch workflow", "error", err)
+	} else {
+		s.logger.Info("Scheduled workflow closed", "workflow-id", s.Info.RunningWorkflows[0].WorkflowId, "status", response.Status)
+	}
+	// the watcher gives up only if the workflow is gone or closed
+	s.Info.RunningWorkflows = s.Info.RunningWorkflows[1:]
+	if len(s.Info.RunningWorkflows) == 0 {
+		s.cancelRequested = false
+	}
+	s.startWatcher()
+}
+
+// sleep blocks until the next action is due, a signal arrives or a running workflow closes
+func (s *scheduler) sleep(nextSleep time.Duration) {
+	selector := workflow.NewSelector(s.ctx)
+
+	timerCtx, cancelTimer := workflow.WithCancel(s.ctx)
+	if nextSleep > 0 {
+		selector.AddFuture(workflow.NewTimer(timerCtx, nextSleep), func(_ workflow.Future) {})
+	}
+	if s.watcher != nil {
+		selector.AddFuture(s.watcher, func(_ workflow.Future) {
+			s.processWatcherResult()
+		})
+	}
+
+	patchCh := workflow.GetSignalChannel(s.ctx, SignalNamePatch)
+	selector.AddReceive(patchCh, func(ch workflow.ReceiveChannel, _ bool) {
+		var patch SchedulePatch
+		ch.Receive(s.ctx, &patch)
+		s.processPatch(&patch)
+	})
+	updateCh := workflow.GetSignalChannel(s.ctx, SignalNameUpdate)
+	selector.AddReceive(updateCh, func(ch workflow.ReceiveChannel, _ bool) {
+		var request UpdateRequest
+		ch.Receive(s.ctx, &request)
+		s.processUpdate(&request)
+	})
+
+	selector.Select(s.ctx)
+	cancelTimer()
+}
+
+func (s *scheduler) processSignals() {
+	patchCh := workflow.GetSignalChannel(s.ctx, SignalNamePatch)
+	for {
+		var patch SchedulePatch
+		if !patchCh.ReceiveAsync(&patch) {
+			break
+		}
+		s.processPatch(&patch)
+	}
+	updateCh := workflow.GetSignalChannel(s.ctx, SignalNameUpdate)
+	for {
+		var request UpdateRequest
+		if !updateCh.ReceiveAsync(&request) {
+			break
+		}
+		s.processUpdate(&request)
+	}
+}
+
+func (s *scheduler) processPatch(patch *SchedulePatch) {
+	if err := ValidatePatch(patch); err != nil {
+		s.logger.Warn("Ignoring invalid patch", "error", err)
+		return
+	}
+
+	if patch.TriggerImmediately != nil {
+		now := workflow.Now(s.ctx)
+		s.addStart(now, now, patch.TriggerImmediately.OverlapPolicy, true)
+	}
+	for _, backfill := range patch.BackfillRequest {
+		// the range is inclusive of the start time
+		s.processTimeRange(
+			backfill.StartTime.Add(-time.Nanosecond),
+			backfill.EndTime,
+			backfill.OverlapPolicy,
+			true,
+		)
+	}
+	if patch.Pause != "" {
+		s.Schedule.State.Paused = true
+		s.Schedule.State.Notes = patch.Pause
+	}
+	if patch.Unpause != "" {
+		s.Schedule.State.Paused = false
+		s.Schedule.State.Notes = patch.Unpause
+	}
+	s.incSeqNo()
+}
+
+func (s *scheduler) processUpdate(request *UpdateRequest) {
+	if request.ConflictToken != 0 && request.ConflictToken != s.State.ConflictToken {
+		s.logger.Warn("Ignoring update with mismatched conflict token",
+			"expected", s.State.ConflictToken, "actual", request.ConflictToken)
+		return
+	}
+
+	s.Schedule = request.Schedule
+	s.ensureFields()
+	s.compileSpec()
+	s.Info.UpdateTime = workflow.Now(s.ctx)
+	s.incSeqNo()
+}
+
+func (s *scheduler) incSeqNo() {
+	s.State.ConflictToken++
+}
+
+func (s *scheduler) updateFutureActionTimes(now time.Time) {
+	s.Info.FutureActionTimes = nil
+	if s.cspec == nil || s.Schedule.State.Paused {
+		return
+	}
+	t := now
+	for len(s.Info.FutureActionTimes) < maxFutureActionTimes {
+		next := s.cspec.getNextTime(t)
+		if next.Nominal.IsZero() {
+			break
+		}
+		s.Info.FutureActionTimes = append(s.Info.FutureActionTimes, next.Next)
+		t = next.Nominal
+	}
+}
+
+func (s *scheduler) describe() (*DescribeResponse, error) {
+	return &DescribeResponse{
+		Schedule:      s.Schedule,
+		Info:          s.Info,
+		ConflictToken: s.State.ConflictToken,
+	}, nil
+}
@@ -0,0 +1,198 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+var testStartTime = time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestSchedule() *StartScheduleArgs {
+	return &StartScheduleArgs{
+		Namespace:  "test-ns",
+		ScheduleID: "test-schedule",
+		Schedule: Schedule{
+			Spec: ScheduleSpec{
+				Interval: []IntervalSpec{{Interval: time.Hour}},
+			},
+			Action: StartWorkflowAction{
+				WorkflowID:   "test-wf",
+				WorkflowType: "test-wf-type",
+				TaskQueue:    "test-tq",
+			},
+		},
+	}
+}
+
+func describeTestSchedule(t *testing.T, env *testsuite.TestWorkflowEnvironment) *DescribeResponse {
+	value, err := env.QueryWorkflow(QueryNameDescribe)
+	require.NoError(t, err)
+	var resp DescribeResponse
+	require.NoError(t, value.Get(&resp))
+	return &resp
+}
+
+func requireContinuedAsNew(t *testing.T, env *testsuite.TestWorkflowEnvironment) {
+	require.True(t, env.IsWorkflowCompleted())
+	var continueAsNewErr *workflow.ContinueAsNewError
+	require.ErrorAs(t, env.GetWorkflowError(), &continueAsNewErr)
+}
+
+func TestSchedulerWorkflow_StartsOnSpec(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.SetStartTime(testStartTime)
+
+	var a *activities
+	env.OnActivity(a.StartWorkflow, mock.Anything, mock.Anything).Return(func(_ context.Context, request *startWorkflowRequest) (*startWorkflowResponse, error) {
+		require.Equal(t, "test-ns", request.Namespace)
+		require.Equal(t, "test-wf-"+request.NominalTime.Format(time.RFC3339), request.WorkflowID)
+		return &startWorkflowResponse{RunID: "run-id", StartTime: request.NominalTime}, nil
+	})
+	env.OnActivity(a.WatchWorkflow, mock.Anything, mock.Anything).Return(&watchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED}, nil)
+
+	env.RegisterDelayedCallback(func() {
+		resp := describeTestSchedule(t, env)
+		require.Equal(t, int64(3), resp.Info.ActionCount)
+		require.Len(t, resp.Info.RecentActions, 3)
+		require.Equal(t, testStartTime.Add(time.Hour), resp.Info.RecentActions[0].ScheduleTime)
+		require.Empty(t, resp.Info.RunningWorkflows)
+		require.Equal(t, testStartTime.Add(4*time.Hour), resp.Info.FutureActionTimes[0])
+	}, 3*time.Hour+30*time.Minute)
+
+	env.ExecuteWorkflow(SchedulerWorkflow, newTestSchedule())
+	requireContinuedAsNew(t, env)
+}
+
+func TestSchedulerWorkflow_OverlapSkip(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.SetStartTime(testStartTime)
+
+	var a *activities
+	env.OnActivity(a.StartWorkflow, mock.Anything, mock.Anything).Return(&startWorkflowResponse{RunID: "run-id"}, nil)
+	// every workflow runs for 90 minutes, so every other action overlaps
+	env.OnActivity(a.WatchWorkflow, mock.Anything, mock.Anything).After(90*time.Minute).Return(&watchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED}, nil)
+
+	env.RegisterDelayedCallback(func() {
+		resp := describeTestSchedule(t, env)
+		require.Equal(t, int64(2), resp.Info.ActionCount)
+		require.Equal(t, int64(2), resp.Info.OverlapSkipped)
+	}, 4*time.Hour+45*time.Minute)
+
+	env.ExecuteWorkflow(SchedulerWorkflow, newTestSchedule())
+	requireContinuedAsNew(t, env)
+}
+
+func TestSchedulerWorkflow_PauseAndTrigger(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.SetStartTime(testStartTime)
+
+	var a *activities
+	env.OnActivity(a.StartWorkflow, mock.Anything, mock.Anything).Return(&startWorkflowResponse{RunID: "run-id"}, nil)
+	env.OnActivity(a.WatchWorkflow, mock.Anything, mock.Anything).Return(&watchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED}, nil)
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(SignalNamePatch, &SchedulePatch{Pause: "paused for test"})
+	}, 30*time.Minute)
+	env.RegisterDelayedCallback(func() {
+		resp := describeTestSchedule(t, env)
+		require.True(t, resp.Schedule.State.Paused)
+		require.Equal(t, "paused for test", resp.Schedule.State.Notes)
+		require.Equal(t, int64(0), resp.Info.ActionCount)
+		require.Empty(t, resp.Info.FutureActionTimes)
+		env.SignalWorkflow(SignalNamePatch, &SchedulePatch{TriggerImmediately: &TriggerImmediatelyRequest{}})
+	}, 3*time.Hour+30*time.Minute)
+	env.RegisterDelayedCallback(func() {
+		resp := describeTestSchedule(t, env)
+		require.Equal(t, int64(1), resp.Info.ActionCount)
+		env.SignalWorkflow(SignalNamePatch, &SchedulePatch{
+			BackfillRequest: []BackfillRequest{{
+				StartTime:     testStartTime,
+				EndTime:       testStartTime.Add(2 * time.Hour),
+				OverlapPolicy: OverlapPolicyAllowAll,
+			}},
+		})
+	}, 3*time.Hour+40*time.Minute)
+	env.RegisterDelayedCallback(func() {
+		resp := describeTestSchedule(t, env)
+		// the backfill includes both bounds of the range
+		require.Equal(t, int64(4), resp.Info.ActionCount)
+	}, 3*time.Hour+50*time.Minute)
+
+	env.ExecuteWorkflow(SchedulerWorkflow, newTestSchedule())
+	requireContinuedAsNew(t, env)
+}
+
+func TestSchedulerWorkflow_Update(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.SetStartTime(testStartTime)
+
+	var a *activities
+	env.OnActivity(a.StartWorkflow, mock.Anything, mock.Anything).Return(&startWorkflowResponse{RunID: "run-id"}, nil)
+	env.OnActivity(a.WatchWorkflow, mock.Anything, mock.Anything).Return(&watchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED}, nil)
+
+	env.RegisterDelayedCallback(func() {
+		resp := describeTestSchedule(t, env)
+		schedule := resp.Schedule
+		schedule.Spec = ScheduleSpec{CronString: []string{"0 0 * * *"}}
+
+		// stale updates are dropped
+		env.SignalWorkflow(SignalNameUpdate, &UpdateRequest{Schedule: schedule, ConflictToken: resp.ConflictToken + 1})
+		env.SignalWorkflow(SignalNameUpdate, &UpdateRequest{Schedule: schedule, ConflictToken: resp.ConflictToken})
+	}, 90*time.Minute)
+	env.RegisterDelayedCallback(func() {
+		resp := describeTestSchedule(t, env)
+		require.Equal(t, []string{"0 0 * * *"}, resp.Schedule.Spec.CronString)
+		require.Equal(t, int64(1), resp.Info.ActionCount)
+		require.Equal(t, testStartTime.Add(24*time.Hour), resp.Info.FutureActionTimes[0])
+	}, 10*time.Hour)
+
+	env.ExecuteWorkflow(SchedulerWorkflow, newTestSchedule())
+	requireContinuedAsNew(t, env)
+}
+
+func TestValidateSchedule(t *testing.T) {
+	schedule := newTestSchedule().Schedule
+	require.NoError(t, ValidateSchedule(&schedule))
+
+	schedule.Policies.OverlapPolicy = "unknown"
+	require.Error(t, ValidateSchedule(&schedule))
+
+	schedule = newTestSchedule().Schedule
+	schedule.Action.TaskQueue = ""
+	require.Error(t, ValidateSchedule(&schedule))
+}
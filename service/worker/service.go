@@ -26,13 +26,16 @@ package worker
 
 import (
 	"math/rand"
+	"net"
 	"sync/atomic"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
 	sdkclient "go.temporal.io/sdk/client"
+	"google.golang.org/grpc"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/workerservice/v1"
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common"
 	carchiver "go.temporal.io/server/common/archiver"
@@ -93,6 +96,10 @@ type (
 		config           *Config
 
 		manager *workerManager
+
+		handler      *Handler
+		server       *grpc.Server
+		grpcListener net.Listener
 	}
 
 	// Config contains all the service config for worker
@@ -131,6 +138,9 @@ func NewService(
 	taskManager persistence.TaskManager,
 	historyClient historyservice.HistoryServiceClient,
 	manager *workerManager,
+	handler *Handler,
+	grpcServerOptions []grpc.ServerOption,
+	grpcListener net.Listener,
 ) (*Service, error) {
 	workerServiceResolver, err := membershipMonitor.GetResolver(common.WorkerServiceName)
 	if err != nil {
@@ -163,6 +173,10 @@ func NewService(
 		historyClient:             historyClient,
 
 		manager: manager,
+
+		handler:      handler,
+		server:       grpc.NewServer(grpcServerOptions...),
+		grpcListener: grpcListener,
 	}, nil
 }
 
@@ -357,6 +371,14 @@ func (s *Service) Start() {
 
 	s.manager.Start()
 
+	workerservice.RegisterWorkerServiceServer(s.server, s.handler)
+	go func() {
+		s.logger.Info("Starting to serve on worker listener")
+		if err := s.server.Serve(s.grpcListener); err != nil && err != grpc.ErrServerStopped {
+			s.logger.Fatal("Failed to serve on worker listener", tag.Error(err))
+		}
+	}()
+
 	s.logger.Info(
 		"worker service started",
 		tag.ComponentWorker,
@@ -377,6 +399,7 @@ func (s *Service) Stop() {
 
 	close(s.stopC)
 
+	s.server.Stop()
 	s.manager.Stop()
 	s.namespaceRegistry.Stop()
 	s.membershipMonitor.Stop()
@@ -414,7 +437,9 @@ func (s *Service) startBatcher() {
 		s.sdkSystemClient,
 		s.metricsClient,
 		s.logger,
-		s.sdkClientFactory).Start(); err != nil {
+		s.sdkClientFactory,
+		s.historyClient,
+		s.namespaceRegistry).Start(); err != nil {
 		s.logger.Fatal(
 			"error starting batcher",
 			tag.Error(err),
@@ -27,6 +27,7 @@ package temporal
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"google.golang.org/grpc"
@@ -57,6 +58,7 @@ import (
 	"go.temporal.io/server/common/ringpop"
 	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/frontend"
 	"go.temporal.io/server/service/history"
 	"go.temporal.io/server/service/matching"
@@ -93,6 +95,7 @@ type (
 func NewServerFx(opts ...ServerOption) *ServerFx {
 	app := fx.New(
 		pprof.Module,
+		tracing.Module,
 		ServerFxImplModule,
 		fx.Supply(opts),
 		fx.Provide(LoggerProvider),
@@ -119,6 +122,7 @@ func NewServerFx(opts ...ServerOption) *ServerFx {
 		fx.Provide(AuthorizerProvider),
 		fx.Provide(ClaimMapperProvider),
 		fx.Provide(JWTAudienceMapperProvider),
+		fx.Provide(AuditSinkProvider),
 		fx.Invoke(ServerLifetimeHooks),
 		fx.NopLogger,
 	)
@@ -166,6 +170,11 @@ func ESConfigAndClientProvider(so *serverOptions, logger log.Logger) (*esclient.
 		return nil, nil, fmt.Errorf("persistence config: advanced visibility datastore %q: missing config", so.config.Persistence.AdvancedVisibilityStore)
 	}
 
+	// Advanced visibility backed by SQL doesn't need Elasticsearch.
+	if advancedVisibilityStore.SQL != nil {
+		return nil, nil, nil
+	}
+
 	if so.elasticsearchHttpClient == nil {
 		var err error
 		so.elasticsearchHttpClient, err = esclient.NewAwsHttpClient(advancedVisibilityStore.Elasticsearch.AWSRequestSigning)
@@ -218,6 +227,24 @@ func JWTAudienceMapperProvider(so *serverOptions) authorization.JWTAudienceMappe
 	return so.audienceGetter
 }
 
+func AuditSinkProvider(so *serverOptions, lc fx.Lifecycle) (authorization.AuditSink, error) {
+	if so.auditSink != nil {
+		return so.auditSink, nil
+	}
+	auditSink, err := authorization.GetAuditSinkFromConfig(&so.config.Global.Authorization.AuditLog)
+	if err != nil {
+		return nil, err
+	}
+	if closer, ok := auditSink.(io.Closer); ok {
+		lc.Append(fx.Hook{
+			OnStop: func(context.Context) error {
+				return closer.Close()
+			},
+		})
+	}
+	return auditSink, nil
+}
+
 type (
 	ServiceProviderParamsCommon struct {
 		fx.In
@@ -240,6 +267,7 @@ type (
 		CustomInterceptors         []grpc.UnaryServerInterceptor
 		Authorizer                 authorization.Authorizer
 		ClaimMapper                authorization.ClaimMapper
+		AuditSink                  authorization.AuditSink
 		DataStoreFactory           persistenceClient.AbstractDataStoreFactory
 	}
 )
@@ -387,6 +415,7 @@ func FrontendServiceProvider(
 		fx.Provide(func() []grpc.UnaryServerInterceptor { return params.CustomInterceptors }),
 		fx.Provide(func() authorization.Authorizer { return params.Authorizer }),
 		fx.Provide(func() authorization.ClaimMapper { return params.ClaimMapper }),
+		fx.Provide(func() authorization.AuditSink { return params.AuditSink }),
 		fx.Provide(func() encryption.TLSConfigProvider { return params.TlsConfigProvider }),
 		fx.Provide(func() dynamicconfig.Client { return params.DynamicConfigClient }),
 		fx.Provide(func() ServiceName { return ServiceName(serviceName) }),
@@ -468,13 +497,31 @@ func WorkerServiceProvider(
 // Important note, persistence config and cluster metadata are later overriden via ApplyClusterMetadataConfigProvider.
 func SoExpander(so *serverOptions) (
 	*config.PProf,
+	*tracing.Config,
 	*config.Config,
 	resolver.ServiceResolver,
 ) {
-	return &so.config.Global.PProf, so.config, so.persistenceServiceResolver
+	return &so.config.Global.PProf, so.config.Global.Tracing, so.config, so.persistenceServiceResolver
+}
+
+func DynamicConfigClientProvider(
+	so *serverOptions,
+	logger log.Logger,
+	stoppedCh chan interface{},
+	persistenceServiceResolver resolver.ServiceResolver,
+	customDataStoreFactory persistenceClient.AbstractDataStoreFactory,
+) (dynamicconfig.Client, error) {
+	result, err := baseDynamicConfigClient(so, logger, stoppedCh)
+	if err != nil {
+		return nil, err
+	}
+	if so.config.PersistenceDynamicConfigClient == nil {
+		return result, nil
+	}
+	return persistenceDynamicConfigClient(so.config, result, logger, stoppedCh, persistenceServiceResolver, customDataStoreFactory)
 }
 
-func DynamicConfigClientProvider(so *serverOptions, logger log.Logger, stoppedCh chan interface{}) (dynamicconfig.Client, error) {
+func baseDynamicConfigClient(so *serverOptions, logger log.Logger, stoppedCh chan interface{}) (dynamicconfig.Client, error) {
 	var result dynamicconfig.Client
 	var err error
 	if so.dynamicConfigClient != nil {
@@ -493,6 +540,67 @@ func DynamicConfigClientProvider(so *serverOptions, logger log.Logger, stoppedCh
 	return dynamicconfig.NewNoopClient(), nil
 }
 
+// persistenceDynamicConfigClient creates the dynamic config client which serves values stored in the
+// cluster metadata store, falling back to the given client for keys without a stored value.
+// The persistence factory it uses is closed when the server stops.
+func persistenceDynamicConfigClient(
+	cfg *config.Config,
+	fallback dynamicconfig.Client,
+	logger log.Logger,
+	stoppedCh chan interface{},
+	persistenceServiceResolver resolver.ServiceResolver,
+	customDataStoreFactory persistenceClient.AbstractDataStoreFactory,
+) (dynamicconfig.Client, error) {
+	factory := persistenceClient.NewFactory(
+		&cfg.Persistence,
+		persistenceServiceResolver,
+		nil,
+		customDataStoreFactory,
+		cfg.ClusterMetadata.CurrentClusterName,
+		nil,
+		logger,
+	)
+	clusterMetadataManager, err := factory.NewClusterMetadataManager()
+	if err != nil {
+		factory.Close()
+		return nil, fmt.Errorf("error initializing cluster metadata manager: %w", err)
+	}
+
+	loader := func() ([]*persistencespb.DynamicConfigEntry, error) {
+		var entries []*persistencespb.DynamicConfigEntry
+		var nextPageToken []byte
+		for {
+			resp, err := clusterMetadataManager.ListDynamicConfig(&persistence.ListDynamicConfigRequest{
+				PageSize:      100,
+				NextPageToken: nextPageToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range resp.Entries {
+				entries = append(entries, e.Entry)
+			}
+			nextPageToken = resp.NextPageToken
+			if len(nextPageToken) == 0 {
+				return entries, nil
+			}
+		}
+	}
+
+	result, err := dynamicconfig.NewPersistenceBasedClient(cfg.PersistenceDynamicConfigClient, loader, fallback, logger, stoppedCh)
+	if err != nil {
+		clusterMetadataManager.Close()
+		factory.Close()
+		return nil, fmt.Errorf("unable to create persistence dynamic config client: %w", err)
+	}
+	go func() {
+		<-stoppedCh
+		clusterMetadataManager.Close()
+		factory.Close()
+	}()
+	return result, nil
+}
+
 func DcCollectionProvider(dynamicConfigClient dynamicconfig.Client, logger log.Logger) *dynamicconfig.Collection {
 	return dynamicconfig.NewCollection(dynamicConfigClient, logger)
 }
@@ -673,6 +781,7 @@ func loadClusterInformationFromStore(config *config.Config, clusterMsg persisten
 			Enabled:                metadata.IsConnectionEnabled,
 			InitialFailoverVersion: metadata.InitialFailoverVersion,
 			RPCAddress:             metadata.ClusterAddress,
+			ShardCount:             metadata.HistoryShardCount,
 		}
 		if staticClusterMetadata, ok := config.ClusterMetadata.ClusterInformation[metadata.ClusterName]; ok && metadata.ClusterName != config.ClusterMetadata.CurrentClusterName {
 			logger.Warn(
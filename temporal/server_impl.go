@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/resolver"
@@ -195,6 +196,16 @@ func newBootstrapParams(
 
 	params.MembershipFactoryInitializer =
 		func(persistenceBean persistenceClient.Bean, logger log.Logger) (resource.MembershipMonitorFactory, error) {
+			switch cfg.Global.Membership.Provider {
+			case config.MembershipProviderStatic, config.MembershipProviderDNS:
+				return membership.NewHostListFactory(
+					&cfg.Global.Membership,
+					svcName,
+					servicePortMap,
+					rpcFactory,
+					logger,
+				)
+			}
 			return ringpop.NewRingpopFactory(
 				&cfg.Global.Membership,
 				rpcFactory.GetRingpopChannel(),
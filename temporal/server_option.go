@@ -119,6 +119,13 @@ func WithAudienceGetter(audienceGetter func(cfg *config.Config) authorization.JW
 	})
 }
 
+// WithAuditSink sets a sink for the authorization audit log. It overrides the audit log file from config.
+func WithAuditSink(auditSink authorization.AuditSink) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.auditSink = auditSink
+	})
+}
+
 // Set custom metric reporter
 // for (deprecated) Tally it should be tally.BaseStatsReporter
 // for Prometheus with framework metrics.FrameworkCustom it should be metrics.Reporter
@@ -58,6 +58,7 @@ type (
 		tlsConfigProvider          encryption.TLSConfigProvider
 		claimMapper                authorization.ClaimMapper
 		audienceGetter             authorization.JWTAudienceMapper
+		auditSink                  authorization.AuditSink
 		metricsReporter            interface{}
 		persistenceServiceResolver resolver.ServiceResolver
 		elasticsearchHttpClient    *http.Client
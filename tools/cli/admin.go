@@ -265,6 +265,29 @@ func newAdminShardManagementCommands() []cli.Command {
 				AdminRemoveTask(c)
 			},
 		},
+		{
+			Name:  "top",
+			Usage: "Show the most loaded shards and their most requested workflows",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagMaxShards,
+					Value: 10,
+					Usage: "Max number of shards to show, all shards are shown if set to 0",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxWorkflowsPerShard,
+					Value: 3,
+					Usage: "Max number of most requested workflows to show for each shard",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminShardTop(c)
+			},
+		},
 	}
 }
 
@@ -549,6 +572,190 @@ func newAdminClusterCommands() []cli.Command {
 				AdminRemoveRemoteCluster(c)
 			},
 		},
+		{
+			Name:    "migrate-shard-count",
+			Aliases: []string{"msc"},
+			Usage:   "Migrate namespaces to a remote cluster which has more history shards",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     FlagCluster,
+					Usage:    "Remote cluster name, its history shard count must be a multiple of the current cluster's",
+					Required: true,
+				},
+				cli.StringSliceFlag{
+					Name:  FlagNamespaces,
+					Usage: "Global namespace to migrate (multiple values are supported), namespaces are migrated in order",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Usage: "Replication task generation rps per activity when replicating existing workflows",
+					Value: 10,
+				},
+				cli.IntFlag{
+					Name:  FlagConcurrency,
+					Usage: "Number of concurrent activities when replicating existing workflows",
+					Value: 1,
+				},
+				cli.IntFlag{
+					Name:  FlagAllowedLaggingSeconds,
+					Usage: "How far behind on replication the remote cluster may be before a namespace is handed over",
+					Value: 5,
+				},
+				cli.IntFlag{
+					Name:  FlagHandoverTimeoutSeconds,
+					Usage: "How long to wait for a namespace handover to complete before rolling it back",
+					Value: 30,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminMigrateShardCount(c)
+			},
+		},
+		{
+			Name:    "describe-shard-count-migration",
+			Aliases: []string{"dscm"},
+			Usage:   "Show progress of the history shard count migration",
+			Action: func(c *cli.Context) {
+				AdminDescribeShardCountMigration(c)
+			},
+		},
+	}
+}
+
+func newAdminDynamicConfigCommands() []cli.Command {
+	constraintFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagNamespace,
+			Usage: "Namespace constraint",
+		},
+		cli.StringFlag{
+			Name:  FlagNamespaceID,
+			Usage: "Namespace Id constraint",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskQueueWithAlias,
+			Usage: "Task queue name constraint",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskQueueTypeWithAlias,
+			Usage: "Task queue type constraint: workflow, activity",
+		},
+		cli.IntFlag{
+			Name:  FlagShardIDWithAlias,
+			Usage: "Shard Id constraint",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowTypeWithAlias,
+			Usage: "Workflow type constraint",
+		},
+	}
+	changeFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagReasonWithAlias,
+			Usage: "Reason for the change",
+		},
+		cli.StringFlag{
+			Name:  FlagIdentity,
+			Usage: "Identity of the operator making the change",
+		},
+	}
+
+	return []cli.Command{
+		{
+			Name:    "get",
+			Aliases: []string{"g"},
+			Usage:   "Show values and change history of a dynamic config key",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDynamicConfigKey,
+					Usage: "Dynamic config key",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminGetDynamicConfig(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List dynamic config keys and their values",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Page size",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListDynamicConfig(c)
+			},
+		},
+		{
+			Name:    "set",
+			Aliases: []string{"s"},
+			Usage:   "Set the value of a dynamic config key for the given constraints",
+			Flags: append(append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDynamicConfigKey,
+					Usage: "Dynamic config key",
+				},
+				cli.StringFlag{
+					Name:  FlagDynamicConfigValue,
+					Usage: "Value in JSON format (i.e. 100, true, \"5m\", {\"key\": \"value\"})",
+				},
+			}, constraintFlags...), changeFlags...),
+			Action: func(c *cli.Context) {
+				AdminSetDynamicConfig(c)
+			},
+		},
+		{
+			Name:    "delete",
+			Aliases: []string{"d"},
+			Usage:   "Delete the value of a dynamic config key for the given constraints, or all values if no constraints are given",
+			Flags: append(append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDynamicConfigKey,
+					Usage: "Dynamic config key",
+				},
+			}, constraintFlags...), changeFlags...),
+			Action: func(c *cli.Context) {
+				AdminDeleteDynamicConfig(c)
+			},
+		},
+		{
+			Name:    "effective",
+			Aliases: []string{"e"},
+			Usage:   "Show effective values of dynamic config keys for a namespace, task queue, shard or workflow type",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDynamicConfigKey,
+					Usage: "Dynamic config key. All registered keys are shown if not set",
+				},
+				cli.StringFlag{
+					Name:  FlagNamespace,
+					Usage: "Namespace",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "Task queue name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskQueueTypeWithAlias,
+					Usage: "Task queue type: workflow, activity",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "Shard Id",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowTypeWithAlias,
+					Usage: "Workflow type",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminGetEffectiveDynamicConfig(c)
+			},
+		},
 	}
 }
 
@@ -25,9 +25,15 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/urfave/cli"
+	sdkclient "go.temporal.io/sdk/client"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/service/worker"
+	"go.temporal.io/server/service/worker/migration"
 )
 
 // AdminDescribeCluster is used to dump information about the cluster
@@ -104,3 +110,49 @@ func AdminRemoveRemoteCluster(c *cli.Context) {
 		ErrorAndExit("Operation RemoveRemoteCluster failed.", err)
 	}
 }
+
+// AdminMigrateShardCount starts migrating namespaces to a remote cluster with a different number of history shards
+func AdminMigrateShardCount(c *cli.Context) {
+	namespaces := c.StringSlice(FlagNamespaces)
+	if len(namespaces) == 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", FlagNamespaces), nil)
+	}
+
+	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	options := sdkclient.StartWorkflowOptions{
+		ID:        migration.ShardCountMigrationWorkflowID,
+		TaskQueue: worker.DefaultWorkerTaskQueue,
+	}
+	params := migration.ShardCountMigrationParams{
+		Namespaces:              namespaces,
+		RemoteCluster:           getRequiredOption(c, FlagCluster),
+		ConcurrentActivityCount: c.Int(FlagConcurrency),
+		RpsPerActivity:          c.Int(FlagRPS),
+		AllowedLaggingSeconds:   c.Int(FlagAllowedLaggingSeconds),
+		HandoverTimeoutSeconds:  c.Int(FlagHandoverTimeoutSeconds),
+	}
+	run, err := client.ExecuteWorkflow(ctx, options, migration.ShardCountMigrationWorkflowName, params)
+	if err != nil {
+		ErrorAndExit("Failed to start shard count migration.", err)
+	}
+	fmt.Printf("Shard count migration started, run id: %s\n", run.GetRunID())
+}
+
+// AdminDescribeShardCountMigration shows progress of the shard count migration
+func AdminDescribeShardCountMigration(c *cli.Context) {
+	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	value, err := client.QueryWorkflow(ctx, migration.ShardCountMigrationWorkflowID, "", migration.ShardCountMigrationProgressQuery)
+	if err != nil {
+		ErrorAndExit("Failed to query shard count migration.", err)
+	}
+	var progress migration.ShardCountMigrationProgress
+	if err := value.Get(&progress); err != nil {
+		ErrorAndExit("Failed to decode shard count migration progress.", err)
+	}
+	prettyPrintJSONObject(progress)
+}
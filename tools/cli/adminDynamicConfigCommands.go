@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// AdminGetDynamicConfig shows values and change history of a dynamic config key
+func AdminGetDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{
+		Key: getRequiredOption(c, FlagDynamicConfigKey),
+	})
+	if err != nil {
+		ErrorAndExit("Operation GetDynamicConfig failed.", err)
+	}
+	prettyPrintJSONObject(response)
+}
+
+// AdminListDynamicConfig lists dynamic config keys and their values
+func AdminListDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	var token []byte
+
+	pageSize := c.Int(FlagPageSize)
+	for more := true; more; more = len(token) > 0 {
+		if more && len(token) > 0 {
+			if !showNextPage() {
+				break
+			}
+		}
+		ctx, cancel := newContext(c)
+		response, err := adminClient.ListDynamicConfig(ctx, &adminservice.ListDynamicConfigRequest{
+			PageSize:      int32(pageSize),
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Operation ListDynamicConfig failed.", err)
+		}
+		token = response.GetNextPageToken()
+		for _, entry := range response.GetEntries() {
+			// change history is only shown by get
+			prettyPrintJSONObject(&persistencespb.DynamicConfigEntry{
+				Key:    entry.GetKey(),
+				Values: entry.GetValues(),
+			})
+		}
+	}
+}
+
+// AdminSetDynamicConfig sets the value of a dynamic config key
+func AdminSetDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.SetDynamicConfig(ctx, &adminservice.SetDynamicConfigRequest{
+		Key:         getRequiredOption(c, FlagDynamicConfigKey),
+		Value:       []byte(getRequiredOption(c, FlagDynamicConfigValue)),
+		Constraints: getDynamicConfigConstraints(c),
+		Identity:    getDynamicConfigChangeIdentity(c),
+		Reason:      c.String(FlagReason),
+	})
+	if err != nil {
+		ErrorAndExit("Operation SetDynamicConfig failed.", err)
+	}
+	fmt.Printf("Dynamic config updated to version %d.\n", response.GetVersion())
+}
+
+// AdminDeleteDynamicConfig deletes values of a dynamic config key
+func AdminDeleteDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	var constraints *persistencespb.DynamicConfigConstraints
+	if hasDynamicConfigConstraints(c) {
+		constraints = getDynamicConfigConstraints(c)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DeleteDynamicConfig(ctx, &adminservice.DeleteDynamicConfigRequest{
+		Key:         getRequiredOption(c, FlagDynamicConfigKey),
+		Constraints: constraints,
+		Identity:    getDynamicConfigChangeIdentity(c),
+		Reason:      c.String(FlagReason),
+	})
+	if err != nil {
+		ErrorAndExit("Operation DeleteDynamicConfig failed.", err)
+	}
+	fmt.Printf("Dynamic config updated to version %d.\n", response.GetVersion())
+}
+
+// AdminGetEffectiveDynamicConfig shows effective values of dynamic config keys
+func AdminGetEffectiveDynamicConfig(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	constraints := getDynamicConfigConstraints(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.GetEffectiveDynamicConfig(ctx, &adminservice.GetEffectiveDynamicConfigRequest{
+		Key:           c.String(FlagDynamicConfigKey),
+		Namespace:     constraints.GetNamespace(),
+		TaskQueue:     constraints.GetTaskQueueName(),
+		TaskQueueType: constraints.GetTaskQueueType(),
+		ShardId:       constraints.GetShardId(),
+		WorkflowType:  constraints.GetWorkflowType(),
+	})
+	if err != nil {
+		ErrorAndExit("Operation GetEffectiveDynamicConfig failed.", err)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Key", "Type", "Value", "Default"})
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, v := range response.GetValues() {
+		table.Append([]string{v.GetKey(), v.GetType(), string(v.GetValue()), strconv.FormatBool(v.GetIsDefault())})
+	}
+	table.Render()
+}
+
+func hasDynamicConfigConstraints(c *cli.Context) bool {
+	for _, flag := range []string{FlagNamespace, FlagNamespaceID, FlagTaskQueue, FlagTaskQueueType, FlagShardID, FlagWorkflowType} {
+		if c.IsSet(flag) {
+			return true
+		}
+	}
+	return false
+}
+
+func getDynamicConfigConstraints(c *cli.Context) *persistencespb.DynamicConfigConstraints {
+	constraints := &persistencespb.DynamicConfigConstraints{
+		Namespace:     c.String(FlagNamespace),
+		NamespaceId:   c.String(FlagNamespaceID),
+		TaskQueueName: c.String(FlagTaskQueue),
+		ShardId:       int32(c.Int(FlagShardID)),
+		WorkflowType:  c.String(FlagWorkflowType),
+	}
+	if c.IsSet(FlagTaskQueueType) {
+		taskQueueType, err := stringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+		if err != nil {
+			ErrorAndExit("Failed to parse task queue type", err)
+		}
+		constraints.TaskQueueType = enumspb.TaskQueueType(taskQueueType)
+	}
+	return constraints
+}
+
+func getDynamicConfigChangeIdentity(c *cli.Context) string {
+	if c.IsSet(FlagIdentity) {
+		return c.String(FlagIdentity)
+	}
+	return getCliIdentity()
+}
@@ -0,0 +1,128 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// AdminShardTop shows the most loaded shards and their most requested workflows
+func AdminShardTop(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.ListShardLoad(ctx, &adminservice.ListShardLoadRequest{
+		MaxShards:            int32(c.Int(FlagMaxShards)),
+		MaxWorkflowsPerShard: int32(c.Int(FlagMaxWorkflowsPerShard)),
+	})
+	if err != nil {
+		ErrorAndExit("Operation ListShardLoad failed.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(response)
+		return
+	}
+
+	header := []string{"Shard", "Host", "RPS", "Top API", "Transfer ID Lag", "Timer Lag", "Cache Hit", "Persistence Avg", "Persistence Max"}
+	headerColors := make([]tablewriter.Colors, len(header))
+	for i := range headerColors {
+		headerColors[i] = tableHeaderBlue
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColors...)
+	for _, shard := range response.GetShards() {
+		table.Append([]string{
+			strconv.Itoa(int(shard.GetShardId())),
+			shard.GetHostAddress(),
+			formatRate(shard.GetRequestsPerSecond()),
+			topShardAPI(shard),
+			strconv.FormatInt(shard.GetTaskIdLag()[tasks.CategoryNameTransfer], 10),
+			timestamp.DurationValue(shard.GetTimerAckLag()).String(),
+			fmt.Sprintf("%.1f%%", shard.GetMutableStateCacheHitRate()*100),
+			timestamp.DurationValue(shard.GetPersistenceLatencyAvg()).String(),
+			timestamp.DurationValue(shard.GetPersistenceLatencyMax()).String(),
+		})
+	}
+	table.Render()
+
+	workflows := tablewriter.NewWriter(os.Stdout)
+	workflows.SetHeader([]string{"Shard", "Namespace ID", "Workflow ID", "Requests"})
+	workflows.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, shard := range response.GetShards() {
+		for _, workflow := range shard.GetTopWorkflows() {
+			workflows.Append([]string{
+				strconv.Itoa(int(shard.GetShardId())),
+				workflow.GetNamespaceId(),
+				workflow.GetWorkflowId(),
+				strconv.FormatInt(workflow.GetRequests(), 10),
+			})
+		}
+	}
+	if workflows.NumLines() > 0 {
+		fmt.Println()
+		workflows.Render()
+	}
+
+	if len(response.GetFailedHosts()) > 0 {
+		fmt.Printf("\nFailed to get shard load from history hosts: %v\n", response.GetFailedHosts())
+	}
+}
+
+// topShardAPI returns the API with the highest request rate on the shard along with its rate.
+func topShardAPI(shard *historyspb.ShardLoad) string {
+	apis := make([]string, 0, len(shard.GetApiRequestsPerSecond()))
+	for api := range shard.GetApiRequestsPerSecond() {
+		apis = append(apis, api)
+	}
+	if len(apis) == 0 {
+		return ""
+	}
+	sort.Slice(apis, func(i, j int) bool {
+		rates := shard.GetApiRequestsPerSecond()
+		if rates[apis[i]] != rates[apis[j]] {
+			return rates[apis[i]] > rates[apis[j]]
+		}
+		return apis[i] < apis[j]
+	})
+	return fmt.Sprintf("%s (%s)", apis[0], formatRate(shard.GetApiRequestsPerSecond()[apis[0]]))
+}
+
+func formatRate(rate float64) string {
+	return strconv.FormatFloat(rate, 'f', 1, 64)
+}
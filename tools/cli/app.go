@@ -139,6 +139,12 @@ func NewCliApp() *cli.App {
 			Usage:       "Batch operation on a list of workflows from query.",
 			Subcommands: newBatchCommands(),
 		},
+		{
+			Name:        "schedule",
+			Aliases:     []string{"sched"},
+			Usage:       "Operate schedules of workflows.",
+			Subcommands: newScheduleCommands(),
+		},
 		{
 			Name:    "admin",
 			Aliases: []string{"adm"},
@@ -185,6 +191,12 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on DLQ",
 					Subcommands: newAdminDLQCommands(),
 				},
+				{
+					Name:        "dynamic-config",
+					Aliases:     []string{"dc"},
+					Usage:       "Run admin operation on dynamic config stored in the database",
+					Subcommands: newAdminDynamicConfigCommands(),
+				},
 				{
 					Name:        "db",
 					Aliases:     []string{"db"},
@@ -88,6 +88,10 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagListQueryWithAlias,
 					Usage: "Query to get workflows for being executed this batch operation",
 				},
+				cli.StringSliceFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId of a workflow to execute this batch operation on, alternative to query (can be repeated)",
+				},
 				cli.StringFlag{
 					Name:  FlagReasonWithAlias,
 					Usage: "Reason to run this batch job",
@@ -105,10 +109,22 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagInputWithAlias,
 					Usage: "Optional input of signal",
 				},
+				cli.StringFlag{
+					Name:  FlagResetType,
+					Usage: "Required for batch reset, supported: " + strings.Join(mapKeysToArray(resetTypesMap), ","),
+				},
+				cli.StringFlag{
+					Name:  FlagResetBadBinaryChecksum,
+					Usage: "Required for batch reset with reset type BadBinary",
+				},
+				cli.StringFlag{
+					Name:  FlagResetReapplyType,
+					Usage: "Optional for batch reset, whether to reapply events after the reset point: " + strings.Join(mapKeysToArray(batchResetReapplyTypes), ","),
+				},
 				cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
-					Usage: "RPS of processing",
+					Usage: "Max RPS of processing, the actual rate backs off while the cluster is overloaded",
 				},
 				cli.BoolFlag{
 					Name:  FlagYes,
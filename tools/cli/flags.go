@@ -26,6 +26,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/urfave/cli"
 )
@@ -202,6 +203,7 @@ const (
 	FlagRPS                                   = "rps"
 	FlagJobID                                 = "job_id"
 	FlagJobIDWithAlias                        = FlagJobID + ", jid"
+	FlagUpdateID                              = "update_id"
 	FlagYes                                   = "yes"
 	FlagServiceConfigDir                      = "service_config_dir"
 	FlagServiceConfigDirWithAlias             = FlagServiceConfigDir + ", scd"
@@ -221,8 +223,13 @@ const (
 	FlagDLQTypeWithAlias                      = FlagDLQType + ", dt"
 	FlagMaxMessageCount                       = "max_message_count"
 	FlagMaxMessageCountWithAlias              = FlagMaxMessageCount + ", mmc"
+	FlagMaxShards                             = "max_shards"
+	FlagMaxWorkflowsPerShard                  = "max_workflows_per_shard"
 	FlagLastMessageID                         = "last_message_id"
 	FlagConcurrency                           = "concurrency"
+	FlagNamespaces                            = "namespaces"
+	FlagAllowedLaggingSeconds                 = "allowed_lagging_seconds"
+	FlagHandoverTimeoutSeconds                = "handover_timeout_seconds"
 	FlagReportRate                            = "report_rate"
 	FlagLowerShardBound                       = "lower_shard_bound"
 	FlagUpperShardBound                       = "upper_shard_bound"
@@ -241,6 +248,22 @@ const (
 	FlagPort                                  = "port"
 	FlagConnectionEnable                      = "enable_connection"
 	FlagConnectionEnableWithAlias             = FlagConnectionEnable + ", ec"
+	FlagScheduleID                            = "schedule_id"
+	FlagScheduleIDWithAlias                   = FlagScheduleID + ", sid"
+	FlagCalendar                              = "calendar"
+	FlagInterval                              = "interval"
+	FlagScheduleStartTime                     = "start_time"
+	FlagScheduleEndTime                       = "end_time"
+	FlagJitter                                = "jitter"
+	FlagTimeZone                              = "time_zone"
+	FlagOverlapPolicy                         = "overlap_policy"
+	FlagCatchupWindow                         = "catchup_window"
+	FlagNotes                                 = "notes"
+	FlagPause                                 = "pause"
+	FlagForce                                 = "force"
+	FlagBuildID                               = "build_id"
+	FlagCompatibleBuildID                     = "compatible_build_id"
+	FlagSetDefault                            = "set_default"
 
 	FlagProtoType  = "type"
 	FlagHexData    = "hex_data"
@@ -250,6 +273,9 @@ const (
 	FlagBase64File = "base64_file"
 
 	FlagSkipSchemaUpdate = "skip-schema-update"
+
+	FlagDynamicConfigKey   = "key"
+	FlagDynamicConfigValue = "value"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -398,6 +424,72 @@ func getFlagsForStart() []cli.Flag {
 	}
 }
 
+func getFlagsForScheduleSpec() []cli.Flag {
+	return []cli.Flag{
+		cli.StringSliceFlag{
+			Name: FlagCronSchedule,
+			Usage: "Cron string of the schedule, e.g. \"0 12 * * MON-FRI\". " +
+				"If there are multiple cron strings, provide multiple flags",
+		},
+		cli.StringSliceFlag{
+			Name: FlagCalendar,
+			Usage: "Calendar spec of the schedule in JSON format, e.g. '{\"Hour\":\"9,17\",\"DayOfWeek\":\"MON-FRI\"}'. " +
+				"Supported fields: Second, Minute, Hour, DayOfMonth, Month, DayOfWeek, Year. " +
+				"If there are multiple calendars, provide multiple flags",
+		},
+		cli.StringSliceFlag{
+			Name: FlagInterval,
+			Usage: "Interval of the schedule in format 'interval[/phase]', e.g. '90m' or '1h/15m'. " +
+				"If there are multiple intervals, provide multiple flags",
+		},
+		cli.StringFlag{
+			Name:  FlagScheduleStartTime,
+			Usage: "Optional time before which the schedule takes no actions, in UTC format '2006-01-02T15:04:05'",
+		},
+		cli.StringFlag{
+			Name:  FlagScheduleEndTime,
+			Usage: "Optional time after which the schedule takes no actions, in UTC format '2006-01-02T15:04:05'",
+		},
+		cli.StringFlag{
+			Name:  FlagJitter,
+			Usage: "Optional maximum random delay of each action, e.g. '30s'",
+		},
+		cli.StringFlag{
+			Name:  FlagTimeZone,
+			Usage: "Optional IANA time zone name used to interpret cron strings and calendars. Default to UTC",
+		},
+		cli.StringFlag{
+			Name:  FlagOverlapPolicy,
+			Usage: "Optional policy applied when an action is due while a previous workflow is still running. Options: " + strings.Join(scheduleOverlapPolicies(), ", "),
+		},
+		cli.StringFlag{
+			Name:  FlagCatchupWindow,
+			Usage: "Optional window in which missed actions are still taken, e.g. '10m'. Default to 1m",
+		},
+		cli.StringFlag{
+			Name:  FlagNotes,
+			Usage: "Optional notes of the schedule",
+		},
+		cli.BoolFlag{
+			Name:  FlagPause,
+			Usage: "Optional flag to pause the schedule",
+		},
+	}
+}
+
+func getFlagsForScheduleAction() []cli.Flag {
+	var flags []cli.Flag
+	for _, flag := range getFlagsForStart() {
+		switch flag.GetName() {
+		case FlagCronSchedule, FlagWorkflowIDReusePolicyAlias:
+			// the schedule spec replaces the cron schedule, and every action starts a new workflow id
+		default:
+			flags = append(flags, flag)
+		}
+	}
+	return flags
+}
+
 func getFlagsForRun() []cli.Flag {
 	flagsForRun := []cli.Flag{
 		cli.BoolFlag{
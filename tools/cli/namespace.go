@@ -104,5 +104,14 @@ func newNamespaceCommands() []cli.Command {
 				newNamespaceCLI(c, false).ListNamespaces(c)
 			},
 		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "Delete existing workflow namespace and all its data",
+			Flags:   deleteNamespaceFlags,
+			Action: func(c *cli.Context) {
+				DeleteNamespace(c)
+			},
+		},
 	}
 }
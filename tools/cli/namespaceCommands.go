@@ -31,6 +31,7 @@ import (
 	"os"
 	"strconv"
 
+	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -39,6 +40,8 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
@@ -325,6 +328,31 @@ func (d *namespaceCLIImpl) DescribeNamespace(c *cli.Context) {
 	printNamespace(resp)
 }
 
+// DeleteNamespace deletes namespace and starts asynchronous deletion of all its data
+func DeleteNamespace(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+
+	promptMsg := color.RedString("Namespace %s and all its workflow executions will be deleted. This can't be undone. Continue? Y/N", namespace)
+	prompt(promptMsg, c.GlobalBool(FlagAutoConfirm) || c.Bool(FlagYes))
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			ErrorAndExit(fmt.Sprintf("Namespace %s does not exist.", namespace), err)
+		}
+		ErrorAndExit("Operation DeleteNamespace failed.", err)
+	}
+
+	fmt.Printf("Namespace %s has been renamed to %s and marked as deleted.\n", namespace, resp.GetDeletedNamespace())
+	fmt.Printf("Namespace data is being deleted by workflow %s. To check progress run:\n", resp.GetReclaimResourcesWorkflowId())
+	fmt.Printf("  tctl --ns %s workflow query --wid %s --qt progress\n", common.SystemLocalNamespace, resp.GetReclaimResourcesWorkflowId())
+}
+
 func printNamespace(resp *workflowservice.DescribeNamespaceResponse) {
 	var formatStr = "Name: %v\nId: %v\nDescription: %v\nOwnerEmail: %v\nNamespaceData: %#v\nState: %v\nRetention: %v\n" +
 		"ActiveClusterName: %v\nClusters: %v\nHistoryArchivalState: %v\n"
@@ -173,6 +173,13 @@ var (
 
 	listNamespacesFlags = []cli.Flag{}
 
+	deleteNamespaceFlags = []cli.Flag{
+		cli.BoolFlag{
+			Name:  FlagYes,
+			Usage: "Optional flag to disable confirmation prompt",
+		},
+	}
+
 	adminNamespaceCommonFlags = []cli.Flag{
 		cli.StringFlag{
 			Name:  FlagServiceConfigDirWithAlias,
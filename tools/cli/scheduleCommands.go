@@ -0,0 +1,347 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/service/worker/scheduler"
+)
+
+// CreateSchedule creates a new schedule
+func CreateSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	schedule := scheduleFromCLI(c)
+	if err := scheduler.ValidateSchedule(schedule); err != nil {
+		ErrorAndExit("Invalid schedule", err)
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.CreateSchedule(tcCtx, &adminservice.CreateScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+		Schedule:   encodeSchedulePayload(schedule),
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to create schedule", err)
+	}
+	fmt.Printf("Schedule %s is created\n", scheduleID)
+}
+
+// UpdateSchedule replaces the definition of a schedule
+func UpdateSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	schedule := scheduleFromCLI(c)
+	if err := scheduler.ValidateSchedule(schedule); err != nil {
+		ErrorAndExit("Invalid schedule", err)
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	current := describeSchedule(c, adminClient, namespace, scheduleID)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UpdateSchedule(tcCtx, &adminservice.UpdateScheduleRequest{
+		Namespace:     namespace,
+		ScheduleId:    scheduleID,
+		Schedule:      encodeSchedulePayload(schedule),
+		ConflictToken: current.ConflictToken,
+		Identity:      getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to update schedule", err)
+	}
+	fmt.Printf("Schedule %s is updated\n", scheduleID)
+}
+
+// DescribeSchedule prints the definition and the state of a schedule
+func DescribeSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	adminClient := cFactory.AdminClient(c)
+	prettyPrintJSONObject(describeSchedule(c, adminClient, namespace, scheduleID))
+}
+
+// PauseSchedule pauses a schedule
+func PauseSchedule(c *cli.Context) {
+	reason := getRequiredOption(c, FlagReason)
+	patchSchedule(c, &scheduler.SchedulePatch{Pause: reason})
+	fmt.Println("Schedule is paused")
+}
+
+// UnpauseSchedule unpauses a schedule
+func UnpauseSchedule(c *cli.Context) {
+	reason := getRequiredOption(c, FlagReason)
+	patchSchedule(c, &scheduler.SchedulePatch{Unpause: reason})
+	fmt.Println("Schedule is unpaused")
+}
+
+// TriggerSchedule takes the action of a schedule immediately
+func TriggerSchedule(c *cli.Context) {
+	patchSchedule(c, &scheduler.SchedulePatch{
+		TriggerImmediately: &scheduler.TriggerImmediatelyRequest{
+			OverlapPolicy: scheduler.OverlapPolicy(c.String(FlagOverlapPolicy)),
+		},
+	})
+	fmt.Println("Schedule is triggered")
+}
+
+// BackfillSchedule takes the actions that a schedule would have taken in a time range
+func BackfillSchedule(c *cli.Context) {
+	now := time.Now().UTC()
+	startTime := parseTime(getRequiredOption(c, FlagScheduleStartTime), time.Time{}, now)
+	endTime := parseTime(getRequiredOption(c, FlagScheduleEndTime), time.Time{}, now)
+	patchSchedule(c, &scheduler.SchedulePatch{
+		BackfillRequest: []scheduler.BackfillRequest{
+			{
+				StartTime:     startTime,
+				EndTime:       endTime,
+				OverlapPolicy: scheduler.OverlapPolicy(c.String(FlagOverlapPolicy)),
+			},
+		},
+	})
+	fmt.Println("Schedule backfill is requested")
+}
+
+// DeleteSchedule deletes a schedule
+func DeleteSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	reason := getRequiredOption(c, FlagReason)
+
+	adminClient := cFactory.AdminClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.DeleteSchedule(tcCtx, &adminservice.DeleteScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+		Reason:     reason,
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to delete schedule", err)
+	}
+	fmt.Printf("Schedule %s is deleted\n", scheduleID)
+}
+
+// ListSchedules lists the schedules of a namespace
+func ListSchedules(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	pageSize := c.Int(FlagPageSize)
+
+	adminClient := cFactory.AdminClient(c)
+	var scheduleIDs []string
+	var nextPageToken []byte
+	for {
+		tcCtx, cancel := newContext(c)
+		resp, err := adminClient.ListSchedules(tcCtx, &adminservice.ListSchedulesRequest{
+			Namespace:     namespace,
+			PageSize:      int32(pageSize),
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list schedules", err)
+		}
+		scheduleIDs = append(scheduleIDs, resp.GetScheduleIds()...)
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	output := make([]interface{}, 0, len(scheduleIDs))
+	for _, scheduleID := range scheduleIDs {
+		output = append(output, map[string]string{
+			"scheduleId": scheduleID,
+		})
+	}
+	prettyPrintJSONObject(output)
+}
+
+func describeSchedule(c *cli.Context, adminClient adminservice.AdminServiceClient, namespace, scheduleID string) *scheduler.DescribeResponse {
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeSchedule(tcCtx, &adminservice.DescribeScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe schedule", err)
+	}
+	result := &scheduler.DescribeResponse{ConflictToken: resp.GetConflictToken()}
+	if err := payload.Decode(resp.GetSchedule(), &result.Schedule); err != nil {
+		ErrorAndExit("Failed to decode schedule", err)
+	}
+	if err := payload.Decode(resp.GetInfo(), &result.Info); err != nil {
+		ErrorAndExit("Failed to decode schedule info", err)
+	}
+	return result
+}
+
+func patchSchedule(c *cli.Context, patch *scheduler.SchedulePatch) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	if err := scheduler.ValidatePatch(patch); err != nil {
+		ErrorAndExit("Invalid schedule patch", err)
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.PatchSchedule(tcCtx, &adminservice.PatchScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+		Patch:      encodeSchedulePayload(patch),
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to patch schedule", err)
+	}
+}
+
+func encodeSchedulePayload(value interface{}) *commonpb.Payload {
+	p, err := payload.Encode(value)
+	if err != nil {
+		ErrorAndExit("Failed to serialize schedule", err)
+	}
+	return p
+}
+
+func scheduleFromCLI(c *cli.Context) *scheduler.Schedule {
+	return &scheduler.Schedule{
+		Spec:   scheduleSpecFromCLI(c),
+		Action: scheduleActionFromCLI(c),
+		Policies: scheduler.SchedulePolicies{
+			OverlapPolicy: scheduler.OverlapPolicy(c.String(FlagOverlapPolicy)),
+			CatchupWindow: parseScheduleDuration(c, FlagCatchupWindow),
+		},
+		State: scheduler.ScheduleState{
+			Notes:  c.String(FlagNotes),
+			Paused: c.Bool(FlagPause),
+		},
+	}
+}
+
+func scheduleSpecFromCLI(c *cli.Context) scheduler.ScheduleSpec {
+	now := time.Now().UTC()
+	spec := scheduler.ScheduleSpec{
+		CronString:   c.StringSlice(FlagCronSchedule),
+		StartTime:    parseTime(c.String(FlagScheduleStartTime), time.Time{}, now),
+		EndTime:      parseTime(c.String(FlagScheduleEndTime), time.Time{}, now),
+		Jitter:       parseScheduleDuration(c, FlagJitter),
+		TimezoneName: c.String(FlagTimeZone),
+	}
+	for _, calendarJSON := range c.StringSlice(FlagCalendar) {
+		var calendar scheduler.CalendarSpec
+		if err := json.Unmarshal([]byte(calendarJSON), &calendar); err != nil {
+			ErrorAndExit(fmt.Sprintf("Invalid calendar %s", calendarJSON), err)
+		}
+		spec.Calendar = append(spec.Calendar, calendar)
+	}
+	for _, intervalStr := range c.StringSlice(FlagInterval) {
+		var interval scheduler.IntervalSpec
+		parts := strings.SplitN(intervalStr, "/", 2)
+		var err error
+		if interval.Interval, err = time.ParseDuration(parts[0]); err != nil {
+			ErrorAndExit(fmt.Sprintf("Invalid interval %s", intervalStr), err)
+		}
+		if len(parts) == 2 {
+			if interval.Phase, err = time.ParseDuration(parts[1]); err != nil {
+				ErrorAndExit(fmt.Sprintf("Invalid interval %s", intervalStr), err)
+			}
+		}
+		spec.Interval = append(spec.Interval, interval)
+	}
+	return spec
+}
+
+func scheduleActionFromCLI(c *cli.Context) scheduler.StartWorkflowAction {
+	input, err := payloads.Encode(unmarshalInputsFromCLI(c)...)
+	if err != nil {
+		ErrorAndExit("Failed to serialize input", err)
+	}
+	action := scheduler.StartWorkflowAction{
+		WorkflowID:               getRequiredOption(c, FlagWorkflowID),
+		WorkflowType:             getRequiredOption(c, FlagWorkflowType),
+		TaskQueue:                getRequiredOption(c, FlagTaskQueue),
+		Input:                    input,
+		WorkflowExecutionTimeout: time.Duration(c.Int(FlagWorkflowExecutionTimeout)) * time.Second,
+		WorkflowRunTimeout:       time.Duration(c.Int(FlagWorkflowRunTimeout)) * time.Second,
+		WorkflowTaskTimeout:      time.Duration(c.Int(FlagWorkflowTaskTimeout)) * time.Second,
+	}
+	if memo := unmarshalMemoFromCLI(c); len(memo) > 0 {
+		action.Memo = &commonpb.Memo{Fields: encodeScheduleFields(memo)}
+	}
+	if searchAttributes := unmarshalSearchAttrFromCLI(c); len(searchAttributes) > 0 {
+		action.SearchAttributes = &commonpb.SearchAttributes{IndexedFields: encodeScheduleFields(searchAttributes)}
+	}
+	return action
+}
+
+func encodeScheduleFields(fields map[string]interface{}) map[string]*commonpb.Payload {
+	result := make(map[string]*commonpb.Payload, len(fields))
+	for key, value := range fields {
+		p, err := payload.Encode(value)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to serialize %s", key), err)
+		}
+		result[key] = p
+	}
+	return result
+}
+
+func parseScheduleDuration(c *cli.Context, flagName string) time.Duration {
+	if !c.IsSet(flagName) {
+		return 0
+	}
+	d, err := time.ParseDuration(c.String(flagName))
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Invalid duration for %s", flagName), err)
+	}
+	return d
+}
+
+func scheduleOverlapPolicies() []string {
+	var result []string
+	for _, policy := range scheduler.AllOverlapPolicies {
+		result = append(result, string(policy))
+	}
+	return result
+}
@@ -55,6 +55,25 @@ func newTaskQueueCommands() []cli.Command {
 				DescribeTaskQueue(c)
 			},
 		},
+		{
+			Name:    "describe-stats",
+			Aliases: []string{"ds"},
+			Usage:   "Describe backlog and throughput stats of task queue aggregated across its partitions",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "TaskQueue name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskQueueTypeWithAlias,
+					Value: "workflow",
+					Usage: "Optional TaskQueue type [workflow|activity]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DescribeTaskQueueStats(c)
+			},
+		},
 		{
 			Name:    "list-partition",
 			Aliases: []string{"lp"},
@@ -69,6 +88,64 @@ func newTaskQueueCommands() []cli.Command {
 				ListTaskQueuePartitions(c)
 			},
 		},
+		{
+			Name:    "add-build-id",
+			Aliases: []string{"abi"},
+			Usage:   "Add a worker build ID to the version graph of a workflow task queue",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "TaskQueue name",
+				},
+				cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Worker build ID (binary checksum) to add",
+				},
+				cli.StringFlag{
+					Name:  FlagCompatibleBuildID,
+					Usage: "Optional existing build ID the new one is compatible with. When not set, the new build ID starts a new, incompatible version set",
+				},
+				cli.BoolFlag{
+					Name:  FlagSetDefault,
+					Usage: "Make the version set of the new build ID the default one for new workflows",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AddTaskQueueBuildID(c)
+			},
+		},
+		{
+			Name:    "promote-build-id",
+			Aliases: []string{"pbi"},
+			Usage:   "Make the version set containing a worker build ID the default one for new workflows",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "TaskQueue name",
+				},
+				cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Existing worker build ID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PromoteTaskQueueBuildID(c)
+			},
+		},
+		{
+			Name:    "get-build-ids",
+			Aliases: []string{"gbi"},
+			Usage:   "Show the version graph of worker build IDs of a workflow task queue",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "TaskQueue name",
+				},
+			},
+			Action: func(c *cli.Context) {
+				GetTaskQueueBuildIDs(c)
+			},
+		},
 	}
 }
 
@@ -25,7 +25,9 @@
 package cli
 
 import (
+	"fmt"
 	"os"
+	"strings"
 
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
@@ -33,6 +35,11 @@ import (
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
 )
 
 // DescribeTaskQueue show pollers info of a given taskqueue
@@ -80,6 +87,120 @@ func ListTaskQueuePartitions(c *cli.Context) {
 	}
 }
 
+// DescribeTaskQueueStats shows backlog and throughput stats of a task queue aggregated across its partitions
+func DescribeTaskQueueStats(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	taskQueue := getRequiredOption(c, FlagTaskQueue)
+	taskQueueType := strToTaskQueueType(c.String(FlagTaskQueueType)) // default type is workflow
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeTaskQueueStats(ctx, &adminservice.DescribeTaskQueueStatsRequest{
+		Namespace:     namespace,
+		TaskQueue:     taskQueue,
+		TaskQueueType: taskQueueType,
+	})
+	if err != nil {
+		ErrorAndExit("Operation DescribeTaskQueueStats failed.", err)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Partition", "Host", "Backlog", "Backlog Age", "Add Rate", "Dispatch Rate", "Sync Match Ratio"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, partition := range response.GetPartitions() {
+		table.Append(taskQueueStatsRow(partition.GetPartition(), partition.GetOwnerHostName(), partition.GetStats()))
+	}
+	table.Append(taskQueueStatsRow("Total", "", response.GetStats()))
+	table.Render()
+}
+
+func taskQueueStatsRow(partition string, host string, stats *taskqueuespb.TaskQueueStats) []string {
+	return []string{
+		partition,
+		host,
+		fmt.Sprint(stats.GetApproximateBacklogCount()),
+		timestamp.DurationValue(stats.GetApproximateBacklogAge()).String(),
+		fmt.Sprintf("%.2f/s", stats.GetTasksAddRate()),
+		fmt.Sprintf("%.2f/s", stats.GetTasksDispatchRate()),
+		fmt.Sprintf("%.2f", stats.GetSyncMatchRatio()),
+	}
+}
+
+// AddTaskQueueBuildID adds a worker build ID to the version graph of a workflow task queue
+func AddTaskQueueBuildID(c *cli.Context) {
+	updateTaskQueueBuildIDs(c, c.String(FlagCompatibleBuildID), c.Bool(FlagSetDefault))
+}
+
+// PromoteTaskQueueBuildID makes the version set containing a worker build ID the default one
+func PromoteTaskQueueBuildID(c *cli.Context) {
+	updateTaskQueueBuildIDs(c, "", true)
+}
+
+func updateTaskQueueBuildIDs(c *cli.Context, compatibleBuildID string, becomeDefault bool) {
+	adminClient := cFactory.AdminClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	taskQueue := getRequiredOption(c, FlagTaskQueue)
+	buildID := getRequiredOption(c, FlagBuildID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.UpdateWorkerBuildIdOrdering(ctx, &adminservice.UpdateWorkerBuildIdOrderingRequest{
+		Namespace:         namespace,
+		TaskQueue:         taskQueue,
+		BuildId:           buildID,
+		CompatibleBuildId: compatibleBuildID,
+		BecomeDefault:     becomeDefault,
+	})
+	if err != nil {
+		ErrorAndExit("Operation UpdateWorkerBuildIdOrdering failed.", err)
+	}
+	printVersioningData(response.GetVersioningData())
+}
+
+// GetTaskQueueBuildIDs shows the version graph of worker build IDs of a workflow task queue
+func GetTaskQueueBuildIDs(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	taskQueue := getRequiredOption(c, FlagTaskQueue)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.GetWorkerBuildIdOrdering(ctx, &adminservice.GetWorkerBuildIdOrderingRequest{
+		Namespace: namespace,
+		TaskQueue: taskQueue,
+	})
+	if err != nil {
+		ErrorAndExit("Operation GetWorkerBuildIdOrdering failed.", err)
+	}
+	printVersioningData(response.GetVersioningData())
+}
+
+func printVersioningData(data *persistencespb.VersioningData) {
+	sets := data.GetVersionSets()
+	if len(sets) == 0 {
+		fmt.Println("Task queue is not versioned.")
+		return
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Version Set", "Compatible Build IDs", "Default"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for i, set := range sets {
+		isDefault := ""
+		if i == len(sets)-1 {
+			isDefault = "yes"
+		}
+		table.Append([]string{fmt.Sprint(i), strings.Join(set.GetBuildIds(), ", "), isDefault})
+	}
+	table.Render()
+}
+
 func printTaskQueuePartitions(taskQueueType string, partitions []*taskqueuepb.TaskQueuePartitionMetadata) {
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
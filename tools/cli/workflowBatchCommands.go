@@ -31,26 +31,55 @@ import (
 	"strings"
 
 	"github.com/urfave/cli"
-	enumspb "go.temporal.io/api/enums/v1"
+	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/workflowservice/v1"
-	sdkclient "go.temporal.io/sdk/client"
 
-	"go.temporal.io/server/common"
-	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/primitives/timestamp"
-	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/worker/batcher"
 )
 
+var (
+	batchOperationTypes = map[string]enumsspb.BatchOperationType{
+		batcher.BatchTypeTerminate: enumsspb.BATCH_OPERATION_TYPE_TERMINATE,
+		batcher.BatchTypeCancel:    enumsspb.BATCH_OPERATION_TYPE_CANCEL,
+		batcher.BatchTypeSignal:    enumsspb.BATCH_OPERATION_TYPE_SIGNAL,
+		batcher.BatchTypeDelete:    enumsspb.BATCH_OPERATION_TYPE_DELETE,
+		batcher.BatchTypeReset:     enumsspb.BATCH_OPERATION_TYPE_RESET,
+	}
+
+	batchResetTypes = map[string]enumsspb.BatchResetType{
+		batcher.ResetTypeFirstWorkflowTask:  enumsspb.BATCH_RESET_TYPE_FIRST_WORKFLOW_TASK,
+		batcher.ResetTypeLastWorkflowTask:   enumsspb.BATCH_RESET_TYPE_LAST_WORKFLOW_TASK,
+		batcher.ResetTypeLastContinuedAsNew: enumsspb.BATCH_RESET_TYPE_LAST_CONTINUED_AS_NEW,
+		batcher.ResetTypeBadBinary:          enumsspb.BATCH_RESET_TYPE_BAD_BINARY,
+	}
+
+	// batch reset goes through history service directly, so it supports reapplying updates as well
+	batchResetReapplyTypes = map[string]interface{}{
+		"":                enumsspb.RESET_REAPPLY_TYPE_SIGNAL, // default value
+		"Signal":          enumsspb.RESET_REAPPLY_TYPE_SIGNAL,
+		"SignalAndUpdate": enumsspb.RESET_REAPPLY_TYPE_SIGNAL_AND_UPDATE,
+		"None":            enumsspb.RESET_REAPPLY_TYPE_NONE,
+	}
+)
+
 // TerminateBatchJob stops abatch job
 func TerminateBatchJob(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
 	jobID := getRequiredOption(c, FlagJobID)
 	reason := getRequiredOption(c, FlagReason)
-	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	adminClient := cFactory.AdminClient(c)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	err := client.TerminateWorkflow(tcCtx, jobID, "", reason, nil)
+	_, err := adminClient.StopBatchOperation(tcCtx, &adminservice.StopBatchOperationRequest{
+		Namespace: namespace,
+		JobId:     jobID,
+		Reason:    reason,
+		Identity:  getCliIdentity(),
+	})
 	if err != nil {
 		ErrorAndExit("Failed to terminate batch job", err)
 	}
@@ -62,35 +91,31 @@ func TerminateBatchJob(c *cli.Context) {
 
 // DescribeBatchJob describe the status of the batch job
 func DescribeBatchJob(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
 	jobID := getRequiredOption(c, FlagJobID)
 
-	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	adminClient := cFactory.AdminClient(c)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	wf, err := client.DescribeWorkflowExecution(tcCtx, jobID, "")
+	resp, err := adminClient.DescribeBatchOperation(tcCtx, &adminservice.DescribeBatchOperationRequest{
+		Namespace: namespace,
+		JobId:     jobID,
+	})
 	if err != nil {
 		ErrorAndExit("Failed to describe batch job", err)
 	}
 
 	output := map[string]interface{}{}
-	if wf.WorkflowExecutionInfo.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
-		if wf.WorkflowExecutionInfo.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED {
-			output["msg"] = "batch job stopped status: " + wf.WorkflowExecutionInfo.GetStatus().String()
-		} else {
-			output["msg"] = "batch job is finished successfully"
-		}
-	} else {
+	switch resp.GetInfo().GetState() {
+	case enumsspb.BATCH_OPERATION_STATE_RUNNING:
 		output["msg"] = "batch job is running"
-		if len(wf.PendingActivities) > 0 {
-			hbdPayload := wf.PendingActivities[0].HeartbeatDetails
-			var hbd batcher.HeartBeatDetails
-			err := payloads.Decode(hbdPayload, &hbd)
-			if err != nil {
-				ErrorAndExit("Failed to describe batch job", err)
-			}
-			output["progress"] = hbd
-		}
+	case enumsspb.BATCH_OPERATION_STATE_COMPLETED:
+		output["msg"] = "batch job is finished successfully"
+	default:
+		output["msg"] = "batch job is stopped"
 	}
+	output["info"] = resp.GetInfo()
+	output["progress"] = resp.GetProgress()
 	prettyPrintJSONObject(output)
 }
 
@@ -98,45 +123,30 @@ func DescribeBatchJob(c *cli.Context) {
 func ListBatchJobs(c *cli.Context) {
 	namespace := getRequiredGlobalOption(c, FlagNamespace)
 	pageSize := c.Int(FlagPageSize)
-	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	adminClient := cFactory.AdminClient(c)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	resp, err := client.ListWorkflow(tcCtx, &workflowservice.ListWorkflowExecutionsRequest{
-		Namespace: common.SystemLocalNamespace,
+	resp, err := adminClient.ListBatchOperations(tcCtx, &adminservice.ListBatchOperationsRequest{
+		Namespace: namespace,
 		PageSize:  int32(pageSize),
-		Query:     fmt.Sprintf("%s = '%s'", searchattribute.BatcherNamespace, namespace),
 	})
 	if err != nil {
 		ErrorAndExit("Failed to list batch jobs", err)
 	}
 
-	output := make([]interface{}, 0, len(resp.Executions))
-	for _, wf := range resp.Executions {
-		var reason, operator string
-		err = payload.Decode(wf.Memo.Fields["Reason"], &reason)
-		if err != nil {
-			ErrorAndExit("Failed to deserialize reason memo field", err)
-		}
-
-		err = payload.Decode(wf.SearchAttributes.IndexedFields[searchattribute.BatcherUser], &operator)
-		if err != nil {
-			ErrorAndExit("Failed to deserialize operator search attribute", err)
-		}
-
+	output := make([]interface{}, 0, len(resp.Operations))
+	for _, operation := range resp.Operations {
 		job := map[string]string{
-			"jobId":     wf.Execution.GetWorkflowId(),
-			"startTime": formatTime(timestamp.TimeValue(wf.GetStartTime()), false),
-			"reason":    reason,
-			"operator":  operator,
+			"jobId":     operation.GetJobId(),
+			"type":      operation.GetOperationType().String(),
+			"startTime": formatTime(timestamp.TimeValue(operation.GetStartTime()), false),
+			"reason":    operation.GetReason(),
+			"operator":  operation.GetIdentity(),
+			"status":    operation.GetState().String(),
 		}
-
-		if wf.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
-			job["status"] = wf.GetStatus().String()
-			job["closeTime"] = formatTime(timestamp.TimeValue(wf.GetCloseTime()), false)
-		} else {
-			job["status"] = "RUNNING"
+		if operation.GetState() != enumsspb.BATCH_OPERATION_STATE_RUNNING {
+			job["closeTime"] = formatTime(timestamp.TimeValue(operation.GetCloseTime()), false)
 		}
-
 		output = append(output, job)
 	}
 	prettyPrintJSONObject(output)
@@ -145,33 +155,69 @@ func ListBatchJobs(c *cli.Context) {
 // StartBatchJob starts a batch job
 func StartBatchJob(c *cli.Context) {
 	namespace := getRequiredGlobalOption(c, FlagNamespace)
-	query := getRequiredOption(c, FlagListQuery)
+	query := c.String(FlagListQuery)
+	var executions []*commonpb.WorkflowExecution
+	for _, wid := range c.StringSlice(FlagWorkflowID) {
+		executions = append(executions, &commonpb.WorkflowExecution{WorkflowId: wid})
+	}
+	if (query == "") == (len(executions) == 0) {
+		ErrorAndExit(fmt.Sprintf("Exactly one of %s and %s must be provided.", FlagListQuery, FlagWorkflowID), nil)
+	}
 	reason := getRequiredOption(c, FlagReason)
 	batchType := getRequiredOption(c, FlagBatchType)
-	if !validateBatchType(batchType) {
+	operationType, ok := batchOperationTypes[batchType]
+	if !ok {
 		ErrorAndExit("batchType is not valid, supported:"+strings.Join(batcher.AllBatchTypes, ","), nil)
 	}
-	operator := getCurrentUserFromEnv()
-	var sigName, sigVal string
-	if batchType == batcher.BatchTypeSignal {
-		sigName = getRequiredOption(c, FlagSignalName)
-		sigVal = getRequiredOption(c, FlagInput)
-	}
-	rps := c.Int(FlagRPS)
-	concurrency := c.Int(FlagConcurrency)
-
-	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
-	tcCtx, cancel := newContext(c)
-	defer cancel()
-	resp, err := client.CountWorkflow(tcCtx, &workflowservice.CountWorkflowExecutionsRequest{
-		Namespace: namespace,
-		Query:     query,
-	})
-	if err != nil {
-		ErrorAndExit("Failed to count impacting workflows for starting a batch job", err)
+	request := &adminservice.StartBatchOperationRequest{
+		Namespace:       namespace,
+		Reason:          reason,
+		VisibilityQuery: query,
+		Executions:      executions,
+		OperationType:   operationType,
+		Rps:             int32(c.Int(FlagRPS)),
+		Concurrency:     int32(c.Int(FlagConcurrency)),
+		Identity:        getCurrentUserFromEnv(),
+	}
+	switch batchType {
+	case batcher.BatchTypeSignal:
+		request.SignalName = getRequiredOption(c, FlagSignalName)
+		sigInput, err := payloads.Encode(getRequiredOption(c, FlagInput))
+		if err != nil {
+			ErrorAndExit("Failed to serialize signal value", err)
+		}
+		request.SignalInput = sigInput
+	case batcher.BatchTypeReset:
+		resetType := getRequiredOption(c, FlagResetType)
+		if request.ResetType, ok = batchResetTypes[resetType]; !ok {
+			ErrorAndExit("resetType is not valid, supported:"+strings.Join(mapKeysToArray(resetTypesMap), ","), nil)
+		}
+		if resetType == batcher.ResetTypeBadBinary {
+			request.ResetBadBinaryChecksum = getRequiredOption(c, FlagResetBadBinaryChecksum)
+		}
+		reapplyType, ok := batchResetReapplyTypes[c.String(FlagResetReapplyType)]
+		if !ok {
+			ErrorAndExit("reapplyType is not valid, supported:"+strings.Join(mapKeysToArray(batchResetReapplyTypes), ","), nil)
+		}
+		request.ResetReapplyType = reapplyType.(enumsspb.ResetReapplyType)
+	}
+
+	count := int64(len(executions))
+	if query != "" {
+		frontendClient := cFactory.FrontendClient(c)
+		tcCtx, cancel := newContext(c)
+		defer cancel()
+		resp, err := frontendClient.CountWorkflowExecutions(tcCtx, &workflowservice.CountWorkflowExecutionsRequest{
+			Namespace: namespace,
+			Query:     query,
+		})
+		if err != nil {
+			ErrorAndExit("Failed to count impacting workflows for starting a batch job", err)
+		}
+		count = resp.GetCount()
 	}
 	fmt.Printf("This batch job will be operating on %v workflows, with max RPS of %v and concurrency of %v.\n",
-		resp.GetCount(), rps, concurrency)
+		count, request.Rps, request.Concurrency)
 	if !c.Bool(FlagYes) {
 		reader := bufio.NewReader(os.Stdin)
 		for {
@@ -189,52 +235,17 @@ func StartBatchJob(c *cli.Context) {
 		}
 
 	}
-	tcCtx, cancel = newContext(c)
-	defer cancel()
-	options := sdkclient.StartWorkflowOptions{
-		TaskQueue: batcher.BatcherTaskQueueName,
-		Memo: map[string]interface{}{
-			"Reason": reason,
-		},
-		SearchAttributes: map[string]interface{}{
-			searchattribute.BatcherNamespace: namespace,
-			searchattribute.BatcherUser:      operator,
-		},
-	}
-
-	sigInput, err := payloads.Encode(sigVal)
-	if err != nil {
-		ErrorAndExit("Failed to serialize signal value", err)
-	}
 
-	params := batcher.BatchParams{
-		Namespace: namespace,
-		Query:     query,
-		Reason:    reason,
-		BatchType: batchType,
-		SignalParams: batcher.SignalParams{
-			SignalName: sigName,
-			Input:      sigInput,
-		},
-		RPS:         rps,
-		Concurrency: concurrency,
-	}
-	wf, err := client.ExecuteWorkflow(tcCtx, options, batcher.BatchWFTypeName, params)
+	adminClient := cFactory.AdminClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.StartBatchOperation(tcCtx, request)
 	if err != nil {
 		ErrorAndExit("Failed to start batch job", err)
 	}
 	output := map[string]interface{}{
 		"msg":   "batch job is started",
-		"jobId": wf.GetID(),
+		"jobId": resp.GetJobId(),
 	}
 	prettyPrintJSONObject(output)
 }
-
-func validateBatchType(bt string) bool {
-	for _, b := range batcher.AllBatchTypes {
-		if b == bt {
-			return true
-		}
-	}
-	return false
-}
@@ -56,6 +56,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	clispb "go.temporal.io/server/api/cli/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/codec"
@@ -487,6 +488,34 @@ func TerminateWorkflow(c *cli.Context) {
 	}
 }
 
+// DeleteWorkflow deletes a workflow execution
+func DeleteWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.DeleteWorkflowExecution(ctx, &adminservice.DeleteWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Force:    c.Bool(FlagForce),
+		Reason:   c.String(FlagReason),
+		Identity: getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Delete workflow failed.", err)
+	} else {
+		fmt.Println("Delete workflow succeeded. The workflow execution will be removed asynchronously.")
+	}
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) {
 	sdkClient := getSDKClient(c)
@@ -535,6 +564,44 @@ func SignalWorkflow(c *cli.Context) {
 	}
 }
 
+// UpdateWorkflow updates a workflow execution and waits for the update result
+func UpdateWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	name := getRequiredOption(c, FlagName)
+	input := processJSONInput(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.UpdateWorkflowExecution(ctx, &adminservice.UpdateWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		UpdateId: c.String(FlagUpdateID),
+		Name:     name,
+		Input:    input,
+		Identity: getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Update workflow failed.", err)
+		return
+	}
+
+	switch {
+	case resp.GetRejected():
+		fmt.Printf("Update %v was rejected: %v\n", resp.GetUpdateId(), resp.GetFailure().GetMessage())
+	case resp.GetFailure() != nil:
+		fmt.Printf("Update %v failed: %v\n", resp.GetUpdateId(), resp.GetFailure().GetMessage())
+	default:
+		fmt.Printf("Update %v result:\n%v\n", resp.GetUpdateId(), payloads.ToString(resp.GetResult()))
+	}
+}
+
 // QueryWorkflow query workflow execution
 func QueryWorkflow(c *cli.Context) {
 	getRequiredGlobalOption(c, FlagNamespace) // for pre-check and alert if not provided
@@ -1868,20 +1935,27 @@ func getFirstWorkflowTaskEventID(ctx context.Context, namespace, wid, rid string
 }
 
 func getLastContinueAsNewID(ctx context.Context, namespace, wid, rid string, frontendClient sdkclient.Client) (resetBaseRunID string, workflowTaskCompletedID int64, err error) {
+	// get first event
 	iterator := frontendClient.GetWorkflowHistory(ctx, wid, rid, false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
-	var firstEvent *historypb.HistoryEvent
+	if !iterator.HasNext() {
+		return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", fmt.Errorf("workflow history is empty"))
+	}
+	firstEvent, err := iterator.Next()
+	if err != nil {
+		return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
+	}
+	resetBaseRunID = firstEvent.GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunId()
+	if resetBaseRunID == "" {
+		return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", fmt.Errorf("cannot get resetBaseRunId"))
+	}
+
+	// reset point is the last completed workflow task of the run which continued as new
+	iterator = frontendClient.GetWorkflowHistory(ctx, wid, resetBaseRunID, false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
 	for iterator.HasNext() {
 		e, err := iterator.Next()
 		if err != nil {
 			return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
 		}
-		if firstEvent == nil {
-			firstEvent = e
-			resetBaseRunID = firstEvent.GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunId()
-			if resetBaseRunID == "" {
-				return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", fmt.Errorf("cannot get resetBaseRunId"))
-			}
-		}
 		if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
 			workflowTaskCompletedID = e.GetEventId()
 		}
@@ -178,7 +178,7 @@ func (task *UpdateTask) buildChangeSet(currVer string) ([]changeSet, error) {
 
 	config := task.config
 
-	verDirs, err := readSchemaDir(config.SchemaDir, currVer, config.TargetVersion, task.logger)
+	verDirs, err := ReadSchemaDir(config.SchemaDir, currVer, config.TargetVersion, task.logger)
 	if err != nil {
 		return nil, fmt.Errorf("error listing schema dir:%v", err.Error())
 	}
@@ -379,10 +379,10 @@ func sortAndFilterVersions(versions []string, startVerExcl string, endVerIncl st
 	return retVersions, nil
 }
 
-// readSchemaDir returns a sorted list of subdir names that hold
+// ReadSchemaDir returns a sorted list of subdir names that hold
 // the schema changes for versions in the range startVer < ver <= endVer
 // when endVer is empty this method returns all subdir names that are greater than startVer
-func readSchemaDir(dir string, startVer string, endVer string, logger log.Logger) ([]string, error) {
+func ReadSchemaDir(dir string, startVer string, endVer string, logger log.Logger) ([]string, error) {
 
 	subDirs, err := os.ReadDir(dir)
 	if err != nil {
@@ -71,29 +71,29 @@ func (s *UpdateTaskTestSuite) SetupSuite() {
 }
 
 func (s *UpdateTaskTestSuite) TestReadSchemaDir() {
-	ans, err := readSchemaDir(s.versionsDir, "0.5", "1.5", s.logger)
+	ans, err := ReadSchemaDir(s.versionsDir, "0.5", "1.5", s.logger)
 	s.NoError(err)
 	s.Equal([]string{"v1.5"}, ans)
 
-	ans, err = readSchemaDir(s.versionsDir, "0.4", "10.2", s.logger)
+	ans, err = ReadSchemaDir(s.versionsDir, "0.4", "10.2", s.logger)
 	s.NoError(err)
 	s.Equal([]string{"v0.5", "v1.5", "v2.5", "v2.5.1", "v2.5.2", "v2.7.17", "v3.5", "v10.2"}, ans)
 
-	ans, err = readSchemaDir(s.versionsDir, "0.5", "3.5", s.logger)
+	ans, err = ReadSchemaDir(s.versionsDir, "0.5", "3.5", s.logger)
 	s.NoError(err)
 	s.Equal([]string{"v1.5", "v2.5", "v2.5.1", "v2.5.2", "v2.7.17", "v3.5"}, ans)
 
 	// Start version found, no later versions. Return nothing.
-	ans, err = readSchemaDir(s.versionsDir, "10.2", "", s.logger)
+	ans, err = ReadSchemaDir(s.versionsDir, "10.2", "", s.logger)
 	s.NoError(err)
 	s.Equal(0, len(ans))
 
 	// Start version not found, no later versions. Return nothing.
-	ans, err = readSchemaDir(s.versionsDir, "10.3", "", s.logger)
+	ans, err = ReadSchemaDir(s.versionsDir, "10.3", "", s.logger)
 	s.NoError(err)
 	s.Equal(0, len(ans))
 
-	ans, err = readSchemaDir(s.versionsDir, "2.5.2", "", s.logger)
+	ans, err = ReadSchemaDir(s.versionsDir, "2.5.2", "", s.logger)
 	s.NoError(err)
 	s.Equal([]string{"v2.7.17", "v3.5", "v10.2"}, ans)
 }
@@ -106,28 +106,28 @@ func (s *UpdateTaskTestSuite) TestSortAndFilterVersionsWithEndLessThanStart_Retu
 
 func (s *UpdateTaskTestSuite) TestReadSchemaDirWithEndVersion_ReturnsErrorWhenNotFound() {
 	// No versions in range
-	_, err := readSchemaDir(s.versionsDir, "11.0", "11.2", s.logger)
+	_, err := ReadSchemaDir(s.versionsDir, "11.0", "11.2", s.logger)
 	s.Error(err)
 	assert.Containsf(s.T(), err.Error(), "specified but not found", "Unexpected error message")
 
 	// Versions in range, but nothing for v10.3
-	_, err = readSchemaDir(s.versionsDir, "0.5", "10.3", s.logger)
+	_, err = ReadSchemaDir(s.versionsDir, "0.5", "10.3", s.logger)
 	s.Error(err)
 	assert.Containsf(s.T(), err.Error(), "specified but not found", "Unexpected error message")
 }
 
 func (s *UpdateTaskTestSuite) TestReadSchemaDirWithSameStartAndEnd_ReturnsEmptyList() {
-	ans, err := readSchemaDir(s.versionsDir, "1.7", "1.7", s.logger)
+	ans, err := ReadSchemaDir(s.versionsDir, "1.7", "1.7", s.logger)
 	s.NoError(err)
 	assert.Equal(s.T(), 0, len(ans))
 }
 
 func (s *UpdateTaskTestSuite) TestReadSchemaDirWithEmptyDir_ReturnsError() {
-	_, err := readSchemaDir(s.emptyDir, "11.0", "", s.logger)
+	_, err := ReadSchemaDir(s.emptyDir, "11.0", "", s.logger)
 	s.Error(err)
 	assert.Containsf(s.T(), err.Error(), "contains no subDirs", "Unexpected error message")
 
-	_, err = readSchemaDir(s.emptyDir, "10.1", "", s.logger)
+	_, err = ReadSchemaDir(s.emptyDir, "10.1", "", s.logger)
 	s.Error(err)
 	assert.Containsf(s.T(), err.Error(), "contains no subDirs", "Unexpected error message")
 }
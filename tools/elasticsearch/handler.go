@@ -0,0 +1,756 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/olivere/elastic/v7"
+	"github.com/urfave/cli"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/tools/common/schema"
+)
+
+const (
+	// CLIOptURL is the cli option for server url
+	CLIOptURL = "url"
+	// CLIOptServerVersion is the cli option for server version
+	CLIOptServerVersion = "es-version"
+	// CLIOptIndexTemplateFile is the cli option for index template file
+	CLIOptIndexTemplateFile = "index-template-file"
+	// CLIOptClusterSettingsFile is the cli option for cluster settings file
+	CLIOptClusterSettingsFile = "cluster-settings-file"
+	// CLIOptTemplateName is the cli option for index template name
+	CLIOptTemplateName = "template-name"
+	// CLIOptIndex is the cli option for index name
+	CLIOptIndex = "index"
+	// CLIOptCurrentVersion is the cli option for current version of an unversioned index
+	CLIOptCurrentVersion = "current-version"
+	// CLIOptSourceIndex is the cli option for reindex source index
+	CLIOptSourceIndex = "source-index"
+	// CLIOptDestIndex is the cli option for reindex destination index
+	CLIOptDestIndex = "dest-index"
+	// CLIOptAlias is the cli option for index alias
+	CLIOptAlias = "alias"
+	// CLIOptScriptFile is the cli option for reindex script file
+	CLIOptScriptFile = "script-file"
+	// CLIOptScriptParams is the cli option for reindex script params
+	CLIOptScriptParams = "script-params"
+
+	// CLIFlagURL is the cli flag for server url
+	CLIFlagURL = CLIOptURL
+	// CLIFlagServerVersion is the cli flag for server version
+	CLIFlagServerVersion = CLIOptServerVersion + ", ev"
+	// CLIFlagIndexTemplateFile is the cli flag for index template file
+	CLIFlagIndexTemplateFile = CLIOptIndexTemplateFile + ", f"
+	// CLIFlagClusterSettingsFile is the cli flag for cluster settings file
+	CLIFlagClusterSettingsFile = CLIOptClusterSettingsFile + ", cs"
+	// CLIFlagTemplateName is the cli flag for index template name
+	CLIFlagTemplateName = CLIOptTemplateName + ", tn"
+	// CLIFlagIndex is the cli flag for index name
+	CLIFlagIndex = CLIOptIndex + ", i"
+	// CLIFlagCurrentVersion is the cli flag for current version of an unversioned index
+	CLIFlagCurrentVersion = CLIOptCurrentVersion + ", cv"
+	// CLIFlagSourceIndex is the cli flag for reindex source index
+	CLIFlagSourceIndex = CLIOptSourceIndex + ", si"
+	// CLIFlagDestIndex is the cli flag for reindex destination index
+	CLIFlagDestIndex = CLIOptDestIndex + ", di"
+	// CLIFlagAlias is the cli flag for index alias
+	CLIFlagAlias = CLIOptAlias + ", a"
+	// CLIFlagScriptFile is the cli flag for reindex script file
+	CLIFlagScriptFile = CLIOptScriptFile + ", sf"
+	// CLIFlagScriptParams is the cli flag for reindex script params
+	CLIFlagScriptParams = CLIOptScriptParams + ", sp"
+
+	serverVersionV7         = "v7"
+	serverVersionOpenSearch = "opensearch"
+
+	defaultTimeout        = 30
+	defaultTemplateName   = "temporal_visibility_v1_template"
+	reindexStatusInterval = 10 * time.Second
+
+	// schemaVersionMetaKey is the key of the schema version in the _meta field of index mapping
+	schemaVersionMetaKey = "temporal_schema_version"
+	manifestFileName     = "manifest.json"
+)
+
+type (
+	// manifest is a value type that represents
+	// the deserialized manifest.json file within
+	// a schema version directory
+	manifest struct {
+		CurrVersion          string
+		MinCompatibleVersion string
+		Description          string
+		IndexTemplateFile    string
+		MappingFile          string
+		// RequiresReindex is set if existing documents are not compatible with the new version
+		RequiresReindex bool
+	}
+
+	// changeSet represents all the changes
+	// corresponding to a single schema version
+	changeSet struct {
+		version       string
+		manifest      *manifest
+		indexTemplate string
+		mapping       string
+	}
+
+	// UpdateConfig holds the config params for updating the schema of a visibility index
+	UpdateConfig struct {
+		Index          string
+		TemplateName   string
+		SchemaDir      string
+		TargetVersion  string
+		CurrentVersion string
+	}
+
+	updateTask struct {
+		client esclient.SchemaClient
+		config *UpdateConfig
+		logger log.Logger
+	}
+)
+
+// setupSchema puts cluster settings and the index template
+// using the given command line arguments as input
+func setupSchema(c *cli.Context, logger log.Logger) error {
+	templateFile := c.String(CLIOptIndexTemplateFile)
+	if templateFile == "" {
+		err := schema.NewConfigError("missing " + flag(CLIOptIndexTemplateFile) + " argument")
+		logger.Error("Unable to read config.", tag.Error(err))
+		return err
+	}
+	version := c.String(schema.CLIOptVersion)
+	if _, err := semver.ParseTolerant(version); err != nil {
+		err = schema.NewConfigError("invalid " + flag(schema.CLIOptVersion) + " argument: " + err.Error())
+		logger.Error("Unable to read config.", tag.Error(err))
+		return err
+	}
+
+	client, err := newClient(c, logger)
+	if err != nil {
+		logger.Error("Unable to create elasticsearch client.", tag.Error(err))
+		return err
+	}
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	if settingsFile := c.String(CLIOptClusterSettingsFile); settingsFile != "" {
+		settings, err := os.ReadFile(settingsFile)
+		if err != nil {
+			logger.Error("Unable to read cluster settings file.", tag.Error(err))
+			return err
+		}
+		if _, err := client.ClusterPutSettings(ctx, string(settings)); err != nil {
+			logger.Error("Unable to put cluster settings.", tag.Error(err))
+			return err
+		}
+		logger.Info("Cluster settings updated.")
+	}
+
+	template, err := readJSONFile(templateFile)
+	if err != nil {
+		logger.Error("Unable to read index template file.", tag.Error(err))
+		return err
+	}
+	templateName := c.String(CLIOptTemplateName)
+	if err := putIndexTemplate(ctx, client, templateName, template, version); err != nil {
+		logger.Error("Unable to put index template.", tag.Error(err))
+		return err
+	}
+	logger.Info("Index template updated.", tag.NewStringTag("template", templateName), tag.NewStringTag("version", version))
+	return nil
+}
+
+// updateSchema updates the index template and the mapping of an existing index
+// to the target version using the given command line arguments as input
+func updateSchema(c *cli.Context, logger log.Logger) error {
+	config := &UpdateConfig{
+		Index:          c.String(CLIOptIndex),
+		TemplateName:   c.String(CLIOptTemplateName),
+		SchemaDir:      c.String(schema.CLIOptSchemaDir),
+		TargetVersion:  c.String(schema.CLIOptTargetVersion),
+		CurrentVersion: c.String(CLIOptCurrentVersion),
+	}
+	if config.Index == "" {
+		err := schema.NewConfigError("missing " + flag(CLIOptIndex) + " argument")
+		logger.Error("Unable to read config.", tag.Error(err))
+		return err
+	}
+	if config.SchemaDir == "" {
+		err := schema.NewConfigError("missing " + flag(schema.CLIOptSchemaDir) + " argument")
+		logger.Error("Unable to read config.", tag.Error(err))
+		return err
+	}
+
+	client, err := newClient(c, logger)
+	if err != nil {
+		logger.Error("Unable to create elasticsearch client.", tag.Error(err))
+		return err
+	}
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	task := &updateTask{
+		client: client,
+		config: config,
+		logger: logger,
+	}
+	if err := task.Run(ctx); err != nil {
+		logger.Error("Unable to update index schema.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+// createIndex creates a new index which gets its settings and mapping from the index template
+func createIndex(c *cli.Context, logger log.Logger) error {
+	index := c.String(CLIOptIndex)
+	if index == "" {
+		err := schema.NewConfigError("missing " + flag(CLIOptIndex) + " argument")
+		logger.Error("Unable to read config.", tag.Error(err))
+		return err
+	}
+
+	client, err := newClient(c, logger)
+	if err != nil {
+		logger.Error("Unable to create elasticsearch client.", tag.Error(err))
+		return err
+	}
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	if _, err := client.CreateIndex(ctx, index); err != nil {
+		logger.Error("Unable to create index.", tag.Error(err))
+		return err
+	}
+	logger.Info("Index created.", tag.NewStringTag("index", index))
+	return nil
+}
+
+// reindex copies all documents from the source index to the destination index
+// and then atomically moves the alias, if any, to the destination index.
+// Before the alias is moved, writes to the source index are blocked and documents
+// written to it during the first reindex are copied by a second one
+func reindex(c *cli.Context, logger log.Logger) error {
+	sourceIndex := c.String(CLIOptSourceIndex)
+	destIndex := c.String(CLIOptDestIndex)
+	if sourceIndex == "" || destIndex == "" {
+		err := schema.NewConfigError("missing " + flag(CLIOptSourceIndex) + " or " + flag(CLIOptDestIndex) + " argument")
+		logger.Error("Unable to read config.", tag.Error(err))
+		return err
+	}
+	var script *elastic.Script
+	if scriptFile := c.String(CLIOptScriptFile); scriptFile != "" {
+		source, err := os.ReadFile(scriptFile)
+		if err != nil {
+			logger.Error("Unable to read script file.", tag.Error(err))
+			return err
+		}
+		script = elastic.NewScript(string(source)).Lang("painless")
+		if scriptParams := c.String(CLIOptScriptParams); scriptParams != "" {
+			var params map[string]interface{}
+			if err := json.Unmarshal([]byte(scriptParams), &params); err != nil {
+				err = schema.NewConfigError("invalid " + flag(CLIOptScriptParams) + " argument: " + err.Error())
+				logger.Error("Unable to read config.", tag.Error(err))
+				return err
+			}
+			script.Params(params)
+		}
+	}
+
+	client, err := newClient(c, logger)
+	if err != nil {
+		logger.Error("Unable to create elasticsearch client.", tag.Error(err))
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	taskID, err := startReindex(ctx, client, sourceIndex, destIndex, script, logger)
+	cancel()
+	if err != nil {
+		logger.Error("Unable to start reindex.", tag.Error(err))
+		return err
+	}
+
+	logger.Info("Reindex started.", tag.NewStringTag("task", taskID))
+	if err := waitForTask(c, client, taskID, logger); err != nil {
+		logger.Error("Reindex failed.", tag.Error(err))
+		return err
+	}
+	logger.Info("Reindex done.", tag.NewStringTag("source", sourceIndex), tag.NewStringTag("dest", destIndex))
+
+	alias := c.String(CLIOptAlias)
+	if alias == "" {
+		return nil
+	}
+	if err := catchUpAndMoveAlias(c, client, sourceIndex, destIndex, alias, script, logger); err != nil {
+		return err
+	}
+	logger.Info("Alias moved.", tag.NewStringTag("alias", alias), tag.NewStringTag("index", destIndex))
+	return nil
+}
+
+// catchUpAndMoveAlias blocks writes to the source index, copies documents written to it since
+// the reindex started and moves the alias to the destination index. Visibility writes which fail
+// while the source index is blocked are retried by temporal server and go to the destination index
+// once the alias is moved. Writes to the source index are unblocked if the alias can't be moved.
+func catchUpAndMoveAlias(
+	c *cli.Context,
+	client esclient.SchemaClient,
+	sourceIndex string,
+	destIndex string,
+	alias string,
+	script *elastic.Script,
+	logger log.Logger,
+) (retError error) {
+	ctx, cancel := newContext(c)
+	err := setWriteBlock(ctx, client, sourceIndex, true)
+	cancel()
+	if err != nil {
+		logger.Error("Unable to block writes to source index.", tag.Error(err))
+		return err
+	}
+	logger.Info("Writes to source index blocked.", tag.NewStringTag("index", sourceIndex))
+	defer func() {
+		if retError == nil {
+			return
+		}
+		ctx, cancel := newContext(c)
+		defer cancel()
+		if err := setWriteBlock(ctx, client, sourceIndex, false); err != nil {
+			logger.Error("Unable to unblock writes to source index.", tag.Error(err))
+			return
+		}
+		logger.Info("Writes to source index unblocked.", tag.NewStringTag("index", sourceIndex))
+	}()
+
+	// documents which are already copied have the same version in both indices and are skipped
+	ctx, cancel = newContext(c)
+	taskID, err := client.Reindex(ctx, sourceIndex, destIndex, script)
+	cancel()
+	if err != nil {
+		logger.Error("Unable to start catch-up reindex.", tag.Error(err))
+		return err
+	}
+	logger.Info("Catch-up reindex started.", tag.NewStringTag("task", taskID))
+	if err := waitForTask(c, client, taskID, logger); err != nil {
+		logger.Error("Catch-up reindex failed.", tag.Error(err))
+		return err
+	}
+
+	ctx, cancel = newContext(c)
+	defer cancel()
+	if err := moveAlias(ctx, client, alias, destIndex); err != nil {
+		logger.Error("Unable to move alias.", tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ping checks that the server is reachable and runs a supported version
+func ping(c *cli.Context, logger log.Logger) error {
+	client, err := newClient(c, logger)
+	if err != nil {
+		logger.Error("Unable to create elasticsearch client.", tag.Error(err))
+		return err
+	}
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	info, err := client.Ping(ctx)
+	if err != nil {
+		logger.Error("Unable to reach server.", tag.Error(err))
+		return err
+	}
+	distribution := info.Version.Distribution
+	if distribution == "" {
+		distribution = "elasticsearch"
+	}
+	if err := verifyServerVersion(c.GlobalString(CLIOptServerVersion), info); err != nil {
+		logger.Error("Server is not supported.", tag.Error(err))
+		return err
+	}
+	logger.Info("Server is reachable.",
+		tag.NewStringTag("distribution", distribution),
+		tag.NewStringTag("version", info.Version.Number),
+		tag.NewStringTag("cluster", info.ClusterName),
+	)
+	return nil
+}
+
+// Run executes the task
+func (task *updateTask) Run(ctx context.Context) error {
+	config := task.config
+
+	task.logger.Info("UpdateSchemaTask started", tag.NewAnyTag("config", config))
+
+	currVer, err := readIndexSchemaVersion(ctx, task.client, config.Index)
+	if err != nil {
+		return fmt.Errorf("error reading current schema version:%v", err.Error())
+	}
+	if currVer == "" {
+		if config.CurrentVersion == "" {
+			return fmt.Errorf("index %v has no schema version, specify it with %v", config.Index, flag(CLIOptCurrentVersion))
+		}
+		currVer = config.CurrentVersion
+	}
+
+	updates, err := task.buildChangeSet(currVer)
+	if err != nil {
+		return err
+	}
+	if len(updates) == 0 {
+		task.logger.Info(fmt.Sprintf("found zero updates from current version %v", currVer))
+		return nil
+	}
+
+	for _, cs := range updates {
+		if err := putIndexTemplate(ctx, task.client, config.TemplateName, cs.indexTemplate, cs.version); err != nil {
+			return fmt.Errorf("error updating index template to version %v:%v", cs.version, err)
+		}
+		if err := putIndexMapping(ctx, task.client, config.Index, cs.mapping, cs.version); err != nil {
+			return fmt.Errorf("error updating index mapping to version %v:%v", cs.version, err)
+		}
+		task.logger.Info(fmt.Sprintf("Schema updated from %v to %v", currVer, cs.version))
+		currVer = cs.version
+	}
+
+	task.logger.Info("UpdateSchemaTask done")
+	return nil
+}
+
+func (task *updateTask) buildChangeSet(currVer string) ([]changeSet, error) {
+	config := task.config
+
+	verDirs, err := schema.ReadSchemaDir(config.SchemaDir, currVer, config.TargetVersion, task.logger)
+	if err != nil {
+		return nil, fmt.Errorf("error listing schema dir:%v", err.Error())
+	}
+
+	var result []changeSet
+	for _, vd := range verDirs {
+		dirPath := filepath.Join(config.SchemaDir, vd)
+
+		m, err := readManifest(dirPath)
+		if err != nil {
+			return nil, fmt.Errorf("error processing manifest for version %v:%v", vd, err.Error())
+		}
+		if m.CurrVersion != strings.TrimPrefix(vd, "v") {
+			return nil, fmt.Errorf(
+				"manifest version doesn't match with dirname, dir=%v,manifest.version=%v",
+				vd, m.CurrVersion,
+			)
+		}
+		// check every version before changing anything
+		if m.RequiresReindex {
+			return nil, fmt.Errorf(
+				"version %v requires reindex: create a new index with create-index and copy documents with reindex",
+				m.CurrVersion,
+			)
+		}
+
+		cs := changeSet{
+			version:  m.CurrVersion,
+			manifest: m,
+		}
+		if cs.indexTemplate, err = readJSONFile(filepath.Join(dirPath, m.IndexTemplateFile)); err != nil {
+			return nil, fmt.Errorf("error reading index template for version %v:%v", vd, err.Error())
+		}
+		if m.MappingFile != "" {
+			if cs.mapping, err = readJSONFile(filepath.Join(dirPath, m.MappingFile)); err != nil {
+				return nil, fmt.Errorf("error reading mapping for version %v:%v", vd, err.Error())
+			}
+		}
+		result = append(result, cs)
+	}
+
+	return result, nil
+}
+
+func readManifest(dirPath string) (*manifest, error) {
+	jsonBlob, err := os.ReadFile(filepath.Join(dirPath, manifestFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	var m manifest
+	if err := json.Unmarshal(jsonBlob, &m); err != nil {
+		return nil, err
+	}
+	if m.CurrVersion == "" {
+		return nil, fmt.Errorf("invalid CurrVersion in manifest")
+	}
+	if m.MinCompatibleVersion == "" {
+		return nil, fmt.Errorf("invalid MinCompatibleVersion in manifest")
+	}
+	if m.IndexTemplateFile == "" {
+		return nil, fmt.Errorf("manifest missing IndexTemplateFile")
+	}
+	return &m, nil
+}
+
+func startReindex(
+	ctx context.Context,
+	client esclient.SchemaClient,
+	sourceIndex string,
+	destIndex string,
+	script *elastic.Script,
+	logger log.Logger,
+) (string, error) {
+	exists, err := client.IndexExists(ctx, sourceIndex)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", fmt.Errorf("source index %v doesn't exist", sourceIndex)
+	}
+
+	exists, err = client.IndexExists(ctx, destIndex)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		if _, err := client.CreateIndex(ctx, destIndex); err != nil {
+			return "", err
+		}
+		logger.Info("Index created.", tag.NewStringTag("index", destIndex))
+	}
+
+	// custom search attributes are only in the mapping of the source index
+	if err := copyMissingFields(ctx, client, sourceIndex, destIndex); err != nil {
+		return "", err
+	}
+
+	return client.Reindex(ctx, sourceIndex, destIndex, script)
+}
+
+func waitForTask(c *cli.Context, client esclient.SchemaClient, taskID string, logger log.Logger) error {
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := client.GetTask(ctx, taskID)
+		cancel()
+		if err != nil {
+			return err
+		}
+		if resp.Task != nil {
+			logger.Info("Reindex status.", tag.NewAnyTag("status", resp.Task.Status))
+		}
+		if resp.Completed {
+			if resp.Error != nil {
+				return fmt.Errorf("%v: %v", resp.Error.Type, resp.Error.Reason)
+			}
+			return nil
+		}
+		time.Sleep(reindexStatusInterval)
+	}
+}
+
+func setWriteBlock(ctx context.Context, client esclient.SchemaClient, index string, block bool) error {
+	_, err := client.IndexPutSettings(ctx, index, fmt.Sprintf(`{"index":{"blocks":{"write":%t}}}`, block))
+	return err
+}
+
+func moveAlias(ctx context.Context, client esclient.SchemaClient, alias string, index string) error {
+	indices, err := client.GetAliasIndices(ctx, alias)
+	if err != nil {
+		return err
+	}
+	var oldIndices []string
+	for _, oldIndex := range indices {
+		if oldIndex != index {
+			oldIndices = append(oldIndices, oldIndex)
+		}
+	}
+	_, err = client.SwapAlias(ctx, alias, oldIndices, index)
+	return err
+}
+
+func copyMissingFields(ctx context.Context, client esclient.SchemaClient, sourceIndex string, destIndex string) error {
+	sourceMapping, err := client.IndexGetMapping(ctx, sourceIndex)
+	if err != nil {
+		return err
+	}
+	destMapping, err := client.IndexGetMapping(ctx, destIndex)
+	if err != nil {
+		return err
+	}
+	sourceFields, _ := sourceMapping["properties"].(map[string]interface{})
+	destFields, _ := destMapping["properties"].(map[string]interface{})
+
+	missingFields := make(map[string]interface{})
+	for name, field := range sourceFields {
+		if _, ok := destFields[name]; !ok {
+			missingFields[name] = field
+		}
+	}
+	if len(missingFields) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(map[string]interface{}{"properties": missingFields})
+	if err != nil {
+		return err
+	}
+	_, err = client.IndexPutMapping(ctx, destIndex, string(body))
+	return err
+}
+
+func readIndexSchemaVersion(ctx context.Context, client esclient.SchemaClient, index string) (string, error) {
+	mapping, err := client.IndexGetMapping(ctx, index)
+	if err != nil {
+		return "", err
+	}
+	meta, _ := mapping["_meta"].(map[string]interface{})
+	version, _ := meta[schemaVersionMetaKey].(string)
+	return version, nil
+}
+
+func putIndexTemplate(ctx context.Context, client esclient.SchemaClient, templateName string, template string, version string) error {
+	body, err := setTemplateSchemaVersion(template, version)
+	if err != nil {
+		return err
+	}
+	_, err = client.IndexPutTemplate(ctx, templateName, body)
+	return err
+}
+
+func putIndexMapping(ctx context.Context, client esclient.SchemaClient, index string, mapping string, version string) error {
+	if mapping == "" {
+		mapping = "{}"
+	}
+	body, err := setMappingSchemaVersion(mapping, version)
+	if err != nil {
+		return err
+	}
+	_, err = client.IndexPutMapping(ctx, index, body)
+	return err
+}
+
+// setTemplateSchemaVersion records version in the mapping of the index template
+// so indices created from the template have the version too
+func setTemplateSchemaVersion(template string, version string) (string, error) {
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(template), &body); err != nil {
+		return "", err
+	}
+	mappings, ok := body["mappings"].(map[string]interface{})
+	if !ok {
+		mappings = make(map[string]interface{})
+		body["mappings"] = mappings
+	}
+	setSchemaVersionMeta(mappings, version)
+	result, err := json.Marshal(body)
+	return string(result), err
+}
+
+func setMappingSchemaVersion(mapping string, version string) (string, error) {
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(mapping), &body); err != nil {
+		return "", err
+	}
+	setSchemaVersionMeta(body, version)
+	result, err := json.Marshal(body)
+	return string(result), err
+}
+
+func setSchemaVersionMeta(mapping map[string]interface{}, version string) {
+	meta, ok := mapping["_meta"].(map[string]interface{})
+	if !ok {
+		meta = make(map[string]interface{})
+		mapping["_meta"] = meta
+	}
+	meta[schemaVersionMetaKey] = version
+}
+
+func verifyServerVersion(serverVersion string, info *esclient.ServerInfo) error {
+	if info.Version.Distribution == serverVersionOpenSearch {
+		if serverVersion != serverVersionOpenSearch {
+			return fmt.Errorf("server is opensearch %v, set %v to %v", info.Version.Number, flag(CLIOptServerVersion), serverVersionOpenSearch)
+		}
+		return nil
+	}
+	version, err := semver.ParseTolerant(info.Version.Number)
+	if err != nil {
+		return err
+	}
+	if version.Major != 7 {
+		return fmt.Errorf("elasticsearch %v is not supported, only 7.x is", info.Version.Number)
+	}
+	if serverVersion != serverVersionV7 {
+		return fmt.Errorf("server is elasticsearch %v, set %v to %v", info.Version.Number, flag(CLIOptServerVersion), serverVersionV7)
+	}
+	return nil
+}
+
+func newClient(c *cli.Context, logger log.Logger) (esclient.SchemaClient, error) {
+	serverVersion := c.GlobalString(CLIOptServerVersion)
+	if serverVersion != serverVersionV7 && serverVersion != serverVersionOpenSearch {
+		return nil, schema.NewConfigError("invalid " + flag(CLIOptServerVersion) + " argument: " + serverVersion)
+	}
+	serverURL, err := url.Parse(c.GlobalString(CLIOptURL))
+	if err != nil {
+		return nil, schema.NewConfigError("invalid " + flag(CLIOptURL) + " argument: " + err.Error())
+	}
+	config := &esclient.Config{
+		// opensearch is compatible with elasticsearch 7 api
+		Version:  serverVersionV7,
+		URL:      *serverURL,
+		Username: c.GlobalString(schema.CLIOptUser),
+		Password: c.GlobalString(schema.CLIOptPassword),
+	}
+	return esclient.NewSchemaClient(config, logger)
+}
+
+func newContext(c *cli.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), time.Duration(c.GlobalInt(schema.CLIOptTimeout))*time.Second)
+}
+
+func readJSONFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	if !json.Valid(content) {
+		return "", fmt.Errorf("file %v is not valid json", path)
+	}
+	return string(content), nil
+}
+
+func flag(opt string) string {
+	return "(-" + opt + ")"
+}
@@ -0,0 +1,255 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	goflag "flag"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/urfave/cli"
+
+	"go.temporal.io/server/common/log"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	esschema "go.temporal.io/server/schema/elasticsearch"
+	"go.temporal.io/server/tools/common/schema"
+)
+
+const (
+	testIndex        = "temporal_visibility_v1_test"
+	testSchemaDir    = "../../schema/elasticsearch/visibility/versioned"
+	testTemplateName = "temporal_visibility_v1_template"
+)
+
+type (
+	UpdateTaskTestSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+		client     *esclient.MockSchemaClient
+		logger     log.Logger
+	}
+)
+
+func TestUpdateTaskTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateTaskTestSuite))
+}
+
+func (s *UpdateTaskTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.client = esclient.NewMockSchemaClient(s.controller)
+	s.logger = log.NewNoopLogger()
+}
+
+func (s *UpdateTaskTestSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *UpdateTaskTestSuite) TestUpdate() {
+	s.client.EXPECT().IndexGetMapping(gomock.Any(), testIndex).Return(mappingWithVersion("1"), nil)
+	s.client.EXPECT().IndexPutTemplate(gomock.Any(), testTemplateName, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, body string) (bool, error) {
+			var template map[string]interface{}
+			s.NoError(json.Unmarshal([]byte(body), &template))
+			s.Equal(esschema.VisibilityVersion, schemaVersion(template["mappings"]))
+			return true, nil
+		})
+	s.client.EXPECT().IndexPutMapping(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, body string) (bool, error) {
+			var mapping map[string]interface{}
+			s.NoError(json.Unmarshal([]byte(body), &mapping))
+			s.Equal(esschema.VisibilityVersion, schemaVersion(mapping))
+			s.Contains(mapping["properties"], "HistorySizeBytes")
+			return true, nil
+		})
+
+	s.NoError(s.newTask("", "").Run(context.Background()))
+}
+
+func (s *UpdateTaskTestSuite) TestUpdate_UpToDate() {
+	s.client.EXPECT().IndexGetMapping(gomock.Any(), testIndex).Return(mappingWithVersion(esschema.VisibilityVersion), nil)
+
+	s.NoError(s.newTask("", "").Run(context.Background()))
+}
+
+func (s *UpdateTaskTestSuite) TestUpdate_CurrentVersionFlag() {
+	s.client.EXPECT().IndexGetMapping(gomock.Any(), testIndex).Return(map[string]interface{}{}, nil)
+	s.client.EXPECT().IndexPutTemplate(gomock.Any(), testTemplateName, gomock.Any()).Return(true, nil)
+	s.client.EXPECT().IndexPutMapping(gomock.Any(), testIndex, gomock.Any()).Return(true, nil)
+
+	s.NoError(s.newTask("1", "2").Run(context.Background()))
+}
+
+func (s *UpdateTaskTestSuite) TestUpdate_UnknownVersion() {
+	s.client.EXPECT().IndexGetMapping(gomock.Any(), testIndex).Return(map[string]interface{}{}, nil)
+
+	s.Error(s.newTask("", "").Run(context.Background()))
+}
+
+func (s *UpdateTaskTestSuite) TestUpdate_RequiresReindex() {
+	s.client.EXPECT().IndexGetMapping(gomock.Any(), testIndex).Return(map[string]interface{}{}, nil)
+
+	// v1 requires reindex so nothing must be changed
+	err := s.newTask("0", "").Run(context.Background())
+	s.Error(err)
+	s.Contains(err.Error(), "requires reindex")
+}
+
+func (s *UpdateTaskTestSuite) TestUpdate_GetMappingError() {
+	s.client.EXPECT().IndexGetMapping(gomock.Any(), testIndex).Return(nil, errors.New("index not found"))
+
+	s.Error(s.newTask("", "").Run(context.Background()))
+}
+
+func (s *UpdateTaskTestSuite) newTask(currentVersion string, targetVersion string) *updateTask {
+	return &updateTask{
+		client: s.client,
+		config: &UpdateConfig{
+			Index:          testIndex,
+			TemplateName:   testTemplateName,
+			SchemaDir:      testSchemaDir,
+			TargetVersion:  targetVersion,
+			CurrentVersion: currentVersion,
+		},
+		logger: s.logger,
+	}
+}
+
+func TestSetTemplateSchemaVersion(t *testing.T) {
+	body, err := setTemplateSchemaVersion(`{"index_patterns":["temporal_visibility_v1*"],"mappings":{"dynamic":"false"}}`, "2")
+	require.NoError(t, err)
+
+	var template map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(body), &template))
+	require.Equal(t, "2", schemaVersion(template["mappings"]))
+	require.Equal(t, "false", template["mappings"].(map[string]interface{})["dynamic"])
+
+	body, err = setTemplateSchemaVersion(`{"index_patterns":["temporal_visibility_v1*"]}`, "1")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(body), &template))
+	require.Equal(t, "1", schemaVersion(template["mappings"]))
+
+	_, err = setTemplateSchemaVersion(`{`, "1")
+	require.Error(t, err)
+}
+
+func TestMoveAlias(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	client := esclient.NewMockSchemaClient(controller)
+
+	client.EXPECT().GetAliasIndices(gomock.Any(), "temporal_visibility").Return([]string{"old", "new"}, nil)
+	client.EXPECT().SwapAlias(gomock.Any(), "temporal_visibility", []string{"old"}, "new").Return(true, nil)
+	require.NoError(t, moveAlias(context.Background(), client, "temporal_visibility", "new"))
+}
+
+func TestCatchUpAndMoveAlias(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	client := esclient.NewMockSchemaClient(controller)
+
+	gomock.InOrder(
+		client.EXPECT().IndexPutSettings(gomock.Any(), "old", `{"index":{"blocks":{"write":true}}}`).Return(true, nil),
+		client.EXPECT().Reindex(gomock.Any(), "old", "new", nil).Return("task", nil),
+		client.EXPECT().GetTask(gomock.Any(), "task").Return(&elastic.TasksGetTaskResponse{Completed: true}, nil),
+		client.EXPECT().GetAliasIndices(gomock.Any(), "temporal_visibility").Return([]string{"old"}, nil),
+		client.EXPECT().SwapAlias(gomock.Any(), "temporal_visibility", []string{"old"}, "new").Return(true, nil),
+	)
+	require.NoError(t, catchUpAndMoveAlias(newTestCLIContext(), client, "old", "new", "temporal_visibility", nil, log.NewNoopLogger()))
+}
+
+func TestCatchUpAndMoveAlias_Failure(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	client := esclient.NewMockSchemaClient(controller)
+
+	gomock.InOrder(
+		client.EXPECT().IndexPutSettings(gomock.Any(), "old", `{"index":{"blocks":{"write":true}}}`).Return(true, nil),
+		client.EXPECT().Reindex(gomock.Any(), "old", "new", nil).Return("", errors.New("reindex failed")),
+		client.EXPECT().IndexPutSettings(gomock.Any(), "old", `{"index":{"blocks":{"write":false}}}`).Return(true, nil),
+	)
+	require.Error(t, catchUpAndMoveAlias(newTestCLIContext(), client, "old", "new", "temporal_visibility", nil, log.NewNoopLogger()))
+}
+
+func newTestCLIContext() *cli.Context {
+	flags := goflag.NewFlagSet("test", goflag.ContinueOnError)
+	flags.Int(schema.CLIOptTimeout, 10, "")
+	return cli.NewContext(nil, flags, nil)
+}
+
+func TestCopyMissingFields(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	client := esclient.NewMockSchemaClient(controller)
+
+	client.EXPECT().IndexGetMapping(gomock.Any(), "source").Return(map[string]interface{}{
+		"properties": map[string]interface{}{
+			"WorkflowId":  map[string]interface{}{"type": "keyword"},
+			"CustomField": map[string]interface{}{"type": "keyword"},
+		},
+	}, nil)
+	client.EXPECT().IndexGetMapping(gomock.Any(), "dest").Return(map[string]interface{}{
+		"properties": map[string]interface{}{
+			"WorkflowId": map[string]interface{}{"type": "keyword"},
+		},
+	}, nil)
+	client.EXPECT().IndexPutMapping(gomock.Any(), "dest", `{"properties":{"CustomField":{"type":"keyword"}}}`).Return(true, nil)
+	require.NoError(t, copyMissingFields(context.Background(), client, "source", "dest"))
+}
+
+func TestVerifyServerVersion(t *testing.T) {
+	info := func(number string, distribution string) *esclient.ServerInfo {
+		result := &esclient.ServerInfo{}
+		result.Version.Number = number
+		result.Version.Distribution = distribution
+		return result
+	}
+
+	require.NoError(t, verifyServerVersion(serverVersionV7, info("7.10.2", "")))
+	require.NoError(t, verifyServerVersion(serverVersionOpenSearch, info("1.2.4", "opensearch")))
+	require.Error(t, verifyServerVersion(serverVersionV7, info("6.8.0", "")))
+	require.Error(t, verifyServerVersion(serverVersionV7, info("1.2.4", "opensearch")))
+	require.Error(t, verifyServerVersion(serverVersionOpenSearch, info("7.10.2", "")))
+}
+
+func mappingWithVersion(version string) map[string]interface{} {
+	return map[string]interface{}{
+		"_meta": map[string]interface{}{schemaVersionMetaKey: version},
+	}
+}
+
+func schemaVersion(mapping interface{}) string {
+	meta, _ := mapping.(map[string]interface{})["_meta"].(map[string]interface{})
+	version, _ := meta[schemaVersionMetaKey].(string)
+	return version
+}
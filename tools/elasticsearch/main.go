@@ -0,0 +1,211 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"os"
+
+	"github.com/urfave/cli"
+
+	"go.temporal.io/server/common/log"
+	esschema "go.temporal.io/server/schema/elasticsearch"
+	"go.temporal.io/server/tools/common/schema"
+)
+
+// RunTool runs the temporal-elasticsearch-tool command line tool
+func RunTool(args []string) error {
+	app := buildCLIOptions()
+	return app.Run(args)
+}
+
+// root handler for all cli commands
+func cliHandler(c *cli.Context, handler func(c *cli.Context, logger log.Logger) error, logger log.Logger) {
+	quiet := c.GlobalBool(schema.CLIOptQuiet)
+	err := handler(c, logger)
+	if err != nil && !quiet {
+		os.Exit(1)
+	}
+}
+
+func buildCLIOptions() *cli.App {
+
+	app := cli.NewApp()
+	app.Name = "temporal-elasticsearch-tool"
+	app.Usage = "Command line tool for temporal elasticsearch and opensearch visibility index operations"
+	app.Version = "0.0.1"
+	logger := log.NewCLILogger()
+
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:   CLIFlagURL,
+			Value:  "http://127.0.0.1:9200",
+			Usage:  "URL of elasticsearch or opensearch server",
+			EnvVar: "ES_URL",
+		},
+		cli.StringFlag{
+			Name:   schema.CLIFlagUser,
+			Value:  "",
+			Usage:  "User name used for basic authentication",
+			EnvVar: "ES_USER",
+		},
+		cli.StringFlag{
+			Name:   schema.CLIFlagPassword,
+			Value:  "",
+			Usage:  "Password used for basic authentication",
+			EnvVar: "ES_PWD",
+		},
+		cli.StringFlag{
+			Name:   CLIFlagServerVersion,
+			Value:  serverVersionV7,
+			Usage:  "Server version: " + serverVersionV7 + " (elasticsearch 7.x) or " + serverVersionOpenSearch,
+			EnvVar: "ES_VERSION",
+		},
+		cli.IntFlag{
+			Name:   schema.CLIFlagTimeout,
+			Value:  defaultTimeout,
+			Usage:  "request timeout in seconds, reindex waits for completion regardless",
+			EnvVar: "ES_TIMEOUT",
+		},
+		cli.BoolFlag{
+			Name:  schema.CLIFlagQuiet,
+			Usage: "Don't set exit status to 1 on error",
+		},
+	}
+
+	app.Commands = []cli.Command{
+		{
+			Name:    "setup-schema",
+			Aliases: []string{"setup"},
+			Usage:   "setup cluster settings and visibility index template",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagVersion,
+					Value: esschema.VisibilityVersion,
+					Usage: "version of the index template, recorded in the mapping of indices created from it",
+				},
+				cli.StringFlag{
+					Name:  CLIFlagIndexTemplateFile,
+					Usage: "path to the index template .json file",
+				},
+				cli.StringFlag{
+					Name:  CLIFlagClusterSettingsFile,
+					Usage: "path to the cluster settings .json file; if un-specified, cluster settings are not changed",
+				},
+				cli.StringFlag{
+					Name:  CLIFlagTemplateName,
+					Value: defaultTemplateName,
+					Usage: "name of the index template",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, setupSchema, logger)
+			},
+		},
+		{
+			Name:    "update-schema",
+			Aliases: []string{"update"},
+			Usage:   "update index template and visibility index mapping to a specific version",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  CLIFlagIndex,
+					Usage: "name of the visibility index or its alias",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagTargetVersion,
+					Usage: "target version for the schema update, defaults to latest",
+				},
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+				cli.StringFlag{
+					Name:  CLIFlagCurrentVersion,
+					Usage: "current version of an index which was not created by this tool and has no recorded version",
+				},
+				cli.StringFlag{
+					Name:  CLIFlagTemplateName,
+					Value: defaultTemplateName,
+					Usage: "name of the index template",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, updateSchema, logger)
+			},
+		},
+		{
+			Name:    "create-index",
+			Aliases: []string{"create"},
+			Usage:   "creates a visibility index from the index template",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  CLIFlagIndex,
+					Usage: "name of the visibility index",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, createIndex, logger)
+			},
+		},
+		{
+			Name:    "reindex",
+			Aliases: []string{"ri"},
+			Usage:   "copies documents to a new visibility index and points the alias to it",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  CLIFlagSourceIndex,
+					Usage: "name of the index to copy documents from",
+				},
+				cli.StringFlag{
+					Name:  CLIFlagDestIndex,
+					Usage: "name of the index to copy documents to, created from the index template if it doesn't exist",
+				},
+				cli.StringFlag{
+					Name:  CLIFlagAlias,
+					Usage: "alias used by temporal server; if specified, writes to the source index are blocked and it is moved to the destination index when reindex is done",
+				},
+				cli.StringFlag{
+					Name:  CLIFlagScriptFile,
+					Usage: "path to the painless script file applied to every document",
+				},
+				cli.StringFlag{
+					Name:  CLIFlagScriptParams,
+					Usage: "json object with params passed to the script",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, reindex, logger)
+			},
+		},
+		{
+			Name:  "ping",
+			Usage: "checks that the server is reachable and supported",
+			Action: func(c *cli.Context) {
+				cliHandler(c, ping, logger)
+			},
+		},
+	}
+
+	return app
+}